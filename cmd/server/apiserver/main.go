@@ -6,6 +6,7 @@ import (
 	"kiwi-user/internal/application"
 	"kiwi-user/internal/bootstrap"
 	"kiwi-user/internal/domain"
+	"kiwi-user/internal/domain/service"
 	"kiwi-user/internal/facade"
 	"kiwi-user/internal/facade/server"
 	"kiwi-user/internal/facade/server/route"
@@ -27,10 +28,15 @@ func registerRoute(route *route.Route, apiserver *server.APIServer) error {
 	return nil
 }
 
-func initJWT(rsa *jwt.RSA) error {
+func initJWT(lc fx.Lifecycle, rsa *jwt.RSA) error {
+	lc.Append(fx.StopHook(rsa.Close))
 	return rsa.Init()
 }
 
+func initGuestPurge(lc fx.Lifecycle, guestService *service.GuestService) {
+	lc.Append(fx.StartStopHook(guestService.Start, guestService.Close))
+}
+
 func initBootstrap(b *bootstrap.Bootstrap) error {
 	return b.Init()
 }
@@ -50,6 +56,7 @@ func main() {
 		fx.Invoke(registerRoute),
 		fx.Invoke(initJWT),
 		fx.Invoke(initBootstrap),
+		fx.Invoke(initGuestPurge),
 	)
 	startCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
@@ -27,6 +27,22 @@ type Config struct {
 	OSS        *OSSConfig           `config:"oss"`
 	Mail       *MailClientConfig    `config:"mail"`
 	Captcha    *CaptchaClientConfig `config:"captcha"`
+	OAuth      *OAuthConfig         `config:"oauth"`
+	MFA        *MFAConfig           `config:"mfa"`
+	WebAuthn   *WebAuthnConfig      `config:"webauthn"`
+	Password   *PasswordConfig      `config:"password"`
+
+	LoginProtection *LoginProtectionConfig `config:"login_protection"`
+	Redis           *RedisConfig           `config:"redis"`
+	Apple           *AppleConfig           `config:"apple"`
+	Federation      *FederationConfig      `config:"federation"`
+	SAML            *SAMLConfig            `config:"saml"`
+	MagicLink       *MagicLinkConfig       `config:"magic_link"`
+	QRLogin         *QRLoginConfig         `config:"qr_login"`
+	Guest           *GuestConfig           `config:"guest"`
+	RateLimit       *RateLimitConfig       `config:"rate_limit"`
+
+	VerificationCode *VerificationCodeConfig `config:"verification_code"`
 }
 
 func NewConfig() (*Config, error) {
@@ -77,6 +93,22 @@ func decodeConfig(c *config.Config) (*Config, error) {
 		OSS:        &OSSConfig{},
 		Mail:       &MailClientConfig{},
 		Captcha:    &CaptchaClientConfig{},
+		OAuth:      &OAuthConfig{},
+		MFA:        &MFAConfig{},
+		WebAuthn:   &WebAuthnConfig{},
+		Password:   &PasswordConfig{},
+
+		LoginProtection: &LoginProtectionConfig{},
+		Redis:           &RedisConfig{},
+		Apple:           &AppleConfig{},
+		Federation:      &FederationConfig{},
+		SAML:            &SAMLConfig{},
+		MagicLink:       &MagicLinkConfig{},
+		QRLogin:         &QRLoginConfig{},
+		Guest:           &GuestConfig{},
+		RateLimit:       &RateLimitConfig{},
+
+		VerificationCode: &VerificationCodeConfig{},
 	}
 
 	t := reflect.TypeOf(cfg)
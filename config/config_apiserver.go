@@ -4,4 +4,9 @@ type APIServerConfig struct {
 	Port             string `config:"port" default:"8080"`
 	Index            int64  `config:"index" default:"0"`
 	ExternalAPIToken string `config:"external_api_token" default:""`
+	// 可信代理的 IP 或 CIDR，只有来自它们的请求才读取 X-Forwarded-For 作为客户端 IP，
+	// 为空时客户端 IP 即连接的对端地址。限流、登录锁定和设备记录都按客户端 IP 计算
+	TrustedProxies []string `config:"trusted_proxies"`
+	// 可信平台写入客户端 IP 的请求头，如 CF-Connecting-IP，只在平台会覆盖该请求头时配置
+	TrustedPlatform string `config:"trusted_platform"`
 }
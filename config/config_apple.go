@@ -0,0 +1,12 @@
+package config
+
+// AppleConfig Sign in with Apple
+type AppleConfig struct {
+	TeamID string `config:"team_id"`
+	// KeyID and PrivateKeyPath the Sign in with Apple key (.p8) signing the client secret of the code exchange
+	KeyID          string `config:"key_id"`
+	PrivateKeyPath string `config:"private_key_path"`
+	// ServicesID the client id of web logins, BundleID the client id of the iOS app
+	ServicesID string `config:"services_id"`
+	BundleID   string `config:"bundle_id"`
+}
@@ -3,4 +3,33 @@ package config
 type CaptchaClientConfig struct {
 	AccessKeySecret string `config:"access_key_secret"`
 	AccessKeyID     string `config:"access_key_id"`
+	// Provider alibaba, or static to accept StaticParam only, for tests and local setups
+	Provider    string `config:"provider" default:"alibaba"`
+	Endpoint    string `config:"endpoint" default:"https://captcha.cn-shanghai.aliyuncs.com"`
+	StaticParam string `config:"static_param" default:""`
+	// SceneID the captcha scene of verification codes and password logins
+	SceneID string `config:"scene_id" default:""`
+
+	// Policy of applications without their own: off, always, or risk to require a captcha only once
+	// a risk threshold is reached
+	Policy string `config:"policy" default:"off"`
+	// RiskTargetThreshold codes sent to one phone number or email address within the window without a captcha
+	RiskTargetThreshold int `config:"risk_target_threshold" default:"1"`
+	// RiskIPThreshold codes requested from one client ip within the window without a captcha
+	RiskIPThreshold  int `config:"risk_ip_threshold" default:"3"`
+	RiskWindowSecond int `config:"risk_window" default:"3600"`
+	// RiskLoginFailures recent failed password logins of the user name or the client ip without a captcha
+	RiskLoginFailures int `config:"risk_login_failures" default:"2"`
+
+	// Applications overrides keyed by application name, unset values fall back to the ones above
+	Applications map[string]*CaptchaPolicyConfig `config:"applications"`
+}
+
+type CaptchaPolicyConfig struct {
+	Policy              string `config:"policy"`
+	SceneID             string `config:"scene_id"`
+	RiskTargetThreshold int    `config:"risk_target_threshold"`
+	RiskIPThreshold     int    `config:"risk_ip_threshold"`
+	RiskWindowSecond    int    `config:"risk_window"`
+	RiskLoginFailures   int    `config:"risk_login_failures"`
 }
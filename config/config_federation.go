@@ -0,0 +1,9 @@
+package config
+
+// FederationConfig generic OpenID Connect identity providers registered per application
+type FederationConfig struct {
+	// SecretEncryptionKey AES key (16, 24 or 32 bytes) used to encrypt provider client secrets at rest
+	SecretEncryptionKey string `config:"secret_encryption_key" default:""`
+	// StateExpireSecond lifetime of the state of an authorization request
+	StateExpireSecond int64 `config:"state_expire" default:"600"`
+}
@@ -0,0 +1,11 @@
+package config
+
+// GuestConfig anonymous users trying the product before registering
+type GuestConfig struct {
+	// RoleName the restricted personal role of guests, guest login is disabled in applications without it
+	RoleName string `config:"role_name" default:"guest"`
+	// ExpireSecond guests not upgraded within this period are purged
+	ExpireSecond int64 `config:"expire" default:"2592000"`
+	// PurgeIntervalSecond how often expired guests are looked for
+	PurgeIntervalSecond int64 `config:"purge_interval" default:"3600"`
+}
@@ -1,8 +1,16 @@
 package config
 
 type JWTConfig struct {
-	PublicKeyPath            string `config:"public_key_path" default:""`
-	PrivateKeyPath           string `config:"private_key_path" default:""`
-	AccessTokenExpireSecond  int64  `config:"access_token_expire" default:"600"`
-	RefreshTokenExpireSecond int64  `config:"refresh_token_expire" default:"86400"`
+	PublicKeyPath             string `config:"public_key_path" default:""`
+	PrivateKeyPath            string `config:"private_key_path" default:""`
+	AccessTokenExpireSecond   int64  `config:"access_token_expire" default:"600"`
+	RefreshTokenExpireSecond  int64  `config:"refresh_token_expire" default:"86400"`
+	PasswordResetExpireSecond int64  `config:"password_reset_expire" default:"900"`
+	// RefreshTokenGraceSecond a rotated refresh token is still accepted this long, covers concurrent refreshes
+	RefreshTokenGraceSecond int64 `config:"refresh_token_grace" default:"30"`
+	// RefreshTokenHashKey keys the hash refresh tokens are stored as, changing it signs every device out
+	RefreshTokenHashKey string `config:"refresh_token_hash_key" default:""`
+	// KeyDirectory enables the rotating keyset, the key pair above is imported on first start
+	KeyDirectory    string `config:"key_directory" default:""`
+	KeyReloadSecond int64  `config:"key_reload_interval" default:"60"`
 }
@@ -0,0 +1,29 @@
+package config
+
+type LoginProtectionConfig struct {
+	Enabled bool `config:"enabled" default:"true"`
+	// failures of one user name before it is locked
+	MaxFailures int `config:"max_failures" default:"5"`
+	// failures from one client ip before it is locked, across user names
+	IPMaxFailures int `config:"ip_max_failures" default:"20"`
+	// failures before attempts are delayed, the delay doubles with each further failure
+	DelayAfter      int `config:"delay_after" default:"3"`
+	BaseDelaySecond int `config:"base_delay" default:"1"`
+	MaxDelaySecond  int `config:"max_delay" default:"30"`
+	LockoutSecond   int `config:"lockout" default:"900"`
+	// failures older than the window are forgotten
+	WindowSecond int `config:"window" default:"900"`
+
+	// Applications overrides keyed by application name, unset values fall back to the ones above
+	Applications map[string]*LoginProtectionPolicy `config:"applications"`
+}
+
+type LoginProtectionPolicy struct {
+	MaxFailures     int `config:"max_failures"`
+	IPMaxFailures   int `config:"ip_max_failures"`
+	DelayAfter      int `config:"delay_after"`
+	BaseDelaySecond int `config:"base_delay"`
+	MaxDelaySecond  int `config:"max_delay"`
+	LockoutSecond   int `config:"lockout"`
+	WindowSecond    int `config:"window"`
+}
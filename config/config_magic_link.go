@@ -0,0 +1,14 @@
+package config
+
+// MagicLinkConfig passwordless email login with a link instead of a code
+type MagicLinkConfig struct {
+	// BaseURL external url of this service, the link in the email points to its confirm endpoint
+	BaseURL string `config:"base_url" default:""`
+	// RedirectURL page the browser lands on after opening the link, status and link_id are appended
+	RedirectURL string `config:"redirect_url" default:""`
+	// ExpireSecond lifetime of a link
+	ExpireSecond int64 `config:"expire" default:"900"`
+	// MailTemplate resend template of the email, the link is filled into its verify code variable
+	MailTemplate string `config:"mail_template" default:""`
+	MailSubject  string `config:"mail_subject" default:""`
+}
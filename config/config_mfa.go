@@ -0,0 +1,9 @@
+package config
+
+type MFAConfig struct {
+	// Issuer is shown in authenticator apps
+	Issuer string `config:"issuer" default:"kiwi-user"`
+	// SecretEncryptionKey AES key (16, 24 or 32 bytes) used to encrypt TOTP secrets at rest
+	SecretEncryptionKey   string `config:"secret_encryption_key" default:""`
+	ChallengeExpireSecond int64  `config:"challenge_expire" default:"300"`
+}
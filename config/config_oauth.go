@@ -0,0 +1,12 @@
+package config
+
+type OAuthConfig struct {
+	// Issuer is the public base url of kiwi-user, e.g. https://user.example.com
+	Issuer string `config:"issuer" default:""`
+	// LoginURL is the page that runs one of the /v1/login flows and then returns to /oauth/authorize
+	LoginURL                      string `config:"login_url" default:""`
+	AuthorizationCodeExpireSecond int64  `config:"authorization_code_expire" default:"300"`
+	IDTokenExpireSecond           int64  `config:"id_token_expire" default:"3600"`
+	// ConsentExpireSecond how long the consent page can be answered
+	ConsentExpireSecond int64 `config:"consent_expire" default:"600"`
+}
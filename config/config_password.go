@@ -0,0 +1,13 @@
+package config
+
+type PasswordConfig struct {
+	// Algorithm used for new hashes: argon2id or scrypt, older hashes are upgraded on login
+	Algorithm string `config:"algorithm" default:"argon2id"`
+	// Argon2Memory in KiB
+	Argon2Memory      int `config:"argon2_memory" default:"65536"`
+	Argon2Iterations  int `config:"argon2_iterations" default:"3"`
+	Argon2Parallelism int `config:"argon2_parallelism" default:"2"`
+	ScryptLogN        int `config:"scrypt_ln" default:"15"`
+	ScryptR           int `config:"scrypt_r" default:"8"`
+	ScryptP           int `config:"scrypt_p" default:"1"`
+}
@@ -0,0 +1,12 @@
+package config
+
+// QRLoginConfig browser logins approved by scanning a qr code with the signed in app
+type QRLoginConfig struct {
+	// ExpireSecond lifetime of a qr code
+	ExpireSecond int64 `config:"expire" default:"120"`
+	// ScanURL deep link of the app encoded in the qr code, login_id is appended. The bare login id
+	// is encoded when empty.
+	ScanURL string `config:"scan_url" default:""`
+	// StreamIntervalSecond how often the event stream of a browser checks its login
+	StreamIntervalSecond int64 `config:"stream_interval" default:"1"`
+}
@@ -0,0 +1,28 @@
+package config
+
+// RateLimitConfig sliding window limits of sending verification codes and of the login endpoints,
+// a limit of 0 disables its rule
+type RateLimitConfig struct {
+	Enabled bool `config:"enabled" default:"true"`
+	// TargetLimit codes sent to one phone number or email address within the window
+	TargetLimit        int `config:"target_limit" default:"5"`
+	TargetWindowSecond int `config:"target_window" default:"3600"`
+	// TargetIntervalSecond least time between two codes sent to the same target
+	TargetIntervalSecond int `config:"target_interval" default:"60"`
+	// IPLimit codes requested from one client ip within the window, across targets
+	IPLimit        int `config:"ip_limit" default:"20"`
+	IPWindowSecond int `config:"ip_window" default:"3600"`
+	// ApplicationLimit codes sent for one application within the window, caps the cost of an attack
+	// spreading over many ips and targets
+	ApplicationLimit        int `config:"application_limit" default:"2000"`
+	ApplicationWindowSecond int `config:"application_window" default:"3600"`
+	// LoginIPLimit requests to the login endpoints from one client ip within the window
+	LoginIPLimit        int `config:"login_ip_limit" default:"60"`
+	LoginIPWindowSecond int `config:"login_ip_window" default:"60"`
+	// MFAIPLimit second factor codes tried from one client ip within the window, on top of the login limit
+	MFAIPLimit        int `config:"mfa_ip_limit" default:"10"`
+	MFAIPWindowSecond int `config:"mfa_ip_window" default:"300"`
+	// PollIPLimit polls of pending logins from one client ip within the window, clients poll every few seconds
+	PollIPLimit        int `config:"poll_ip_limit" default:"120"`
+	PollIPWindowSecond int `config:"poll_ip_window" default:"60"`
+}
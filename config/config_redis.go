@@ -0,0 +1,9 @@
+package config
+
+// RedisConfig shared redis, features fall back to process memory when Addr is empty
+type RedisConfig struct {
+	Addr      string `config:"addr" default:""`
+	Password  string `config:"password" default:""`
+	DB        int    `config:"db" default:"0"`
+	KeyPrefix string `config:"key_prefix" default:"kiwi-user:"`
+}
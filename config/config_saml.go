@@ -0,0 +1,12 @@
+package config
+
+// SAMLConfig SAML 2.0 service provider for the identity providers of enterprise organizations
+type SAMLConfig struct {
+	// BaseURL external url of this service, the service provider entity id and
+	// assertion consumer service url of an organization are built from it
+	BaseURL string `config:"base_url" default:""`
+	// RequestExpireSecond lifetime of an authentication request sent to the identity provider
+	RequestExpireSecond int64 `config:"request_expire" default:"600"`
+	// TicketExpireSecond lifetime of the one time ticket exchanged for tokens after the assertion
+	TicketExpireSecond int64 `config:"ticket_expire" default:"60"`
+}
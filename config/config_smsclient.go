@@ -10,4 +10,37 @@ type SmsConfig struct {
 	TemplateParam    string `config:"template_param"`     // 当指定的短信模板（TemplateID）存在变量时，您需要设置变量的实际值。支持传入一个或多个参数，格式示例：{"code1":"1234", "code2":"5678"}
 	DefaultScene     string `config:"default_scene"`      // 默认使用场景
 	Tag              string `config:"tag"`                // 透传字段
+
+	// Providers senders of verification codes in failover order: volcengine, alibaba, twilio, or log
+	// to write the codes to the log and LogFile in local setups. Empty means volcengine.
+	Providers []string `config:"providers"`
+	// CodeParam variable of the verification code in the templates of volcengine and alibaba
+	CodeParam string `config:"code_param" default:"code"`
+
+	// 阿里云短信
+	AlibabaAccessKeyID     string `config:"alibaba_access_key_id" default:""`
+	AlibabaAccessKeySecret string `config:"alibaba_access_key_secret" default:""`
+	AlibabaEndpoint        string `config:"alibaba_endpoint" default:"https://dysmsapi.aliyuncs.com"`
+	AlibabaSignName        string `config:"alibaba_sign_name" default:""`
+	AlibabaTemplateCode    string `config:"alibaba_template_code" default:""`
+
+	// Twilio, the message is sent from TwilioFrom or by the messaging service when it is set
+	TwilioAccountSID          string `config:"twilio_account_sid" default:""`
+	TwilioAuthToken           string `config:"twilio_auth_token" default:""`
+	TwilioFrom                string `config:"twilio_from" default:""`
+	TwilioMessagingServiceSID string `config:"twilio_messaging_service_sid" default:""`
+	TwilioEndpoint            string `config:"twilio_endpoint" default:"https://api.twilio.com"`
+	// TwilioMessage body of the message, {code} is replaced with the verification code
+	TwilioMessage string `config:"twilio_message" default:"Your verification code is {code}"`
+
+	// LogFile the log provider appends the codes to this file as well, empty logs them only
+	LogFile string `config:"log_file" default:""`
+
+	// Applications overrides keyed by application name
+	Applications map[string]*SmsPolicyConfig `config:"applications"`
+}
+
+type SmsPolicyConfig struct {
+	// Providers failover order of the application, empty falls back to the one above
+	Providers []string `config:"providers"`
 }
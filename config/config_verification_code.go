@@ -0,0 +1,13 @@
+package config
+
+// VerificationCodeConfig codes sent by email or sms to prove the ownership of the address or number
+type VerificationCodeConfig struct {
+	// Length digits of a code
+	Length int `config:"length" default:"6"`
+	// ExpireSecond lifetime of a code, sending another one restarts it
+	ExpireSecond int64 `config:"expire" default:"600"`
+	// MaxAttempts wrong guesses after which the code is invalidated and a new one has to be sent
+	MaxAttempts int `config:"max_attempts" default:"5"`
+	// HashKey keys the hash codes are stored as, changing it invalidates the codes in flight
+	HashKey string `config:"hash_key" default:""`
+}
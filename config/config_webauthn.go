@@ -0,0 +1,11 @@
+package config
+
+type WebAuthnConfig struct {
+	// RPID is the domain passkeys are scoped to, e.g. example.com
+	RPID   string `config:"rp_id" default:""`
+	RPName string `config:"rp_name" default:"kiwi-user"`
+	// Origins the browser may report in clientDataJSON, e.g. https://app.example.com
+	Origins                 []string `config:"origins"`
+	RequireUserVerification bool     `config:"require_user_verification" default:"true"`
+	ChallengeExpireSecond   int64    `config:"challenge_expire" default:"300"`
+}
@@ -16,6 +16,11 @@ type WechatConfig struct {
 	// 公众号
 	OfficalAccountID     string `config:"offical_account_id"`
 	OfficalAccountSecret string `config:"offical_account_secret"`
+	// 公众号服务器配置，扫码关注登录的事件推送到 /v1/wechat/officalaccount/callback
+	OfficalAccountToken          string `config:"offical_account_token"`
+	OfficalAccountEncodingAESKey string `config:"offical_account_encoding_aes_key"` // 扫码关注登录只接受安全模式的消息，必填
+	// 扫码关注登录二维码的有效期
+	OfficalAccountQRExpireSecond int64 `config:"offical_account_qr_expire" default:"300"`
 
 	// 企业微信
 	QyWechatCorpID     string `config:"qy_wechat_corp_id"`
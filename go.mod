@@ -6,14 +6,17 @@ require (
 	entgo.io/ent v0.14.3
 	github.com/Yet-Another-AI-Project/kiwi-lib v0.0.0-20260201060824-4468c04b84f9
 	github.com/avast/retry-go v3.0.0+incompatible
+	github.com/beevik/etree v1.1.0
 	github.com/bwmarrin/snowflake v0.3.0
 	github.com/futurxlab/golanggraph v0.0.9
 	github.com/gin-gonic/gin v1.10.1
 	github.com/google/uuid v1.6.0
 	github.com/gookit/config/v2 v2.2.5
 	github.com/lib/pq v1.10.9
+	github.com/mattn/go-sqlite3 v1.14.16
 	github.com/posthog/posthog-go v1.2.24
 	github.com/redis/go-redis/v9 v9.12.1
+	github.com/russellhaering/goxmldsig v1.4.0
 	github.com/stripe/stripe-go/v84 v84.3.0
 	github.com/wechatpay-apiv3/wechatpay-go v0.2.20
 	go.uber.org/fx v1.23.0
@@ -73,6 +76,7 @@ require (
 	github.com/gookit/goutil v0.6.15 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.25.1 // indirect
 	github.com/hashicorp/hcl/v2 v2.19.1 // indirect
+	github.com/jonboulle/clockwork v0.2.2 // indirect
 	github.com/json-iterator/go v1.1.12 // indirect
 	github.com/klauspost/cpuid/v2 v2.2.10 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
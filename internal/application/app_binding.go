@@ -2,119 +2,367 @@ package application
 
 import (
 	"context"
+	"kiwi-user/config"
 	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
 	"kiwi-user/internal/domain/model/entity"
 	"kiwi-user/internal/domain/model/enum"
 	"kiwi-user/internal/domain/service"
 	"kiwi-user/internal/facade/dto"
-	"kiwi-user/internal/infrastructure/utils"
+	"kiwi-user/internal/infrastructure/replay"
 	"time"
 
 	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/posthog/posthog-go"
 )
 
-const (
-	defaultBindingVerifyExpireSecond = 60 * 10
-)
+// appleNonceExpiry outlives the ten minutes an apple identity token is valid
+const appleNonceExpiry = time.Hour
 
+// BindingApplication links login methods to the current user:
+//
+//	verify_code -> a code is sent to the phone or email to link
+//	link        -> the code, oauth code or password proves the ownership and the binding is added
+//	unlink      -> the binding is removed unless it is the last way to log in
 type BindingApplication struct {
-	userReadRepository          contract.IUserReadRepository
-	bindingVerifyReadRepository contract.IBindingVerifyReadRepository
-	bindingService              *service.BindingService
+	userReadRepository contract.IUserReadRepository
+	replayStore        replay.Store
+
+	bindingService        *service.BindingService
+	userService           *service.UserService
+	rateLimitService      *service.RateLimitService
+	captchaService        *service.CaptchaService
+	smsService            *service.SmsService
+	loginProviderRegistry *service.LoginProviderRegistry
+
+	config        *config.Config
+	logger        logger.ILogger
+	posthogClient posthog.Client
 }
 
 func NewBindingApplication(
+	config *config.Config,
+	logger logger.ILogger,
 	userReadRepository contract.IUserReadRepository,
-	bindingVerifyReadRepository contract.IBindingVerifyReadRepository,
 	bindingService *service.BindingService,
+	userService *service.UserService,
+	rateLimitService *service.RateLimitService,
+	captchaService *service.CaptchaService,
+	smsService *service.SmsService,
+	posthogClient posthog.Client,
+	loginProviderRegistry *service.LoginProviderRegistry,
+	replayStore replay.Store,
 ) *BindingApplication {
 	return &BindingApplication{
-		userReadRepository:          userReadRepository,
-		bindingVerifyReadRepository: bindingVerifyReadRepository,
-		bindingService:              bindingService,
+		config:                config,
+		logger:                logger,
+		userReadRepository:    userReadRepository,
+		replayStore:           replayStore,
+		bindingService:        bindingService,
+		userService:           userService,
+		rateLimitService:      rateLimitService,
+		captchaService:        captchaService,
+		smsService:            smsService,
+		posthogClient:         posthogClient,
+		loginProviderRegistry: loginProviderRegistry,
 	}
 }
 
-func (b *BindingApplication) SendPhoneVerifyCode(ctx context.Context, userID string, request *dto.SendPhoneVerifyCodeRequest) *facade.Error {
+func (b *BindingApplication) ListBindings(ctx context.Context, userID string) (*dto.BindingsResponse, *facade.Error) {
+	userAggregate, ferr := b.findUser(ctx, userID)
+	if ferr != nil {
+		return nil, ferr
+	}
 
-	bindingVerifyEntity, err := b.bindingVerifyReadRepository.Find(ctx, userID, enum.BindingTypePhone, request.Phone)
-	if err != nil {
-		return facade.ErrServerInternal.Wrap(err)
+	bindings := make([]*dto.BindingInfo, 0, len(userAggregate.Bindings))
+	for _, binding := range userAggregate.Bindings {
+		bindings = append(bindings, convertBindingToDTO(binding))
 	}
 
-	code := utils.GenerateVerificationCode()
+	return &dto.BindingsResponse{Bindings: bindings}, nil
+}
+
+// SendVerifyCode sends the code proving the ownership of a phone or email
+func (b *BindingApplication) SendVerifyCode(ctx context.Context, userID string, request dto.SendBindingVerifyCodeRequest) (*dto.OperationResponse, *facade.Error) {
+	userAggregate, ferr := b.findUser(ctx, userID)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	bindingType := enum.ParseBindingType(request.Type)
+
+	// do not spend a code on an identity that can not be linked anyway
+	owner, err := b.userService.FindByVerifiedBinding(ctx, userAggregate.Application.ID, bindingType, request.Identity)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
 
-	if bindingVerifyEntity == nil {
-		bindingVerifyEntity = &entity.BindingVerifyEntity{
-			UserID:    userID,
-			Type:      enum.BindingTypePhone,
-			Identity:  request.Phone,
-			Code:      code,
-			ExpiresAt: time.Now().Add(defaultBindingVerifyExpireSecond),
+	if owner != nil {
+		if owner.User.ID != userAggregate.User.ID {
+			return nil, facade.ErrForbidden.Facade("identity is bound to another account")
 		}
+		return nil, facade.ErrForbidden.Facade("identity already bound")
+	}
 
-		bindingVerifyEntity, err = b.bindingService.CreateBindingVerify(ctx, bindingVerifyEntity)
-		if err != nil {
-			return facade.ErrServerInternal.Wrap(err)
+	if b.bindingService.HasBindingOfKind(userAggregate, &entity.BindingEntity{Type: bindingType, Identity: request.Identity}) {
+		return nil, convertBindingError(service.ErrBindingKindLinked)
+	}
+
+	if ferr := checkVerifyCodeCaptcha(ctx, b.captchaService, userAggregate.Application.Name, request.Identity, request.CaptchaVerifyParam); ferr != nil {
+		return nil, ferr
+	}
+
+	if ferr := checkVerifyCodeRateLimit(ctx, b.rateLimitService, userAggregate.Application.Name, request.Identity); ferr != nil {
+		return nil, ferr
+	}
+
+	switch bindingType {
+	case enum.BindingTypePhone:
+		// checked by the phone login provider when linking
+		if err := b.smsService.SendVerifyCode(ctx, userAggregate.Application.Name, request.Identity, enum.VertificationCodeTypeLogin); err != nil {
+			return nil, facade.ErrForbidden.Wrap(err)
 		}
-	} else {
-		if bindingVerifyEntity.UpdatedAt.Add(time.Second * 60).After(time.Now()) {
-			return facade.ErrForbidden.Facade("request too frequent")
+	case enum.BindingTypeEmail:
+		if err := b.bindingService.SendEmailVerifyCode(ctx, request.Identity); err != nil {
+			return nil, facade.ErrServerInternal.Wrap(err)
 		}
+	default:
+		return nil, facade.ErrBadRequest.Facade("unsupported binding type")
+	}
 
-		if bindingVerifyEntity.VerifiedAt.Unix() > 0 {
-			return facade.ErrForbidden.Facade("phone already verified")
+	return &dto.OperationResponse{Success: true}, nil
+}
+
+func (b *BindingApplication) LinkBinding(ctx context.Context, userID string, request dto.LinkBindingRequest) (*dto.BindingsResponse, *facade.Error) {
+	userAggregate, ferr := b.findUser(ctx, userID)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	bindingType := enum.ParseBindingType(request.Type)
+
+	// a password proves nothing, it is only set when the user has none, changing it goes through ChangePassword
+	if bindingType == enum.BindingTypePassword {
+		if request.Password == "" {
+			return nil, facade.ErrBadRequest.Facade("password is required")
 		}
 
-		bindingVerifyEntity.Code = code
-		bindingVerifyEntity.ExpiresAt = time.Now().Add(defaultBindingVerifyExpireSecond)
+		if findPasswordBinding(userAggregate.Bindings) != nil {
+			return nil, facade.ErrForbidden.Facade("password already set")
+		}
 
-		bindingVerifyEntity, err = b.bindingService.UpdateBindingVerify(ctx, bindingVerifyEntity)
+		userAggregate, err := b.userService.UpdatePassword(ctx, userAggregate, request.Password)
 		if err != nil {
-			return facade.ErrServerInternal.Wrap(err)
+			return nil, facade.ErrServerInternal.Wrap(err)
 		}
+
+		b.capture(ctx, userAggregate, "binding_linked", bindingType)
+
+		return b.ListBindings(ctx, userAggregate.User.ID)
 	}
 
-	// TODO: send sms through huaweicloud sms service
+	binding, ferr := b.proveBinding(ctx, userAggregate, bindingType, request)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	if binding.Identity == "" {
+		return nil, facade.ErrForbidden.Facade("identity not found")
+	}
 
-	return nil
+	userAggregate, err := b.bindingService.Link(ctx, userAggregate, binding)
+	if err != nil {
+		return nil, convertBindingError(err)
+	}
+
+	b.capture(ctx, userAggregate, "binding_linked", bindingType)
+
+	return b.ListBindings(ctx, userAggregate.User.ID)
 }
 
-func (b *BindingApplication) VerifyPhoneCode(ctx context.Context, userID string, request *dto.VerifyPhoneCodeRequest) *facade.Error {
+func (b *BindingApplication) UnlinkBinding(ctx context.Context, userID string, bindingType string) (*dto.BindingsResponse, *facade.Error) {
+	userAggregate, ferr := b.findUser(ctx, userID)
+	if ferr != nil {
+		return nil, ferr
+	}
 
-	userAggregate, err := b.userReadRepository.Find(ctx, userID)
+	userAggregate, err := b.bindingService.Unlink(ctx, userAggregate, enum.ParseBindingType(bindingType))
 	if err != nil {
-		return facade.ErrServerInternal.Wrap(err)
+		return nil, convertBindingError(err)
 	}
 
-	if userAggregate == nil {
-		return facade.ErrForbidden.Facade("user not found")
+	b.capture(ctx, userAggregate, "binding_unlinked", enum.ParseBindingType(bindingType))
+
+	return b.ListBindings(ctx, userAggregate.User.ID)
+}
+
+// proveBinding resolves the identity of the request from its proof of ownership
+func (b *BindingApplication) proveBinding(
+	ctx context.Context,
+	userAggregate *aggregate.UserAggregate,
+	bindingType enum.BindingType,
+	request dto.LinkBindingRequest) (*entity.BindingEntity, *facade.Error) {
+
+	if request.Code == "" && request.IdentityToken == "" {
+		return nil, facade.ErrBadRequest.Facade("code is required")
+	}
+
+	credential := service.LoginCredential{
+		Code:          request.Code,
+		RedirectURI:   request.RedirectURI,
+		Platform:      request.Platform,
+		Identity:      request.Identity,
+		CorpID:        request.CorpID,
+		IdentityToken: request.IdentityToken,
+		Nonce:         request.Nonce,
 	}
 
-	bindingVerifyEntity, err := b.bindingVerifyReadRepository.Find(ctx, userID, enum.BindingTypePhone, request.Phone)
+	var providerName string
+
+	switch bindingType {
+	case enum.BindingTypePhone:
+		// the phone code of the mini program carries the phone number
+		if request.Platform != "miniprogram" && request.Identity == "" {
+			return nil, facade.ErrBadRequest.Facade("identity is required")
+		}
+
+		providerName = service.LoginProviderPhone
+	case enum.BindingTypeEmail:
+		if request.Identity == "" {
+			return nil, facade.ErrBadRequest.Facade("identity is required")
+		}
+
+		if err := b.bindingService.VerifyEmailCode(ctx, request.Identity, request.Code); err != nil {
+			return nil, convertBindingError(err)
+		}
+
+		return &entity.BindingEntity{Type: enum.BindingTypeEmail, Identity: request.Identity, Email: request.Identity}, nil
+	case enum.BindingTypeWechat:
+		providerName = service.LoginProviderWechat
+		if request.Platform == "miniprogram" {
+			providerName = service.LoginProviderWechatMiniProgram
+		}
+	case enum.BindingTypeQyWechat:
+		providerName = service.LoginProviderQyWechat
+	case enum.BindingTypeGoogle:
+		providerName = service.LoginProviderGoogle
+	case enum.BindingTypeApple:
+		// a token of the user leaked to anyone else must not link their apple account, the
+		// nonce ties the token to the request of this link
+		if request.Nonce == "" {
+			return nil, facade.ErrBadRequest.Facade("nonce is required")
+		}
+
+		providerName = service.LoginProviderApple
+	default:
+		return nil, facade.ErrBadRequest.Facade("unsupported binding type")
+	}
+
+	provider, err := b.loginProviderRegistry.Provider(providerName)
 	if err != nil {
-		return facade.ErrServerInternal.Wrap(err)
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	identity, err := provider.Resolve(ctx, userAggregate.Application, credential)
+	if err != nil {
+		switch {
+		case xerror.Is(err, service.ErrQyWechatCorpNotFound), xerror.Is(err, service.ErrQyWechatCorpDisabled):
+			return nil, convertQyWechatError(err)
+		case xerror.Is(err, service.ErrLoginCredentialInvalid):
+			return nil, facade.ErrForbidden.Facade("invalid verification code")
+		case xerror.Is(err, service.ErrVerificationCodeExhausted):
+			return nil, convertVerificationCodeError(err)
+		default:
+			return nil, facade.ErrForbidden.Wrap(err)
+		}
 	}
 
-	if bindingVerifyEntity == nil {
-		return facade.ErrForbidden.Facade("verify code not found")
+	if bindingType == enum.BindingTypeApple {
+		if ferr := consumeOnce(ctx, b.replayStore, "apple-nonce:"+request.Nonce, time.Now().Add(appleNonceExpiry)); ferr != nil {
+			return nil, ferr
+		}
 	}
 
-	if bindingVerifyEntity.VerifiedAt.Unix() > 0 {
-		return facade.ErrForbidden.Facade("phone already verified")
+	// the mini program pays and notifies with the openid
+	if identity.WechatOpenID != nil && !hasWechatOpenID(userAggregate.WechatOpenIDs, identity.WechatOpenID) {
+		userAggregate.WechatOpenIDs = append(userAggregate.WechatOpenIDs, identity.WechatOpenID)
 	}
 
-	if bindingVerifyEntity.ExpiresAt.Before(time.Now()) {
-		return facade.ErrForbidden.Facade("verify code expired")
+	return identity.Binding, nil
+}
+
+func (b *BindingApplication) findUser(ctx context.Context, userID string) (*aggregate.UserAggregate, *facade.Error) {
+	userAggregate, err := b.userReadRepository.Find(ctx, userID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	if bindingVerifyEntity.Code != request.Code {
-		return facade.ErrForbidden.Facade("verify code not match")
+	if userAggregate == nil {
+		return nil, facade.ErrForbidden.Facade("user not found")
 	}
 
-	if err := b.bindingService.BindingCodeVerified(ctx, userAggregate, bindingVerifyEntity); err != nil {
+	return userAggregate, nil
+}
+
+func (b *BindingApplication) capture(ctx context.Context, userAggregate *aggregate.UserAggregate, event string, bindingType enum.BindingType) {
+	if err := b.posthogClient.Enqueue(posthog.Capture{
+		DistinctId: userAggregate.User.ID,
+		Event:      event,
+		Properties: map[string]interface{}{
+			"binding_type": bindingType.String(),
+		},
+	}); err != nil {
+		b.logger.Errorf(ctx, "posthog event failed: %w", err)
+	}
+}
+
+func convertBindingError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrBindingConflict):
+		return facade.ErrForbidden.Facade("identity is bound to another account")
+	case xerror.Is(err, service.ErrBindingAlreadyLinked):
+		return facade.ErrForbidden.Facade("identity already bound")
+	case xerror.Is(err, service.ErrBindingNotFound):
+		return facade.ErrForbidden.Facade("binding not found")
+	case xerror.Is(err, service.ErrBindingKindLinked):
+		return facade.ErrForbidden.Facade("a binding of this type is already linked, unlink it first")
+	case xerror.Is(err, service.ErrBindingNotLinkable):
+		return facade.ErrBadRequest.Facade("unsupported binding type")
+	case xerror.Is(err, service.ErrLastLoginMethod):
+		return facade.ErrForbidden.Facade("can not remove the last login method")
+	case xerror.Is(err, service.ErrBindingVerifyCodeInvalid):
+		return facade.ErrForbidden.Facade("invalid verification code")
+	case xerror.Is(err, service.ErrVerificationCodeExhausted):
+		return convertVerificationCodeError(err)
+	default:
 		return facade.ErrServerInternal.Wrap(err)
 	}
+}
+
+func convertBindingToDTO(binding *entity.BindingEntity) *dto.BindingInfo {
+	info := &dto.BindingInfo{
+		Type:     binding.Type.String(),
+		Email:    binding.Email,
+		Verified: binding.Verified,
+	}
+
+	// the identity of a password or second factor binding is a secret
+	switch binding.Type {
+	case enum.BindingTypePhone, enum.BindingTypeEmail:
+		info.Identity = binding.Identity
+	}
+
+	return info
+}
+
+func hasWechatOpenID(openIDs []*entity.WechatOpenIDEntity, openID *entity.WechatOpenIDEntity) bool {
+	for _, existing := range openIDs {
+		if existing.Platform == openID.Platform && existing.OpenID == openID.OpenID {
+			return true
+		}
+	}
 
-	return nil
+	return false
 }
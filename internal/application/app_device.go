@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"kiwi-user/internal/domain/service"
+	"kiwi-user/internal/facade/dto"
+	"strconv"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// DeviceApplication sessions of the user, one per device it logged in on
+type DeviceApplication struct {
+	deviceService *service.DeviceService
+}
+
+func NewDeviceApplication(deviceService *service.DeviceService) *DeviceApplication {
+	return &DeviceApplication{
+		deviceService: deviceService,
+	}
+}
+
+// ListDevices active sessions of the user, current marks the device of the access token
+func (d *DeviceApplication) ListDevices(ctx context.Context, userID string, current dto.Device) (*dto.DeviceSessionsResponse, *facade.Error) {
+	devices, err := d.deviceService.ListActiveDevices(ctx, userID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	response := &dto.DeviceSessionsResponse{
+		Devices: make([]*dto.DeviceSession, 0, len(devices)),
+	}
+
+	for _, device := range devices {
+		session := &dto.DeviceSession{
+			ID:         device.ID,
+			DeviceType: device.DeviceType,
+			DeviceID:   device.DeviceID,
+			LastIP:     device.LastIP,
+			UserAgent:  device.UserAgent,
+			CreatedAt:  device.CreatedAt.Unix(),
+			ExpiresAt:  device.RefreshTokenExpiresAt.Unix(),
+			Current:    device.DeviceType == current.DeviceType && device.DeviceID == current.DeviceID,
+		}
+
+		if device.OrganizationID != uuid.Nil {
+			session.OrganizationID = device.OrganizationID.String()
+		}
+
+		// devices logged in before the refresh time was recorded
+		if !device.LastRefreshedAt.IsZero() {
+			session.LastRefreshedAt = device.LastRefreshedAt.Unix()
+		}
+
+		response.Devices = append(response.Devices, session)
+	}
+
+	return response, nil
+}
+
+func (d *DeviceApplication) RevokeDevice(ctx context.Context, userID string, id string) *facade.Error {
+	deviceID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return facade.ErrBadRequest.Facade("invalid device id")
+	}
+
+	if err := d.deviceService.RevokeDevice(ctx, userID, deviceID); err != nil {
+		if xerror.Is(err, service.ErrDeviceNotFound) {
+			return facade.ErrForbidden.Facade("device not found")
+		}
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	return nil
+}
+
+// RevokeOtherDevices signs out every session but the one of the access token
+func (d *DeviceApplication) RevokeOtherDevices(ctx context.Context, userID string, current dto.Device) *facade.Error {
+	if err := d.deviceService.RevokeOtherDevices(ctx, userID, current.DeviceType, current.DeviceID); err != nil {
+		if xerror.Is(err, service.ErrDeviceNotFound) {
+			return facade.ErrForbidden.Facade("device not found")
+		}
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	return nil
+}
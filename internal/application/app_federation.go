@@ -0,0 +1,174 @@
+package application
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/service"
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// FederationApplication admin management of the OpenID Connect providers of applications
+type FederationApplication struct {
+	applicationService *service.ApplicationService
+	federationService  *service.FederationService
+}
+
+func NewFederationApplication(
+	applicationService *service.ApplicationService,
+	federationService *service.FederationService) *FederationApplication {
+	return &FederationApplication{
+		applicationService: applicationService,
+		federationService:  federationService,
+	}
+}
+
+func (f *FederationApplication) ListIdentityProviders(ctx context.Context, applicationName string) (*dto.IdentityProvidersAdminResponse, *facade.Error) {
+	application, ferr := f.getApplication(ctx, applicationName)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	providers, err := f.federationService.ListProviders(ctx, application.Application.ID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	response := &dto.IdentityProvidersAdminResponse{
+		Providers: make([]*dto.IdentityProvider, 0, len(providers)),
+	}
+
+	for _, provider := range providers {
+		response.Providers = append(response.Providers, convertIdentityProviderToDTO(application, provider))
+	}
+
+	return response, nil
+}
+
+func (f *FederationApplication) CreateIdentityProvider(ctx context.Context, request dto.IdentityProviderRequest) (*dto.IdentityProvider, *facade.Error) {
+	application, ferr := f.getApplication(ctx, request.ApplicationName)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	provider := convertIdentityProviderRequest(application, request)
+	provider.Enabled = request.Enabled == nil || *request.Enabled
+
+	provider, err := f.federationService.CreateProvider(ctx, provider)
+	if err != nil {
+		return nil, convertFederationError(err)
+	}
+
+	return convertIdentityProviderToDTO(application, provider), nil
+}
+
+// UpdateIdentityProvider replaces the configuration, an empty client_secret keeps the current one
+func (f *FederationApplication) UpdateIdentityProvider(ctx context.Context, id string, request dto.IdentityProviderRequest) (*dto.IdentityProvider, *facade.Error) {
+	providerID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid identity provider id")
+	}
+
+	application, ferr := f.getApplication(ctx, request.ApplicationName)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	provider := convertIdentityProviderRequest(application, request)
+	provider.ID = providerID
+	provider.Enabled = request.Enabled == nil || *request.Enabled
+
+	provider, err = f.federationService.UpdateProvider(ctx, provider)
+	if err != nil {
+		return nil, convertFederationError(err)
+	}
+
+	return convertIdentityProviderToDTO(application, provider), nil
+}
+
+func (f *FederationApplication) DeleteIdentityProvider(ctx context.Context, applicationName string, id string) *facade.Error {
+	providerID, err := uuid.Parse(id)
+	if err != nil {
+		return facade.ErrBadRequest.Facade("invalid identity provider id")
+	}
+
+	application, ferr := f.getApplication(ctx, applicationName)
+	if ferr != nil {
+		return ferr
+	}
+
+	if err := f.federationService.DeleteProvider(ctx, application.Application.ID, providerID); err != nil {
+		return convertFederationError(err)
+	}
+
+	return nil
+}
+
+func (f *FederationApplication) getApplication(ctx context.Context, applicationName string) (*aggregate.ApplicationAggregate, *facade.Error) {
+	application, err := f.applicationService.GetApplication(ctx, applicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) || xerror.Is(err, service.ErrApplicationInvalidName) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return application, nil
+}
+
+func convertFederationError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrIdentityProviderNotFound):
+		return facade.ErrForbidden.Facade("identity provider not found")
+	case xerror.Is(err, service.ErrIdentityProviderExists):
+		return facade.ErrForbidden.Facade("identity provider already exists")
+	case xerror.Is(err, service.ErrIdentityProviderInvalid):
+		return facade.ErrBadRequest.Facade("identity provider configuration is invalid")
+	case xerror.Is(err, service.ErrFederationNotConfigured):
+		return facade.ErrForbidden.Facade("federation is not configured")
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
+
+func convertIdentityProviderRequest(application *aggregate.ApplicationAggregate, request dto.IdentityProviderRequest) *entity.IdentityProviderEntity {
+	return &entity.IdentityProviderEntity{
+		ApplicationID:         application.Application.ID,
+		Name:                  request.Name,
+		DisplayName:           request.DisplayName,
+		Issuer:                request.Issuer,
+		ClientID:              request.ClientID,
+		ClientSecret:          request.ClientSecret,
+		Scopes:                request.Scopes,
+		AuthorizationEndpoint: request.AuthorizationEndpoint,
+		TokenEndpoint:         request.TokenEndpoint,
+		UserinfoEndpoint:      request.UserinfoEndpoint,
+		JWKSURI:               request.JWKSURI,
+		ClaimMapping:          request.ClaimMapping,
+	}
+}
+
+func convertIdentityProviderToDTO(application *aggregate.ApplicationAggregate, provider *entity.IdentityProviderEntity) *dto.IdentityProvider {
+	return &dto.IdentityProvider{
+		ID:                    provider.ID.String(),
+		Application:           application.Application.Name,
+		Name:                  provider.Name,
+		DisplayName:           provider.DisplayName,
+		Issuer:                provider.Issuer,
+		ClientID:              provider.ClientID,
+		HasClientSecret:       provider.ClientSecret != "",
+		Scopes:                provider.Scopes,
+		AuthorizationEndpoint: provider.AuthorizationEndpoint,
+		TokenEndpoint:         provider.TokenEndpoint,
+		UserinfoEndpoint:      provider.UserinfoEndpoint,
+		JWKSURI:               provider.JWKSURI,
+		ClaimMapping:          provider.ClaimMapping,
+		Enabled:               provider.Enabled,
+		CreatedAt:             provider.CreatedAt.Unix(),
+		UpdatedAt:             provider.UpdatedAt.Unix(),
+	}
+}
@@ -2,18 +2,22 @@ package application
 
 import (
 	"context"
+	"crypto/subtle"
+	"fmt"
 	"kiwi-user/config"
 	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
 	"kiwi-user/internal/domain/model/entity"
 	"kiwi-user/internal/domain/model/enum"
 	"kiwi-user/internal/domain/service"
 	"kiwi-user/internal/facade/dto"
+	"kiwi-user/internal/infrastructure/apple"
+	"kiwi-user/internal/infrastructure/oidc"
+	"kiwi-user/internal/infrastructure/replay"
+	"kiwi-user/internal/infrastructure/utils"
+	"kiwi-user/internal/infrastructure/wechat"
 	"time"
 
-	"github.com/Yet-Another-AI-Project/kiwi-lib/client/alibaba/captcha"
-
-	"github.com/Yet-Another-AI-Project/kiwi-lib/client/volcengine/msgsms"
-
 	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
 	"github.com/futurxlab/golanggraph/logger"
 	"github.com/futurxlab/golanggraph/xerror"
@@ -29,6 +33,17 @@ type LoginApplication struct {
 	applicationService       *service.ApplicationService
 	deviceService            *service.DeviceService
 	rbacService              *service.RBACService
+	mfaService               *service.MFAService
+	loginProtectionService   *service.LoginProtectionService
+	rateLimitService         *service.RateLimitService
+	captchaService           *service.CaptchaService
+	smsService               *service.SmsService
+	federationService        *service.FederationService
+	samlService              *service.SAMLService
+	magicLinkService         *service.MagicLinkService
+	qrLoginService           *service.QRLoginService
+	guestService             *service.GuestService
+	wechatScanLoginService   *service.WechatScanLoginService
 
 	deviceReadRepository           contract.IDeviceReadRepository
 	userReadRepository             contract.IUserReadRepository
@@ -38,8 +53,9 @@ type LoginApplication struct {
 	logger        logger.ILogger
 	jwthelper     *jwt.JWTHelper
 	posthogClient posthog.Client
-	smsClient     msgsms.SmsClient
-	captchaClient captcha.CaptchaClient
+
+	replayStore     replay.Store
+	officialAccount *wechat.OfficialAccount
 }
 
 func NewLoginApplication(
@@ -49,14 +65,25 @@ func NewLoginApplication(
 	applicationService *service.ApplicationService,
 	deviceService *service.DeviceService,
 	rbacService *service.RBACService,
+	mfaService *service.MFAService,
+	loginProtectionService *service.LoginProtectionService,
+	rateLimitService *service.RateLimitService,
+	captchaService *service.CaptchaService,
+	smsService *service.SmsService,
 	deviceReadRepository contract.IDeviceReadRepository,
 	userReadRepository contract.IUserReadRepository,
 	organizationUserReadRepository contract.IOrganizationUserReadRepository,
 	jwthelper *jwt.JWTHelper,
 	posthogClient posthog.Client,
-	smsClient msgsms.SmsClient,
 	vertificationCodeService *service.VertificationCodeService,
-	captchaClient captcha.CaptchaClient,
+	federationService *service.FederationService,
+	samlService *service.SAMLService,
+	replayStore replay.Store,
+	magicLinkService *service.MagicLinkService,
+	qrLoginService *service.QRLoginService,
+	guestService *service.GuestService,
+	wechatScanLoginService *service.WechatScanLoginService,
+	officialAccount *wechat.OfficialAccount,
 ) *LoginApplication {
 	return &LoginApplication{
 		config:                         config,
@@ -65,201 +92,94 @@ func NewLoginApplication(
 		applicationService:             applicationService,
 		deviceService:                  deviceService,
 		rbacService:                    rbacService,
+		mfaService:                     mfaService,
+		loginProtectionService:         loginProtectionService,
+		rateLimitService:               rateLimitService,
+		captchaService:                 captchaService,
+		smsService:                     smsService,
 		deviceReadRepository:           deviceReadRepository,
 		userReadRepository:             userReadRepository,
 		organizationUserReadRepository: organizationUserReadRepository,
 		jwthelper:                      jwthelper,
 		posthogClient:                  posthogClient,
-		smsClient:                      smsClient,
 		vertificationCodeService:       vertificationCodeService,
-		captchaClient:                  captchaClient,
+		federationService:              federationService,
+		samlService:                    samlService,
+		replayStore:                    replayStore,
+		magicLinkService:               magicLinkService,
+		qrLoginService:                 qrLoginService,
+		guestService:                   guestService,
+		wechatScanLoginService:         wechatScanLoginService,
+		officialAccount:                officialAccount,
 	}
 }
 
 func (l *LoginApplication) WechatWebLogin(ctx context.Context, request dto.WechatWebLoginRequest) (*dto.LoginResponse, *facade.Error) {
-	// get application aggergate
-	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
-	if err != nil {
-		if xerror.Is(err, service.ErrApplicationNotFound) {
-			return nil, facade.ErrForbidden.Facade("application not found")
-		}
-
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	var referralChannel entity.UserRefferalChannel
-
-	if request.ReferralChannel != nil {
-		referralChannel = entity.UserRefferalChannel{
-			Type: request.ReferralChannel.Type,
-			ID:   request.ReferralChannel.ID,
-			Name: request.ReferralChannel.Name,
-		}
-	}
-
-	// login and get user aggregate
-	user, err := l.loginService.WechatWebLogin(
+	return l.providerLogin(
 		ctx,
-		application,
-		referralChannel,
-		request.Code,
+		service.LoginProviderWechat,
+		request.ApplicationName,
+		request.ReferralChannel,
+		request.Device,
+		service.LoginCredential{
+			Code:     request.Code,
+			Platform: request.Platform,
+		},
 		request.Platform)
-	if err != nil {
-		if xerror.Is(err, service.ErrWechatInvalidScope) {
-			return nil, facade.ErrForbidden.Wrap(err)
-		}
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
+}
 
-	// get refreshtoken
-	deviceAggregate, err := l.deviceService.UpsertDevice(
+func (l *LoginApplication) WechatMiniProgramLogin(ctx context.Context, request dto.WechatMiniProgramLoginRequest) (*dto.LoginResponse, *facade.Error) {
+	return l.providerLogin(
 		ctx,
-		user.User.ID,
-		request.Device.DeviceType,
-		request.Device.DeviceID,
-		uuid.Nil)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	// generate login result
-	result, err := generateLoginResult(ctx, user, deviceAggregate.Device, l.rbacService, l.jwthelper)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	if err = l.posthogClient.Enqueue(posthog.Capture{
-		DistinctId: user.User.ID,
-		Event:      "$set",
-		Properties: map[string]interface{}{
-			"$set": map[string]interface{}{
-				"name":        user.User.Name,
-				"display":     user.User.DisplayName,
-				"application": user.Application.Name,
-			},
+		service.LoginProviderWechatMiniProgram,
+		request.ApplicationName,
+		request.ReferralChannel,
+		request.Device,
+		service.LoginCredential{
+			Code:      request.Code,
+			PhoneCode: request.MiniProgramPhoneCode,
 		},
-	}); err != nil {
-		l.logger.Errorf(ctx, "posthog event failed: %w", err)
-	}
+		"miniprogram")
+}
 
-	if err := l.posthogClient.Enqueue(posthog.Capture{
-		DistinctId: user.User.ID,
-		Event:      "login",
-		Properties: map[string]interface{}{
-			"$set": map[string]interface{}{
-				"type":     "wechat",
-				"platform": request.Platform,
-			},
+// QyWechatLogin members of corps mapped to an organization join it
+func (l *LoginApplication) QyWechatLogin(ctx context.Context, request dto.QyWechatLoginRequest) (*dto.LoginResponse, *facade.Error) {
+	return l.providerLogin(
+		ctx,
+		service.LoginProviderQyWechat,
+		request.ApplicationName,
+		request.ReferralChannel,
+		request.Device,
+		service.LoginCredential{
+			Code:   request.Code,
+			CorpID: request.CorpID,
 		},
-	}); err != nil {
-		l.logger.Errorf(ctx, "posthog event failed: %w", err)
-	}
-
-	return result, nil
+		"qywechat")
 }
 
-func (l *LoginApplication) WechatMiniProgramLogin(ctx context.Context, request dto.WechatMiniProgramLoginRequest) (*dto.LoginResponse, *facade.Error) {
+func (l *LoginApplication) PasswordLogin(ctx context.Context, request dto.PasswordLoginRequest, clientIP string) (*dto.LoginResponse, *facade.Error) {
 	// get application aggergate
 	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
 	if err != nil {
 		if xerror.Is(err, service.ErrApplicationNotFound) {
 			return nil, facade.ErrForbidden.Facade("application not found")
 		}
-
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	var referralChannel entity.UserRefferalChannel
-
-	if request.ReferralChannel != nil {
-		referralChannel = entity.UserRefferalChannel{
-			Type: request.ReferralChannel.Type,
-			ID:   request.ReferralChannel.ID,
-			Name: request.ReferralChannel.Name,
-		}
-	}
-
-	// login and get user aggregate
-	user, err := l.loginService.WechatMiniprogramLogin(ctx, application, referralChannel, request.Code, request.MiniProgramPhoneCode)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	// get refreshtoken
-	deviceAggregate, err := l.deviceService.UpsertDevice(
-		ctx,
-		user.User.ID,
-		request.Device.DeviceType,
-		request.Device.DeviceID,
-		uuid.Nil)
-	if err != nil {
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	// generate login result
-	result, err := generateLoginResult(ctx, user, deviceAggregate.Device, l.rbacService, l.jwthelper)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	return result, nil
-}
-
-func (l *LoginApplication) QyWechatLogin(ctx context.Context, request dto.QyWechatLoginRequest) (*dto.LoginResponse, *facade.Error) {
-	// get application aggregate
-	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
-	if err != nil {
-		if xerror.Is(err, service.ErrApplicationNotFound) {
-			return nil, facade.ErrForbidden.Facade("application not found")
+	// reject locked or throttled user names and client ips before checking the password
+	if err := l.loginProtectionService.Check(ctx, application.Application.Name, request.Name, clientIP); err != nil {
+		if xerror.Is(err, service.ErrLoginLocked) {
+			return nil, facade.ErrForbidden.Facade("too many failed logins, try again later")
 		}
-
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	var referralChannel entity.UserRefferalChannel
-
-	if request.ReferralChannel != nil {
-		referralChannel = entity.UserRefferalChannel{
-			Type: request.ReferralChannel.Type,
-			ID:   request.ReferralChannel.ID,
-			Name: request.ReferralChannel.Name,
+		if xerror.Is(err, service.ErrLoginThrottled) {
+			return nil, facade.ErrForbidden.Facade("login attempted too soon, try again later")
 		}
-	}
-
-	// login and get user aggregate
-	user, err := l.loginService.QyWechatLogin(ctx, application, referralChannel, request.Code)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	// get refreshtoken
-	deviceAggregate, err := l.deviceService.UpsertDevice(
-		ctx,
-		user.User.ID,
-		request.Device.DeviceType,
-		request.Device.DeviceID,
-		uuid.Nil)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	// generate login result
-	result, err := generateLoginResult(ctx, user, deviceAggregate.Device, l.rbacService, l.jwthelper)
-	if err != nil {
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	return result, nil
-}
-
-func (l *LoginApplication) PasswordLogin(ctx context.Context, request dto.PasswordLoginRequest) (*dto.LoginResponse, *facade.Error) {
-	// get application aggergate
-	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
-	if err != nil {
-		if xerror.Is(err, service.ErrApplicationNotFound) {
-			return nil, facade.ErrForbidden.Facade("application not found")
-		}
-		return nil, facade.ErrServerInternal.Wrap(err)
+	if err := l.captchaService.CheckPasswordLogin(ctx, application.Application.Name, request.Name, clientIP, request.CaptchaVerifyParam); err != nil {
+		return nil, convertCaptchaError(err)
 	}
 
 	// login and get user aggregate
@@ -267,57 +187,45 @@ func (l *LoginApplication) PasswordLogin(ctx context.Context, request dto.Passwo
 	if err != nil {
 
 		if xerror.Is(err, service.ErrUserNotFound) {
+			l.recordLoginFailure(ctx, application.Application.Name, request.Name, clientIP)
 			return nil, facade.ErrForbidden.Facade("user not found")
 		}
 
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	// get refreshtoken
-	deviceAggregate, err := l.deviceService.UpsertDevice(
-		ctx,
-		user.User.ID,
-		request.Device.DeviceType,
-		request.Device.DeviceID,
-		uuid.Nil)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	// generate login result
-	result, err := generateLoginResult(ctx, user, deviceAggregate.Device, l.rbacService, l.jwthelper)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
+	if err := l.loginProtectionService.RecordSuccess(ctx, application.Application.Name, request.Name); err != nil {
+		l.logger.Errorf(ctx, "reset login failures failed: %w", err)
 	}
 
-	if err := l.posthogClient.Enqueue(posthog.Capture{
-		DistinctId: user.User.ID,
-		Event:      "$set",
-		Properties: map[string]interface{}{
-			"$set": map[string]interface{}{
-				"name":        user.User.Name,
-				"display":     user.User.DisplayName,
-				"application": user.Application.Name,
-			},
-		},
-	}); err != nil {
-		l.logger.Errorf(ctx, "posthog event failed: %w", err)
-	}
+	return l.completeLogin(ctx, user, request.Device, uuid.Nil, "namepass", map[string]interface{}{
+		"platform": "",
+	})
+}
 
-	if err := l.posthogClient.Enqueue(posthog.Capture{
-		DistinctId: user.User.ID,
-		Event:      "login",
-		Properties: map[string]interface{}{
-			"$set": map[string]interface{}{
-				"type":     "namepass",
-				"platform": "",
+// recordLoginFailure count the failure and report new lockouts, errors must not change the login response
+func (l *LoginApplication) recordLoginFailure(ctx context.Context, applicationName string, name string, clientIP string) {
+	locks, err := l.loginProtectionService.RecordFailure(ctx, applicationName, name, clientIP)
+	if err != nil {
+		l.logger.Errorf(ctx, "record login failure failed: %w", err)
+		return
+	}
+
+	for _, lock := range locks {
+		if err := l.posthogClient.Enqueue(posthog.Capture{
+			DistinctId: fmt.Sprintf("%s:%s", lock.Scope, lock.Subject),
+			Event:      "login_lockout",
+			Properties: map[string]interface{}{
+				"application":  lock.ApplicationName,
+				"scope":        lock.Scope.String(),
+				"subject":      lock.Subject,
+				"failures":     lock.Failures,
+				"locked_until": lock.LockedUntil.Unix(),
 			},
-		},
-	}); err != nil {
-		l.logger.Errorf(ctx, "posthog event failed: %w", err)
+		}); err != nil {
+			l.logger.Errorf(ctx, "posthog event failed: %w", err)
+		}
 	}
-
-	return result, nil
 }
 
 func (l *LoginApplication) OrganizationLogin(ctx context.Context, request dto.OrganizationLoginRequest) (*dto.LoginResponse, *facade.Error) {
@@ -368,9 +276,17 @@ func (l *LoginApplication) OrganizationLogin(ctx context.Context, request dto.Or
 		return nil, facade.ErrForbidden.Facade("device not found")
 	}
 
-	if deviceAggregate.Device.RefreshTokenExpiresAt.Before(time.Now()) ||
-		deviceAggregate.Device.RefreshToken != request.RefreshToken {
-		return nil, facade.ErrForbidden.Facade("invalid refresh token")
+	// refresh tokens are only stored hashed, switching the organization issues a rotated one
+	deviceAggregate, err = l.deviceService.RotateRefreshToken(ctx, deviceAggregate, request.RefreshToken)
+	if err != nil {
+		if xerror.Is(err, service.ErrRefreshTokenReused) {
+			reportRefreshTokenReuse(ctx, l.logger, l.posthogClient, userAggregate, request.Device.DeviceType, request.Device.DeviceID)
+			return nil, facade.ErrForbidden.Facade("invalid refresh token")
+		}
+		if xerror.Is(err, service.ErrRefreshTokenInvalid) {
+			return nil, facade.ErrForbidden.Facade("invalid refresh token")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
 	// update organization id
@@ -383,111 +299,43 @@ func (l *LoginApplication) OrganizationLogin(ctx context.Context, request dto.Or
 	// genereate new access token
 	result, err := generateLoginResult(ctx, userAggregate, deviceAggregate.Device, l.rbacService, l.jwthelper)
 	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
+		return nil, convertLoginResultError(err)
 	}
 
 	return result, nil
 }
 
 func (l *LoginApplication) PhoneLogin(ctx context.Context, request dto.PhoneLoginRequest) (*dto.LoginResponse, *facade.Error) {
-	// 1. 获取应用
-	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
-	if err != nil {
-		if xerror.Is(err, service.ErrApplicationNotFound) {
-			return nil, facade.ErrForbidden.Facade("application not found")
-		}
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	// 2. 验证手机验证码
-	verified, err := l.smsClient.CheckVerifyCode(request.Phone, request.VerifyCode)
-	if err != nil {
-		l.logger.Errorf(ctx, "phone login error: %w", err)
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	if !verified {
-		l.logger.Errorf(ctx, "phone login code verified fail")
-		return nil, facade.ErrForbidden.Facade("invalid verification code")
-	}
-
-	// 3. 查找或创建用户
-	user, err := l.loginService.PhoneLogin(ctx, application, request.Phone)
-	if err != nil {
-		l.logger.Errorf(ctx, "phone login or create account error: %w", err)
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	// 4. 更新或创建设备
-	l.logger.Debugf(ctx, "phone login UpsertDevice")
-	deviceAggregate, err := l.deviceService.UpsertDevice(
+	return l.providerLogin(
 		ctx,
-		user.User.ID,
-		request.Device.DeviceType,
-		request.Device.DeviceID,
-		uuid.Nil)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	// 5. 生成登录结果
-	l.logger.Debugf(ctx, "phone login generateLoginResult")
-	result, err := generateLoginResult(ctx, user, deviceAggregate.Device, l.rbacService, l.jwthelper)
-	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
-	}
-
-	// 6. 记录登录事件
-	l.logger.Debugf(ctx, "phone login Enqueue")
-	if err = l.posthogClient.Enqueue(posthog.Capture{
-		DistinctId: user.User.ID,
-		Event:      "$set",
-		Properties: map[string]interface{}{
-			"$set": map[string]interface{}{
-				"name":        user.User.Name,
-				"display":     user.User.DisplayName,
-				"application": user.Application.Name,
-			},
+		service.LoginProviderPhone,
+		request.ApplicationName,
+		nil,
+		request.Device,
+		service.LoginCredential{
+			Code:     request.VerifyCode,
+			Identity: request.Phone,
 		},
-	}); err != nil {
-		l.logger.Errorf(ctx, "posthog event failed: %w", err)
-	}
-
-	if err = l.posthogClient.Enqueue(posthog.Capture{
-		DistinctId: user.User.ID,
-		Event:      "login",
-		Properties: map[string]interface{}{
-			"$set": map[string]interface{}{
-				"type":     "phone",
-				"platform": "app",
-			},
-		},
-	}); err != nil {
-		l.logger.Errorf(ctx, "posthog event failed: %w", err)
-	}
-
-	return result, nil
+		"app")
 }
 
 // SendSmsVerifyCode sends a verification code to the specified phone
-func (l *LoginApplication) SendPhoneVerifyCode(ctx context.Context, phone string) *facade.Error {
-	if phone == "" {
+func (l *LoginApplication) SendPhoneVerifyCode(ctx context.Context, request dto.SendVerifyCodeRequest) *facade.Error {
+	if request.Phone == "" {
 		return facade.ErrBadRequest.Facade("phone is required")
 	}
 
-	result, err := l.smsClient.SendVerifyCode(phone, l.config.Sms.VerifyTemplateID)
-	if err != nil {
-		return facade.ErrForbidden.Wrap(err)
+	// the captcha goes first, unsolved requests must not use up the limits of the target
+	if ferr := checkVerifyCodeCaptcha(ctx, l.captchaService, request.ApplicationName, request.Phone, request.CaptchaVerifyParam); ferr != nil {
+		return ferr
 	}
 
-	if result == nil {
-		return facade.ErrServerInternal.Facade("sms no response")
+	if ferr := checkVerifyCodeRateLimit(ctx, l.rateLimitService, request.ApplicationName, request.Phone); ferr != nil {
+		return ferr
 	}
 
-	if result.ResponseMetadata.Error != nil {
-		l.logger.Errorf(ctx, "SendVerifyCode ResponseMetadata Error: %w", result.ResponseMetadata.Error)
-		return facade.ErrServerInternal.Facade(result.ResponseMetadata.Error.Message)
-
+	if err := l.smsService.SendVerifyCode(ctx, request.ApplicationName, request.Phone, enum.VertificationCodeTypeLogin); err != nil {
+		return facade.ErrForbidden.Wrap(err)
 	}
 
 	return nil
@@ -495,8 +343,22 @@ func (l *LoginApplication) SendPhoneVerifyCode(ctx context.Context, phone string
 
 // SendEmailVerificationCode sends a verification code to the specified email
 func (l *LoginApplication) SendEmailVerificationCode(ctx context.Context, request dto.SendEmailVerificationCodeRequest) *facade.Error {
+	// binding codes are sent to a logged in user only
+	codeType := enum.ParseVertificationCodeType(request.CodeType.String())
+	if codeType != enum.VertificationCodeTypeLogin && codeType != enum.VertificationCodeTypePasswordReset {
+		return facade.ErrBadRequest.Facade("unsupported code type")
+	}
+
+	if ferr := checkVerifyCodeCaptcha(ctx, l.captchaService, request.ApplicationName, request.Email, request.CaptchaVerifyParam); ferr != nil {
+		return ferr
+	}
+
+	if ferr := checkVerifyCodeRateLimit(ctx, l.rateLimitService, request.ApplicationName, request.Email); ferr != nil {
+		return ferr
+	}
+
 	// Send verification code
-	err := l.vertificationCodeService.SendEmailVerificationCode(ctx, request.Email, request.CodeType)
+	err := l.vertificationCodeService.SendEmailVerificationCode(ctx, request.Email, codeType)
 	if err != nil {
 		return facade.ErrServerInternal.Wrap(err)
 	}
@@ -506,7 +368,21 @@ func (l *LoginApplication) SendEmailVerificationCode(ctx context.Context, reques
 
 // EmailLogin handles email verification code login
 func (l *LoginApplication) EmailLogin(ctx context.Context, request dto.EmailLoginRequest) (*dto.LoginResponse, *facade.Error) {
-	// 1. Get application
+	return l.providerLogin(
+		ctx,
+		service.LoginProviderEmail,
+		request.ApplicationName,
+		nil,
+		request.Device,
+		service.LoginCredential{
+			Code:     request.VerifyCode,
+			Identity: request.Email,
+		},
+		"app")
+}
+
+// SendEmailLink emails a login link bound to the requesting device
+func (l *LoginApplication) SendEmailLink(ctx context.Context, request dto.EmailLinkRequest) (*dto.EmailLinkResponse, *facade.Error) {
 	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
 	if err != nil {
 		if xerror.Is(err, service.ErrApplicationNotFound) {
@@ -515,27 +391,382 @@ func (l *LoginApplication) EmailLogin(ctx context.Context, request dto.EmailLogi
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	// 2. Verify email code
-	verified, err := l.vertificationCodeService.VerifyEmailCode(ctx, request.Email, request.VerifyCode, enum.VertificationCodeTypeLogin)
-	if err != nil {
-		l.logger.Debugf(ctx, "email login code verified fail: %w", err)
-		return nil, facade.ErrForbidden.Facade(err.Error())
+	// every link costs an email like a verification code
+	if ferr := checkVerifyCodeCaptcha(ctx, l.captchaService, application.Application.Name, request.Email, request.CaptchaVerifyParam); ferr != nil {
+		return nil, ferr
 	}
 
-	if !verified {
-		l.logger.Debugf(ctx, "email login code verified fail")
-		return nil, facade.ErrForbidden.Facade("email verification failed")
+	if ferr := checkVerifyCodeRateLimit(ctx, l.rateLimitService, application.Application.Name, request.Email); ferr != nil {
+		return nil, ferr
 	}
 
-	// 3. Find or create user
-	user, err := l.loginService.EmailLogin(ctx, application, request.Email)
+	link, pollToken, err := l.magicLinkService.Create(
+		ctx,
+		application.Application.ID,
+		request.Email,
+		request.Device.DeviceType,
+		request.Device.DeviceID)
 	if err != nil {
-		l.logger.Debugf(ctx, "email login or create account error: %w", err)
+		if xerror.Is(err, service.ErrMagicLinkNotConfigured) {
+			return nil, facade.ErrForbidden.Facade("magic link is not configured")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	token, err := l.jwthelper.GenerateRSA256JWT(
+		l.jwthelper.NewMagicLinkPayload(link.ID.String(), application.Application.Name, request.Email))
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if err := l.magicLinkService.Send(ctx, request.Email, token.String()); err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return &dto.EmailLinkResponse{
+		LinkID:    link.ID.String(),
+		PollToken: pollToken,
+		ExpiresAt: link.ExpiresAt.Unix(),
+	}, nil
+}
+
+// ShowEmailLink the landing page of the link in the email names the device that requested the
+// login. Opening the link changes nothing, a mail scanner prefetching it does not confirm it.
+func (l *LoginApplication) ShowEmailLink(ctx context.Context, token string) (*dto.EmailLinkDetails, *facade.Error) {
+	id, ok := l.magicLinkID(token)
+	if !ok {
+		return &dto.EmailLinkDetails{Status: dto.EmailLinkStatusInvalid}, nil
+	}
+
+	link, err := l.magicLinkService.Find(ctx, id)
+	if err != nil {
+		status, ferr := convertMagicLinkStatus(err)
+		if ferr != nil {
+			return nil, ferr
+		}
+		return &dto.EmailLinkDetails{Status: status}, nil
+	}
+
+	return &dto.EmailLinkDetails{
+		Status:     dto.EmailLinkStatusPending,
+		Token:      token,
+		Email:      link.Email,
+		DeviceType: link.DeviceType,
+		ClientIP:   link.ClientIP,
+		UserAgent:  link.UserAgent,
+		CreatedAt:  link.CreatedAt,
+		ExpiresAt:  link.ExpiresAt,
+	}, nil
+}
+
+// ConfirmEmailLink posted from the landing page once the user recognized the requesting device.
+// It only confirms the login and returns the page to redirect the browser to, the requesting
+// device completes the login.
+func (l *LoginApplication) ConfirmEmailLink(ctx context.Context, token string) (string, *facade.Error) {
+	status := dto.EmailLinkStatusConfirmed
+	linkID := ""
+
+	id, ok := l.magicLinkID(token)
+	if !ok {
+		status = dto.EmailLinkStatusInvalid
+	} else {
+		linkID = id.String()
+		if err := l.magicLinkService.Confirm(ctx, id); err != nil {
+			var ferr *facade.Error
+			if status, ferr = convertMagicLinkStatus(err); ferr != nil {
+				return "", ferr
+			}
+		}
+	}
+
+	landingURL, err := l.magicLinkService.LandingURL(status, linkID)
+	if err != nil {
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+
+	return landingURL, nil
+}
+
+// magicLinkID the id of the magic link the token of the email was issued for
+func (l *LoginApplication) magicLinkID(token string) (uuid.UUID, bool) {
+	jwtToken, err := l.jwthelper.VerifyRS256JWT(token)
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	var mp jwt.MagicLinkPayload
+	if err := jwtToken.UnmarshalPayload(&mp); err != nil || mp.Type != jwt.MAGICLINK || mp.Expire < time.Now().Unix() {
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(mp.ID)
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+func convertMagicLinkStatus(err error) (string, *facade.Error) {
+	switch {
+	case xerror.Is(err, service.ErrMagicLinkNotFound):
+		return dto.EmailLinkStatusExpired, nil
+	case xerror.Is(err, service.ErrMagicLinkUsed):
+		return dto.EmailLinkStatusUsed, nil
+	default:
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+}
+
+// PollEmailLink completes the login of the device that requested the link once it was opened
+func (l *LoginApplication) PollEmailLink(ctx context.Context, request dto.EmailLinkPollRequest) (*dto.EmailLinkPollResponse, *facade.Error) {
+	linkID, err := uuid.Parse(request.LinkID)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid link id")
+	}
+
+	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	link, confirmed, err := l.magicLinkService.Complete(
+		ctx,
+		application.Application.ID,
+		linkID,
+		request.PollToken,
+		request.Device.DeviceType,
+		request.Device.DeviceID)
+	if err != nil {
+		if xerror.Is(err, service.ErrMagicLinkNotFound) {
+			return nil, facade.ErrForbidden.Facade("magic link not found or expired")
+		}
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	// 4. Update or create device
-	l.logger.Debugf(ctx, "email login UpsertDevice")
+	if !confirmed {
+		return &dto.EmailLinkPollResponse{
+			Status: dto.EmailLinkStatusPending,
+		}, nil
+	}
+
+	// find or create user, opening the link proved the email
+	user, err := l.loginService.EmailLogin(ctx, application, link.Email)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	result, ferr := l.completeLogin(ctx, user, request.Device, uuid.Nil, "email_link", map[string]interface{}{
+		"platform": "app",
+	})
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	return &dto.EmailLinkPollResponse{
+		Status: dto.EmailLinkStatusConfirmed,
+		Login:  result,
+	}, nil
+}
+
+// GuestLogin logs the device in as an anonymous guest with the restricted guest role
+func (l *LoginApplication) GuestLogin(ctx context.Context, request dto.GuestLoginRequest) (*dto.LoginResponse, *facade.Error) {
+	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	guestRole, err := l.guestService.GuestRole(ctx, application.Application.Name)
+	if err != nil {
+		if xerror.Is(err, service.ErrGuestLoginDisabled) {
+			return nil, facade.ErrForbidden.Facade("guest login is not enabled")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	user, guestSecret, err := l.loginService.GuestLogin(ctx, application, guestRole, request.GuestSecret)
+	if err != nil {
+		if xerror.Is(err, service.ErrGuestSecretInvalid) {
+			return nil, facade.ErrUnauthorized.Facade("guest secret invalid")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	result, ferr := l.completeLogin(ctx, user, request.Device, uuid.Nil, "guest", map[string]interface{}{
+		"platform": request.Device.DeviceType,
+	})
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	result.GuestSecret = guestSecret
+	return result, nil
+}
+
+// CreateQRLogin a pending login of the browser, rendered as qr code for the app to scan
+func (l *LoginApplication) CreateQRLogin(ctx context.Context, request dto.QRLoginRequest) (*dto.QRLoginResponse, *facade.Error) {
+	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	login, pollToken, err := l.qrLoginService.Create(
+		ctx,
+		application.Application.ID,
+		request.Device.DeviceType,
+		request.Device.DeviceID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	payload, err := l.qrLoginService.Payload(login)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return &dto.QRLoginResponse{
+		LoginID:   login.ID.String(),
+		QRPayload: payload,
+		PollToken: pollToken,
+		ExpiresAt: login.ExpiresAt.Unix(),
+	}, nil
+}
+
+// PollQRLogin the state of the qr code for the browser that created it, once approved the browser
+// is logged in as the user who scanned it
+func (l *LoginApplication) PollQRLogin(ctx context.Context, request dto.QRLoginPollRequest) (*dto.QRLoginPollResponse, *facade.Error) {
+	applicationID, loginID, ferr := l.resolveQRLogin(ctx, request)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	response, err := l.pollQRLogin(ctx, applicationID, loginID, request)
+	if err != nil {
+		return nil, convertQRLoginError(err)
+	}
+
+	return response, nil
+}
+
+// StreamQRLogin sends the state of the qr code to the browser whenever it changes, until the login
+// is approved, rejected or expired or the browser goes away. Errors before the first state are
+// returned, later ones end the stream.
+func (l *LoginApplication) StreamQRLogin(
+	ctx context.Context,
+	request dto.QRLoginPollRequest,
+	send func(*dto.QRLoginPollResponse)) *facade.Error {
+	applicationID, loginID, ferr := l.resolveQRLogin(ctx, request)
+	if ferr != nil {
+		return ferr
+	}
+
+	response, err := l.pollQRLogin(ctx, applicationID, loginID, request)
+	if err != nil {
+		return convertQRLoginError(err)
+	}
+	send(response)
+
+	ticker := time.NewTicker(time.Duration(l.config.QRLogin.StreamIntervalSecond) * time.Second)
+	defer ticker.Stop()
+
+	status := response.Status
+	for status == dto.QRLoginStatusPending || status == dto.QRLoginStatusScanned {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		response, err := l.pollQRLogin(ctx, applicationID, loginID, request)
+		if err != nil {
+			if xerror.Is(err, service.ErrQRLoginNotFound) {
+				send(&dto.QRLoginPollResponse{
+					Status: dto.QRLoginStatusExpired,
+				})
+				return nil
+			}
+
+			l.logger.Errorf(ctx, "stream qr login failed: %w", err)
+			return nil
+		}
+
+		if response.Status != status {
+			send(response)
+			status = response.Status
+		}
+	}
+
+	return nil
+}
+
+func (l *LoginApplication) resolveQRLogin(ctx context.Context, request dto.QRLoginPollRequest) (uuid.UUID, uuid.UUID, *facade.Error) {
+	loginID, err := uuid.Parse(request.LoginID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, facade.ErrBadRequest.Facade("invalid login id")
+	}
+
+	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return uuid.Nil, uuid.Nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return uuid.Nil, uuid.Nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return application.Application.ID, loginID, nil
+}
+
+func (l *LoginApplication) pollQRLogin(
+	ctx context.Context,
+	applicationID uuid.UUID,
+	loginID uuid.UUID,
+	request dto.QRLoginPollRequest) (*dto.QRLoginPollResponse, error) {
+	login, err := l.qrLoginService.Poll(
+		ctx,
+		applicationID,
+		loginID,
+		request.PollToken,
+		request.Device.DeviceType,
+		request.Device.DeviceID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	switch login.Status {
+	case enum.QRLoginStatusApproved:
+	case enum.QRLoginStatusRejected:
+		return &dto.QRLoginPollResponse{
+			Status: dto.QRLoginStatusRejected,
+		}, nil
+	case enum.QRLoginStatusScanned:
+		return &dto.QRLoginPollResponse{
+			Status: dto.QRLoginStatusScanned,
+		}, nil
+	default:
+		return &dto.QRLoginPollResponse{
+			Status: dto.QRLoginStatusPending,
+		}, nil
+	}
+
+	user, err := l.userReadRepository.Find(ctx, login.UserID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if user == nil {
+		return nil, xerror.Wrap(service.ErrUserNotFound)
+	}
+
+	// approving from a signed in app stands in for the second factor
 	deviceAggregate, err := l.deviceService.UpsertDevice(
 		ctx,
 		user.User.ID,
@@ -543,48 +774,93 @@ func (l *LoginApplication) EmailLogin(ctx context.Context, request dto.EmailLogi
 		request.Device.DeviceID,
 		uuid.Nil)
 	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
+		return nil, xerror.Wrap(err)
 	}
 
-	// 5. Generate login result
 	result, err := generateLoginResult(ctx, user, deviceAggregate.Device, l.rbacService, l.jwthelper)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	l.captureLogin(ctx, user, "qr_code", map[string]interface{}{
+		"platform": "web",
+	})
+
+	return &dto.QRLoginPollResponse{
+		Status: dto.QRLoginStatusApproved,
+		Login:  result,
+	}, nil
+}
+
+// ScanQRLogin the signed in user scanned the code, the browser asking to log in is returned for
+// the user to approve
+func (l *LoginApplication) ScanQRLogin(ctx context.Context, userID string, request dto.QRLoginScanRequest) (*dto.QRLoginScanResponse, *facade.Error) {
+	loginID, err := uuid.Parse(request.LoginID)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid login id")
+	}
+
+	user, err := l.userReadRepository.Find(ctx, userID)
 	if err != nil {
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	// 6. Record login event
-	if err = l.posthogClient.Enqueue(posthog.Capture{
-		DistinctId: user.User.ID,
-		Event:      "$set",
-		Properties: map[string]interface{}{
-			"$set": map[string]interface{}{
-				"name":        user.User.Name,
-				"display":     user.User.DisplayName,
-				"application": user.Application.Name,
-			},
-		},
-	}); err != nil {
-		l.logger.Errorf(ctx, "posthog event failed: %w", err)
+	if user == nil {
+		return nil, facade.ErrForbidden.Facade("user not found")
 	}
 
-	if err = l.posthogClient.Enqueue(posthog.Capture{
-		DistinctId: user.User.ID,
-		Event:      "login",
-		Properties: map[string]interface{}{
-			"$set": map[string]interface{}{
-				"type":     "email",
-				"platform": "app",
-			},
-		},
-	}); err != nil {
-		l.logger.Errorf(ctx, "posthog event failed: %w", err)
+	login, err := l.qrLoginService.Scan(ctx, loginID, user.User, user.Application.ID)
+	if err != nil {
+		return nil, convertQRLoginError(err)
 	}
 
-	return result, nil
+	return &dto.QRLoginScanResponse{
+		LoginID:   login.ID.String(),
+		ClientIP:  login.ClientIP,
+		UserAgent: login.UserAgent,
+		CreatedAt: login.CreatedAt.Unix(),
+		ExpiresAt: login.ExpiresAt.Unix(),
+	}, nil
 }
 
-func (l *LoginApplication) GoogleWebLogin(ctx context.Context, request dto.GoogleWebLoginRequest) (*dto.LoginResponse, *facade.Error) {
-	// get application aggregate
+// ConfirmQRLogin approves or rejects a code the user scanned
+func (l *LoginApplication) ConfirmQRLogin(ctx context.Context, userID string, request dto.QRLoginConfirmRequest) *facade.Error {
+	loginID, err := uuid.Parse(request.LoginID)
+	if err != nil {
+		return facade.ErrBadRequest.Facade("invalid login id")
+	}
+
+	user, err := l.userReadRepository.Find(ctx, userID)
+	if err != nil {
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	if user == nil {
+		return facade.ErrForbidden.Facade("user not found")
+	}
+
+	if err := l.qrLoginService.Decide(ctx, loginID, user.User, user.Application.ID, request.Approve); err != nil {
+		return convertQRLoginError(err)
+	}
+
+	return nil
+}
+
+func convertQRLoginError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrQRLoginNotFound):
+		return facade.ErrForbidden.Facade("qr login not found or expired")
+	case xerror.Is(err, service.ErrQRLoginUsed):
+		return facade.ErrForbidden.Facade("qr login already used")
+	case xerror.Is(err, service.ErrUserBanned):
+		return convertLoginResultError(err)
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
+
+// CreateWechatScanLogin a pending login of the browser, rendered as the qr code of the official account
+func (l *LoginApplication) CreateWechatScanLogin(ctx context.Context, request dto.WechatScanLoginRequest) (*dto.WechatScanLoginResponse, *facade.Error) {
 	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
 	if err != nil {
 		if xerror.Is(err, service.ErrApplicationNotFound) {
@@ -593,34 +869,236 @@ func (l *LoginApplication) GoogleWebLogin(ctx context.Context, request dto.Googl
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	var referralChannel entity.UserRefferalChannel
+	login, qrCode, pollToken, err := l.wechatScanLoginService.Create(
+		ctx,
+		application.Application.ID,
+		request.Device.DeviceType,
+		request.Device.DeviceID)
+	if err != nil {
+		if xerror.Is(err, wechat.ErrNotConfigured) {
+			return nil, facade.ErrForbidden.Facade("wechat official account is not configured")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return &dto.WechatScanLoginResponse{
+		LoginID:    login.ID.String(),
+		QRURL:      qrCode.URL,
+		QRImageURL: qrCode.ImageURL,
+		PollToken:  pollToken,
+		ExpiresAt:  login.ExpiresAt.Unix(),
+	}, nil
+}
 
-	if request.ReferralChannel != nil {
-		referralChannel = entity.UserRefferalChannel{
-			Type: request.ReferralChannel.Type,
-			ID:   request.ReferralChannel.ID,
-			Name: request.ReferralChannel.Name,
+// PollWechatScanLogin pending until the qr code is scanned, then the login result of the wechat user
+// for the browser that created it
+func (l *LoginApplication) PollWechatScanLogin(ctx context.Context, request dto.WechatScanLoginPollRequest) (*dto.WechatScanLoginPollResponse, *facade.Error) {
+	loginID, err := uuid.Parse(request.LoginID)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid login id")
+	}
+
+	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
 		}
+		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	// login and get user aggregate
-	user, err := l.loginService.GoogleWebLogin(
+	login, err := l.wechatScanLoginService.Poll(
 		ctx,
-		application,
-		referralChannel,
-		request.Code,
-		request.RedirectURI)
+		application.Application.ID,
+		loginID,
+		request.PollToken,
+		request.Device.DeviceType,
+		request.Device.DeviceID)
+	if err != nil {
+		if xerror.Is(err, service.ErrWechatScanLoginNotFound) {
+			return nil, facade.ErrForbidden.Facade("wechat scan login not found or expired")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if !login.Scanned() {
+		return &dto.WechatScanLoginPollResponse{
+			Status: dto.WechatScanLoginStatusPending,
+		}, nil
+	}
+
+	// find or create user, the signed scan event proved the wechat user
+	user, err := l.loginService.WechatOfficialAccountLogin(ctx, application, login.OpenID, login.UnionID)
 	if err != nil {
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
+	result, ferr := l.completeLogin(ctx, user, request.Device, uuid.Nil, "wechat_scan", map[string]interface{}{
+		"platform": "web",
+	})
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	return &dto.WechatScanLoginPollResponse{
+		Status: dto.WechatScanLoginStatusConfirmed,
+		Login:  result,
+	}, nil
+}
+
+// VerifyWechatCallback answers the url verification of the server config of the official account
+func (l *LoginApplication) VerifyWechatCallback(ctx context.Context, query wechat.CallbackQuery) (string, *facade.Error) {
+	echo, err := l.officialAccount.VerifyURL(query)
+	if err != nil {
+		return "", convertWechatCallbackError(err)
+	}
+
+	return echo, nil
+}
+
+// WechatCallback a message pushed to the official account. Scans of login qr codes complete the
+// login, everything else is acknowledged and dropped. Scans of unknown or used codes are acknowledged
+// too so wechat does not retry them.
+func (l *LoginApplication) WechatCallback(ctx context.Context, query wechat.CallbackQuery, body []byte) *facade.Error {
+	message, err := l.officialAccount.ParseMessage(ctx, query, body)
+	if err != nil {
+		return convertWechatCallbackError(err)
+	}
+
+	event, err := l.officialAccount.ScanEvent(ctx, message)
+	if err != nil {
+		return convertWechatCallbackError(err)
+	}
+
+	if event == nil {
+		return nil
+	}
+
+	if err := l.wechatScanLoginService.Scan(ctx, event); err != nil {
+		if xerror.Is(err, service.ErrWechatScanLoginNotFound) || xerror.Is(err, service.ErrWechatScanLoginUsed) {
+			l.logger.Warnf(ctx, "wechat scan of scene %s by %s ignored: %w", event.Scene, event.OpenID, err)
+			return nil
+		}
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	return nil
+}
+
+func convertWechatCallbackError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, wechat.ErrNotConfigured):
+		return facade.ErrForbidden.Facade("wechat official account is not configured")
+	case xerror.Is(err, wechat.ErrInvalidSignature):
+		return facade.ErrUnauthorized.Facade("invalid signature")
+	case xerror.Is(err, wechat.ErrStaleMessage):
+		return facade.ErrUnauthorized.Facade("stale or replayed message")
+	case xerror.Is(err, wechat.ErrInvalidMessage):
+		return facade.ErrBadRequest.Facade("invalid message")
+	case xerror.Is(err, wechat.ErrPlaintextMessage):
+		return facade.ErrForbidden.Facade("scan events must use the safe mode of the official account")
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
+
+func (l *LoginApplication) GoogleWebLogin(ctx context.Context, request dto.GoogleWebLoginRequest) (*dto.LoginResponse, *facade.Error) {
+	return l.providerLogin(
+		ctx,
+		service.LoginProviderGoogle,
+		request.ApplicationName,
+		request.ReferralChannel,
+		request.Device,
+		service.LoginCredential{
+			Code:        request.Code,
+			RedirectURI: request.RedirectURI,
+		},
+		"web")
+}
+
+func (l *LoginApplication) AppleLogin(ctx context.Context, request dto.AppleLoginRequest) (*dto.LoginResponse, *facade.Error) {
+	// the iOS app signs in natively with an identity token
+	platform := "web"
+	if request.IdentityToken != "" {
+		platform = "app"
+	}
+
+	return l.providerLogin(
+		ctx,
+		service.LoginProviderApple,
+		request.ApplicationName,
+		request.ReferralChannel,
+		request.Device,
+		service.LoginCredential{
+			Code:          request.Code,
+			RedirectURI:   request.RedirectURI,
+			IdentityToken: request.IdentityToken,
+			Nonce:         request.Nonce,
+			Name:          request.Name,
+		},
+		platform)
+}
+
+// providerLogin the login of a login provider: the provider proves the external account, its user is
+// found or created and the device logs in
+func (l *LoginApplication) providerLogin(
+	ctx context.Context,
+	providerName string,
+	applicationName string,
+	referralChannel *dto.ReferralChannel,
+	device *dto.Device,
+	credential service.LoginCredential,
+	platform string) (*dto.LoginResponse, *facade.Error) {
+
+	application, err := l.applicationService.GetApplication(ctx, applicationName)
+	if err != nil {
+		return nil, convertLoginError(err)
+	}
+
+	user, err := l.loginService.Login(ctx, application, convertReferralChannel(referralChannel), providerName, credential)
+	if err != nil {
+		l.logger.Errorf(ctx, "%s login error: %w", providerName, err)
+		return nil, convertLoginError(err)
+	}
+
+	return l.completeLogin(ctx, user, device, uuid.Nil, providerName, map[string]interface{}{
+		"platform": platform,
+	})
+}
+
+// completeLogin the first factor passed, a challenge is handed out when the user requires a second
+// factor, otherwise the device logs in to the organization
+func (l *LoginApplication) completeLogin(
+	ctx context.Context,
+	user *aggregate.UserAggregate,
+	device *dto.Device,
+	organizationID uuid.UUID,
+	loginType string,
+	properties map[string]interface{}) (*dto.LoginResponse, *facade.Error) {
+
+	// require second factor
+	if l.mfaService.IsMFAEnabled(user) {
+		return l.newMFAChallenge(user, device, organizationID, loginType)
+	}
+
+	return l.loginDevice(ctx, user, device, organizationID, loginType, properties)
+}
+
+// loginDevice issues the tokens of the device once every factor passed
+func (l *LoginApplication) loginDevice(
+	ctx context.Context,
+	user *aggregate.UserAggregate,
+	device *dto.Device,
+	organizationID uuid.UUID,
+	loginType string,
+	properties map[string]interface{}) (*dto.LoginResponse, *facade.Error) {
+
 	// get refreshtoken
 	deviceAggregate, err := l.deviceService.UpsertDevice(
 		ctx,
 		user.User.ID,
-		request.Device.DeviceType,
-		request.Device.DeviceID,
-		uuid.Nil)
+		device.DeviceType,
+		device.DeviceID,
+		organizationID)
 	if err != nil {
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
@@ -628,11 +1106,17 @@ func (l *LoginApplication) GoogleWebLogin(ctx context.Context, request dto.Googl
 	// generate login result
 	result, err := generateLoginResult(ctx, user, deviceAggregate.Device, l.rbacService, l.jwthelper)
 	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
+		return nil, convertLoginResultError(err)
 	}
 
-	// record login event
-	if err = l.posthogClient.Enqueue(posthog.Capture{
+	l.captureLogin(ctx, user, loginType, properties)
+
+	return result, nil
+}
+
+// captureLogin records the user and the login event, properties describe the login besides its type
+func (l *LoginApplication) captureLogin(ctx context.Context, user *aggregate.UserAggregate, loginType string, properties map[string]interface{}) {
+	if err := l.posthogClient.Enqueue(posthog.Capture{
 		DistinctId: user.User.ID,
 		Event:      "$set",
 		Properties: map[string]interface{}{
@@ -646,18 +1130,361 @@ func (l *LoginApplication) GoogleWebLogin(ctx context.Context, request dto.Googl
 		l.logger.Errorf(ctx, "posthog event failed: %w", err)
 	}
 
+	set := map[string]interface{}{
+		"type": loginType,
+	}
+	for key, value := range properties {
+		set[key] = value
+	}
+
 	if err := l.posthogClient.Enqueue(posthog.Capture{
 		DistinctId: user.User.ID,
 		Event:      "login",
 		Properties: map[string]interface{}{
-			"$set": map[string]interface{}{
-				"type":     "google",
-				"platform": "web",
-			},
+			"$set": set,
 		},
 	}); err != nil {
 		l.logger.Errorf(ctx, "posthog event failed: %w", err)
 	}
+}
 
-	return result, nil
+func convertReferralChannel(referralChannel *dto.ReferralChannel) entity.UserRefferalChannel {
+	if referralChannel == nil {
+		return entity.UserRefferalChannel{}
+	}
+
+	return entity.UserRefferalChannel{
+		Type: referralChannel.Type,
+		ID:   referralChannel.ID,
+		Name: referralChannel.Name,
+	}
+}
+
+func convertLoginError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrApplicationNotFound):
+		return facade.ErrForbidden.Facade("application not found")
+	case xerror.Is(err, service.ErrLoginProviderNotFound):
+		return facade.ErrBadRequest.Facade("unsupported login type")
+	case xerror.Is(err, service.ErrLoginCredentialInvalid):
+		return facade.ErrForbidden.Facade("invalid verification code")
+	case xerror.Is(err, service.ErrVerificationCodeExhausted):
+		return convertVerificationCodeError(err)
+	case xerror.Is(err, service.ErrInvalidWechatCode), xerror.Is(err, service.ErrWechatInvalidScope):
+		return facade.ErrForbidden.Wrap(err)
+	case xerror.Is(err, apple.ErrInvalidIdentityToken):
+		return facade.ErrUnauthorized.Facade("invalid identity token")
+	case xerror.Is(err, service.ErrDefaultOrgRoleNotFound):
+		return facade.ErrBadRequest.Facade("application has no default organization role")
+	case xerror.Is(err, service.ErrQyWechatCorpNotFound),
+		xerror.Is(err, service.ErrQyWechatCorpDisabled),
+		xerror.Is(err, service.ErrFederationNotConfigured):
+		return convertQyWechatError(err)
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
+
+// MFALogin completes a login that returned a mfa challenge. Failed codes count towards the lockout
+// of the user, a challenge and an accepted code are only good for one login.
+func (l *LoginApplication) MFALogin(ctx context.Context, request dto.MFALoginRequest) (*dto.LoginResponse, *facade.Error) {
+	token, err := l.jwthelper.VerifyRS256JWT(request.MFAToken)
+	if err != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid mfa token")
+	}
+
+	payload := &jwt.MFAChallengePayload{}
+	if err := token.UnmarshalPayload(payload); err != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid mfa token")
+	}
+
+	if payload.Type != jwt.MFACHALLENGE || payload.ID == "" || payload.Expire < time.Now().Unix() {
+		return nil, facade.ErrUnauthorized.Facade("invalid mfa token")
+	}
+
+	organizationID := uuid.Nil
+	if payload.OrganizationID != "" {
+		organizationID, err = uuid.Parse(payload.OrganizationID)
+		if err != nil {
+			return nil, facade.ErrUnauthorized.Facade("invalid mfa token")
+		}
+	}
+
+	user, err := l.userReadRepository.Find(ctx, payload.UserID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if user == nil || user.Application == nil || user.Application.Name != payload.Application {
+		return nil, facade.ErrForbidden.Facade("user not found")
+	}
+
+	// the lockout of the second factor is per user, separate from the password failures of its name
+	subject := mfaLoginSubject(user.User.ID)
+	clientIP := utils.ClientFromContext(ctx).IP
+
+	if err := l.loginProtectionService.Check(ctx, payload.Application, subject, clientIP); err != nil {
+		if xerror.Is(err, service.ErrLoginLocked) {
+			return nil, facade.ErrForbidden.Facade("too many failed mfa codes, try again later")
+		}
+		if xerror.Is(err, service.ErrLoginThrottled) {
+			return nil, facade.ErrForbidden.Facade("mfa code attempted too soon, try again later")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if err := l.mfaService.VerifyTOTP(ctx, user, request.Code); err != nil {
+		if xerror.Is(err, service.ErrMFAInvalidCode) {
+			l.recordLoginFailure(ctx, payload.Application, subject, clientIP)
+			return nil, facade.ErrForbidden.Facade("invalid mfa code")
+		}
+		if xerror.Is(err, service.ErrMFACodeUsed) {
+			return nil, facade.ErrForbidden.Facade("mfa code was already used, wait for the next one")
+		}
+		if xerror.Is(err, service.ErrMFANotEnabled) {
+			return nil, facade.ErrForbidden.Facade("invalid mfa code")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if ferr := consumeOnce(ctx, l.replayStore, "mfa:"+payload.ID, time.Unix(payload.Expire, 0)); ferr != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid mfa token")
+	}
+
+	if err := l.loginProtectionService.RecordSuccess(ctx, payload.Application, subject); err != nil {
+		l.logger.Errorf(ctx, "reset mfa failures failed: %w", err)
+	}
+
+	return l.loginDevice(ctx, user, &dto.Device{
+		DeviceType: payload.DeviceType,
+		DeviceID:   payload.DeviceID,
+	}, organizationID, payload.LoginType, map[string]interface{}{
+		"mfa": "totp",
+	})
+}
+
+// mfaLoginSubject the login protection subject of the second factor of a user, never a valid user name
+func mfaLoginSubject(userID string) string {
+	return "mfa:" + userID
+}
+
+// newMFAChallenge the first factor passed, hand out a challenge token instead of tokens
+func (l *LoginApplication) newMFAChallenge(user *aggregate.UserAggregate, device *dto.Device, organizationID uuid.UUID, loginType string) (*dto.LoginResponse, *facade.Error) {
+	if device == nil {
+		return nil, facade.ErrBadRequest.Facade("device is required")
+	}
+
+	orgID := ""
+	if organizationID != uuid.Nil {
+		orgID = organizationID.String()
+	}
+
+	mp := l.jwthelper.NewMFAChallengePayload(
+		user.User.ID,
+		user.Application.Name,
+		device.DeviceType,
+		device.DeviceID,
+		orgID,
+		loginType)
+
+	token, err := l.jwthelper.GenerateRSA256JWT(mp)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return &dto.LoginResponse{
+		UserID:            user.User.ID,
+		DeviceType:        device.DeviceType,
+		DeviceID:          device.DeviceID,
+		MFARequired:       true,
+		MFAToken:          token.String(),
+		MFATokenExpiresAt: mp.Expire,
+	}, nil
+}
+
+// ListIdentityProviders the enabled OpenID Connect providers of the application, for the login page
+func (l *LoginApplication) ListIdentityProviders(ctx context.Context, applicationName string) (*dto.IdentityProvidersResponse, *facade.Error) {
+	application, err := l.applicationService.GetApplication(ctx, applicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) || xerror.Is(err, service.ErrApplicationInvalidName) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	providers, err := l.federationService.ListProviders(ctx, application.Application.ID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	response := &dto.IdentityProvidersResponse{
+		Providers: make([]*dto.IdentityProviderInfo, 0, len(providers)),
+	}
+
+	for _, provider := range providers {
+		if !provider.Enabled {
+			continue
+		}
+
+		displayName := provider.DisplayName
+		if displayName == "" {
+			displayName = provider.Name
+		}
+
+		response.Providers = append(response.Providers, &dto.IdentityProviderInfo{
+			Name:        provider.Name,
+			DisplayName: displayName,
+		})
+	}
+
+	return response, nil
+}
+
+// OIDCAuthorize starts a login at an OpenID Connect provider, the signed state carries the nonce
+// and redirect_uri so the login needs no server side session. The state verifier stays with the
+// browser, a code and state forwarded to another browser cannot complete the login there.
+func (l *LoginApplication) OIDCAuthorize(ctx context.Context, request dto.OIDCAuthorizeRequest) (*dto.OIDCAuthorizeResponse, *facade.Error) {
+	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	provider, err := l.federationService.GetEnabledProvider(ctx, application.Application.ID, request.Provider)
+	if err != nil {
+		if xerror.Is(err, service.ErrIdentityProviderNotFound) || xerror.Is(err, service.ErrIdentityProviderDisabled) {
+			return nil, facade.ErrForbidden.Facade("identity provider not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	nonce, err := utils.RandomURLSafeToken(32)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	verifier, err := utils.RandomURLSafeToken(32)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	sp := l.jwthelper.NewOIDCStatePayload(application.Application.Name, provider.Name, nonce, request.RedirectURI, utils.Sha256(verifier))
+
+	state, err := l.jwthelper.GenerateRSA256JWT(sp)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	authorizationURL, err := l.federationService.AuthorizationURL(ctx, provider, request.RedirectURI, state.String(), nonce)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return &dto.OIDCAuthorizeResponse{
+		AuthorizationURL: authorizationURL,
+		State:            state.String(),
+		StateVerifier:    verifier,
+	}, nil
+}
+
+// OIDCLogin completes a login started by OIDCAuthorize
+func (l *LoginApplication) OIDCLogin(ctx context.Context, request dto.OIDCLoginRequest) (*dto.LoginResponse, *facade.Error) {
+	token, err := l.jwthelper.VerifyRS256JWT(request.State)
+	if err != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid state")
+	}
+
+	var sp jwt.OIDCStatePayload
+	if err := token.UnmarshalPayload(&sp); err != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid state")
+	}
+
+	if sp.Type != jwt.OIDCSTATE || sp.Expire < time.Now().Unix() || sp.Application != request.ApplicationName {
+		return nil, facade.ErrUnauthorized.Facade("invalid state")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(sp.VerifierHash), []byte(utils.Sha256(request.StateVerifier))) != 1 {
+		return nil, facade.ErrUnauthorized.Facade("invalid state")
+	}
+
+	application, err := l.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	provider, err := l.federationService.GetEnabledProvider(ctx, application.Application.ID, sp.Provider)
+	if err != nil {
+		if xerror.Is(err, service.ErrIdentityProviderNotFound) || xerror.Is(err, service.ErrIdentityProviderDisabled) {
+			return nil, facade.ErrForbidden.Facade("identity provider not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	identity, err := l.federationService.Identity(ctx, provider, request.Code, sp.RedirectURI, sp.Nonce)
+	if err != nil {
+		if xerror.Is(err, oidc.ErrInvalidIDToken) || xerror.Is(err, oidc.ErrSubjectNotFound) {
+			return nil, facade.ErrUnauthorized.Facade("invalid id token")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	// login and get user aggregate
+	user, err := l.loginService.FederatedLogin(ctx, application, convertReferralChannel(request.ReferralChannel), provider, identity)
+	if err != nil {
+		return nil, convertLoginError(err)
+	}
+
+	return l.completeLogin(ctx, user, request.Device, uuid.Nil, "oidc", map[string]interface{}{
+		"provider": provider.Name,
+	})
+}
+
+// SAMLLogin exchanges the ticket the assertion consumer service issued for tokens of the organization
+func (l *LoginApplication) SAMLLogin(ctx context.Context, request dto.SAMLLoginRequest) (*dto.LoginResponse, *facade.Error) {
+	token, err := l.jwthelper.VerifyRS256JWT(request.Ticket)
+	if err != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid ticket")
+	}
+
+	var tp jwt.SAMLTicketPayload
+	if err := token.UnmarshalPayload(&tp); err != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid ticket")
+	}
+
+	if tp.Type != jwt.SAMLTICKET || tp.Expire < time.Now().Unix() || tp.Application != request.ApplicationName {
+		return nil, facade.ErrUnauthorized.Facade("invalid ticket")
+	}
+
+	organizationID, err := uuid.Parse(tp.OrganizationID)
+	if err != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid ticket")
+	}
+
+	// the connection may have been disabled since the assertion
+	if _, err := l.samlService.GetEnabledConnection(ctx, organizationID); err != nil {
+		return nil, convertSAMLError(err)
+	}
+
+	if ferr := consumeOnce(ctx, l.replayStore, tp.ID, time.Unix(tp.Expire, 0)); ferr != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid ticket")
+	}
+
+	user, err := l.userReadRepository.Find(ctx, tp.UserID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if user == nil {
+		return nil, facade.ErrForbidden.Facade("user not found")
+	}
+
+	// the device logs in to the organization of the identity provider
+	return l.completeLogin(ctx, user, request.Device, organizationID, "saml", map[string]interface{}{
+		"organization": organizationID.String(),
+	})
 }
@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"kiwi-user/internal/domain/service"
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type LoginLockApplication struct {
+	loginProtectionService *service.LoginProtectionService
+}
+
+func NewLoginLockApplication(loginProtectionService *service.LoginProtectionService) *LoginLockApplication {
+	return &LoginLockApplication{
+		loginProtectionService: loginProtectionService,
+	}
+}
+
+// ListLoginLocks active lockouts of user names and client ips, all applications when applicationName is empty
+func (l *LoginLockApplication) ListLoginLocks(ctx context.Context, applicationName string) (*dto.LoginLocksResponse, *facade.Error) {
+	locks, err := l.loginProtectionService.ListLocks(ctx, applicationName)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	response := &dto.LoginLocksResponse{
+		Locks: make([]*dto.LoginLock, 0, len(locks)),
+	}
+
+	for _, lock := range locks {
+		response.Locks = append(response.Locks, &dto.LoginLock{
+			ID:            lock.ID.String(),
+			Application:   lock.ApplicationName,
+			Scope:         lock.Scope.String(),
+			Subject:       lock.Subject,
+			Failures:      lock.Failures,
+			LastFailureAt: lock.LastFailureAt.Unix(),
+			LockedUntil:   lock.LockedUntil.Unix(),
+		})
+	}
+
+	return response, nil
+}
+
+func (l *LoginLockApplication) ClearLoginLock(ctx context.Context, id string) *facade.Error {
+	lockID, err := uuid.Parse(id)
+	if err != nil {
+		return facade.ErrBadRequest.Facade("invalid lock id")
+	}
+
+	if err := l.loginProtectionService.ClearLock(ctx, lockID); err != nil {
+		if xerror.Is(err, service.ErrLoginLockNotFound) {
+			return facade.ErrForbidden.Facade("login lock not found")
+		}
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,662 @@
+package application
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/domain/service"
+	"kiwi-user/internal/facade/dto"
+	"kiwi-user/internal/infrastructure/apple"
+	"kiwi-user/internal/infrastructure/jwt"
+	"kiwi-user/internal/infrastructure/utils"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+	"github.com/posthog/posthog-go"
+)
+
+const (
+	oauthScopeOpenID  = "openid"
+	oauthScopeProfile = "profile"
+	oauthScopeEmail   = "email"
+	oauthScopePhone   = "phone"
+
+	oauthGrantTypeAuthorizationCode = "authorization_code"
+	oauthGrantTypeRefreshToken      = "refresh_token"
+)
+
+type OAuthApplication struct {
+	oauthService       *service.OAuthService
+	applicationService *service.ApplicationService
+	deviceService      *service.DeviceService
+	rbacService        *service.RBACService
+
+	userReadRepository contract.IUserReadRepository
+
+	config        *config.Config
+	logger        logger.ILogger
+	jwthelper     *jwt.JWTHelper
+	rsa           *jwt.RSA
+	posthogClient posthog.Client
+}
+
+func NewOAuthApplication(
+	config *config.Config,
+	logger logger.ILogger,
+	oauthService *service.OAuthService,
+	applicationService *service.ApplicationService,
+	deviceService *service.DeviceService,
+	rbacService *service.RBACService,
+	userReadRepository contract.IUserReadRepository,
+	jwthelper *jwt.JWTHelper,
+	rsa *jwt.RSA,
+	posthogClient posthog.Client,
+) *OAuthApplication {
+	return &OAuthApplication{
+		config:             config,
+		logger:             logger,
+		oauthService:       oauthService,
+		applicationService: applicationService,
+		deviceService:      deviceService,
+		rbacService:        rbacService,
+		userReadRepository: userReadRepository,
+		jwthelper:          jwthelper,
+		rsa:                rsa,
+		posthogClient:      posthogClient,
+	}
+}
+
+func (o *OAuthApplication) GetOpenIDConfiguration(ctx context.Context) *dto.OpenIDConfiguration {
+	issuer := strings.TrimSuffix(o.config.OAuth.Issuer, "/")
+
+	return &dto.OpenIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/oauth/authorize",
+		TokenEndpoint:                     issuer + "/oauth/token",
+		UserInfoEndpoint:                  issuer + "/oauth/userinfo",
+		JWKSURI:                           issuer + "/.well-known/jwks.json",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{oauthGrantTypeAuthorizationCode, oauthGrantTypeRefreshToken},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{jwt.RS256ALGRAS},
+		ScopesSupported:                   []string{oauthScopeOpenID, oauthScopeProfile, oauthScopeEmail, oauthScopePhone},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{utils.PKCEMethodS256, utils.PKCEMethodPlain},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "nonce",
+			"name", "preferred_username", "picture", "email", "email_verified", "phone_number",
+		},
+	}
+}
+
+func (o *OAuthApplication) GetJWKS(ctx context.Context) (*dto.JWKSResponse, *facade.Error) {
+	jwks, err := o.rsa.GetPublicJWKs()
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	response := &dto.JWKSResponse{
+		Keys: make([]*dto.JWK, 0, len(jwks)),
+	}
+
+	for _, jwk := range jwks {
+		response.Keys = append(response.Keys, &dto.JWK{
+			Kty: jwk.Kty,
+			Use: jwk.Use,
+			Alg: jwk.Alg,
+			Kid: jwk.Kid,
+			N:   jwk.N,
+			E:   jwk.E,
+		})
+	}
+
+	return response, nil
+}
+
+// Authorize returns the location the user agent should be redirected to.
+// When the user has not signed in to the client application yet, it is the configured login page,
+// which runs one of the /v1/login flows and then sends the user back to the original authorize url.
+// When the user has not allowed the client the requested scopes yet, the consent page is shown instead.
+func (o *OAuthApplication) Authorize(
+	ctx context.Context,
+	request dto.OAuthAuthorizeRequest,
+	userID string,
+	requestURI string) (*dto.OAuthAuthorizeResult, *facade.Error) {
+
+	application, ferr := o.getOAuthClient(ctx, request.ClientID, request.RedirectURI)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	if request.ResponseType != "code" {
+		return &dto.OAuthAuthorizeResult{Location: buildOAuthRedirect(request.RedirectURI, map[string]string{
+			"error": "unsupported_response_type",
+			"state": request.State,
+		})}, nil
+	}
+
+	codeChallengeMethod, err := o.oauthService.ValidateCodeChallenge(application.Application, request.CodeChallenge, request.CodeChallengeMethod)
+	if err != nil {
+		return &dto.OAuthAuthorizeResult{Location: buildOAuthRedirect(request.RedirectURI, map[string]string{
+			"error":             "invalid_request",
+			"error_description": "code_challenge is required, plain is only allowed for confidential clients",
+			"state":             request.State,
+		})}, nil
+	}
+
+	var userAggregate *aggregate.UserAggregate
+	if userID != "" {
+		userAggregate, err = o.userReadRepository.Find(ctx, userID)
+		if err != nil {
+			return nil, facade.ErrServerInternal.Wrap(err)
+		}
+	}
+
+	// users belong to a single application, a session of another application does not count
+	if userAggregate == nil || userAggregate.Application.ID != application.Application.ID {
+		if request.Prompt == "none" || o.config.OAuth.LoginURL == "" {
+			return &dto.OAuthAuthorizeResult{Location: buildOAuthRedirect(request.RedirectURI, map[string]string{
+				"error": "login_required",
+				"state": request.State,
+			})}, nil
+		}
+
+		return &dto.OAuthAuthorizeResult{Location: buildOAuthRedirect(o.config.OAuth.LoginURL, map[string]string{
+			"application_name": application.Application.Name,
+			"return_to":        strings.TrimSuffix(o.config.OAuth.Issuer, "/") + requestURI,
+		})}, nil
+	}
+
+	consented, err := o.oauthService.HasConsent(ctx, application.Application, userAggregate.User.ID, request.Scope)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if !consented || request.Prompt == "consent" {
+		if request.Prompt == "none" {
+			return &dto.OAuthAuthorizeResult{Location: buildOAuthRedirect(request.RedirectURI, map[string]string{
+				"error": "consent_required",
+				"state": request.State,
+			})}, nil
+		}
+
+		consentToken, err := o.jwthelper.GenerateRSA256JWT(o.jwthelper.NewOAuthConsentPayload(
+			userAggregate.User.ID,
+			application.Application.Name,
+			request.RedirectURI,
+			request.Scope,
+			request.State,
+			request.Nonce,
+			request.CodeChallenge,
+			codeChallengeMethod))
+		if err != nil {
+			return nil, facade.ErrServerInternal.Wrap(err)
+		}
+
+		return &dto.OAuthAuthorizeResult{Consent: &dto.OAuthConsent{
+			ClientID:     application.Application.Name,
+			Scopes:       strings.Fields(request.Scope),
+			ConsentToken: consentToken.String(),
+		}}, nil
+	}
+
+	location, ferr := o.issueAuthorizationCode(
+		ctx,
+		application,
+		userAggregate.User.ID,
+		request.RedirectURI,
+		request.Scope,
+		request.State,
+		request.Nonce,
+		request.CodeChallenge,
+		codeChallengeMethod)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	return &dto.OAuthAuthorizeResult{Location: location}, nil
+}
+
+// Consent answers the consent page. Only the user the page was shown to can answer it, the code is
+// issued to the redirect uri of the original request.
+func (o *OAuthApplication) Consent(ctx context.Context, request dto.OAuthConsentRequest, userID string) (string, *facade.Error) {
+	jwtToken, err := o.jwthelper.VerifyRS256JWT(request.ConsentToken)
+	if err != nil {
+		return "", facade.ErrBadRequest.Facade("invalid consent")
+	}
+
+	var cp jwt.OAuthConsentPayload
+	if err := jwtToken.UnmarshalPayload(&cp); err != nil || cp.Type != jwt.OAUTHCONSENT || cp.Expire < time.Now().Unix() {
+		return "", facade.ErrBadRequest.Facade("invalid consent")
+	}
+
+	if userID == "" || userID != cp.UserID {
+		return "", facade.ErrForbidden.Facade("consent was asked of another user")
+	}
+
+	application, ferr := o.getOAuthClient(ctx, cp.ClientID, cp.RedirectURI)
+	if ferr != nil {
+		return "", ferr
+	}
+
+	if request.Decision != "allow" {
+		return buildOAuthRedirect(cp.RedirectURI, map[string]string{
+			"error": "access_denied",
+			"state": cp.State,
+		}), nil
+	}
+
+	if err := o.oauthService.GrantConsent(ctx, application.Application, cp.UserID, cp.Scope); err != nil {
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+
+	return o.issueAuthorizationCode(
+		ctx,
+		application,
+		cp.UserID,
+		cp.RedirectURI,
+		cp.Scope,
+		cp.State,
+		cp.Nonce,
+		cp.CodeChallenge,
+		cp.CodeChallengeMethod)
+}
+
+// getOAuthClient the client and its registered redirect uri, errors are shown to the user agent instead of redirected
+func (o *OAuthApplication) getOAuthClient(ctx context.Context, clientID string, redirectURI string) (*aggregate.ApplicationAggregate, *facade.Error) {
+	application, err := o.applicationService.GetApplication(ctx, clientID)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrBadRequest.Facade("invalid client_id")
+		}
+
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	// never redirect to an unregistered uri
+	if err := o.oauthService.ValidateRedirectURI(application.Application, redirectURI); err != nil {
+		if xerror.Is(err, service.ErrOAuthClientNotConfigured) {
+			return nil, facade.ErrBadRequest.Facade("client is not configured for oauth")
+		}
+
+		return nil, facade.ErrBadRequest.Facade("invalid redirect_uri")
+	}
+
+	return application, nil
+}
+
+func (o *OAuthApplication) issueAuthorizationCode(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	userID string,
+	redirectURI string,
+	scope string,
+	state string,
+	nonce string,
+	codeChallenge string,
+	codeChallengeMethod string) (string, *facade.Error) {
+
+	code, err := o.oauthService.CreateAuthorizationCode(
+		ctx,
+		application.Application,
+		userID,
+		redirectURI,
+		scope,
+		nonce,
+		codeChallenge,
+		codeChallengeMethod)
+	if err != nil {
+		if xerror.Is(err, service.ErrOAuthInvalidCodeChallenge) {
+			return buildOAuthRedirect(redirectURI, map[string]string{
+				"error":             "invalid_request",
+				"error_description": "unsupported code_challenge_method",
+				"state":             state,
+			}), nil
+		}
+
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+
+	return buildOAuthRedirect(redirectURI, map[string]string{
+		"code":  code,
+		"state": state,
+	}), nil
+}
+
+func (o *OAuthApplication) Token(ctx context.Context, request dto.OAuthTokenRequest) (*dto.OAuthTokenResponse, *dto.OAuthErrorResponse) {
+	application, oerr := o.authenticateClient(ctx, request.ClientID, request.ClientSecret)
+	if oerr != nil {
+		return nil, oerr
+	}
+
+	switch request.GrantType {
+	case oauthGrantTypeAuthorizationCode:
+		return o.exchangeAuthorizationCode(ctx, application, request)
+	case oauthGrantTypeRefreshToken:
+		return o.refreshToken(ctx, application, request)
+	default:
+		return nil, newOAuthError(http.StatusBadRequest, "unsupported_grant_type", "")
+	}
+}
+
+// UserInfo the claims about the user covered by the scope granted to the client the token was issued to
+func (o *OAuthApplication) UserInfo(ctx context.Context, userID string, scope string) (*dto.OAuthUserInfoResponse, *facade.Error) {
+	userAggregate, err := o.userReadRepository.Find(ctx, userID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if userAggregate == nil {
+		return nil, facade.ErrUnauthorized
+	}
+
+	scopes := strings.Fields(scope)
+	if !slices.Contains(scopes, oauthScopeOpenID) {
+		return nil, facade.ErrForbidden.Facade("openid scope was not granted")
+	}
+
+	claims := buildOIDCUserClaims(userAggregate, scopes)
+
+	return &dto.OAuthUserInfoResponse{
+		Subject:           userAggregate.User.ID,
+		Name:              claims.Name,
+		PreferredUsername: claims.PreferredUsername,
+		Picture:           claims.Picture,
+		Email:             claims.Email,
+		EmailVerified:     claims.EmailVerified,
+		PhoneNumber:       claims.PhoneNumber,
+	}, nil
+}
+
+func (o *OAuthApplication) UpdateOAuthClient(ctx context.Context, request *dto.UpdateOAuthClientRequest) (*dto.UpdateOAuthClientResponse, *facade.Error) {
+	application, err := o.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	applicationEntity, clientSecret, err := o.oauthService.UpdateClient(ctx, application, request.RedirectURIs, request.RegenerateSecret)
+	if err != nil {
+		if xerror.Is(err, service.ErrOAuthInvalidRedirectURI) {
+			return nil, facade.ErrBadRequest.Facade("invalid redirect_uri")
+		}
+
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return &dto.UpdateOAuthClientResponse{
+		ClientID:     applicationEntity.Name,
+		ClientSecret: clientSecret,
+		RedirectURIs: applicationEntity.RedirectURIs,
+	}, nil
+}
+
+func (o *OAuthApplication) authenticateClient(ctx context.Context, clientID string, clientSecret string) (*aggregate.ApplicationAggregate, *dto.OAuthErrorResponse) {
+	if clientID == "" {
+		return nil, newOAuthError(http.StatusUnauthorized, "invalid_client", "client_id is required")
+	}
+
+	application, err := o.applicationService.GetApplication(ctx, clientID)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, newOAuthError(http.StatusUnauthorized, "invalid_client", "")
+		}
+
+		o.logger.Errorf(ctx, "get oauth client failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	if err := o.oauthService.AuthenticateClient(application.Application, clientSecret); err != nil {
+		return nil, newOAuthError(http.StatusUnauthorized, "invalid_client", "")
+	}
+
+	return application, nil
+}
+
+func (o *OAuthApplication) exchangeAuthorizationCode(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	request dto.OAuthTokenRequest) (*dto.OAuthTokenResponse, *dto.OAuthErrorResponse) {
+
+	if request.Code == "" || request.RedirectURI == "" {
+		return nil, newOAuthError(http.StatusBadRequest, "invalid_request", "code and redirect_uri are required")
+	}
+
+	authorizationCode, err := o.oauthService.ExchangeAuthorizationCode(
+		ctx,
+		application.Application,
+		request.Code,
+		request.RedirectURI,
+		request.CodeVerifier)
+	if err != nil {
+		if xerror.Is(err, service.ErrOAuthInvalidGrant) {
+			return nil, newOAuthError(http.StatusBadRequest, "invalid_grant", "")
+		}
+
+		o.logger.Errorf(ctx, "exchange authorization code failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	userAggregate, err := o.userReadRepository.Find(ctx, authorizationCode.UserID)
+	if err != nil {
+		o.logger.Errorf(ctx, "find user failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	if userAggregate == nil {
+		return nil, newOAuthError(http.StatusBadRequest, "invalid_grant", "")
+	}
+
+	// every grant is a separate session with its own refresh token
+	deviceAggregate, err := o.deviceService.UpsertDevice(
+		ctx,
+		userAggregate.User.ID,
+		service.OAuthDeviceType,
+		uuid.NewString(),
+		uuid.Nil)
+	if err != nil {
+		o.logger.Errorf(ctx, "upsert device failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	// tokens of the session carry the granted scope, userinfo releases the claims it covers only
+	deviceAggregate.Device.OAuthScope = authorizationCode.Scope
+	deviceAggregate, err = o.deviceService.UpdateDevice(ctx, deviceAggregate)
+	if err != nil {
+		o.logger.Errorf(ctx, "update device failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	result, err := generateLoginResult(ctx, userAggregate, deviceAggregate.Device, o.rbacService, o.jwthelper)
+	if err != nil {
+		if xerror.Is(err, service.ErrUserBanned) {
+			return nil, newOAuthError(http.StatusBadRequest, "invalid_grant", "user is banned")
+		}
+		o.logger.Errorf(ctx, "generate login result failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	response := &dto.OAuthTokenResponse{
+		AccessToken:  result.AccessToken,
+		TokenType:    result.Type,
+		ExpiresIn:    result.AccessTokenExpiresAt - time.Now().Unix(),
+		RefreshToken: result.RefreshToken,
+		Scope:        authorizationCode.Scope,
+	}
+
+	scopes := strings.Fields(authorizationCode.Scope)
+	if slices.Contains(scopes, oauthScopeOpenID) {
+		idToken, err := o.jwthelper.GenerateRSA256JWT(o.jwthelper.NewIDTokenPayload(
+			strings.TrimSuffix(o.config.OAuth.Issuer, "/"),
+			userAggregate.User.ID,
+			application.Application.Name,
+			authorizationCode.Nonce,
+			0,
+			buildOIDCUserClaims(userAggregate, scopes)))
+		if err != nil {
+			o.logger.Errorf(ctx, "generate id token failed: %w", err)
+			return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+		}
+
+		response.IDToken = idToken.String()
+	}
+
+	if err := o.posthogClient.Enqueue(posthog.Capture{
+		DistinctId: userAggregate.User.ID,
+		Event:      "login",
+		Properties: map[string]interface{}{
+			"$set": map[string]interface{}{
+				"type":        "oauth",
+				"application": application.Application.Name,
+			},
+		},
+	}); err != nil {
+		o.logger.Errorf(ctx, "posthog event failed: %w", err)
+	}
+
+	return response, nil
+}
+
+func (o *OAuthApplication) refreshToken(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	request dto.OAuthTokenRequest) (*dto.OAuthTokenResponse, *dto.OAuthErrorResponse) {
+
+	if request.RefreshToken == "" {
+		return nil, newOAuthError(http.StatusBadRequest, "invalid_request", "refresh_token is required")
+	}
+
+	deviceAggregate, err := o.deviceService.FindByRefreshToken(ctx, request.RefreshToken)
+	if err != nil {
+		o.logger.Errorf(ctx, "find device failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	if deviceAggregate == nil ||
+		deviceAggregate.Device.DeviceType != service.OAuthDeviceType {
+		return nil, newOAuthError(http.StatusBadRequest, "invalid_grant", "")
+	}
+
+	userAggregate, err := o.userReadRepository.Find(ctx, deviceAggregate.User.ID)
+	if err != nil {
+		o.logger.Errorf(ctx, "find user failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	if userAggregate == nil || userAggregate.Application.ID != application.Application.ID {
+		return nil, newOAuthError(http.StatusBadRequest, "invalid_grant", "")
+	}
+
+	// rotate refresh token, a replayed one revokes the whole token family
+	rotatedDevice, err := o.deviceService.RotateRefreshToken(ctx, deviceAggregate, request.RefreshToken)
+	if err != nil {
+		if xerror.Is(err, service.ErrRefreshTokenReused) {
+			reportRefreshTokenReuse(ctx, o.logger, o.posthogClient, userAggregate, service.OAuthDeviceType, deviceAggregate.Device.DeviceID)
+			return nil, newOAuthError(http.StatusBadRequest, "invalid_grant", "")
+		}
+		if xerror.Is(err, service.ErrRefreshTokenInvalid) {
+			return nil, newOAuthError(http.StatusBadRequest, "invalid_grant", "")
+		}
+		o.logger.Errorf(ctx, "rotate refresh token failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	result, err := generateLoginResult(ctx, userAggregate, rotatedDevice.Device, o.rbacService, o.jwthelper)
+	if err != nil {
+		if xerror.Is(err, service.ErrUserBanned) {
+			return nil, newOAuthError(http.StatusBadRequest, "invalid_grant", "user is banned")
+		}
+		o.logger.Errorf(ctx, "generate login result failed: %w", err)
+		return nil, newOAuthError(http.StatusInternalServerError, "server_error", "")
+	}
+
+	return &dto.OAuthTokenResponse{
+		AccessToken:  result.AccessToken,
+		TokenType:    result.Type,
+		ExpiresIn:    result.AccessTokenExpiresAt - time.Now().Unix(),
+		RefreshToken: result.RefreshToken,
+		Scope:        rotatedDevice.Device.OAuthScope,
+	}, nil
+}
+
+// buildOIDCUserClaims only releases the claims covered by the granted scopes
+func buildOIDCUserClaims(userAggregate *aggregate.UserAggregate, scopes []string) jwt.UserClaims {
+	claims := jwt.UserClaims{}
+
+	if slices.Contains(scopes, oauthScopeProfile) {
+		claims.Name = userAggregate.User.Name
+		if userAggregate.User.DisplayName != "" {
+			claims.Name = userAggregate.User.DisplayName
+		}
+		claims.PreferredUsername = userAggregate.User.Name
+		claims.Picture = userAggregate.User.Avatar
+	}
+
+	for _, binding := range userAggregate.Bindings {
+		switch binding.Type {
+		case enum.BindingTypeEmail:
+			if slices.Contains(scopes, oauthScopeEmail) {
+				claims.Email = binding.Identity
+				claims.EmailVerified = true
+			}
+		case enum.BindingTypeGoogle:
+			if slices.Contains(scopes, oauthScopeEmail) && claims.Email == "" {
+				claims.Email = binding.Email
+				claims.EmailVerified = binding.Email != ""
+			}
+		case enum.BindingTypeApple:
+			if slices.Contains(scopes, oauthScopeEmail) && claims.Email == "" && !apple.IsPrivateRelayEmail(binding.Email) {
+				claims.Email = binding.Email
+				claims.EmailVerified = binding.Email != ""
+			}
+		case enum.BindingTypeOIDC:
+			if slices.Contains(scopes, oauthScopeEmail) && claims.Email == "" {
+				claims.Email = binding.Email
+				claims.EmailVerified = binding.Email != ""
+			}
+		case enum.BindingTypePhone:
+			if slices.Contains(scopes, oauthScopePhone) {
+				claims.PhoneNumber = binding.Identity
+			}
+		}
+	}
+
+	return claims
+}
+
+func buildOAuthRedirect(base string, params map[string]string) string {
+	u, err := url.Parse(base)
+	if err != nil {
+		return base
+	}
+
+	query := u.Query()
+	for key, value := range params {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+func newOAuthError(status int, code string, description string) *dto.OAuthErrorResponse {
+	return &dto.OAuthErrorResponse{
+		Status:           status,
+		Error:            code,
+		ErrorDescription: description,
+	}
+}
@@ -0,0 +1,347 @@
+package application
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/service"
+	"kiwi-user/internal/facade/dto"
+	"kiwi-user/internal/infrastructure/jwt"
+	"kiwi-user/internal/infrastructure/webauthn"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+	"github.com/posthog/posthog-go"
+)
+
+const (
+	passkeyCredentialType = "public-key"
+	passkeyAttestation    = "none"
+)
+
+type PasskeyApplication struct {
+	passkeyService     *service.PasskeyService
+	applicationService *service.ApplicationService
+	deviceService      *service.DeviceService
+	rbacService        *service.RBACService
+	bindingService     *service.BindingService
+
+	userReadRepository contract.IUserReadRepository
+
+	config        *config.Config
+	logger        logger.ILogger
+	jwthelper     *jwt.JWTHelper
+	posthogClient posthog.Client
+}
+
+func NewPasskeyApplication(
+	config *config.Config,
+	logger logger.ILogger,
+	passkeyService *service.PasskeyService,
+	applicationService *service.ApplicationService,
+	deviceService *service.DeviceService,
+	rbacService *service.RBACService,
+	bindingService *service.BindingService,
+	userReadRepository contract.IUserReadRepository,
+	jwthelper *jwt.JWTHelper,
+	posthogClient posthog.Client,
+) *PasskeyApplication {
+	return &PasskeyApplication{
+		config:             config,
+		logger:             logger,
+		passkeyService:     passkeyService,
+		applicationService: applicationService,
+		deviceService:      deviceService,
+		rbacService:        rbacService,
+		bindingService:     bindingService,
+		userReadRepository: userReadRepository,
+		jwthelper:          jwthelper,
+		posthogClient:      posthogClient,
+	}
+}
+
+func (p *PasskeyApplication) BeginRegistration(ctx context.Context, userID string) (*dto.PasskeyCreationOptions, *facade.Error) {
+	user, err := p.userReadRepository.Find(ctx, userID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if user == nil {
+		return nil, facade.ErrForbidden.Facade("user not found")
+	}
+
+	challenge, credentials, err := p.passkeyService.BeginRegistration(ctx, user)
+	if err != nil {
+		return nil, convertPasskeyError(err)
+	}
+
+	displayName := user.User.DisplayName
+	if displayName == "" {
+		displayName = user.User.Name
+	}
+
+	rp := p.passkeyService.RelyingParty()
+	options := &dto.PasskeyCreationOptions{
+		RP: dto.PasskeyRelyingParty{
+			ID:   rp.ID,
+			Name: rp.Name,
+		},
+		User: dto.PasskeyUser{
+			ID:          webauthn.EncodeBase64URL([]byte(user.User.ID)),
+			Name:        user.User.Name,
+			DisplayName: displayName,
+		},
+		Challenge:          challenge.Challenge,
+		Timeout:            p.config.WebAuthn.ChallengeExpireSecond * 1000,
+		ExcludeCredentials: convertPasskeysToDescriptors(credentials),
+		AuthenticatorSelection: dto.PasskeyAuthenticatorSelection{
+			ResidentKey:        "required",
+			RequireResidentKey: true,
+			UserVerification:   p.userVerification(),
+		},
+		Attestation: passkeyAttestation,
+	}
+
+	for _, alg := range webauthn.SupportedAlgorithms() {
+		options.PubKeyCredParams = append(options.PubKeyCredParams, dto.PasskeyCredentialParameter{
+			Type: passkeyCredentialType,
+			Alg:  alg,
+		})
+	}
+
+	return options, nil
+}
+
+func (p *PasskeyApplication) FinishRegistration(ctx context.Context, userID string, request dto.PasskeyRegisterFinishRequest) (*dto.PasskeyInfo, *facade.Error) {
+	if request.Credential.Type != passkeyCredentialType {
+		return nil, facade.ErrBadRequest.Facade("invalid credential type")
+	}
+
+	clientDataJSON, err := webauthn.DecodeBase64URL(request.Credential.Response.ClientDataJSON)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid clientDataJSON")
+	}
+
+	attestationObject, err := webauthn.DecodeBase64URL(request.Credential.Response.AttestationObject)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid attestationObject")
+	}
+
+	user, err := p.userReadRepository.Find(ctx, userID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if user == nil {
+		return nil, facade.ErrForbidden.Facade("user not found")
+	}
+
+	credential, err := p.passkeyService.FinishRegistration(
+		ctx,
+		user,
+		clientDataJSON,
+		attestationObject,
+		request.Credential.Response.Transports,
+		request.Name)
+	if err != nil {
+		return nil, convertPasskeyError(err)
+	}
+
+	return convertPasskeyToDTO(credential), nil
+}
+
+func (p *PasskeyApplication) ListPasskeys(ctx context.Context, userID string) ([]*dto.PasskeyInfo, *facade.Error) {
+	credentials, err := p.passkeyService.ListCredentials(ctx, userID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	result := make([]*dto.PasskeyInfo, 0, len(credentials))
+	for _, credential := range credentials {
+		result = append(result, convertPasskeyToDTO(credential))
+	}
+
+	return result, nil
+}
+
+func (p *PasskeyApplication) DeletePasskey(ctx context.Context, userID string, id string) (*dto.OperationResponse, *facade.Error) {
+	credentialID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid passkey id")
+	}
+
+	user, err := p.userReadRepository.Find(ctx, userID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if user == nil {
+		return nil, facade.ErrForbidden.Facade("user not found")
+	}
+
+	loginMethods, err := p.bindingService.CountLoginMethods(ctx, user)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if loginMethods <= 1 {
+		return nil, facade.ErrForbidden.Facade("can not remove the last login method")
+	}
+
+	if err := p.passkeyService.DeleteCredential(ctx, userID, credentialID); err != nil {
+		return nil, convertPasskeyError(err)
+	}
+
+	return &dto.OperationResponse{Success: true}, nil
+}
+
+func (p *PasskeyApplication) BeginLogin(ctx context.Context, request dto.PasskeyLoginBeginRequest) (*dto.PasskeyRequestOptions, *facade.Error) {
+	application, err := p.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	challenge, err := p.passkeyService.BeginLogin(ctx, application.Application)
+	if err != nil {
+		return nil, convertPasskeyError(err)
+	}
+
+	// empty allowCredentials, the authenticator offers its discoverable credentials
+	return &dto.PasskeyRequestOptions{
+		Challenge:        challenge.Challenge,
+		Timeout:          p.config.WebAuthn.ChallengeExpireSecond * 1000,
+		RPID:             p.passkeyService.RelyingParty().ID,
+		AllowCredentials: []dto.PasskeyCredentialDescriptor{},
+		UserVerification: p.userVerification(),
+	}, nil
+}
+
+// FinishLogin a passkey with user verification is already two factors, no mfa challenge is issued
+func (p *PasskeyApplication) FinishLogin(ctx context.Context, request dto.PasskeyLoginFinishRequest) (*dto.LoginResponse, *facade.Error) {
+	if request.Credential.Type != passkeyCredentialType {
+		return nil, facade.ErrBadRequest.Facade("invalid credential type")
+	}
+
+	clientDataJSON, err := webauthn.DecodeBase64URL(request.Credential.Response.ClientDataJSON)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid clientDataJSON")
+	}
+
+	authenticatorData, err := webauthn.DecodeBase64URL(request.Credential.Response.AuthenticatorData)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid authenticatorData")
+	}
+
+	signature, err := webauthn.DecodeBase64URL(request.Credential.Response.Signature)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid signature")
+	}
+
+	application, err := p.applicationService.GetApplication(ctx, request.ApplicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	user, err := p.passkeyService.FinishLogin(
+		ctx,
+		application.Application,
+		request.Credential.ID,
+		clientDataJSON,
+		authenticatorData,
+		signature)
+	if err != nil {
+		return nil, convertPasskeyError(err)
+	}
+
+	// get refreshtoken
+	deviceAggregate, err := p.deviceService.UpsertDevice(
+		ctx,
+		user.User.ID,
+		request.Device.DeviceType,
+		request.Device.DeviceID,
+		uuid.Nil)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	// generate login result
+	result, err := generateLoginResult(ctx, user, deviceAggregate.Device, p.rbacService, p.jwthelper)
+	if err != nil {
+		return nil, convertLoginResultError(err)
+	}
+
+	if err := p.posthogClient.Enqueue(posthog.Capture{
+		DistinctId: user.User.ID,
+		Event:      "login",
+		Properties: map[string]interface{}{
+			"$set": map[string]interface{}{
+				"type":     "passkey",
+				"platform": "web",
+			},
+		},
+	}); err != nil {
+		p.logger.Errorf(ctx, "posthog event failed: %w", err)
+	}
+
+	return result, nil
+}
+
+func (p *PasskeyApplication) userVerification() string {
+	if p.config.WebAuthn.RequireUserVerification {
+		return "required"
+	}
+	return "preferred"
+}
+
+func convertPasskeyError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrPasskeyNotConfigured):
+		return facade.ErrForbidden.Facade("passkey not configured")
+	case xerror.Is(err, service.ErrPasskeyInvalidChallenge):
+		return facade.ErrForbidden.Facade("passkey challenge invalid or expired")
+	case xerror.Is(err, service.ErrPasskeyVerificationFailed):
+		return facade.ErrForbidden.Facade("passkey verification failed")
+	case xerror.Is(err, service.ErrPasskeyCredentialNotFound):
+		return facade.ErrForbidden.Facade("passkey not found")
+	case xerror.Is(err, service.ErrPasskeyCredentialAlreadyExists):
+		return facade.ErrForbidden.Facade("passkey already registered")
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
+
+func convertPasskeysToDescriptors(credentials []*entity.PasskeyCredentialEntity) []dto.PasskeyCredentialDescriptor {
+	descriptors := make([]dto.PasskeyCredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		descriptors = append(descriptors, dto.PasskeyCredentialDescriptor{
+			Type:       passkeyCredentialType,
+			ID:         credential.CredentialID,
+			Transports: credential.Transports,
+		})
+	}
+	return descriptors
+}
+
+func convertPasskeyToDTO(credential *entity.PasskeyCredentialEntity) *dto.PasskeyInfo {
+	info := &dto.PasskeyInfo{
+		ID:         credential.ID.String(),
+		Name:       credential.Name,
+		Transports: credential.Transports,
+		CreatedAt:  credential.CreatedAt.Unix(),
+	}
+
+	if !credential.LastUsedAt.IsZero() {
+		info.LastUsedAt = credential.LastUsedAt.Unix()
+	}
+
+	return info
+}
@@ -0,0 +1,240 @@
+package application
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/domain/service"
+	"kiwi-user/internal/facade/dto"
+	"kiwi-user/internal/infrastructure/jwt"
+	"kiwi-user/internal/infrastructure/revocation"
+	"time"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/posthog/posthog-go"
+)
+
+// PasswordApplication self-service password reset:
+//
+//	forgot  -> a code is sent to the verified email or phone binding
+//	verify  -> the code is exchanged for a signed PASSWORDRESET token
+//	reset   -> the token sets the new password and signs the user out everywhere
+type PasswordApplication struct {
+	applicationService       *service.ApplicationService
+	userService              *service.UserService
+	deviceService            *service.DeviceService
+	vertificationCodeService *service.VertificationCodeService
+	rateLimitService         *service.RateLimitService
+	captchaService           *service.CaptchaService
+	smsService               *service.SmsService
+
+	userReadRepository contract.IUserReadRepository
+
+	config          *config.Config
+	logger          logger.ILogger
+	jwthelper       *jwt.JWTHelper
+	revocationStore revocation.Store
+	posthogClient   posthog.Client
+}
+
+func NewPasswordApplication(
+	config *config.Config,
+	logger logger.ILogger,
+	applicationService *service.ApplicationService,
+	userService *service.UserService,
+	deviceService *service.DeviceService,
+	vertificationCodeService *service.VertificationCodeService,
+	rateLimitService *service.RateLimitService,
+	captchaService *service.CaptchaService,
+	smsService *service.SmsService,
+	userReadRepository contract.IUserReadRepository,
+	jwthelper *jwt.JWTHelper,
+	revocationStore revocation.Store,
+	posthogClient posthog.Client,
+) *PasswordApplication {
+	return &PasswordApplication{
+		config:                   config,
+		logger:                   logger,
+		applicationService:       applicationService,
+		userService:              userService,
+		deviceService:            deviceService,
+		vertificationCodeService: vertificationCodeService,
+		rateLimitService:         rateLimitService,
+		captchaService:           captchaService,
+		smsService:               smsService,
+		userReadRepository:       userReadRepository,
+		jwthelper:                jwthelper,
+		revocationStore:          revocationStore,
+		posthogClient:            posthogClient,
+	}
+}
+
+// ForgotPassword always reports success so the endpoint does not reveal which accounts exist
+func (p *PasswordApplication) ForgotPassword(ctx context.Context, request dto.ForgotPasswordRequest) (*dto.OperationResponse, *facade.Error) {
+	target := request.Email
+	if target == "" {
+		target = request.Phone
+	}
+
+	// checked before the lookup, unknown accounts are treated like known ones
+	if ferr := checkVerifyCodeCaptcha(ctx, p.captchaService, request.ApplicationName, target, request.CaptchaVerifyParam); ferr != nil {
+		return nil, ferr
+	}
+
+	if ferr := checkVerifyCodeRateLimit(ctx, p.rateLimitService, request.ApplicationName, target); ferr != nil {
+		return nil, ferr
+	}
+
+	user, ferr := p.findUser(ctx, request.ApplicationName, request.Email, request.Phone)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	if user == nil {
+		p.logger.Infof(ctx, "password reset requested for unknown account")
+		return &dto.OperationResponse{Success: true}, nil
+	}
+
+	if request.Email != "" {
+		if err := p.vertificationCodeService.SendEmailVerificationCode(ctx, request.Email, enum.VertificationCodeTypePasswordReset); err != nil {
+			return nil, facade.ErrServerInternal.Wrap(err)
+		}
+	} else {
+		if err := p.smsService.SendVerifyCode(ctx, request.ApplicationName, request.Phone, enum.VertificationCodeTypePasswordReset); err != nil {
+			return nil, facade.ErrForbidden.Wrap(err)
+		}
+	}
+
+	return &dto.OperationResponse{Success: true}, nil
+}
+
+// VerifyPasswordResetCode exchanges the code for a reset token
+func (p *PasswordApplication) VerifyPasswordResetCode(ctx context.Context, request dto.VerifyPasswordResetCodeRequest) (*dto.PasswordResetTokenResponse, *facade.Error) {
+	if request.Email != "" {
+		verified, err := p.vertificationCodeService.VerifyEmailCode(ctx, request.Email, request.VerifyCode, enum.VertificationCodeTypePasswordReset)
+		if err != nil {
+			return nil, convertVerificationCodeError(err)
+		}
+
+		if !verified {
+			return nil, facade.ErrForbidden.Facade("invalid verification code")
+		}
+	} else if request.Phone != "" {
+		verified, err := p.smsService.VerifyCode(ctx, request.Phone, request.VerifyCode, enum.VertificationCodeTypePasswordReset)
+		if err != nil {
+			return nil, convertVerificationCodeError(err)
+		}
+
+		if !verified {
+			return nil, facade.ErrForbidden.Facade("invalid verification code")
+		}
+	}
+
+	user, ferr := p.findUser(ctx, request.ApplicationName, request.Email, request.Phone)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	if user == nil {
+		return nil, facade.ErrForbidden.Facade("user not found")
+	}
+
+	payload := p.jwthelper.NewPasswordResetPayload(
+		user.User.ID,
+		user.Application.Name,
+		p.userService.PasswordFingerprint(user))
+
+	token, err := p.jwthelper.GenerateRSA256JWT(payload)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return &dto.PasswordResetTokenResponse{
+		ResetToken:          token.String(),
+		ResetTokenExpiresAt: payload.Expire,
+	}, nil
+}
+
+// ResetPassword the token is single use: it only matches the password it was issued for
+func (p *PasswordApplication) ResetPassword(ctx context.Context, request dto.ResetPasswordRequest) (*dto.OperationResponse, *facade.Error) {
+	token, err := p.jwthelper.VerifyRS256JWT(request.ResetToken)
+	if err != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid reset token")
+	}
+
+	payload := &jwt.PasswordResetPayload{}
+	if err := token.UnmarshalPayload(payload); err != nil {
+		return nil, facade.ErrUnauthorized.Facade("invalid reset token")
+	}
+
+	if payload.Type != jwt.PASSWORDRESET || payload.Expire < time.Now().Unix() {
+		return nil, facade.ErrUnauthorized.Facade("invalid reset token")
+	}
+
+	user, err := p.userReadRepository.Find(ctx, payload.UserID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if user == nil || user.Application == nil || user.Application.Name != payload.Application {
+		return nil, facade.ErrForbidden.Facade("user not found")
+	}
+
+	if p.userService.PasswordFingerprint(user) != payload.PasswordFingerprint {
+		return nil, facade.ErrUnauthorized.Facade("reset token already used")
+	}
+
+	if _, err := p.userService.UpdatePassword(ctx, user, request.NewPassword); err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if err := p.deviceService.RevokeUserRefreshTokens(ctx, user.User.ID); err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if err := p.revocationStore.RevokeUser(ctx, user.User.ID, time.Now()); err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if err := p.posthogClient.Enqueue(posthog.Capture{
+		DistinctId: user.User.ID,
+		Event:      "password_reset",
+		Properties: map[string]interface{}{
+			"application": user.Application.Name,
+		},
+	}); err != nil {
+		p.logger.Errorf(ctx, "posthog event failed: %w", err)
+	}
+
+	return &dto.OperationResponse{Success: true}, nil
+}
+
+func (p *PasswordApplication) findUser(ctx context.Context, applicationName string, email string, phone string) (*aggregate.UserAggregate, *facade.Error) {
+	if (email == "") == (phone == "") {
+		return nil, facade.ErrBadRequest.Facade("one of email or phone is required")
+	}
+
+	application, err := p.applicationService.GetApplication(ctx, applicationName)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return nil, facade.ErrForbidden.Facade("application not found")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	bindingType, identity := enum.BindingTypeEmail, email
+	if phone != "" {
+		bindingType, identity = enum.BindingTypePhone, phone
+	}
+
+	user, err := p.userService.FindByVerifiedBinding(ctx, application.Application.ID, bindingType, identity)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,121 @@
+package application
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/service"
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// QyWechatApplication admin management of the WeCom corps mapped to organizations
+type QyWechatApplication struct {
+	qyWechatService *service.QyWechatService
+
+	organizationReadRepository contract.IOrganizationReadRepository
+}
+
+func NewQyWechatApplication(
+	qyWechatService *service.QyWechatService,
+	organizationReadRepository contract.IOrganizationReadRepository) *QyWechatApplication {
+	return &QyWechatApplication{
+		qyWechatService:            qyWechatService,
+		organizationReadRepository: organizationReadRepository,
+	}
+}
+
+func (q *QyWechatApplication) GetCorp(ctx context.Context, organizationID string) (*dto.QyWechatCorp, *facade.Error) {
+	organization, ferr := q.getOrganization(ctx, organizationID)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	corp, err := q.qyWechatService.GetCorp(ctx, organization.Organization.ID)
+	if err != nil {
+		return nil, convertQyWechatError(err)
+	}
+
+	return convertQyWechatCorpToDTO(corp), nil
+}
+
+// SaveCorp maps the corp to the organization or replaces its mapping
+func (q *QyWechatApplication) SaveCorp(ctx context.Context, request dto.QyWechatCorpRequest) (*dto.QyWechatCorp, *facade.Error) {
+	organization, ferr := q.getOrganization(ctx, request.OrganizationID)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	corp, err := q.qyWechatService.SaveCorp(
+		ctx,
+		organization.Organization.ID,
+		request.CorpID,
+		request.CorpSecret,
+		request.Enabled == nil || *request.Enabled)
+	if err != nil {
+		return nil, convertQyWechatError(err)
+	}
+
+	return convertQyWechatCorpToDTO(corp), nil
+}
+
+func (q *QyWechatApplication) DeleteCorp(ctx context.Context, organizationID string) *facade.Error {
+	organization, ferr := q.getOrganization(ctx, organizationID)
+	if ferr != nil {
+		return ferr
+	}
+
+	if err := q.qyWechatService.DeleteCorp(ctx, organization.Organization.ID); err != nil {
+		return convertQyWechatError(err)
+	}
+
+	return nil
+}
+
+func (q *QyWechatApplication) getOrganization(ctx context.Context, organizationID string) (*aggregate.OrganizationAggregate, *facade.Error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid organization id")
+	}
+
+	organization, err := q.organizationReadRepository.Find(ctx, orgID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if organization == nil {
+		return nil, facade.ErrForbidden.Facade("organization not found")
+	}
+
+	return organization, nil
+}
+
+func convertQyWechatCorpToDTO(corp *entity.QyWechatCorpEntity) *dto.QyWechatCorp {
+	return &dto.QyWechatCorp{
+		ID:             corp.ID.String(),
+		OrganizationID: corp.OrganizationID.String(),
+		CorpID:         corp.CorpID,
+		Enabled:        corp.Enabled,
+		CreatedAt:      corp.CreatedAt.Unix(),
+		UpdatedAt:      corp.UpdatedAt.Unix(),
+	}
+}
+
+func convertQyWechatError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrQyWechatCorpNotFound), xerror.Is(err, service.ErrQyWechatCorpDisabled):
+		return facade.ErrForbidden.Facade("qy wechat corp not found")
+	case xerror.Is(err, service.ErrQyWechatCorpExists):
+		return facade.ErrBadRequest.Facade("qy wechat corp already mapped to another organization")
+	case xerror.Is(err, service.ErrQyWechatCorpInvalid):
+		return facade.ErrBadRequest.Facade("qy wechat corp configuration is invalid")
+	case xerror.Is(err, service.ErrFederationNotConfigured):
+		return facade.ErrForbidden.Facade("secret encryption key is not configured")
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
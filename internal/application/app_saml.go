@@ -0,0 +1,309 @@
+package application
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/service"
+	"kiwi-user/internal/facade/dto"
+	"kiwi-user/internal/infrastructure/jwt"
+	"kiwi-user/internal/infrastructure/replay"
+	"kiwi-user/internal/infrastructure/saml"
+	"kiwi-user/internal/infrastructure/utils"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// SAMLApplication the service provider endpoints the browser and the identity provider of an
+// organization talk to, and the admin management of the connection
+type SAMLApplication struct {
+	applicationService *service.ApplicationService
+	samlService        *service.SAMLService
+	loginService       *service.LoginService
+
+	organizationReadRepository contract.IOrganizationReadRepository
+
+	logger      logger.ILogger
+	jwthelper   *jwt.JWTHelper
+	replayStore replay.Store
+}
+
+func NewSAMLApplication(
+	logger logger.ILogger,
+	applicationService *service.ApplicationService,
+	samlService *service.SAMLService,
+	loginService *service.LoginService,
+	organizationReadRepository contract.IOrganizationReadRepository,
+	jwthelper *jwt.JWTHelper,
+	replayStore replay.Store) *SAMLApplication {
+	return &SAMLApplication{
+		logger:                     logger,
+		applicationService:         applicationService,
+		samlService:                samlService,
+		loginService:               loginService,
+		organizationReadRepository: organizationReadRepository,
+		jwthelper:                  jwthelper,
+		replayStore:                replayStore,
+	}
+}
+
+// Metadata the service provider metadata xml of the organization
+func (s *SAMLApplication) Metadata(ctx context.Context, organizationID string) ([]byte, *facade.Error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid organization id")
+	}
+
+	metadata, err := s.samlService.Metadata(ctx, orgID)
+	if err != nil {
+		return nil, convertSAMLError(err)
+	}
+
+	return metadata, nil
+}
+
+// Login starts a service provider initiated login, the browser is redirected to the returned url.
+// The request id travels signed in the relay state, the identity provider has to answer it.
+func (s *SAMLApplication) Login(ctx context.Context, organizationID string) (string, *facade.Error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return "", facade.ErrBadRequest.Facade("invalid organization id")
+	}
+
+	connection, err := s.samlService.GetEnabledConnection(ctx, orgID)
+	if err != nil {
+		return "", convertSAMLError(err)
+	}
+
+	// xml ids can not start with a digit
+	token, err := utils.RandomURLSafeToken(32)
+	if err != nil {
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+	requestID := "_" + token
+
+	relay, err := s.jwthelper.GenerateRSA256JWT(s.jwthelper.NewSAMLRelayPayload(requestID, orgID.String()))
+	if err != nil {
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+
+	authnRequestURL, err := s.samlService.AuthnRequestURL(connection, requestID, relay.String())
+	if err != nil {
+		return "", convertSAMLError(err)
+	}
+
+	return authnRequestURL, nil
+}
+
+// ACS the assertion consumer service. The assertion is verified, the user provisioned into the
+// organization and the browser redirected to the redirect_url of the connection with a one time ticket.
+func (s *SAMLApplication) ACS(ctx context.Context, organizationID string, request dto.SAMLACSRequest) (string, *facade.Error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return "", facade.ErrBadRequest.Facade("invalid organization id")
+	}
+
+	token, err := s.jwthelper.VerifyRS256JWT(request.RelayState)
+	if err != nil {
+		return "", facade.ErrUnauthorized.Facade("invalid relay state")
+	}
+
+	var relay jwt.SAMLRelayPayload
+	if err := token.UnmarshalPayload(&relay); err != nil {
+		return "", facade.ErrUnauthorized.Facade("invalid relay state")
+	}
+
+	if relay.Type != jwt.SAMLRELAY || relay.Expire < time.Now().Unix() || relay.OrganizationID != orgID.String() {
+		return "", facade.ErrUnauthorized.Facade("invalid relay state")
+	}
+
+	connection, err := s.samlService.GetEnabledConnection(ctx, orgID)
+	if err != nil {
+		return "", convertSAMLError(err)
+	}
+
+	identity, err := s.samlService.Identity(connection, request.SAMLResponse, relay.ID)
+	if err != nil {
+		if xerror.Is(err, saml.ErrInvalidResponse) || xerror.Is(err, saml.ErrInvalidSignature) {
+			s.logger.Warnf(ctx, "invalid saml response: organization=%s, %w", orgID, err)
+			return "", facade.ErrUnauthorized.Facade("invalid saml response")
+		}
+		return "", convertSAMLError(err)
+	}
+
+	// every authentication request is answered once, a replayed response is refused
+	if ferr := consumeOnce(ctx, s.replayStore, relay.ID, time.Unix(relay.Expire, 0)); ferr != nil {
+		return "", ferr
+	}
+
+	organization, err := s.organizationReadRepository.Find(ctx, orgID)
+	if err != nil {
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+
+	if organization == nil {
+		return "", facade.ErrForbidden.Facade("organization not found")
+	}
+
+	application, err := s.applicationService.GetApplication(ctx, organization.Application.Name)
+	if err != nil {
+		if xerror.Is(err, service.ErrApplicationNotFound) {
+			return "", facade.ErrForbidden.Facade("application not found")
+		}
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+
+	user, err := s.loginService.SAMLLogin(ctx, application, organization, identity)
+	if err != nil {
+		return "", convertSAMLError(err)
+	}
+
+	ticketID, err := utils.RandomURLSafeToken(32)
+	if err != nil {
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+
+	ticket, err := s.jwthelper.GenerateRSA256JWT(
+		s.jwthelper.NewSAMLTicketPayload(ticketID, user.User.ID, application.Application.Name, orgID.String()))
+	if err != nil {
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+
+	redirectURL, err := url.Parse(connection.RedirectURL)
+	if err != nil {
+		return "", facade.ErrServerInternal.Wrap(err)
+	}
+
+	query := redirectURL.Query()
+	query.Set("ticket", ticket.String())
+	redirectURL.RawQuery = query.Encode()
+
+	return redirectURL.String(), nil
+}
+
+func (s *SAMLApplication) GetConnection(ctx context.Context, organizationID string) (*dto.SAMLConnection, *facade.Error) {
+	organization, ferr := s.getOrganization(ctx, organizationID)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	connection, err := s.samlService.GetConnection(ctx, organization.Organization.ID)
+	if err != nil {
+		return nil, convertSAMLError(err)
+	}
+
+	return s.convertSAMLConnectionToDTO(connection)
+}
+
+// SaveConnection creates the connection of the organization or replaces it
+func (s *SAMLApplication) SaveConnection(ctx context.Context, request dto.SAMLConnectionRequest) (*dto.SAMLConnection, *facade.Error) {
+	organization, ferr := s.getOrganization(ctx, request.OrganizationID)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	connection, err := s.samlService.SaveConnection(
+		ctx,
+		organization.Organization.ID,
+		request.IdPMetadata,
+		request.AttributeMapping,
+		request.RedirectURL,
+		request.Enabled == nil || *request.Enabled)
+	if err != nil {
+		return nil, convertSAMLError(err)
+	}
+
+	return s.convertSAMLConnectionToDTO(connection)
+}
+
+func (s *SAMLApplication) DeleteConnection(ctx context.Context, organizationID string) *facade.Error {
+	organization, ferr := s.getOrganization(ctx, organizationID)
+	if ferr != nil {
+		return ferr
+	}
+
+	if err := s.samlService.DeleteConnection(ctx, organization.Organization.ID); err != nil {
+		return convertSAMLError(err)
+	}
+
+	return nil
+}
+
+func (s *SAMLApplication) getOrganization(ctx context.Context, organizationID string) (*aggregate.OrganizationAggregate, *facade.Error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, facade.ErrBadRequest.Facade("invalid organization id")
+	}
+
+	organization, err := s.organizationReadRepository.Find(ctx, orgID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if organization == nil {
+		return nil, facade.ErrForbidden.Facade("organization not found")
+	}
+
+	return organization, nil
+}
+
+func (s *SAMLApplication) convertSAMLConnectionToDTO(connection *entity.SAMLConnectionEntity) (*dto.SAMLConnection, *facade.Error) {
+	sp, err := s.samlService.ServiceProvider(connection.OrganizationID)
+	if err != nil {
+		return nil, convertSAMLError(err)
+	}
+
+	return &dto.SAMLConnection{
+		ID:               connection.ID.String(),
+		OrganizationID:   connection.OrganizationID.String(),
+		EntityID:         sp.EntityID,
+		ACSURL:           sp.ACSURL,
+		MetadataURL:      sp.EntityID,
+		LoginURL:         strings.TrimSuffix(sp.ACSURL, "/acs") + "/login",
+		IdPEntityID:      connection.IdPEntityID,
+		IdPSSOURL:        connection.IdPSSOURL,
+		AttributeMapping: connection.AttributeMapping,
+		RedirectURL:      connection.RedirectURL,
+		Enabled:          connection.Enabled,
+		CreatedAt:        connection.CreatedAt.Unix(),
+		UpdatedAt:        connection.UpdatedAt.Unix(),
+	}, nil
+}
+
+// consumeOnce records id as used until expiresAt, a second use is refused
+func consumeOnce(ctx context.Context, store replay.Store, id string, expiresAt time.Time) *facade.Error {
+	ok, err := store.Consume(ctx, id, expiresAt)
+	if err != nil {
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	if !ok {
+		return facade.ErrUnauthorized.Facade("already used")
+	}
+
+	return nil
+}
+
+func convertSAMLError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrSAMLConnectionNotFound), xerror.Is(err, service.ErrSAMLConnectionDisabled):
+		return facade.ErrForbidden.Facade("saml connection not found")
+	case xerror.Is(err, service.ErrSAMLConnectionInvalid):
+		return facade.ErrBadRequest.Facade("saml connection configuration is invalid")
+	case xerror.Is(err, service.ErrSAMLNameIDNotFound):
+		return facade.ErrUnauthorized.Facade("invalid saml response")
+	case xerror.Is(err, service.ErrSAMLNotConfigured):
+		return facade.ErrForbidden.Facade("saml is not configured")
+	case xerror.Is(err, service.ErrDefaultOrgRoleNotFound):
+		return facade.ErrBadRequest.Facade("application has no default organization role")
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
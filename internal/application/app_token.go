@@ -6,6 +6,7 @@ import (
 	"kiwi-user/internal/domain/service"
 	"kiwi-user/internal/facade/dto"
 	"kiwi-user/internal/infrastructure/jwt"
+	"kiwi-user/internal/infrastructure/revocation"
 	"time"
 
 	"github.com/futurxlab/golanggraph/logger"
@@ -20,8 +21,9 @@ type TokenApplication struct {
 	deviceService *service.DeviceService
 	rbacService   *service.RBACService
 
-	rsa       *jwt.RSA
-	jwthelper *jwt.JWTHelper
+	rsa             *jwt.RSA
+	jwthelper       *jwt.JWTHelper
+	revocationStore revocation.Store
 
 	deviceReadRepository           contract.IDeviceReadRepository
 	userReadRepository             contract.IUserReadRepository
@@ -35,6 +37,7 @@ type TokenApplication struct {
 func NewTokenApplication(
 	rsa *jwt.RSA,
 	jwthelper *jwt.JWTHelper,
+	revocationStore revocation.Store,
 	deviceService *service.DeviceService,
 	rbacService *service.RBACService,
 	deviceReadRepository contract.IDeviceReadRepository,
@@ -46,6 +49,7 @@ func NewTokenApplication(
 	return &TokenApplication{
 		rsa:                            rsa,
 		jwthelper:                      jwthelper,
+		revocationStore:                revocationStore,
 		deviceService:                  deviceService,
 		rbacService:                    rbacService,
 		deviceReadRepository:           deviceReadRepository,
@@ -80,7 +84,17 @@ func (t *TokenApplication) VerifyAccessToken(ctx context.Context, token string)
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	if payload.Expire < time.Now().Unix() {
+	// tokens issued to oauth clients are not accepted by first party services
+	if payload.Type != jwt.ACCESS || payload.Audience != "" || payload.Expire < time.Now().Unix() {
+		return nil, facade.ErrForbidden
+	}
+
+	revoked, err := t.revocationStore.IsRevoked(ctx, payload.ID, payload.UserID, payload.DeviceType, payload.DeviceID, payload.IssuedAt())
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if revoked {
 		return nil, facade.ErrForbidden
 	}
 
@@ -123,9 +137,17 @@ func (t *TokenApplication) RefreshAccessToken(ctx context.Context, request dto.R
 		return nil, facade.ErrForbidden.Facade("device not found")
 	}
 
-	if deviceAggregate.Device.RefreshTokenExpiresAt.Before(time.Now()) ||
-		deviceAggregate.Device.RefreshToken != request.RefreshToken {
-		return nil, facade.ErrForbidden.Facade("invalid refresh token")
+	// rotate refresh token, a replayed one revokes the whole token family
+	deviceAggregate, err = t.deviceService.RotateRefreshToken(ctx, deviceAggregate, request.RefreshToken)
+	if err != nil {
+		if xerror.Is(err, service.ErrRefreshTokenReused) {
+			reportRefreshTokenReuse(ctx, t.logger, t.posthogClient, userAggregate, request.Device.DeviceType, request.Device.DeviceID)
+			return nil, facade.ErrForbidden.Facade("invalid refresh token")
+		}
+		if xerror.Is(err, service.ErrRefreshTokenInvalid) {
+			return nil, facade.ErrForbidden.Facade("invalid refresh token")
+		}
+		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
 	// check organization
@@ -148,16 +170,10 @@ func (t *TokenApplication) RefreshAccessToken(ctx context.Context, request dto.R
 		}
 	}
 
-	// generate new refresh token
-	// deviceAggregate, err = t.deviceService.RegenerateRefreshToken(ctx, deviceAggregate, false)
-	// if err != nil {
-	// 	return nil, facade.ErrServerInternal.Wrap(err)
-	// }
-
 	// genereate new access token
 	result, err := generateLoginResult(ctx, userAggregate, deviceAggregate.Device, t.rbacService, t.jwthelper)
 	if err != nil {
-		return nil, facade.ErrServerInternal.Wrap(err)
+		return nil, convertLoginResultError(err)
 	}
 
 	return &dto.RefreshAccessTokenResponse{
@@ -177,7 +193,7 @@ func (t *TokenApplication) Logout(ctx context.Context, request dto.LogoutRequest
 	}
 
 	if deviceAggregate.Device.RefreshTokenExpiresAt.Before(time.Now()) ||
-		deviceAggregate.Device.RefreshToken != request.RefreshToken {
+		!t.deviceService.MatchRefreshToken(deviceAggregate, request.RefreshToken) {
 		return facade.ErrForbidden.Facade("invalid refresh token")
 	}
 
@@ -198,6 +214,11 @@ func (t *TokenApplication) Logout(ctx context.Context, request dto.LogoutRequest
 		return facade.ErrServerInternal.Wrap(err)
 	}
 
+	// 使当前 access token 失效
+	if err := t.revocationStore.RevokeToken(ctx, request.AccessTokenID, time.Unix(request.AccessTokenExpire, 0)); err != nil {
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
 	// 记录登出事件
 	if err = t.posthogClient.Enqueue(posthog.Capture{
 		DistinctId: request.UserID,
@@ -215,3 +236,121 @@ func (t *TokenApplication) Logout(ctx context.Context, request dto.LogoutRequest
 
 	return nil
 }
+
+func (t *TokenApplication) ListSigningKeys(ctx context.Context) (*dto.SigningKeysResponse, *facade.Error) {
+	response := &dto.SigningKeysResponse{
+		Keys: make([]*dto.SigningKey, 0),
+	}
+
+	for _, key := range t.rsa.ListKeys() {
+		response.Keys = append(response.Keys, convertSigningKeyInfoToDTO(key))
+	}
+
+	return response, nil
+}
+
+// StageSigningKey the new key is published in the jwks right away but only signs after ActivateSigningKey
+func (t *TokenApplication) StageSigningKey(ctx context.Context) (*dto.SigningKey, *facade.Error) {
+	key, err := t.rsa.StageKey()
+	if err != nil {
+		if xerror.Is(err, jwt.ErrKeyDirectoryNotConfigured) {
+			return nil, facade.ErrForbidden.Facade("jwt key directory not configured")
+		}
+
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	t.logger.Infof(ctx, "jwt signing key %s staged", key.Kid)
+
+	return convertSigningKeyInfoToDTO(key), nil
+}
+
+func (t *TokenApplication) ActivateSigningKey(ctx context.Context, kid string) *facade.Error {
+	if err := t.rsa.ActivateKey(kid); err != nil {
+		return convertSigningKeyError(err)
+	}
+
+	t.logger.Infof(ctx, "jwt signing key %s activated", kid)
+
+	return nil
+}
+
+func (t *TokenApplication) RemoveSigningKey(ctx context.Context, kid string) *facade.Error {
+	if err := t.rsa.RemoveKey(kid); err != nil {
+		return convertSigningKeyError(err)
+	}
+
+	t.logger.Infof(ctx, "jwt signing key %s removed", kid)
+
+	return nil
+}
+
+func convertSigningKeyError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, jwt.ErrKeyDirectoryNotConfigured):
+		return facade.ErrForbidden.Facade("jwt key directory not configured")
+	case xerror.Is(err, jwt.ErrSigningKeyNotFound):
+		return facade.ErrForbidden.Facade("signing key not found")
+	case xerror.Is(err, jwt.ErrSigningKeyInvalidStatus):
+		return facade.ErrForbidden.Facade("signing key status does not allow this operation")
+	case xerror.Is(err, jwt.ErrSigningKeyInUse):
+		return facade.ErrForbidden.Facade("signing key may still verify live tokens")
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
+
+func convertSigningKeyInfoToDTO(key *jwt.SigningKeyInfo) *dto.SigningKey {
+	signingKey := &dto.SigningKey{
+		Kid:       key.Kid,
+		Status:    string(key.Status),
+		CreatedAt: key.CreatedAt.Unix(),
+	}
+
+	if !key.ActivatedAt.IsZero() {
+		signingKey.ActivatedAt = key.ActivatedAt.Unix()
+	}
+
+	if !key.RetiredAt.IsZero() {
+		signingKey.RetiredAt = key.RetiredAt.Unix()
+	}
+
+	if !key.RemovableAt.IsZero() {
+		signingKey.RemovableAt = key.RemovableAt.Unix()
+	}
+
+	return signingKey
+}
+
+// RevokeUserTokens signs the user out everywhere at once: refresh tokens are revoked and
+// access tokens already issued are rejected until they expire
+func (t *TokenApplication) RevokeUserTokens(ctx context.Context, request dto.RevokeUserTokensRequest) *facade.Error {
+	userAggregate, err := t.userReadRepository.Find(ctx, request.UserID)
+	if err != nil {
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	if userAggregate == nil {
+		return facade.ErrForbidden.Facade("user not found")
+	}
+
+	if err := t.deviceService.RevokeUserRefreshTokens(ctx, request.UserID); err != nil {
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	if err := t.revocationStore.RevokeUser(ctx, request.UserID, time.Now()); err != nil {
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	if err := t.posthogClient.Enqueue(posthog.Capture{
+		DistinctId: request.UserID,
+		Event:      "user_tokens_revoked",
+		Properties: map[string]interface{}{
+			"application": userAggregate.Application.Name,
+		},
+	}); err != nil {
+		t.logger.Errorf(ctx, "posthog event failed: %w", err)
+	}
+
+	return nil
+}
@@ -12,8 +12,9 @@ import (
 	"kiwi-user/internal/domain/model/enum"
 	"kiwi-user/internal/domain/service"
 	"kiwi-user/internal/facade/dto"
-	"kiwi-user/internal/infrastructure/utils"
+	"kiwi-user/internal/infrastructure/revocation"
 	"strings"
+	"time"
 
 	"github.com/Yet-Another-AI-Project/kiwi-lib/client/alibaba/oss"
 	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
@@ -35,8 +36,11 @@ type UserApplication struct {
 	organizationService *service.OrganizationService
 	userService         *service.UserService
 	loginService        *service.LoginService
+	mfaService          *service.MFAService
+	deviceService       *service.DeviceService
 	posthogClient       posthog.Client
 	ossClient           *oss.AliyunOss
+	revocationStore     revocation.Store
 
 	config *config.Config
 }
@@ -223,7 +227,8 @@ func (u *UserApplication) UpdateUserInfo(ctx context.Context, userID string, req
 	return getUserInfo(ctx, "", userAggregate, u.roleReadRepository, u.organizationUserReadRepository)
 }
 
-func (u *UserApplication) ChangePassword(ctx context.Context, userID string, request dto.ChangePasswordRequest) (*dto.OperationResponse, *facade.Error) {
+// ChangePassword signs the user out of every other device, whoever knew the old password loses its session
+func (u *UserApplication) ChangePassword(ctx context.Context, userID string, current dto.Device, request dto.ChangePasswordRequest) (*dto.OperationResponse, *facade.Error) {
 	if userID == "" {
 		return nil, facade.ErrBadRequest.Facade("user_id is required")
 	}
@@ -242,16 +247,16 @@ func (u *UserApplication) ChangePassword(ctx context.Context, userID string, req
 	}
 
 	passwordBinding := findPasswordBinding(userAggregate.Bindings)
-	if passwordBinding == nil || passwordBinding.Salt == "" {
+	if passwordBinding == nil {
 		return nil, facade.ErrForbidden.Facade("旧密码不正确")
 	}
 
-	hashedOldPassword, err := utils.EncodePassword(request.OldPassword, passwordBinding.Salt)
+	ok, err := u.userService.VerifyPassword(userAggregate, request.OldPassword)
 	if err != nil {
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	if hashedOldPassword != passwordBinding.Identity {
+	if !ok {
 		return nil, facade.ErrForbidden.Facade("旧密码不正确")
 	}
 
@@ -260,9 +265,82 @@ func (u *UserApplication) ChangePassword(ctx context.Context, userID string, req
 		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
+	if err := u.deviceService.RevokeOtherDevices(ctx, userID, current.DeviceType, current.DeviceID); err != nil {
+		if !xerror.Is(err, service.ErrDeviceNotFound) {
+			return nil, facade.ErrServerInternal.Wrap(err)
+		}
+
+		// a token without a session of its own keeps none
+		if err := u.deviceService.RevokeUserRefreshTokens(ctx, userID); err != nil {
+			return nil, facade.ErrServerInternal.Wrap(err)
+		}
+	}
+
+	// access tokens issued with the old password stop working, the current device refreshes for a new one
+	if err := u.revocationStore.RevokeUser(ctx, userID, time.Now()); err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
 	return &dto.OperationResponse{Success: true}, nil
 }
 
+// BanUser refuses the user any new token and signs it out of every device at once
+func (u *UserApplication) BanUser(ctx context.Context, request dto.BanUserRequest) *facade.Error {
+	userAggregate, ferr := u.setBanned(ctx, request.UserID, true)
+	if ferr != nil {
+		return ferr
+	}
+
+	if err := u.deviceService.RevokeUserRefreshTokens(ctx, userAggregate.User.ID); err != nil {
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	if err := u.revocationStore.RevokeUser(ctx, userAggregate.User.ID, time.Now()); err != nil {
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	return nil
+}
+
+// UnbanUser lets the user log in again, the sessions revoked by the ban stay revoked
+func (u *UserApplication) UnbanUser(ctx context.Context, request dto.BanUserRequest) *facade.Error {
+	_, ferr := u.setBanned(ctx, request.UserID, false)
+	return ferr
+}
+
+func (u *UserApplication) setBanned(ctx context.Context, userID string, banned bool) (*aggregate.UserAggregate, *facade.Error) {
+	userAggregate, err := u.userReadRepository.Find(ctx, userID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if userAggregate == nil {
+		return nil, facade.ErrForbidden.Facade("user not found")
+	}
+
+	userAggregate, err = u.userService.SetBanned(ctx, userAggregate, banned)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	event := "user_unbanned"
+	if banned {
+		event = "user_banned"
+	}
+
+	if err := u.posthogClient.Enqueue(posthog.Capture{
+		DistinctId: userAggregate.User.ID,
+		Event:      event,
+		Properties: map[string]interface{}{
+			"application": userAggregate.Application.Name,
+		},
+	}); err != nil {
+		u.logger.Errorf(ctx, "posthog event failed: %w", err)
+	}
+
+	return userAggregate, nil
+}
+
 func (u *UserApplication) CreateUserWithPassword(ctx context.Context, request dto.CreateUserWithPasswordRequest) (*dto.UserInfo, *facade.Error) {
 
 	applicationAggregate, err := u.applicationReadRepository.FindByName(ctx, request.Application)
@@ -346,93 +424,74 @@ func (u *UserApplication) CreateUserWithPassword(ctx context.Context, request dt
 	return getUserInfo(ctx, "", userAggregate, u.roleReadRepository, u.organizationUserReadRepository)
 }
 
-func (u *UserApplication) BindingPhone(ctx context.Context, userID string, phone string, mini_program_code string) *facade.Error {
-	if phone == "" {
-		if mini_program_code == "" {
-			return facade.ErrBadRequest.Facade("mini_program_code is required")
-		}
-
-		var err error
-		phone, err = u.loginService.GetPhoneFromMiniProgramCode(mini_program_code, u.config.Wechat.MiniProgramID, u.config.Wechat.MiniProgramSecret)
-		if err != nil {
-			return facade.ErrServerInternal.Wrap(err)
-		}
-	}
-
+func (u *UserApplication) EnrollTOTP(ctx context.Context, userID string) (*dto.TOTPEnrollResponse, *facade.Error) {
 	userAggregate, err := u.userReadRepository.Find(ctx, userID)
 	if err != nil {
-		return facade.ErrServerInternal.Wrap(err)
+		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
 	if userAggregate == nil {
-		return facade.ErrForbidden.Facade("user not found")
+		return nil, facade.ErrForbidden.Facade("user not found")
 	}
 
-	for _, binding := range userAggregate.Bindings {
-		if binding.Type == enum.BindingTypePhone && binding.Identity == phone {
-			return facade.ErrForbidden.Facade("phone already bound")
-		}
+	secret, uri, err := u.mfaService.EnrollTOTP(ctx, userAggregate)
+	if err != nil {
+		return nil, convertMFAError(err)
 	}
 
-	userAggregate.Bindings = append(userAggregate.Bindings, &entity.BindingEntity{
-		Type:     enum.BindingTypePhone,
-		Identity: phone,
-		Verified: true,
-	})
+	return &dto.TOTPEnrollResponse{
+		Secret:     secret,
+		OTPAuthURL: uri,
+	}, nil
+}
 
-	if _, err := u.userService.Update(ctx, userAggregate); err != nil {
-		return facade.ErrServerInternal.Wrap(err)
+func (u *UserApplication) ConfirmTOTP(ctx context.Context, userID string, request dto.TOTPCodeRequest) (*dto.OperationResponse, *facade.Error) {
+	userAggregate, err := u.userReadRepository.Find(ctx, userID)
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
-	return nil
-}
-
-func (u *UserApplication) BindingPhoneWithVerifyCode(ctx context.Context, userID string, phone string, verifyCode string) *facade.Error {
-	if phone == "" {
-		return facade.ErrBadRequest.Facade("phone is required")
+	if userAggregate == nil {
+		return nil, facade.ErrForbidden.Facade("user not found")
 	}
-	if verifyCode == "" {
-		return facade.ErrBadRequest.Facade("verify_code is required")
+
+	if err := u.mfaService.ConfirmTOTP(ctx, userAggregate, request.Code); err != nil {
+		return nil, convertMFAError(err)
 	}
 
+	return &dto.OperationResponse{Success: true}, nil
+}
+
+func (u *UserApplication) DisableTOTP(ctx context.Context, userID string, request dto.TOTPCodeRequest) (*dto.OperationResponse, *facade.Error) {
 	userAggregate, err := u.userReadRepository.Find(ctx, userID)
 	if err != nil {
-		return facade.ErrServerInternal.Wrap(err)
+		return nil, facade.ErrServerInternal.Wrap(err)
 	}
 
 	if userAggregate == nil {
-		return facade.ErrForbidden.Facade("user not found")
+		return nil, facade.ErrForbidden.Facade("user not found")
 	}
 
-	for _, binding := range userAggregate.Bindings {
-		if binding.Type == enum.BindingTypePhone && binding.Identity == phone {
-			return facade.ErrForbidden.Facade("phone already bound")
-		}
+	if err := u.mfaService.DisableTOTP(ctx, userAggregate, request.Code); err != nil {
+		return nil, convertMFAError(err)
 	}
 
-	// todo: 2025-04-24 临时取消短信验证，待实名认证通过后再恢复测试
-	//// check verifyCode
-	//isSuccess, err := u.smsClient.CheckVerifyCode(phone, "注册验证码", verifyCode)
-	//
-	//if err != nil {
-	//	return facade.ErrServerInternal.Wrap(err)
-	//}
-	//
-	//if !isSuccess {
-	//	return facade.ErrForbidden.Facade("verify_code is invalid")
-	//}
-
-	userAggregate.Bindings = append(userAggregate.Bindings, &entity.BindingEntity{
-		Type:     enum.BindingTypePhone,
-		Identity: phone,
-		Verified: true,
-	})
-
-	if _, err := u.userService.Update(ctx, userAggregate); err != nil {
+	return &dto.OperationResponse{Success: true}, nil
+}
+
+func convertMFAError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrMFAAlreadyEnabled):
+		return facade.ErrForbidden.Facade("mfa already enabled")
+	case xerror.Is(err, service.ErrMFANotEnabled):
+		return facade.ErrForbidden.Facade("mfa not enabled")
+	case xerror.Is(err, service.ErrMFAInvalidCode):
+		return facade.ErrForbidden.Facade("invalid mfa code")
+	case xerror.Is(err, service.ErrMFACodeUsed):
+		return facade.ErrForbidden.Facade("mfa code was already used, wait for the next one")
+	default:
 		return facade.ErrServerInternal.Wrap(err)
 	}
-
-	return nil
 }
 
 func findPasswordBinding(bindings []*entity.BindingEntity) *entity.BindingEntity {
@@ -455,8 +514,11 @@ func NewUserApplication(
 	organizationService *service.OrganizationService,
 	userService *service.UserService,
 	loginService *service.LoginService,
+	mfaService *service.MFAService,
+	deviceService *service.DeviceService,
 	posthogClient posthog.Client,
 	ossClient *oss.AliyunOss,
+	revocationStore revocation.Store,
 	config *config.Config,
 ) *UserApplication {
 	return &UserApplication{
@@ -469,8 +531,11 @@ func NewUserApplication(
 		organizationService:            organizationService,
 		userService:                    userService,
 		loginService:                   loginService,
+		mfaService:                     mfaService,
+		deviceService:                  deviceService,
 		posthogClient:                  posthogClient,
 		ossClient:                      ossClient,
+		revocationStore:                revocationStore,
 		config:                         config,
 	}
 }
@@ -11,4 +11,12 @@ var Module = fx.Provide(
 	NewBindingApplication,
 	NewPaymentApplication,
 	NewOrganizationRequestApplication,
+	NewOAuthApplication,
+	NewPasskeyApplication,
+	NewPasswordApplication,
+	NewLoginLockApplication,
+	NewDeviceApplication,
+	NewFederationApplication,
+	NewSAMLApplication,
+	NewQyWechatApplication,
 )
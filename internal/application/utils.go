@@ -2,17 +2,22 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"kiwi-user/internal/domain/contract"
 	"kiwi-user/internal/domain/model/aggregate"
 	"kiwi-user/internal/domain/model/entity"
 	"kiwi-user/internal/domain/model/enum"
 	"kiwi-user/internal/domain/service"
 	"kiwi-user/internal/facade/dto"
+	"kiwi-user/internal/infrastructure/apple"
 	"kiwi-user/internal/infrastructure/jwt"
+	"kiwi-user/internal/infrastructure/ratelimit"
 
 	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/logger"
 	"github.com/futurxlab/golanggraph/xerror"
 	"github.com/google/uuid"
+	"github.com/posthog/posthog-go"
 )
 
 func getUserInfo(
@@ -39,6 +44,21 @@ func getUserInfo(
 		if binding.Type == enum.BindingTypeGoogle && userInfo.Email == "" {
 			userInfo.Email = binding.Email
 		}
+
+		// a Hide My Email relay drops mail from unregistered senders, it is no contact address
+		if binding.Type == enum.BindingTypeApple && userInfo.Email == "" && !apple.IsPrivateRelayEmail(binding.Email) {
+			userInfo.Email = binding.Email
+		}
+
+		// only set when the provider verified it
+		if binding.Type == enum.BindingTypeOIDC && userInfo.Email == "" {
+			userInfo.Email = binding.Email
+		}
+
+		// clients offer guests to register
+		if binding.Type == enum.BindingTypeGuest {
+			userInfo.Guest = true
+		}
 	}
 
 	// NOTE: override name if displayname is not empty, for UI back compatibility
@@ -104,6 +124,11 @@ func generateLoginResult(
 	rbacService *service.RBACService,
 	jwthelper *jwt.JWTHelper) (*dto.LoginResponse, error) {
 
+	// every login and refresh ends here, a banned user gets no tokens whatever it signed in with
+	if user.User.Banned {
+		return nil, service.ErrUserBanned
+	}
+
 	roleName := ""
 	scopes := make([]string, 0)
 
@@ -137,6 +162,12 @@ func generateLoginResult(
 		deviceEntity.DeviceID,
 		orgnizationID)
 
+	// tokens of an oauth session are for its client only, first party endpoints refuse them
+	if deviceEntity.DeviceType == service.OAuthDeviceType {
+		up.Audience = user.Application.Name
+		up.OAuthScope = deviceEntity.OAuthScope
+	}
+
 	accessToken, err := jwthelper.GenerateRSA256JWT(up)
 
 	if err != nil {
@@ -159,3 +190,93 @@ func generateLoginResult(
 
 	return result, nil
 }
+
+// reportRefreshTokenReuse a rotated refresh token was presented again, either the client or an attacker
+// holds a leaked copy. The token family is already revoked, this only records the security event.
+func reportRefreshTokenReuse(
+	ctx context.Context,
+	logger logger.ILogger,
+	posthogClient posthog.Client,
+	user *aggregate.UserAggregate,
+	deviceType string,
+	deviceID string) {
+
+	logger.Warnf(ctx, "rotated refresh token reused, token family revoked, user: %s, device: %s %s", user.User.ID, deviceType, deviceID)
+
+	if err := posthogClient.Enqueue(posthog.Capture{
+		DistinctId: user.User.ID,
+		Event:      "refresh_token_reuse",
+		Properties: map[string]interface{}{
+			"device_type": deviceType,
+			"device_id":   deviceID,
+			"application": user.Application.Name,
+		},
+	}); err != nil {
+		logger.Errorf(ctx, "posthog event failed: %w", err)
+	}
+}
+
+// checkVerifyCodeCaptcha verifies the captcha the policy of the application asks for before a code is sent
+func checkVerifyCodeCaptcha(
+	ctx context.Context,
+	captchaService *service.CaptchaService,
+	applicationName string,
+	target string,
+	captchaVerifyParam string) *facade.Error {
+
+	if err := captchaService.CheckVerifyCode(ctx, applicationName, target, captchaVerifyParam); err != nil {
+		return convertCaptchaError(err)
+	}
+
+	return nil
+}
+
+func convertLoginResultError(err error) *facade.Error {
+	if xerror.Is(err, service.ErrUserBanned) {
+		return facade.ErrForbidden.Facade("user is banned")
+	}
+
+	return facade.ErrServerInternal.Wrap(err)
+}
+
+func convertCaptchaError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrCaptchaRequired):
+		return facade.ErrForbidden.Facade("captcha required")
+	case xerror.Is(err, service.ErrCaptchaInvalid):
+		return facade.ErrForbidden.Facade("invalid captcha")
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
+
+func convertVerificationCodeError(err error) *facade.Error {
+	switch {
+	case xerror.Is(err, service.ErrVerificationCodeNotFound), xerror.Is(err, service.ErrVerificationCodeInvalid):
+		return facade.ErrForbidden.Facade("invalid verification code")
+	case xerror.Is(err, service.ErrVerificationCodeExhausted):
+		return facade.ErrForbidden.Facade("too many attempts, request a new verification code")
+	default:
+		return facade.ErrServerInternal.Wrap(err)
+	}
+}
+
+// checkVerifyCodeRateLimit rejects sending a code once the target, the client ip or the application
+// reached its limit. The request is answered with 429 and the wait in Retry-After.
+func checkVerifyCodeRateLimit(
+	ctx context.Context,
+	rateLimitService *service.RateLimitService,
+	applicationName string,
+	target string) *facade.Error {
+
+	retryAfter, err := rateLimitService.CheckVerifyCode(ctx, applicationName, target)
+	if err != nil {
+		if xerror.Is(err, service.ErrRateLimited) {
+			ratelimit.Reject(ctx, retryAfter)
+			return facade.ErrForbidden.Facade(fmt.Sprintf("too many requests, retry after %d seconds", ratelimit.RetryAfterSeconds(retryAfter)))
+		}
+		return facade.ErrServerInternal.Wrap(err)
+	}
+
+	return nil
+}
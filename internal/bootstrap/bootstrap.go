@@ -22,7 +22,8 @@ type Bootstrap struct {
 	userRepository        contract.IUserRepository
 	roleRepository        contract.IRoleRepository
 
-	userService *service.UserService
+	userService   *service.UserService
+	deviceService *service.DeviceService
 }
 
 func NewBootStrap(
@@ -32,6 +33,7 @@ func NewBootStrap(
 	userRepository contract.IUserRepository,
 	roleRepository contract.IRoleRepository,
 	userService *service.UserService,
+	deviceService *service.DeviceService,
 ) *Bootstrap {
 	return &Bootstrap{
 		logger:                logger,
@@ -40,6 +42,7 @@ func NewBootStrap(
 		userRepository:        userRepository,
 		roleRepository:        roleRepository,
 		userService:           userService,
+		deviceService:         deviceService,
 	}
 }
 
@@ -113,5 +116,15 @@ func (b *Bootstrap) Init() error {
 		return xerror.Wrap(err)
 	}
 
+	// refresh tokens written before they were hashed
+	count, err := b.deviceService.HashLegacyRefreshTokens(ctx)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if count > 0 {
+		b.logger.Infof(ctx, "hashed %d legacy refresh tokens", count)
+	}
+
 	return nil
 }
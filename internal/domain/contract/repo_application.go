@@ -3,6 +3,7 @@ package contract
 import (
 	"context"
 	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
 )
 
 type IApplicationReadRepository interface {
@@ -13,6 +14,7 @@ type IApplicationReadRepository interface {
 type IApplicationWriteRepository interface {
 	Create(ctx context.Context, application *aggregate.ApplicationAggregate) (*aggregate.ApplicationAggregate, error)
 	Update(ctx context.Context, application *aggregate.ApplicationAggregate) (*aggregate.ApplicationAggregate, error)
+	UpdateOAuthClient(ctx context.Context, application *entity.ApplicationEntity) (*entity.ApplicationEntity, error)
 	Delete(ctx context.Context, application *aggregate.ApplicationAggregate) error
 }
 
@@ -3,16 +3,26 @@ package contract
 import (
 	"context"
 	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/google/uuid"
 )
 
 type IDeviceReadRepository interface {
+	Find(ctx context.Context, id int64) (*aggregate.DeviceAggregate, error)
+	FindForUpdate(ctx context.Context, id int64) (*aggregate.DeviceAggregate, error)
 	FindByDevice(ctx context.Context, userID string, deviceType, deviceID string) (*aggregate.DeviceAggregate, error)
-	FindByRefreshToken(ctx context.Context, refreshToken string) (*aggregate.DeviceAggregate, error)
+	FindByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*aggregate.DeviceAggregate, error)
+	FindActiveByUserID(ctx context.Context, userID string) ([]*entity.DeviceEntity, error)
 }
 
 type IDeviceWriteRepository interface {
 	Create(ctx context.Context, device *aggregate.DeviceAggregate) (*aggregate.DeviceAggregate, error)
 	Update(ctx context.Context, device *aggregate.DeviceAggregate) (*aggregate.DeviceAggregate, error)
+	RevokeByUserID(ctx context.Context, userID string) error
+	RevokeOthersByUserID(ctx context.Context, userID string, exceptID int64) error
+	// HashLegacyRefreshTokens replace refresh tokens stored in clear by their hash, returns the number of devices migrated
+	HashLegacyRefreshTokens(ctx context.Context, hash func(refreshToken string) string) (int, error)
 }
 
 type IDeviceRepository interface {
@@ -20,3 +30,19 @@ type IDeviceRepository interface {
 	IDeviceReadRepository
 	IDeviceWriteRepository
 }
+
+type IRotatedRefreshTokenReadRepository interface {
+	FindByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*entity.RotatedRefreshTokenEntity, error)
+}
+
+type IRotatedRefreshTokenWriteRepository interface {
+	Create(ctx context.Context, token *entity.RotatedRefreshTokenEntity) (*entity.RotatedRefreshTokenEntity, error)
+	DeleteByFamily(ctx context.Context, family uuid.UUID) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type IRotatedRefreshTokenRepository interface {
+	ITransaction
+	IRotatedRefreshTokenReadRepository
+	IRotatedRefreshTokenWriteRepository
+}
@@ -0,0 +1,26 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/google/uuid"
+)
+
+type IIdentityProviderReadRepository interface {
+	Find(ctx context.Context, id uuid.UUID) (*entity.IdentityProviderEntity, error)
+	FindByName(ctx context.Context, applicationID uuid.UUID, name string) (*entity.IdentityProviderEntity, error)
+	FindByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]*entity.IdentityProviderEntity, error)
+}
+
+type IIdentityProviderWriteRepository interface {
+	Create(ctx context.Context, provider *entity.IdentityProviderEntity) (*entity.IdentityProviderEntity, error)
+	Update(ctx context.Context, provider *entity.IdentityProviderEntity) (*entity.IdentityProviderEntity, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type IIdentityProviderRepository interface {
+	ITransaction
+	IIdentityProviderReadRepository
+	IIdentityProviderWriteRepository
+}
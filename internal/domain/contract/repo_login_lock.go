@@ -0,0 +1,30 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ILoginLockReadRepository interface {
+	Find(ctx context.Context, id uuid.UUID) (*entity.LoginLockEntity, error)
+	FindBySubject(ctx context.Context, applicationName string, scope enum.LoginLockScope, subject string) (*entity.LoginLockEntity, error)
+	FindBySubjectForUpdate(ctx context.Context, applicationName string, scope enum.LoginLockScope, subject string) (*entity.LoginLockEntity, error)
+	// FindLocked locks still active at the given time, all applications when applicationName is empty
+	FindLocked(ctx context.Context, applicationName string, at time.Time) ([]*entity.LoginLockEntity, error)
+}
+
+type ILoginLockWriteRepository interface {
+	Create(ctx context.Context, lock *entity.LoginLockEntity) (*entity.LoginLockEntity, error)
+	Update(ctx context.Context, lock *entity.LoginLockEntity) (*entity.LoginLockEntity, error)
+	Delete(ctx context.Context, lock *entity.LoginLockEntity) error
+}
+
+type ILoginLockRepository interface {
+	ITransaction
+	ILoginLockReadRepository
+	ILoginLockWriteRepository
+}
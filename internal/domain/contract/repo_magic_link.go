@@ -0,0 +1,26 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/google/uuid"
+)
+
+type IMagicLinkReadRepository interface {
+	Find(ctx context.Context, id uuid.UUID) (*entity.MagicLinkEntity, error)
+	FindForUpdate(ctx context.Context, id uuid.UUID) (*entity.MagicLinkEntity, error)
+}
+
+type IMagicLinkWriteRepository interface {
+	Create(ctx context.Context, link *entity.MagicLinkEntity) (*entity.MagicLinkEntity, error)
+	Confirm(ctx context.Context, link *entity.MagicLinkEntity) error
+	Delete(ctx context.Context, link *entity.MagicLinkEntity) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type IMagicLinkRepository interface {
+	ITransaction
+	IMagicLinkReadRepository
+	IMagicLinkWriteRepository
+}
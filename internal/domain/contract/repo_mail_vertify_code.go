@@ -8,6 +8,7 @@ import (
 
 type IMailVertifyCodeReadRepository interface {
 	Find(ctx context.Context, email string, codetype enum.VertificationCodeType) (*entity.MailVertifyCodeEntity, error)
+	FindForUpdate(ctx context.Context, email string, codetype enum.VertificationCodeType) (*entity.MailVertifyCodeEntity, error)
 }
 
 type IMailVertifyCodeWriteRepository interface {
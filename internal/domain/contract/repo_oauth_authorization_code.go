@@ -0,0 +1,22 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+)
+
+type IOAuthAuthorizationCodeReadRepository interface {
+	FindByCodeHashForUpdate(ctx context.Context, codeHash string) (*entity.OAuthAuthorizationCodeEntity, error)
+}
+
+type IOAuthAuthorizationCodeWriteRepository interface {
+	Create(ctx context.Context, code *entity.OAuthAuthorizationCodeEntity) (*entity.OAuthAuthorizationCodeEntity, error)
+	Delete(ctx context.Context, code *entity.OAuthAuthorizationCodeEntity) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type IOAuthAuthorizationCodeRepository interface {
+	ITransaction
+	IOAuthAuthorizationCodeReadRepository
+	IOAuthAuthorizationCodeWriteRepository
+}
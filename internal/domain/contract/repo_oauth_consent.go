@@ -0,0 +1,23 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/google/uuid"
+)
+
+type IOAuthConsentReadRepository interface {
+	Find(ctx context.Context, userID string, applicationID uuid.UUID) (*entity.OAuthConsentEntity, error)
+}
+
+type IOAuthConsentWriteRepository interface {
+	Create(ctx context.Context, consent *entity.OAuthConsentEntity) (*entity.OAuthConsentEntity, error)
+	Update(ctx context.Context, consent *entity.OAuthConsentEntity) (*entity.OAuthConsentEntity, error)
+}
+
+type IOAuthConsentRepository interface {
+	ITransaction
+	IOAuthConsentReadRepository
+	IOAuthConsentWriteRepository
+}
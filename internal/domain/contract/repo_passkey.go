@@ -0,0 +1,42 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/google/uuid"
+)
+
+type IPasskeyCredentialReadRepository interface {
+	Find(ctx context.Context, id uuid.UUID) (*entity.PasskeyCredentialEntity, error)
+	FindByCredentialIDForUpdate(ctx context.Context, credentialID string) (*entity.PasskeyCredentialEntity, error)
+	FindByUserID(ctx context.Context, userID string) ([]*entity.PasskeyCredentialEntity, error)
+}
+
+type IPasskeyCredentialWriteRepository interface {
+	Create(ctx context.Context, credential *entity.PasskeyCredentialEntity) (*entity.PasskeyCredentialEntity, error)
+	Update(ctx context.Context, credential *entity.PasskeyCredentialEntity) (*entity.PasskeyCredentialEntity, error)
+	Delete(ctx context.Context, credential *entity.PasskeyCredentialEntity) error
+}
+
+type IPasskeyCredentialRepository interface {
+	ITransaction
+	IPasskeyCredentialReadRepository
+	IPasskeyCredentialWriteRepository
+}
+
+type IWebAuthnChallengeReadRepository interface {
+	FindByChallengeForUpdate(ctx context.Context, challenge string) (*entity.WebAuthnChallengeEntity, error)
+}
+
+type IWebAuthnChallengeWriteRepository interface {
+	Create(ctx context.Context, challenge *entity.WebAuthnChallengeEntity) (*entity.WebAuthnChallengeEntity, error)
+	Delete(ctx context.Context, challenge *entity.WebAuthnChallengeEntity) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type IWebAuthnChallengeRepository interface {
+	ITransaction
+	IWebAuthnChallengeReadRepository
+	IWebAuthnChallengeWriteRepository
+}
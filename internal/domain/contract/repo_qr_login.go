@@ -0,0 +1,25 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/google/uuid"
+)
+
+type IQRLoginReadRepository interface {
+	FindForUpdate(ctx context.Context, id uuid.UUID) (*entity.QRLoginEntity, error)
+}
+
+type IQRLoginWriteRepository interface {
+	Create(ctx context.Context, login *entity.QRLoginEntity) (*entity.QRLoginEntity, error)
+	Update(ctx context.Context, login *entity.QRLoginEntity) error
+	Delete(ctx context.Context, login *entity.QRLoginEntity) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type IQRLoginRepository interface {
+	ITransaction
+	IQRLoginReadRepository
+	IQRLoginWriteRepository
+}
@@ -0,0 +1,25 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/google/uuid"
+)
+
+type IQyWechatCorpReadRepository interface {
+	FindByCorpID(ctx context.Context, corpID string) (*entity.QyWechatCorpEntity, error)
+	FindByOrganizationID(ctx context.Context, organizationID uuid.UUID) (*entity.QyWechatCorpEntity, error)
+}
+
+type IQyWechatCorpWriteRepository interface {
+	Create(ctx context.Context, corp *entity.QyWechatCorpEntity) (*entity.QyWechatCorpEntity, error)
+	Update(ctx context.Context, corp *entity.QyWechatCorpEntity) (*entity.QyWechatCorpEntity, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type IQyWechatCorpRepository interface {
+	ITransaction
+	IQyWechatCorpReadRepository
+	IQyWechatCorpWriteRepository
+}
@@ -0,0 +1,24 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/google/uuid"
+)
+
+type ISAMLConnectionReadRepository interface {
+	FindByOrganizationID(ctx context.Context, organizationID uuid.UUID) (*entity.SAMLConnectionEntity, error)
+}
+
+type ISAMLConnectionWriteRepository interface {
+	Create(ctx context.Context, connection *entity.SAMLConnectionEntity) (*entity.SAMLConnectionEntity, error)
+	Update(ctx context.Context, connection *entity.SAMLConnectionEntity) (*entity.SAMLConnectionEntity, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type ISAMLConnectionRepository interface {
+	ITransaction
+	ISAMLConnectionReadRepository
+	ISAMLConnectionWriteRepository
+}
@@ -0,0 +1,24 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+)
+
+type ISmsVerifyCodeReadRepository interface {
+	Find(ctx context.Context, phone string, codetype enum.VertificationCodeType) (*entity.SmsVerifyCodeEntity, error)
+	FindForUpdate(ctx context.Context, phone string, codetype enum.VertificationCodeType) (*entity.SmsVerifyCodeEntity, error)
+}
+
+type ISmsVerifyCodeWriteRepository interface {
+	Create(ctx context.Context, smsVerifyCode *entity.SmsVerifyCodeEntity) error
+	Update(ctx context.Context, smsVerifyCode *entity.SmsVerifyCodeEntity) error
+	Delete(ctx context.Context, smsVerifyCode *entity.SmsVerifyCodeEntity) error
+}
+
+type ISmsVerifyCodeRepository interface {
+	ITransaction
+	ISmsVerifyCodeReadRepository
+	ISmsVerifyCodeWriteRepository
+}
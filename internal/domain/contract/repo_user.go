@@ -4,6 +4,7 @@ import (
 	"context"
 	"kiwi-user/internal/domain/model/aggregate"
 	"kiwi-user/internal/domain/model/entity"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,11 +16,15 @@ type IUserReadRepository interface {
 	FindByBindingForUpdate(ctx context.Context, applicationID uuid.UUID, binding *entity.BindingEntity) (*aggregate.UserAggregate, error)
 	FindWechatOpenIDByUserAndPlatform(ctx context.Context, userID string, platform string) (*entity.WechatOpenIDEntity, error)
 	FindByWechatOpenIDAndPlatformForUpdate(ctx context.Context, applicationID uuid.UUID, openID string, platform string) (*aggregate.UserAggregate, error)
+	// FindGuestIDs users still bound to their guest secret created before the time, paying guests are kept
+	FindGuestIDs(ctx context.Context, createdBefore time.Time, limit int) ([]string, error)
 }
 
 type IUserWriteRepository interface {
 	Update(ctx context.Context, user *aggregate.UserAggregate) (*aggregate.UserAggregate, error)
 	Create(ctx context.Context, user *aggregate.UserAggregate) (*aggregate.UserAggregate, error)
+	DeleteBinding(ctx context.Context, bindingID uuid.UUID) error
+	Delete(ctx context.Context, user *aggregate.UserAggregate) error
 }
 
 type IUserRepository interface {
@@ -0,0 +1,25 @@
+package contract
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/google/uuid"
+)
+
+type IWechatScanLoginReadRepository interface {
+	FindForUpdate(ctx context.Context, id uuid.UUID) (*entity.WechatScanLoginEntity, error)
+}
+
+type IWechatScanLoginWriteRepository interface {
+	Create(ctx context.Context, login *entity.WechatScanLoginEntity) (*entity.WechatScanLoginEntity, error)
+	Update(ctx context.Context, login *entity.WechatScanLoginEntity) error
+	Delete(ctx context.Context, login *entity.WechatScanLoginEntity) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type IWechatScanLoginRepository interface {
+	ITransaction
+	IWechatScanLoginReadRepository
+	IWechatScanLoginWriteRepository
+}
@@ -7,4 +7,6 @@ type OrganizationUserAggregate struct {
 	Application      *entity.ApplicationEntity
 	User             *entity.UserEntity
 	OrganizationRole *entity.RoleEntity
+	// Department synced from the identity provider of saml logins
+	Department string
 }
@@ -5,4 +5,8 @@ import "github.com/google/uuid"
 type ApplicationEntity struct {
 	ID   uuid.UUID
 	Name string
+
+	// oauth client settings, the application name is used as client_id
+	ClientSecretHash string
+	RedirectURIs     []string
 }
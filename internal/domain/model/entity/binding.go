@@ -14,4 +14,5 @@ type BindingEntity struct {
 	Email         string
 	Verified      bool
 	Salt          string
+	Secret        string
 }
@@ -7,10 +7,32 @@ import (
 )
 
 type DeviceEntity struct {
-	ID                    int64
-	DeviceType            string
-	DeviceID              string
-	OrganizationID        uuid.UUID
+	ID             int64
+	DeviceType     string
+	DeviceID       string
+	OrganizationID uuid.UUID
+	// RefreshToken only set on the entity that issued it, the token is never stored
 	RefreshToken          string
+	RefreshTokenHash      string
 	RefreshTokenExpiresAt time.Time
+	RefreshTokenFamily    uuid.UUID
+	// client of the latest login or refresh
+	LastIP          string
+	UserAgent       string
+	LastRefreshedAt time.Time
+	// OAuthScope scope granted to the client of an oauth session
+	OAuthScope string
+	CreatedAt  time.Time
+}
+
+// RotatedRefreshTokenEntity a refresh token replaced by a rotation
+type RotatedRefreshTokenEntity struct {
+	ID               uuid.UUID
+	RefreshTokenHash string
+	Family           uuid.UUID
+	DeviceID         int64
+	RotatedAt        time.Time
+	// Successor the replacing token encrypted under this one
+	Successor string
+	ExpiresAt time.Time
 }
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type IdentityProviderEntity struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	Name          string
+	DisplayName   string
+	Issuer        string
+	ClientID      string
+	// ClientSecret encrypted at rest
+	ClientSecret          string
+	Scopes                []string
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	UserinfoEndpoint      string
+	JWKSURI               string
+	ClaimMapping          map[string]string
+	Enabled               bool
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LoginLockEntity struct {
+	ID              uuid.UUID
+	ApplicationName string
+	Scope           enum.LoginLockScope
+	Subject         string
+	Failures        int
+	LastFailureAt   time.Time
+	NextAttemptAt   time.Time
+	LockedUntil     time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MagicLinkEntity struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	Email         string
+	DeviceType    string
+	DeviceID      string
+	PollTokenHash string
+	ClientIP      string
+	UserAgent     string
+	// Confirmed set once the link in the email was opened
+	Confirmed bool
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
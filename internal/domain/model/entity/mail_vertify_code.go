@@ -10,7 +10,8 @@ import (
 type MailVertifyCodeEntity struct {
 	ID        uuid.UUID
 	Email     string
-	Code      string
+	CodeHash  string
 	Type      enum.VertificationCodeType
+	Attempts  int
 	ExpiresAt time.Time
 }
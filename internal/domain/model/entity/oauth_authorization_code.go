@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OAuthAuthorizationCodeEntity struct {
+	ID                  uuid.UUID
+	CodeHash            string
+	ApplicationID       uuid.UUID
+	UserID              string
+	RedirectURI         string
+	Scope               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	ExpiresAt           time.Time
+}
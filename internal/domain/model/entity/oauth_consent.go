@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OAuthConsentEntity struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	UserID        string
+	Scope         string
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasskeyCredentialEntity struct {
+	ID           uuid.UUID
+	UserID       string
+	CredentialID string
+	PublicKey    []byte
+	SignCount    uint32
+	Transports   []string
+	AAGUID       string
+	Name         string
+	CreatedAt    time.Time
+	LastUsedAt   time.Time
+}
+
+type WebAuthnChallengeEntity struct {
+	ID            uuid.UUID
+	Type          enum.WebAuthnChallengeType
+	Challenge     string
+	ApplicationID uuid.UUID
+	UserID        string
+	ExpiresAt     time.Time
+}
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type QRLoginEntity struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	DeviceType    string
+	DeviceID      string
+	PollTokenHash string
+	ClientIP      string
+	UserAgent     string
+	Status        enum.QRLoginStatus
+	// UserID the user who scanned the code
+	UserID    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
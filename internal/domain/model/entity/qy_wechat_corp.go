@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QyWechatCorpEntity the WeCom corp mapped to an organization
+type QyWechatCorpEntity struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	CorpID         string
+	// CorpSecret encrypted secret of the self built app members log in with
+	CorpSecret string
+	Enabled    bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SAMLConnectionEntity the SAML identity provider of an organization
+type SAMLConnectionEntity struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	IdPEntityID    string
+	IdPSSOURL      string
+	// IdPCertificates base64 DER signing certificates
+	IdPCertificates []string
+	IdPMetadata     string
+	// AttributeMapping saml attribute names by name, email and department
+	AttributeMapping map[string]string
+	// RedirectURL the frontend page the assertion consumer service sends the login ticket to
+	RedirectURL string
+	Enabled     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SmsVerifyCodeEntity struct {
+	ID        uuid.UUID
+	Phone     string
+	CodeHash  string
+	Type      enum.VertificationCodeType
+	Attempts  int
+	ExpiresAt time.Time
+}
@@ -7,6 +7,8 @@ type UserEntity struct {
 	Avatar          string
 	RefferalChannel UserRefferalChannel
 	Department      string
+	// Banned refused every login and token refresh until unbanned
+	Banned bool
 }
 
 type UserRefferalChannel struct {
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WechatScanLoginEntity struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	DeviceType    string
+	DeviceID      string
+	PollTokenHash string
+	// OpenID and UnionID of the wechat user who scanned the code, empty until scanned
+	OpenID    string
+	UnionID   string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// Scanned the scan event of the code was received
+func (w *WechatScanLoginEntity) Scanned() bool {
+	return w.OpenID != ""
+}
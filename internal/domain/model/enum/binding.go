@@ -11,12 +11,26 @@ const (
 	BindingTypePassword BindingType = "password"
 	BindingTypeEmail    BindingType = "email"
 	BindingTypeGoogle   BindingType = "google"
+	BindingTypeTOTP     BindingType = "totp"
+	BindingTypeApple    BindingType = "apple"
+	// BindingTypeOIDC external OpenID Connect providers, the identity is qualified by the issuer
+	BindingTypeOIDC BindingType = "oidc"
+	// BindingTypeSAML organization SAML identity providers, the identity is qualified by the organization id
+	BindingTypeSAML BindingType = "saml"
+	// BindingTypeGuest anonymous users, the identity is the hash of the secret issued to the guest
+	BindingTypeGuest BindingType = "guest"
 )
 
 func (b BindingType) String() string {
 	return string(b)
 }
 
+// IsLoginMethod reports bindings a user can sign in with, a second factor alone is not one and
+// neither is the secret of a guest, it is dropped once the guest links a real identity
+func (b BindingType) IsLoginMethod() bool {
+	return b != BindingTypeTOTP && b != BindingTypeGuest && b != BindingUnknown
+}
+
 func GetAllBindingTypes() []BindingType {
 	return []BindingType{
 		BindingTypeWechat,
@@ -26,6 +40,11 @@ func GetAllBindingTypes() []BindingType {
 		BindingTypePassword,
 		BindingTypeEmail,
 		BindingTypeGoogle,
+		BindingTypeTOTP,
+		BindingTypeApple,
+		BindingTypeOIDC,
+		BindingTypeSAML,
+		BindingTypeGuest,
 		BindingUnknown,
 	}
 }
@@ -46,6 +65,16 @@ func ParseBindingType(bindingType string) BindingType {
 		return BindingTypeEmail
 	case "google":
 		return BindingTypeGoogle
+	case "totp":
+		return BindingTypeTOTP
+	case "apple":
+		return BindingTypeApple
+	case "oidc":
+		return BindingTypeOIDC
+	case "saml":
+		return BindingTypeSAML
+	case "guest":
+		return BindingTypeGuest
 	default:
 		return BindingUnknown
 	}
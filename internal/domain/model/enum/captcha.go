@@ -0,0 +1,25 @@
+package enum
+
+type CaptchaPolicy string
+
+const (
+	CaptchaPolicyOff    CaptchaPolicy = "off"
+	CaptchaPolicyAlways CaptchaPolicy = "always"
+	// CaptchaPolicyRisk a captcha is only required once a risk threshold is reached
+	CaptchaPolicyRisk CaptchaPolicy = "risk"
+)
+
+func (c CaptchaPolicy) String() string {
+	return string(c)
+}
+
+func ParseCaptchaPolicy(s string) CaptchaPolicy {
+	switch s {
+	case "always":
+		return CaptchaPolicyAlways
+	case "risk":
+		return CaptchaPolicyRisk
+	default:
+		return CaptchaPolicyOff
+	}
+}
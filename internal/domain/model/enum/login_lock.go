@@ -0,0 +1,32 @@
+package enum
+
+type LoginLockScope string
+
+const (
+	LoginLockScopeUser    LoginLockScope = "user"
+	LoginLockScopeIP      LoginLockScope = "ip"
+	LoginLockScopeUnknown LoginLockScope = "unknown"
+)
+
+func (l LoginLockScope) String() string {
+	return string(l)
+}
+
+func GetAllLoginLockScopes() []LoginLockScope {
+	return []LoginLockScope{
+		LoginLockScopeUser,
+		LoginLockScopeIP,
+		LoginLockScopeUnknown,
+	}
+}
+
+func ParseLoginLockScope(s string) LoginLockScope {
+	switch s {
+	case "user":
+		return LoginLockScopeUser
+	case "ip":
+		return LoginLockScopeIP
+	default:
+		return LoginLockScopeUnknown
+	}
+}
@@ -0,0 +1,40 @@
+package enum
+
+type QRLoginStatus string
+
+const (
+	QRLoginStatusPending  QRLoginStatus = "pending"
+	QRLoginStatusScanned  QRLoginStatus = "scanned"
+	QRLoginStatusApproved QRLoginStatus = "approved"
+	QRLoginStatusRejected QRLoginStatus = "rejected"
+	QRLoginStatusUnknown  QRLoginStatus = "unknown"
+)
+
+func (q QRLoginStatus) String() string {
+	return string(q)
+}
+
+func GetAllQRLoginStatuses() []QRLoginStatus {
+	return []QRLoginStatus{
+		QRLoginStatusPending,
+		QRLoginStatusScanned,
+		QRLoginStatusApproved,
+		QRLoginStatusRejected,
+		QRLoginStatusUnknown,
+	}
+}
+
+func ParseQRLoginStatus(s string) QRLoginStatus {
+	switch s {
+	case "pending":
+		return QRLoginStatusPending
+	case "scanned":
+		return QRLoginStatusScanned
+	case "approved":
+		return QRLoginStatusApproved
+	case "rejected":
+		return QRLoginStatusRejected
+	default:
+		return QRLoginStatusUnknown
+	}
+}
@@ -1,10 +1,14 @@
 package enum
 
+// VertificationCodeType purpose of an email code, every purpose has its own code in flight
 type VertificationCodeType string
 
 const (
-	VertificationCodeTypeLogin   VertificationCodeType = "login"
-	VertificationCodeTypeUnknown VertificationCodeType = "unknown"
+	VertificationCodeTypeLogin         VertificationCodeType = "login"
+	VertificationCodeTypePasswordReset VertificationCodeType = "password_reset"
+	VertificationCodeTypeBindEmail     VertificationCodeType = "bind_email"
+	VertificationCodeTypeChangeEmail   VertificationCodeType = "change_email"
+	VertificationCodeTypeUnknown       VertificationCodeType = "unknown"
 )
 
 func (v VertificationCodeType) String() string {
@@ -14,6 +18,9 @@ func (v VertificationCodeType) String() string {
 func GetAllVertificationCodeTypes() []VertificationCodeType {
 	return []VertificationCodeType{
 		VertificationCodeTypeLogin,
+		VertificationCodeTypePasswordReset,
+		VertificationCodeTypeBindEmail,
+		VertificationCodeTypeChangeEmail,
 		VertificationCodeTypeUnknown,
 	}
 }
@@ -22,6 +29,12 @@ func ParseVertificationCodeType(s string) VertificationCodeType {
 	switch s {
 	case "login":
 		return VertificationCodeTypeLogin
+	case "password_reset", "reset_password":
+		return VertificationCodeTypePasswordReset
+	case "bind_email":
+		return VertificationCodeTypeBindEmail
+	case "change_email":
+		return VertificationCodeTypeChangeEmail
 	default:
 		return VertificationCodeTypeUnknown
 	}
@@ -0,0 +1,32 @@
+package enum
+
+type WebAuthnChallengeType string
+
+const (
+	WebAuthnChallengeTypeRegistration WebAuthnChallengeType = "registration"
+	WebAuthnChallengeTypeLogin        WebAuthnChallengeType = "login"
+	WebAuthnChallengeTypeUnknown      WebAuthnChallengeType = "unknown"
+)
+
+func (w WebAuthnChallengeType) String() string {
+	return string(w)
+}
+
+func GetAllWebAuthnChallengeTypes() []WebAuthnChallengeType {
+	return []WebAuthnChallengeType{
+		WebAuthnChallengeTypeRegistration,
+		WebAuthnChallengeTypeLogin,
+		WebAuthnChallengeTypeUnknown,
+	}
+}
+
+func ParseWebAuthnChallengeType(s string) WebAuthnChallengeType {
+	switch s {
+	case "registration":
+		return WebAuthnChallengeTypeRegistration
+	case "login":
+		return WebAuthnChallengeTypeLogin
+	default:
+		return WebAuthnChallengeTypeUnknown
+	}
+}
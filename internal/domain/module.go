@@ -18,4 +18,40 @@ var Module = fx.Provide(
 	service.NewStripePaymentService,
 	service.NewOrganizationApplicationService,
 	service.NewVertificationCodeService,
+	service.NewSmsService,
+	service.NewOAuthService,
+	service.NewMFAService,
+	service.NewPasskeyService,
+	service.NewLoginProtectionService,
+	service.NewRateLimitService,
+	service.NewCaptchaService,
+	service.NewFederationService,
+	service.NewSAMLService,
+	service.NewQyWechatService,
+	service.NewMagicLinkService,
+	service.NewQRLoginService,
+	service.NewWechatScanLoginService,
+	service.NewGuestService,
+
+	// login providers
+	fx.Annotate(
+		service.NewLoginProviderRegistry,
+		fx.ParamTags(`group:"login_providers"`),
+	),
+	loginProvider(service.NewWechatLoginProvider),
+	loginProvider(service.NewWechatMiniProgramLoginProvider),
+	loginProvider(service.NewQyWechatLoginProvider),
+	loginProvider(service.NewGoogleLoginProvider),
+	loginProvider(service.NewAppleLoginProvider),
+	loginProvider(service.NewPhoneLoginProvider),
+	loginProvider(service.NewEmailLoginProvider),
 )
+
+// loginProvider registers the constructor of a provider with the login provider registry
+func loginProvider(constructor any) any {
+	return fx.Annotate(
+		constructor,
+		fx.As(new(service.LoginProvider)),
+		fx.ResultTags(`group:"login_providers"`),
+	)
+}
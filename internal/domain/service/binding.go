@@ -5,59 +5,229 @@ import (
 	"kiwi-user/internal/domain/contract"
 	"kiwi-user/internal/domain/model/aggregate"
 	"kiwi-user/internal/domain/model/entity"
-	"time"
+	"kiwi-user/internal/domain/model/enum"
+	"strings"
 
 	"github.com/futurxlab/golanggraph/xerror"
 )
 
+// BindingService links login methods to an existing user. The ownership of the identity is proven
+// by the caller, the service keeps an identity with a single user and every user with a way to log in.
 type BindingService struct {
-	userRepository          contract.IUserRepository
-	bindingVerifyRepository contract.IBindingVerifyRepository
+	userRepository              contract.IUserRepository
+	applicationRepository       contract.IApplicationReadRepository
+	passkeyCredentialRepository contract.IPasskeyCredentialRepository
+	vertificationCodeService    *VertificationCodeService
 }
 
 func NewBindingService(
-	bindingVerifyRepository contract.IBindingVerifyRepository,
+	userRepository contract.IUserRepository,
+	applicationRepository contract.IApplicationReadRepository,
+	passkeyCredentialRepository contract.IPasskeyCredentialRepository,
+	vertificationCodeService *VertificationCodeService,
 ) *BindingService {
 	return &BindingService{
-		bindingVerifyRepository: bindingVerifyRepository,
+		userRepository:              userRepository,
+		applicationRepository:       applicationRepository,
+		passkeyCredentialRepository: passkeyCredentialRepository,
+		vertificationCodeService:    vertificationCodeService,
 	}
 }
 
-func (b *BindingService) CreateBindingVerify(ctx context.Context, bindingVerify *entity.BindingVerifyEntity) (*entity.BindingVerifyEntity, error) {
-	return b.bindingVerifyRepository.Create(ctx, bindingVerify)
+// SendEmailVerifyCode mails a code proving the user owns the email. The code has its own purpose, a
+// login or password reset code of the same address stays valid.
+func (b *BindingService) SendEmailVerifyCode(ctx context.Context, email string) error {
+	if err := b.vertificationCodeService.SendEmailVerificationCode(ctx, email, enum.VertificationCodeTypeBindEmail); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
 }
 
-func (b *BindingService) UpdateBindingVerify(ctx context.Context, bindingVerify *entity.BindingVerifyEntity) (*entity.BindingVerifyEntity, error) {
-	return b.bindingVerifyRepository.Update(ctx, bindingVerify)
+// VerifyEmailCode consumes the code sent by SendEmailVerifyCode
+func (b *BindingService) VerifyEmailCode(ctx context.Context, email string, code string) error {
+	verified, err := b.vertificationCodeService.VerifyEmailCode(ctx, email, code, enum.VertificationCodeTypeBindEmail)
+	if err != nil {
+		if xerror.Is(err, ErrVerificationCodeNotFound) || xerror.Is(err, ErrVerificationCodeInvalid) {
+			return ErrBindingVerifyCodeInvalid
+		}
+		return xerror.Wrap(err)
+	}
+
+	if !verified {
+		return ErrBindingVerifyCodeInvalid
+	}
+
+	return nil
 }
 
-func (b *BindingService) BindingCodeVerified(ctx context.Context, userAggregate *aggregate.UserAggregate, bindingVerify *entity.BindingVerifyEntity) error {
-	err := b.bindingVerifyRepository.WithTransaction(ctx, func(ctx context.Context) error {
-		// update binding verify
-		bindingVerify.VerifiedAt = time.Now()
-		if _, err := b.bindingVerifyRepository.Update(ctx, bindingVerify); err != nil {
+// HasBindingOfKind reports whether the user holds a binding of the kind of binding, Link refuses
+// another one
+func (b *BindingService) HasBindingOfKind(userAggregate *aggregate.UserAggregate, binding *entity.BindingEntity) bool {
+	kind := bindingKind(binding)
+	for _, existing := range userAggregate.Bindings {
+		if bindingKind(existing) == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Link adds the binding to the user. A linked binding is never replaced, a session alone must not be
+// enough to move the account to another phone or account: the old binding is unlinked first, which
+// needs another way to log in. A guest linking its first identity is upgraded to a full account with
+// the same user id.
+func (b *BindingService) Link(ctx context.Context, userAggregate *aggregate.UserAggregate, binding *entity.BindingEntity) (*aggregate.UserAggregate, error) {
+	if !binding.Type.IsLoginMethod() || binding.Type == enum.BindingTypePassword {
+		return nil, ErrBindingNotLinkable
+	}
+
+	binding.ApplicationID = userAggregate.Application.ID
+	binding.Verified = true
+
+	if err := b.userRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		// locks the identity, concurrent links of it are serialized
+		owner, err := b.userRepository.FindByBindingForUpdate(ctx, userAggregate.Application.ID, binding)
+		if err != nil {
 			return xerror.Wrap(err)
 		}
 
-		// create user binding
-		userAggregate.Bindings = append(userAggregate.Bindings, &entity.BindingEntity{
-			Type:     bindingVerify.Type,
-			Identity: bindingVerify.Identity,
-			Verified: true,
-		})
+		if owner != nil {
+			if owner.User.ID != userAggregate.User.ID {
+				return ErrBindingConflict
+			}
+			return ErrBindingAlreadyLinked
+		}
 
-		_, err := b.userRepository.Update(ctx, userAggregate)
+		if b.HasBindingOfKind(userAggregate, binding) {
+			return ErrBindingKindLinked
+		}
+		userAggregate.Bindings = append(userAggregate.Bindings, binding)
 
+		if err := b.upgradeGuest(ctx, userAggregate); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		userAggregate, err = b.userRepository.Update(ctx, userAggregate)
 		if err != nil {
 			return xerror.Wrap(err)
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return userAggregate, nil
+}
+
+// upgradeGuest drops the guest binding and grants the default personal role, the guest secret no
+// longer logs in as this user
+func (b *BindingService) upgradeGuest(ctx context.Context, userAggregate *aggregate.UserAggregate) error {
+	guestBinding := findBinding(userAggregate.Bindings, enum.BindingTypeGuest)
+	if guestBinding == nil {
+		return nil
+	}
 
+	if err := b.userRepository.DeleteBinding(ctx, guestBinding.ID); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	bindings := make([]*entity.BindingEntity, 0, len(userAggregate.Bindings))
+	for _, other := range userAggregate.Bindings {
+		if other.ID != guestBinding.ID {
+			bindings = append(bindings, other)
+		}
+	}
+	userAggregate.Bindings = bindings
+
+	applicationAggregate, err := b.applicationRepository.FindByName(ctx, userAggregate.Application.Name)
 	if err != nil {
 		return xerror.Wrap(err)
 	}
 
+	if applicationAggregate != nil && applicationAggregate.DefaultPersonalRole != nil {
+		userAggregate.PersonalRole = applicationAggregate.DefaultPersonalRole
+	}
+
+	return nil
+}
+
+// Unlink removes the binding of the type, unless the user could not log in anymore
+func (b *BindingService) Unlink(ctx context.Context, userAggregate *aggregate.UserAggregate, bindingType enum.BindingType) (*aggregate.UserAggregate, error) {
+	// second factors are removed by disabling them
+	if !bindingType.IsLoginMethod() {
+		return nil, ErrBindingNotLinkable
+	}
+
+	binding := findBinding(userAggregate.Bindings, bindingType)
+	if binding == nil {
+		return nil, ErrBindingNotFound
+	}
+
+	loginMethods, err := b.CountLoginMethods(ctx, userAggregate)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if binding.Verified && loginMethods <= 1 {
+		return nil, ErrLastLoginMethod
+	}
+
+	if err := b.userRepository.DeleteBinding(ctx, binding.ID); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	bindings := make([]*entity.BindingEntity, 0, len(userAggregate.Bindings))
+	for _, other := range userAggregate.Bindings {
+		if other.ID != binding.ID {
+			bindings = append(bindings, other)
+		}
+	}
+	userAggregate.Bindings = bindings
+
+	return userAggregate, nil
+}
+
+// CountLoginMethods verified bindings the user can log in with and its passkeys
+func (b *BindingService) CountLoginMethods(ctx context.Context, userAggregate *aggregate.UserAggregate) (int, error) {
+	count := 0
+	for _, binding := range userAggregate.Bindings {
+		if binding.Verified && binding.Type.IsLoginMethod() {
+			count++
+		}
+	}
+
+	credentials, err := b.passkeyCredentialRepository.FindByUserID(ctx, userAggregate.User.ID)
+	if err != nil {
+		return 0, xerror.Wrap(err)
+	}
+
+	return count + len(credentials), nil
+}
+
+// bindingKind a user holds one binding of each kind. Oidc identities are qualified by their issuer
+// and saml identities by their organization, one of every issuer and organization is allowed.
+func bindingKind(binding *entity.BindingEntity) string {
+	switch binding.Type {
+	case enum.BindingTypeOIDC:
+		issuer, _, _ := strings.Cut(binding.Identity, "#")
+		return binding.Type.String() + ":" + issuer
+	case enum.BindingTypeSAML:
+		organizationID, _, _ := strings.Cut(binding.Identity, ":")
+		return binding.Type.String() + ":" + organizationID
+	default:
+		return binding.Type.String()
+	}
+}
+
+func findBinding(bindings []*entity.BindingEntity, bindingType enum.BindingType) *entity.BindingEntity {
+	for _, binding := range bindings {
+		if binding.Type == bindingType {
+			return binding
+		}
+	}
+
 	return nil
 }
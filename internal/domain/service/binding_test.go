@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"testing"
+)
+
+func newTestBindingUser(t *testing.T, users *fakeUserRepository, application *aggregate.ApplicationAggregate, bindings ...*entity.BindingEntity) *aggregate.UserAggregate {
+	t.Helper()
+
+	for _, binding := range bindings {
+		binding.ApplicationID = application.Application.ID
+		binding.Verified = true
+	}
+
+	user, err := users.Create(context.Background(), &aggregate.UserAggregate{
+		User:         &entity.UserEntity{Name: "user"},
+		Application:  application.Application,
+		Bindings:     bindings,
+		PersonalRole: application.DefaultPersonalRole,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return user
+}
+
+func TestLinkRefusesReplacingBinding(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUserRepository()
+	application := newTestApplication()
+	b := &BindingService{userRepository: users, applicationRepository: &fakeApplicationRepository{application: application}}
+
+	user := newTestBindingUser(t, users, application, &entity.BindingEntity{Type: enum.BindingTypePhone, Identity: "+8613800000000"})
+
+	_, err := b.Link(ctx, user, &entity.BindingEntity{Type: enum.BindingTypePhone, Identity: "+8613900000000"})
+	if !errors.Is(err, ErrBindingKindLinked) {
+		t.Fatalf("link another phone: got %v, want %v", err, ErrBindingKindLinked)
+	}
+
+	if phone := findBinding(users.users[user.User.ID].Bindings, enum.BindingTypePhone); phone.Identity != "+8613800000000" {
+		t.Fatalf("phone changed to %s", phone.Identity)
+	}
+
+	// another type is added next to it
+	linked, err := b.Link(ctx, user, &entity.BindingEntity{Type: enum.BindingTypeEmail, Identity: "alice@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(linked.Bindings) != 2 {
+		t.Fatalf("unexpected bindings %+v", linked.Bindings)
+	}
+}
+
+func TestLinkOneBindingPerProvider(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUserRepository()
+	application := newTestApplication()
+	b := &BindingService{userRepository: users, applicationRepository: &fakeApplicationRepository{application: application}}
+
+	user := newTestBindingUser(t, users, application, &entity.BindingEntity{Type: enum.BindingTypeOIDC, Identity: FederatedIdentity("https://example.okta.com", "alice")})
+
+	if _, err := b.Link(ctx, user, &entity.BindingEntity{Type: enum.BindingTypeOIDC, Identity: FederatedIdentity("https://gitlab.com", "alice")}); err != nil {
+		t.Fatalf("link another provider: %v", err)
+	}
+
+	if _, err := b.Link(ctx, user, &entity.BindingEntity{Type: enum.BindingTypeOIDC, Identity: FederatedIdentity("https://example.okta.com/", "bob")}); !errors.Is(err, ErrBindingKindLinked) {
+		t.Fatalf("link another account of the provider: got %v, want %v", err, ErrBindingKindLinked)
+	}
+}
+
+func TestLinkIdentityOfAnotherUser(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUserRepository()
+	application := newTestApplication()
+	b := &BindingService{userRepository: users, applicationRepository: &fakeApplicationRepository{application: application}}
+
+	owner := newTestBindingUser(t, users, application, &entity.BindingEntity{Type: enum.BindingTypeEmail, Identity: "alice@example.com"})
+	other := newTestBindingUser(t, users, application, &entity.BindingEntity{Type: enum.BindingTypePhone, Identity: "+8613800000000"})
+
+	if _, err := b.Link(ctx, other, &entity.BindingEntity{Type: enum.BindingTypeEmail, Identity: "alice@example.com"}); !errors.Is(err, ErrBindingConflict) {
+		t.Fatalf("link the email of another user: got %v, want %v", err, ErrBindingConflict)
+	}
+
+	if _, err := b.Link(ctx, owner, &entity.BindingEntity{Type: enum.BindingTypeEmail, Identity: "alice@example.com"}); !errors.Is(err, ErrBindingAlreadyLinked) {
+		t.Fatalf("link the own email again: got %v, want %v", err, ErrBindingAlreadyLinked)
+	}
+
+	if _, err := b.Link(ctx, owner, &entity.BindingEntity{Type: enum.BindingTypeTOTP, Identity: "secret"}); !errors.Is(err, ErrBindingNotLinkable) {
+		t.Fatalf("link a second factor: got %v, want %v", err, ErrBindingNotLinkable)
+	}
+}
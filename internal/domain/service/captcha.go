@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/captcha"
+	"kiwi-user/internal/infrastructure/ratelimit"
+	"kiwi-user/internal/infrastructure/utils"
+	"strings"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+type captchaPolicy struct {
+	policy          enum.CaptchaPolicy
+	sceneID         string
+	targetThreshold int
+	ipThreshold     int
+	window          time.Duration
+	loginFailures   int
+}
+
+// CaptchaService requires a solved captcha before verification codes are sent and passwords are
+// checked. Each application has a policy: off, always, or risk where the captcha is only required
+// once the codes sent to a target or from a client ip, or the failed logins, reach a threshold.
+type CaptchaService struct {
+	verifier               captcha.Verifier
+	limiter                ratelimit.Limiter
+	loginProtectionService *LoginProtectionService
+	config                 *config.Config
+	logger                 logger.ILogger
+}
+
+func NewCaptchaService(
+	verifier captcha.Verifier,
+	limiter ratelimit.Limiter,
+	loginProtectionService *LoginProtectionService,
+	config *config.Config,
+	logger logger.ILogger) *CaptchaService {
+
+	return &CaptchaService{
+		verifier:               verifier,
+		limiter:                limiter,
+		loginProtectionService: loginProtectionService,
+		config:                 config,
+		logger:                 logger,
+	}
+}
+
+// CheckVerifyCode verifies the captcha param before a code is sent to the phone number or email
+// address, the client ip is taken from ctx. It returns ErrCaptchaRequired when the policy requires a
+// captcha and none was sent, ErrCaptchaInvalid when the param does not verify.
+func (c *CaptchaService) CheckVerifyCode(ctx context.Context, applicationName string, target string, param string) error {
+	policy := c.policy(applicationName)
+
+	switch policy.policy {
+	case enum.CaptchaPolicyAlways:
+		return c.verify(ctx, policy, param)
+	case enum.CaptchaPolicyRisk:
+		if !c.codeRisk(ctx, policy, applicationName, target) {
+			return nil
+		}
+		return c.verify(ctx, policy, param)
+	default:
+		return nil
+	}
+}
+
+// CheckPasswordLogin verifies the captcha param before the password of the user name is checked
+func (c *CaptchaService) CheckPasswordLogin(ctx context.Context, applicationName string, name string, ip string, param string) error {
+	policy := c.policy(applicationName)
+
+	switch policy.policy {
+	case enum.CaptchaPolicyAlways:
+		return c.verify(ctx, policy, param)
+	case enum.CaptchaPolicyRisk:
+		if policy.loginFailures > 0 {
+			failures, err := c.loginProtectionService.Failures(ctx, applicationName, name, ip)
+			if err != nil {
+				return xerror.Wrap(err)
+			}
+
+			if failures < policy.loginFailures {
+				return nil
+			}
+		}
+		return c.verify(ctx, policy, param)
+	default:
+		return nil
+	}
+}
+
+// codeRisk counts the code towards the thresholds of the target and the client ip, both are counted
+// so that each keeps its own window. A failing limiter is treated as risky.
+func (c *CaptchaService) codeRisk(ctx context.Context, policy *captchaPolicy, applicationName string, target string) bool {
+	target = strings.ToLower(strings.TrimSpace(target))
+
+	rules := []rateLimitRule{
+		{
+			key:   "captcha:target:" + applicationName + ":" + target,
+			limit: policy.targetThreshold,
+		},
+	}
+
+	if ip := utils.ClientFromContext(ctx).IP; ip != "" {
+		rules = append(rules, rateLimitRule{
+			key:   "captcha:ip:" + applicationName + ":" + ip,
+			limit: policy.ipThreshold,
+		})
+	}
+
+	risky := false
+	for _, rule := range rules {
+		if rule.limit <= 0 || policy.window <= 0 {
+			risky = true
+			continue
+		}
+
+		result, err := c.limiter.Allow(ctx, rule.key, rule.limit, policy.window)
+		if err != nil {
+			c.logger.Errorf(ctx, "captcha risk %s failed: %v", rule.key, err)
+			risky = true
+			continue
+		}
+
+		if !result.Allowed {
+			risky = true
+		}
+	}
+
+	return risky
+}
+
+// verify an outage of the captcha service lets requests through, rate limits still apply to them
+func (c *CaptchaService) verify(ctx context.Context, policy *captchaPolicy, param string) error {
+	if param == "" {
+		return xerror.Wrap(ErrCaptchaRequired)
+	}
+
+	ok, err := c.verifier.Verify(ctx, param, policy.sceneID)
+	if err != nil {
+		if xerror.Is(err, captcha.ErrNotConfigured) {
+			return xerror.Wrap(err)
+		}
+
+		c.logger.Errorf(ctx, "captcha verify failed: %v", err)
+		return nil
+	}
+
+	if !ok {
+		return xerror.Wrap(ErrCaptchaInvalid)
+	}
+
+	return nil
+}
+
+func (c *CaptchaService) policy(applicationName string) *captchaPolicy {
+	cfg := c.config.Captcha
+
+	policy := &captchaPolicy{
+		policy:          enum.ParseCaptchaPolicy(cfg.Policy),
+		sceneID:         cfg.SceneID,
+		targetThreshold: cfg.RiskTargetThreshold,
+		ipThreshold:     cfg.RiskIPThreshold,
+		window:          time.Duration(cfg.RiskWindowSecond) * time.Second,
+		loginFailures:   cfg.RiskLoginFailures,
+	}
+
+	override, ok := cfg.Applications[applicationName]
+	if !ok || override == nil {
+		return policy
+	}
+
+	if override.Policy != "" {
+		policy.policy = enum.ParseCaptchaPolicy(override.Policy)
+	}
+	if override.SceneID != "" {
+		policy.sceneID = override.SceneID
+	}
+	if override.RiskTargetThreshold != 0 {
+		policy.targetThreshold = override.RiskTargetThreshold
+	}
+	if override.RiskIPThreshold != 0 {
+		policy.ipThreshold = override.RiskIPThreshold
+	}
+	if override.RiskWindowSecond != 0 {
+		policy.window = time.Duration(override.RiskWindowSecond) * time.Second
+	}
+	if override.RiskLoginFailures != 0 {
+		policy.loginFailures = override.RiskLoginFailures
+	}
+
+	return policy
+}
@@ -2,28 +2,53 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
 	"kiwi-user/config"
 	"kiwi-user/internal/domain/contract"
 	"kiwi-user/internal/domain/model/aggregate"
 	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/revocation"
 	"kiwi-user/internal/infrastructure/utils"
+	"kiwi-user/internal/infrastructure/utils/aes"
 	"time"
 
+	"github.com/futurxlab/golanggraph/logger"
 	"github.com/futurxlab/golanggraph/xerror"
 	"github.com/google/uuid"
 )
 
 type DeviceService struct {
-	deviceRepository         contract.IDeviceRepository
-	refreshTokenExpireSecond int64
+	deviceRepository              contract.IDeviceRepository
+	rotatedRefreshTokenRepository contract.IRotatedRefreshTokenRepository
+	revocationStore               revocation.Store
+	logger                        logger.ILogger
+	refreshTokenExpireSecond      int64
+	refreshTokenGrace             time.Duration
+	refreshTokenHashKey           []byte
 }
 
-func NewDeviceService(config *config.Config, deviceRepository contract.IDeviceRepository) *DeviceService {
-	return &DeviceService{
-		deviceRepository:         deviceRepository,
-		refreshTokenExpireSecond: config.JWT.RefreshTokenExpireSecond,
+func NewDeviceService(
+	config *config.Config,
+	logger logger.ILogger,
+	deviceRepository contract.IDeviceRepository,
+	rotatedRefreshTokenRepository contract.IRotatedRefreshTokenRepository,
+	revocationStore revocation.Store) (*DeviceService, error) {
+
+	if config.JWT.RefreshTokenHashKey == "" {
+		return nil, xerror.New("jwt refresh_token_hash_key is required")
 	}
+
+	return &DeviceService{
+		deviceRepository:              deviceRepository,
+		rotatedRefreshTokenRepository: rotatedRefreshTokenRepository,
+		revocationStore:               revocationStore,
+		logger:                        logger,
+		refreshTokenExpireSecond:      config.JWT.RefreshTokenExpireSecond,
+		refreshTokenGrace:             time.Duration(config.JWT.RefreshTokenGraceSecond) * time.Second,
+		refreshTokenHashKey:           []byte(config.JWT.RefreshTokenHashKey),
+	}, nil
 }
 
 func (d *DeviceService) UpsertDevice(
@@ -38,18 +63,28 @@ func (d *DeviceService) UpsertDevice(
 		return nil, xerror.Wrap(err)
 	}
 
+	refreshToken, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	client := utils.ClientFromContext(ctx)
+	now := time.Now()
+
 	if deviceAggregate == nil {
 
 		deviceAggregate = &aggregate.DeviceAggregate{
 			Device: &entity.DeviceEntity{
-				DeviceType: deviceType,
-				DeviceID:   deviceID,
-				RefreshToken: utils.GenerateRefreshToken(fmt.Sprintf("%s%s%s",
-					userID,
-					deviceType,
-					deviceID)),
+				DeviceType:            deviceType,
+				DeviceID:              deviceID,
+				RefreshToken:          refreshToken,
+				RefreshTokenHash:      d.hashRefreshToken(refreshToken),
 				RefreshTokenExpiresAt: time.Now().Add(time.Duration(d.refreshTokenExpireSecond) * time.Second),
+				RefreshTokenFamily:    uuid.New(),
 				OrganizationID:        organizationID,
+				LastIP:                client.IP,
+				UserAgent:             client.UserAgent,
+				LastRefreshedAt:       now,
 			},
 			User: &entity.UserEntity{
 				ID: userID,
@@ -62,12 +97,14 @@ func (d *DeviceService) UpsertDevice(
 			return nil, xerror.Wrap(err)
 		}
 	} else {
-		deviceAggregate.Device.RefreshToken = utils.GenerateRefreshToken(fmt.Sprintf("%s%s%s",
-			userID,
-			deviceType,
-			deviceID))
+		deviceAggregate.Device.RefreshToken = refreshToken
+		deviceAggregate.Device.RefreshTokenHash = d.hashRefreshToken(refreshToken)
 		deviceAggregate.Device.RefreshTokenExpiresAt = time.Now().Add(time.Duration(d.refreshTokenExpireSecond) * time.Second)
+		deviceAggregate.Device.RefreshTokenFamily = uuid.New()
 		deviceAggregate.Device.OrganizationID = organizationID
+		deviceAggregate.Device.LastIP = client.IP
+		deviceAggregate.Device.UserAgent = client.UserAgent
+		deviceAggregate.Device.LastRefreshedAt = now
 
 		deviceAggregate, err = d.deviceRepository.Update(ctx, deviceAggregate)
 		if err != nil {
@@ -80,16 +117,18 @@ func (d *DeviceService) UpsertDevice(
 
 func (d *DeviceService) RegenerateRefreshToken(ctx context.Context, deviceAggregate *aggregate.DeviceAggregate, refreshExpireTime bool) (*aggregate.DeviceAggregate, error) {
 
-	deviceAggregate.Device.RefreshToken = utils.GenerateRefreshToken(fmt.Sprintf("%s%s%s",
-		deviceAggregate.User.ID,
-		deviceAggregate.Device.DeviceType,
-		deviceAggregate.Device.DeviceID))
+	refreshToken, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	deviceAggregate.Device.RefreshToken = refreshToken
+	deviceAggregate.Device.RefreshTokenHash = d.hashRefreshToken(refreshToken)
 
 	if refreshExpireTime {
 		deviceAggregate.Device.RefreshTokenExpiresAt = time.Now().Add(time.Duration(d.refreshTokenExpireSecond) * time.Second)
 	}
 
-	var err error
 	deviceAggregate, err = d.deviceRepository.Update(ctx, deviceAggregate)
 	if err != nil {
 		return nil, xerror.Wrap(err)
@@ -98,6 +137,171 @@ func (d *DeviceService) RegenerateRefreshToken(ctx context.Context, deviceAggreg
 	return deviceAggregate, nil
 }
 
+// MatchRefreshToken reports whether refreshToken is the current token of the device
+func (d *DeviceService) MatchRefreshToken(deviceAggregate *aggregate.DeviceAggregate, refreshToken string) bool {
+	return subtle.ConstantTimeCompare(
+		[]byte(d.hashRefreshToken(refreshToken)),
+		[]byte(deviceAggregate.Device.RefreshTokenHash)) == 1
+}
+
+// FindByRefreshToken find the device of a current or a rotated refresh token
+func (d *DeviceService) FindByRefreshToken(ctx context.Context, refreshToken string) (*aggregate.DeviceAggregate, error) {
+	refreshTokenHash := d.hashRefreshToken(refreshToken)
+
+	deviceAggregate, err := d.deviceRepository.FindByRefreshTokenHash(ctx, refreshTokenHash)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if deviceAggregate != nil {
+		return deviceAggregate, nil
+	}
+
+	rotated, err := d.rotatedRefreshTokenRepository.FindByRefreshTokenHash(ctx, refreshTokenHash)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if rotated == nil {
+		return nil, nil
+	}
+
+	deviceAggregate, err = d.deviceRepository.Find(ctx, rotated.DeviceID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return deviceAggregate, nil
+}
+
+// RotateRefreshToken exchange the presented refresh token of the device for a new one.
+// A token rotated within the grace window gets the current token of its family, an older one is
+// a replay of a leaked token: the family is revoked and ErrRefreshTokenReused returned.
+func (d *DeviceService) RotateRefreshToken(
+	ctx context.Context,
+	deviceAggregate *aggregate.DeviceAggregate,
+	refreshToken string) (*aggregate.DeviceAggregate, error) {
+
+	// rotated tokens are kept until their original expiry, drop the stale ones on the way
+	if err := d.rotatedRefreshTokenRepository.DeleteExpired(ctx); err != nil {
+		d.logger.Warnf(ctx, "delete expired rotated refresh tokens failed: %w", err)
+	}
+
+	refreshTokenHash := d.hashRefreshToken(refreshToken)
+
+	var result *aggregate.DeviceAggregate
+	reused := false
+
+	if err := d.deviceRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		// the row lock serializes concurrent refreshes of the device
+		current, err := d.deviceRepository.FindForUpdate(ctx, deviceAggregate.Device.ID)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		now := time.Now()
+		if current == nil || current.Device.RefreshTokenExpiresAt.Before(now) {
+			return ErrRefreshTokenInvalid
+		}
+
+		if d.MatchRefreshToken(current, refreshToken) {
+			// devices logged in before token families existed
+			if current.Device.RefreshTokenFamily == uuid.Nil {
+				current.Device.RefreshTokenFamily = uuid.New()
+			}
+
+			next, err := utils.GenerateRefreshToken()
+			if err != nil {
+				return xerror.Wrap(err)
+			}
+
+			successor, err := aes.GCMEncrypt(next, d.successorKey(refreshToken))
+			if err != nil {
+				return xerror.Wrap(err)
+			}
+
+			if _, err := d.rotatedRefreshTokenRepository.Create(ctx, &entity.RotatedRefreshTokenEntity{
+				RefreshTokenHash: refreshTokenHash,
+				Family:           current.Device.RefreshTokenFamily,
+				DeviceID:         current.Device.ID,
+				RotatedAt:        now,
+				Successor:        successor,
+				ExpiresAt:        current.Device.RefreshTokenExpiresAt,
+			}); err != nil {
+				return xerror.Wrap(err)
+			}
+
+			current.Device.RefreshToken = next
+			current.Device.RefreshTokenHash = d.hashRefreshToken(next)
+
+			client := utils.ClientFromContext(ctx)
+			current.Device.LastIP = client.IP
+			current.Device.UserAgent = client.UserAgent
+			current.Device.LastRefreshedAt = now
+
+			result, err = d.deviceRepository.Update(ctx, current)
+			if err != nil {
+				return xerror.Wrap(err)
+			}
+
+			return nil
+		}
+
+		rotated, err := d.rotatedRefreshTokenRepository.FindByRefreshTokenHash(ctx, refreshTokenHash)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		// tokens of an earlier login on the device are simply invalid
+		if rotated == nil ||
+			rotated.DeviceID != current.Device.ID ||
+			rotated.Family != current.Device.RefreshTokenFamily {
+			return ErrRefreshTokenInvalid
+		}
+
+		// a concurrent refresh with the direct predecessor of the current token gets the current token,
+		// any other rotated token is a replay
+		if now.Sub(rotated.RotatedAt) <= d.refreshTokenGrace && rotated.Successor != "" {
+			successor, err := aes.GCMDecrypt(rotated.Successor, d.successorKey(refreshToken))
+			if err == nil && d.MatchRefreshToken(current, successor) {
+				current.Device.RefreshToken = successor
+				result = current
+				return nil
+			}
+		}
+
+		current.Device.RefreshTokenExpiresAt = now
+		if _, err := d.deviceRepository.Update(ctx, current); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if err := d.rotatedRefreshTokenRepository.DeleteByFamily(ctx, rotated.Family); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		reused = true
+		return nil
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if reused {
+		return nil, ErrRefreshTokenReused
+	}
+
+	return result, nil
+}
+
+// HashLegacyRefreshTokens hash the refresh tokens stored in clear before tokens were hashed, current sessions survive
+func (d *DeviceService) HashLegacyRefreshTokens(ctx context.Context) (int, error) {
+	count, err := d.deviceRepository.HashLegacyRefreshTokens(ctx, d.hashRefreshToken)
+	if err != nil {
+		return count, xerror.Wrap(err)
+	}
+
+	return count, nil
+}
+
 func (d *DeviceService) UpdateDevice(ctx context.Context, deviceAggregate *aggregate.DeviceAggregate) (*aggregate.DeviceAggregate, error) {
 
 	deviceAggregate, err := d.deviceRepository.Update(ctx, deviceAggregate)
@@ -107,3 +311,100 @@ func (d *DeviceService) UpdateDevice(ctx context.Context, deviceAggregate *aggre
 
 	return deviceAggregate, nil
 }
+
+// RevokeUserRefreshTokens signs the user out of every device once their access tokens expire
+func (d *DeviceService) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	if err := d.deviceRepository.RevokeByUserID(ctx, userID); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// ListActiveDevices the sessions of the user which can still refresh
+func (d *DeviceService) ListActiveDevices(ctx context.Context, userID string) ([]*entity.DeviceEntity, error) {
+	devices, err := d.deviceRepository.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return devices, nil
+}
+
+// RevokeDevice signs one session of the user out, its refresh token and its access tokens at once
+func (d *DeviceService) RevokeDevice(ctx context.Context, userID string, id int64) error {
+	deviceAggregate, err := d.deviceRepository.Find(ctx, id)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	// devices of other users are reported as missing
+	if deviceAggregate == nil || deviceAggregate.User.ID != userID {
+		return ErrDeviceNotFound
+	}
+
+	now := time.Now()
+	if deviceAggregate.Device.RefreshTokenExpiresAt.After(now) {
+		deviceAggregate.Device.RefreshTokenExpiresAt = now
+		if _, err := d.deviceRepository.Update(ctx, deviceAggregate); err != nil {
+			return xerror.Wrap(err)
+		}
+	}
+
+	// an expired refresh token may still have access tokens, the cutoff follows the refresh token
+	// so no refresh slips in between
+	if err := d.revocationStore.RevokeDevice(ctx, userID, deviceAggregate.Device.DeviceType, deviceAggregate.Device.DeviceID, now); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// RevokeOtherDevices signs the user out of every session but the current one, their access tokens
+// included
+func (d *DeviceService) RevokeOtherDevices(ctx context.Context, userID string, deviceType string, deviceID string) error {
+	current, err := d.deviceRepository.FindByDevice(ctx, userID, deviceType, deviceID)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if current == nil {
+		return ErrDeviceNotFound
+	}
+
+	// sessions revoked before were cut off by their revocation
+	devices, err := d.deviceRepository.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	now := time.Now()
+	if err := d.deviceRepository.RevokeOthersByUserID(ctx, userID, current.Device.ID); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	for _, device := range devices {
+		if device.ID == current.Device.ID {
+			continue
+		}
+
+		if err := d.revocationStore.RevokeDevice(ctx, userID, device.DeviceType, device.DeviceID, now); err != nil {
+			return xerror.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// hashRefreshToken only this keyed hash is stored, a database leak does not reveal usable tokens
+func (d *DeviceService) hashRefreshToken(refreshToken string) string {
+	return utils.HmacSha256(d.refreshTokenHashKey, refreshToken)
+}
+
+// successorKey the key the successor of a rotated token is encrypted under. Only a holder of the
+// rotated token can open it, the stored hash and the hash key alone are not enough.
+func (d *DeviceService) successorKey(refreshToken string) []byte {
+	mac := hmac.New(sha256.New, d.refreshTokenHashKey)
+	mac.Write([]byte("successor:" + refreshToken))
+	return mac.Sum(nil)
+}
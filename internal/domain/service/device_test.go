@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/revocation"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fakeDeviceRepository the devices of one user
+type fakeDeviceRepository struct {
+	mu      sync.Mutex
+	userID  string
+	devices map[int64]*entity.DeviceEntity
+}
+
+func (f *fakeDeviceRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return fn(ctx)
+}
+
+func (f *fakeDeviceRepository) get(id int64) *aggregate.DeviceAggregate {
+	device, ok := f.devices[id]
+	if !ok {
+		return nil
+	}
+	copied := *device
+	return &aggregate.DeviceAggregate{Device: &copied, User: &entity.UserEntity{ID: f.userID}}
+}
+
+func (f *fakeDeviceRepository) Find(ctx context.Context, id int64) (*aggregate.DeviceAggregate, error) {
+	return f.get(id), nil
+}
+
+func (f *fakeDeviceRepository) FindForUpdate(ctx context.Context, id int64) (*aggregate.DeviceAggregate, error) {
+	return f.get(id), nil
+}
+
+func (f *fakeDeviceRepository) FindByDevice(ctx context.Context, userID string, deviceType, deviceID string) (*aggregate.DeviceAggregate, error) {
+	for id, device := range f.devices {
+		if userID == f.userID && device.DeviceType == deviceType && device.DeviceID == deviceID {
+			return f.get(id), nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeDeviceRepository) FindByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*aggregate.DeviceAggregate, error) {
+	for id, device := range f.devices {
+		if device.RefreshTokenHash == refreshTokenHash {
+			return f.get(id), nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeDeviceRepository) FindActiveByUserID(ctx context.Context, userID string) ([]*entity.DeviceEntity, error) {
+	devices := []*entity.DeviceEntity{}
+	for id, device := range f.devices {
+		if userID == f.userID && device.RefreshTokenExpiresAt.After(time.Now()) {
+			devices = append(devices, f.get(id).Device)
+		}
+	}
+	return devices, nil
+}
+
+func (f *fakeDeviceRepository) Create(ctx context.Context, device *aggregate.DeviceAggregate) (*aggregate.DeviceAggregate, error) {
+	created := *device.Device
+	created.ID = int64(len(f.devices) + 1)
+	f.devices[created.ID] = &created
+	return f.get(created.ID), nil
+}
+
+func (f *fakeDeviceRepository) Update(ctx context.Context, device *aggregate.DeviceAggregate) (*aggregate.DeviceAggregate, error) {
+	updated := *device.Device
+	f.devices[updated.ID] = &updated
+	return f.get(updated.ID), nil
+}
+
+func (f *fakeDeviceRepository) RevokeByUserID(ctx context.Context, userID string) error {
+	return nil
+}
+
+func (f *fakeDeviceRepository) RevokeOthersByUserID(ctx context.Context, userID string, exceptID int64) error {
+	for id, device := range f.devices {
+		if userID == f.userID && id != exceptID {
+			device.RefreshTokenExpiresAt = time.Now()
+		}
+	}
+	return nil
+}
+
+func (f *fakeDeviceRepository) HashLegacyRefreshTokens(ctx context.Context, hash func(refreshToken string) string) (int, error) {
+	return 0, nil
+}
+
+type fakeRotatedRefreshTokenRepository struct {
+	tokens map[string]*entity.RotatedRefreshTokenEntity
+}
+
+func (f *fakeRotatedRefreshTokenRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (f *fakeRotatedRefreshTokenRepository) FindByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*entity.RotatedRefreshTokenEntity, error) {
+	token, ok := f.tokens[refreshTokenHash]
+	if !ok {
+		return nil, nil
+	}
+	copied := *token
+	return &copied, nil
+}
+
+func (f *fakeRotatedRefreshTokenRepository) Create(ctx context.Context, token *entity.RotatedRefreshTokenEntity) (*entity.RotatedRefreshTokenEntity, error) {
+	created := *token
+	f.tokens[created.RefreshTokenHash] = &created
+	return &created, nil
+}
+
+func (f *fakeRotatedRefreshTokenRepository) DeleteByFamily(ctx context.Context, family uuid.UUID) error {
+	for hash, token := range f.tokens {
+		if token.Family == family {
+			delete(f.tokens, hash)
+		}
+	}
+	return nil
+}
+
+func (f *fakeRotatedRefreshTokenRepository) DeleteExpired(ctx context.Context) error {
+	return nil
+}
+
+func newTestDeviceService(grace time.Duration) (*DeviceService, *fakeDeviceRepository, *fakeRotatedRefreshTokenRepository) {
+	devices := &fakeDeviceRepository{userID: "user-1", devices: map[int64]*entity.DeviceEntity{}}
+	rotated := &fakeRotatedRefreshTokenRepository{tokens: map[string]*entity.RotatedRefreshTokenEntity{}}
+
+	return &DeviceService{
+		deviceRepository:              devices,
+		rotatedRefreshTokenRepository: rotated,
+		revocationStore:               revocation.NewStore(&config.Config{JWT: &config.JWTConfig{AccessTokenExpireSecond: 600}}, nil),
+		refreshTokenExpireSecond:      3600,
+		refreshTokenGrace:             grace,
+		refreshTokenHashKey:           []byte("test-refresh-token-hash-key"),
+	}, devices, rotated
+}
+
+func createTestDevice(t *testing.T, d *DeviceService, devices *fakeDeviceRepository, refreshToken string) *aggregate.DeviceAggregate {
+	t.Helper()
+
+	device, err := devices.Create(context.Background(), &aggregate.DeviceAggregate{Device: &entity.DeviceEntity{
+		DeviceType:            "web",
+		DeviceID:              "device-" + refreshToken,
+		RefreshTokenHash:      d.hashRefreshToken(refreshToken),
+		RefreshTokenExpiresAt: time.Now().Add(time.Hour),
+		RefreshTokenFamily:    uuid.New(),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return device
+}
+
+func TestRotateRefreshTokenDrawsNewToken(t *testing.T) {
+	ctx := context.Background()
+	d, devices, rotated := newTestDeviceService(30 * time.Second)
+	device := createTestDevice(t, d, devices, "first")
+
+	result, err := d.RotateRefreshToken(ctx, device, "first")
+	if err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+
+	next := result.Device.RefreshToken
+	if next == "" || next == "first" {
+		t.Fatalf("rotation returned %q", next)
+	}
+
+	stored, _ := devices.Find(ctx, device.Device.ID)
+	if !d.MatchRefreshToken(stored, next) || d.MatchRefreshToken(stored, "first") {
+		t.Fatal("device must hold the new token only")
+	}
+
+	row := rotated.tokens[d.hashRefreshToken("first")]
+	if row == nil || row.Successor == "" || row.Successor == next {
+		t.Fatal("rotated token must keep its successor encrypted")
+	}
+
+	// the same rotation of another device draws another token
+	other, _ := d.RotateRefreshToken(ctx, createTestDevice(t, d, devices, "first-other"), "first-other")
+	if other.Device.RefreshToken == next {
+		t.Fatal("rotated tokens must be random")
+	}
+}
+
+func TestRotateRefreshTokenGraceReturnsCurrent(t *testing.T) {
+	ctx := context.Background()
+	d, devices, _ := newTestDeviceService(30 * time.Second)
+	device := createTestDevice(t, d, devices, "first")
+
+	result, err := d.RotateRefreshToken(ctx, device, "first")
+	if err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+
+	// a concurrent refresh with the token just rotated
+	again, err := d.RotateRefreshToken(ctx, device, "first")
+	if err != nil {
+		t.Fatalf("refresh within grace: %v", err)
+	}
+
+	if again.Device.RefreshToken != result.Device.RefreshToken {
+		t.Fatal("refresh within grace must return the current token")
+	}
+}
+
+func TestRotateRefreshTokenReuseAfterGrace(t *testing.T) {
+	ctx := context.Background()
+	d, devices, rotated := newTestDeviceService(0)
+	device := createTestDevice(t, d, devices, "first")
+
+	result, err := d.RotateRefreshToken(ctx, device, "first")
+	if err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+
+	if _, err := d.RotateRefreshToken(ctx, device, "first"); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("replay after grace: got %v, want %v", err, ErrRefreshTokenReused)
+	}
+
+	if len(rotated.tokens) != 0 {
+		t.Fatal("reuse must drop the token family")
+	}
+
+	// the whole family is revoked, the current token included
+	if _, err := d.RotateRefreshToken(ctx, device, result.Device.RefreshToken); !errors.Is(err, ErrRefreshTokenInvalid) {
+		t.Fatalf("current token after reuse: got %v, want %v", err, ErrRefreshTokenInvalid)
+	}
+}
+
+func TestRotateRefreshTokenGraceOnlyForDirectPredecessor(t *testing.T) {
+	ctx := context.Background()
+	d, devices, _ := newTestDeviceService(30 * time.Second)
+	device := createTestDevice(t, d, devices, "first")
+
+	second, err := d.RotateRefreshToken(ctx, device, "first")
+	if err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+
+	if _, err := d.RotateRefreshToken(ctx, device, second.Device.RefreshToken); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+
+	// "first" was rotated within the window but its successor is no longer current
+	if _, err := d.RotateRefreshToken(ctx, device, "first"); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("replay of an older token: got %v, want %v", err, ErrRefreshTokenReused)
+	}
+}
+
+// isRevoked reports whether an access token of the device issued at issuedAt is revoked
+func isRevoked(t *testing.T, d *DeviceService, device *aggregate.DeviceAggregate, issuedAt time.Time) bool {
+	t.Helper()
+
+	revoked, err := d.revocationStore.IsRevoked(context.Background(), uuid.NewString(), "user-1", device.Device.DeviceType, device.Device.DeviceID, issuedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return revoked
+}
+
+func TestRevokeDeviceRevokesAccessTokens(t *testing.T) {
+	ctx := context.Background()
+	d, devices, _ := newTestDeviceService(0)
+	revoked := createTestDevice(t, d, devices, "revoked")
+	other := createTestDevice(t, d, devices, "other")
+	issuedAt := time.Now().Add(-time.Second)
+
+	if err := d.RevokeDevice(ctx, "user-1", revoked.Device.ID); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+
+	if !isRevoked(t, d, revoked, issuedAt) {
+		t.Fatal("access tokens of the revoked device must be revoked")
+	}
+	if isRevoked(t, d, other, issuedAt) {
+		t.Fatal("access tokens of other devices must stay valid")
+	}
+
+	// logging in again issues new tokens
+	if isRevoked(t, d, revoked, time.Now().Add(time.Millisecond)) {
+		t.Fatal("access tokens issued after the revocation must stay valid")
+	}
+
+	if err := d.RevokeDevice(ctx, "user-2", other.Device.ID); !errors.Is(err, ErrDeviceNotFound) {
+		t.Fatalf("revoke a device of another user: got %v, want %v", err, ErrDeviceNotFound)
+	}
+}
+
+func TestRevokeOtherDevicesRevokesAccessTokens(t *testing.T) {
+	ctx := context.Background()
+	d, devices, _ := newTestDeviceService(0)
+	current := createTestDevice(t, d, devices, "current")
+	others := []*aggregate.DeviceAggregate{createTestDevice(t, d, devices, "other-1"), createTestDevice(t, d, devices, "other-2")}
+	issuedAt := time.Now().Add(-time.Second)
+
+	if err := d.RevokeOtherDevices(ctx, "user-1", current.Device.DeviceType, current.Device.DeviceID); err != nil {
+		t.Fatalf("revoke others: %v", err)
+	}
+
+	if isRevoked(t, d, current, issuedAt) {
+		t.Fatal("access tokens of the current device must stay valid")
+	}
+
+	for _, other := range others {
+		if !isRevoked(t, d, other, issuedAt) {
+			t.Fatalf("access tokens of %s must be revoked", other.Device.DeviceID)
+		}
+
+		stored, _ := devices.Find(ctx, other.Device.ID)
+		if stored.Device.RefreshTokenExpiresAt.After(time.Now()) {
+			t.Fatalf("refresh token of %s must be expired", other.Device.DeviceID)
+		}
+	}
+}
@@ -9,14 +9,20 @@ var (
 	ErrApplicationNotFound      = errors.New("application not found")
 
 	// device
-	ErrDeviceNotFound = errors.New("device not found")
+	ErrDeviceNotFound      = errors.New("device not found")
+	ErrRefreshTokenInvalid = errors.New("refresh token is invalid or expired")
+	ErrRefreshTokenReused  = errors.New("rotated refresh token reused")
 
 	// login
-	ErrInvalidWechatCode     = errors.New("invalid wechat code")
-	ErrUserNotFound          = errors.New("user not found")
-	ErrUserAlreadyExists     = errors.New("user already exists")
-	ErrUserNameAlreadyExists = errors.New("user name already exists")
-	ErrWechatInvalidScope    = errors.New("wechat access_token scope not found or invalid")
+	ErrInvalidWechatCode      = errors.New("invalid wechat code")
+	ErrUserNotFound           = errors.New("user not found")
+	ErrUserAlreadyExists      = errors.New("user already exists")
+	ErrUserNameAlreadyExists  = errors.New("user name already exists")
+	ErrUserBanned             = errors.New("user is banned")
+	ErrWechatInvalidScope     = errors.New("wechat access_token scope not found or invalid")
+	ErrLoginProviderNotFound  = errors.New("login provider not found")
+	ErrLoginCredentialInvalid = errors.New("login credential is invalid or expired")
+	ErrDefaultOrgRoleNotFound = errors.New("default organization role of the application not found")
 
 	// rbac
 	ErrRoleNotFound       = errors.New("role not found")
@@ -28,4 +34,88 @@ var (
 
 	// binding verify
 	ErrBindingVerifyAlreadyExists = errors.New("binding verify already exists")
+	ErrBindingVerifyCodeInvalid   = errors.New("binding verify code is invalid or expired")
+
+	// verification code
+	ErrVerificationCodeNotFound  = errors.New("verification code not found or expired")
+	ErrVerificationCodeInvalid   = errors.New("invalid verification code")
+	ErrVerificationCodeExhausted = errors.New("verification code attempts exhausted")
+
+	// binding
+	ErrBindingConflict      = errors.New("identity is linked to another user")
+	ErrBindingAlreadyLinked = errors.New("identity is already linked to the user")
+	ErrBindingNotFound      = errors.New("binding not found")
+	ErrBindingNotLinkable   = errors.New("binding type can not be linked")
+	ErrBindingKindLinked    = errors.New("a binding of the kind is already linked to the user")
+	ErrLastLoginMethod      = errors.New("last login method can not be removed")
+
+	// oauth
+	ErrOAuthClientNotConfigured  = errors.New("oauth client not configured")
+	ErrOAuthInvalidClient        = errors.New("oauth client authentication failed")
+	ErrOAuthInvalidRedirectURI   = errors.New("oauth redirect_uri is not registered")
+	ErrOAuthInvalidCodeChallenge = errors.New("oauth code_challenge is invalid")
+	ErrOAuthInvalidGrant         = errors.New("oauth grant is invalid or expired")
+
+	// mfa
+	ErrMFANotConfigured  = errors.New("mfa secret encryption key not configured")
+	ErrMFAAlreadyEnabled = errors.New("mfa already enabled")
+	ErrMFANotEnabled     = errors.New("mfa not enabled")
+	ErrMFAInvalidCode    = errors.New("mfa code is invalid")
+	ErrMFACodeUsed       = errors.New("mfa code was already used")
+
+	// passkey
+	ErrPasskeyNotConfigured           = errors.New("webauthn relying party not configured")
+	ErrPasskeyInvalidChallenge        = errors.New("webauthn challenge is invalid or expired")
+	ErrPasskeyVerificationFailed      = errors.New("webauthn verification failed")
+	ErrPasskeyCredentialNotFound      = errors.New("passkey credential not found")
+	ErrPasskeyCredentialAlreadyExists = errors.New("passkey credential already registered")
+
+	// login protection
+	ErrLoginLocked       = errors.New("login locked after too many failed attempts")
+	ErrLoginThrottled    = errors.New("login attempted too soon after a failure")
+	ErrLoginLockNotFound = errors.New("login lock not found")
+
+	// rate limit
+	ErrRateLimited = errors.New("too many requests")
+
+	// captcha
+	ErrCaptchaRequired = errors.New("captcha required")
+	ErrCaptchaInvalid  = errors.New("captcha is invalid")
+
+	// federation
+	ErrFederationNotConfigured  = errors.New("federation secret encryption key not configured")
+	ErrIdentityProviderNotFound = errors.New("identity provider not found")
+	ErrIdentityProviderDisabled = errors.New("identity provider is disabled")
+	ErrIdentityProviderExists   = errors.New("identity provider already exists")
+	ErrIdentityProviderInvalid  = errors.New("identity provider configuration is invalid")
+
+	// saml
+	ErrSAMLNotConfigured      = errors.New("saml base url not configured")
+	ErrSAMLConnectionNotFound = errors.New("saml connection not found")
+	ErrSAMLConnectionDisabled = errors.New("saml connection is disabled")
+	ErrSAMLConnectionInvalid  = errors.New("saml connection configuration is invalid")
+	ErrSAMLNameIDNotFound     = errors.New("saml assertion has no name id")
+
+	// qy wechat
+	ErrQyWechatCorpNotFound = errors.New("qy wechat corp not found")
+	ErrQyWechatCorpDisabled = errors.New("qy wechat corp is disabled")
+	ErrQyWechatCorpExists   = errors.New("qy wechat corp already mapped to another organization")
+	ErrQyWechatCorpInvalid  = errors.New("qy wechat corp configuration is invalid")
+
+	// magic link
+	ErrMagicLinkNotConfigured = errors.New("magic link base url or redirect url not configured")
+	ErrMagicLinkNotFound      = errors.New("magic link not found or expired")
+	ErrMagicLinkUsed          = errors.New("magic link already used")
+
+	// qr login
+	ErrQRLoginNotFound = errors.New("qr login not found or expired")
+	ErrQRLoginUsed     = errors.New("qr login already scanned or decided")
+
+	// wechat scan login
+	ErrWechatScanLoginNotFound = errors.New("wechat scan login not found or expired")
+	ErrWechatScanLoginUsed     = errors.New("wechat scan login already scanned")
+
+	// guest
+	ErrGuestLoginDisabled = errors.New("guest role not found in application")
+	ErrGuestSecretInvalid = errors.New("guest secret invalid")
 )
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/oidc"
+	"kiwi-user/internal/infrastructure/utils/aes"
+	"net/url"
+	"regexp"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// identityProviderNamePattern provider names are part of the binding identity and of urls
+var identityProviderNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// FederationService generic OpenID Connect identity providers, registered by admins per application
+type FederationService struct {
+	identityProviderRepository contract.IIdentityProviderRepository
+	oidcClient                 *oidc.Client
+	config                     *config.Config
+}
+
+func NewFederationService(
+	identityProviderRepository contract.IIdentityProviderRepository,
+	oidcClient *oidc.Client,
+	config *config.Config) *FederationService {
+	return &FederationService{
+		identityProviderRepository: identityProviderRepository,
+		oidcClient:                 oidcClient,
+		config:                     config,
+	}
+}
+
+func (f *FederationService) ListProviders(ctx context.Context, applicationID uuid.UUID) ([]*entity.IdentityProviderEntity, error) {
+	providers, err := f.identityProviderRepository.FindByApplicationID(ctx, applicationID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return providers, nil
+}
+
+// GetEnabledProvider the provider users of the application log in with
+func (f *FederationService) GetEnabledProvider(ctx context.Context, applicationID uuid.UUID, name string) (*entity.IdentityProviderEntity, error) {
+	provider, err := f.identityProviderRepository.FindByName(ctx, applicationID, name)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if provider == nil {
+		return nil, xerror.Wrap(ErrIdentityProviderNotFound)
+	}
+
+	if !provider.Enabled {
+		return nil, xerror.Wrap(ErrIdentityProviderDisabled)
+	}
+
+	return provider, nil
+}
+
+func (f *FederationService) CreateProvider(ctx context.Context, provider *entity.IdentityProviderEntity) (*entity.IdentityProviderEntity, error) {
+	if !identityProviderNamePattern.MatchString(provider.Name) {
+		return nil, xerror.Wrap(ErrIdentityProviderInvalid)
+	}
+
+	if err := f.validateProvider(provider); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	existing, err := f.identityProviderRepository.FindByName(ctx, provider.ApplicationID, provider.Name)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if existing != nil {
+		return nil, xerror.Wrap(ErrIdentityProviderExists)
+	}
+
+	if provider.ClientSecret != "" {
+		provider.ClientSecret, err = f.encryptSecret(provider.ClientSecret)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+	}
+
+	provider, err = f.identityProviderRepository.Create(ctx, provider)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return provider, nil
+}
+
+// UpdateProvider name and application can not change, an empty client secret keeps the current one
+func (f *FederationService) UpdateProvider(ctx context.Context, provider *entity.IdentityProviderEntity) (*entity.IdentityProviderEntity, error) {
+	existing, err := f.identityProviderRepository.Find(ctx, provider.ID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if existing == nil || existing.ApplicationID != provider.ApplicationID {
+		return nil, xerror.Wrap(ErrIdentityProviderNotFound)
+	}
+
+	if err := f.validateProvider(provider); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	provider.Name = existing.Name
+	if provider.ClientSecret == "" {
+		provider.ClientSecret = existing.ClientSecret
+	} else {
+		provider.ClientSecret, err = f.encryptSecret(provider.ClientSecret)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+	}
+
+	provider, err = f.identityProviderRepository.Update(ctx, provider)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return provider, nil
+}
+
+func (f *FederationService) DeleteProvider(ctx context.Context, applicationID uuid.UUID, id uuid.UUID) error {
+	existing, err := f.identityProviderRepository.Find(ctx, id)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if existing == nil || existing.ApplicationID != applicationID {
+		return xerror.Wrap(ErrIdentityProviderNotFound)
+	}
+
+	if err := f.identityProviderRepository.Delete(ctx, id); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// AuthorizationURL where the browser is sent to log in at the provider
+func (f *FederationService) AuthorizationURL(
+	ctx context.Context,
+	provider *entity.IdentityProviderEntity,
+	redirectURI string,
+	state string,
+	nonce string) (string, error) {
+	authorizationURL, err := f.oidcClient.AuthorizationURL(ctx, f.toOIDCProvider(provider, ""), redirectURI, state, nonce)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	return authorizationURL, nil
+}
+
+// Identity exchanges the authorization code and maps the claims of the provider
+func (f *FederationService) Identity(
+	ctx context.Context,
+	provider *entity.IdentityProviderEntity,
+	code string,
+	redirectURI string,
+	nonce string) (*oidc.Identity, error) {
+	clientSecret := ""
+	if provider.ClientSecret != "" {
+		key, err := f.secretKey()
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		clientSecret, err = aes.AESDecrypt(provider.ClientSecret, key)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+	}
+
+	identity, err := f.oidcClient.Identity(ctx, f.toOIDCProvider(provider, clientSecret), code, redirectURI, nonce)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return identity, nil
+}
+
+func (f *FederationService) toOIDCProvider(provider *entity.IdentityProviderEntity, clientSecret string) *oidc.Provider {
+	return &oidc.Provider{
+		Issuer:                provider.Issuer,
+		ClientID:              provider.ClientID,
+		ClientSecret:          clientSecret,
+		Scopes:                provider.Scopes,
+		AuthorizationEndpoint: provider.AuthorizationEndpoint,
+		TokenEndpoint:         provider.TokenEndpoint,
+		UserinfoEndpoint:      provider.UserinfoEndpoint,
+		JWKSURI:               provider.JWKSURI,
+		ClaimMapping:          provider.ClaimMapping,
+	}
+}
+
+func (f *FederationService) validateProvider(provider *entity.IdentityProviderEntity) error {
+	if provider.ClientID == "" || !isHTTPSURL(provider.Issuer) {
+		return ErrIdentityProviderInvalid
+	}
+
+	for _, endpoint := range []string{
+		provider.AuthorizationEndpoint,
+		provider.TokenEndpoint,
+		provider.UserinfoEndpoint,
+		provider.JWKSURI,
+	} {
+		if endpoint != "" && !isHTTPSURL(endpoint) {
+			return ErrIdentityProviderInvalid
+		}
+	}
+
+	for key := range provider.ClaimMapping {
+		switch key {
+		case oidc.ClaimSubject, oidc.ClaimEmail, oidc.ClaimEmailVerified, oidc.ClaimName, oidc.ClaimPicture:
+		default:
+			return ErrIdentityProviderInvalid
+		}
+	}
+
+	return nil
+}
+
+func (f *FederationService) encryptSecret(secret string) (string, error) {
+	key, err := f.secretKey()
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	encrypted, err := aes.AESEncrypt(secret, key)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	return encrypted, nil
+}
+
+func (f *FederationService) secretKey() ([]byte, error) {
+	key := []byte(f.config.Federation.SecretEncryptionKey)
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, ErrFederationNotConfigured
+	}
+}
+
+func isHTTPSURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && u.Scheme == "https" && u.Host != ""
+}
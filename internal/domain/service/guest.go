@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/replay"
+	"strconv"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// guestPurgeBatch guests deleted per query, the purge continues until none are left
+const guestPurgeBatch = 100
+
+// GuestService the restricted role of guests and the purge of guests that were never upgraded.
+// Guests log in through LoginService.GuestLogin and are upgraded by BindingService.Link.
+type GuestService struct {
+	userRepository contract.IUserRepository
+	roleRepository contract.IRoleReadRepository
+	replayStore    replay.Store
+	config         *config.Config
+	logger         logger.ILogger
+
+	stop chan struct{}
+}
+
+func NewGuestService(
+	logger logger.ILogger,
+	config *config.Config,
+	userRepository contract.IUserRepository,
+	roleRepository contract.IRoleReadRepository,
+	replayStore replay.Store) *GuestService {
+	return &GuestService{
+		logger:         logger,
+		config:         config,
+		userRepository: userRepository,
+		roleRepository: roleRepository,
+		replayStore:    replayStore,
+	}
+}
+
+// GuestRole the role guests of the application are created with
+func (g *GuestService) GuestRole(ctx context.Context, applicationName string) (*entity.RoleEntity, error) {
+	roleAggregate, err := g.roleRepository.FindByName(ctx, applicationName, g.config.Guest.RoleName)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if roleAggregate == nil || roleAggregate.Role.Type != enum.RoleTypePersonal {
+		return nil, xerror.Wrap(ErrGuestLoginDisabled)
+	}
+
+	return roleAggregate.Role, nil
+}
+
+// Purge deletes the guests created before the expire period that did not link an identity. A guest
+// that fails to be deleted is logged and left for the next purge.
+func (g *GuestService) Purge(ctx context.Context) (int, error) {
+	createdBefore := time.Now().Add(-time.Duration(g.config.Guest.ExpireSecond) * time.Second)
+	purged := 0
+
+	for {
+		ids, err := g.userRepository.FindGuestIDs(ctx, createdBefore, guestPurgeBatch)
+		if err != nil {
+			return purged, xerror.Wrap(err)
+		}
+
+		failed := false
+		for _, id := range ids {
+			if err := g.purgeGuest(ctx, id); err != nil {
+				g.logger.Errorf(ctx, "purge guest %s failed: %w", id, err)
+				failed = true
+				continue
+			}
+			purged++
+		}
+
+		// a failed guest would be found again
+		if failed || len(ids) < guestPurgeBatch {
+			return purged, nil
+		}
+	}
+}
+
+func (g *GuestService) purgeGuest(ctx context.Context, id string) error {
+	return g.userRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		userAggregate, err := g.userRepository.Find(ctx, id)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		// upgraded meanwhile
+		if userAggregate == nil || findBinding(userAggregate.Bindings, enum.BindingTypeGuest) == nil {
+			return nil
+		}
+
+		if err := g.userRepository.Delete(ctx, userAggregate); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	})
+}
+
+// claimPurge every replica ticks, the first to claim the purge interval that now falls in runs the purge
+func (g *GuestService) claimPurge(ctx context.Context, now time.Time) (bool, error) {
+	interval := time.Duration(g.config.Guest.PurgeIntervalSecond) * time.Second
+	period := now.Truncate(interval)
+
+	claimed, err := g.replayStore.Consume(ctx, "guest-purge:"+strconv.FormatInt(period.Unix(), 10), period.Add(interval))
+	if err != nil {
+		return false, xerror.Wrap(err)
+	}
+
+	return claimed, nil
+}
+
+// Start purges expired guests every purge interval until Close, once across the replicas
+func (g *GuestService) Start() {
+	g.stop = make(chan struct{})
+	go g.purgeLoop()
+}
+
+func (g *GuestService) Close() {
+	if g.stop != nil {
+		close(g.stop)
+	}
+}
+
+func (g *GuestService) purgeLoop() {
+	ticker := time.NewTicker(time.Duration(g.config.Guest.PurgeIntervalSecond) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-g.stop:
+			return
+		case now := <-ticker.C:
+			ctx := context.Background()
+			claimed, err := g.claimPurge(ctx, now)
+			if err != nil {
+				g.logger.Errorf(ctx, "claim guest purge failed: %w", err)
+				continue
+			}
+			if !claimed {
+				continue
+			}
+
+			purged, err := g.Purge(ctx)
+			if err != nil {
+				g.logger.Errorf(ctx, "purge guests failed: %w", err)
+			}
+			if purged > 0 {
+				g.logger.Infof(ctx, "purged %d expired guests", purged)
+			}
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/replay"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type fakeUserRepository struct {
+	users     map[string]*aggregate.UserAggregate
+	createdAt map[string]time.Time
+}
+
+func newFakeUserRepository() *fakeUserRepository {
+	return &fakeUserRepository{
+		users:     map[string]*aggregate.UserAggregate{},
+		createdAt: map[string]time.Time{},
+	}
+}
+
+func (f *fakeUserRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (f *fakeUserRepository) Find(ctx context.Context, id string) (*aggregate.UserAggregate, error) {
+	return f.users[id], nil
+}
+
+func (f *fakeUserRepository) FindIn(ctx context.Context, ids []string) ([]*aggregate.UserAggregate, error) {
+	return nil, nil
+}
+
+func (f *fakeUserRepository) FindByName(ctx context.Context, application string, name string) (*aggregate.UserAggregate, error) {
+	return nil, nil
+}
+
+func (f *fakeUserRepository) FindByBindingForUpdate(ctx context.Context, applicationID uuid.UUID, binding *entity.BindingEntity) (*aggregate.UserAggregate, error) {
+	for _, user := range f.users {
+		for _, b := range user.Bindings {
+			if b.ApplicationID == applicationID && b.Type == binding.Type && b.Identity == binding.Identity {
+				return user, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeUserRepository) FindWechatOpenIDByUserAndPlatform(ctx context.Context, userID string, platform string) (*entity.WechatOpenIDEntity, error) {
+	return nil, nil
+}
+
+func (f *fakeUserRepository) FindByWechatOpenIDAndPlatformForUpdate(ctx context.Context, applicationID uuid.UUID, openID string, platform string) (*aggregate.UserAggregate, error) {
+	return nil, nil
+}
+
+func (f *fakeUserRepository) FindGuestIDs(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	ids := []string{}
+	for id, user := range f.users {
+		if findBinding(user.Bindings, enum.BindingTypeGuest) != nil && f.createdAt[id].Before(createdBefore) && len(ids) < limit {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (f *fakeUserRepository) Update(ctx context.Context, user *aggregate.UserAggregate) (*aggregate.UserAggregate, error) {
+	for _, binding := range user.Bindings {
+		if binding.ID == uuid.Nil {
+			binding.ID = uuid.New()
+		}
+	}
+	f.users[user.User.ID] = user
+	return user, nil
+}
+
+func (f *fakeUserRepository) Create(ctx context.Context, user *aggregate.UserAggregate) (*aggregate.UserAggregate, error) {
+	user.User.ID = uuid.NewString()
+	f.createdAt[user.User.ID] = time.Now()
+	return f.Update(ctx, user)
+}
+
+func (f *fakeUserRepository) DeleteBinding(ctx context.Context, bindingID uuid.UUID) error {
+	return nil
+}
+
+func (f *fakeUserRepository) Delete(ctx context.Context, user *aggregate.UserAggregate) error {
+	delete(f.users, user.User.ID)
+	return nil
+}
+
+type fakeApplicationRepository struct {
+	application *aggregate.ApplicationAggregate
+}
+
+func (f *fakeApplicationRepository) FindByName(ctx context.Context, name string) (*aggregate.ApplicationAggregate, error) {
+	return f.application, nil
+}
+
+func (f *fakeApplicationRepository) FindAll(ctx context.Context, offset, limit int) ([]*aggregate.ApplicationAggregate, error) {
+	return []*aggregate.ApplicationAggregate{f.application}, nil
+}
+
+func newTestApplication() *aggregate.ApplicationAggregate {
+	return &aggregate.ApplicationAggregate{
+		Application:         &entity.ApplicationEntity{ID: uuid.New(), Name: "app"},
+		DefaultPersonalRole: &entity.RoleEntity{Name: "member", Type: enum.RoleTypePersonal},
+	}
+}
+
+var testGuestRole = &entity.RoleEntity{Name: "guest", Type: enum.RoleTypePersonal}
+
+func TestGuestLoginBySecret(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUserRepository()
+	l := &LoginService{userRepository: users}
+	application := newTestApplication()
+
+	guest, secret, err := l.GuestLogin(ctx, application, testGuestRole, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binding := findBinding(guest.Bindings, enum.BindingTypeGuest)
+	if secret == "" || binding == nil || binding.Identity == secret || guest.PersonalRole != testGuestRole {
+		t.Fatalf("unexpected guest %+v with secret %q", guest, secret)
+	}
+
+	again, issued, err := l.GuestLogin(ctx, application, testGuestRole, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.User.ID != guest.User.ID || issued != "" {
+		t.Fatal("the secret must log the same guest in without issuing another")
+	}
+
+	// knowing the device is not enough, an unknown secret creates nothing
+	if _, _, err := l.GuestLogin(ctx, application, testGuestRole, "web:device"); !errors.Is(err, ErrGuestSecretInvalid) {
+		t.Fatalf("unknown secret: got %v, want %v", err, ErrGuestSecretInvalid)
+	}
+	if len(users.users) != 1 {
+		t.Fatalf("%d users, want 1", len(users.users))
+	}
+
+	// every first login is another guest
+	other, _, err := l.GuestLogin(ctx, application, testGuestRole, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.User.ID == guest.User.ID {
+		t.Fatal("a first login must create a new guest")
+	}
+}
+
+func TestGuestUpgradeKeepsUserID(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUserRepository()
+	application := newTestApplication()
+	l := &LoginService{userRepository: users}
+	b := &BindingService{userRepository: users, applicationRepository: &fakeApplicationRepository{application: application}}
+
+	guest, secret, err := l.GuestLogin(ctx, application, testGuestRole, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	upgraded, err := b.Link(ctx, guest, &entity.BindingEntity{Type: enum.BindingTypeEmail, Identity: "alice@example.com", Email: "alice@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if upgraded.User.ID != guest.User.ID {
+		t.Fatalf("upgrade changed the user id from %s to %s", guest.User.ID, upgraded.User.ID)
+	}
+	if findBinding(upgraded.Bindings, enum.BindingTypeGuest) != nil || findBinding(upgraded.Bindings, enum.BindingTypeEmail) == nil {
+		t.Fatalf("unexpected bindings %+v", upgraded.Bindings)
+	}
+	if upgraded.PersonalRole != application.DefaultPersonalRole {
+		t.Fatal("an upgraded guest must get the default personal role")
+	}
+
+	// the user now logs in with its email, the guest secret is spent
+	if _, _, err := l.GuestLogin(ctx, application, testGuestRole, secret); !errors.Is(err, ErrGuestSecretInvalid) {
+		t.Fatalf("secret after upgrade: got %v, want %v", err, ErrGuestSecretInvalid)
+	}
+}
+
+func TestGuestPurgeSkipsUpgraded(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUserRepository()
+	application := newTestApplication()
+	l := &LoginService{userRepository: users}
+	b := &BindingService{userRepository: users, applicationRepository: &fakeApplicationRepository{application: application}}
+	cfg := &config.Config{Guest: &config.GuestConfig{ExpireSecond: 3600, PurgeIntervalSecond: 60}}
+	g := &GuestService{userRepository: users, config: cfg}
+
+	expired, _, _ := l.GuestLogin(ctx, application, testGuestRole, "")
+	upgraded, _, _ := l.GuestLogin(ctx, application, testGuestRole, "")
+	recent, _, _ := l.GuestLogin(ctx, application, testGuestRole, "")
+	users.createdAt[expired.User.ID] = time.Now().Add(-2 * time.Hour)
+	users.createdAt[upgraded.User.ID] = time.Now().Add(-2 * time.Hour)
+
+	if _, err := b.Link(ctx, upgraded, &entity.BindingEntity{Type: enum.BindingTypePhone, Identity: "+8613800000000"}); err != nil {
+		t.Fatal(err)
+	}
+
+	purged, err := g.Purge(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if purged != 1 || users.users[expired.User.ID] != nil || users.users[upgraded.User.ID] == nil || users.users[recent.User.ID] == nil {
+		t.Fatalf("purged %d, only the expired guest must be gone", purged)
+	}
+
+	// found as a guest just before its upgrade committed
+	if err := g.purgeGuest(ctx, upgraded.User.ID); err != nil || users.users[upgraded.User.ID] == nil {
+		t.Fatalf("purge of an upgraded user: %v", err)
+	}
+}
+
+func TestGuestPurgeClaimedOnce(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{Guest: &config.GuestConfig{PurgeIntervalSecond: 3600}}
+	store := replay.NewStore(cfg, nil)
+
+	// two replicas ticking within the same interval
+	first := &GuestService{replayStore: store, config: cfg}
+	second := &GuestService{replayStore: store, config: cfg}
+	interval := time.Now().Truncate(time.Hour)
+
+	if claimed, err := first.claimPurge(ctx, interval); err != nil || !claimed {
+		t.Fatalf("first claim: %v, %v", claimed, err)
+	}
+	if claimed, _ := second.claimPurge(ctx, interval.Add(59*time.Minute)); claimed {
+		t.Fatal("the interval must be purged once")
+	}
+	if claimed, _ := second.claimPurge(ctx, interval.Add(time.Hour)); !claimed {
+		t.Fatal("the next interval must be claimable")
+	}
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"net/http"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// ResolveAccount 登录的统一账号处理：通过 binding 查找用户，不存在时创建，存在时同步资料
+// 同一次登录证明的其它 binding 关联到该用户，需要时加入组织
+func (l *LoginService) ResolveAccount(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	refferalChannel entity.UserRefferalChannel,
+	identity *ExternalIdentity,
+) (*aggregate.UserAggregate, error) {
+	var userAggregate *aggregate.UserAggregate
+
+	if err := l.userRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		// 1. 通过 binding 查找用户
+		identity.Binding.ApplicationID = application.Application.ID
+		identity.Binding.Verified = true
+
+		userAggregate, err = l.userRepository.FindByBindingForUpdate(ctx, application.Application.ID, identity.Binding)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		// 2. 关联的 binding 已属于其它用户时保持不变，主 binding 不存在时使用关联 binding 的用户
+		var linkedBindings []*entity.BindingEntity
+		for _, binding := range identity.LinkedBindings {
+			binding.ApplicationID = application.Application.ID
+			binding.Verified = true
+
+			owner, err := l.userRepository.FindByBindingForUpdate(ctx, application.Application.ID, binding)
+			if err != nil {
+				return xerror.Wrap(err)
+			}
+
+			switch {
+			case owner == nil:
+				linkedBindings = append(linkedBindings, binding)
+			case userAggregate == nil:
+				userAggregate = owner
+			case owner.User.ID != userAggregate.User.ID:
+				l.logger.Warnf(ctx, "%s binding and %s binding belong to different users: %s, %s, %s, %s",
+					identity.Binding.Type, binding.Type, userAggregate.User.ID, owner.User.ID, identity.Binding.Identity, binding.Identity)
+			}
+		}
+
+		// 3. 创建或更新用户
+		if userAggregate == nil {
+			userAggregate, err = l.createAccount(ctx, application, refferalChannel, identity, linkedBindings)
+		} else {
+			userAggregate, err = l.updateAccount(ctx, userAggregate, identity, linkedBindings)
+		}
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		// 4. 加入组织，已有成员的角色不变，只同步部门
+		if identity.Membership != nil {
+			if err := l.joinOrganization(ctx, application, userAggregate, identity.Membership); err != nil {
+				return xerror.Wrap(err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return userAggregate, nil
+}
+
+func (l *LoginService) createAccount(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	refferalChannel entity.UserRefferalChannel,
+	identity *ExternalIdentity,
+	linkedBindings []*entity.BindingEntity,
+) (*aggregate.UserAggregate, error) {
+	name := identity.Name
+	if name == "" {
+		randomUserName, err := l.randomUserName(ctx, application.Application.Name)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+		name = randomUserName
+	}
+
+	displayName := identity.DisplayName
+	if displayName == "" {
+		displayName = name
+	}
+
+	personalRole := identity.PersonalRole
+	if personalRole == nil {
+		personalRole = application.DefaultPersonalRole
+	}
+
+	userAggregate := &aggregate.UserAggregate{
+		User: &entity.UserEntity{
+			Name:            name,
+			DisplayName:     displayName,
+			Avatar:          identity.Avatar,
+			Department:      identity.Department,
+			RefferalChannel: refferalChannel,
+		},
+		Application:  application.Application,
+		Bindings:     append([]*entity.BindingEntity{identity.Binding}, linkedBindings...),
+		PersonalRole: personalRole,
+	}
+
+	if identity.WechatOpenID != nil {
+		userAggregate.WechatOpenIDs = []*entity.WechatOpenIDEntity{identity.WechatOpenID}
+	}
+
+	if identity.QyWechatUserID != nil {
+		userAggregate.QyWechatUserIDs = []*entity.QyWechatUserIDEntity{identity.QyWechatUserID}
+	}
+
+	userAggregate, err := l.userRepository.Create(ctx, userAggregate)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	// 头像按用户 ID 保存，创建后再导入
+	if identity.ImportAvatar && identity.Avatar != "" {
+		avatar, err := l.importAvatar(ctx, identity.Avatar, userAggregate.User.ID)
+		if err != nil {
+			l.logger.Warnf(ctx, "failed to import avatar: %v, using original URL", err)
+		} else if avatar != userAggregate.User.Avatar {
+			userAggregate.User.Avatar = avatar
+			userAggregate, err = l.userRepository.Update(ctx, userAggregate)
+			if err != nil {
+				return nil, xerror.Wrap(err)
+			}
+		}
+	}
+
+	return userAggregate, nil
+}
+
+func (l *LoginService) updateAccount(
+	ctx context.Context,
+	userAggregate *aggregate.UserAggregate,
+	identity *ExternalIdentity,
+	linkedBindings []*entity.BindingEntity,
+) (*aggregate.UserAggregate, error) {
+	changed := false
+
+	// 用户通过关联 binding 找到时补上主 binding
+	if !hasBinding(userAggregate.Bindings, identity.Binding) {
+		userAggregate.Bindings = append(userAggregate.Bindings, identity.Binding)
+		changed = true
+	}
+
+	if len(linkedBindings) > 0 {
+		userAggregate.Bindings = append(userAggregate.Bindings, linkedBindings...)
+		changed = true
+	}
+
+	// 提供方只在用户授权时返回邮箱，没有返回时保留原来的
+	if identity.Binding.Email != "" {
+		for _, binding := range userAggregate.Bindings {
+			if binding.Type == identity.Binding.Type && binding.Identity == identity.Binding.Identity && binding.Email != identity.Binding.Email {
+				binding.Email = identity.Binding.Email
+				changed = true
+			}
+		}
+	}
+
+	if identity.DisplayName != "" && identity.DisplayName != userAggregate.User.DisplayName {
+		userAggregate.User.DisplayName = identity.DisplayName
+		changed = true
+	}
+
+	if identity.Avatar != "" {
+		avatar := identity.Avatar
+		if identity.ImportAvatar {
+			imported, err := l.importAvatar(ctx, identity.Avatar, userAggregate.User.ID)
+			if err != nil {
+				l.logger.Warnf(ctx, "failed to import avatar: %v, using original URL", err)
+			} else {
+				avatar = imported
+			}
+		}
+
+		if avatar != userAggregate.User.Avatar {
+			userAggregate.User.Avatar = avatar
+			changed = true
+		}
+	}
+
+	if identity.Department != "" && identity.Department != userAggregate.User.Department {
+		userAggregate.User.Department = identity.Department
+		changed = true
+	}
+
+	if identity.WechatOpenID != nil && !hasWechatOpenIDPlatform(userAggregate.WechatOpenIDs, identity.WechatOpenID.Platform) {
+		userAggregate.WechatOpenIDs = append(userAggregate.WechatOpenIDs, identity.WechatOpenID)
+		changed = true
+	}
+
+	if identity.QyWechatUserID != nil && mergeQyWechatUserID(userAggregate, identity.QyWechatUserID) {
+		changed = true
+	}
+
+	if !changed {
+		return userAggregate, nil
+	}
+
+	userAggregate, err := l.userRepository.Update(ctx, userAggregate)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return userAggregate, nil
+}
+
+func (l *LoginService) joinOrganization(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	userAggregate *aggregate.UserAggregate,
+	membership *OrganizationMembership,
+) error {
+	organization := membership.Organization
+
+	organizationUser, err := l.organizationUserRepository.Find(ctx, userAggregate.User.ID, organization.Organization.ID)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if organizationUser == nil {
+		if application.DefaultOrgRole == nil {
+			return xerror.Wrap(ErrDefaultOrgRoleNotFound)
+		}
+
+		_, err = l.organizationUserRepository.Create(ctx, &aggregate.OrganizationUserAggregate{
+			Organization:     organization.Organization,
+			Application:      application.Application,
+			User:             userAggregate.User,
+			OrganizationRole: application.DefaultOrgRole,
+			Department:       membership.Department,
+		})
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	}
+
+	// 获取部门失败时保留原部门
+	if membership.Department != "" && organizationUser.Department != membership.Department && organizationUser.OrganizationRole != nil {
+		organizationUser.Department = membership.Department
+
+		_, err = l.organizationUserRepository.Update(ctx, organizationUser)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// importAvatar 下载第三方头像并上传到 OSS，第三方头像地址会过期
+func (l *LoginService) importAvatar(ctx context.Context, pictureURL string, userID string) (string, error) {
+	l.logger.Infof(ctx, "uploading picture to OSS: %s", pictureURL)
+
+	if l.ossClient == nil || l.config == nil || l.config.OSS == nil {
+		l.logger.Warnf(ctx, "OSS client or config not available, skipping picture upload")
+		return pictureURL, nil
+	}
+
+	// 下载图片
+	resp, err := l.httpClient.Get(pictureURL)
+	if err != nil {
+		return "", xerror.Wrap(fmt.Errorf("failed to download picture: %w", err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", xerror.Wrap(fmt.Errorf("failed to download picture: status %d", resp.StatusCode))
+	}
+
+	// 读取图片数据
+	imageData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", xerror.Wrap(fmt.Errorf("failed to read picture data: %w", err))
+	}
+
+	// 上传到 OSS
+	key := fmt.Sprintf("user/user_avatar/%s", userID)
+	if err := l.ossClient.PutObject(l.config.OSS.BucketName, key, bytes.NewReader(imageData)); err != nil {
+		return "", xerror.Wrap(fmt.Errorf("failed to upload picture to OSS: %w", err))
+	}
+
+	// 返回 CDN URL
+	if l.config.OSS.CDN != "" {
+		return fmt.Sprintf("https://%s/%s", l.config.OSS.CDN, key), nil
+	}
+
+	// 如果没有 CDN，返回 OSS URL
+	return fmt.Sprintf("https://%s.%s/%s", l.config.OSS.BucketName, l.config.OSS.Endpoint, key), nil
+}
+
+func hasBinding(bindings []*entity.BindingEntity, binding *entity.BindingEntity) bool {
+	for _, b := range bindings {
+		if b.Type == binding.Type && b.Identity == binding.Identity {
+			return true
+		}
+	}
+	return false
+}
+
+func hasWechatOpenIDPlatform(openIDs []*entity.WechatOpenIDEntity, platform enum.WechatOpenIDPlatform) bool {
+	for _, openID := range openIDs {
+		if openID.Platform == platform {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeQyWechatUserID 企业员工通过 userid 匹配并更新 openid，非企业员工通过 openid 匹配
+func mergeQyWechatUserID(userAggregate *aggregate.UserAggregate, qyWechatUserID *entity.QyWechatUserIDEntity) bool {
+	for _, existing := range userAggregate.QyWechatUserIDs {
+		if qyWechatUserID.QyWechatUserID != "" {
+			if existing.QyWechatUserID != qyWechatUserID.QyWechatUserID {
+				continue
+			}
+
+			if qyWechatUserID.OpenID == "" || existing.OpenID == qyWechatUserID.OpenID {
+				return false
+			}
+
+			existing.OpenID = qyWechatUserID.OpenID
+			return true
+		}
+
+		if existing.QyWechatUserID == "" && existing.OpenID == qyWechatUserID.OpenID {
+			return false
+		}
+	}
+
+	userAggregate.QyWechatUserIDs = append(userAggregate.QyWechatUserIDs, qyWechatUserID)
+	return true
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/utils"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// GuestLogin logs the guest holding guestSecret in. Without a secret an anonymous user with the guest
+// role is created and its secret returned, the client keeps it for later logins. Only the hash of the
+// secret is stored, as the identity of the guest binding. The guest keeps its user id when it links a
+// real identity later.
+func (l *LoginService) GuestLogin(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	guestRole *entity.RoleEntity,
+	guestSecret string,
+) (*aggregate.UserAggregate, string, error) {
+	if guestSecret != "" {
+		userAggregate, err := l.userRepository.FindByBindingForUpdate(ctx, application.Application.ID, &entity.BindingEntity{
+			Type:     enum.BindingTypeGuest,
+			Identity: utils.Sha256(guestSecret),
+		})
+		if err != nil {
+			return nil, "", xerror.Wrap(err)
+		}
+
+		// unknown, or upgraded and logging in with its identity since
+		if userAggregate == nil {
+			return nil, "", ErrGuestSecretInvalid
+		}
+
+		return userAggregate, "", nil
+	}
+
+	secret, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, "", xerror.Wrap(err)
+	}
+
+	userAggregate, err := l.ResolveAccount(ctx, application, entity.UserRefferalChannel{}, &ExternalIdentity{
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypeGuest,
+			Identity: utils.Sha256(secret),
+		},
+		PersonalRole: guestRole,
+	})
+	if err != nil {
+		return nil, "", xerror.Wrap(err)
+	}
+
+	return userAggregate, secret, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/oidc"
+	"strings"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// FederatedIdentity the identity of an oidc binding. Subjects are only unique per issuer (OpenID
+// Connect Core 1.0, section 2), the provider name is an admin label that may be renamed or reused
+// for another issuer. Issuers have no fragment, the first # ends the issuer.
+func FederatedIdentity(issuer string, subject string) string {
+	return strings.TrimSuffix(issuer, "/") + "#" + subject
+}
+
+// FederatedLogin 通用 OpenID Connect 身份提供方登录, identity 已由 FederationService 校验
+func (l *LoginService) FederatedLogin(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	refferalChannel entity.UserRefferalChannel,
+	provider *entity.IdentityProviderEntity,
+	identity *oidc.Identity,
+) (*aggregate.UserAggregate, error) {
+	l.logger.Infof(ctx, "start oidc login: %s, provider=%s, sub=%s", application.Application.Name, provider.Name, identity.Subject)
+
+	// 未经提供方验证的邮箱不能作为用户邮箱
+	email := ""
+	if identity.EmailVerified {
+		email = identity.Email
+	}
+
+	userAggregate, err := l.ResolveAccount(ctx, application, refferalChannel, &ExternalIdentity{
+		// issuer + sub 是唯一标识
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypeOIDC,
+			Identity: FederatedIdentity(provider.Issuer, identity.Subject),
+			Email:    email,
+		},
+		DisplayName: identity.Name,
+		Avatar:      identity.Picture,
+	})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return userAggregate, nil
+}
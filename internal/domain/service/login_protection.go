@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// maxDelayShift caps the doubling of the progressive delay
+const maxDelayShift = 16
+
+type loginPolicy struct {
+	maxFailures   int
+	ipMaxFailures int
+	delayAfter    int
+	baseDelay     time.Duration
+	maxDelay      time.Duration
+	lockout       time.Duration
+	window        time.Duration
+}
+
+type loginSubject struct {
+	scope       enum.LoginLockScope
+	subject     string
+	maxFailures int
+}
+
+// LoginProtectionService counts failed password logins per user name and per client ip of an application,
+// delays further attempts progressively and locks the subject once the limit is reached
+type LoginProtectionService struct {
+	loginLockRepository contract.ILoginLockRepository
+	config              *config.Config
+}
+
+func NewLoginProtectionService(loginLockRepository contract.ILoginLockRepository, config *config.Config) *LoginProtectionService {
+	return &LoginProtectionService{
+		loginLockRepository: loginLockRepository,
+		config:              config,
+	}
+}
+
+// Check returns ErrLoginLocked or ErrLoginThrottled when the user name or the client ip may not attempt a login now
+func (l *LoginProtectionService) Check(ctx context.Context, applicationName string, name string, ip string) error {
+	if !l.config.LoginProtection.Enabled {
+		return nil
+	}
+
+	now := time.Now()
+	for _, subject := range l.subjects(l.policy(applicationName), name, ip) {
+		lock, err := l.loginLockRepository.FindBySubject(ctx, applicationName, subject.scope, subject.subject)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if lock == nil {
+			continue
+		}
+
+		if now.Before(lock.LockedUntil) {
+			return ErrLoginLocked
+		}
+
+		if now.Before(lock.NextAttemptAt) {
+			return ErrLoginThrottled
+		}
+	}
+
+	return nil
+}
+
+// Failures recent failed logins of the user name or of the client ip, whichever has more
+func (l *LoginProtectionService) Failures(ctx context.Context, applicationName string, name string, ip string) (int, error) {
+	if !l.config.LoginProtection.Enabled {
+		return 0, nil
+	}
+
+	policy := l.policy(applicationName)
+
+	now := time.Now()
+	failures := 0
+	for _, subject := range l.subjects(policy, name, ip) {
+		lock, err := l.loginLockRepository.FindBySubject(ctx, applicationName, subject.scope, subject.subject)
+		if err != nil {
+			return 0, xerror.Wrap(err)
+		}
+
+		// failures older than the window are no longer counted
+		if lock == nil || lock.LockedUntil.IsZero() && now.Sub(lock.LastFailureAt) > policy.window {
+			continue
+		}
+
+		failures = max(failures, lock.Failures)
+	}
+
+	return failures, nil
+}
+
+// RecordFailure count a failed login, returns the locks put in place by this failure
+func (l *LoginProtectionService) RecordFailure(ctx context.Context, applicationName string, name string, ip string) ([]*entity.LoginLockEntity, error) {
+	if !l.config.LoginProtection.Enabled {
+		return nil, nil
+	}
+
+	policy := l.policy(applicationName)
+
+	var locked []*entity.LoginLockEntity
+	for _, subject := range l.subjects(policy, name, ip) {
+		var lock *entity.LoginLockEntity
+		newlyLocked := false
+
+		if err := l.loginLockRepository.WithTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			lock, err = l.loginLockRepository.FindBySubjectForUpdate(ctx, applicationName, subject.scope, subject.subject)
+			if err != nil {
+				return xerror.Wrap(err)
+			}
+
+			now := time.Now()
+
+			if lock == nil {
+				lock = &entity.LoginLockEntity{
+					ApplicationName: applicationName,
+					Scope:           subject.scope,
+					Subject:         subject.subject,
+				}
+			} else if !lock.LockedUntil.IsZero() && !now.Before(lock.LockedUntil) ||
+				lock.LockedUntil.IsZero() && now.Sub(lock.LastFailureAt) > policy.window {
+				// the lockout is over or the previous failures are too old, start counting again
+				lock.Failures = 0
+				lock.LockedUntil = time.Time{}
+			}
+
+			lock.Failures++
+			lock.LastFailureAt = now
+			lock.NextAttemptAt = time.Time{}
+
+			if lock.Failures >= subject.maxFailures {
+				if lock.LockedUntil.IsZero() {
+					lock.LockedUntil = now.Add(policy.lockout)
+					newlyLocked = true
+				}
+			} else if policy.delayAfter > 0 && lock.Failures >= policy.delayAfter {
+				lock.NextAttemptAt = now.Add(policy.delay(lock.Failures - policy.delayAfter))
+			}
+
+			if lock.ID == uuid.Nil {
+				lock, err = l.loginLockRepository.Create(ctx, lock)
+			} else {
+				lock, err = l.loginLockRepository.Update(ctx, lock)
+			}
+			if err != nil {
+				return xerror.Wrap(err)
+			}
+
+			return nil
+		}); err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		if newlyLocked {
+			locked = append(locked, lock)
+		}
+	}
+
+	return locked, nil
+}
+
+// RecordSuccess forget the failures of the user name, the ones of the client ip are kept
+func (l *LoginProtectionService) RecordSuccess(ctx context.Context, applicationName string, name string) error {
+	if !l.config.LoginProtection.Enabled {
+		return nil
+	}
+
+	lock, err := l.loginLockRepository.FindBySubject(ctx, applicationName, enum.LoginLockScopeUser, name)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if lock == nil {
+		return nil
+	}
+
+	if err := l.loginLockRepository.Delete(ctx, lock); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// ListLocks active lockouts, all applications when applicationName is empty
+func (l *LoginProtectionService) ListLocks(ctx context.Context, applicationName string) ([]*entity.LoginLockEntity, error) {
+	locks, err := l.loginLockRepository.FindLocked(ctx, applicationName, time.Now())
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return locks, nil
+}
+
+// ClearLock unlock a user name or client ip and forget its failures
+func (l *LoginProtectionService) ClearLock(ctx context.Context, id uuid.UUID) error {
+	lock, err := l.loginLockRepository.Find(ctx, id)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if lock == nil {
+		return ErrLoginLockNotFound
+	}
+
+	if err := l.loginLockRepository.Delete(ctx, lock); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (l *LoginProtectionService) subjects(policy *loginPolicy, name string, ip string) []loginSubject {
+	subjects := make([]loginSubject, 0, 2)
+
+	if name != "" && policy.maxFailures > 0 {
+		subjects = append(subjects, loginSubject{scope: enum.LoginLockScopeUser, subject: name, maxFailures: policy.maxFailures})
+	}
+
+	if ip != "" && policy.ipMaxFailures > 0 {
+		subjects = append(subjects, loginSubject{scope: enum.LoginLockScopeIP, subject: ip, maxFailures: policy.ipMaxFailures})
+	}
+
+	return subjects
+}
+
+func (l *LoginProtectionService) policy(applicationName string) *loginPolicy {
+	c := l.config.LoginProtection
+
+	policy := &loginPolicy{
+		maxFailures:   c.MaxFailures,
+		ipMaxFailures: c.IPMaxFailures,
+		delayAfter:    c.DelayAfter,
+		baseDelay:     time.Duration(c.BaseDelaySecond) * time.Second,
+		maxDelay:      time.Duration(c.MaxDelaySecond) * time.Second,
+		lockout:       time.Duration(c.LockoutSecond) * time.Second,
+		window:        time.Duration(c.WindowSecond) * time.Second,
+	}
+
+	override, ok := c.Applications[applicationName]
+	if !ok || override == nil {
+		return policy
+	}
+
+	if override.MaxFailures != 0 {
+		policy.maxFailures = override.MaxFailures
+	}
+	if override.IPMaxFailures != 0 {
+		policy.ipMaxFailures = override.IPMaxFailures
+	}
+	if override.DelayAfter != 0 {
+		policy.delayAfter = override.DelayAfter
+	}
+	if override.BaseDelaySecond != 0 {
+		policy.baseDelay = time.Duration(override.BaseDelaySecond) * time.Second
+	}
+	if override.MaxDelaySecond != 0 {
+		policy.maxDelay = time.Duration(override.MaxDelaySecond) * time.Second
+	}
+	if override.LockoutSecond != 0 {
+		policy.lockout = time.Duration(override.LockoutSecond) * time.Second
+	}
+	if override.WindowSecond != 0 {
+		policy.window = time.Duration(override.WindowSecond) * time.Second
+	}
+
+	return policy
+}
+
+// delay doubles from the base delay with every failure past delayAfter
+func (p *loginPolicy) delay(step int) time.Duration {
+	if step > maxDelayShift {
+		step = maxDelayShift
+	}
+
+	delay := p.baseDelay << step
+	if p.maxDelay > 0 && delay > p.maxDelay {
+		delay = p.maxDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// Login providers prove the ownership of an external account and describe it. Finding or creating
+// the user of the account is left to ResolveAccount, shared by every login.
+
+const (
+	LoginProviderWechat            = "wechat"
+	LoginProviderWechatMiniProgram = "wechat_miniprogram"
+	LoginProviderQyWechat          = "qywechat"
+	LoginProviderGoogle            = "google"
+	LoginProviderApple             = "apple"
+	LoginProviderPhone             = "phone"
+	LoginProviderEmail             = "email"
+)
+
+// LoginCredential what a client sends to log in or link an account, each provider reads its fields
+type LoginCredential struct {
+	// Code the authorization code, verification code or mini program phone code
+	Code        string
+	RedirectURI string
+	Platform    string
+	// Identity the phone number or email address the verification code was sent to
+	Identity string
+	// PhoneCode the phone number code of a mini program login, links the phone to the wechat user
+	PhoneCode string
+	CorpID    string
+	// IdentityToken, Nonce and Name of native Sign in with Apple
+	IdentityToken string
+	Nonce         string
+	Name          string
+}
+
+// OrganizationMembership the organization a login provisions its user into
+type OrganizationMembership struct {
+	Organization *aggregate.OrganizationAggregate
+	Department   string
+}
+
+// ExternalIdentity an external account proven by a provider, empty fields are left as they are
+type ExternalIdentity struct {
+	// Binding type, identity and email of the account, its user is the user of the login
+	Binding *entity.BindingEntity
+	// LinkedBindings proven by the same login, they are added to the user unless another user has them
+	LinkedBindings []*entity.BindingEntity
+
+	// Name the user name of new users, random when empty
+	Name         string
+	DisplayName  string
+	Avatar       string
+	ImportAvatar bool
+	Department   string
+
+	WechatOpenID   *entity.WechatOpenIDEntity
+	QyWechatUserID *entity.QyWechatUserIDEntity
+
+	// PersonalRole of new users, the default personal role of the application when nil
+	PersonalRole *entity.RoleEntity
+	Membership   *OrganizationMembership
+}
+
+// LoginProvider resolves the external identity of a credential without finding or creating a user,
+// account linking binds the result to the logged in user
+type LoginProvider interface {
+	Name() string
+	Resolve(ctx context.Context, application *entity.ApplicationEntity, credential LoginCredential) (*ExternalIdentity, error)
+}
+
+type LoginProviderRegistry struct {
+	providers map[string]LoginProvider
+}
+
+// NewLoginProviderRegistry the providers annotated into the login_providers group
+func NewLoginProviderRegistry(providers []LoginProvider) *LoginProviderRegistry {
+	registry := &LoginProviderRegistry{
+		providers: make(map[string]LoginProvider, len(providers)),
+	}
+
+	for _, provider := range providers {
+		registry.providers[provider.Name()] = provider
+	}
+
+	return registry
+}
+
+func (r *LoginProviderRegistry) Provider(name string) (LoginProvider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, xerror.Wrap(ErrLoginProviderNotFound)
+	}
+
+	return provider, nil
+}
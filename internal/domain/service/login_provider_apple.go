@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/apple"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// AppleLoginProvider Sign in with Apple, the authorization code of a web login or the identity token
+// of the iOS app. Apple only sends the name on the first sign in.
+type AppleLoginProvider struct {
+	logger      logger.ILogger
+	appleClient *apple.Client
+}
+
+func NewAppleLoginProvider(logger logger.ILogger, appleClient *apple.Client) *AppleLoginProvider {
+	return &AppleLoginProvider{
+		logger:      logger,
+		appleClient: appleClient,
+	}
+}
+
+func (a *AppleLoginProvider) Name() string {
+	return LoginProviderApple
+}
+
+func (a *AppleLoginProvider) Resolve(ctx context.Context, application *entity.ApplicationEntity, credential LoginCredential) (*ExternalIdentity, error) {
+	// 1. 校验 identity token, web 登录先用授权码换取
+	identityToken := credential.IdentityToken
+	if identityToken == "" {
+		var err error
+		identityToken, err = a.appleClient.ExchangeCode(ctx, credential.Code, credential.RedirectURI)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+	}
+
+	claims, err := a.appleClient.VerifyIdentityToken(ctx, identityToken, credential.Nonce)
+	if err != nil {
+		a.logger.Errorf(ctx, "failed to verify apple identity token: %s", err)
+		return nil, xerror.Wrap(err)
+	}
+
+	a.logger.Infof(ctx, "apple user info: sub=%s, private_email=%t", claims.Subject, claims.IsPrivateRelayEmail())
+
+	// 2. sub 是唯一标识, email 可能是 Hide My Email 的中转地址
+	return &ExternalIdentity{
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypeApple,
+			Identity: claims.Subject,
+			Email:    claims.Email,
+		},
+		DisplayName: credential.Name,
+	}, nil
+}
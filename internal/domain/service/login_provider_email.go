@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// EmailLoginProvider email verification code login, the code is consumed once verified
+type EmailLoginProvider struct {
+	logger                   logger.ILogger
+	vertificationCodeService *VertificationCodeService
+}
+
+func NewEmailLoginProvider(logger logger.ILogger, vertificationCodeService *VertificationCodeService) *EmailLoginProvider {
+	return &EmailLoginProvider{
+		logger:                   logger,
+		vertificationCodeService: vertificationCodeService,
+	}
+}
+
+func (e *EmailLoginProvider) Name() string {
+	return LoginProviderEmail
+}
+
+func (e *EmailLoginProvider) Resolve(ctx context.Context, application *entity.ApplicationEntity, credential LoginCredential) (*ExternalIdentity, error) {
+	verified, err := e.vertificationCodeService.VerifyEmailCode(ctx, credential.Identity, credential.Code, enum.VertificationCodeTypeLogin)
+	if err != nil {
+		e.logger.Debugf(ctx, "email login code verified fail: %w", err)
+		if xerror.Is(err, ErrVerificationCodeExhausted) {
+			return nil, xerror.Wrap(ErrVerificationCodeExhausted)
+		}
+		if xerror.Is(err, ErrVerificationCodeNotFound) || xerror.Is(err, ErrVerificationCodeInvalid) {
+			return nil, xerror.Wrap(ErrLoginCredentialInvalid)
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	if !verified {
+		return nil, xerror.Wrap(ErrLoginCredentialInvalid)
+	}
+
+	return EmailIdentity(credential.Identity), nil
+}
+
+// EmailIdentity the identity of a proven email address, by a code or an opened login link
+func EmailIdentity(email string) *ExternalIdentity {
+	return &ExternalIdentity{
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypeEmail,
+			Identity: email,
+		},
+	}
+}
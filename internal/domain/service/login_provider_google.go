@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"google.golang.org/api/idtoken"
+)
+
+// GoogleLoginProvider Google Web 登录，头像导入到 OSS
+type GoogleLoginProvider struct {
+	logger     logger.ILogger
+	httpClient *xhttp.Client
+
+	googleClientID     string
+	googleClientSecret string
+}
+
+func NewGoogleLoginProvider(logger logger.ILogger, config *config.Config, httpClient *xhttp.Client) *GoogleLoginProvider {
+	provider := &GoogleLoginProvider{
+		logger:     logger,
+		httpClient: httpClient,
+	}
+
+	if config.Google != nil {
+		provider.googleClientID = config.Google.ClientID
+		provider.googleClientSecret = config.Google.ClientSecret
+	}
+
+	return provider
+}
+
+func (g *GoogleLoginProvider) Name() string {
+	return LoginProviderGoogle
+}
+
+func (g *GoogleLoginProvider) Resolve(ctx context.Context, application *entity.ApplicationEntity, credential LoginCredential) (*ExternalIdentity, error) {
+	// 1. 使用授权码换取 ID token 和 access token
+	idToken, _, err := g.getGoogleTokensFromCode(ctx, credential.Code, credential.RedirectURI)
+	if err != nil {
+		g.logger.Errorf(ctx, "failed to exchange code for tokens %s, error: %s", credential.Code, err)
+		return nil, xerror.Wrap(err)
+	}
+
+	// 2. 使用 Google 官方 SDK 验证 ID token 并获取 Google 用户信息
+	googleUserInfo, err := g.verifyGoogleIdToken(ctx, idToken)
+	if err != nil {
+		g.logger.Errorf(ctx, "failed to verify google id token: %s", err)
+		return nil, xerror.Wrap(err)
+	}
+
+	g.logger.Infof(ctx, "google user info: sub=%s, email=%s, name=%s", googleUserInfo.Sub, googleUserInfo.Email, googleUserInfo.Name)
+
+	// 使用 sub (subject) 作为用户的唯一标识
+	return &ExternalIdentity{
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypeGoogle,
+			Identity: googleUserInfo.Sub,
+			Email:    googleUserInfo.Email,
+		},
+		DisplayName:  googleUserInfo.Name,
+		Avatar:       googleUserInfo.Picture,
+		ImportAvatar: true,
+	}, nil
+}
+
+type googleUserInfo struct {
+	Sub           string // Google user ID (unique identifier)
+	Email         string // User's email address
+	EmailVerified bool   // Whether the email is verified
+	Name          string // User's full name
+	GivenName     string // User's first name
+	FamilyName    string // User's last name
+	Picture       string // URL of user's profile picture
+	Locale        string // User's locale
+}
+
+type googleTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+	Scope            string `json:"scope"`
+	TokenType        string `json:"token_type"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// getGoogleTokensFromCode 使用授权码换取 Google ID token 和 access token
+func (g *GoogleLoginProvider) getGoogleTokensFromCode(ctx context.Context, code string, redirectURI string) (string, string, error) {
+	if g.googleClientID == "" || g.googleClientSecret == "" {
+		return "", "", xerror.Wrap(errors.New("google client credentials are not configured"))
+	}
+
+	// 构建请求参数
+	apiURL := "https://oauth2.googleapis.com/token"
+
+	data := url.Values{}
+	data.Set("code", code)
+	data.Set("client_id", g.googleClientID)
+	data.Set("client_secret", g.googleClientSecret)
+	data.Set("redirect_uri", redirectURI)
+	data.Set("grant_type", "authorization_code")
+
+	request, err := http.NewRequestWithContext(ctx, "POST", apiURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := g.httpClient.Do(request)
+	if err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+
+	var tokenResp googleTokenResponse
+	if err := json.Unmarshal(b, &tokenResp); err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+
+	if tokenResp.Error != "" {
+		return "", "", xerror.Wrap(fmt.Errorf("failed to exchange code for token: %s - %s", tokenResp.Error, tokenResp.ErrorDescription))
+	}
+
+	if tokenResp.IDToken == "" {
+		return "", "", xerror.Wrap(errors.New("id_token is empty in response"))
+	}
+
+	if tokenResp.AccessToken == "" {
+		return "", "", xerror.Wrap(errors.New("access_token is empty in response"))
+	}
+
+	return tokenResp.IDToken, tokenResp.AccessToken, nil
+}
+
+// verifyGoogleIdToken 使用 Google 官方 SDK 验证 ID token
+// 使用 google.golang.org/api/idtoken 包，这是生产环境推荐方案
+func (g *GoogleLoginProvider) verifyGoogleIdToken(ctx context.Context, idToken string) (*googleUserInfo, error) {
+	// 检查是否配置了 Client ID
+	if g.googleClientID == "" {
+		return nil, xerror.Wrap(errors.New("google client ID is not configured"))
+	}
+
+	// 使用官方 SDK 验证 ID token
+	// Validate 会自动验证签名、iss、aud、exp 等字段
+	payload, err := idtoken.Validate(ctx, idToken, g.googleClientID)
+	if err != nil {
+		g.logger.Errorf(ctx, "failed to validate google id token: %w", err)
+		return nil, xerror.Wrap(fmt.Errorf("invalid id token: %w", err))
+	}
+
+	g.logger.Debugf(ctx, "google id token validated: sub=%s, iss=%s, aud=%s", payload.Subject, payload.Issuer, payload.Audience)
+
+	// 验证 issuer 是否为 Google
+	if payload.Issuer != "https://accounts.google.com" && payload.Issuer != "accounts.google.com" {
+		return nil, xerror.Wrap(fmt.Errorf("invalid token issuer: %s", payload.Issuer))
+	}
+
+	// 构建用户信息
+	userInfo := &googleUserInfo{
+		Sub: payload.Subject,
+	}
+
+	// 从 Claims 中提取可选字段（需要用户授权了 profile 和 email scope）
+	if email, ok := payload.Claims["email"].(string); ok {
+		userInfo.Email = email
+	}
+	if emailVerified, ok := payload.Claims["email_verified"].(bool); ok {
+		userInfo.EmailVerified = emailVerified
+	}
+	if name, ok := payload.Claims["name"].(string); ok {
+		userInfo.Name = name
+	}
+	if givenName, ok := payload.Claims["given_name"].(string); ok {
+		userInfo.GivenName = givenName
+	}
+	if familyName, ok := payload.Claims["family_name"].(string); ok {
+		userInfo.FamilyName = familyName
+	}
+	if picture, ok := payload.Claims["picture"].(string); ok {
+		userInfo.Picture = picture
+	}
+	if locale, ok := payload.Claims["locale"].(string); ok {
+		userInfo.Locale = locale
+	}
+
+	return userInfo, nil
+}
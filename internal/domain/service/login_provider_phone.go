@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// PhoneLoginProvider 手机号验证码登录，platform 为 miniprogram 时 code 是小程序的手机号 code
+type PhoneLoginProvider struct {
+	smsService  *SmsService
+	miniProgram *WechatMiniProgramLoginProvider
+}
+
+func NewPhoneLoginProvider(logger logger.ILogger, config *config.Config, httpClient *xhttp.Client, smsService *SmsService) *PhoneLoginProvider {
+	return &PhoneLoginProvider{
+		smsService:  smsService,
+		miniProgram: NewWechatMiniProgramLoginProvider(logger, config, httpClient),
+	}
+}
+
+func (p *PhoneLoginProvider) Name() string {
+	return LoginProviderPhone
+}
+
+func (p *PhoneLoginProvider) Resolve(ctx context.Context, application *entity.ApplicationEntity, credential LoginCredential) (*ExternalIdentity, error) {
+	phone := credential.Identity
+
+	if credential.Platform == "miniprogram" {
+		var err error
+		phone, err = p.miniProgram.Phone(credential.Code)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+	} else {
+		if phone == "" {
+			return nil, xerror.Wrap(ErrLoginCredentialInvalid)
+		}
+
+		verified, err := p.smsService.VerifyCode(ctx, phone, credential.Code, enum.VertificationCodeTypeLogin)
+		if err != nil {
+			if xerror.Is(err, ErrVerificationCodeExhausted) {
+				return nil, xerror.Wrap(ErrVerificationCodeExhausted)
+			}
+			if xerror.Is(err, ErrVerificationCodeNotFound) || xerror.Is(err, ErrVerificationCodeInvalid) {
+				return nil, xerror.Wrap(ErrLoginCredentialInvalid)
+			}
+			return nil, xerror.Wrap(err)
+		}
+
+		if !verified {
+			return nil, xerror.Wrap(ErrLoginCredentialInvalid)
+		}
+	}
+
+	return &ExternalIdentity{
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypePhone,
+			Identity: phone,
+		},
+	}, nil
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"net/http"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// QyWechatLoginProvider 企业微信登录
+// corp 映射到组织时，企业员工自动以应用的默认组织角色加入组织并同步部门
+type QyWechatLoginProvider struct {
+	logger          logger.ILogger
+	httpClient      *xhttp.Client
+	qyWechatService *QyWechatService
+}
+
+func NewQyWechatLoginProvider(logger logger.ILogger, httpClient *xhttp.Client, qyWechatService *QyWechatService) *QyWechatLoginProvider {
+	return &QyWechatLoginProvider{
+		logger:          logger,
+		httpClient:      httpClient,
+		qyWechatService: qyWechatService,
+	}
+}
+
+func (q *QyWechatLoginProvider) Name() string {
+	return LoginProviderQyWechat
+}
+
+func (q *QyWechatLoginProvider) Resolve(ctx context.Context, application *entity.ApplicationEntity, credential LoginCredential) (*ExternalIdentity, error) {
+	// 1. 签发 code 的 corp，映射的组织必须属于当前应用
+	corp, err := q.qyWechatService.ResolveCorp(ctx, credential.CorpID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if corp.Organization != nil && corp.Organization.Application.ID != application.ID {
+		return nil, xerror.Wrap(ErrQyWechatCorpNotFound)
+	}
+
+	q.logger.Infof(ctx, "start qy wechat login: %s, %s, corp=%s", credential.Code, application.Name, corp.CorpID)
+
+	// 2. 获取企业微信 access_token
+	accessToken, err := q.getQyWechatAccessToken(corp.CorpID, corp.CorpSecret)
+	if err != nil {
+		q.logger.Errorf(ctx, "failed to get qy wechat access token: %w", err)
+		return nil, xerror.Wrap(err)
+	}
+
+	// 3. 获取企业微信用户信息
+	userInfo, err := q.getQyWechatUserInfo(accessToken, credential.Code)
+	if err != nil {
+		q.logger.Errorf(ctx, "failed to get qy wechat user info: %w", err)
+		return nil, xerror.Wrap(err)
+	}
+
+	// 企业微信接口返回：如果是企业员工返回 UserID，非企业员工返回 OpenID
+	if userInfo.UserID == "" && userInfo.OpenID == "" {
+		return nil, xerror.Wrap(errors.New("both userID and openID are empty"))
+	}
+
+	q.logger.Infof(ctx, "qy wechat user info: %s, %s, %s", userInfo.UserID, userInfo.OpenID, userInfo.UserTicket)
+
+	// 4. 获取用户详细信息（包括 avatar）
+	var avatar string
+	if userInfo.UserTicket != "" {
+		userDetail, err := q.getQyWechatUserDetail(accessToken, userInfo.UserTicket)
+		if err != nil {
+			q.logger.Warnf(ctx, "failed to get qy wechat user detail: %w", err)
+			// 如果获取详细信息失败，继续使用基本信息登录
+		} else {
+			avatar = userDetail.Avatar
+			q.logger.Infof(ctx, "qy wechat user detail: %s", avatar)
+		}
+	}
+
+	// 5. 获取用户真实姓名和部门（仅企业员工，有 UserID）
+	var realName string
+	var department string
+	if userInfo.UserID != "" {
+		userProfile, err := q.getQyWechatUserProfile(accessToken, userInfo.UserID)
+		if err != nil {
+			q.logger.Warnf(ctx, "failed to get qy wechat user profile: %w", err)
+			// 获取失败不影响登录，只打印日志
+		} else {
+			realName = userProfile.Name
+			q.logger.Infof(ctx, "qy wechat user profile: %s, %v", realName, userProfile.Department)
+
+			// 获取部门名称（取第一个部门）
+			if len(userProfile.Department) > 0 {
+				deptName, err := q.getQyWechatDepartmentName(accessToken, userProfile.Department[0])
+				if err != nil {
+					q.logger.Warnf(ctx, "failed to get qy wechat department name: %w", err)
+					// 获取部门名称失败不影响登录，只打印日志
+				} else {
+					department = deptName
+					q.logger.Infof(ctx, "qy wechat department name: %s", department)
+				}
+			}
+		}
+	}
+
+	// 所有用户都使用 qy_wechat binding，identity 使用 userid（企业员工）或 openid（非企业员工）
+	bindingIdentity := userInfo.UserID
+	if bindingIdentity == "" {
+		bindingIdentity = userInfo.OpenID
+	}
+
+	identity := &ExternalIdentity{
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypeQyWechat,
+			Identity: q.qyWechatService.BindingIdentity(corp.CorpID, bindingIdentity),
+		},
+		// 获取到真实姓名时作为新用户的用户名
+		Name:       realName,
+		Avatar:     avatar,
+		Department: department,
+		// 企业员工 qy_wechat_user_id 字段有值，非企业员工 open_id 字段有值
+		QyWechatUserID: &entity.QyWechatUserIDEntity{
+			QyWechatUserID: userInfo.UserID,
+			OpenID:         userInfo.OpenID,
+		},
+	}
+
+	// 6. 企业员工加入 corp 映射的组织
+	if corp.Organization != nil && userInfo.UserID != "" {
+		identity.Membership = &OrganizationMembership{
+			Organization: corp.Organization,
+			Department:   department,
+		}
+	}
+
+	return identity, nil
+}
+
+// getQyWechatAccessToken 获取企业微信的 access_token
+func (q *QyWechatLoginProvider) getQyWechatAccessToken(corpID, corpSecret string) (string, error) {
+	url := fmt.Sprintf("https://qyapi.weixin.qq.com/cgi-bin/gettoken?corpid=%s&corpsecret=%s", corpID, corpSecret)
+
+	resp, err := q.httpClient.Get(url)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	defer resp.Body.Close()
+
+	body := struct {
+		Errcode     int    `json:"errcode"`
+		Errmsg      string `json:"errmsg"`
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if body.Errcode != 0 {
+			return "", xerror.Wrap(fmt.Errorf("qy wechat api error: %d, %s", body.Errcode, body.Errmsg))
+		}
+		if body.AccessToken == "" {
+			return "", xerror.Wrap(errors.New("access_token is empty"))
+		}
+		return body.AccessToken, nil
+	}
+
+	return "", xerror.Wrap(errors.New("unknown server error"))
+}
+
+type qyWechatUserInfo struct {
+	Errcode    int    `json:"errcode"`
+	Errmsg     string `json:"errmsg"`
+	UserID     string `json:"UserId"`
+	DeviceID   string `json:"DeviceId"`
+	UserTicket string `json:"user_ticket"`
+	OpenID     string `json:"OpenId"`
+}
+
+type qyWechatUserDetail struct {
+	Errcode int    `json:"errcode"`
+	Errmsg  string `json:"errmsg"`
+	UserID  string `json:"userid"`
+	Gender  string `json:"gender"`
+	Avatar  string `json:"avatar"`
+	QrCode  string `json:"qr_code"`
+	Mobile  string `json:"mobile"`
+	Email   string `json:"email"`
+	BizMail string `json:"biz_mail"`
+	Address string `json:"address"`
+}
+
+// getQyWechatUserInfo 获取企业微信用户信息
+func (q *QyWechatLoginProvider) getQyWechatUserInfo(accessToken, code string) (*qyWechatUserInfo, error) {
+	url := fmt.Sprintf("https://qyapi.weixin.qq.com/cgi-bin/user/getuserinfo?access_token=%s&code=%s", accessToken, code)
+
+	resp, err := q.httpClient.Get(url)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	defer resp.Body.Close()
+
+	userInfo := qyWechatUserInfo{}
+	if err := json.Unmarshal(b, &userInfo); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if userInfo.Errcode != 0 {
+			return nil, xerror.Wrap(fmt.Errorf("qy wechat api error: %d, %s", userInfo.Errcode, userInfo.Errmsg))
+		}
+		return &userInfo, nil
+	}
+
+	return nil, xerror.Wrap(errors.New("unknown server error"))
+}
+
+// getQyWechatUserDetail 获取企业微信用户敏感信息
+func (q *QyWechatLoginProvider) getQyWechatUserDetail(accessToken, userTicket string) (*qyWechatUserDetail, error) {
+	url := fmt.Sprintf("https://qyapi.weixin.qq.com/cgi-bin/user/getuserdetail?access_token=%s", accessToken)
+
+	body := struct {
+		UserTicket string `json:"user_ticket"`
+	}{UserTicket: userTicket}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	resp, err := q.httpClient.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	b, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	defer resp.Body.Close()
+
+	userDetail := qyWechatUserDetail{}
+	if err := json.Unmarshal(b, &userDetail); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if userDetail.Errcode != 0 {
+			return nil, xerror.Wrap(fmt.Errorf("qy wechat api error: %d, %s", userDetail.Errcode, userDetail.Errmsg))
+		}
+		return &userDetail, nil
+	}
+
+	return nil, xerror.Wrap(errors.New("unknown server error"))
+}
+
+type qyWechatUserProfile struct {
+	Errcode    int    `json:"errcode"`
+	Errmsg     string `json:"errmsg"`
+	UserID     string `json:"userid"`
+	Name       string `json:"name"`
+	Department []int  `json:"department"`
+	Position   string `json:"position"`
+	Mobile     string `json:"mobile"`
+	Gender     string `json:"gender"`
+	Email      string `json:"email"`
+	Avatar     string `json:"avatar"`
+	Status     int    `json:"status"`
+}
+
+type qyWechatDepartment struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	ParentID int    `json:"parentid"`
+	Order    int    `json:"order"`
+}
+
+type qyWechatDepartmentList struct {
+	Errcode    int                  `json:"errcode"`
+	Errmsg     string               `json:"errmsg"`
+	Department []qyWechatDepartment `json:"department"`
+}
+
+// getQyWechatUserProfile 获取企业微信用户基本信息（包括姓名和部门）
+func (q *QyWechatLoginProvider) getQyWechatUserProfile(accessToken, userID string) (*qyWechatUserProfile, error) {
+	url := fmt.Sprintf("https://qyapi.weixin.qq.com/cgi-bin/user/get?access_token=%s&userid=%s", accessToken, userID)
+
+	resp, err := q.httpClient.Get(url)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	defer resp.Body.Close()
+
+	userProfile := qyWechatUserProfile{}
+	if err := json.Unmarshal(b, &userProfile); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if userProfile.Errcode != 0 {
+			return nil, xerror.Wrap(fmt.Errorf("qy wechat api error: %d, %s", userProfile.Errcode, userProfile.Errmsg))
+		}
+		return &userProfile, nil
+	}
+
+	return nil, xerror.Wrap(errors.New("unknown server error"))
+}
+
+// getQyWechatDepartmentName 根据部门ID获取部门名称
+func (q *QyWechatLoginProvider) getQyWechatDepartmentName(accessToken string, departmentID int) (string, error) {
+	// 获取部门列表（指定 id 会返回该部门及其子部门，不指定则返回所有部门）
+	// 为了准确获取单个部门信息，先尝试指定 id，如果失败则获取所有部门
+	url := fmt.Sprintf("https://qyapi.weixin.qq.com/cgi-bin/department/list?access_token=%s&id=%d", accessToken, departmentID)
+
+	resp, err := q.httpClient.Get(url)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	defer resp.Body.Close()
+
+	deptList := qyWechatDepartmentList{}
+	if err := json.Unmarshal(b, &deptList); err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if deptList.Errcode != 0 {
+			return "", xerror.Wrap(fmt.Errorf("qy wechat api error: %d, %s", deptList.Errcode, deptList.Errmsg))
+		}
+		// 查找匹配的部门
+		for _, dept := range deptList.Department {
+			if dept.ID == departmentID {
+				return dept.Name, nil
+			}
+		}
+		return "", xerror.Wrap(errors.New("department not found"))
+	}
+
+	return "", xerror.Wrap(errors.New("unknown server error"))
+}
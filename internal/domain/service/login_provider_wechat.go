@@ -0,0 +1,389 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"net/http"
+	"strings"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+type wechatUserInfo struct {
+	OpenID     string `json:"openid"`
+	Nickname   string `json:"nickname"`
+	Sex        int    `json:"sex"`
+	Province   string `json:"province"`
+	City       string `json:"city"`
+	Country    string `json:"country"`
+	HeadimgURL string `json:"headimgurl"`
+	Unionid    string `json:"unionid"`
+	ErrCode    int    `json:"errcode"`
+	ErrMSG     string `json:"errmsg"`
+}
+
+// WechatLoginProvider 微信网页登录，platform 为 officalaccount 时使用公众号网页授权
+type WechatLoginProvider struct {
+	logger     logger.ILogger
+	httpClient *xhttp.Client
+
+	wechatWebID                string
+	wechatWebSecret            string
+	wechatOfficalAccountID     string
+	wechatOfficalAccountSecret string
+}
+
+func NewWechatLoginProvider(logger logger.ILogger, config *config.Config, httpClient *xhttp.Client) *WechatLoginProvider {
+	provider := &WechatLoginProvider{
+		logger:     logger,
+		httpClient: httpClient,
+	}
+
+	if config.Wechat != nil {
+		provider.wechatWebID = config.Wechat.WebID
+		provider.wechatWebSecret = config.Wechat.WebSecret
+		provider.wechatOfficalAccountID = config.Wechat.OfficalAccountID
+		provider.wechatOfficalAccountSecret = config.Wechat.OfficalAccountSecret
+	}
+
+	return provider
+}
+
+func (w *WechatLoginProvider) Name() string {
+	return LoginProviderWechat
+}
+
+func (w *WechatLoginProvider) Resolve(ctx context.Context, application *entity.ApplicationEntity, credential LoginCredential) (*ExternalIdentity, error) {
+	clientID := w.wechatWebID
+	clientSecret := w.wechatWebSecret
+	if credential.Platform == "officalaccount" {
+		clientID = w.wechatOfficalAccountID
+		clientSecret = w.wechatOfficalAccountSecret
+	}
+
+	openid, accessToken, err := w.getWechatAccessToken(ctx, credential.Code, clientID, clientSecret)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	wechatUserInfo, err := w.getWechatUserInfo(accessToken, openid)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	w.logger.Debugf(ctx, "wechat user info: %w", wechatUserInfo)
+
+	identity := wechatUserInfo.Unionid
+	if identity == "" {
+		identity = wechatUserInfo.OpenID
+	}
+
+	return &ExternalIdentity{
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypeWechat,
+			Identity: identity,
+		},
+		DisplayName: wechatUserInfo.Nickname,
+		Avatar:      wechatUserInfo.HeadimgURL,
+	}, nil
+}
+
+func (w *WechatLoginProvider) getWechatAccessToken(ctx context.Context, code string, appID string, appSecret string) (string, string, error) {
+	// get access token
+	url := fmt.Sprintf("https://api.weixin.qq.com/sns/oauth2/access_token?appid=%s&secret=%s&code=%s&grant_type=authorization_code",
+		appID, appSecret, code)
+
+	resp, err := w.httpClient.Get(url)
+	if err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+
+	body := struct {
+		AccessToken string `json:"access_token"`
+		Unionid     string `json:"unionid"`
+		OpenID      string `json:"openid"`
+		Scope       string `json:"scope"`
+		Errmsg      string `json:"errmsg"`
+		Errcode     int32  `json:"errcode"`
+	}{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if !strings.Contains(body.Scope, "snsapi_login") &&
+			!strings.Contains(body.Scope, "snsapi_userinfo") {
+			w.logger.Infof(ctx, "wechat api error: access_token scope not found or invalid: %v", body)
+			return "", "", xerror.Wrap(ErrWechatInvalidScope)
+		}
+		return body.OpenID, body.AccessToken, nil
+	}
+
+	if body.Errcode != 0 {
+		// invalid code
+		if body.Errcode == 40029 {
+			return "", "", xerror.Wrap(ErrInvalidWechatCode)
+		}
+		return "", "", xerror.Wrap(fmt.Errorf("wechat api error: %d, %s", body.Errcode, body.Errmsg))
+	}
+
+	return "", "", xerror.Wrap(errors.New("unknow server error"))
+}
+
+func (w *WechatLoginProvider) getWechatUserInfo(accessToken string, openID string) (*wechatUserInfo, error) {
+
+	url := fmt.Sprintf("https://api.weixin.qq.com/sns/userinfo?access_token=%s&openid=%s",
+		accessToken, openID)
+
+	resp, err := w.httpClient.Get(url)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	winfo := wechatUserInfo{}
+
+	if err := json.Unmarshal(b, &winfo); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return &winfo, nil
+	}
+
+	if winfo.ErrMSG != "" {
+		// invalid code
+		if winfo.ErrCode == 40003 {
+			return nil, xerror.Wrap(ErrInvalidWechatCode)
+		}
+		return nil, xerror.Wrap(errors.New(winfo.ErrMSG))
+	}
+
+	return nil, xerror.Wrap(errors.New("unknow server error"))
+}
+
+// WechatMiniProgramLoginProvider 微信小程序登录，传入 phone code 时同时关联手机号
+type WechatMiniProgramLoginProvider struct {
+	logger     logger.ILogger
+	httpClient *xhttp.Client
+
+	wechatMiniProgramID     string
+	wechatMiniProgramSecret string
+}
+
+func NewWechatMiniProgramLoginProvider(logger logger.ILogger, config *config.Config, httpClient *xhttp.Client) *WechatMiniProgramLoginProvider {
+	provider := &WechatMiniProgramLoginProvider{
+		logger:     logger,
+		httpClient: httpClient,
+	}
+
+	if config.Wechat != nil {
+		provider.wechatMiniProgramID = config.Wechat.MiniProgramID
+		provider.wechatMiniProgramSecret = config.Wechat.MiniProgramSecret
+	}
+
+	return provider
+}
+
+func (w *WechatMiniProgramLoginProvider) Name() string {
+	return LoginProviderWechatMiniProgram
+}
+
+func (w *WechatMiniProgramLoginProvider) Resolve(ctx context.Context, application *entity.ApplicationEntity, credential LoginCredential) (*ExternalIdentity, error) {
+	w.logger.Infof(ctx, "start wechat mini-program login: %s, %s", credential.Code, credential.PhoneCode)
+
+	// 如果传入了 phone code，先通过微信接口获取手机号
+	var phone string
+	if credential.PhoneCode != "" {
+		var err error
+		phone, err = w.Phone(credential.PhoneCode)
+		if err != nil {
+			w.logger.Errorf(ctx, "failed to get phone from mini program code: %w", err)
+			return nil, xerror.Wrap(fmt.Errorf("failed to get phone from mini program code: %w", err))
+		}
+		w.logger.Infof(ctx, "got phone from mini program code: %s", phone)
+	}
+
+	_, unionID, openID, err := w.getWechatSessionKey(ctx, credential.Code)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	w.logger.Infof(ctx, "wechat openid and unionid: %s, %s", openID, unionID)
+
+	identity := &ExternalIdentity{
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypeWechat,
+			Identity: unionID,
+		},
+		// 小程序支付和通知使用 openid
+		WechatOpenID: &entity.WechatOpenIDEntity{
+			Platform: enum.WechatOpenIDPlatformMiniProgram,
+			OpenID:   openID,
+		},
+	}
+
+	if phone != "" {
+		identity.LinkedBindings = []*entity.BindingEntity{{
+			Type:     enum.BindingTypePhone,
+			Identity: phone,
+		}}
+	}
+
+	return identity, nil
+}
+
+func (w *WechatMiniProgramLoginProvider) getWechatSessionKey(ctx context.Context, code string) (sessionkey, unionid string, openid string, err error) {
+	// get access token
+	url := fmt.Sprintf("https://api.weixin.qq.com/sns/jscode2session?appid=%s&secret=%s&js_code=%s&grant_type=authorization_code",
+		w.wechatMiniProgramID, w.wechatMiniProgramSecret, code)
+
+	resp, err := w.httpClient.Get(url)
+	if err != nil {
+		return "", "", "", xerror.Wrap(err)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", "", xerror.Wrap(err)
+	}
+
+	w.logger.Infof(ctx, "wechat raw response: %s", string(b))
+
+	body := struct {
+		SessionKey string `json:"session_key"`
+		Unionid    string `json:"unionid"`
+		OpenID     string `json:"openid"`
+		Errcode    int32  `json:"errcode"`
+		Errmsg     string `json:"errmsg"`
+	}{}
+
+	if err := json.Unmarshal(b, &body); err != nil {
+		return "", "", "", xerror.Wrap(err)
+	}
+
+	if body.Errcode != 0 {
+		// invalid code
+		if body.Errcode == 40029 {
+			return "", "", "", xerror.Wrap(ErrInvalidWechatCode)
+		}
+		return "", "", "", xerror.Wrap(fmt.Errorf("wechat api error: %d, %s", body.Errcode, body.Errmsg))
+	}
+
+	if body.Unionid == "" || body.SessionKey == "" {
+		w.logger.Errorf(ctx, "missing required fields from wechat: %s, %s, %s", body.Unionid, body.SessionKey, body.OpenID)
+		return "", "", "", xerror.Wrap(fmt.Errorf("session_key and unionid can't be empty"))
+	}
+
+	return body.SessionKey, body.Unionid, body.OpenID, nil
+}
+
+func (w *WechatMiniProgramLoginProvider) getWechatMiniProgramAccessToken() (string, error) {
+	url := fmt.Sprintf("https://api.weixin.qq.com/cgi-bin/token?grant_type=client_credential&appid=%s&secret=%s",
+		w.wechatMiniProgramID, w.wechatMiniProgramSecret)
+
+	resp, err := w.httpClient.Get(url)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	body := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return body.AccessToken, nil
+	}
+
+	return "", xerror.Wrap(errors.New("unknow server error"))
+}
+
+// Phone the phone number of a mini program phone code, with the country code
+func (w *WechatMiniProgramLoginProvider) Phone(code string) (string, error) {
+	accessToken, err := w.getWechatMiniProgramAccessToken()
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	url := fmt.Sprintf("https://api.weixin.qq.com/wxa/business/getuserphonenumber?access_token=%s", accessToken)
+
+	body := struct {
+		Code string `json:"code"`
+	}{Code: code}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	resp, err := w.httpClient.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	b, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	defer resp.Body.Close()
+
+	responseBody := struct {
+		Errcode   int    `json:"errcode"`
+		Errmsg    string `json:"errmsg"`
+		PhoneInfo struct {
+			PhoneNumber string `json:"phoneNumber"`
+			PurePhone   string `json:"purePhoneNumber"`
+			CountryCode string `json:"countryCode"`
+			WaterMark   struct {
+				Timestamp int64  `json:"timestamp"`
+				AppID     string `json:"appid"`
+			} `json:"watermark"`
+		} `json:"phone_info"`
+	}{}
+	if err := json.Unmarshal(b, &responseBody); err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if responseBody.Errcode != 0 {
+			// 对于 code 无效的情况，使用特定的错误类型
+			if responseBody.Errcode == 40029 {
+				return "", xerror.Wrap(ErrInvalidWechatCode)
+			}
+			return "", xerror.Wrap(fmt.Errorf("wechat api error: %d, %s", responseBody.Errcode, responseBody.Errmsg))
+		}
+		// 返回带区号的手机号
+		return responseBody.PhoneInfo.PhoneNumber, nil
+	}
+
+	return "", xerror.Wrap(errors.New("unknow server error"))
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// SAMLIdentity the user asserted by the identity provider of an organization, attributes already mapped
+type SAMLIdentity struct {
+	NameID     string
+	Name       string
+	Email      string
+	Department string
+}
+
+// SAMLBindingIdentity the identity of a saml binding, name ids are only unique per identity provider
+func SAMLBindingIdentity(organizationID uuid.UUID, nameID string) string {
+	return organizationID.String() + ":" + nameID
+}
+
+// SAMLLogin 企业组织 SAML 登录, identity 已由 SAMLService 校验
+// 首次登录的用户自动创建并以应用的默认组织角色加入组织
+func (l *LoginService) SAMLLogin(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	organization *aggregate.OrganizationAggregate,
+	identity *SAMLIdentity,
+) (*aggregate.UserAggregate, error) {
+	l.logger.Infof(ctx, "start saml login: %s, organization=%s, name_id=%s", application.Application.Name, organization.Organization.ID, identity.NameID)
+
+	userAggregate, err := l.ResolveAccount(ctx, application, entity.UserRefferalChannel{}, &ExternalIdentity{
+		// organization + name id 是唯一标识
+		Binding: &entity.BindingEntity{
+			Type:     enum.BindingTypeSAML,
+			Identity: SAMLBindingIdentity(organization.Organization.ID, identity.NameID),
+			Email:    identity.Email,
+		},
+		DisplayName: identity.Name,
+		Membership: &OrganizationMembership{
+			Organization: organization,
+			Department:   identity.Department,
+		},
+	})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return userAggregate, nil
+}
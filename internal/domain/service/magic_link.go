@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/mail"
+	"kiwi-user/internal/infrastructure/utils"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// magicLinkPollTokenBytes the secret only the requesting device holds
+const magicLinkPollTokenBytes = 32
+
+// MagicLinkService passwordless email login. Opening the link in the email shows the requesting
+// device, the user confirms the login from that page and the requesting device completes it with
+// the poll token it got back, wherever the link was opened.
+type MagicLinkService struct {
+	magicLinkRepository contract.IMagicLinkRepository
+	mailer              *mail.MagicLinkMailer
+	config              *config.Config
+	logger              logger.ILogger
+}
+
+func NewMagicLinkService(
+	logger logger.ILogger,
+	config *config.Config,
+	magicLinkRepository contract.IMagicLinkRepository,
+	mailer *mail.MagicLinkMailer) *MagicLinkService {
+	return &MagicLinkService{
+		logger:              logger,
+		config:              config,
+		magicLinkRepository: magicLinkRepository,
+		mailer:              mailer,
+	}
+}
+
+// Create records a pending login of the device, the returned poll token completes it
+func (m *MagicLinkService) Create(
+	ctx context.Context,
+	applicationID uuid.UUID,
+	email string,
+	deviceType string,
+	deviceID string) (*entity.MagicLinkEntity, string, error) {
+	if m.config.MagicLink == nil || m.config.MagicLink.BaseURL == "" || m.config.MagicLink.RedirectURL == "" {
+		return nil, "", xerror.Wrap(ErrMagicLinkNotConfigured)
+	}
+
+	// links live for minutes, drop the stale ones on the way
+	if err := m.magicLinkRepository.DeleteExpired(ctx); err != nil {
+		m.logger.Warnf(ctx, "delete expired magic links failed: %w", err)
+	}
+
+	pollToken, err := utils.RandomURLSafeToken(magicLinkPollTokenBytes)
+	if err != nil {
+		return nil, "", xerror.Wrap(err)
+	}
+
+	client := utils.ClientFromContext(ctx)
+
+	link, err := m.magicLinkRepository.Create(ctx, &entity.MagicLinkEntity{
+		ApplicationID: applicationID,
+		Email:         email,
+		DeviceType:    deviceType,
+		DeviceID:      deviceID,
+		PollTokenHash: utils.Sha256(pollToken),
+		ClientIP:      client.IP,
+		UserAgent:     client.UserAgent,
+		ExpiresAt:     time.Now().Add(time.Duration(m.config.MagicLink.ExpireSecond) * time.Second),
+	})
+	if err != nil {
+		return nil, "", xerror.Wrap(err)
+	}
+
+	return link, pollToken, nil
+}
+
+// Send emails the link carrying the signed token of the magic link
+func (m *MagicLinkService) Send(ctx context.Context, email string, token string) error {
+	link := strings.TrimRight(m.config.MagicLink.BaseURL, "/") + "/v1/login/email/link/confirm?token=" + url.QueryEscape(token)
+
+	if err := m.mailer.Send(email, link); err != nil {
+		m.logger.Errorf(ctx, "Failed to enqueue magic link: %w", err)
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// Find the pending link to show on its landing page, opening the link changes nothing
+func (m *MagicLinkService) Find(ctx context.Context, id uuid.UUID) (*entity.MagicLinkEntity, error) {
+	link, err := m.magicLinkRepository.Find(ctx, id)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if link == nil || link.ExpiresAt.Before(time.Now()) {
+		return nil, xerror.Wrap(ErrMagicLinkNotFound)
+	}
+
+	if link.Confirmed {
+		return nil, xerror.Wrap(ErrMagicLinkUsed)
+	}
+
+	return link, nil
+}
+
+// Confirm approves the login, posted by the user from the landing page. A link is confirmed once.
+func (m *MagicLinkService) Confirm(ctx context.Context, id uuid.UUID) error {
+	return m.magicLinkRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		link, err := m.magicLinkRepository.FindForUpdate(ctx, id)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if link == nil || link.ExpiresAt.Before(time.Now()) {
+			return xerror.Wrap(ErrMagicLinkNotFound)
+		}
+
+		if link.Confirmed {
+			return xerror.Wrap(ErrMagicLinkUsed)
+		}
+
+		if err := m.magicLinkRepository.Confirm(ctx, link); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	})
+}
+
+// Complete reports whether the link was confirmed and consumes it if so. Only the device that
+// requested the link, holding its poll token, can complete it.
+func (m *MagicLinkService) Complete(
+	ctx context.Context,
+	applicationID uuid.UUID,
+	id uuid.UUID,
+	pollToken string,
+	deviceType string,
+	deviceID string) (*entity.MagicLinkEntity, bool, error) {
+	var (
+		link      *entity.MagicLinkEntity
+		confirmed bool
+	)
+
+	if err := m.magicLinkRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		link, err = m.magicLinkRepository.FindForUpdate(ctx, id)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if link == nil || link.ExpiresAt.Before(time.Now()) {
+			return xerror.Wrap(ErrMagicLinkNotFound)
+		}
+
+		if link.ApplicationID != applicationID ||
+			link.DeviceType != deviceType ||
+			link.DeviceID != deviceID ||
+			subtle.ConstantTimeCompare([]byte(link.PollTokenHash), []byte(utils.Sha256(pollToken))) != 1 {
+			return xerror.Wrap(ErrMagicLinkNotFound)
+		}
+
+		if !link.Confirmed {
+			return nil
+		}
+
+		if err := m.magicLinkRepository.Delete(ctx, link); err != nil {
+			return xerror.Wrap(err)
+		}
+		confirmed = true
+
+		return nil
+	}); err != nil {
+		return nil, false, xerror.Wrap(err)
+	}
+
+	return link, confirmed, nil
+}
+
+// LandingURL the page the browser is sent to after opening the link
+func (m *MagicLinkService) LandingURL(status string, id string) (string, error) {
+	landing, err := url.Parse(m.config.MagicLink.RedirectURL)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	query := landing.Query()
+	query.Set("status", status)
+	if id != "" {
+		query.Set("link_id", id)
+	}
+	landing.RawQuery = query.Encode()
+
+	return landing.String(), nil
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/model/entity"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type fakeMagicLinkRepository struct {
+	mu    sync.Mutex
+	links map[uuid.UUID]*entity.MagicLinkEntity
+}
+
+func newFakeMagicLinkRepository() *fakeMagicLinkRepository {
+	return &fakeMagicLinkRepository{links: map[uuid.UUID]*entity.MagicLinkEntity{}}
+}
+
+func (f *fakeMagicLinkRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return fn(ctx)
+}
+
+func (f *fakeMagicLinkRepository) Find(ctx context.Context, id uuid.UUID) (*entity.MagicLinkEntity, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.get(id), nil
+}
+
+func (f *fakeMagicLinkRepository) FindForUpdate(ctx context.Context, id uuid.UUID) (*entity.MagicLinkEntity, error) {
+	return f.get(id), nil
+}
+
+func (f *fakeMagicLinkRepository) get(id uuid.UUID) *entity.MagicLinkEntity {
+	link, ok := f.links[id]
+	if !ok {
+		return nil
+	}
+	copied := *link
+	return &copied
+}
+
+func (f *fakeMagicLinkRepository) Create(ctx context.Context, link *entity.MagicLinkEntity) (*entity.MagicLinkEntity, error) {
+	created := *link
+	created.ID = uuid.New()
+	created.CreatedAt = time.Now()
+	f.links[created.ID] = &created
+	return f.get(created.ID), nil
+}
+
+func (f *fakeMagicLinkRepository) Confirm(ctx context.Context, link *entity.MagicLinkEntity) error {
+	f.links[link.ID].Confirmed = true
+	return nil
+}
+
+func (f *fakeMagicLinkRepository) Delete(ctx context.Context, link *entity.MagicLinkEntity) error {
+	delete(f.links, link.ID)
+	return nil
+}
+
+func (f *fakeMagicLinkRepository) DeleteExpired(ctx context.Context) error {
+	for id, link := range f.links {
+		if link.ExpiresAt.Before(time.Now()) {
+			delete(f.links, id)
+		}
+	}
+	return nil
+}
+
+func newTestMagicLinkService(repository *fakeMagicLinkRepository) *MagicLinkService {
+	return &MagicLinkService{
+		magicLinkRepository: repository,
+		config: &config.Config{MagicLink: &config.MagicLinkConfig{
+			BaseURL:      "https://user.example.com",
+			RedirectURL:  "https://app.example.com/link",
+			ExpireSecond: 900,
+		}},
+	}
+}
+
+func TestMagicLinkConfirmThenPoll(t *testing.T) {
+	ctx := context.Background()
+	repository := newFakeMagicLinkRepository()
+	service := newTestMagicLinkService(repository)
+	applicationID := uuid.New()
+
+	link, pollToken, err := service.Create(ctx, applicationID, "user@example.com", "web", "device-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the requesting device polls before the user confirmed
+	if _, confirmed, err := service.Complete(ctx, applicationID, link.ID, pollToken, "web", "device-1"); err != nil || confirmed {
+		t.Fatalf("expected pending, got %v %v", confirmed, err)
+	}
+
+	// opening the link only shows it
+	if _, err := service.Find(ctx, link.ID); err != nil {
+		t.Fatal(err)
+	}
+	if repository.links[link.ID].Confirmed {
+		t.Fatal("finding the link must not confirm it")
+	}
+
+	if err := service.Confirm(ctx, link.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.Confirm(ctx, link.ID); !xerror.Is(err, ErrMagicLinkUsed) {
+		t.Fatalf("expected a second confirm to fail, got %v", err)
+	}
+
+	if _, err := service.Find(ctx, link.ID); !xerror.Is(err, ErrMagicLinkUsed) {
+		t.Fatalf("expected a confirmed link to show as used, got %v", err)
+	}
+
+	completed, confirmed, err := service.Complete(ctx, applicationID, link.ID, pollToken, "web", "device-1")
+	if err != nil || !confirmed || completed.Email != "user@example.com" {
+		t.Fatalf("expected the login to complete, got %v %v", confirmed, err)
+	}
+
+	// the link is consumed by the completed login
+	if _, _, err := service.Complete(ctx, applicationID, link.ID, pollToken, "web", "device-1"); !xerror.Is(err, ErrMagicLinkNotFound) {
+		t.Fatalf("expected a completed link to be gone, got %v", err)
+	}
+}
+
+func TestMagicLinkCompleteOnlyByRequestingDevice(t *testing.T) {
+	ctx := context.Background()
+	repository := newFakeMagicLinkRepository()
+	service := newTestMagicLinkService(repository)
+	applicationID := uuid.New()
+
+	link, pollToken, err := service.Create(ctx, applicationID, "user@example.com", "web", "device-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.Confirm(ctx, link.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name          string
+		applicationID uuid.UUID
+		pollToken     string
+		deviceID      string
+	}{
+		{"other device", applicationID, pollToken, "device-2"},
+		{"wrong poll token", applicationID, "guessed", "device-1"},
+		{"other application", uuid.New(), pollToken, "device-1"},
+	}
+
+	for _, c := range cases {
+		if _, _, err := service.Complete(ctx, c.applicationID, link.ID, c.pollToken, "web", c.deviceID); !xerror.Is(err, ErrMagicLinkNotFound) {
+			t.Fatalf("%s: expected ErrMagicLinkNotFound, got %v", c.name, err)
+		}
+	}
+
+	if _, confirmed, err := service.Complete(ctx, applicationID, link.ID, pollToken, "web", "device-1"); err != nil || !confirmed {
+		t.Fatalf("expected the requesting device to complete, got %v %v", confirmed, err)
+	}
+}
+
+func TestMagicLinkExpired(t *testing.T) {
+	ctx := context.Background()
+	repository := newFakeMagicLinkRepository()
+	service := newTestMagicLinkService(repository)
+	applicationID := uuid.New()
+
+	link, pollToken, err := service.Create(ctx, applicationID, "user@example.com", "web", "device-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository.links[link.ID].ExpiresAt = time.Now().Add(-time.Second)
+
+	if _, err := service.Find(ctx, link.ID); !xerror.Is(err, ErrMagicLinkNotFound) {
+		t.Fatalf("expected an expired link not to show, got %v", err)
+	}
+
+	if err := service.Confirm(ctx, link.ID); !xerror.Is(err, ErrMagicLinkNotFound) {
+		t.Fatalf("expected an expired link not to confirm, got %v", err)
+	}
+
+	if _, _, err := service.Complete(ctx, applicationID, link.ID, pollToken, "web", "device-1"); !xerror.Is(err, ErrMagicLinkNotFound) {
+		t.Fatalf("expected an expired link not to complete, got %v", err)
+	}
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/replay"
+	"kiwi-user/internal/infrastructure/utils"
+	"kiwi-user/internal/infrastructure/utils/aes"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// totpSkew accepted time steps around the current one, tolerates clock drift of the authenticator
+const totpSkew = 1
+
+type MFAService struct {
+	userRepository contract.IUserRepository
+	replayStore    replay.Store
+	config         *config.Config
+}
+
+func NewMFAService(userRepository contract.IUserRepository, replayStore replay.Store, config *config.Config) *MFAService {
+	return &MFAService{
+		userRepository: userRepository,
+		replayStore:    replayStore,
+		config:         config,
+	}
+}
+
+// IsMFAEnabled a user has mfa enabled once the totp binding is confirmed
+func (m *MFAService) IsMFAEnabled(user *aggregate.UserAggregate) bool {
+	binding := m.getTOTPBinding(user)
+	return binding != nil && binding.Verified
+}
+
+// EnrollTOTP create a new unconfirmed secret, replaces a previous unconfirmed one
+func (m *MFAService) EnrollTOTP(ctx context.Context, user *aggregate.UserAggregate) (secret string, uri string, err error) {
+	key, err := m.secretKey()
+	if err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+
+	binding := m.getTOTPBinding(user)
+	if binding != nil && binding.Verified {
+		return "", "", ErrMFAAlreadyEnabled
+	}
+
+	secret, err = utils.GenerateTOTPSecret()
+	if err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+
+	encrypted, err := aes.AESEncrypt(secret, key)
+	if err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+
+	if binding == nil {
+		binding = &entity.BindingEntity{
+			Type:     enum.BindingTypeTOTP,
+			Identity: user.User.ID,
+		}
+		user.Bindings = append(user.Bindings, binding)
+	}
+
+	binding.Secret = encrypted
+	binding.Verified = false
+
+	if _, err := m.userRepository.Update(ctx, user); err != nil {
+		return "", "", xerror.Wrap(err)
+	}
+
+	account := user.User.Name
+	if account == "" {
+		account = user.User.ID
+	}
+
+	return secret, utils.TOTPProvisioningURI(m.config.MFA.Issuer, account, secret), nil
+}
+
+// ConfirmTOTP enable mfa after the user proved the authenticator is set up
+func (m *MFAService) ConfirmTOTP(ctx context.Context, user *aggregate.UserAggregate, code string) error {
+	binding := m.getTOTPBinding(user)
+	if binding == nil {
+		return ErrMFANotEnabled
+	}
+
+	if binding.Verified {
+		return ErrMFAAlreadyEnabled
+	}
+
+	if err := m.verifyCode(ctx, user, binding, code); err != nil {
+		return err
+	}
+
+	binding.Verified = true
+	if _, err := m.userRepository.Update(ctx, user); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// DisableTOTP remove the totp binding, requires a valid code when mfa is enabled
+func (m *MFAService) DisableTOTP(ctx context.Context, user *aggregate.UserAggregate, code string) error {
+	binding := m.getTOTPBinding(user)
+	if binding == nil {
+		return ErrMFANotEnabled
+	}
+
+	if binding.Verified {
+		if err := m.verifyCode(ctx, user, binding, code); err != nil {
+			return err
+		}
+	}
+
+	if err := m.userRepository.DeleteBinding(ctx, binding.ID); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// VerifyTOTP check a code against the confirmed totp binding
+func (m *MFAService) VerifyTOTP(ctx context.Context, user *aggregate.UserAggregate, code string) error {
+	binding := m.getTOTPBinding(user)
+	if binding == nil || !binding.Verified {
+		return ErrMFANotEnabled
+	}
+
+	return m.verifyCode(ctx, user, binding, code)
+}
+
+// verifyCode an accepted code is used up, it is refused for the rest of its validity even if intercepted
+func (m *MFAService) verifyCode(ctx context.Context, user *aggregate.UserAggregate, binding *entity.BindingEntity, code string) error {
+	key, err := m.secretKey()
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	secret, err := aes.AESDecrypt(binding.Secret, key)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	step, ok := utils.MatchTOTP(secret, code, time.Now(), totpSkew)
+	if !ok {
+		return ErrMFAInvalidCode
+	}
+
+	// the code is accepted until the skew steps after its own step passed
+	expiresAt := time.Unix((step+totpSkew+1)*utils.TOTPPeriod, 0)
+	fresh, err := m.replayStore.Consume(ctx, fmt.Sprintf("totp:%s:%d", user.User.ID, step), expiresAt)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if !fresh {
+		return ErrMFACodeUsed
+	}
+
+	return nil
+}
+
+func (m *MFAService) secretKey() ([]byte, error) {
+	key := []byte(m.config.MFA.SecretEncryptionKey)
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, ErrMFANotConfigured
+	}
+}
+
+func (m *MFAService) getTOTPBinding(user *aggregate.UserAggregate) *entity.BindingEntity {
+	for _, binding := range user.Bindings {
+		if binding.Type == enum.BindingTypeTOTP {
+			return binding
+		}
+	}
+
+	return nil
+}
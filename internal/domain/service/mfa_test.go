@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/replay"
+	"kiwi-user/internal/infrastructure/utils"
+	"kiwi-user/internal/infrastructure/utils/aes"
+	"testing"
+	"time"
+)
+
+func newTestMFAUser(t *testing.T, key string) (*aggregate.UserAggregate, string) {
+	t.Helper()
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, err := aes.AESEncrypt(secret, []byte(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &aggregate.UserAggregate{
+		User: &entity.UserEntity{ID: "user"},
+		Bindings: []*entity.BindingEntity{{
+			Type:     enum.BindingTypeTOTP,
+			Identity: "user",
+			Secret:   encrypted,
+			Verified: true,
+		}},
+	}, secret
+}
+
+func TestVerifyTOTPRefusesReplay(t *testing.T) {
+	ctx := context.Background()
+	key := "0123456789abcdef"
+	cfg := &config.Config{MFA: &config.MFAConfig{SecretEncryptionKey: key}}
+	m := &MFAService{replayStore: replay.NewStore(cfg, nil), config: cfg}
+
+	user, secret := newTestMFAUser(t, key)
+	code, err := utils.TOTPCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.VerifyTOTP(ctx, user, code); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+
+	if err := m.VerifyTOTP(ctx, user, code); !errors.Is(err, ErrMFACodeUsed) {
+		t.Fatalf("replay: got %v, want %v", err, ErrMFACodeUsed)
+	}
+
+	// another user with the same code is not affected
+	other, _ := newTestMFAUser(t, key)
+	other.User.ID = "other"
+	other.Bindings[0].Secret = user.Bindings[0].Secret
+	if err := m.VerifyTOTP(ctx, other, code); err != nil {
+		t.Fatalf("other user: %v", err)
+	}
+}
+
+func TestVerifyTOTPWrongCodeIsNotConsumed(t *testing.T) {
+	ctx := context.Background()
+	key := "0123456789abcdef"
+	cfg := &config.Config{MFA: &config.MFAConfig{SecretEncryptionKey: key}}
+	m := &MFAService{replayStore: replay.NewStore(cfg, nil), config: cfg}
+
+	user, secret := newTestMFAUser(t, key)
+	code, err := utils.TOTPCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrong := "000000"
+	if wrong == code {
+		wrong = "111111"
+	}
+
+	if err := m.VerifyTOTP(ctx, user, wrong); !errors.Is(err, ErrMFAInvalidCode) {
+		t.Fatalf("wrong code: got %v, want %v", err, ErrMFAInvalidCode)
+	}
+
+	if err := m.VerifyTOTP(ctx, user, code); err != nil {
+		t.Fatalf("valid code after a wrong one: %v", err)
+	}
+}
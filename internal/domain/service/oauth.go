@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/utils"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+const (
+	// oauth sessions are stored as devices of this type
+	OAuthDeviceType = "oauth"
+
+	oauthClientSecretBytes      = 32
+	oauthAuthorizationCodeBytes = 32
+)
+
+type OAuthService struct {
+	logger                           logger.ILogger
+	applicationRepository            contract.IApplicationRepository
+	oauthAuthorizationCodeRepository contract.IOAuthAuthorizationCodeRepository
+	oauthConsentRepository           contract.IOAuthConsentRepository
+	authorizationCodeExpireSecond    int64
+}
+
+func NewOAuthService(
+	config *config.Config,
+	logger logger.ILogger,
+	applicationRepository contract.IApplicationRepository,
+	oauthAuthorizationCodeRepository contract.IOAuthAuthorizationCodeRepository,
+	oauthConsentRepository contract.IOAuthConsentRepository) *OAuthService {
+
+	return &OAuthService{
+		logger:                           logger,
+		applicationRepository:            applicationRepository,
+		oauthAuthorizationCodeRepository: oauthAuthorizationCodeRepository,
+		oauthConsentRepository:           oauthConsentRepository,
+		authorizationCodeExpireSecond:    config.OAuth.AuthorizationCodeExpireSecond,
+	}
+}
+
+// UpdateClient sets the redirect uris of an application and optionally issues a new client secret.
+// The plain secret is only returned here, the database keeps its hash.
+func (o *OAuthService) UpdateClient(
+	ctx context.Context,
+	application *aggregate.ApplicationAggregate,
+	redirectURIs []string,
+	regenerateSecret bool) (*entity.ApplicationEntity, string, error) {
+
+	for _, redirectURI := range redirectURIs {
+		u, err := url.Parse(redirectURI)
+		if err != nil || u.Scheme == "" || u.Host == "" || u.Fragment != "" {
+			return nil, "", xerror.Wrap(ErrOAuthInvalidRedirectURI)
+		}
+	}
+
+	applicationEntity := *application.Application
+	applicationEntity.RedirectURIs = redirectURIs
+
+	clientSecret := ""
+	if regenerateSecret {
+		secret, err := utils.RandomURLSafeToken(oauthClientSecretBytes)
+		if err != nil {
+			return nil, "", xerror.Wrap(err)
+		}
+
+		clientSecret = secret
+		applicationEntity.ClientSecretHash = utils.Sha256(secret)
+	}
+
+	updated, err := o.applicationRepository.UpdateOAuthClient(ctx, &applicationEntity)
+	if err != nil {
+		return nil, "", xerror.Wrap(err)
+	}
+
+	return updated, clientSecret, nil
+}
+
+// ValidateRedirectURI the redirect uri must exactly match one of the registered uris
+func (o *OAuthService) ValidateRedirectURI(application *entity.ApplicationEntity, redirectURI string) error {
+	if len(application.RedirectURIs) == 0 {
+		return xerror.Wrap(ErrOAuthClientNotConfigured)
+	}
+
+	if !slices.Contains(application.RedirectURIs, redirectURI) {
+		return xerror.Wrap(ErrOAuthInvalidRedirectURI)
+	}
+
+	return nil
+}
+
+// AuthenticateClient confidential clients must present their secret, public clients rely on PKCE only
+func (o *OAuthService) AuthenticateClient(application *entity.ApplicationEntity, clientSecret string) error {
+	if len(application.RedirectURIs) == 0 {
+		return xerror.Wrap(ErrOAuthClientNotConfigured)
+	}
+
+	if application.ClientSecretHash == "" {
+		return nil
+	}
+
+	if subtle.ConstantTimeCompare([]byte(utils.Sha256(clientSecret)), []byte(application.ClientSecretHash)) != 1 {
+		return xerror.Wrap(ErrOAuthInvalidClient)
+	}
+
+	return nil
+}
+
+// ValidateCodeChallenge returns the challenge method, S256 when none was given
+func (o *OAuthService) ValidateCodeChallenge(application *entity.ApplicationEntity, codeChallenge string, codeChallengeMethod string) (string, error) {
+	if codeChallengeMethod == "" {
+		codeChallengeMethod = utils.PKCEMethodS256
+	}
+
+	if codeChallenge == "" ||
+		(codeChallengeMethod != utils.PKCEMethodS256 && codeChallengeMethod != utils.PKCEMethodPlain) {
+		return "", xerror.Wrap(ErrOAuthInvalidCodeChallenge)
+	}
+
+	// a plain challenge is the verifier itself, only the secret of a confidential client protects the code then
+	if codeChallengeMethod == utils.PKCEMethodPlain && application.ClientSecretHash == "" {
+		return "", xerror.Wrap(ErrOAuthInvalidCodeChallenge)
+	}
+
+	return codeChallengeMethod, nil
+}
+
+func (o *OAuthService) CreateAuthorizationCode(
+	ctx context.Context,
+	application *entity.ApplicationEntity,
+	userID string,
+	redirectURI string,
+	scope string,
+	nonce string,
+	codeChallenge string,
+	codeChallengeMethod string) (string, error) {
+
+	codeChallengeMethod, err := o.ValidateCodeChallenge(application, codeChallenge, codeChallengeMethod)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	// codes live for minutes, drop the stale ones on the way
+	if err := o.oauthAuthorizationCodeRepository.DeleteExpired(ctx); err != nil {
+		o.logger.Warnf(ctx, "delete expired authorization codes failed: %w", err)
+	}
+
+	code, err := utils.RandomURLSafeToken(oauthAuthorizationCodeBytes)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	if _, err := o.oauthAuthorizationCodeRepository.Create(ctx, &entity.OAuthAuthorizationCodeEntity{
+		CodeHash:            utils.Sha256(code),
+		ApplicationID:       application.ID,
+		UserID:              userID,
+		RedirectURI:         redirectURI,
+		Scope:               scope,
+		Nonce:               nonce,
+		CodeChallenge:       codeChallenge,
+		CodeChallengeMethod: codeChallengeMethod,
+		ExpiresAt:           time.Now().Add(time.Duration(o.authorizationCodeExpireSecond) * time.Second),
+	}); err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	return code, nil
+}
+
+// HasConsent whether the user already allowed the client every scope requested
+func (o *OAuthService) HasConsent(ctx context.Context, application *entity.ApplicationEntity, userID string, scope string) (bool, error) {
+	consent, err := o.oauthConsentRepository.Find(ctx, userID, application.ID)
+	if err != nil {
+		return false, xerror.Wrap(err)
+	}
+
+	if consent == nil {
+		return false, nil
+	}
+
+	granted := strings.Fields(consent.Scope)
+	for _, requested := range strings.Fields(scope) {
+		if !slices.Contains(granted, requested) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// GrantConsent records that the user allowed the client the scopes, they add to the scopes allowed before
+func (o *OAuthService) GrantConsent(ctx context.Context, application *entity.ApplicationEntity, userID string, scope string) error {
+	if err := o.oauthConsentRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		consent, err := o.oauthConsentRepository.Find(ctx, userID, application.ID)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if consent == nil {
+			if _, err := o.oauthConsentRepository.Create(ctx, &entity.OAuthConsentEntity{
+				ApplicationID: application.ID,
+				UserID:        userID,
+				Scope:         strings.Join(strings.Fields(scope), " "),
+			}); err != nil {
+				return xerror.Wrap(err)
+			}
+
+			return nil
+		}
+
+		granted := strings.Fields(consent.Scope)
+		for _, requested := range strings.Fields(scope) {
+			if !slices.Contains(granted, requested) {
+				granted = append(granted, requested)
+			}
+		}
+
+		consent.Scope = strings.Join(granted, " ")
+		if _, err := o.oauthConsentRepository.Update(ctx, consent); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	}); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// ExchangeAuthorizationCode consumes the code, it can never be used twice even if the exchange fails
+func (o *OAuthService) ExchangeAuthorizationCode(
+	ctx context.Context,
+	application *entity.ApplicationEntity,
+	code string,
+	redirectURI string,
+	codeVerifier string) (*entity.OAuthAuthorizationCodeEntity, error) {
+
+	var authorizationCode *entity.OAuthAuthorizationCodeEntity
+
+	if err := o.oauthAuthorizationCodeRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		authorizationCode, err = o.oauthAuthorizationCodeRepository.FindByCodeHashForUpdate(ctx, utils.Sha256(code))
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if authorizationCode == nil {
+			return nil
+		}
+
+		return o.oauthAuthorizationCodeRepository.Delete(ctx, authorizationCode)
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if authorizationCode == nil ||
+		authorizationCode.ExpiresAt.Before(time.Now()) ||
+		authorizationCode.ApplicationID != application.ID ||
+		authorizationCode.RedirectURI != redirectURI ||
+		!utils.VerifyPKCE(codeVerifier, authorizationCode.CodeChallenge, authorizationCode.CodeChallengeMethod) {
+		return nil, xerror.Wrap(ErrOAuthInvalidGrant)
+	}
+
+	return authorizationCode, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/utils"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type fakeOAuthConsentRepository struct {
+	consents map[string]*entity.OAuthConsentEntity
+}
+
+func (f *fakeOAuthConsentRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (f *fakeOAuthConsentRepository) Find(ctx context.Context, userID string, applicationID uuid.UUID) (*entity.OAuthConsentEntity, error) {
+	consent, ok := f.consents[userID+applicationID.String()]
+	if !ok {
+		return nil, nil
+	}
+	copied := *consent
+	return &copied, nil
+}
+
+func (f *fakeOAuthConsentRepository) Create(ctx context.Context, consent *entity.OAuthConsentEntity) (*entity.OAuthConsentEntity, error) {
+	created := *consent
+	created.ID = uuid.New()
+	f.consents[created.UserID+created.ApplicationID.String()] = &created
+	return &created, nil
+}
+
+func (f *fakeOAuthConsentRepository) Update(ctx context.Context, consent *entity.OAuthConsentEntity) (*entity.OAuthConsentEntity, error) {
+	updated := *consent
+	f.consents[updated.UserID+updated.ApplicationID.String()] = &updated
+	return &updated, nil
+}
+
+func TestValidateCodeChallenge(t *testing.T) {
+	o := &OAuthService{}
+	public := &entity.ApplicationEntity{ID: uuid.New(), Name: "public"}
+	confidential := &entity.ApplicationEntity{ID: uuid.New(), Name: "confidential", ClientSecretHash: utils.Sha256("secret")}
+
+	method, err := o.ValidateCodeChallenge(public, "challenge", "")
+	if err != nil || method != utils.PKCEMethodS256 {
+		t.Fatalf("missing method: got %q, %v, want S256", method, err)
+	}
+
+	if _, err := o.ValidateCodeChallenge(public, "challenge", utils.PKCEMethodPlain); !errors.Is(err, ErrOAuthInvalidCodeChallenge) {
+		t.Fatalf("plain for a public client: got %v", err)
+	}
+
+	if _, err := o.ValidateCodeChallenge(confidential, "challenge", utils.PKCEMethodPlain); err != nil {
+		t.Fatalf("plain for a confidential client: %v", err)
+	}
+
+	if _, err := o.ValidateCodeChallenge(public, "", ""); !errors.Is(err, ErrOAuthInvalidCodeChallenge) {
+		t.Fatalf("missing challenge: got %v", err)
+	}
+}
+
+func TestOAuthConsent(t *testing.T) {
+	ctx := context.Background()
+	o := &OAuthService{oauthConsentRepository: &fakeOAuthConsentRepository{consents: map[string]*entity.OAuthConsentEntity{}}}
+	application := &entity.ApplicationEntity{ID: uuid.New(), Name: "client"}
+
+	if consented, _ := o.HasConsent(ctx, application, "user", "openid"); consented {
+		t.Fatal("no consent was given yet")
+	}
+
+	if err := o.GrantConsent(ctx, application, "user", "openid profile"); err != nil {
+		t.Fatal(err)
+	}
+
+	if consented, _ := o.HasConsent(ctx, application, "user", "profile openid"); !consented {
+		t.Fatal("scopes allowed before need no consent")
+	}
+
+	if consented, _ := o.HasConsent(ctx, application, "user", "openid email"); consented {
+		t.Fatal("a new scope needs consent")
+	}
+
+	if err := o.GrantConsent(ctx, application, "user", "email"); err != nil {
+		t.Fatal(err)
+	}
+
+	if consented, _ := o.HasConsent(ctx, application, "user", "openid profile email"); !consented {
+		t.Fatal("consents add up")
+	}
+
+	if consented, _ := o.HasConsent(ctx, application, "other", "openid"); consented {
+		t.Fatal("consent is per user")
+	}
+}
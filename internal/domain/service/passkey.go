@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/utils"
+	"kiwi-user/internal/infrastructure/webauthn"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+const webauthnChallengeBytes = 32
+
+type PasskeyService struct {
+	logger                      logger.ILogger
+	userRepository              contract.IUserRepository
+	passkeyCredentialRepository contract.IPasskeyCredentialRepository
+	webAuthnChallengeRepository contract.IWebAuthnChallengeRepository
+	relyingParty                *webauthn.RelyingParty
+	challengeExpireSecond       int64
+}
+
+func NewPasskeyService(
+	config *config.Config,
+	logger logger.ILogger,
+	userRepository contract.IUserRepository,
+	passkeyCredentialRepository contract.IPasskeyCredentialRepository,
+	webAuthnChallengeRepository contract.IWebAuthnChallengeRepository) *PasskeyService {
+
+	return &PasskeyService{
+		logger:                      logger,
+		userRepository:              userRepository,
+		passkeyCredentialRepository: passkeyCredentialRepository,
+		webAuthnChallengeRepository: webAuthnChallengeRepository,
+		relyingParty: &webauthn.RelyingParty{
+			ID:                      config.WebAuthn.RPID,
+			Name:                    config.WebAuthn.RPName,
+			Origins:                 config.WebAuthn.Origins,
+			RequireUserVerification: config.WebAuthn.RequireUserVerification,
+		},
+		challengeExpireSecond: config.WebAuthn.ChallengeExpireSecond,
+	}
+}
+
+// RelyingParty the relying party passed to the browser in the ceremony options
+func (p *PasskeyService) RelyingParty() *webauthn.RelyingParty {
+	return p.relyingParty
+}
+
+// BeginRegistration create a registration challenge, returns the registered credentials so the
+// browser does not create a second passkey on the same authenticator
+func (p *PasskeyService) BeginRegistration(
+	ctx context.Context,
+	user *aggregate.UserAggregate) (*entity.WebAuthnChallengeEntity, []*entity.PasskeyCredentialEntity, error) {
+
+	challenge, err := p.createChallenge(ctx, enum.WebAuthnChallengeTypeRegistration, user.Application.ID, user.User.ID)
+	if err != nil {
+		return nil, nil, xerror.Wrap(err)
+	}
+
+	credentials, err := p.passkeyCredentialRepository.FindByUserID(ctx, user.User.ID)
+	if err != nil {
+		return nil, nil, xerror.Wrap(err)
+	}
+
+	return challenge, credentials, nil
+}
+
+// FinishRegistration verify the attestation and store the credential for the user
+func (p *PasskeyService) FinishRegistration(
+	ctx context.Context,
+	user *aggregate.UserAggregate,
+	clientDataJSON []byte,
+	attestationObject []byte,
+	transports []string,
+	name string) (*entity.PasskeyCredentialEntity, error) {
+
+	challenge, err := p.consumeChallenge(ctx, clientDataJSON, enum.WebAuthnChallengeTypeRegistration)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if challenge.UserID != user.User.ID {
+		return nil, xerror.Wrap(ErrPasskeyInvalidChallenge)
+	}
+
+	credential, err := p.relyingParty.VerifyRegistration(challenge.Challenge, clientDataJSON, attestationObject)
+	if err != nil {
+		p.logger.Infof(ctx, "passkey registration rejected: %v", err)
+		return nil, xerror.Wrap(ErrPasskeyVerificationFailed)
+	}
+
+	credentialID := webauthn.EncodeBase64URL(credential.ID)
+
+	var created *entity.PasskeyCredentialEntity
+	if err := p.passkeyCredentialRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		existing, err := p.passkeyCredentialRepository.FindByCredentialIDForUpdate(ctx, credentialID)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if existing != nil {
+			return xerror.Wrap(ErrPasskeyCredentialAlreadyExists)
+		}
+
+		aaguid := ""
+		if parsed, err := uuid.FromBytes(credential.AAGUID); err == nil && parsed != uuid.Nil {
+			aaguid = parsed.String()
+		}
+
+		created, err = p.passkeyCredentialRepository.Create(ctx, &entity.PasskeyCredentialEntity{
+			UserID:       user.User.ID,
+			CredentialID: credentialID,
+			PublicKey:    credential.PublicKey,
+			SignCount:    credential.SignCount,
+			Transports:   transports,
+			AAGUID:       aaguid,
+			Name:         name,
+		})
+
+		return err
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return created, nil
+}
+
+// BeginLogin create a login challenge, the user is resolved from the discoverable credential later
+func (p *PasskeyService) BeginLogin(ctx context.Context, application *entity.ApplicationEntity) (*entity.WebAuthnChallengeEntity, error) {
+	return p.createChallenge(ctx, enum.WebAuthnChallengeTypeLogin, application.ID, "")
+}
+
+// FinishLogin verify the assertion and return the user owning the credential
+func (p *PasskeyService) FinishLogin(
+	ctx context.Context,
+	application *entity.ApplicationEntity,
+	credentialID string,
+	clientDataJSON []byte,
+	authenticatorData []byte,
+	signature []byte) (*aggregate.UserAggregate, error) {
+
+	challenge, err := p.consumeChallenge(ctx, clientDataJSON, enum.WebAuthnChallengeTypeLogin)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if challenge.ApplicationID != application.ID {
+		return nil, xerror.Wrap(ErrPasskeyInvalidChallenge)
+	}
+
+	var user *aggregate.UserAggregate
+	if err := p.passkeyCredentialRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		credential, err := p.passkeyCredentialRepository.FindByCredentialIDForUpdate(ctx, credentialID)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if credential == nil {
+			return xerror.Wrap(ErrPasskeyCredentialNotFound)
+		}
+
+		user, err = p.userRepository.Find(ctx, credential.UserID)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		// passkeys are scoped to the rp id, not to an application
+		if user == nil || user.Application == nil || user.Application.ID != application.ID {
+			return xerror.Wrap(ErrPasskeyCredentialNotFound)
+		}
+
+		signCount, err := p.relyingParty.VerifyAssertion(
+			challenge.Challenge,
+			clientDataJSON,
+			authenticatorData,
+			signature,
+			credential.PublicKey,
+			credential.SignCount)
+		if err != nil {
+			if errors.Is(err, webauthn.ErrSignCountRollback) {
+				p.logger.Warnf(ctx, "passkey %s sign count rollback, the authenticator may be cloned", credential.ID)
+			}
+			p.logger.Infof(ctx, "passkey assertion rejected: %v", err)
+			return xerror.Wrap(ErrPasskeyVerificationFailed)
+		}
+
+		credential.SignCount = signCount
+		credential.LastUsedAt = time.Now()
+		_, err = p.passkeyCredentialRepository.Update(ctx, credential)
+		return err
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return user, nil
+}
+
+func (p *PasskeyService) ListCredentials(ctx context.Context, userID string) ([]*entity.PasskeyCredentialEntity, error) {
+	return p.passkeyCredentialRepository.FindByUserID(ctx, userID)
+}
+
+func (p *PasskeyService) DeleteCredential(ctx context.Context, userID string, id uuid.UUID) error {
+	credential, err := p.passkeyCredentialRepository.Find(ctx, id)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if credential == nil || credential.UserID != userID {
+		return xerror.Wrap(ErrPasskeyCredentialNotFound)
+	}
+
+	return p.passkeyCredentialRepository.Delete(ctx, credential)
+}
+
+func (p *PasskeyService) createChallenge(
+	ctx context.Context,
+	challengeType enum.WebAuthnChallengeType,
+	applicationID uuid.UUID,
+	userID string) (*entity.WebAuthnChallengeEntity, error) {
+
+	if p.relyingParty.ID == "" || len(p.relyingParty.Origins) == 0 {
+		return nil, xerror.Wrap(ErrPasskeyNotConfigured)
+	}
+
+	// challenges live for minutes, drop the stale ones on the way
+	if err := p.webAuthnChallengeRepository.DeleteExpired(ctx); err != nil {
+		p.logger.Warnf(ctx, "delete expired webauthn challenges failed: %w", err)
+	}
+
+	challenge, err := utils.RandomURLSafeToken(webauthnChallengeBytes)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return p.webAuthnChallengeRepository.Create(ctx, &entity.WebAuthnChallengeEntity{
+		Type:          challengeType,
+		Challenge:     challenge,
+		ApplicationID: applicationID,
+		UserID:        userID,
+		ExpiresAt:     time.Now().Add(time.Duration(p.challengeExpireSecond) * time.Second),
+	})
+}
+
+// consumeChallenge look up the challenge echoed in clientDataJSON, a challenge is single use
+func (p *PasskeyService) consumeChallenge(
+	ctx context.Context,
+	clientDataJSON []byte,
+	challengeType enum.WebAuthnChallengeType) (*entity.WebAuthnChallengeEntity, error) {
+
+	clientData, err := webauthn.ParseClientData(clientDataJSON)
+	if err != nil {
+		return nil, xerror.Wrap(ErrPasskeyVerificationFailed)
+	}
+
+	var challenge *entity.WebAuthnChallengeEntity
+	if err := p.webAuthnChallengeRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		challenge, err = p.webAuthnChallengeRepository.FindByChallengeForUpdate(ctx, clientData.Challenge)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if challenge == nil {
+			return nil
+		}
+
+		return p.webAuthnChallengeRepository.Delete(ctx, challenge)
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if challenge == nil || challenge.Type != challengeType || challenge.ExpiresAt.Before(time.Now()) {
+		return nil, xerror.Wrap(ErrPasskeyInvalidChallenge)
+	}
+
+	return challenge, nil
+}
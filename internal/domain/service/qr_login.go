@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/utils"
+	"net/url"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// qrLoginPollTokenBytes the secret only the browser showing the code holds
+const qrLoginPollTokenBytes = 32
+
+// QRLoginService cross device login: a browser shows a qr code, a user signed in on the app scans
+// and approves it, and the browser, polling with its poll token, is issued a session of that user
+type QRLoginService struct {
+	qrLoginRepository contract.IQRLoginRepository
+	config            *config.Config
+	logger            logger.ILogger
+}
+
+func NewQRLoginService(
+	logger logger.ILogger,
+	config *config.Config,
+	qrLoginRepository contract.IQRLoginRepository) *QRLoginService {
+	return &QRLoginService{
+		logger:            logger,
+		config:            config,
+		qrLoginRepository: qrLoginRepository,
+	}
+}
+
+// Create records a pending login of the browser, the returned poll token completes it
+func (q *QRLoginService) Create(
+	ctx context.Context,
+	applicationID uuid.UUID,
+	deviceType string,
+	deviceID string) (*entity.QRLoginEntity, string, error) {
+	// codes live for minutes, drop the stale ones on the way
+	if err := q.qrLoginRepository.DeleteExpired(ctx); err != nil {
+		q.logger.Warnf(ctx, "delete expired qr logins failed: %w", err)
+	}
+
+	pollToken, err := utils.RandomURLSafeToken(qrLoginPollTokenBytes)
+	if err != nil {
+		return nil, "", xerror.Wrap(err)
+	}
+
+	client := utils.ClientFromContext(ctx)
+
+	login, err := q.qrLoginRepository.Create(ctx, &entity.QRLoginEntity{
+		ApplicationID: applicationID,
+		DeviceType:    deviceType,
+		DeviceID:      deviceID,
+		PollTokenHash: utils.Sha256(pollToken),
+		ClientIP:      client.IP,
+		UserAgent:     client.UserAgent,
+		Status:        enum.QRLoginStatusPending,
+		ExpiresAt:     time.Now().Add(time.Duration(q.config.QRLogin.ExpireSecond) * time.Second),
+	})
+	if err != nil {
+		return nil, "", xerror.Wrap(err)
+	}
+
+	return login, pollToken, nil
+}
+
+// Payload the content of the qr code, the deep link of the app when configured
+func (q *QRLoginService) Payload(login *entity.QRLoginEntity) (string, error) {
+	if q.config.QRLogin.ScanURL == "" {
+		return login.ID.String(), nil
+	}
+
+	scan, err := url.Parse(q.config.QRLogin.ScanURL)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	query := scan.Query()
+	query.Set("login_id", login.ID.String())
+	scan.RawQuery = query.Encode()
+
+	return scan.String(), nil
+}
+
+// Scan the user opened the code in the app, the browser is shown as scanned until the user decides.
+// Scanning again by the same user is allowed, a code scanned by someone else is used.
+func (q *QRLoginService) Scan(ctx context.Context, id uuid.UUID, user *entity.UserEntity, applicationID uuid.UUID) (*entity.QRLoginEntity, error) {
+	var login *entity.QRLoginEntity
+
+	if err := q.qrLoginRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		login, err = q.findValid(ctx, id, applicationID)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		switch {
+		case login.Status == enum.QRLoginStatusPending:
+		case login.Status == enum.QRLoginStatusScanned && login.UserID == user.ID:
+			return nil
+		default:
+			return xerror.Wrap(ErrQRLoginUsed)
+		}
+
+		login.Status = enum.QRLoginStatusScanned
+		login.UserID = user.ID
+
+		if err := q.qrLoginRepository.Update(ctx, login); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return login, nil
+}
+
+// Decide approves or rejects a code the user scanned
+func (q *QRLoginService) Decide(ctx context.Context, id uuid.UUID, user *entity.UserEntity, applicationID uuid.UUID, approve bool) error {
+	return q.qrLoginRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		login, err := q.findValid(ctx, id, applicationID)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if login.Status != enum.QRLoginStatusScanned || login.UserID != user.ID {
+			return xerror.Wrap(ErrQRLoginUsed)
+		}
+
+		login.Status = enum.QRLoginStatusRejected
+		if approve {
+			login.Status = enum.QRLoginStatusApproved
+		}
+
+		if err := q.qrLoginRepository.Update(ctx, login); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	})
+}
+
+// Poll the state of the login for the browser that created it. An approved or rejected login is
+// consumed by the poll that reports it.
+func (q *QRLoginService) Poll(
+	ctx context.Context,
+	applicationID uuid.UUID,
+	id uuid.UUID,
+	pollToken string,
+	deviceType string,
+	deviceID string) (*entity.QRLoginEntity, error) {
+	var login *entity.QRLoginEntity
+
+	if err := q.qrLoginRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		login, err = q.findValid(ctx, id, applicationID)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if login.DeviceType != deviceType ||
+			login.DeviceID != deviceID ||
+			subtle.ConstantTimeCompare([]byte(login.PollTokenHash), []byte(utils.Sha256(pollToken))) != 1 {
+			return xerror.Wrap(ErrQRLoginNotFound)
+		}
+
+		if login.Status != enum.QRLoginStatusApproved && login.Status != enum.QRLoginStatusRejected {
+			return nil
+		}
+
+		if err := q.qrLoginRepository.Delete(ctx, login); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return login, nil
+}
+
+func (q *QRLoginService) findValid(ctx context.Context, id uuid.UUID, applicationID uuid.UUID) (*entity.QRLoginEntity, error) {
+	login, err := q.qrLoginRepository.FindForUpdate(ctx, id)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if login == nil || login.ExpiresAt.Before(time.Now()) || login.ApplicationID != applicationID {
+		return nil, xerror.Wrap(ErrQRLoginNotFound)
+	}
+
+	return login, nil
+}
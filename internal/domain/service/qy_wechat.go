@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/utils/aes"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// QyWechatCorp the WeCom corp a login code was issued by, decrypted.
+// Organization is nil for the global corp of the config when it is not mapped.
+type QyWechatCorp struct {
+	CorpID       string
+	CorpSecret   string
+	Organization *aggregate.OrganizationAggregate
+}
+
+// QyWechatService WeCom corps mapped to organizations, one per organization.
+// The global corp of the wechat config keeps working without a mapping.
+type QyWechatService struct {
+	qyWechatCorpRepository     contract.IQyWechatCorpRepository
+	organizationReadRepository contract.IOrganizationReadRepository
+	config                     *config.Config
+}
+
+func NewQyWechatService(
+	qyWechatCorpRepository contract.IQyWechatCorpRepository,
+	organizationReadRepository contract.IOrganizationReadRepository,
+	config *config.Config) *QyWechatService {
+	return &QyWechatService{
+		qyWechatCorpRepository:     qyWechatCorpRepository,
+		organizationReadRepository: organizationReadRepository,
+		config:                     config,
+	}
+}
+
+// ResolveCorp the corp members log in from, an empty corp id is the global corp of the config
+func (q *QyWechatService) ResolveCorp(ctx context.Context, corpID string) (*QyWechatCorp, error) {
+	var globalCorpID, globalCorpSecret string
+	if q.config.Wechat != nil {
+		globalCorpID = q.config.Wechat.QyWechatCorpID
+		globalCorpSecret = q.config.Wechat.QyWechatCorpSecret
+	}
+
+	if corpID == "" {
+		corpID = globalCorpID
+	}
+
+	if corpID == "" {
+		return nil, xerror.Wrap(ErrQyWechatCorpNotFound)
+	}
+
+	corp, err := q.qyWechatCorpRepository.FindByCorpID(ctx, corpID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if corp == nil {
+		if corpID != globalCorpID {
+			return nil, xerror.Wrap(ErrQyWechatCorpNotFound)
+		}
+
+		return &QyWechatCorp{
+			CorpID:     corpID,
+			CorpSecret: globalCorpSecret,
+		}, nil
+	}
+
+	if !corp.Enabled {
+		return nil, xerror.Wrap(ErrQyWechatCorpDisabled)
+	}
+
+	key, err := q.secretKey()
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	corpSecret, err := aes.AESDecrypt(corp.CorpSecret, key)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	organization, err := q.organizationReadRepository.Find(ctx, corp.OrganizationID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if organization == nil {
+		return nil, xerror.Wrap(ErrQyWechatCorpNotFound)
+	}
+
+	return &QyWechatCorp{
+		CorpID:       corp.CorpID,
+		CorpSecret:   corpSecret,
+		Organization: organization,
+	}, nil
+}
+
+// BindingIdentity the identity of a qy wechat binding, userids and openids are only unique per corp.
+// Members of the global corp of the config keep the bare identity they were bound with.
+func (q *QyWechatService) BindingIdentity(corpID string, identity string) string {
+	if q.config.Wechat != nil && corpID == q.config.Wechat.QyWechatCorpID {
+		return identity
+	}
+
+	return corpID + ":" + identity
+}
+
+func (q *QyWechatService) GetCorp(ctx context.Context, organizationID uuid.UUID) (*entity.QyWechatCorpEntity, error) {
+	corp, err := q.qyWechatCorpRepository.FindByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if corp == nil {
+		return nil, xerror.Wrap(ErrQyWechatCorpNotFound)
+	}
+
+	return corp, nil
+}
+
+// SaveCorp maps the corp to the organization or replaces its mapping, an empty secret keeps the current one
+func (q *QyWechatService) SaveCorp(
+	ctx context.Context,
+	organizationID uuid.UUID,
+	corpID string,
+	corpSecret string,
+	enabled bool) (*entity.QyWechatCorpEntity, error) {
+	if corpID == "" {
+		return nil, xerror.Wrap(ErrQyWechatCorpInvalid)
+	}
+
+	mapped, err := q.qyWechatCorpRepository.FindByCorpID(ctx, corpID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if mapped != nil && mapped.OrganizationID != organizationID {
+		return nil, xerror.Wrap(ErrQyWechatCorpExists)
+	}
+
+	existing, err := q.qyWechatCorpRepository.FindByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if corpSecret == "" {
+		// a secret belongs to its corp, mapping another corp needs the secret of it
+		if existing == nil || existing.CorpID != corpID {
+			return nil, xerror.Wrap(ErrQyWechatCorpInvalid)
+		}
+		corpSecret = existing.CorpSecret
+	} else {
+		key, err := q.secretKey()
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		corpSecret, err = aes.AESEncrypt(corpSecret, key)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+	}
+
+	corp := &entity.QyWechatCorpEntity{
+		OrganizationID: organizationID,
+		CorpID:         corpID,
+		CorpSecret:     corpSecret,
+		Enabled:        enabled,
+	}
+
+	if existing == nil {
+		corp, err = q.qyWechatCorpRepository.Create(ctx, corp)
+	} else {
+		corp.ID = existing.ID
+		corp, err = q.qyWechatCorpRepository.Update(ctx, corp)
+	}
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return corp, nil
+}
+
+// DeleteCorp removes the mapping, members already provisioned stay in the organization
+func (q *QyWechatService) DeleteCorp(ctx context.Context, organizationID uuid.UUID) error {
+	corp, err := q.GetCorp(ctx, organizationID)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if err := q.qyWechatCorpRepository.Delete(ctx, corp.ID); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// secretKey corp secrets are encrypted at rest with the federation secret encryption key
+func (q *QyWechatService) secretKey() ([]byte, error) {
+	key := []byte(q.config.Federation.SecretEncryptionKey)
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, ErrFederationNotConfigured
+	}
+}
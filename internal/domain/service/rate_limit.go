@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/infrastructure/ratelimit"
+	"kiwi-user/internal/infrastructure/utils"
+	"strings"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+type rateLimitRule struct {
+	key    string
+	limit  int
+	window time.Duration
+}
+
+// RateLimitService limits how often verification codes are sent, every code costs an sms or an email.
+// The login endpoints are limited per client ip by the api server middleware.
+type RateLimitService struct {
+	limiter ratelimit.Limiter
+	config  *config.Config
+	logger  logger.ILogger
+}
+
+func NewRateLimitService(limiter ratelimit.Limiter, config *config.Config, logger logger.ILogger) *RateLimitService {
+	return &RateLimitService{
+		limiter: limiter,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// CheckVerifyCode counts a code sent to the phone number or email address for the application, the
+// client ip is taken from ctx. It returns ErrRateLimited and how long to wait when a limit is reached.
+func (r *RateLimitService) CheckVerifyCode(ctx context.Context, applicationName string, target string) (time.Duration, error) {
+	cfg := r.config.RateLimit
+	if !cfg.Enabled {
+		return 0, nil
+	}
+
+	target = strings.ToLower(strings.TrimSpace(target))
+
+	rules := []rateLimitRule{
+		{
+			key:    "code:interval:" + target,
+			limit:  1,
+			window: time.Duration(cfg.TargetIntervalSecond) * time.Second,
+		},
+		{
+			key:    "code:target:" + target,
+			limit:  cfg.TargetLimit,
+			window: time.Duration(cfg.TargetWindowSecond) * time.Second,
+		},
+	}
+
+	if ip := utils.ClientFromContext(ctx).IP; ip != "" {
+		rules = append(rules, rateLimitRule{
+			key:    "code:ip:" + ip,
+			limit:  cfg.IPLimit,
+			window: time.Duration(cfg.IPWindowSecond) * time.Second,
+		})
+	}
+
+	// clients sending codes without naming their application are only limited by target and ip
+	if applicationName != "" {
+		rules = append(rules, rateLimitRule{
+			key:    "code:application:" + applicationName,
+			limit:  cfg.ApplicationLimit,
+			window: time.Duration(cfg.ApplicationWindowSecond) * time.Second,
+		})
+	}
+
+	return r.check(ctx, rules)
+}
+
+// check stops at the first rule rejecting the request, the rules before it have counted the request.
+// The limiter counts in process while redis fails, a code is not sent when even that fails.
+func (r *RateLimitService) check(ctx context.Context, rules []rateLimitRule) (time.Duration, error) {
+	for _, rule := range rules {
+		if rule.limit <= 0 || rule.window <= 0 {
+			continue
+		}
+
+		result, err := r.limiter.Allow(ctx, rule.key, rule.limit, rule.window)
+		if err != nil {
+			return 0, xerror.Wrap(err)
+		}
+
+		if !result.Allowed {
+			return result.RetryAfter, xerror.Wrap(ErrRateLimited)
+		}
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/saml"
+	"strings"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// attribute mapping keys, the values are the saml attribute names sent by the identity provider
+const (
+	SAMLAttributeName       = "name"
+	SAMLAttributeEmail      = "email"
+	SAMLAttributeDepartment = "department"
+)
+
+// SAMLService SAML 2.0 identity providers of enterprise organizations, one per organization
+type SAMLService struct {
+	samlConnectionRepository contract.ISAMLConnectionRepository
+	config                   *config.Config
+}
+
+func NewSAMLService(
+	samlConnectionRepository contract.ISAMLConnectionRepository,
+	config *config.Config) *SAMLService {
+	return &SAMLService{
+		samlConnectionRepository: samlConnectionRepository,
+		config:                   config,
+	}
+}
+
+// ServiceProvider the entity id and assertion consumer service url of the organization
+func (s *SAMLService) ServiceProvider(organizationID uuid.UUID) (saml.ServiceProvider, error) {
+	if s.config.SAML == nil || s.config.SAML.BaseURL == "" {
+		return saml.ServiceProvider{}, ErrSAMLNotConfigured
+	}
+
+	base := strings.TrimRight(s.config.SAML.BaseURL, "/") + "/saml/" + organizationID.String()
+
+	return saml.ServiceProvider{
+		EntityID: base + "/metadata",
+		ACSURL:   base + "/acs",
+	}, nil
+}
+
+// Metadata the service provider metadata the organization registers at its identity provider
+func (s *SAMLService) Metadata(ctx context.Context, organizationID uuid.UUID) ([]byte, error) {
+	if _, err := s.GetConnection(ctx, organizationID); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	sp, err := s.ServiceProvider(organizationID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return saml.Metadata(sp), nil
+}
+
+func (s *SAMLService) GetConnection(ctx context.Context, organizationID uuid.UUID) (*entity.SAMLConnectionEntity, error) {
+	connection, err := s.samlConnectionRepository.FindByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if connection == nil {
+		return nil, xerror.Wrap(ErrSAMLConnectionNotFound)
+	}
+
+	return connection, nil
+}
+
+// GetEnabledConnection the connection members of the organization log in with
+func (s *SAMLService) GetEnabledConnection(ctx context.Context, organizationID uuid.UUID) (*entity.SAMLConnectionEntity, error) {
+	connection, err := s.GetConnection(ctx, organizationID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if !connection.Enabled {
+		return nil, xerror.Wrap(ErrSAMLConnectionDisabled)
+	}
+
+	return connection, nil
+}
+
+// SaveConnection creates or replaces the connection of the organization from the uploaded identity provider metadata
+func (s *SAMLService) SaveConnection(
+	ctx context.Context,
+	organizationID uuid.UUID,
+	idpMetadata string,
+	attributeMapping map[string]string,
+	redirectURL string,
+	enabled bool) (*entity.SAMLConnectionEntity, error) {
+	idp, err := saml.ParseMetadata([]byte(idpMetadata))
+	if err != nil {
+		return nil, xerror.Wrap(ErrSAMLConnectionInvalid)
+	}
+
+	if !isHTTPSURL(redirectURL) {
+		return nil, xerror.Wrap(ErrSAMLConnectionInvalid)
+	}
+
+	for key, value := range attributeMapping {
+		switch key {
+		case SAMLAttributeName, SAMLAttributeEmail, SAMLAttributeDepartment:
+		default:
+			return nil, xerror.Wrap(ErrSAMLConnectionInvalid)
+		}
+
+		if value == "" {
+			return nil, xerror.Wrap(ErrSAMLConnectionInvalid)
+		}
+	}
+
+	existing, err := s.samlConnectionRepository.FindByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	connection := &entity.SAMLConnectionEntity{
+		OrganizationID:   organizationID,
+		IdPEntityID:      idp.EntityID,
+		IdPSSOURL:        idp.SSOURL,
+		IdPCertificates:  idp.Certificates,
+		IdPMetadata:      idpMetadata,
+		AttributeMapping: attributeMapping,
+		RedirectURL:      redirectURL,
+		Enabled:          enabled,
+	}
+
+	if existing == nil {
+		connection, err = s.samlConnectionRepository.Create(ctx, connection)
+	} else {
+		connection.ID = existing.ID
+		connection, err = s.samlConnectionRepository.Update(ctx, connection)
+	}
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return connection, nil
+}
+
+func (s *SAMLService) DeleteConnection(ctx context.Context, organizationID uuid.UUID) error {
+	connection, err := s.GetConnection(ctx, organizationID)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if err := s.samlConnectionRepository.Delete(ctx, connection.ID); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// AuthnRequestURL where the browser is sent to log in at the identity provider
+func (s *SAMLService) AuthnRequestURL(
+	connection *entity.SAMLConnectionEntity,
+	requestID string,
+	relayState string) (string, error) {
+	sp, err := s.ServiceProvider(connection.OrganizationID)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	authnRequestURL, err := saml.AuthnRequestURL(sp, toSAMLIdentityProvider(connection), requestID, relayState, time.Now())
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	return authnRequestURL, nil
+}
+
+// Identity validates the response posted to the assertion consumer service and maps its attributes
+func (s *SAMLService) Identity(
+	connection *entity.SAMLConnectionEntity,
+	samlResponse string,
+	requestID string) (*SAMLIdentity, error) {
+	sp, err := s.ServiceProvider(connection.OrganizationID)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	assertion, err := saml.ParseResponse(samlResponse, sp, toSAMLIdentityProvider(connection), requestID, time.Now())
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if assertion.NameID == "" {
+		return nil, xerror.Wrap(ErrSAMLNameIDNotFound)
+	}
+
+	identity := &SAMLIdentity{
+		NameID:     assertion.NameID,
+		Name:       mappedAttribute(assertion, connection.AttributeMapping, SAMLAttributeName),
+		Email:      mappedAttribute(assertion, connection.AttributeMapping, SAMLAttributeEmail),
+		Department: mappedAttribute(assertion, connection.AttributeMapping, SAMLAttributeDepartment),
+	}
+
+	// without a mapped email attribute an email formatted name id is the email
+	if identity.Email == "" && assertion.NameIDFormat == saml.NameIDFormatEmail {
+		identity.Email = assertion.NameID
+	}
+
+	return identity, nil
+}
+
+func mappedAttribute(assertion *saml.Assertion, mapping map[string]string, key string) string {
+	name, ok := mapping[key]
+	if !ok {
+		name = key
+	}
+
+	return assertion.Attribute(name)
+}
+
+func toSAMLIdentityProvider(connection *entity.SAMLConnectionEntity) *saml.IdentityProvider {
+	return &saml.IdentityProvider{
+		EntityID:     connection.IdPEntityID,
+		SSOURL:       connection.IdPSSOURL,
+		Certificates: connection.IdPCertificates,
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/sms"
+	"kiwi-user/internal/infrastructure/utils"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// SmsService sends codes proving the ownership of a phone number. The codes are generated and
+// checked here like the email codes, the providers of an application only deliver them and take
+// over from each other in the configured order.
+type SmsService struct {
+	smsVerifyCodeRepository contract.ISmsVerifyCodeRepository
+	senders                 sms.Senders
+	config                  *config.Config
+	logger                  logger.ILogger
+}
+
+func NewSmsService(
+	smsVerifyCodeRepository contract.ISmsVerifyCodeRepository,
+	senders sms.Senders,
+	config *config.Config,
+	logger logger.ILogger,
+) *SmsService {
+	return &SmsService{
+		smsVerifyCodeRepository: smsVerifyCodeRepository,
+		senders:                 senders,
+		config:                  config,
+		logger:                  logger,
+	}
+}
+
+// SendVerifyCode sends a new code of the purpose, the previous one stops working
+func (s *SmsService) SendVerifyCode(ctx context.Context, applicationName string, phone string, codetype enum.VertificationCodeType) error {
+	senders := s.senders.Ordered(s.providers(applicationName))
+	if len(senders) == 0 {
+		return xerror.Wrap(sms.ErrNotConfigured)
+	}
+
+	code, err := utils.GenerateNumericCode(s.config.VerificationCode.Length)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	existingCode, err := s.smsVerifyCodeRepository.Find(ctx, phone, codetype)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	smsVerifyCode := &entity.SmsVerifyCodeEntity{
+		Phone:     phone,
+		CodeHash:  hashVerificationCode(s.config.VerificationCode.HashKey, phone, codetype, code),
+		Type:      codetype,
+		Attempts:  0,
+		ExpiresAt: time.Now().Add(time.Duration(s.config.VerificationCode.ExpireSecond) * time.Second),
+	}
+
+	if existingCode != nil {
+		smsVerifyCode.ID = existingCode.ID
+		err = s.smsVerifyCodeRepository.Update(ctx, smsVerifyCode)
+	} else {
+		err = s.smsVerifyCodeRepository.Create(ctx, smsVerifyCode)
+	}
+
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	for _, sender := range senders {
+		if err = sender.SendVerifyCode(ctx, phone, code); err == nil {
+			return nil
+		}
+
+		s.logger.Warnf(ctx, "send sms verify code with %s failed: %w", sender.Name(), err)
+	}
+
+	return xerror.Wrap(err)
+}
+
+// VerifyCode consumes the code on a match, after MaxAttempts wrong codes a new one has to be sent
+func (s *SmsService) VerifyCode(ctx context.Context, phone string, code string, codetype enum.VertificationCodeType) (bool, error) {
+	var verifyErr error
+
+	if err := s.smsVerifyCodeRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		existingCode, err := s.smsVerifyCodeRepository.FindForUpdate(ctx, phone, codetype)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if existingCode == nil || existingCode.ExpiresAt.Before(time.Now()) {
+			verifyErr = ErrVerificationCodeNotFound
+			return nil
+		}
+
+		attempts, err := checkVerificationCode(
+			existingCode.CodeHash,
+			existingCode.Attempts,
+			s.config.VerificationCode.MaxAttempts,
+			hashVerificationCode(s.config.VerificationCode.HashKey, phone, codetype, code))
+		if xerror.Is(err, ErrVerificationCodeInvalid) {
+			verifyErr = err
+			existingCode.Attempts = attempts
+			if err := s.smsVerifyCodeRepository.Update(ctx, existingCode); err != nil {
+				return xerror.Wrap(err)
+			}
+			return nil
+		}
+
+		verifyErr = err
+		if err := s.smsVerifyCodeRepository.Delete(ctx, existingCode); err != nil {
+			return xerror.Wrap(err)
+		}
+		return nil
+	}); err != nil {
+		return false, xerror.Wrap(err)
+	}
+
+	if verifyErr != nil {
+		return false, verifyErr
+	}
+
+	return true, nil
+}
+
+// providers failover order of the application, volcengine when none is configured
+func (s *SmsService) providers(applicationName string) []string {
+	if policy, ok := s.config.Sms.Applications[applicationName]; ok && policy != nil && len(policy.Providers) > 0 {
+		return policy.Providers
+	}
+
+	if len(s.config.Sms.Providers) > 0 {
+		return s.config.Sms.Providers
+	}
+
+	return []string{sms.ProviderVolcengine}
+}
@@ -6,15 +6,18 @@ import (
 	"kiwi-user/internal/domain/model/aggregate"
 	"kiwi-user/internal/domain/model/entity"
 	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/password"
 	"kiwi-user/internal/infrastructure/utils"
 	"net/url"
 
 	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
 )
 
 type UserService struct {
 	userRepository          contract.IUserRepository
 	bindingVerifyRepository contract.IBindingVerifyRepository
+	passwordHasher          *password.Hasher
 }
 
 func (u *UserService) Update(
@@ -48,8 +51,7 @@ func (u *UserService) UserRegisterWithPassword(
 	}
 
 	// create the user
-	salt := utils.RandomSalt(name)
-	identity, err := utils.EncodePassword(password, salt)
+	identity, err := u.passwordHasher.Hash(password)
 	if err != nil {
 		return nil, xerror.Wrap(err)
 	}
@@ -63,7 +65,6 @@ func (u *UserService) UserRegisterWithPassword(
 			{
 				Type:     enum.BindingTypePassword,
 				Identity: identity,
-				Salt:     salt,
 				Verified: verified,
 			},
 		},
@@ -158,13 +159,13 @@ func (u *UserService) UpdatePassword(
 			user.Bindings = append(user.Bindings, passwordBinding)
 		}
 
-		salt := utils.RandomSalt(user.User.Name)
-		hashedPassword, err := utils.EncodePassword(newPassword, salt)
+		hashedPassword, err := u.passwordHasher.Hash(newPassword)
 		if err != nil {
 			return xerror.Wrap(err)
 		}
 
-		passwordBinding.Salt = salt
+		// the salt is part of the PHC string, the column only serves legacy hashes
+		passwordBinding.Salt = ""
 		passwordBinding.Identity = hashedPassword
 		passwordBinding.Verified = true
 
@@ -181,11 +182,89 @@ func (u *UserService) UpdatePassword(
 	return user, nil
 }
 
+// SetBanned ban or unban the user, tokens already issued have to be revoked by the caller
+func (u *UserService) SetBanned(
+	ctx context.Context,
+	user *aggregate.UserAggregate,
+	banned bool) (*aggregate.UserAggregate, error) {
+
+	user.User.Banned = banned
+	user, err := u.userRepository.Update(ctx, user)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return user, nil
+}
+
+// FindByVerifiedBinding find the user owning a verified email or phone binding
+func (u *UserService) FindByVerifiedBinding(
+	ctx context.Context,
+	applicationID uuid.UUID,
+	bindingType enum.BindingType,
+	identity string) (*aggregate.UserAggregate, error) {
+
+	var user *aggregate.UserAggregate
+	if err := u.userRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = u.userRepository.FindByBindingForUpdate(ctx, applicationID, &entity.BindingEntity{
+			Type:     bindingType,
+			Identity: identity,
+		})
+		return err
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if user == nil {
+		return nil, nil
+	}
+
+	for _, binding := range user.Bindings {
+		if binding.Type == bindingType && binding.Identity == identity && binding.Verified {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// PasswordFingerprint identifies the current password without revealing it, empty when the user has none
+func (u *UserService) PasswordFingerprint(user *aggregate.UserAggregate) string {
+	for _, binding := range user.Bindings {
+		if binding.Type == enum.BindingTypePassword {
+			return utils.Sha256(binding.Salt + binding.Identity)
+		}
+	}
+
+	return ""
+}
+
+// VerifyPassword check password against the password binding of the user
+func (u *UserService) VerifyPassword(user *aggregate.UserAggregate, password string) (bool, error) {
+	for _, binding := range user.Bindings {
+		if binding.Type != enum.BindingTypePassword {
+			continue
+		}
+
+		ok, _, err := u.passwordHasher.Verify(password, binding.Identity, binding.Salt)
+		if err != nil {
+			return false, xerror.Wrap(err)
+		}
+
+		return ok, nil
+	}
+
+	return false, nil
+}
+
 func NewUserService(
 	userRepository contract.IUserRepository,
-	bindingVerifyRepository contract.IBindingVerifyRepository) *UserService {
+	bindingVerifyRepository contract.IBindingVerifyRepository,
+	passwordHasher *password.Hasher) *UserService {
 	return &UserService{
 		userRepository:          userRepository,
 		bindingVerifyRepository: bindingVerifyRepository,
+		passwordHasher:          passwordHasher,
 	}
 }
@@ -2,7 +2,8 @@ package service
 
 import (
 	"context"
-	"errors"
+	"crypto/subtle"
+	"kiwi-user/config"
 	"kiwi-user/internal/domain/contract"
 	"kiwi-user/internal/domain/model/entity"
 	"kiwi-user/internal/domain/model/enum"
@@ -14,32 +15,41 @@ import (
 	"github.com/futurxlab/golanggraph/xerror"
 )
 
+// VertificationCodeService mails codes proving the ownership of an email. Codes are stored as keyed
+// hashes, each purpose of an email has its own code and a code is dropped after too many wrong guesses.
 type VertificationCodeService struct {
 	mailVertifyCodeRepository contract.IMailVertifyCodeRepository
 	mailClient                *resend.ResendClient
+	config                    *config.Config
 	logger                    logger.ILogger
 }
 
 func NewVertificationCodeService(
 	logger logger.ILogger,
 	mailClient *resend.ResendClient,
-	mailVertifyCodeRepository contract.IMailVertifyCodeRepository) *VertificationCodeService {
+	mailVertifyCodeRepository contract.IMailVertifyCodeRepository,
+	config *config.Config) *VertificationCodeService {
 
 	return &VertificationCodeService{
 		logger:                    logger,
 		mailClient:                mailClient,
 		mailVertifyCodeRepository: mailVertifyCodeRepository,
+		config:                    config,
 	}
 }
 
-// SendEmailVerificationCode sends a verification code to the specified email
+// SendEmailVerificationCode sends a verification code to the specified email, the previous code of
+// the same purpose stops working
 func (v *VertificationCodeService) SendEmailVerificationCode(
 	ctx context.Context,
 	email string,
 	codetype enum.VertificationCodeType,
 ) error {
 	// 1. Generate a random verification code
-	code := utils.GenerateVerificationCode()
+	code, err := utils.GenerateNumericCode(v.config.VerificationCode.Length)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
 
 	// 2. Check if verification code exists for this email
 	existingCode, err := v.mailVertifyCodeRepository.Find(ctx, email, codetype)
@@ -47,12 +57,13 @@ func (v *VertificationCodeService) SendEmailVerificationCode(
 		return xerror.Wrap(err)
 	}
 
-	// 3. Create or update verification code
+	// 3. Create or update verification code, a new code gets all its attempts back
 	mailVertifyCode := &entity.MailVertifyCodeEntity{
 		Email:     email,
-		Code:      code,
+		CodeHash:  v.hashCode(email, codetype, code),
 		Type:      codetype,
-		ExpiresAt: time.Now().Add(time.Minute * 10),
+		Attempts:  0,
+		ExpiresAt: time.Now().Add(time.Duration(v.config.VerificationCode.ExpireSecond) * time.Second),
 	}
 
 	if existingCode != nil {
@@ -78,33 +89,78 @@ func (v *VertificationCodeService) SendEmailVerificationCode(
 	return nil
 }
 
+// VerifyEmailCode consumes the code on a match, after MaxAttempts wrong codes a new one has to be sent
 func (v *VertificationCodeService) VerifyEmailCode(
 	ctx context.Context,
 	email string,
 	code string,
 	codetype enum.VertificationCodeType,
 ) (bool, error) {
-	existingCode, err := v.mailVertifyCodeRepository.Find(ctx, email, codetype)
-	if err != nil {
+	var verifyErr error
+
+	// the attempt has to be counted even though the verification fails, so the transaction only
+	// rolls back on repository errors
+	if err := v.mailVertifyCodeRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		// locks the code, concurrent guesses are counted one by one
+		existingCode, err := v.mailVertifyCodeRepository.FindForUpdate(ctx, email, codetype)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if existingCode == nil || existingCode.ExpiresAt.Before(time.Now()) {
+			verifyErr = ErrVerificationCodeNotFound
+			return nil
+		}
+
+		attempts, err := checkVerificationCode(existingCode.CodeHash, existingCode.Attempts, v.config.VerificationCode.MaxAttempts, v.hashCode(email, codetype, code))
+		if xerror.Is(err, ErrVerificationCodeInvalid) {
+			verifyErr = err
+			existingCode.Attempts = attempts
+			if err := v.mailVertifyCodeRepository.Update(ctx, existingCode); err != nil {
+				return xerror.Wrap(err)
+			}
+			return nil
+		}
+
+		// matched or used up, either way the code is gone
+		verifyErr = err
+		if err := v.mailVertifyCodeRepository.Delete(ctx, existingCode); err != nil {
+			return xerror.Wrap(err)
+		}
+		return nil
+	}); err != nil {
 		return false, xerror.Wrap(err)
 	}
 
-	if existingCode == nil {
-		return false, xerror.Wrap(errors.New("verification code not found"))
+	if verifyErr != nil {
+		return false, verifyErr
 	}
 
-	if existingCode.ExpiresAt.Before(time.Now()) {
-		return false, xerror.Wrap(errors.New("verification code expired"))
-	}
+	return true, nil
+}
 
-	if existingCode.Code != code {
-		return false, xerror.Wrap(errors.New("invalid verification code"))
+func (v *VertificationCodeService) hashCode(email string, codetype enum.VertificationCodeType, code string) string {
+	return hashVerificationCode(v.config.VerificationCode.HashKey, email, codetype, code)
+}
+
+// hashVerificationCode binds the code to the email or phone and the purpose, a stored hash is
+// useless for another one
+func hashVerificationCode(key string, target string, codetype enum.VertificationCodeType, code string) string {
+	return utils.HmacSha256([]byte(key), target+":"+codetype.String()+":"+code)
+}
+
+// checkVerificationCode compares the code with the stored hash and counts a wrong one, returns the
+// attempts after the check. A match returns no error, ErrVerificationCodeInvalid keeps the code for
+// another attempt, ErrVerificationCodeExhausted used up the last one.
+func checkVerificationCode(codeHash string, attempts int, maxAttempts int, hash string) (int, error) {
+	if attempts < maxAttempts && subtle.ConstantTimeCompare([]byte(codeHash), []byte(hash)) == 1 {
+		return attempts, nil
 	}
 
-	err = v.mailVertifyCodeRepository.Delete(ctx, existingCode)
-	if err != nil {
-		return false, xerror.Wrap(err)
+	attempts++
+	if attempts < maxAttempts {
+		return attempts, ErrVerificationCodeInvalid
 	}
 
-	return true, nil
+	return attempts, ErrVerificationCodeExhausted
 }
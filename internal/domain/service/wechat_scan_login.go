@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"kiwi-user/config"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/utils"
+	"kiwi-user/internal/infrastructure/wechat"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+// wechatScanLoginPollTokenBytes the secret only the browser showing the code holds
+const wechatScanLoginPollTokenBytes = 32
+
+// WechatScanLoginService scan to follow login of the wechat official account: a browser shows a
+// parametric qr code of the account, wechat pushes an event with the openid of the user scanning it,
+// and the browser, polling with its poll token, is logged in as that wechat user
+type WechatScanLoginService struct {
+	wechatScanLoginRepository contract.IWechatScanLoginRepository
+	officialAccount           *wechat.OfficialAccount
+	config                    *config.Config
+	logger                    logger.ILogger
+}
+
+func NewWechatScanLoginService(
+	logger logger.ILogger,
+	config *config.Config,
+	wechatScanLoginRepository contract.IWechatScanLoginRepository,
+	officialAccount *wechat.OfficialAccount) *WechatScanLoginService {
+	return &WechatScanLoginService{
+		logger:                    logger,
+		config:                    config,
+		wechatScanLoginRepository: wechatScanLoginRepository,
+		officialAccount:           officialAccount,
+	}
+}
+
+// Create records a pending login of the browser and the qr code of it, the returned poll token completes it
+func (w *WechatScanLoginService) Create(
+	ctx context.Context,
+	applicationID uuid.UUID,
+	deviceType string,
+	deviceID string) (*entity.WechatScanLoginEntity, *wechat.QRCode, string, error) {
+	// codes live for minutes, drop the stale ones on the way
+	if err := w.wechatScanLoginRepository.DeleteExpired(ctx); err != nil {
+		w.logger.Warnf(ctx, "delete expired wechat scan logins failed: %w", err)
+	}
+
+	pollToken, err := utils.RandomURLSafeToken(wechatScanLoginPollTokenBytes)
+	if err != nil {
+		return nil, nil, "", xerror.Wrap(err)
+	}
+
+	expire := time.Duration(w.config.Wechat.OfficalAccountQRExpireSecond) * time.Second
+
+	login, err := w.wechatScanLoginRepository.Create(ctx, &entity.WechatScanLoginEntity{
+		ApplicationID: applicationID,
+		DeviceType:    deviceType,
+		DeviceID:      deviceID,
+		PollTokenHash: utils.Sha256(pollToken),
+		ExpiresAt:     time.Now().Add(expire),
+	})
+	if err != nil {
+		return nil, nil, "", xerror.Wrap(err)
+	}
+
+	qrCode, err := w.officialAccount.CreateQRCode(ctx, login.ID.String(), expire)
+	if err != nil {
+		return nil, nil, "", xerror.Wrap(err)
+	}
+
+	return login, qrCode, pollToken, nil
+}
+
+// Scan records the wechat user of a scan event. Wechat retries events it got no answer for, the
+// same user scanning again is accepted, a code scanned by someone else is used.
+func (w *WechatScanLoginService) Scan(ctx context.Context, event *wechat.ScanEvent) error {
+	id, err := uuid.Parse(event.Scene)
+	if err != nil {
+		return xerror.Wrap(ErrWechatScanLoginNotFound)
+	}
+
+	return w.wechatScanLoginRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		login, err := w.findValid(ctx, id)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if login.Scanned() {
+			if login.OpenID != event.OpenID {
+				return xerror.Wrap(ErrWechatScanLoginUsed)
+			}
+			return nil
+		}
+
+		login.OpenID = event.OpenID
+		login.UnionID = event.UnionID
+
+		if err := w.wechatScanLoginRepository.Update(ctx, login); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	})
+}
+
+// Poll the state of the login for the browser that created it, a scanned login is consumed by the
+// poll that reports it
+func (w *WechatScanLoginService) Poll(
+	ctx context.Context,
+	applicationID uuid.UUID,
+	id uuid.UUID,
+	pollToken string,
+	deviceType string,
+	deviceID string) (*entity.WechatScanLoginEntity, error) {
+	var login *entity.WechatScanLoginEntity
+
+	if err := w.wechatScanLoginRepository.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		login, err = w.findValid(ctx, id)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if login.ApplicationID != applicationID ||
+			login.DeviceType != deviceType ||
+			login.DeviceID != deviceID ||
+			subtle.ConstantTimeCompare([]byte(login.PollTokenHash), []byte(utils.Sha256(pollToken))) != 1 {
+			return xerror.Wrap(ErrWechatScanLoginNotFound)
+		}
+
+		if !login.Scanned() {
+			return nil
+		}
+
+		if err := w.wechatScanLoginRepository.Delete(ctx, login); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return login, nil
+}
+
+func (w *WechatScanLoginService) findValid(ctx context.Context, id uuid.UUID) (*entity.WechatScanLoginEntity, error) {
+	login, err := w.wechatScanLoginRepository.FindForUpdate(ctx, id)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if login == nil || login.ExpiresAt.Before(time.Now()) {
+		return nil, xerror.Wrap(ErrWechatScanLoginNotFound)
+	}
+
+	return login, nil
+}
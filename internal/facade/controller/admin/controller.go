@@ -9,6 +9,12 @@ type Controller struct {
 	organizationApplication            *application.OrganizationApplication
 	organizationApplicationApplication *application.OrganizationApplicationApplication
 	userApplication                    *application.UserApplication
+	oauthApplication                   *application.OAuthApplication
+	tokenApplication                   *application.TokenApplication
+	loginLockApplication               *application.LoginLockApplication
+	federationApplication              *application.FederationApplication
+	samlApplication                    *application.SAMLApplication
+	qyWechatApplication                *application.QyWechatApplication
 }
 
 func NewController(
@@ -16,11 +22,23 @@ func NewController(
 	organizationApplication *application.OrganizationApplication,
 	organizationApplicationApplication *application.OrganizationApplicationApplication,
 	userApplication *application.UserApplication,
+	oauthApplication *application.OAuthApplication,
+	tokenApplication *application.TokenApplication,
+	loginLockApplication *application.LoginLockApplication,
+	federationApplication *application.FederationApplication,
+	samlApplication *application.SAMLApplication,
+	qyWechatApplication *application.QyWechatApplication,
 ) (*Controller, error) {
 	return &Controller{
 		rbacApplication:                    rbacApplication,
 		organizationApplication:            organizationApplication,
 		organizationApplicationApplication: organizationApplicationApplication,
 		userApplication:                    userApplication,
+		oauthApplication:                   oauthApplication,
+		tokenApplication:                   tokenApplication,
+		loginLockApplication:               loginLockApplication,
+		federationApplication:              federationApplication,
+		samlApplication:                    samlApplication,
+		qyWechatApplication:                qyWechatApplication,
 	}, nil
 }
@@ -0,0 +1,82 @@
+package admin
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// ListIdentityProviders godoc
+// @Summary ListIdentityProviders
+// @Tags Admin
+// @Description OpenID Connect providers of an application, client secrets are never returned
+// @Accept  json
+// @Produce  json
+// @Param  application query string true "application name"
+// @Success 200 {object}  facade.BaseResponse{data=dto.IdentityProvidersAdminResponse}
+//
+// @Router /admin/identity_providers [get]
+func (c *Controller) ListIdentityProviders(ctx *gin.Context, userID string) (*dto.IdentityProvidersAdminResponse, *facade.Error) {
+	return c.federationApplication.ListIdentityProviders(ctx, ctx.Query("application"))
+}
+
+// CreateIdentityProvider godoc
+// @Summary CreateIdentityProvider
+// @Tags Admin
+// @Description register an OpenID Connect provider, endpoints left empty are discovered from the issuer
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.IdentityProviderRequest true "identity provider"
+// @Success 200 {object}  facade.BaseResponse{data=dto.IdentityProvider}
+//
+// @Router /admin/identity_providers [post]
+func (c *Controller) CreateIdentityProvider(ctx *gin.Context, userID string) (*dto.IdentityProvider, *facade.Error) {
+	var request dto.IdentityProviderRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.federationApplication.CreateIdentityProvider(ctx, request)
+}
+
+// UpdateIdentityProvider godoc
+// @Summary UpdateIdentityProvider
+// @Tags Admin
+// @Description replace the configuration of a provider, the name can not change and an empty client_secret keeps the current one
+// @Accept  json
+// @Produce  json
+// @Param  id path string true "identity provider id"
+// @Param  request body dto.IdentityProviderRequest true "identity provider"
+// @Success 200 {object}  facade.BaseResponse{data=dto.IdentityProvider}
+//
+// @Router /admin/identity_providers/{id} [put]
+func (c *Controller) UpdateIdentityProvider(ctx *gin.Context, userID string) (*dto.IdentityProvider, *facade.Error) {
+	var request dto.IdentityProviderRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.federationApplication.UpdateIdentityProvider(ctx, ctx.Param("id"), request)
+}
+
+// DeleteIdentityProvider godoc
+// @Summary DeleteIdentityProvider
+// @Tags Admin
+// @Description remove a provider, users who logged in with it keep their accounts
+// @Accept  json
+// @Produce  json
+// @Param  id path string true "identity provider id"
+// @Param  application query string true "application name"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /admin/identity_providers/{id} [delete]
+func (c *Controller) DeleteIdentityProvider(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	if err := c.federationApplication.DeleteIdentityProvider(ctx, ctx.Query("application"), ctx.Param("id")); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// ListLoginLocks godoc
+// @Summary ListLoginLocks
+// @Tags Admin
+// @Description user names and client ips locked after too many failed password logins
+// @Accept  json
+// @Produce  json
+// @Param  application query string false "application name, all applications when empty"
+// @Success 200 {object}  facade.BaseResponse{data=dto.LoginLocksResponse}
+//
+// @Router /admin/login/locks [get]
+func (c *Controller) ListLoginLocks(ctx *gin.Context, userID string) (*dto.LoginLocksResponse, *facade.Error) {
+	return c.loginLockApplication.ListLoginLocks(ctx, ctx.Query("application"))
+}
+
+// ClearLoginLock godoc
+// @Summary ClearLoginLock
+// @Tags Admin
+// @Description unlock a user name or client ip and reset its failed attempts
+// @Accept  json
+// @Produce  json
+// @Param  id path string true "lock id"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /admin/login/locks/{id} [delete]
+func (c *Controller) ClearLoginLock(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	if err := c.loginLockApplication.ClearLoginLock(ctx, ctx.Param("id")); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
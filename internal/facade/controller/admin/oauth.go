@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateOAuthClient godoc
+// @Summary UpdateOAuthClient
+// @Tags Admin
+// @Description register redirect uris of an application and optionally issue a new client secret, the secret is only returned once
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.UpdateOAuthClientRequest true "update oauth client request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.UpdateOAuthClientResponse}
+//
+// @Router /admin/rbac/application/oauth [put]
+func (c *Controller) UpdateOAuthClient(ctx *gin.Context, userID string) (*dto.UpdateOAuthClientResponse, *facade.Error) {
+	request := &dto.UpdateOAuthClientRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.oauthApplication.UpdateOAuthClient(ctx, request)
+}
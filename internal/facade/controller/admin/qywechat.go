@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// GetQyWechatCorp godoc
+// @Summary GetQyWechatCorp
+// @Tags Admin
+// @Description WeCom corp mapped to an organization
+// @Accept  json
+// @Produce  json
+// @Param  organization_id query string true "organization id"
+// @Success 200 {object}  facade.BaseResponse{data=dto.QyWechatCorp}
+//
+// @Router /admin/organization/qywechat [get]
+func (c *Controller) GetQyWechatCorp(ctx *gin.Context, userID string) (*dto.QyWechatCorp, *facade.Error) {
+	return c.qyWechatApplication.GetCorp(ctx, ctx.Query("organization_id"))
+}
+
+// SaveQyWechatCorp godoc
+// @Summary SaveQyWechatCorp
+// @Tags Admin
+// @Description map a WeCom corp to an organization or replace its mapping, members logging in from it join the organization
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.QyWechatCorpRequest true "qy wechat corp"
+// @Success 200 {object}  facade.BaseResponse{data=dto.QyWechatCorp}
+//
+// @Router /admin/organization/qywechat [put]
+func (c *Controller) SaveQyWechatCorp(ctx *gin.Context, userID string) (*dto.QyWechatCorp, *facade.Error) {
+	var request dto.QyWechatCorpRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.qyWechatApplication.SaveCorp(ctx, request)
+}
+
+// DeleteQyWechatCorp godoc
+// @Summary DeleteQyWechatCorp
+// @Tags Admin
+// @Description remove the WeCom corp of an organization, provisioned members stay
+// @Accept  json
+// @Produce  json
+// @Param  organization_id query string true "organization id"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /admin/organization/qywechat [delete]
+func (c *Controller) DeleteQyWechatCorp(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	if err := c.qyWechatApplication.DeleteCorp(ctx, ctx.Query("organization_id")); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
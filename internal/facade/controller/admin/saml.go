@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// GetSAMLConnection godoc
+// @Summary GetSAMLConnection
+// @Tags Admin
+// @Description SAML identity provider of an organization and the service provider values to register at it
+// @Accept  json
+// @Produce  json
+// @Param  organization_id query string true "organization id"
+// @Success 200 {object}  facade.BaseResponse{data=dto.SAMLConnection}
+//
+// @Router /admin/organization/saml [get]
+func (c *Controller) GetSAMLConnection(ctx *gin.Context, userID string) (*dto.SAMLConnection, *facade.Error) {
+	return c.samlApplication.GetConnection(ctx, ctx.Query("organization_id"))
+}
+
+// SaveSAMLConnection godoc
+// @Summary SaveSAMLConnection
+// @Tags Admin
+// @Description create or replace the SAML identity provider of an organization from its metadata
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.SAMLConnectionRequest true "saml connection"
+// @Success 200 {object}  facade.BaseResponse{data=dto.SAMLConnection}
+//
+// @Router /admin/organization/saml [put]
+func (c *Controller) SaveSAMLConnection(ctx *gin.Context, userID string) (*dto.SAMLConnection, *facade.Error) {
+	var request dto.SAMLConnectionRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.samlApplication.SaveConnection(ctx, request)
+}
+
+// DeleteSAMLConnection godoc
+// @Summary DeleteSAMLConnection
+// @Tags Admin
+// @Description remove the SAML identity provider of an organization, provisioned members stay
+// @Accept  json
+// @Produce  json
+// @Param  organization_id query string true "organization id"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /admin/organization/saml [delete]
+func (c *Controller) DeleteSAMLConnection(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	if err := c.samlApplication.DeleteConnection(ctx, ctx.Query("organization_id")); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// ListSigningKeys godoc
+// @Summary ListSigningKeys
+// @Tags Admin
+// @Description ListSigningKeys
+// @Accept  json
+// @Produce  json
+// @Success 200 {object}  facade.BaseResponse{data=dto.SigningKeysResponse}
+//
+// @Router /admin/jwt/keys [get]
+func (c *Controller) ListSigningKeys(ctx *gin.Context, userID string) (*dto.SigningKeysResponse, *facade.Error) {
+	return c.tokenApplication.ListSigningKeys(ctx)
+}
+
+// StageSigningKey godoc
+// @Summary StageSigningKey
+// @Tags Admin
+// @Description generate a pending signing key, it is published in the jwks but does not sign yet
+// @Accept  json
+// @Produce  json
+// @Success 200 {object}  facade.BaseResponse{data=dto.SigningKey}
+//
+// @Router /admin/jwt/keys [post]
+func (c *Controller) StageSigningKey(ctx *gin.Context, userID string) (*dto.SigningKey, *facade.Error) {
+	return c.tokenApplication.StageSigningKey(ctx)
+}
+
+// ActivateSigningKey godoc
+// @Summary ActivateSigningKey
+// @Tags Admin
+// @Description sign new tokens with a pending key, the current active key is retired
+// @Accept  json
+// @Produce  json
+// @Param  kid path string true "key id"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /admin/jwt/keys/{kid}/activate [put]
+func (c *Controller) ActivateSigningKey(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	if err := c.tokenApplication.ActivateSigningKey(ctx, ctx.Param("kid")); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
+
+// RemoveSigningKey godoc
+// @Summary RemoveSigningKey
+// @Tags Admin
+// @Description remove a pending key, or a retired key after every token it signed has expired
+// @Accept  json
+// @Produce  json
+// @Param  kid path string true "key id"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /admin/jwt/keys/{kid} [delete]
+func (c *Controller) RemoveSigningKey(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	if err := c.tokenApplication.RemoveSigningKey(ctx, ctx.Param("kid")); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
+
+// RevokeUserTokens godoc
+// @Summary RevokeUserTokens
+// @Tags Admin
+// @Description sign a user out of every device, access tokens already issued are rejected at once
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.RevokeUserTokensRequest true "revoke user tokens request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /admin/user/tokens/revoke [post]
+func (c *Controller) RevokeUserTokens(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	var request dto.RevokeUserTokensRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	if err := c.tokenApplication.RevokeUserTokens(ctx, request); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
@@ -69,3 +69,53 @@ func (c *Controller) CreateUserWithPassword(ctx *gin.Context) (*dto.UserInfo, *f
 
 	return userInfo, nil
 }
+
+// BanUser godoc
+// @Summary BanUser
+// @Tags Admin
+// @Description refuse a user every login and token refresh, it is signed out of every device at once
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.BanUserRequest true "ban user request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /admin/user/ban [post]
+func (c *Controller) BanUser(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	var request dto.BanUserRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	if err := c.userApplication.BanUser(ctx, request); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
+
+// UnbanUser godoc
+// @Summary UnbanUser
+// @Tags Admin
+// @Description let a banned user log in again
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.BanUserRequest true "unban user request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /admin/user/unban [post]
+func (c *Controller) UnbanUser(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	var request dto.BanUserRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	if err := c.userApplication.UnbanUser(ctx, request); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// ListBindings godoc
+// @Summary ListBindings
+// @Tags User
+// @Description List the login methods linked to the logged in user
+// @Accept  json
+// @Produce  json
+// @Success 200 {object}  facade.BaseResponse{data=dto.BindingsResponse}
+//
+// @Router /v1/user/bindings [get]
+func (c *Controller) ListBindings(ctx *gin.Context, userID string) (*dto.BindingsResponse, *facade.Error) {
+	return c.bindingApplication.ListBindings(ctx, userID)
+}
+
+// SendBindingVerifyCode godoc
+// @Summary SendBindingVerifyCode
+// @Tags User
+// @Description Send the code proving the ownership of a phone or email to link
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.SendBindingVerifyCodeRequest true "send binding verify code request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+// @Failure 429 {object}  facade.BaseResponse{data=dto.RateLimitedResponse}
+//
+// @Router /v1/user/bindings/verify_code [post]
+func (c *Controller) SendBindingVerifyCode(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	var request dto.SendBindingVerifyCodeRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.bindingApplication.SendVerifyCode(ctx, userID, request)
+}
+
+// LinkBinding godoc
+// @Summary LinkBinding
+// @Tags User
+// @Description Link a login method to the logged in user, an identity bound to another account is refused
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.LinkBindingRequest true "link binding request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.BindingsResponse}
+//
+// @Router /v1/user/bindings [post]
+func (c *Controller) LinkBinding(ctx *gin.Context, userID string) (*dto.BindingsResponse, *facade.Error) {
+	var request dto.LinkBindingRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.bindingApplication.LinkBinding(ctx, userID, request)
+}
+
+// UnlinkBinding godoc
+// @Summary UnlinkBinding
+// @Tags User
+// @Description Unlink a login method of the logged in user, the last one can not be removed
+// @Accept  json
+// @Produce  json
+// @Param  type path string true "binding type"
+// @Success 200 {object}  facade.BaseResponse{data=dto.BindingsResponse}
+//
+// @Router /v1/user/bindings/{type} [delete]
+func (c *Controller) UnlinkBinding(ctx *gin.Context, userID string) (*dto.BindingsResponse, *facade.Error) {
+	return c.bindingApplication.UnlinkBinding(ctx, userID, ctx.Param("type"))
+}
@@ -24,6 +24,11 @@ type Controller struct {
 	bindingApplication                 *application.BindingApplication
 	paymentApplication                 *application.PaymentApplication
 	organizationApplicationApplication *application.OrganizationApplicationApplication
+	oauthApplication                   *application.OAuthApplication
+	passkeyApplication                 *application.PasskeyApplication
+	passwordApplication                *application.PasswordApplication
+	deviceApplication                  *application.DeviceApplication
+	samlApplication                    *application.SAMLApplication
 	logger                             logger.ILogger
 }
 
@@ -36,6 +41,11 @@ func NewController(
 	bindingApplication *application.BindingApplication,
 	paymentApplication *application.PaymentApplication,
 	organizationApplicationApplication *application.OrganizationApplicationApplication,
+	oauthApplication *application.OAuthApplication,
+	passkeyApplication *application.PasskeyApplication,
+	passwordApplication *application.PasswordApplication,
+	deviceApplication *application.DeviceApplication,
+	samlApplication *application.SAMLApplication,
 	logger logger.ILogger,
 ) (*Controller, error) {
 	return &Controller{
@@ -47,6 +57,11 @@ func NewController(
 		bindingApplication:                 bindingApplication,
 		paymentApplication:                 paymentApplication,
 		organizationApplicationApplication: organizationApplicationApplication,
+		oauthApplication:                   oauthApplication,
+		passkeyApplication:                 passkeyApplication,
+		passwordApplication:                passwordApplication,
+		deviceApplication:                  deviceApplication,
+		samlApplication:                    samlApplication,
 		logger:                             logger,
 	}, nil
 }
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// currentDevice the device of the access token, set by the auth middleware
+func currentDevice(ctx *gin.Context) dto.Device {
+	return dto.Device{
+		DeviceType: ctx.GetString("device_type"),
+		DeviceID:   ctx.GetString("device_id"),
+	}
+}
+
+// ListDevices godoc
+// @Summary ListDevices
+// @Tags User
+// @Description List the active sessions of the logged in user, one per device
+// @Accept  json
+// @Produce  json
+// @Success 200 {object}  facade.BaseResponse{data=dto.DeviceSessionsResponse}
+//
+// @Router /v1/user/devices [get]
+func (c *Controller) ListDevices(ctx *gin.Context, userID string) (*dto.DeviceSessionsResponse, *facade.Error) {
+	return c.deviceApplication.ListDevices(ctx, userID, currentDevice(ctx))
+}
+
+// RevokeDevice godoc
+// @Summary RevokeDevice
+// @Tags User
+// @Description Sign out one session of the logged in user
+// @Accept  json
+// @Produce  json
+// @Param  id path string true "device session id"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /v1/user/devices/{id} [delete]
+func (c *Controller) RevokeDevice(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	if err := c.deviceApplication.RevokeDevice(ctx, userID, ctx.Param("id")); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
+
+// SignOutOtherDevices godoc
+// @Summary SignOutOtherDevices
+// @Tags User
+// @Description Sign out every session of the logged in user but the current one
+// @Accept  json
+// @Produce  json
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /v1/user/devices/sign_out_others [post]
+func (c *Controller) SignOutOtherDevices(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	if err := c.deviceApplication.RevokeOtherDevices(ctx, userID, currentDevice(ctx)); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package api
+
+import "html/template"
+
+// emailLinkPage the landing page of the link in the email. It names the device that asked for the
+// login, only the form posted from it confirms the login.
+var emailLinkPage = template.Must(template.New("email_link").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="referrer" content="no-referrer">
+<title>Confirm sign in</title>
+</head>
+<body>
+{{if eq .Status "pending"}}
+<h1>Confirm sign in</h1>
+<p>A sign in to {{.Email}} was requested from this device:</p>
+<ul>
+<li>Device: {{.DeviceType}}</li>
+<li>IP address: {{.ClientIP}}</li>
+<li>Browser: {{.UserAgent}}</li>
+<li>Requested at: {{.CreatedAt.Format "2006-01-02 15:04:05 MST"}}</li>
+</ul>
+<p>Only confirm if you made this request yourself, the device above will be signed in.</p>
+<form method="post" action="">
+<input type="hidden" name="token" value="{{.Token}}">
+<button type="submit">Confirm sign in</button>
+</form>
+{{else if eq .Status "used"}}
+<p>This link was already used.</p>
+{{else if eq .Status "expired"}}
+<p>This link has expired, request a new one.</p>
+{{else}}
+<p>This link is invalid.</p>
+{{end}}
+</body>
+</html>
+`))
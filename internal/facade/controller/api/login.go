@@ -3,8 +3,10 @@ package api
 import (
 	"encoding/json"
 	"kiwi-user/internal/facade/dto"
+	"net/http"
 
 	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/gin/utils"
 	"github.com/gin-gonic/gin"
 )
 
@@ -68,6 +70,30 @@ func (c *Controller) WechatMiniProgramLogin(ctx *gin.Context) (*dto.LoginRespons
 	return response, nil
 }
 
+// QyWechatLogin godoc
+// @Summary QyWechatLogin
+// @Tags Login
+// @Description login with the code of a WeCom corp, members of a corp mapped to an organization join it
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.QyWechatLoginRequest true "qy wechat request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.LoginResponse}
+//
+// @Router /v1/login/qywechat [post]
+func (c *Controller) QyWechatLogin(ctx *gin.Context) (*dto.LoginResponse, *facade.Error) {
+	var request dto.QyWechatLoginRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	response, err := c.loginApplication.QyWechatLogin(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 // PasswordLogin godoc
 // @Summary PasswordLogin
 // @Tags Login
@@ -84,7 +110,7 @@ func (c *Controller) PasswordLogin(ctx *gin.Context) (*dto.LoginResponse, *facad
 		return nil, facade.ErrBadRequest.Wrap(err)
 	}
 
-	response, err := c.loginApplication.PasswordLogin(ctx, request)
+	response, err := c.loginApplication.PasswordLogin(ctx, request, ctx.ClientIP())
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +149,7 @@ func (c *Controller) OrganizationLogin(ctx *gin.Context) (*dto.LoginResponse, *f
 // @Produce  json
 // @Param  request body dto.SendVerifyCodeRequest true "send sms verify code request"
 // @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+// @Failure 429 {object}  facade.BaseResponse{data=dto.RateLimitedResponse}
 //
 // @Router /v1/login/phone/verify_code [post]
 func (c *Controller) SendPhoneVerifyCode(ctx *gin.Context) (*dto.OperationResponse, *facade.Error) {
@@ -132,7 +159,7 @@ func (c *Controller) SendPhoneVerifyCode(ctx *gin.Context) (*dto.OperationRespon
 		return nil, facade.ErrBadRequest.Wrap(err)
 	}
 
-	if err := c.loginApplication.SendPhoneVerifyCode(ctx, request.Phone); err != nil {
+	if err := c.loginApplication.SendPhoneVerifyCode(ctx, request); err != nil {
 		c.logger.Errorf(ctx, "SendPhoneVerifyCode Api Error %v", err)
 		return nil, err
 	}
@@ -174,6 +201,7 @@ func (c *Controller) PhoneLogin(ctx *gin.Context) (*dto.LoginResponse, *facade.E
 // @Produce  json
 // @Param  request body dto.SendEmailVerificationCodeRequest true "send email verification code request"
 // @Success 200 {object}  facade.BaseResponse{data=dto.SendEmailVerificationCodeResponse}
+// @Failure 429 {object}  facade.BaseResponse{data=dto.RateLimitedResponse}
 // @Router /v1/login/email/verify_code [post]
 func (c *Controller) SendEmailVerificationCode(ctx *gin.Context) (*dto.SendEmailVerificationCodeResponse, *facade.Error) {
 	var request dto.SendEmailVerificationCodeRequest
@@ -222,6 +250,235 @@ func (c *Controller) EmailLogin(ctx *gin.Context) (*dto.LoginResponse, *facade.E
 	return response, nil
 }
 
+// SendEmailLink godoc
+// @Summary SendEmailLink
+// @Tags Login
+// @Description email a single use login link bound to the device, then poll /v1/login/email/link/poll
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.EmailLinkRequest true "email link request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.EmailLinkResponse}
+// @Failure 429 {object}  facade.BaseResponse{data=dto.RateLimitedResponse}
+//
+// @Router /v1/login/email/link [post]
+func (c *Controller) SendEmailLink(ctx *gin.Context) (*dto.EmailLinkResponse, *facade.Error) {
+	var request dto.EmailLinkRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.loginApplication.SendEmailLink(ctx, request)
+}
+
+// ShowEmailLink godoc
+// @Summary ShowEmailLink
+// @Tags Login
+// @Description the link in the email, a page naming the requesting device with a form confirming the login
+// @Param  token query string true "link token"
+// @Produce  html
+// @Success 200
+//
+// @Router /v1/login/email/link/confirm [get]
+func (c *Controller) ShowEmailLink(ctx *gin.Context) {
+	details, err := c.loginApplication.ShowEmailLink(ctx, ctx.Query("token"))
+	if err != nil {
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("X-Frame-Options", "DENY")
+	ctx.Status(http.StatusOK)
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	if err := emailLinkPage.Execute(ctx.Writer, details); err != nil {
+		c.logger.Errorf(ctx, "render email link page failed: %w", err)
+	}
+}
+
+// ConfirmEmailLink godoc
+// @Summary ConfirmEmailLink
+// @Tags Login
+// @Description posted from the page of the link, confirms the login and redirects to the landing page with status and link_id
+// @Accept  x-www-form-urlencoded
+// @Param  token formData string true "link token"
+// @Success 303
+//
+// @Router /v1/login/email/link/confirm [post]
+func (c *Controller) ConfirmEmailLink(ctx *gin.Context) {
+	location, err := c.loginApplication.ConfirmEmailLink(ctx, ctx.PostForm("token"))
+	if err != nil {
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	ctx.Redirect(http.StatusSeeOther, location)
+}
+
+// PollEmailLink godoc
+// @Summary PollEmailLink
+// @Tags Login
+// @Description pending until the link is opened, then the login result for the requesting device
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.EmailLinkPollRequest true "email link poll request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.EmailLinkPollResponse}
+//
+// @Router /v1/login/email/link/poll [post]
+func (c *Controller) PollEmailLink(ctx *gin.Context) (*dto.EmailLinkPollResponse, *facade.Error) {
+	var request dto.EmailLinkPollRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.loginApplication.PollEmailLink(ctx, request)
+}
+
+// GuestLogin godoc
+// @Summary GuestLogin
+// @Tags Login
+// @Description log the device in as an anonymous guest, the first login returns the guest_secret later logins send. Linking a phone, email or wechat binding upgrades the guest
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.GuestLoginRequest true "guest login request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.LoginResponse}
+//
+// @Router /v1/login/guest [post]
+func (c *Controller) GuestLogin(ctx *gin.Context) (*dto.LoginResponse, *facade.Error) {
+	var request dto.GuestLoginRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.loginApplication.GuestLogin(ctx, request)
+}
+
+// CreateQRLogin godoc
+// @Summary CreateQRLogin
+// @Tags Login
+// @Description a qr code for the signed in app to scan, then poll /v1/login/qr/poll or stream /v1/login/qr/stream
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.QRLoginRequest true "qr login request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.QRLoginResponse}
+//
+// @Router /v1/login/qr [post]
+func (c *Controller) CreateQRLogin(ctx *gin.Context) (*dto.QRLoginResponse, *facade.Error) {
+	var request dto.QRLoginRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.loginApplication.CreateQRLogin(ctx, request)
+}
+
+// PollQRLogin godoc
+// @Summary PollQRLogin
+// @Tags Login
+// @Description pending, scanned, rejected, or approved with the login result for the browser
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.QRLoginPollRequest true "qr login poll request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.QRLoginPollResponse}
+//
+// @Router /v1/login/qr/poll [post]
+func (c *Controller) PollQRLogin(ctx *gin.Context) (*dto.QRLoginPollResponse, *facade.Error) {
+	var request dto.QRLoginPollRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.loginApplication.PollQRLogin(ctx, request)
+}
+
+// StreamQRLogin godoc
+// @Summary StreamQRLogin
+// @Tags Login
+// @Description server sent events of the qr login, a status event with dto.QRLoginPollResponse on every change
+// @Produce  text/event-stream
+// @Param  application_name query string true "application name"
+// @Param  login_id query string true "login id"
+// @Param  poll_token query string true "poll token"
+// @Param  device_type query string true "device type"
+// @Param  device_id query string true "device id"
+// @Success 200
+//
+// @Router /v1/login/qr/stream [get]
+func (c *Controller) StreamQRLogin(ctx *gin.Context) {
+	request := dto.QRLoginPollRequest{
+		ApplicationName: ctx.Query("application_name"),
+		LoginID:         ctx.Query("login_id"),
+		PollToken:       ctx.Query("poll_token"),
+		Device: &dto.Device{
+			DeviceType: ctx.Query("device_type"),
+			DeviceID:   ctx.Query("device_id"),
+		},
+	}
+
+	if request.ApplicationName == "" ||
+		request.LoginID == "" ||
+		request.PollToken == "" ||
+		request.Device.DeviceType == "" ||
+		request.Device.DeviceID == "" {
+		utils.ResponseError(ctx, facade.ErrBadRequest.Facade("application_name, login_id, poll_token and device are required"))
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-cache")
+	// keep proxies from buffering the events
+	ctx.Header("X-Accel-Buffering", "no")
+
+	if err := c.loginApplication.StreamQRLogin(ctx, request, func(response *dto.QRLoginPollResponse) {
+		ctx.SSEvent("status", response)
+		ctx.Writer.Flush()
+	}); err != nil {
+		utils.ResponseError(ctx, err)
+	}
+}
+
+// ScanQRLogin godoc
+// @Summary ScanQRLogin
+// @Tags Login
+// @Description the signed in app scanned a qr code, returns the browser asking to log in
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.QRLoginScanRequest true "qr login scan request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.QRLoginScanResponse}
+//
+// @Router /v1/user/qr_login/scan [post]
+func (c *Controller) ScanQRLogin(ctx *gin.Context, userID string) (*dto.QRLoginScanResponse, *facade.Error) {
+	var request dto.QRLoginScanRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.loginApplication.ScanQRLogin(ctx, userID, request)
+}
+
+// ConfirmQRLogin godoc
+// @Summary ConfirmQRLogin
+// @Tags Login
+// @Description approve or reject the login of a scanned qr code
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.QRLoginConfirmRequest true "qr login confirm request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /v1/user/qr_login/confirm [post]
+func (c *Controller) ConfirmQRLogin(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	var request dto.QRLoginConfirmRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	if err := c.loginApplication.ConfirmQRLogin(ctx, userID, request); err != nil {
+		return nil, err
+	}
+
+	return &dto.OperationResponse{
+		Success: true,
+	}, nil
+}
+
 // GoogleWebLogin godoc
 // @Summary GoogleWebLogin
 // @Tags Login
@@ -246,3 +503,110 @@ func (c *Controller) GoogleWebLogin(ctx *gin.Context) (*dto.LoginResponse, *faca
 
 	return response, nil
 }
+
+// AppleLogin godoc
+// @Summary AppleLogin
+// @Tags Login
+// @Description Sign in with Apple, web logins send the authorization code, the iOS app the identity token
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.AppleLoginRequest true "apple login request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.LoginResponse}
+//
+// @Router /v1/login/apple [post]
+func (c *Controller) AppleLogin(ctx *gin.Context) (*dto.LoginResponse, *facade.Error) {
+	var request dto.AppleLoginRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	response, err := c.loginApplication.AppleLogin(ctx, request)
+	if err != nil {
+		c.logger.Errorf(ctx, "AppleLogin error: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ListIdentityProviders godoc
+// @Summary ListIdentityProviders
+// @Tags Login
+// @Description OpenID Connect providers users of the application can log in with
+// @Accept  json
+// @Produce  json
+// @Param  application query string true "application name"
+// @Success 200 {object}  facade.BaseResponse{data=dto.IdentityProvidersResponse}
+//
+// @Router /v1/login/oidc/providers [get]
+func (c *Controller) ListIdentityProviders(ctx *gin.Context) (*dto.IdentityProvidersResponse, *facade.Error) {
+	return c.loginApplication.ListIdentityProviders(ctx, ctx.Query("application"))
+}
+
+// OIDCAuthorize godoc
+// @Summary OIDCAuthorize
+// @Tags Login
+// @Description start a login at an OpenID Connect provider, redirect the browser to authorization_url
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.OIDCAuthorizeRequest true "oidc authorize request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OIDCAuthorizeResponse}
+//
+// @Router /v1/login/oidc/authorize [post]
+func (c *Controller) OIDCAuthorize(ctx *gin.Context) (*dto.OIDCAuthorizeResponse, *facade.Error) {
+	var request dto.OIDCAuthorizeRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.loginApplication.OIDCAuthorize(ctx, request)
+}
+
+// OIDCLogin godoc
+// @Summary OIDCLogin
+// @Tags Login
+// @Description complete a login at an OpenID Connect provider with the code and state it redirected back with
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.OIDCLoginRequest true "oidc login request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.LoginResponse}
+//
+// @Router /v1/login/oidc [post]
+func (c *Controller) OIDCLogin(ctx *gin.Context) (*dto.LoginResponse, *facade.Error) {
+	var request dto.OIDCLoginRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	response, err := c.loginApplication.OIDCLogin(ctx, request)
+	if err != nil {
+		c.logger.Errorf(ctx, "OIDCLogin error: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// MFALogin godoc
+// @Summary MFALogin
+// @Tags Login
+// @Description Complete a login that returned mfa_required with a second factor code
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.MFALoginRequest true "mfa login request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.LoginResponse}
+//
+// @Router /v1/login/mfa [post]
+func (c *Controller) MFALogin(ctx *gin.Context) (*dto.LoginResponse, *facade.Error) {
+	var request dto.MFALoginRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	response, err := c.loginApplication.MFALogin(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// EnrollTOTP godoc
+// @Summary EnrollTOTP
+// @Tags User
+// @Description Generate a TOTP secret, mfa is enabled after it is confirmed with a code
+// @Accept  json
+// @Produce  json
+// @Success 200 {object}  facade.BaseResponse{data=dto.TOTPEnrollResponse}
+//
+// @Router /v1/user/mfa/totp [post]
+func (c *Controller) EnrollTOTP(ctx *gin.Context, userID string) (*dto.TOTPEnrollResponse, *facade.Error) {
+	return c.userApplication.EnrollTOTP(ctx, userID)
+}
+
+// ConfirmTOTP godoc
+// @Summary ConfirmTOTP
+// @Tags User
+// @Description Confirm the enrolled TOTP secret with a code and enable mfa
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.TOTPCodeRequest true "totp code request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /v1/user/mfa/totp/confirm [post]
+func (c *Controller) ConfirmTOTP(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	var request dto.TOTPCodeRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.userApplication.ConfirmTOTP(ctx, userID, request)
+}
+
+// DisableTOTP godoc
+// @Summary DisableTOTP
+// @Tags User
+// @Description Disable TOTP mfa, requires a valid code
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.TOTPCodeRequest true "totp code request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /v1/user/mfa/totp/disable [post]
+func (c *Controller) DisableTOTP(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	var request dto.TOTPCodeRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.userApplication.DisableTOTP(ctx, userID, request)
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"kiwi-user/internal/facade/dto"
+	"net/http"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/gin/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// OpenIDConfiguration godoc
+// @Summary OpenIDConfiguration
+// @Tags OAuth
+// @Description OpenID Connect discovery document
+// @Produce  json
+// @Success 200 {object}  dto.OpenIDConfiguration
+//
+// @Router /.well-known/openid-configuration [get]
+func (c *Controller) OpenIDConfiguration(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, c.oauthApplication.GetOpenIDConfiguration(ctx))
+}
+
+// JWKS godoc
+// @Summary JWKS
+// @Tags OAuth
+// @Description public keys used to verify access tokens and id tokens
+// @Produce  json
+// @Success 200 {object}  dto.JWKSResponse
+//
+// @Router /.well-known/jwks.json [get]
+func (c *Controller) JWKS(ctx *gin.Context) {
+	response, err := c.oauthApplication.GetJWKS(ctx)
+	if err != nil {
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// OAuthAuthorize godoc
+// @Summary OAuthAuthorize
+// @Tags OAuth
+// @Description authorization endpoint, authorization code flow with PKCE. Shows the consent page when the user has not allowed the client the scopes yet
+// @Param  request query dto.OAuthAuthorizeRequest true "authorize request"
+// @Success 200
+// @Success 302
+//
+// @Router /oauth/authorize [get]
+func (c *Controller) OAuthAuthorize(ctx *gin.Context) {
+	var request dto.OAuthAuthorizeRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		utils.ResponseError(ctx, facade.ErrBadRequest.Wrap(err))
+		return
+	}
+
+	result, err := c.oauthApplication.Authorize(ctx, request, ctx.GetString("user_id"), ctx.Request.URL.RequestURI())
+	if err != nil {
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	if result.Consent == nil {
+		ctx.Redirect(http.StatusFound, result.Location)
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("X-Frame-Options", "DENY")
+	ctx.Status(http.StatusOK)
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	if err := oauthConsentPage.Execute(ctx.Writer, result.Consent); err != nil {
+		c.logger.Errorf(ctx, "render oauth consent page failed: %w", err)
+	}
+}
+
+// OAuthConsent godoc
+// @Summary OAuthConsent
+// @Tags OAuth
+// @Description posted from the consent page, redirects to the client with the code or access_denied
+// @Accept  x-www-form-urlencoded
+// @Param  request formData dto.OAuthConsentRequest true "consent request"
+// @Success 303
+//
+// @Router /oauth/authorize [post]
+func (c *Controller) OAuthConsent(ctx *gin.Context) {
+	var request dto.OAuthConsentRequest
+	if err := ctx.ShouldBind(&request); err != nil {
+		utils.ResponseError(ctx, facade.ErrBadRequest.Wrap(err))
+		return
+	}
+
+	location, err := c.oauthApplication.Consent(ctx, request, ctx.GetString("user_id"))
+	if err != nil {
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	ctx.Redirect(http.StatusSeeOther, location)
+}
+
+// OAuthToken godoc
+// @Summary OAuthToken
+// @Tags OAuth
+// @Description token endpoint, supports authorization_code and refresh_token grants
+// @Accept  x-www-form-urlencoded
+// @Produce  json
+// @Param  request formData dto.OAuthTokenRequest true "token request"
+// @Success 200 {object}  dto.OAuthTokenResponse
+// @Failure 400 {object}  dto.OAuthErrorResponse
+//
+// @Router /oauth/token [post]
+func (c *Controller) OAuthToken(ctx *gin.Context) {
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Pragma", "no-cache")
+
+	var request dto.OAuthTokenRequest
+	if err := ctx.ShouldBind(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, &dto.OAuthErrorResponse{
+			Error:            "invalid_request",
+			ErrorDescription: err.Error(),
+		})
+		return
+	}
+
+	// client_secret_basic
+	if clientID, clientSecret, ok := ctx.Request.BasicAuth(); ok {
+		request.ClientID = clientID
+		request.ClientSecret = clientSecret
+	}
+
+	response, oerr := c.oauthApplication.Token(ctx, request)
+	if oerr != nil {
+		if oerr.Status == http.StatusUnauthorized {
+			ctx.Header("WWW-Authenticate", "Basic")
+		}
+		ctx.JSON(oerr.Status, oerr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// OAuthUserInfo godoc
+// @Summary OAuthUserInfo
+// @Tags OAuth
+// @Description OpenID Connect userinfo endpoint, takes access tokens issued to oauth clients
+// @Produce  json
+// @Security ApiKeyAuth
+// @Success 200 {object}  dto.OAuthUserInfoResponse
+//
+// @Router /oauth/userinfo [get]
+func (c *Controller) OAuthUserInfo(ctx *gin.Context) {
+	response, err := c.oauthApplication.UserInfo(ctx, ctx.GetString("user_id"), ctx.GetString("oauth_scope"))
+	if err != nil {
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,35 @@
+package api
+
+import "html/template"
+
+// oauthConsentPage asks the signed-in user to allow a client the requested scopes, only the form
+// posted from it issues the authorization code
+var oauthConsentPage = template.Must(template.New("oauth_consent").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="referrer" content="no-referrer">
+<title>Allow access</title>
+</head>
+<body>
+<h1>Allow {{.ClientID}} access to your account?</h1>
+<p>{{.ClientID}} asks for:</p>
+<ul>
+{{range .Scopes}}
+{{if eq . "openid"}}<li>Your user id</li>
+{{else if eq . "profile"}}<li>Your name and picture</li>
+{{else if eq . "email"}}<li>Your email address</li>
+{{else if eq . "phone"}}<li>Your phone number</li>
+{{else}}<li>{{.}}</li>
+{{end}}
+{{end}}
+</ul>
+<form method="post" action="">
+<input type="hidden" name="consent_token" value="{{.ConsentToken}}">
+<button type="submit" name="decision" value="allow">Allow</button>
+<button type="submit" name="decision" value="deny">Deny</button>
+</form>
+</body>
+</html>
+`))
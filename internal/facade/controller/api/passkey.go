@@ -0,0 +1,105 @@
+package api
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// BeginPasskeyRegistration godoc
+// @Summary BeginPasskeyRegistration
+// @Tags User
+// @Description Start registering a passkey for the logged in user, returns PublicKeyCredentialCreationOptions
+// @Accept  json
+// @Produce  json
+// @Success 200 {object}  facade.BaseResponse{data=dto.PasskeyCreationOptions}
+//
+// @Router /v1/user/passkey/register/begin [post]
+func (c *Controller) BeginPasskeyRegistration(ctx *gin.Context, userID string) (*dto.PasskeyCreationOptions, *facade.Error) {
+	return c.passkeyApplication.BeginRegistration(ctx, userID)
+}
+
+// FinishPasskeyRegistration godoc
+// @Summary FinishPasskeyRegistration
+// @Tags User
+// @Description Verify the credential created by navigator.credentials.create and store it
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.PasskeyRegisterFinishRequest true "passkey register finish request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.PasskeyInfo}
+//
+// @Router /v1/user/passkey/register/finish [post]
+func (c *Controller) FinishPasskeyRegistration(ctx *gin.Context, userID string) (*dto.PasskeyInfo, *facade.Error) {
+	var request dto.PasskeyRegisterFinishRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.passkeyApplication.FinishRegistration(ctx, userID, request)
+}
+
+// ListPasskeys godoc
+// @Summary ListPasskeys
+// @Tags User
+// @Description List the passkeys of the logged in user
+// @Accept  json
+// @Produce  json
+// @Success 200 {object}  facade.BaseResponse{data=[]dto.PasskeyInfo}
+//
+// @Router /v1/user/passkeys [get]
+func (c *Controller) ListPasskeys(ctx *gin.Context, userID string) ([]*dto.PasskeyInfo, *facade.Error) {
+	return c.passkeyApplication.ListPasskeys(ctx, userID)
+}
+
+// DeletePasskey godoc
+// @Summary DeletePasskey
+// @Tags User
+// @Description Delete a passkey of the logged in user
+// @Accept  json
+// @Produce  json
+// @Param  id path string true "passkey id"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /v1/user/passkeys/{id} [delete]
+func (c *Controller) DeletePasskey(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
+	return c.passkeyApplication.DeletePasskey(ctx, userID, ctx.Param("id"))
+}
+
+// BeginPasskeyLogin godoc
+// @Summary BeginPasskeyLogin
+// @Tags Login
+// @Description Start a passkey login, returns PublicKeyCredentialRequestOptions
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.PasskeyLoginBeginRequest true "passkey login begin request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.PasskeyRequestOptions}
+//
+// @Router /v1/login/passkey/begin [post]
+func (c *Controller) BeginPasskeyLogin(ctx *gin.Context) (*dto.PasskeyRequestOptions, *facade.Error) {
+	var request dto.PasskeyLoginBeginRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.passkeyApplication.BeginLogin(ctx, request)
+}
+
+// FinishPasskeyLogin godoc
+// @Summary FinishPasskeyLogin
+// @Tags Login
+// @Description Verify the assertion returned by navigator.credentials.get and log the user in
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.PasskeyLoginFinishRequest true "passkey login finish request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.LoginResponse}
+//
+// @Router /v1/login/passkey/finish [post]
+func (c *Controller) FinishPasskeyLogin(ctx *gin.Context) (*dto.LoginResponse, *facade.Error) {
+	var request dto.PasskeyLoginFinishRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.passkeyApplication.FinishLogin(ctx, request)
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"kiwi-user/internal/facade/dto"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/gin-gonic/gin"
+)
+
+// ForgotPassword godoc
+// @Summary ForgotPassword
+// @Tags Password
+// @Description Send a password reset code to a verified email or phone binding
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.ForgotPasswordRequest true "forgot password request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+// @Failure 429 {object}  facade.BaseResponse{data=dto.RateLimitedResponse}
+//
+// @Router /v1/password/forgot [post]
+func (c *Controller) ForgotPassword(ctx *gin.Context) (*dto.OperationResponse, *facade.Error) {
+	var request dto.ForgotPasswordRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.passwordApplication.ForgotPassword(ctx, request)
+}
+
+// VerifyPasswordResetCode godoc
+// @Summary VerifyPasswordResetCode
+// @Tags Password
+// @Description Exchange the password reset code for a single-use reset token
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.VerifyPasswordResetCodeRequest true "verify password reset code request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.PasswordResetTokenResponse}
+//
+// @Router /v1/password/forgot/verify [post]
+func (c *Controller) VerifyPasswordResetCode(ctx *gin.Context) (*dto.PasswordResetTokenResponse, *facade.Error) {
+	var request dto.VerifyPasswordResetCodeRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.passwordApplication.VerifyPasswordResetCode(ctx, request)
+}
+
+// ResetPassword godoc
+// @Summary ResetPassword
+// @Tags Password
+// @Description Set a new password with a reset token, every refresh token of the user is revoked
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.ResetPasswordRequest true "reset password request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.OperationResponse}
+//
+// @Router /v1/password/reset [post]
+func (c *Controller) ResetPassword(ctx *gin.Context) (*dto.OperationResponse, *facade.Error) {
+	var request dto.ResetPasswordRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.passwordApplication.ResetPassword(ctx, request)
+}
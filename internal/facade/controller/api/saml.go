@@ -0,0 +1,99 @@
+package api
+
+import (
+	"kiwi-user/internal/facade/dto"
+	"net/http"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/gin/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// SAMLMetadata godoc
+// @Summary SAMLMetadata
+// @Tags SAML
+// @Description service provider metadata of the organization, to register at its identity provider
+// @Produce  xml
+// @Param  organization_id path string true "organization id"
+// @Success 200
+//
+// @Router /saml/{organization_id}/metadata [get]
+func (c *Controller) SAMLMetadata(ctx *gin.Context) {
+	metadata, err := c.samlApplication.Metadata(ctx, ctx.Param("organization_id"))
+	if err != nil {
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	ctx.Data(http.StatusOK, "application/samlmetadata+xml", metadata)
+}
+
+// SAMLLoginRedirect godoc
+// @Summary SAMLLoginRedirect
+// @Tags SAML
+// @Description start a login at the identity provider of the organization
+// @Param  organization_id path string true "organization id"
+// @Success 302
+//
+// @Router /saml/{organization_id}/login [get]
+func (c *Controller) SAMLLoginRedirect(ctx *gin.Context) {
+	location, err := c.samlApplication.Login(ctx, ctx.Param("organization_id"))
+	if err != nil {
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	ctx.Redirect(http.StatusFound, location)
+}
+
+// SAMLACS godoc
+// @Summary SAMLACS
+// @Tags SAML
+// @Description assertion consumer service, redirects to the redirect_url of the connection with a ticket for /v1/login/saml
+// @Accept  x-www-form-urlencoded
+// @Param  organization_id path string true "organization id"
+// @Param  request formData dto.SAMLACSRequest true "saml response"
+// @Success 302
+//
+// @Router /saml/{organization_id}/acs [post]
+func (c *Controller) SAMLACS(ctx *gin.Context) {
+	var request dto.SAMLACSRequest
+	if err := ctx.ShouldBind(&request); err != nil {
+		utils.ResponseError(ctx, facade.ErrBadRequest.Wrap(err))
+		return
+	}
+
+	location, err := c.samlApplication.ACS(ctx, ctx.Param("organization_id"), request)
+	if err != nil {
+		c.logger.Errorf(ctx, "SAMLACS error: %v", err)
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	ctx.Redirect(http.StatusFound, location)
+}
+
+// SAMLLogin godoc
+// @Summary SAMLLogin
+// @Tags Login
+// @Description exchange the ticket of the assertion consumer service for tokens of the organization
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.SAMLLoginRequest true "saml login request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.LoginResponse}
+//
+// @Router /v1/login/saml [post]
+func (c *Controller) SAMLLogin(ctx *gin.Context) (*dto.LoginResponse, *facade.Error) {
+	var request dto.SAMLLoginRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	response, err := c.loginApplication.SAMLLogin(ctx, request)
+	if err != nil {
+		c.logger.Errorf(ctx, "SAMLLogin error: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -99,6 +99,8 @@ func (c *Controller) Logout(ctx *gin.Context, userID string) (*dto.OperationResp
 		return nil, facade.ErrBadRequest.Wrap(err)
 	}
 	request.UserID = userID
+	request.AccessTokenID = ctx.GetString("jti")
+	request.AccessTokenExpire = ctx.GetInt64("token_exp")
 
 	if err := c.tokenApplication.Logout(ctx, request); err != nil {
 		return nil, err
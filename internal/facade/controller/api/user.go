@@ -81,7 +81,7 @@ func (c *Controller) UpdateUserInfo(ctx *gin.Context, userID string) (*dto.UserI
 // ChangePassword godoc
 // @Summary ChangePassword
 // @Tags User
-// @Description Change user password
+// @Description Change user password, every other device is signed out
 // @Accept  json
 // @Produce  json
 // @Param  request body dto.ChangePasswordRequest true "change password request"
@@ -94,23 +94,7 @@ func (c *Controller) ChangePassword(ctx *gin.Context, userID string) (*dto.Opera
 		return nil, facade.ErrBadRequest.Wrap(err)
 	}
 
-	return c.userApplication.ChangePassword(ctx, userID, request)
-}
-
-// VerifyPhoneCode godoc
-func (c *Controller) VerifyPhoneCode(ctx *gin.Context, userID string) (*dto.OperationResponse, *facade.Error) {
-	var request dto.VerifyPhoneCodeRequest
-	if err := ctx.BindJSON(&request); err != nil {
-		return nil, facade.ErrBadRequest.Wrap(err)
-	}
-
-	if err := c.bindingApplication.VerifyPhoneCode(ctx, userID, &request); err != nil {
-		return nil, err
-	}
-
-	return &dto.OperationResponse{
-		Success: true,
-	}, nil
+	return c.userApplication.ChangePassword(ctx, userID, currentDevice(ctx), request)
 }
 
 // BindingPhoneWithMiniProgramCode BindingPhone godoc
@@ -129,7 +113,11 @@ func (c *Controller) BindingPhoneWithMiniProgramCode(ctx *gin.Context, userID st
 		return nil, facade.ErrBadRequest.Wrap(err)
 	}
 
-	if err := c.userApplication.BindingPhone(ctx, userID, request.Phone, request.MiniProgramCode); err != nil {
+	if _, err := c.bindingApplication.LinkBinding(ctx, userID, dto.LinkBindingRequest{
+		Type:     "phone",
+		Code:     request.MiniProgramCode,
+		Platform: "miniprogram",
+	}); err != nil {
 		return nil, err
 	}
 
@@ -154,7 +142,11 @@ func (c *Controller) BindingPhoneWithVerifyCode(ctx *gin.Context, userID string)
 		return nil, facade.ErrBadRequest.Wrap(err)
 	}
 
-	if err := c.userApplication.BindingPhoneWithVerifyCode(ctx, userID, request.Phone, request.VerifyCode); err != nil {
+	if _, err := c.bindingApplication.LinkBinding(ctx, userID, dto.LinkBindingRequest{
+		Type:     "phone",
+		Identity: request.Phone,
+		Code:     request.VerifyCode,
+	}); err != nil {
 		return nil, err
 	}
 
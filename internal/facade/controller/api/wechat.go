@@ -0,0 +1,112 @@
+package api
+
+import (
+	"io"
+	"kiwi-user/internal/facade/dto"
+	"kiwi-user/internal/infrastructure/wechat"
+	"net/http"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/gin/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// WechatScanLogin godoc
+// @Summary WechatScanLogin
+// @Tags Login
+// @Description create a parametric qr code of the official account, scanning it follows the account and logs the browser in
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.WechatScanLoginRequest true "wechat scan login request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.WechatScanLoginResponse}
+//
+// @Router /v1/login/wechat/officalaccount [post]
+func (c *Controller) WechatScanLogin(ctx *gin.Context) (*dto.WechatScanLoginResponse, *facade.Error) {
+	var request dto.WechatScanLoginRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.loginApplication.CreateWechatScanLogin(ctx, request)
+}
+
+// PollWechatScanLogin godoc
+// @Summary PollWechatScanLogin
+// @Tags Login
+// @Description pending until the qr code is scanned, then the login result for the requesting device
+// @Accept  json
+// @Produce  json
+// @Param  request body dto.WechatScanLoginPollRequest true "wechat scan login poll request"
+// @Success 200 {object}  facade.BaseResponse{data=dto.WechatScanLoginPollResponse}
+//
+// @Router /v1/login/wechat/officalaccount/poll [post]
+func (c *Controller) PollWechatScanLogin(ctx *gin.Context) (*dto.WechatScanLoginPollResponse, *facade.Error) {
+	var request dto.WechatScanLoginPollRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		return nil, facade.ErrBadRequest.Wrap(err)
+	}
+
+	return c.loginApplication.PollWechatScanLogin(ctx, request)
+}
+
+// VerifyWechatCallback godoc
+// @Summary VerifyWechatCallback
+// @Tags Wechat
+// @Description url verification of the server config of the official account, answers echostr
+// @Produce  plain
+// @Param  signature query string true "signature"
+// @Param  timestamp query string true "timestamp"
+// @Param  nonce query string true "nonce"
+// @Param  echostr query string true "echo string"
+// @Success 200
+//
+// @Router /v1/wechat/officalaccount/callback [get]
+func (c *Controller) VerifyWechatCallback(ctx *gin.Context) {
+	echo, err := c.loginApplication.VerifyWechatCallback(ctx, wechatCallbackQuery(ctx))
+	if err != nil {
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	ctx.String(http.StatusOK, echo)
+}
+
+// WechatCallback godoc
+// @Summary WechatCallback
+// @Tags Wechat
+// @Description messages and events pushed to the official account, scans of login qr codes complete the login
+// @Accept  xml
+// @Produce  plain
+// @Param  signature query string true "signature"
+// @Param  timestamp query string true "timestamp"
+// @Param  nonce query string true "nonce"
+// @Success 200
+//
+// @Router /v1/wechat/officalaccount/callback [post]
+func (c *Controller) WechatCallback(ctx *gin.Context) {
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		utils.ResponseError(ctx, facade.ErrBadRequest.Wrap(err))
+		return
+	}
+
+	if err := c.loginApplication.WechatCallback(ctx, wechatCallbackQuery(ctx), body); err != nil {
+		c.logger.Errorf(ctx, "WechatCallback error: %v", err)
+		utils.ResponseError(ctx, err)
+		return
+	}
+
+	// wechat stops retrying on success and sends nothing to the user
+	ctx.String(http.StatusOK, "success")
+}
+
+func wechatCallbackQuery(ctx *gin.Context) wechat.CallbackQuery {
+	return wechat.CallbackQuery{
+		Signature:    ctx.Query("signature"),
+		Timestamp:    ctx.Query("timestamp"),
+		Nonce:        ctx.Query("nonce"),
+		EchoStr:      ctx.Query("echostr"),
+		EncryptType:  ctx.Query("encrypt_type"),
+		MsgSignature: ctx.Query("msg_signature"),
+	}
+}
@@ -1,10 +1,43 @@
 package dto
 
-type SendPhoneVerifyCodeRequest struct {
-	Phone string `json:"phone"`
+type BindingInfo struct {
+	Type     string `json:"type"`
+	Identity string `json:"identity,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Verified bool   `json:"verified"`
 }
 
-type VerifyPhoneCodeRequest struct {
-	Phone string `json:"phone"`
-	Code  string `json:"code"`
+type BindingsResponse struct {
+	Bindings []*BindingInfo `json:"bindings"`
+}
+
+// SendBindingVerifyCodeRequest type is phone or email
+type SendBindingVerifyCodeRequest struct {
+	Type               string `json:"type" binding:"required,oneof=phone email"`
+	Identity           string `json:"identity" binding:"required"`
+	CaptchaVerifyParam string `json:"captcha_verify_param"`
+}
+
+// LinkBindingRequest the proof depends on the type
+//
+//	phone     identity + code sent by verify_code, or code of the mini program phone button with platform miniprogram
+//	email     identity + code sent by verify_code
+//	wechat    authorization code, platform web, officalaccount or miniprogram
+//	qy_wechat authorization code
+//	google    authorization code + redirect_uri
+//	apple     authorization code + redirect_uri, or identity_token of the iOS app
+//	password  password, only when the user has none
+type LinkBindingRequest struct {
+	Type        string `json:"type" binding:"required"`
+	Identity    string `json:"identity"`
+	Code        string `json:"code"`
+	Platform    string `json:"platform"`
+	RedirectURI string `json:"redirect_uri"`
+	Password    string `json:"password"`
+	// IdentityToken the Sign in with Apple identity token of native clients
+	IdentityToken string `json:"identity_token"`
+	// Nonce sent with the Sign in with Apple request of this link, required to link apple
+	Nonce string `json:"nonce"`
+	// CorpID the corp issuing a qy wechat code, empty for the global corp of the config
+	CorpID string `json:"corp_id"`
 }
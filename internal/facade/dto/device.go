@@ -0,0 +1,19 @@
+package dto
+
+type DeviceSession struct {
+	ID              int64  `json:"id"`
+	DeviceType      string `json:"device_type"`
+	DeviceID        string `json:"device_id"`
+	OrganizationID  string `json:"organization_id,omitempty"`
+	LastIP          string `json:"last_ip"`
+	UserAgent       string `json:"user_agent"`
+	CreatedAt       int64  `json:"created_at"`
+	LastRefreshedAt int64  `json:"last_refreshed_at,omitempty"`
+	ExpiresAt       int64  `json:"expires_at"`
+	// Current the session the request was made with
+	Current bool `json:"current"`
+}
+
+type DeviceSessionsResponse struct {
+	Devices []*DeviceSession `json:"devices"`
+}
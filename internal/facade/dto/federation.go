@@ -0,0 +1,78 @@
+package dto
+
+// IdentityProviderInfo a provider users of the application can log in with
+type IdentityProviderInfo struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+type IdentityProvidersResponse struct {
+	Providers []*IdentityProviderInfo `json:"providers"`
+}
+
+type OIDCAuthorizeRequest struct {
+	ApplicationName string `json:"application_name" binding:"required"`
+	Provider        string `json:"provider" binding:"required"`
+	// RedirectURI the callback the provider sends the code to, must be registered at the provider
+	RedirectURI string `json:"redirect_uri" binding:"required"`
+}
+
+type OIDCAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+	// StateVerifier kept by the browser until the provider redirects back, never put in a url
+	StateVerifier string `json:"state_verifier"`
+}
+
+// OIDCLoginRequest code and state the provider appended to the redirect_uri, with the verifier
+// the browser kept from OIDCAuthorize
+type OIDCLoginRequest struct {
+	ApplicationName string           `json:"application_name" binding:"required"`
+	Code            string           `json:"code" binding:"required"`
+	State           string           `json:"state" binding:"required"`
+	StateVerifier   string           `json:"state_verifier" binding:"required"`
+	ReferralChannel *ReferralChannel `json:"referral_channel"`
+	Device          *Device          `json:"device" binding:"required"`
+}
+
+// IdentityProvider admin view of a provider, the client secret is write only
+type IdentityProvider struct {
+	ID                    string            `json:"id"`
+	Application           string            `json:"application"`
+	Name                  string            `json:"name"`
+	DisplayName           string            `json:"display_name"`
+	Issuer                string            `json:"issuer"`
+	ClientID              string            `json:"client_id"`
+	HasClientSecret       bool              `json:"has_client_secret"`
+	Scopes                []string          `json:"scopes"`
+	AuthorizationEndpoint string            `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint         string            `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint      string            `json:"userinfo_endpoint,omitempty"`
+	JWKSURI               string            `json:"jwks_uri,omitempty"`
+	ClaimMapping          map[string]string `json:"claim_mapping,omitempty"`
+	Enabled               bool              `json:"enabled"`
+	CreatedAt             int64             `json:"created_at"`
+	UpdatedAt             int64             `json:"updated_at"`
+}
+
+type IdentityProvidersAdminResponse struct {
+	Providers []*IdentityProvider `json:"providers"`
+}
+
+// IdentityProviderRequest endpoints left empty are discovered from the issuer,
+// claim_mapping keys are subject, email, email_verified, name and picture
+type IdentityProviderRequest struct {
+	ApplicationName       string            `json:"application_name" binding:"required"`
+	Name                  string            `json:"name"`
+	DisplayName           string            `json:"display_name"`
+	Issuer                string            `json:"issuer" binding:"required"`
+	ClientID              string            `json:"client_id" binding:"required"`
+	ClientSecret          string            `json:"client_secret"`
+	Scopes                []string          `json:"scopes"`
+	AuthorizationEndpoint string            `json:"authorization_endpoint"`
+	TokenEndpoint         string            `json:"token_endpoint"`
+	UserinfoEndpoint      string            `json:"userinfo_endpoint"`
+	JWKSURI               string            `json:"jwks_uri"`
+	ClaimMapping          map[string]string `json:"claim_mapping"`
+	Enabled               *bool             `json:"enabled"`
+}
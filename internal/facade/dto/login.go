@@ -3,6 +3,7 @@ package dto
 import (
 	"kiwi-user/internal/domain/model/enum"
 	"strings"
+	"time"
 )
 
 type WechatWebLoginRequest struct {
@@ -24,6 +25,7 @@ type WechatMiniProgramLoginRequest struct {
 type QyWechatLoginRequest struct {
 	ApplicationName string           `json:"application_name" binding:"required"`
 	Code            string           `json:"code" binding:"required"`
+	CorpID          string           `json:"corp_id"` // 非必填，发放 code 的企业微信 corp，为空时使用配置中的企业
 	ReferralChannel *ReferralChannel `json:"referral_channel"`
 	Device          *Device          `json:"device" binding:"required"`
 }
@@ -33,6 +35,8 @@ type PasswordLoginRequest struct {
 	Name            string  `json:"name" binding:"required"`
 	Password        string  `json:"password" binding:"required"`
 	Device          *Device `json:"device" binding:"required"`
+	// CaptchaVerifyParam of the solved captcha, required when the captcha policy of the application asks for one
+	CaptchaVerifyParam string `json:"captcha_verify_param"`
 }
 
 type OrganizationLoginRequest struct {
@@ -62,6 +66,18 @@ type LoginResponse struct {
 	DeviceType            string `json:"device_type"`
 	DeviceID              string `json:"device_id"`
 	UserID                string `json:"user_id"`
+	// MFARequired is set instead of the tokens when the user has a second factor enabled,
+	// exchange MFAToken with a code at /v1/login/mfa
+	MFARequired       bool   `json:"mfa_required,omitempty"`
+	MFAToken          string `json:"mfa_token,omitempty"`
+	MFATokenExpiresAt int64  `json:"mfa_token_expires_at,omitempty"`
+	// GuestSecret is returned once, by the guest login creating the guest
+	GuestSecret string `json:"guest_secret,omitempty"`
+}
+
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
 }
 
 type PhoneLoginRequest struct {
@@ -72,14 +88,12 @@ type PhoneLoginRequest struct {
 }
 
 type SendEmailVerificationCodeRequest struct {
-	Email    string                     `json:"email" binding:"required,email"`
+	Email string `json:"email" binding:"required,email"`
+	// CodeType login or password_reset (reset_password), binding codes are sent by the binding api
 	CodeType enum.VertificationCodeType `json:"code_type" binding:"required"`
-}
-
-type SendEmailVerifyCodeWithCaptchaRequest struct {
-	Email              string                     `json:"email" binding:"required,email"`
-	CodeType           enum.VertificationCodeType `json:"code_type" binding:"required"`
-	CaptchaVerifyParam string                     `json:"captcha_verify_param" binding:"required"`
+	// ApplicationName optional, codes of an application count against its limit
+	ApplicationName    string `json:"application_name"`
+	CaptchaVerifyParam string `json:"captcha_verify_param"`
 }
 
 type EmailLoginRequest struct {
@@ -89,6 +103,152 @@ type EmailLoginRequest struct {
 	Device          *Device `json:"device" binding:"required"`
 }
 
+// EmailLinkRequest sends a login link instead of a code, the link is bound to Device
+type EmailLinkRequest struct {
+	ApplicationName    string  `json:"application_name" binding:"required"`
+	Email              string  `json:"email" binding:"required,email"`
+	Device             *Device `json:"device" binding:"required"`
+	CaptchaVerifyParam string  `json:"captcha_verify_param"`
+}
+
+// EmailLinkResponse poll_token completes the login at /v1/login/email/link/poll once the link was opened
+type EmailLinkResponse struct {
+	LinkID    string `json:"link_id"`
+	PollToken string `json:"poll_token"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+type EmailLinkPollRequest struct {
+	ApplicationName string  `json:"application_name" binding:"required"`
+	LinkID          string  `json:"link_id" binding:"required"`
+	PollToken       string  `json:"poll_token" binding:"required"`
+	Device          *Device `json:"device" binding:"required"`
+}
+
+const (
+	EmailLinkStatusPending   = "pending"
+	EmailLinkStatusConfirmed = "confirmed"
+	EmailLinkStatusInvalid   = "invalid"
+	EmailLinkStatusExpired   = "expired"
+	EmailLinkStatusUsed      = "used"
+)
+
+// EmailLinkDetails the landing page of the link, Status is pending while it can be confirmed and
+// the requesting device is shown
+type EmailLinkDetails struct {
+	Status     string
+	Token      string
+	Email      string
+	DeviceType string
+	ClientIP   string
+	UserAgent  string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+}
+
+// EmailLinkPollResponse Status is pending until the link is opened, then confirmed with Login set
+type EmailLinkPollResponse struct {
+	Status string         `json:"status"`
+	Login  *LoginResponse `json:"login,omitempty"`
+}
+
+// GuestLoginRequest logs Device in as an anonymous guest. The first login leaves GuestSecret empty
+// and gets a new guest with its secret, later logins of the guest send the secret.
+type GuestLoginRequest struct {
+	ApplicationName string  `json:"application_name" binding:"required"`
+	Device          *Device `json:"device" binding:"required"`
+	GuestSecret     string  `json:"guest_secret"`
+}
+
+// QRLoginRequest the browser asking for a qr code, the login is issued to Device
+type QRLoginRequest struct {
+	ApplicationName string  `json:"application_name" binding:"required"`
+	Device          *Device `json:"device" binding:"required"`
+}
+
+// QRLoginResponse QRPayload is rendered as qr code, poll_token completes the login at /v1/login/qr/poll
+type QRLoginResponse struct {
+	LoginID   string `json:"login_id"`
+	QRPayload string `json:"qr_payload"`
+	PollToken string `json:"poll_token"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+type QRLoginPollRequest struct {
+	ApplicationName string  `json:"application_name" binding:"required"`
+	LoginID         string  `json:"login_id" binding:"required"`
+	PollToken       string  `json:"poll_token" binding:"required"`
+	Device          *Device `json:"device" binding:"required"`
+}
+
+const (
+	QRLoginStatusPending  = "pending"
+	QRLoginStatusScanned  = "scanned"
+	QRLoginStatusApproved = "approved"
+	QRLoginStatusRejected = "rejected"
+	// QRLoginStatusExpired only sent on the event stream, polling an expired login fails
+	QRLoginStatusExpired = "expired"
+)
+
+// QRLoginPollResponse Status moves from pending to scanned to approved with Login set, or rejected
+type QRLoginPollResponse struct {
+	Status string         `json:"status"`
+	Login  *LoginResponse `json:"login,omitempty"`
+}
+
+// QRLoginScanRequest LoginID is read from the scanned qr code
+type QRLoginScanRequest struct {
+	LoginID string `json:"login_id" binding:"required"`
+}
+
+// QRLoginScanResponse the browser asking to log in, shown to the user before approving
+type QRLoginScanResponse struct {
+	LoginID   string `json:"login_id"`
+	ClientIP  string `json:"client_ip"`
+	UserAgent string `json:"user_agent"`
+	CreatedAt int64  `json:"created_at"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+type QRLoginConfirmRequest struct {
+	LoginID string `json:"login_id" binding:"required"`
+	Approve bool   `json:"approve"`
+}
+
+// WechatScanLoginRequest the browser asking for the qr code of the official account, the login is issued to Device
+type WechatScanLoginRequest struct {
+	ApplicationName string  `json:"application_name" binding:"required"`
+	Device          *Device `json:"device" binding:"required"`
+}
+
+// WechatScanLoginResponse qr_url is rendered as qr code or qr_image_url shown as is,
+// poll_token completes the login at /v1/login/wechat/officalaccount/poll
+type WechatScanLoginResponse struct {
+	LoginID    string `json:"login_id"`
+	QRURL      string `json:"qr_url"`
+	QRImageURL string `json:"qr_image_url"`
+	PollToken  string `json:"poll_token"`
+	ExpiresAt  int64  `json:"expires_at"`
+}
+
+type WechatScanLoginPollRequest struct {
+	ApplicationName string  `json:"application_name" binding:"required"`
+	LoginID         string  `json:"login_id" binding:"required"`
+	PollToken       string  `json:"poll_token" binding:"required"`
+	Device          *Device `json:"device" binding:"required"`
+}
+
+const (
+	WechatScanLoginStatusPending   = "pending"
+	WechatScanLoginStatusConfirmed = "confirmed"
+)
+
+// WechatScanLoginPollResponse Status is pending until the code is scanned, then confirmed with Login set
+type WechatScanLoginPollResponse struct {
+	Status string         `json:"status"`
+	Login  *LoginResponse `json:"login,omitempty"`
+}
+
 type GoogleWebLoginRequest struct {
 	ApplicationName string           `json:"application_name" binding:"required"`
 	Code            string           `json:"code" binding:"required"`
@@ -97,6 +257,19 @@ type GoogleWebLoginRequest struct {
 	Device          *Device          `json:"device" binding:"required"`
 }
 
+// AppleLoginRequest web logins send code and redirect_uri, the iOS app sends identity_token.
+// Apple only returns the name of the user on the first sign in, the client forwards it as name.
+type AppleLoginRequest struct {
+	ApplicationName string           `json:"application_name" binding:"required"`
+	Code            string           `json:"code" binding:"required_without=IdentityToken"`
+	RedirectURI     string           `json:"redirect_uri" binding:"required_with=Code"`
+	IdentityToken   string           `json:"identity_token"`
+	Nonce           string           `json:"nonce"`
+	Name            string           `json:"name"`
+	ReferralChannel *ReferralChannel `json:"referral_channel"`
+	Device          *Device          `json:"device" binding:"required"`
+}
+
 type SendEmailVerificationCodeResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message,omitempty"`
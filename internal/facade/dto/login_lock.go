@@ -0,0 +1,15 @@
+package dto
+
+type LoginLock struct {
+	ID            string `json:"id"`
+	Application   string `json:"application"`
+	Scope         string `json:"scope"`
+	Subject       string `json:"subject"`
+	Failures      int    `json:"failures"`
+	LastFailureAt int64  `json:"last_failure_at"`
+	LockedUntil   int64  `json:"locked_until"`
+}
+
+type LoginLocksResponse struct {
+	Locks []*LoginLock `json:"locks"`
+}
@@ -0,0 +1,109 @@
+package dto
+
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+type JWKSResponse struct {
+	Keys []*JWK `json:"keys"`
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type OAuthAuthorizeRequest struct {
+	ResponseType        string `form:"response_type"`
+	ClientID            string `form:"client_id" binding:"required"`
+	RedirectURI         string `form:"redirect_uri" binding:"required"`
+	Scope               string `form:"scope"`
+	State               string `form:"state"`
+	Nonce               string `form:"nonce"`
+	Prompt              string `form:"prompt"`
+	CodeChallenge       string `form:"code_challenge"`
+	CodeChallengeMethod string `form:"code_challenge_method"`
+}
+
+// OAuthAuthorizeResult either the location the user agent is redirected to, or the consent the user gives first
+type OAuthAuthorizeResult struct {
+	Location string
+	Consent  *OAuthConsent
+}
+
+// OAuthConsent what the consent page shows, the consent token carries the authorization request
+type OAuthConsent struct {
+	ClientID     string
+	Scopes       []string
+	ConsentToken string
+}
+
+type OAuthConsentRequest struct {
+	ConsentToken string `form:"consent_token" binding:"required"`
+	// Decision allow or deny
+	Decision string `form:"decision" binding:"required"`
+}
+
+type OAuthTokenRequest struct {
+	GrantType    string `form:"grant_type" binding:"required"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// OAuthErrorResponse error body defined by RFC 6749 section 5.2
+type OAuthErrorResponse struct {
+	Status           int    `json:"-"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+type OAuthUserInfoResponse struct {
+	Subject           string `json:"sub"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Picture           string `json:"picture,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+	PhoneNumber       string `json:"phone_number,omitempty"`
+}
+
+type UpdateOAuthClientRequest struct {
+	ApplicationName  string   `json:"application_name" binding:"required"`
+	RedirectURIs     []string `json:"redirect_uris" binding:"required"`
+	RegenerateSecret bool     `json:"regenerate_secret"`
+}
+
+type UpdateOAuthClientResponse struct {
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	RedirectURIs []string `json:"redirect_uris"`
+}
@@ -0,0 +1,100 @@
+package dto
+
+// WebAuthn ceremony options and responses keep the camelCase member names of the WebAuthn JSON
+// serialization so they can be passed to PublicKeyCredential.parseCreationOptionsFromJSON /
+// parseRequestOptionsFromJSON and PublicKeyCredential.toJSON as is. Binary values are base64url.
+
+type PasskeyRelyingParty struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type PasskeyUser struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type PasskeyCredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+type PasskeyCredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+type PasskeyAuthenticatorSelection struct {
+	ResidentKey        string `json:"residentKey"`
+	RequireResidentKey bool   `json:"requireResidentKey"`
+	UserVerification   string `json:"userVerification"`
+}
+
+type PasskeyCreationOptions struct {
+	RP                     PasskeyRelyingParty           `json:"rp"`
+	User                   PasskeyUser                   `json:"user"`
+	Challenge              string                        `json:"challenge"`
+	PubKeyCredParams       []PasskeyCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                         `json:"timeout"`
+	ExcludeCredentials     []PasskeyCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection PasskeyAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                        `json:"attestation"`
+}
+
+type PasskeyRequestOptions struct {
+	Challenge        string                        `json:"challenge"`
+	Timeout          int64                         `json:"timeout"`
+	RPID             string                        `json:"rpId"`
+	AllowCredentials []PasskeyCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                        `json:"userVerification"`
+}
+
+type PasskeyAttestationResponse struct {
+	ClientDataJSON    string   `json:"clientDataJSON" binding:"required"`
+	AttestationObject string   `json:"attestationObject" binding:"required"`
+	Transports        []string `json:"transports"`
+}
+
+type PasskeyRegistrationCredential struct {
+	ID       string                     `json:"id" binding:"required"`
+	Type     string                     `json:"type" binding:"required"`
+	Response PasskeyAttestationResponse `json:"response" binding:"required"`
+}
+
+type PasskeyAssertionResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON" binding:"required"`
+	AuthenticatorData string `json:"authenticatorData" binding:"required"`
+	Signature         string `json:"signature" binding:"required"`
+	UserHandle        string `json:"userHandle"`
+}
+
+type PasskeyLoginCredential struct {
+	ID       string                   `json:"id" binding:"required"`
+	Type     string                   `json:"type" binding:"required"`
+	Response PasskeyAssertionResponse `json:"response" binding:"required"`
+}
+
+type PasskeyRegisterFinishRequest struct {
+	Name       string                        `json:"name"`
+	Credential PasskeyRegistrationCredential `json:"credential" binding:"required"`
+}
+
+type PasskeyLoginBeginRequest struct {
+	ApplicationName string `json:"application_name" binding:"required"`
+}
+
+type PasskeyLoginFinishRequest struct {
+	ApplicationName string                 `json:"application_name" binding:"required"`
+	Credential      PasskeyLoginCredential `json:"credential" binding:"required"`
+	Device          *Device                `json:"device" binding:"required"`
+}
+
+type PasskeyInfo struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Transports []string `json:"transports"`
+	CreatedAt  int64    `json:"created_at"`
+	LastUsedAt int64    `json:"last_used_at"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+// ForgotPasswordRequest one of email or phone is required
+type ForgotPasswordRequest struct {
+	ApplicationName    string `json:"application_name" binding:"required"`
+	Email              string `json:"email" binding:"omitempty,email"`
+	Phone              string `json:"phone"`
+	CaptchaVerifyParam string `json:"captcha_verify_param"`
+}
+
+type VerifyPasswordResetCodeRequest struct {
+	ApplicationName string `json:"application_name" binding:"required"`
+	Email           string `json:"email" binding:"omitempty,email"`
+	Phone           string `json:"phone"`
+	VerifyCode      string `json:"verify_code" binding:"required"`
+}
+
+type PasswordResetTokenResponse struct {
+	ResetToken          string `json:"reset_token"`
+	ResetTokenExpiresAt int64  `json:"reset_token_expires_at"`
+}
+
+type ResetPasswordRequest struct {
+	ResetToken  string `json:"reset_token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8"`
+}
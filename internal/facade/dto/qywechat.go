@@ -0,0 +1,20 @@
+package dto
+
+// QyWechatCorp admin view of the WeCom corp of an organization, the secret is never returned
+type QyWechatCorp struct {
+	ID             string `json:"id"`
+	OrganizationID string `json:"organization_id"`
+	CorpID         string `json:"corp_id"`
+	Enabled        bool   `json:"enabled"`
+	CreatedAt      int64  `json:"created_at"`
+	UpdatedAt      int64  `json:"updated_at"`
+}
+
+// QyWechatCorpRequest corp_secret is the secret of the self built app members log in with,
+// it can be left empty to keep the current one of the same corp
+type QyWechatCorpRequest struct {
+	OrganizationID string `json:"organization_id" binding:"required"`
+	CorpID         string `json:"corp_id" binding:"required"`
+	CorpSecret     string `json:"corp_secret"`
+	Enabled        *bool  `json:"enabled"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+// RateLimitedResponse data of a request rejected with 429, the wait is repeated in Retry-After
+type RateLimitedResponse struct {
+	RetryAfter int64 `json:"retry_after"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+// SAMLACSRequest the response the identity provider posts through the browser (HTTP-POST binding)
+type SAMLACSRequest struct {
+	SAMLResponse string `form:"SAMLResponse" binding:"required"`
+	RelayState   string `form:"RelayState" binding:"required"`
+}
+
+// SAMLLoginRequest ticket the assertion consumer service appended to the redirect_url of the connection
+type SAMLLoginRequest struct {
+	ApplicationName string  `json:"application_name" binding:"required"`
+	Ticket          string  `json:"ticket" binding:"required"`
+	Device          *Device `json:"device" binding:"required"`
+}
+
+// SAMLConnection admin view of the identity provider of an organization
+type SAMLConnection struct {
+	ID             string `json:"id"`
+	OrganizationID string `json:"organization_id"`
+	// EntityID and ACSURL the service provider values to register at the identity provider
+	EntityID         string            `json:"entity_id"`
+	ACSURL           string            `json:"acs_url"`
+	MetadataURL      string            `json:"metadata_url"`
+	LoginURL         string            `json:"login_url"`
+	IdPEntityID      string            `json:"idp_entity_id"`
+	IdPSSOURL        string            `json:"idp_sso_url"`
+	AttributeMapping map[string]string `json:"attribute_mapping,omitempty"`
+	RedirectURL      string            `json:"redirect_url"`
+	Enabled          bool              `json:"enabled"`
+	CreatedAt        int64             `json:"created_at"`
+	UpdatedAt        int64             `json:"updated_at"`
+}
+
+// SAMLConnectionRequest idp_metadata is the metadata xml of the identity provider,
+// attribute_mapping keys are name, email and department
+type SAMLConnectionRequest struct {
+	OrganizationID   string            `json:"organization_id" binding:"required"`
+	IdPMetadata      string            `json:"idp_metadata" binding:"required"`
+	AttributeMapping map[string]string `json:"attribute_mapping"`
+	RedirectURL      string            `json:"redirect_url" binding:"required"`
+	Enabled          *bool             `json:"enabled"`
+}
@@ -27,4 +27,24 @@ type LogoutRequest struct {
 	UserID       string  `json:"user_id"`
 	RefreshToken string  `json:"refresh_token" binding:"required"`
 	Device       *Device `json:"device" binding:"required"`
+	// the access token the request was authenticated with, revoked as well
+	AccessTokenID     string `json:"-"`
+	AccessTokenExpire int64  `json:"-"`
+}
+
+type RevokeUserTokensRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+}
+
+type SigningKey struct {
+	Kid         string `json:"kid"`
+	Status      string `json:"status"`
+	CreatedAt   int64  `json:"created_at"`
+	ActivatedAt int64  `json:"activated_at,omitempty"`
+	RetiredAt   int64  `json:"retired_at,omitempty"`
+	RemovableAt int64  `json:"removable_at,omitempty"`
+}
+
+type SigningKeysResponse struct {
+	Keys []*SigningKey `json:"keys"`
 }
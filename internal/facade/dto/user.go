@@ -22,6 +22,8 @@ type UserInfo struct {
 	CurrentOrgID   string              `json:"current_org_id"`
 	Orgs           []*OrganizationUser `json:"orgs"`
 	Department     string              `json:"department"`
+	// Guest an anonymous user, linking a phone, email or wechat upgrades it
+	Guest bool `json:"guest"`
 }
 
 type PublicUserInfo struct {
@@ -78,11 +80,23 @@ type ChangePasswordRequest struct {
 	NewPassword string `json:"new_password" binding:"required,min=8"`
 }
 
+type BanUserRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+}
+
 type SendVerifyCodeRequest struct {
 	Phone string `json:"phone"`
+	// ApplicationName optional, codes of an application count against its limit
+	ApplicationName string `json:"application_name"`
+	// CaptchaVerifyParam when the captcha policy of the application asks for one
+	CaptchaVerifyParam string `json:"captcha_verify_param"`
+}
+
+type TOTPEnrollResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURL string `json:"otpauth_url"`
 }
 
-type SendVerifyCodeWithCaptchaRequest struct {
-	Phone              string `json:"phone"`
-	CaptchaVerifyParam string `json:"captcha_verify_param" binding:"required"`
+type TOTPCodeRequest struct {
+	Code string `json:"code"`
 }
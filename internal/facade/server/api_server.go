@@ -32,6 +32,10 @@ func NewAPIServer(lc fx.Lifecycle,
 		return nil, err
 	}
 
+	if err := setTrustedProxies(engine, cfg.APIServer); err != nil {
+		return nil, err
+	}
+
 	srv := &http.Server{
 		Addr:    ":" + cfg.APIServer.Port,
 		Handler: engine,
@@ -61,3 +65,15 @@ func NewAPIServer(lc fx.Lifecycle,
 		Engine: engine,
 	}, nil
 }
+
+// setTrustedProxies only proxies of the config may set the client ip through X-Forwarded-For,
+// gin trusts every peer otherwise and any client could pick the ip its limits are counted on
+func setTrustedProxies(engine *gin.Engine, cfg *config.APIServerConfig) error {
+	if err := engine.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		return err
+	}
+
+	engine.TrustedPlatform = cfg.TrustedPlatform
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"kiwi-user/config"
+	"kiwi-user/internal/facade/server/middleware"
+	"kiwi-user/internal/infrastructure/ratelimit"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// recordingLimiter allows every hit and remembers its key
+type recordingLimiter struct {
+	keys []string
+}
+
+func (r *recordingLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (*ratelimit.Result, error) {
+	r.keys = append(r.keys, key)
+	return &ratelimit.Result{Allowed: true, Remaining: limit - 1}, nil
+}
+
+func TestTrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	if err := setTrustedProxies(engine, &config.APIServerConfig{TrustedProxies: []string{"10.0.0.0/8"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	limiter := &recordingLimiter{}
+	engine.GET("/limited", middleware.NewIPRateLimit("test", limiter, 10, time.Minute, nil), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		key        string
+	}{
+		{name: "spoofed by the client", remoteAddr: "203.0.113.7:40000", key: "test:ip:203.0.113.7"},
+		{name: "set by a trusted proxy", remoteAddr: "10.1.2.3:40000", key: "test:ip:198.51.100.9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter.keys = nil
+
+			request := httptest.NewRequest(http.MethodGet, "/limited", nil)
+			request.RemoteAddr = tt.remoteAddr
+			request.Header.Set("X-Forwarded-For", "198.51.100.9")
+
+			engine.ServeHTTP(httptest.NewRecorder(), request)
+
+			if len(limiter.keys) != 1 || limiter.keys[0] != tt.key {
+				t.Fatalf("rate limit keys %v, want %q", limiter.keys, tt.key)
+			}
+		})
+	}
+}
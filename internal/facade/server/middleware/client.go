@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"kiwi-user/internal/infrastructure/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewClientInfo records ip and user agent of the request, devices remember them on login and refresh
+func NewClientInfo() func(*gin.Context) {
+
+	return func(c *gin.Context) {
+		c.Set(utils.ClientContextKey, utils.NewClient(c.ClientIP(), c.Request.UserAgent()))
+
+		c.Next()
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"kiwi-user/internal/infrastructure/jwt"
+	"kiwi-user/internal/infrastructure/revocation"
 	"net/http"
 	"strings"
 	"time"
@@ -34,40 +35,135 @@ func getAccessToken(r *http.Request) (string, *facade.Error) {
 	return auth[1], nil
 }
 
-func NewKiwiUserAuth(application, role string, jwtHelper *jwt.JWTHelper) func(*gin.Context) {
+// verifyAccessToken the payload of a valid, unexpired and unrevoked access token
+func verifyAccessToken(c *gin.Context, jwtHelper *jwt.JWTHelper, revocationStore revocation.Store) (*jwt.AccessPayload, *facade.Error) {
+	token, ferr := getAccessToken(c.Request)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	jwtToken, err := jwtHelper.VerifyRS256JWT(token)
+	if xerror.Is(err, jwt.ErrInvalidJWTToken) {
+		return nil, facade.ErrUnauthorized
+	}
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	payload, err := jwtHelper.DecodeAccessPayload(jwtToken.Payload)
+	if err != nil {
+		return nil, facade.ErrUnauthorized.Wrap(err)
+	}
+
+	// id tokens share the signing key but must not be accepted as access tokens
+	if payload.Type != jwt.ACCESS {
+		return nil, facade.ErrUnauthorized
+	}
+
+	expire := time.Unix(payload.Expire, 0)
+	if expire.Before(time.Now()) {
+		return nil, facade.ErrUnauthorized
+	}
+
+	revoked, err := revocationStore.IsRevoked(c, payload.ID, payload.UserID, payload.DeviceType, payload.DeviceID, payload.IssuedAt())
+	if err != nil {
+		return nil, facade.ErrServerInternal.Wrap(err)
+	}
+
+	if revoked {
+		return nil, facade.ErrUnauthorized
+	}
+
+	return payload, nil
+}
+
+func NewKiwiUserAuth(application, role string, jwtHelper *jwt.JWTHelper, revocationStore revocation.Store) func(*gin.Context) {
 
 	return func(c *gin.Context) {
 
-		token, ferr := getAccessToken(c.Request)
+		payload, ferr := verifyAccessToken(c, jwtHelper, revocationStore)
 		if ferr != nil {
 			utils.ResponseError(c, ferr)
 			return
 		}
 
-		jwtToken, err := jwtHelper.VerifyRS256JWT(token)
-		if xerror.Is(err, jwt.ErrInvalidJWTToken) {
+		// tokens issued to oauth clients only reach the oauth endpoints
+		if payload.Audience != "" {
 			utils.ResponseError(c, facade.ErrUnauthorized)
 			return
 		}
 
-		payload, err := jwtHelper.DecodeAccessPayload(jwtToken.Payload)
-		if err != nil {
-			utils.ResponseError(c, facade.ErrUnauthorized.Wrap(err))
+		// check issuer and role
+		if application != "" && role != "'" {
+			if payload.Application != application || payload.PersonalRole != role {
+				utils.ResponseError(c, facade.ErrUnauthorized)
+				return
+			}
+		}
+
+		c.Set("user_id", payload.UserID)
+		c.Set("org_id", payload.OrganizationID)
+		// logout revokes the presented access token
+		c.Set("jti", payload.ID)
+		c.Set("token_exp", payload.Expire)
+		// the session list marks the current device
+		c.Set("device_type", payload.DeviceType)
+		c.Set("device_id", payload.DeviceID)
+
+		c.Next()
+	}
+}
+
+// NewOAuthUserAuth accepts the access tokens issued to oauth clients only, sets the scope granted to the client
+func NewOAuthUserAuth(jwtHelper *jwt.JWTHelper, revocationStore revocation.Store) func(*gin.Context) {
+
+	return func(c *gin.Context) {
+
+		payload, ferr := verifyAccessToken(c, jwtHelper, revocationStore)
+		if ferr != nil {
+			utils.ResponseError(c, ferr)
 			return
 		}
 
-		expire := time.Unix(payload.Expire, 0)
-		if expire.Before(time.Now()) {
+		if payload.Audience == "" {
 			utils.ResponseError(c, facade.ErrUnauthorized)
 			return
 		}
 
-		// check issuer and role
-		if application != "" && role != "'" {
-			if payload.Application != application || payload.PersonalRole != role {
-				utils.ResponseError(c, facade.ErrUnauthorized)
-				return
-			}
+		c.Set("user_id", payload.UserID)
+		c.Set("oauth_client_id", payload.Audience)
+		c.Set("oauth_scope", payload.OAuthScope)
+
+		c.Next()
+	}
+}
+
+// NewKiwiUserOptionalAuth sets user_id when a valid access token is present, anonymous requests pass through
+func NewKiwiUserOptionalAuth(jwtHelper *jwt.JWTHelper, revocationStore revocation.Store) func(*gin.Context) {
+
+	return func(c *gin.Context) {
+
+		token, ferr := getAccessToken(c.Request)
+		if ferr != nil || token == "" {
+			c.Next()
+			return
+		}
+
+		jwtToken, err := jwtHelper.VerifyRS256JWT(token)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		payload, err := jwtHelper.DecodeAccessPayload(jwtToken.Payload)
+		if err != nil || payload.Type != jwt.ACCESS || payload.Audience != "" || time.Unix(payload.Expire, 0).Before(time.Now()) {
+			c.Next()
+			return
+		}
+
+		if revoked, err := revocationStore.IsRevoked(c, payload.ID, payload.UserID, payload.DeviceType, payload.DeviceID, payload.IssuedAt()); err != nil || revoked {
+			c.Next()
+			return
 		}
 
 		c.Set("user_id", payload.UserID)
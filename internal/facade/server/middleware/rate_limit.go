@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"fmt"
+	"kiwi-user/internal/facade/dto"
+	"kiwi-user/internal/infrastructure/ratelimit"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/server/facade"
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// NewIPRateLimit limits the requests of a client ip to the routes of name, rejected requests get 429
+// with the seconds to wait in Retry-After. The limiter counts in process while redis fails, requests
+// are refused when even that fails.
+func NewIPRateLimit(name string, limiter ratelimit.Limiter, limit int, window time.Duration, logger logger.ILogger) func(*gin.Context) {
+
+	return func(c *gin.Context) {
+		if limit <= 0 || window <= 0 {
+			c.Next()
+			return
+		}
+
+		result, err := limiter.Allow(c, name+":ip:"+c.ClientIP(), limit, window)
+		if err != nil {
+			logger.Errorf(c, "rate limit %s failed: %v", name, err)
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, &facade.BaseResponse{
+				Status:  "error",
+				Message: "rate limit unavailable",
+			})
+			return
+		}
+
+		if !result.Allowed {
+			AbortRateLimited(c, result.RetryAfter)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// AbortRateLimited answers a request refused by a rate limit with 429 and the seconds to wait
+func AbortRateLimited(c *gin.Context, wait time.Duration) {
+	retryAfter := ratelimit.RetryAfterSeconds(wait)
+
+	c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, &facade.BaseResponse{
+		Status:  "error",
+		Message: fmt.Sprintf("too many requests, retry after %d seconds", retryAfter),
+		Data: &dto.RateLimitedResponse{
+			RetryAfter: retryAfter,
+		},
+	})
+}
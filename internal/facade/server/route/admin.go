@@ -13,7 +13,8 @@ func (route *Route) RegisterAdmin(gin *gin.Engine) {
 	adminAuth := middleware.NewKiwiUserAuth(
 		constants.AdminApplicationName,
 		constants.AdminPersonalRoleName,
-		route.jwtHepler)
+		route.jwtHepler,
+		route.revocationStore)
 
 	// admin apis
 	admin := gin.Group("/admin", adminAuth)
@@ -21,10 +22,27 @@ func (route *Route) RegisterAdmin(gin *gin.Engine) {
 		admin.GET("/rbac/application", RequireUserIDHandler(route.adminController.GetApplication))
 		admin.POST("/rbac/application", RequireUserIDHandler(route.adminController.CreateApplication))
 		admin.PUT("/rbac/application/default-role", RequireUserIDHandler(route.adminController.UpdateApplicationDefaultRole))
+		admin.PUT("/rbac/application/oauth", RequireUserIDHandler(route.adminController.UpdateOAuthClient))
 
 		admin.POST("/rbac/role", RequireUserIDHandler(route.adminController.CreateRole))
 		admin.POST("/rbac/scope", RequireUserIDHandler(route.adminController.CreateScope))
 
+		// jwt signing keys
+		admin.GET("/jwt/keys", RequireUserIDHandler(route.adminController.ListSigningKeys))
+		admin.POST("/jwt/keys", RequireUserIDHandler(route.adminController.StageSigningKey))
+		admin.PUT("/jwt/keys/:kid/activate", RequireUserIDHandler(route.adminController.ActivateSigningKey))
+		admin.DELETE("/jwt/keys/:kid", RequireUserIDHandler(route.adminController.RemoveSigningKey))
+
+		// login protection
+		admin.GET("/login/locks", RequireUserIDHandler(route.adminController.ListLoginLocks))
+		admin.DELETE("/login/locks/:id", RequireUserIDHandler(route.adminController.ClearLoginLock))
+
+		// openid connect identity providers
+		admin.GET("/identity_providers", RequireUserIDHandler(route.adminController.ListIdentityProviders))
+		admin.POST("/identity_providers", RequireUserIDHandler(route.adminController.CreateIdentityProvider))
+		admin.PUT("/identity_providers/:id", RequireUserIDHandler(route.adminController.UpdateIdentityProvider))
+		admin.DELETE("/identity_providers/:id", RequireUserIDHandler(route.adminController.DeleteIdentityProvider))
+
 		// organization
 		admin.POST("/organization", NormalHandler(route.adminController.CreateOrganization))
 		admin.PUT("/organization", NormalHandler(route.adminController.UpdateOrganization))
@@ -33,8 +51,21 @@ func (route *Route) RegisterAdmin(gin *gin.Engine) {
 		admin.DELETE("/organization/user", NormalHandler(route.adminController.DeleteOrganizationUser))
 		admin.GET("/organization/user/infos", NormalHandler(route.adminController.GetOrganizationUserInfos))
 
+		// saml identity providers of organizations
+		admin.GET("/organization/saml", RequireUserIDHandler(route.adminController.GetSAMLConnection))
+		admin.PUT("/organization/saml", RequireUserIDHandler(route.adminController.SaveSAMLConnection))
+		admin.DELETE("/organization/saml", RequireUserIDHandler(route.adminController.DeleteSAMLConnection))
+
+		// wecom corps of organizations
+		admin.GET("/organization/qywechat", RequireUserIDHandler(route.adminController.GetQyWechatCorp))
+		admin.PUT("/organization/qywechat", RequireUserIDHandler(route.adminController.SaveQyWechatCorp))
+		admin.DELETE("/organization/qywechat", RequireUserIDHandler(route.adminController.DeleteQyWechatCorp))
+
 		admin.POST("/user/role", NormalHandler(route.adminController.CreateUserRole))
 		admin.POST("/user/password", NormalHandler(route.adminController.CreateUserWithPassword))
+		admin.POST("/user/tokens/revoke", RequireUserIDHandler(route.adminController.RevokeUserTokens))
+		admin.POST("/user/ban", RequireUserIDHandler(route.adminController.BanUser))
+		admin.POST("/user/unban", RequireUserIDHandler(route.adminController.UnbanUser))
 
 		// organization application
 		admin.GET("/organization_application/infos", NormalHandler(route.adminController.PageOrganizationApplication))
@@ -1,36 +1,122 @@
 package route
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
 
 	"kiwi-user/internal/facade/server/middleware"
 )
 
+// ipRateLimit limits the routes of name per client ip, not at all when rate limits are disabled
+func (route *Route) ipRateLimit(name string, limit int, windowSecond int) func(*gin.Context) {
+	if !route.config.RateLimit.Enabled {
+		limit = 0
+	}
+
+	return middleware.NewIPRateLimit(name, route.limiter, limit, time.Duration(windowSecond)*time.Second, route.logger)
+}
+
 // RegisterApiV1 register route for api server
 func (route *Route) RegisterApiV1(gin *gin.Engine) {
 
 	userAuth := middleware.NewKiwiUserAuth(
 		"",
 		"",
-		route.jwtHepler)
+		route.jwtHepler,
+		route.revocationStore)
+
+	optionalUserAuth := middleware.NewKiwiUserOptionalAuth(route.jwtHepler, route.revocationStore)
+
+	oauthUserAuth := middleware.NewOAuthUserAuth(route.jwtHepler, route.revocationStore)
+
+	// login and password reset are limited per client ip, verification codes are limited further by the applications
+	rateLimit := route.config.RateLimit
+	loginRateLimit := route.ipRateLimit("login", rateLimit.LoginIPLimit, rateLimit.LoginIPWindowSecond)
+	// a second factor code is six digits, its guesses are limited tighter than other logins
+	mfaRateLimit := route.ipRateLimit("mfa", rateLimit.MFAIPLimit, rateLimit.MFAIPWindowSecond)
+	pollRateLimit := route.ipRateLimit("poll", rateLimit.PollIPLimit, rateLimit.PollIPWindowSecond)
+
+	// devices remember the client they logged in and refreshed from
+	gin.Use(middleware.NewClientInfo())
 
 	gin.GET("/ping", NormalHandler(route.apiController.Ping))
 
+	// openid connect provider, responses follow the oauth specs instead of facade.BaseResponse
+	wellKnown := gin.Group("/.well-known")
+	{
+		wellKnown.GET("/openid-configuration", route.apiController.OpenIDConfiguration)
+		wellKnown.GET("/jwks.json", route.apiController.JWKS)
+	}
+
+	oauth := gin.Group("/oauth")
+	{
+		oauth.GET("/authorize", optionalUserAuth, route.apiController.OAuthAuthorize)
+		oauth.POST("/authorize", optionalUserAuth, route.apiController.OAuthConsent)
+		oauth.POST("/token", route.apiController.OAuthToken)
+		oauth.GET("/userinfo", oauthUserAuth, route.apiController.OAuthUserInfo)
+		oauth.POST("/userinfo", oauthUserAuth, route.apiController.OAuthUserInfo)
+	}
+
+	// saml service provider of organizations, called by browsers and identity providers
+	samlSP := gin.Group("/saml/:organization_id")
+	{
+		samlSP.GET("/metadata", route.apiController.SAMLMetadata)
+		samlSP.GET("/login", route.apiController.SAMLLoginRedirect)
+		samlSP.POST("/acs", route.apiController.SAMLACS)
+	}
+
 	v1 := gin.Group("/v1")
 
-	login := v1.Group("/login")
+	login := v1.Group("/login", loginRateLimit)
 	{
 		login.POST("/wechat/miniprogram", NormalHandler(route.apiController.WechatMiniProgramLogin))
 		login.POST("/wechat/web", NormalHandler(route.apiController.WechatWebLogin))
+		login.POST("/qywechat", NormalHandler(route.apiController.QyWechatLogin))
+		login.POST("/wechat/officalaccount", NormalHandler(route.apiController.WechatScanLogin))
 		login.POST("/password", NormalHandler(route.apiController.PasswordLogin))
 		login.POST("/organization", NormalHandler(route.apiController.OrganizationLogin))
 		login.POST("/phone", NormalHandler(route.apiController.PhoneLogin))
 		login.POST("/phone/verify_code", NormalHandler(route.apiController.SendPhoneVerifyCode))
-		// login.POST("/phone/captcha/verify_code", NormalHandler(route.apiController.SendPhoneVerifyCodeWithCaptcha))
 		login.POST("/email", NormalHandler(route.apiController.EmailLogin))
 		login.POST("/email/verify_code", NormalHandler(route.apiController.SendEmailVerificationCode))
-		// login.POST("/email/captcha/verify_code", NormalHandler(route.apiController.SendEmailVerificationCodeWithCaptcha))
+		login.POST("/email/link", NormalHandler(route.apiController.SendEmailLink))
+		login.POST("/guest", NormalHandler(route.apiController.GuestLogin))
+		login.POST("/qr", NormalHandler(route.apiController.CreateQRLogin))
 		login.POST("/google/web", NormalHandler(route.apiController.GoogleWebLogin))
+		login.POST("/apple", NormalHandler(route.apiController.AppleLogin))
+		login.GET("/oidc/providers", NormalHandler(route.apiController.ListIdentityProviders))
+		login.POST("/oidc/authorize", NormalHandler(route.apiController.OIDCAuthorize))
+		login.POST("/oidc", NormalHandler(route.apiController.OIDCLogin))
+		login.POST("/saml", NormalHandler(route.apiController.SAMLLogin))
+		login.POST("/mfa", mfaRateLimit, NormalHandler(route.apiController.MFALogin))
+		login.POST("/passkey/begin", NormalHandler(route.apiController.BeginPasskeyLogin))
+		login.POST("/passkey/finish", NormalHandler(route.apiController.FinishPasskeyLogin))
+	}
+
+	// clients poll pending logins every few seconds, the polls have a limit of their own
+	loginPoll := v1.Group("/login", pollRateLimit)
+	{
+		loginPoll.POST("/wechat/officalaccount/poll", NormalHandler(route.apiController.PollWechatScanLogin))
+		loginPoll.GET("/email/link/confirm", route.apiController.ShowEmailLink)
+		loginPoll.POST("/email/link/confirm", route.apiController.ConfirmEmailLink)
+		loginPoll.POST("/email/link/poll", NormalHandler(route.apiController.PollEmailLink))
+		loginPoll.POST("/qr/poll", NormalHandler(route.apiController.PollQRLogin))
+		loginPoll.GET("/qr/stream", route.apiController.StreamQRLogin)
+	}
+
+	// server config of the wechat official account, called by wechat
+	wechat := v1.Group("/wechat")
+	{
+		wechat.GET("/officalaccount/callback", route.apiController.VerifyWechatCallback)
+		wechat.POST("/officalaccount/callback", route.apiController.WechatCallback)
+	}
+
+	password := v1.Group("/password", loginRateLimit)
+	{
+		password.POST("/forgot", NormalHandler(route.apiController.ForgotPassword))
+		password.POST("/forgot/verify", NormalHandler(route.apiController.VerifyPasswordResetCode))
+		password.POST("/reset", NormalHandler(route.apiController.ResetPassword))
 	}
 
 	token := v1.Group("/token")
@@ -44,13 +130,34 @@ func (route *Route) RegisterApiV1(gin *gin.Engine) {
 	{
 		user.GET("/info", userAuth, RequireUserIDHandler(route.apiController.GetUserInfo))
 		user.PUT("/info", userAuth, RequireUserIDHandler(route.apiController.UpdateUserInfo))
-		// user.POST("/password", userAuth, RequireUserIDHandler(route.apiController.ChangePassword))
-		// user.POST("/binding/phone", userAuth, RequireUserIDHandler(route.apiController.BindingPhoneWithMiniProgramCode))
-		// user.POST("/binding/phone/verify_code", userAuth, RequireUserIDHandler(route.apiController.BindingPhoneWithVerifyCode))
+		user.POST("/password", userAuth, loginRateLimit, RequireUserIDHandler(route.apiController.ChangePassword))
+		user.POST("/binding/phone", userAuth, RequireUserIDHandler(route.apiController.BindingPhoneWithMiniProgramCode))
+		user.POST("/binding/phone/verify_code", userAuth, RequireUserIDHandler(route.apiController.BindingPhoneWithVerifyCode))
+		// bindings
+		user.GET("/bindings", userAuth, RequireUserIDHandler(route.apiController.ListBindings))
+		user.POST("/bindings", userAuth, RequireUserIDHandler(route.apiController.LinkBinding))
+		user.POST("/bindings/verify_code", userAuth, RequireUserIDHandler(route.apiController.SendBindingVerifyCode))
+		user.DELETE("/bindings/:type", userAuth, RequireUserIDHandler(route.apiController.UnlinkBinding))
 		// organization application
 		user.GET("/organization_application/infos", userAuth, RequireUserIDHandler(route.apiController.GetOrganizationApplicationInfos))
 		user.POST("/organization_application/request", userAuth, RequireUserIDHandler(route.apiController.CreateOrganizationApplication))
 		user.POST("/logout", userAuth, RequireUserIDHandler(route.apiController.Logout))
+		// mfa
+		user.POST("/mfa/totp", userAuth, RequireUserIDHandler(route.apiController.EnrollTOTP))
+		user.POST("/mfa/totp/confirm", userAuth, RequireUserIDHandler(route.apiController.ConfirmTOTP))
+		user.POST("/mfa/totp/disable", userAuth, RequireUserIDHandler(route.apiController.DisableTOTP))
+		// passkey
+		user.POST("/passkey/register/begin", userAuth, RequireUserIDHandler(route.apiController.BeginPasskeyRegistration))
+		user.POST("/passkey/register/finish", userAuth, RequireUserIDHandler(route.apiController.FinishPasskeyRegistration))
+		user.GET("/passkeys", userAuth, RequireUserIDHandler(route.apiController.ListPasskeys))
+		user.DELETE("/passkeys/:id", userAuth, RequireUserIDHandler(route.apiController.DeletePasskey))
+		// sessions
+		user.GET("/devices", userAuth, RequireUserIDHandler(route.apiController.ListDevices))
+		user.DELETE("/devices/:id", userAuth, RequireUserIDHandler(route.apiController.RevokeDevice))
+		user.POST("/devices/sign_out_others", userAuth, RequireUserIDHandler(route.apiController.SignOutOtherDevices))
+		// qr login of a browser approved from the app
+		user.POST("/qr_login/scan", userAuth, RequireUserIDHandler(route.apiController.ScanQRLogin))
+		user.POST("/qr_login/confirm", userAuth, RequireUserIDHandler(route.apiController.ConfirmQRLogin))
 	}
 
 	payment := v1.Group("/payments")
@@ -2,6 +2,8 @@ package route
 
 import (
 	"fmt"
+	"kiwi-user/internal/facade/server/middleware"
+	"kiwi-user/internal/infrastructure/ratelimit"
 	"net/http"
 	"runtime/debug"
 
@@ -28,9 +30,12 @@ func RequireUserIDHandler[T any](f func(*gin.Context, string) (T, *facade.Error)
 			return
 		}
 
+		rejection := &ratelimit.Rejection{}
+		c.Set(ratelimit.RejectionContextKey, rejection)
+
 		data, err := f(c, userID.(string))
 		if err != nil {
-			utils.ResponseError(c, err)
+			responseError(c, rejection, err)
 			return
 		}
 
@@ -52,9 +57,12 @@ func NormalHandler[T any](f func(*gin.Context) (T, *facade.Error)) func(*gin.Con
 			}
 		}()
 
+		rejection := &ratelimit.Rejection{}
+		c.Set(ratelimit.RejectionContextKey, rejection)
+
 		data, err := f(c)
 		if err != nil {
-			utils.ResponseError(c, err)
+			responseError(c, rejection, err)
 			return
 		}
 
@@ -64,3 +72,13 @@ func NormalHandler[T any](f func(*gin.Context) (T, *facade.Error)) func(*gin.Con
 		})
 	}
 }
+
+// responseError answers a request refused by a rate limit with 429 and Retry-After
+func responseError(c *gin.Context, rejection *ratelimit.Rejection, err *facade.Error) {
+	if rejection.RetryAfter > 0 {
+		middleware.AbortRateLimited(c, rejection.RetryAfter)
+		return
+	}
+
+	utils.ResponseError(c, err)
+}
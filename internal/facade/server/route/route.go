@@ -5,6 +5,8 @@ import (
 	"kiwi-user/internal/facade/controller/admin"
 	"kiwi-user/internal/facade/controller/api"
 	"kiwi-user/internal/infrastructure/jwt"
+	"kiwi-user/internal/infrastructure/ratelimit"
+	"kiwi-user/internal/infrastructure/revocation"
 
 	"github.com/futurxlab/golanggraph/logger"
 )
@@ -15,6 +17,8 @@ type Route struct {
 	adminController *admin.Controller
 	logger          logger.ILogger
 	jwtHepler       *jwt.JWTHelper
+	revocationStore revocation.Store
+	limiter         ratelimit.Limiter
 }
 
 func NewRoute(
@@ -22,7 +26,9 @@ func NewRoute(
 	apiController *api.Controller,
 	adminController *admin.Controller,
 	logger logger.ILogger,
-	jwtHepler *jwt.JWTHelper) *Route {
+	jwtHepler *jwt.JWTHelper,
+	revocationStore revocation.Store,
+	limiter ratelimit.Limiter) *Route {
 
 	return &Route{
 		config:          config,
@@ -30,5 +36,7 @@ func NewRoute(
 		adminController: adminController,
 		logger:          logger,
 		jwtHepler:       jwtHepler,
+		revocationStore: revocationStore,
+		limiter:         limiter,
 	}
 }
@@ -0,0 +1,103 @@
+package aliyun
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// RPC style apis of Alibaba Cloud (https://help.aliyun.com/document_detail/315526.html): the action
+// and its parameters are posted as a form, signed with the access key secret.
+
+// CallRPC posts the action with the common parameters and the signature, returns the body and the
+// status code of the response
+func CallRPC(
+	ctx context.Context,
+	httpClient *xhttp.Client,
+	endpoint string,
+	accessKeyID string,
+	accessKeySecret string,
+	action string,
+	version string,
+	params url.Values,
+) ([]byte, int, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, 0, xerror.Wrap(err)
+	}
+
+	params.Set("Action", action)
+	params.Set("Version", version)
+	params.Set("Format", "JSON")
+	params.Set("AccessKeyId", accessKeyID)
+	params.Set("SignatureMethod", "HMAC-SHA1")
+	params.Set("SignatureVersion", "1.0")
+	params.Set("SignatureNonce", hex.EncodeToString(nonce))
+	params.Set("Timestamp", time.Now().UTC().Format("2006-01-02T15:04:05Z"))
+	params.Set("Signature", SignRPC(http.MethodPost, params, accessKeySecret))
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, 0, xerror.Wrap(err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := httpClient.Do(request)
+	if err != nil {
+		return nil, 0, xerror.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, xerror.Wrap(fmt.Errorf("%s response status %d: %w", action, resp.StatusCode, err))
+	}
+
+	return b, resp.StatusCode, nil
+}
+
+// SignRPC signature version 1.0: the sorted and percent encoded parameters are signed with
+// HMAC-SHA1 under the access key secret followed by "&"
+func SignRPC(method string, params url.Values, accessKeySecret string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if key != "Signature" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, PercentEncode(key)+"="+PercentEncode(params.Get(key)))
+	}
+
+	stringToSign := method + "&" + PercentEncode("/") + "&" + PercentEncode(strings.Join(pairs, "&"))
+
+	mac := hmac.New(sha1.New, []byte(accessKeySecret+"&"))
+	mac.Write([]byte(stringToSign))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// PercentEncode RFC 3986 encoding the signature is computed over
+func PercentEncode(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.ReplaceAll(s, "+", "%20")
+	s = strings.ReplaceAll(s, "*", "%2A")
+	s = strings.ReplaceAll(s, "%7E", "~")
+	return s
+}
@@ -0,0 +1,29 @@
+package aliyun
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestSignRPC(t *testing.T) {
+	// the example of the signature documentation of Alibaba Cloud
+	params := url.Values{}
+	params.Set("AccessKeyId", "testid")
+	params.Set("Action", "DescribeRegions")
+	params.Set("Format", "XML")
+	params.Set("SignatureMethod", "HMAC-SHA1")
+	params.Set("SignatureNonce", "3ee8c1b8-83d3-44af-a94f-4e0ad82fd6cf")
+	params.Set("SignatureVersion", "1.0")
+	params.Set("Timestamp", "2016-02-23T12:46:24Z")
+	params.Set("Version", "2014-05-26")
+
+	if signature := SignRPC("GET", params, "testsecret"); signature != "OLeaidS1JvxuMvnyHOwuJ+uX5qY=" {
+		t.Fatalf("unexpected signature %s", signature)
+	}
+}
+
+func TestPercentEncode(t *testing.T) {
+	if encoded := PercentEncode("a b*c~d/e"); encoded != "a%20b%2Ac~d%2Fe" {
+		t.Fatalf("unexpected encoding %s", encoded)
+	}
+}
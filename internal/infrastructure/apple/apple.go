@@ -0,0 +1,360 @@
+package apple
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"kiwi-user/config"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// Sign in with Apple (https://developer.apple.com/documentation/sign_in_with_apple).
+// Web logins exchange the authorization code for an identity token, the iOS app sends the identity
+// token it got from AuthenticationServices. Either way the identity token is verified against the
+// keyset of Apple before its subject is trusted.
+
+const (
+	Issuer = "https://appleid.apple.com"
+
+	tokenURL = "https://appleid.apple.com/auth/token"
+
+	// PrivateRelayDomain the domain of the addresses handed out by Hide My Email
+	PrivateRelayDomain = "privaterelay.appleid.com"
+
+	keySetCacheTTL = time.Hour
+	// keySetRefreshInterval limits refetches caused by tokens with an unknown kid
+	keySetRefreshInterval = time.Minute
+	clientSecretExpire    = 5 * time.Minute
+)
+
+var (
+	ErrNotConfigured        = errors.New("sign in with apple is not configured")
+	ErrInvalidIdentityToken = errors.New("invalid apple identity token")
+)
+
+// IdentityClaims the claims of an identity token, email is only present when the user shared it
+type IdentityClaims struct {
+	Issuer         string   `json:"iss"`
+	Subject        string   `json:"sub"`
+	Audience       string   `json:"aud"`
+	IssuedAt       int64    `json:"iat"`
+	ExpiresAt      int64    `json:"exp"`
+	Nonce          string   `json:"nonce"`
+	Email          string   `json:"email"`
+	EmailVerified  jsonBool `json:"email_verified"`
+	IsPrivateEmail jsonBool `json:"is_private_email"`
+}
+
+// IsPrivateRelayEmail the email is a Hide My Email relay, it only forwards mail from registered senders
+func (c *IdentityClaims) IsPrivateRelayEmail() bool {
+	return bool(c.IsPrivateEmail) || IsPrivateRelayEmail(c.Email)
+}
+
+// IsPrivateRelayEmail reports Hide My Email relay addresses
+func IsPrivateRelayEmail(email string) bool {
+	return strings.HasSuffix(strings.ToLower(email), "@"+PrivateRelayDomain)
+}
+
+// jsonBool Apple sends booleans either as JSON booleans or as "true" / "false"
+type jsonBool bool
+
+func (b *jsonBool) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "true":
+		*b = true
+	case "false", "null", "":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type Client struct {
+	teamID     string
+	keyID      string
+	privateKey *ecdsa.PrivateKey
+	servicesID string
+	bundleID   string
+
+	httpClient *xhttp.Client
+	fetcher    KeySetFetcher
+
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+func NewClient(config *config.Config, httpClient *xhttp.Client, fetcher KeySetFetcher) (*Client, error) {
+	client := &Client{
+		httpClient: httpClient,
+		fetcher:    fetcher,
+	}
+
+	if config.Apple == nil {
+		return client, nil
+	}
+
+	client.teamID = config.Apple.TeamID
+	client.keyID = config.Apple.KeyID
+	client.servicesID = config.Apple.ServicesID
+	client.bundleID = config.Apple.BundleID
+
+	// the key is only needed by web logins, native logins verify the identity token alone
+	if config.Apple.PrivateKeyPath != "" {
+		b, err := os.ReadFile(config.Apple.PrivateKeyPath)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		privateKey, err := parsePrivateKey(b)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		client.privateKey = privateKey
+	}
+
+	return client, nil
+}
+
+// ExchangeCode exchanges the authorization code of a web login for an identity token
+func (c *Client) ExchangeCode(ctx context.Context, code string, redirectURI string) (string, error) {
+	if c.servicesID == "" || c.teamID == "" || c.keyID == "" || c.privateKey == nil {
+		return "", xerror.Wrap(ErrNotConfigured)
+	}
+
+	clientSecret, err := c.clientSecret(c.servicesID)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	data := url.Values{}
+	data.Set("client_id", c.servicesID)
+	data.Set("client_secret", clientSecret)
+	data.Set("code", code)
+	data.Set("grant_type", "authorization_code")
+	data.Set("redirect_uri", redirectURI)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	var tokenResp tokenResponse
+	if err := json.Unmarshal(b, &tokenResp); err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	if tokenResp.Error != "" {
+		return "", xerror.Wrap(fmt.Errorf("failed to exchange code for token: %s - %s", tokenResp.Error, tokenResp.ErrorDescription))
+	}
+
+	if tokenResp.IDToken == "" {
+		return "", xerror.Wrap(errors.New("id_token is empty in response"))
+	}
+
+	return tokenResp.IDToken, nil
+}
+
+// VerifyIdentityToken checks the signature, issuer, audience and expiry of an identity token,
+// the nonce too when one was sent with the authorization request
+func (c *Client) VerifyIdentityToken(ctx context.Context, identityToken string, nonce string) (*IdentityClaims, error) {
+	audiences := make([]string, 0, 2)
+	for _, audience := range []string{c.servicesID, c.bundleID} {
+		if audience != "" {
+			audiences = append(audiences, audience)
+		}
+	}
+
+	if len(audiences) == 0 {
+		return nil, xerror.Wrap(ErrNotConfigured)
+	}
+
+	parts := strings.Split(identityToken, ".")
+	if len(parts) != 3 {
+		return nil, xerror.Wrap(ErrInvalidIdentityToken)
+	}
+
+	head := struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}{}
+	if err := decodeSegment(parts[0], &head); err != nil || head.Alg != "RS256" {
+		return nil, xerror.Wrap(ErrInvalidIdentityToken)
+	}
+
+	publicKey, err := c.publicKey(ctx, head.Kid)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, xerror.Wrap(ErrInvalidIdentityToken)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, xerror.Wrap(ErrInvalidIdentityToken)
+	}
+
+	claims := &IdentityClaims{}
+	if err := decodeSegment(parts[1], claims); err != nil {
+		return nil, xerror.Wrap(ErrInvalidIdentityToken)
+	}
+
+	if claims.Issuer != Issuer ||
+		!slices.Contains(audiences, claims.Audience) ||
+		claims.ExpiresAt < time.Now().Unix() ||
+		claims.Subject == "" {
+		return nil, xerror.Wrap(ErrInvalidIdentityToken)
+	}
+
+	if nonce != "" && claims.Nonce != nonce {
+		return nil, xerror.Wrap(ErrInvalidIdentityToken)
+	}
+
+	return claims, nil
+}
+
+// publicKey looks the kid up in the cached keyset, Apple rotates keys so an unknown kid refetches it
+func (c *Client) publicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	fetchedAt := c.fetchedAt
+	c.mu.RUnlock()
+
+	if ok && time.Since(fetchedAt) < keySetCacheTTL {
+		return key, nil
+	}
+
+	if !ok && time.Since(fetchedAt) < keySetRefreshInterval {
+		return nil, xerror.Wrap(ErrInvalidIdentityToken)
+	}
+
+	keys, err := c.fetcher.FetchKeySet(ctx)
+	if err != nil {
+		// a stale key still verifies while Apple is unreachable
+		if ok {
+			return key, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.fetchedAt = time.Now()
+	c.mu.Unlock()
+
+	key, ok = keys[kid]
+	if !ok {
+		return nil, xerror.Wrap(ErrInvalidIdentityToken)
+	}
+
+	return key, nil
+}
+
+// clientSecret the ES256 signed JWT Apple accepts as client secret
+func (c *Client) clientSecret(clientID string) (string, error) {
+	head, err := json.Marshal(map[string]string{
+		"alg": "ES256",
+		"kid": c.keyID,
+	})
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(map[string]any{
+		"iss": c.teamID,
+		"iat": now.Unix(),
+		"exp": now.Add(clientSecretExpire).Unix(),
+		"aud": Issuer,
+		"sub": clientID,
+	})
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(head) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	r, s, err := ecdsa.Sign(rand.Reader, c.privateKey, digest[:])
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	// JWS wants the fixed size r || s, not the ASN.1 encoding
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func parsePrivateKey(b []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, xerror.Wrap(errors.New("invalid apple private key"))
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	privateKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, xerror.Wrap(errors.New("apple private key is not an ecdsa key"))
+	}
+
+	return privateKey, nil
+}
+
+func decodeSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,151 @@
+package apple
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type fakeKeySetFetcher struct {
+	keys    map[string]*rsa.PublicKey
+	fetches int
+}
+
+func (f *fakeKeySetFetcher) FetchKeySet(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	f.fetches++
+	return f.keys, nil
+}
+
+func signIdentityToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+
+	head, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": kid})
+	payload, _ := json.Marshal(claims)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(head) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func newTestClient(t *testing.T) (*Client, *rsa.PrivateKey, *fakeKeySetFetcher) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetcher := &fakeKeySetFetcher{keys: map[string]*rsa.PublicKey{"kid-1": &key.PublicKey}}
+
+	return &Client{
+		servicesID: "com.example.web",
+		bundleID:   "com.example.app",
+		fetcher:    fetcher,
+	}, key, fetcher
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"iss":              Issuer,
+		"sub":              "001234.abcdef",
+		"aud":              "com.example.app",
+		"iat":              time.Now().Unix(),
+		"exp":              time.Now().Add(time.Minute).Unix(),
+		"nonce":            "n-0S6_WzA2Mj",
+		"email":            "x7k2@privaterelay.appleid.com",
+		"email_verified":   "true",
+		"is_private_email": "true",
+	}
+}
+
+func TestVerifyIdentityToken(t *testing.T) {
+	client, key, _ := newTestClient(t)
+
+	claims, err := client.VerifyIdentityToken(context.Background(), signIdentityToken(t, key, "kid-1", validClaims()), "n-0S6_WzA2Mj")
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+
+	if claims.Subject != "001234.abcdef" {
+		t.Fatalf("subject = %s", claims.Subject)
+	}
+
+	if !claims.IsPrivateRelayEmail() || !bool(claims.EmailVerified) {
+		t.Fatalf("string booleans not decoded: %+v", claims)
+	}
+}
+
+func TestVerifyIdentityTokenRejects(t *testing.T) {
+	client, key, _ := newTestClient(t)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		key    *rsa.PrivateKey
+		modify func(claims map[string]any)
+		nonce  string
+	}{
+		"wrong signature": {key: otherKey},
+		"wrong issuer":    {key: key, modify: func(c map[string]any) { c["iss"] = "https://accounts.google.com" }},
+		"wrong audience":  {key: key, modify: func(c map[string]any) { c["aud"] = "com.other.app" }},
+		"expired":         {key: key, modify: func(c map[string]any) { c["exp"] = time.Now().Add(-time.Minute).Unix() }},
+		"wrong nonce":     {key: key, nonce: "another"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			claims := validClaims()
+			if tc.modify != nil {
+				tc.modify(claims)
+			}
+
+			if _, err := client.VerifyIdentityToken(context.Background(), signIdentityToken(t, tc.key, "kid-1", claims), tc.nonce); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestVerifyIdentityTokenRotatedKey(t *testing.T) {
+	client, key, fetcher := newTestClient(t)
+
+	if _, err := client.VerifyIdentityToken(context.Background(), signIdentityToken(t, key, "kid-1", validClaims()), ""); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+
+	rotated, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fetcher.keys = map[string]*rsa.PublicKey{"kid-2": &rotated.PublicKey}
+
+	// the keyset was just fetched, an unknown kid does not hammer Apple
+	if _, err := client.VerifyIdentityToken(context.Background(), signIdentityToken(t, rotated, "kid-2", validClaims()), ""); err == nil {
+		t.Fatal("expected an error before the refresh interval")
+	}
+
+	client.fetchedAt = time.Now().Add(-keySetRefreshInterval)
+
+	if _, err := client.VerifyIdentityToken(context.Background(), signIdentityToken(t, rotated, "kid-2", validClaims()), ""); err != nil {
+		t.Fatalf("verify with rotated key: %v", err)
+	}
+
+	if fetcher.fetches != 2 {
+		t.Fatalf("fetches = %d, want 2", fetcher.fetches)
+	}
+}
@@ -0,0 +1,103 @@
+package apple
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+const keySetURL = "https://appleid.apple.com/auth/keys"
+
+// KeySetFetcher fetches the public keys signing identity tokens, by kid
+type KeySetFetcher interface {
+	FetchKeySet(ctx context.Context) (map[string]*rsa.PublicKey, error)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type httpKeySetFetcher struct {
+	httpClient *xhttp.Client
+}
+
+// NewKeySetFetcher fetches the keyset published by Apple
+func NewKeySetFetcher(httpClient *xhttp.Client) KeySetFetcher {
+	return &httpKeySetFetcher{httpClient: httpClient}
+}
+
+func (f *httpKeySetFetcher) FetchKeySet(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, keySetURL, nil)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	resp, err := f.httpClient.Do(request)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, xerror.Wrap(fmt.Errorf("fetch apple keyset failed: %s", resp.Status))
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	keySet := &jsonWebKeySet{}
+	if err := json.Unmarshal(b, keySet); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(keySet.Keys))
+	for _, key := range keySet.Keys {
+		if key.Kty != "RSA" {
+			continue
+		}
+
+		publicKey, err := parseRSAPublicKey(key.N, key.E)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		keys[key.Kid] = publicKey
+	}
+
+	return keys, nil
+}
+
+func parseRSAPublicKey(n string, e string) (*rsa.PublicKey, error) {
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	eb, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nb),
+		E: int(new(big.Int).SetBytes(eb).Int64()),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"kiwi-user/internal/infrastructure/aliyun"
+	"net/url"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// Captcha 2.0 of Alibaba Cloud (https://help.aliyun.com/document_detail/2573441.html), the verify
+// param is checked with VerifyIntelligentCaptcha, an RPC style api signed with the access key.
+
+const (
+	alibabaAPIVersion = "2023-03-05"
+	alibabaAction     = "VerifyIntelligentCaptcha"
+)
+
+type alibabaResponse struct {
+	RequestID string `json:"RequestId"`
+	Code      string `json:"Code"`
+	Message   string `json:"Message"`
+	Success   bool   `json:"Success"`
+	Result    struct {
+		VerifyResult bool   `json:"VerifyResult"`
+		VerifyCode   string `json:"VerifyCode"`
+	} `json:"Result"`
+}
+
+type alibabaVerifier struct {
+	endpoint        string
+	accessKeyID     string
+	accessKeySecret string
+	httpClient      *xhttp.Client
+}
+
+func newAlibabaVerifier(endpoint string, accessKeyID string, accessKeySecret string, httpClient *xhttp.Client) *alibabaVerifier {
+	return &alibabaVerifier{
+		endpoint:        endpoint,
+		accessKeyID:     accessKeyID,
+		accessKeySecret: accessKeySecret,
+		httpClient:      httpClient,
+	}
+}
+
+func (v *alibabaVerifier) Verify(ctx context.Context, param string, sceneID string) (bool, error) {
+	if v.endpoint == "" || v.accessKeyID == "" || v.accessKeySecret == "" {
+		return false, xerror.Wrap(ErrNotConfigured)
+	}
+
+	if param == "" {
+		return false, nil
+	}
+
+	params := url.Values{}
+	params.Set("CaptchaVerifyParam", param)
+	if sceneID != "" {
+		params.Set("SceneId", sceneID)
+	}
+
+	b, status, err := aliyun.CallRPC(ctx, v.httpClient, v.endpoint, v.accessKeyID, v.accessKeySecret, alibabaAction, alibabaAPIVersion, params)
+	if err != nil {
+		return false, xerror.Wrap(err)
+	}
+
+	var response alibabaResponse
+	if err := json.Unmarshal(b, &response); err != nil {
+		return false, xerror.Wrap(fmt.Errorf("captcha response status %d: %w", status, err))
+	}
+
+	if !response.Success {
+		return false, xerror.Wrap(fmt.Errorf("captcha api error: %s, %s, request %s", response.Code, response.Message, response.RequestID))
+	}
+
+	return response.Result.VerifyResult, nil
+}
@@ -0,0 +1,39 @@
+package captcha
+
+import (
+	"context"
+	"errors"
+	"kiwi-user/config"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+)
+
+// Clients solve a captcha of the scene and send the verify param it produced along with the
+// request, the server verifies the param once with the captcha service.
+
+const (
+	ProviderAlibaba = "alibaba"
+	// ProviderStatic accepts one configured param, it stands in for the captcha service in tests
+	// and local setups
+	ProviderStatic = "static"
+)
+
+var ErrNotConfigured = errors.New("captcha is not configured")
+
+// Verifier checks the verify param of a solved captcha
+type Verifier interface {
+	Verify(ctx context.Context, param string, sceneID string) (bool, error)
+}
+
+// NewVerifier the verifier of the provider of the captcha config
+func NewVerifier(config *config.Config, httpClient *xhttp.Client) Verifier {
+	if config.Captcha.Provider == ProviderStatic {
+		return NewStaticVerifier(config.Captcha.StaticParam)
+	}
+
+	return newAlibabaVerifier(
+		config.Captcha.Endpoint,
+		config.Captcha.AccessKeyID,
+		config.Captcha.AccessKeySecret,
+		httpClient)
+}
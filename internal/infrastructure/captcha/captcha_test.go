@@ -0,0 +1,31 @@
+package captcha
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStaticVerifier(t *testing.T) {
+	ctx := context.Background()
+	verifier := NewStaticVerifier("pass")
+
+	if ok, err := verifier.Verify(ctx, "pass", "scene"); err != nil || !ok {
+		t.Fatalf("expected the configured param to pass, got %v %v", ok, err)
+	}
+
+	if ok, err := verifier.Verify(ctx, "fail", "scene"); err != nil || ok {
+		t.Fatalf("expected another param to fail, got %v %v", ok, err)
+	}
+
+	if _, err := NewStaticVerifier("").Verify(ctx, "", "scene"); err != ErrNotConfigured {
+		t.Fatalf("expected ErrNotConfigured without a param, got %v", err)
+	}
+}
+
+func TestAlibabaVerifierNotConfigured(t *testing.T) {
+	verifier := newAlibabaVerifier("https://captcha.cn-shanghai.aliyuncs.com", "", "", nil)
+
+	if _, err := verifier.Verify(context.Background(), "param", "scene"); err == nil {
+		t.Fatal("expected an error without access key")
+	}
+}
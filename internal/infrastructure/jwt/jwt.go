@@ -11,6 +11,7 @@ import (
 	b64 "encoding/base64"
 
 	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
 )
 
 var (
@@ -18,14 +19,30 @@ var (
 )
 
 type JWTHelper struct {
-	rsa                     *RSA
-	accessTokenExpireSecond int64
+	rsa                       *RSA
+	accessTokenExpireSecond   int64
+	idTokenExpireSecond       int64
+	mfaChallengeExpireSecond  int64
+	passwordResetExpireSecond int64
+	oidcStateExpireSecond     int64
+	samlRequestExpireSecond   int64
+	samlTicketExpireSecond    int64
+	magicLinkExpireSecond     int64
+	oauthConsentExpireSecond  int64
 }
 
 func NewJWTHelper(config *config.Config, rsa *RSA) *JWTHelper {
 	return &JWTHelper{
-		rsa:                     rsa,
-		accessTokenExpireSecond: config.JWT.AccessTokenExpireSecond,
+		rsa:                       rsa,
+		accessTokenExpireSecond:   config.JWT.AccessTokenExpireSecond,
+		idTokenExpireSecond:       config.OAuth.IDTokenExpireSecond,
+		mfaChallengeExpireSecond:  config.MFA.ChallengeExpireSecond,
+		passwordResetExpireSecond: config.JWT.PasswordResetExpireSecond,
+		oidcStateExpireSecond:     config.Federation.StateExpireSecond,
+		samlRequestExpireSecond:   config.SAML.RequestExpireSecond,
+		samlTicketExpireSecond:    config.SAML.TicketExpireSecond,
+		magicLinkExpireSecond:     config.MagicLink.ExpireSecond,
+		oauthConsentExpireSecond:  config.OAuth.ConsentExpireSecond,
 	}
 }
 
@@ -34,6 +51,7 @@ func (j *JWTHelper) GenerateRSA256JWT(payload any) (*JWTToken, error) {
 	head := Head{}
 	head.Alg = RS256ALGRAS
 	head.Type = "jwt"
+	head.Kid = j.rsa.ActiveKeyID()
 	h, err := json.Marshal(head)
 	if err != nil {
 		return nil, xerror.Wrap(err)
@@ -48,7 +66,7 @@ func (j *JWTHelper) GenerateRSA256JWT(payload any) (*JWTToken, error) {
 
 	jwtToken.Payload = b64.RawURLEncoding.EncodeToString(p)
 
-	sig, err := j.rsa.SignWithPrivateKey([]byte(fmt.Sprintf("%s.%s", jwtToken.Head, jwtToken.Payload)), crypto.SHA256)
+	sig, err := j.rsa.SignWithPrivateKey(head.Kid, []byte(fmt.Sprintf("%s.%s", jwtToken.Head, jwtToken.Payload)), crypto.SHA256)
 
 	if err != nil {
 		return nil, xerror.Wrap(err)
@@ -88,9 +106,19 @@ func (j *JWTHelper) VerifyRS256JWT(token string) (*JWTToken, error) {
 
 	jwtToken.Sign = parts[2]
 
+	head := Head{}
+	decodedHead, err := b64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, xerror.Wrap(ErrInvalidJWTToken)
+	}
+
+	if err := json.Unmarshal(decodedHead, &head); err != nil || head.Alg != RS256ALGRAS {
+		return nil, xerror.Wrap(ErrInvalidJWTToken)
+	}
+
 	concat := fmt.Sprintf("%s.%s", parts[0], parts[1])
 
-	if err := j.rsa.VerifySignWithPublicKey([]byte(concat), decodedSign, crypto.SHA256); err != nil {
+	if err := j.rsa.VerifySignWithPublicKey(head.Kid, []byte(concat), decodedSign, crypto.SHA256); err != nil {
 		return nil, xerror.Wrap(ErrInvalidJWTToken)
 	}
 	return jwtToken, nil
@@ -118,6 +146,7 @@ func (j *JWTHelper) NewAccessPayload(
 	deviceID string,
 	organizationID string) *AccessPayload {
 	up := &AccessPayload{}
+	up.ID = uuid.NewString()
 	up.UserID = userID
 	up.PersonalRole = personalRole
 	up.Scopes = personalScopes
@@ -126,8 +155,157 @@ func (j *JWTHelper) NewAccessPayload(
 	up.DeviceID = deviceID
 	up.OrganizationID = organizationID
 
+	now := time.Now()
 	up.Payload.Type = ACCESS
-	up.Payload.Create = time.Now().Unix()
-	up.Payload.Expire = time.Now().Unix() + j.accessTokenExpireSecond
+	up.Payload.Create = now.Unix()
+	up.Payload.Expire = now.Unix() + j.accessTokenExpireSecond
+	up.CreateMilli = now.UnixMilli()
 	return up
 }
+
+func (j *JWTHelper) NewIDTokenPayload(
+	issuer string,
+	userID string,
+	audience string,
+	nonce string,
+	authTime int64,
+	claims UserClaims) *IDTokenPayload {
+	ip := &IDTokenPayload{}
+	ip.Issuer = issuer
+	ip.Subject = userID
+	ip.Audience = audience
+	ip.Nonce = nonce
+	ip.AuthTime = authTime
+	ip.UserClaims = claims
+
+	ip.Payload.Type = IDTOKEN
+	ip.Payload.Create = time.Now().Unix()
+	ip.Payload.Expire = time.Now().Unix() + j.idTokenExpireSecond
+	return ip
+}
+
+func (j *JWTHelper) NewMFAChallengePayload(
+	userID string,
+	application string,
+	deviceType string,
+	deviceID string,
+	organizationID string,
+	loginType string) *MFAChallengePayload {
+	mp := &MFAChallengePayload{}
+	mp.ID = uuid.NewString()
+	mp.UserID = userID
+	mp.Application = application
+	mp.DeviceType = deviceType
+	mp.DeviceID = deviceID
+	mp.OrganizationID = organizationID
+	mp.LoginType = loginType
+
+	mp.Payload.Type = MFACHALLENGE
+	mp.Payload.Create = time.Now().Unix()
+	mp.Payload.Expire = time.Now().Unix() + j.mfaChallengeExpireSecond
+	return mp
+}
+
+func (j *JWTHelper) NewPasswordResetPayload(
+	userID string,
+	application string,
+	passwordFingerprint string) *PasswordResetPayload {
+	pp := &PasswordResetPayload{}
+	pp.UserID = userID
+	pp.Application = application
+	pp.PasswordFingerprint = passwordFingerprint
+
+	pp.Payload.Type = PASSWORDRESET
+	pp.Payload.Create = time.Now().Unix()
+	pp.Payload.Expire = time.Now().Unix() + j.passwordResetExpireSecond
+	return pp
+}
+
+func (j *JWTHelper) NewOIDCStatePayload(
+	application string,
+	provider string,
+	nonce string,
+	redirectURI string,
+	verifierHash string) *OIDCStatePayload {
+	op := &OIDCStatePayload{}
+	op.Application = application
+	op.Provider = provider
+	op.Nonce = nonce
+	op.RedirectURI = redirectURI
+	op.VerifierHash = verifierHash
+
+	op.Payload.Type = OIDCSTATE
+	op.Payload.Create = time.Now().Unix()
+	op.Payload.Expire = time.Now().Unix() + j.oidcStateExpireSecond
+	return op
+}
+
+func (j *JWTHelper) NewSAMLRelayPayload(
+	requestID string,
+	organizationID string) *SAMLRelayPayload {
+	sp := &SAMLRelayPayload{}
+	sp.ID = requestID
+	sp.OrganizationID = organizationID
+
+	sp.Payload.Type = SAMLRELAY
+	sp.Payload.Create = time.Now().Unix()
+	sp.Payload.Expire = time.Now().Unix() + j.samlRequestExpireSecond
+	return sp
+}
+
+func (j *JWTHelper) NewSAMLTicketPayload(
+	ticketID string,
+	userID string,
+	application string,
+	organizationID string) *SAMLTicketPayload {
+	sp := &SAMLTicketPayload{}
+	sp.ID = ticketID
+	sp.UserID = userID
+	sp.Application = application
+	sp.OrganizationID = organizationID
+
+	sp.Payload.Type = SAMLTICKET
+	sp.Payload.Create = time.Now().Unix()
+	sp.Payload.Expire = time.Now().Unix() + j.samlTicketExpireSecond
+	return sp
+}
+
+func (j *JWTHelper) NewMagicLinkPayload(
+	linkID string,
+	application string,
+	email string) *MagicLinkPayload {
+	mp := &MagicLinkPayload{}
+	mp.ID = linkID
+	mp.Application = application
+	mp.Email = email
+
+	mp.Payload.Type = MAGICLINK
+	mp.Payload.Create = time.Now().Unix()
+	mp.Payload.Expire = time.Now().Unix() + j.magicLinkExpireSecond
+	return mp
+}
+
+func (j *JWTHelper) NewOAuthConsentPayload(
+	userID string,
+	clientID string,
+	redirectURI string,
+	scope string,
+	state string,
+	nonce string,
+	codeChallenge string,
+	codeChallengeMethod string) *OAuthConsentPayload {
+	op := &OAuthConsentPayload{}
+	op.UserID = userID
+	op.ClientID = clientID
+	op.RedirectURI = redirectURI
+	op.Scope = scope
+	op.State = state
+	op.Nonce = nonce
+	op.CodeChallenge = codeChallenge
+	op.CodeChallengeMethod = codeChallengeMethod
+
+	op.Payload.Type = OAUTHCONSENT
+	op.Payload.Create = time.Now().Unix()
+	op.Payload.Expire = time.Now().Unix() + j.oauthConsentExpireSecond
+	return op
+}
@@ -0,0 +1,397 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// Key lifecycle in the key directory:
+//
+//	pending  -> published in the jwks so consumers can cache it, not used for signing yet
+//	active   -> signs new tokens, exactly one key at a time
+//	retired  -> no longer signs, still verifies until every token it signed has expired
+//
+// A rotation stages a pending key, activates it once consumers had time to refresh their jwks,
+// and removes the retired key after the longest token lifetime has passed.
+
+type KeyStatus string
+
+const (
+	KeyStatusPending KeyStatus = "pending"
+	KeyStatusActive  KeyStatus = "active"
+	KeyStatusRetired KeyStatus = "retired"
+
+	keyManifestFile = "keys.json"
+	keyBits         = 2048
+)
+
+var (
+	ErrKeyDirectoryNotConfigured = errors.New("jwt key directory not configured")
+	ErrSigningKeyNotFound        = errors.New("signing key not found")
+	ErrSigningKeyInvalidStatus   = errors.New("signing key status does not allow this operation")
+	ErrSigningKeyInUse           = errors.New("signing key may still verify live tokens")
+)
+
+type signingKey struct {
+	Kid         string    `json:"kid"`
+	Status      KeyStatus `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	ActivatedAt time.Time `json:"activated_at,omitempty"`
+	RetiredAt   time.Time `json:"retired_at,omitempty"`
+
+	publicKey  *rsa.PublicKey
+	privateKey *rsa.PrivateKey
+}
+
+type keyManifest struct {
+	Keys []*signingKey `json:"keys"`
+}
+
+// SigningKeyInfo describes a key without exposing key material
+type SigningKeyInfo struct {
+	Kid         string
+	Status      KeyStatus
+	CreatedAt   time.Time
+	ActivatedAt time.Time
+	RetiredAt   time.Time
+	// RemovableAt is when a retired key no longer verifies any live token
+	RemovableAt time.Time
+}
+
+// ListKeys : list the keys of the keyset
+func (r *RSA) ListKeys() []*SigningKeyInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	infos := make([]*SigningKeyInfo, 0, len(r.keys))
+	for _, key := range sortedSigningKeys(r.keys) {
+		info := &SigningKeyInfo{
+			Kid:         key.Kid,
+			Status:      key.Status,
+			CreatedAt:   key.CreatedAt,
+			ActivatedAt: key.ActivatedAt,
+			RetiredAt:   key.RetiredAt,
+		}
+
+		if key.Status == KeyStatusRetired {
+			info.RemovableAt = key.RetiredAt.Add(time.Duration(r.maxTokenExpireSecond) * time.Second)
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// StageKey : generate a new pending key
+func (r *RSA) StageKey() (*SigningKeyInfo, error) {
+	if r.keyDirectory == "" {
+		return nil, xerror.Wrap(ErrKeyDirectoryNotConfigured)
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, keyBits)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	var staged *signingKey
+	err = r.updateManifest(func(manifest *keyManifest) error {
+		kid, err := keyThumbprint(&privateKey.PublicKey)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if err := r.writePrivateKeyToFile(privateKey, r.keyFilePath(kid)); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		staged = &signingKey{
+			Kid:       kid,
+			Status:    KeyStatusPending,
+			CreatedAt: time.Now(),
+		}
+		manifest.Keys = append(manifest.Keys, staged)
+
+		return nil
+	})
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return &SigningKeyInfo{
+		Kid:       staged.Kid,
+		Status:    staged.Status,
+		CreatedAt: staged.CreatedAt,
+	}, nil
+}
+
+// ActivateKey : sign with a pending key from now on, the previous active key is retired
+func (r *RSA) ActivateKey(kid string) error {
+	if r.keyDirectory == "" {
+		return xerror.Wrap(ErrKeyDirectoryNotConfigured)
+	}
+
+	return r.updateManifest(func(manifest *keyManifest) error {
+		var target *signingKey
+		for _, key := range manifest.Keys {
+			if key.Kid == kid {
+				target = key
+			}
+		}
+
+		if target == nil {
+			return xerror.Wrap(ErrSigningKeyNotFound)
+		}
+
+		if target.Status != KeyStatusPending {
+			return xerror.Wrap(ErrSigningKeyInvalidStatus)
+		}
+
+		now := time.Now()
+		for _, key := range manifest.Keys {
+			if key.Status == KeyStatusActive {
+				key.Status = KeyStatusRetired
+				key.RetiredAt = now
+			}
+		}
+
+		target.Status = KeyStatusActive
+		target.ActivatedAt = now
+
+		return nil
+	})
+}
+
+// RemoveKey : delete a pending key, or a retired key once every token it signed has expired
+func (r *RSA) RemoveKey(kid string) error {
+	if r.keyDirectory == "" {
+		return xerror.Wrap(ErrKeyDirectoryNotConfigured)
+	}
+
+	return r.updateManifest(func(manifest *keyManifest) error {
+		keys := make([]*signingKey, 0, len(manifest.Keys))
+		var target *signingKey
+		for _, key := range manifest.Keys {
+			if key.Kid == kid {
+				target = key
+				continue
+			}
+			keys = append(keys, key)
+		}
+
+		if target == nil {
+			return xerror.Wrap(ErrSigningKeyNotFound)
+		}
+
+		switch target.Status {
+		case KeyStatusActive:
+			return xerror.Wrap(ErrSigningKeyInvalidStatus)
+		case KeyStatusRetired:
+			if time.Now().Before(target.RetiredAt.Add(time.Duration(r.maxTokenExpireSecond) * time.Second)) {
+				return xerror.Wrap(ErrSigningKeyInUse)
+			}
+		}
+
+		manifest.Keys = keys
+
+		if err := os.Remove(r.keyFilePath(kid)); err != nil && !os.IsNotExist(err) {
+			return xerror.Wrap(err)
+		}
+
+		return nil
+	})
+}
+
+// initKeyDirectory : create the manifest on first start, importing the single key pair when configured
+// so tokens signed before the keyset existed keep verifying
+func (r *RSA) initKeyDirectory() error {
+	if _, err := os.Stat(filepath.Join(r.keyDirectory, keyManifestFile)); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return xerror.Wrap(err)
+	}
+
+	if err := os.MkdirAll(r.keyDirectory, 0700); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	var privateKey *rsa.PrivateKey
+	if r.privateKeyPath != "" {
+		if _, err := os.Stat(r.privateKeyPath); err == nil {
+			r.logger.Infof(context.Background(), "import %s into key directory", r.privateKeyPath)
+			privateKey, err = r.loadPrivateKeyFromFile(r.privateKeyPath)
+			if err != nil {
+				return xerror.Wrap(err)
+			}
+		}
+	}
+
+	if privateKey == nil {
+		var err error
+		privateKey, err = rsa.GenerateKey(rand.Reader, keyBits)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+	}
+
+	kid, err := keyThumbprint(&privateKey.PublicKey)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if err := r.writePrivateKeyToFile(privateKey, r.keyFilePath(kid)); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	now := time.Now()
+	return r.writeManifest(&keyManifest{
+		Keys: []*signingKey{
+			{
+				Kid:         kid,
+				Status:      KeyStatusActive,
+				CreatedAt:   now,
+				ActivatedAt: now,
+			},
+		},
+	})
+}
+
+func (r *RSA) reloadLoop() {
+	ticker := time.NewTicker(r.keyReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			if err := r.reload(); err != nil {
+				r.logger.Errorf(context.Background(), "reload jwt keys failed: %w", err)
+			}
+		}
+	}
+}
+
+// reload : load the manifest and every key file into memory
+func (r *RSA) reload() error {
+	manifest, err := r.readManifest()
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	keys := make(map[string]*signingKey, len(manifest.Keys))
+	activeKid := ""
+	for _, key := range manifest.Keys {
+		privateKey, err := r.loadPrivateKeyFromFile(r.keyFilePath(key.Kid))
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		key.privateKey = privateKey
+		key.publicKey = &privateKey.PublicKey
+		keys[key.Kid] = key
+
+		if key.Status == KeyStatusActive {
+			activeKid = key.Kid
+		}
+	}
+
+	if activeKid == "" {
+		return xerror.Wrap(ErrRSAKeyNotExists)
+	}
+
+	r.mu.Lock()
+	r.keys = keys
+	r.activeKid = activeKid
+	r.mu.Unlock()
+
+	return nil
+}
+
+// updateManifest : apply fn to the manifest on disk, persist it and reload the keyset
+func (r *RSA) updateManifest(fn func(manifest *keyManifest) error) error {
+	manifest, err := r.readManifest()
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if err := fn(manifest); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if err := r.writeManifest(manifest); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return r.reload()
+}
+
+func (r *RSA) readManifest() (*keyManifest, error) {
+	b, err := os.ReadFile(filepath.Join(r.keyDirectory, keyManifestFile))
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	manifest := &keyManifest{}
+	if err := json.Unmarshal(b, manifest); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return manifest, nil
+}
+
+// writeManifest : write to a temp file and rename, readers never see a partial manifest
+func (r *RSA) writeManifest(manifest *keyManifest) error {
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	tmp := filepath.Join(r.keyDirectory, keyManifestFile+".tmp")
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if err := os.Rename(tmp, filepath.Join(r.keyDirectory, keyManifestFile)); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *RSA) writePrivateKeyToFile(privateKey *rsa.PrivateKey, filePath string) error {
+	var keybytes = x509.MarshalPKCS1PrivateKey(privateKey)
+	block := &pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: keybytes,
+	}
+	return os.WriteFile(filePath, pem.EncodeToMemory(block), 0600)
+}
+
+func (r *RSA) keyFilePath(kid string) string {
+	return filepath.Join(r.keyDirectory, kid+".pem")
+}
+
+func sortedSigningKeys(keys map[string]*signingKey) []*signingKey {
+	sorted := make([]*signingKey, 0, len(keys))
+	for _, key := range keys {
+		sorted = append(sorted, key)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	return sorted
+}
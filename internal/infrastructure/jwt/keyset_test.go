@@ -0,0 +1,249 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/rsa"
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func newTestKeySet(t *testing.T) (*RSA, *JWTHelper) {
+	t.Helper()
+
+	r := &RSA{
+		keyDirectory:         t.TempDir(),
+		maxTokenExpireSecond: 3600,
+		keys:                 make(map[string]*signingKey),
+	}
+	if err := r.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	return r, &JWTHelper{rsa: r, accessTokenExpireSecond: 3600}
+}
+
+// signWith signs a token with the key of signKid and puts headKid in its header
+func signWith(t *testing.T, r *RSA, headKid string, signKid string) string {
+	t.Helper()
+
+	h, _ := json.Marshal(Head{Type: "jwt", Alg: RS256ALGRAS, Kid: headKid})
+	p, _ := json.Marshal(Payload{Type: ACCESS, Create: time.Now().Unix(), Expire: time.Now().Add(time.Hour).Unix()})
+	signingInput := b64.RawURLEncoding.EncodeToString(h) + "." + b64.RawURLEncoding.EncodeToString(p)
+
+	sig, err := r.SignWithPrivateKey(signKid, []byte(signingInput), crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signingInput + "." + b64.RawURLEncoding.EncodeToString(sig)
+}
+
+func tokenKid(t *testing.T, token *JWTToken) string {
+	t.Helper()
+
+	b, err := b64.RawURLEncoding.DecodeString(token.Head)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head := Head{}
+	if err := json.Unmarshal(b, &head); err != nil {
+		t.Fatal(err)
+	}
+
+	return head.Kid
+}
+
+func jwkKids(t *testing.T, r *RSA) []string {
+	t.Helper()
+
+	jwks, err := r.GetPublicJWKs()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kids := make([]string, 0, len(jwks))
+	for _, jwk := range jwks {
+		kids = append(kids, jwk.Kid)
+	}
+
+	return kids
+}
+
+func TestKeyRotation(t *testing.T) {
+	r, helper := newTestKeySet(t)
+	first := r.ActiveKeyID()
+
+	before, err := helper.GenerateRSA256JWT(helper.NewAccessPayload("user-1", "", nil, "app", "web", "device-1", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokenKid(t, before) != first {
+		t.Fatalf("token signed with %q, want the active key %q", tokenKid(t, before), first)
+	}
+
+	staged, err := r.StageKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a pending key is published but does not sign yet
+	if kids := jwkKids(t, r); len(kids) != 2 || kids[0] != first || kids[1] != staged.Kid {
+		t.Fatalf("jwks %v, want the active key %q first and the pending key %q", kids, first, staged.Kid)
+	}
+	if r.ActiveKeyID() != first {
+		t.Fatal("staging a key must not change the signing key")
+	}
+
+	if err := r.ActivateKey(staged.Kid); err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := helper.GenerateRSA256JWT(helper.NewAccessPayload("user-1", "", nil, "app", "web", "device-1", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokenKid(t, after) != staged.Kid {
+		t.Fatalf("token signed with %q after the rotation, want %q", tokenKid(t, after), staged.Kid)
+	}
+
+	// the retired key keeps verifying the tokens it signed
+	for _, token := range []*JWTToken{before, after} {
+		if _, err := helper.VerifyRS256JWT(token.String()); err != nil {
+			t.Fatalf("token of %q: %v", tokenKid(t, token), err)
+		}
+	}
+	if kids := jwkKids(t, r); len(kids) != 2 || kids[0] != staged.Kid || kids[1] != first {
+		t.Fatalf("jwks %v, want the new active key %q first and the retired key %q", kids, staged.Kid, first)
+	}
+
+	if err := r.RemoveKey(staged.Kid); !errors.Is(err, ErrSigningKeyInvalidStatus) {
+		t.Fatalf("remove the active key: got %v, want %v", err, ErrSigningKeyInvalidStatus)
+	}
+	if err := r.RemoveKey(first); !errors.Is(err, ErrSigningKeyInUse) {
+		t.Fatalf("remove a retired key within the token lifetime: got %v, want %v", err, ErrSigningKeyInUse)
+	}
+
+	// every token the retired key signed has expired
+	r.maxTokenExpireSecond = 0
+	if err := r.RemoveKey(first); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := helper.VerifyRS256JWT(before.String()); !errors.Is(err, ErrInvalidJWTToken) {
+		t.Fatalf("token of a removed key: got %v, want %v", err, ErrInvalidJWTToken)
+	}
+	if kids := jwkKids(t, r); len(kids) != 1 || kids[0] != staged.Kid {
+		t.Fatalf("jwks %v, want only %q", kids, staged.Kid)
+	}
+}
+
+func TestVerifyKidSelection(t *testing.T) {
+	r, helper := newTestKeySet(t)
+	retired := r.ActiveKeyID()
+
+	active, err := r.StageKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.ActivateKey(active.Kid); err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := r.StageKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		headKid string
+		signKid string
+		wantErr bool
+	}{
+		{name: "active key", headKid: active.Kid, signKid: active.Kid},
+		{name: "retired key", headKid: retired, signKid: retired},
+		{name: "no kid signed by the active key", signKid: active.Kid},
+		{name: "no kid signed by the retired key", signKid: retired},
+		{name: "no kid signed by a pending key", signKid: pending.Kid, wantErr: true},
+		{name: "pending key", headKid: pending.Kid, signKid: pending.Kid, wantErr: true},
+		{name: "kid of another key", headKid: retired, signKid: active.Kid, wantErr: true},
+		{name: "unknown kid", headKid: "unknown", signKid: active.Kid, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := helper.VerifyRS256JWT(signWith(t, r, tt.headKid, tt.signKid))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyRS256JWT() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPublicJWKs(t *testing.T) {
+	r, _ := newTestKeySet(t)
+
+	jwks, err := r.GetPublicJWKs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jwks) != 1 {
+		t.Fatalf("%d keys, want 1", len(jwks))
+	}
+
+	jwk := jwks[0]
+	if jwk.Kty != "RSA" || jwk.Use != "sig" || jwk.Alg != RS256ALGRAS || jwk.Kid != r.ActiveKeyID() {
+		t.Fatalf("unexpected jwk %+v", jwk)
+	}
+
+	n, err := b64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := b64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey := r.keys[r.activeKid].publicKey
+	if new(big.Int).SetBytes(n).Cmp(publicKey.N) != 0 || int(new(big.Int).SetBytes(e).Int64()) != publicKey.E {
+		t.Fatal("the jwk does not encode the public key")
+	}
+
+	// private members must never be published
+	b, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	members := []map[string]any{}
+	if err := json.Unmarshal(b, &members); err != nil {
+		t.Fatal(err)
+	}
+	for _, private := range []string{"d", "p", "q", "dp", "dq", "qi"} {
+		if _, ok := members[0][private]; ok {
+			t.Fatalf("jwk publishes the private member %q", private)
+		}
+	}
+}
+
+func TestKeyThumbprint(t *testing.T) {
+	// RFC 7638, section 3.1
+	n, err := b64.RawURLEncoding.DecodeString("0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kid, err := keyThumbprint(&rsa.PublicKey{N: new(big.Int).SetBytes(n), E: 65537})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if kid != "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs" {
+		t.Fatalf("thumbprint %q", kid)
+	}
+}
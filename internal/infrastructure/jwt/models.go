@@ -4,6 +4,7 @@ import (
 	b64 "encoding/base64"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/futurxlab/golanggraph/xerror"
 )
@@ -13,6 +14,13 @@ const (
 	ACCESS         = "access"
 	REGISTERVERIFY = "register_verify"
 	PASSWORDRESET  = "password_reset"
+	IDTOKEN        = "id_token"
+	MFACHALLENGE   = "mfa_challenge"
+	OIDCSTATE      = "oidc_state"
+	SAMLRELAY      = "saml_relay"
+	SAMLTICKET     = "saml_ticket"
+	MAGICLINK      = "magic_link"
+	OAUTHCONSENT   = "oauth_consent"
 )
 
 type JWTToken struct {
@@ -45,6 +53,7 @@ func (jwt *JWTToken) UnmarshalPayload(v interface{}) error {
 type Head struct {
 	Type string `json:"type"`
 	Alg  string `json:"alg"`
+	Kid  string `json:"kid,omitempty"`
 }
 
 type Payload struct {
@@ -55,6 +64,8 @@ type Payload struct {
 
 type AccessPayload struct {
 	Payload
+	// ID unique per token, the handle to revoke it by
+	ID             string   `json:"jti"`
 	UserID         string   `json:"sub"`
 	Application    string   `json:"iss"`
 	PersonalRole   string   `json:"roles"`
@@ -62,4 +73,123 @@ type AccessPayload struct {
 	DeviceType     string   `json:"device_type"`
 	DeviceID       string   `json:"device_id"`
 	OrganizationID string   `json:"organization_id"`
+	// Audience the oauth client a token issued to a third party is for, empty for first party tokens
+	Audience string `json:"aud,omitempty"`
+	// OAuthScope the scope granted to that client
+	OAuthScope string `json:"scope,omitempty"`
+	// CreateMilli the issue time in milliseconds, iat alone cannot order a token against a
+	// revocation within the same second
+	CreateMilli int64 `json:"iat_ms,omitempty"`
+}
+
+// IssuedAt the issue time of the token, tokens issued before iat_ms existed fall back to iat
+func (p *AccessPayload) IssuedAt() time.Time {
+	if p.CreateMilli != 0 {
+		return time.UnixMilli(p.CreateMilli)
+	}
+
+	return time.Unix(p.Create, 0)
+}
+
+// PasswordResetPayload authorizes one password reset, PasswordFingerprint binds it to the password
+// it replaces so the token stops working once the password changed
+type PasswordResetPayload struct {
+	Payload
+	UserID              string `json:"sub"`
+	Application         string `json:"iss"`
+	PasswordFingerprint string `json:"pwf"`
+}
+
+// MFAChallengePayload issued after the first factor, exchanged once for tokens with a valid second factor
+type MFAChallengePayload struct {
+	Payload
+	ID             string `json:"jti"`
+	UserID         string `json:"sub"`
+	Application    string `json:"iss"`
+	DeviceType     string `json:"device_type"`
+	DeviceID       string `json:"device_id"`
+	OrganizationID string `json:"organization_id,omitempty"`
+	LoginType      string `json:"login_type"`
+}
+
+// OIDCStatePayload the state of an authorization request sent to a federated identity provider,
+// it comes back with the code and ties it to the application, provider and nonce of the request.
+// VerifierHash binds it to the browser that started the login, the state travels in urls the
+// verifier never does.
+type OIDCStatePayload struct {
+	Payload
+	Application  string `json:"iss"`
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	RedirectURI  string `json:"redirect_uri"`
+	VerifierHash string `json:"verifier_hash"`
+}
+
+// SAMLRelayPayload the relay state of an authentication request sent to the identity provider of
+// an organization, ID is the request id the response has to answer
+type SAMLRelayPayload struct {
+	Payload
+	ID             string `json:"jti"`
+	OrganizationID string `json:"organization_id"`
+}
+
+// SAMLTicketPayload issued by the assertion consumer service to the frontend, exchanged once for tokens
+type SAMLTicketPayload struct {
+	Payload
+	ID             string `json:"jti"`
+	UserID         string `json:"sub"`
+	Application    string `json:"iss"`
+	OrganizationID string `json:"organization_id"`
+}
+
+// MagicLinkPayload the token in a login link, ID is the magic link it confirms
+type MagicLinkPayload struct {
+	Payload
+	ID          string `json:"jti"`
+	Application string `json:"iss"`
+	Email       string `json:"email"`
+}
+
+// OAuthConsentPayload carries an authorization request through the consent page, the code is only
+// issued when the signed-in user posts it back
+type OAuthConsentPayload struct {
+	Payload
+	UserID              string `json:"sub"`
+	ClientID            string `json:"client_id"`
+	RedirectURI         string `json:"redirect_uri"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	Nonce               string `json:"nonce"`
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+}
+
+// UserClaims standard OpenID Connect claims about the end user
+type UserClaims struct {
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Picture           string `json:"picture,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+	PhoneNumber       string `json:"phone_number,omitempty"`
+}
+
+type IDTokenPayload struct {
+	Payload
+	UserClaims
+	Issuer   string `json:"iss"`
+	Subject  string `json:"sub"`
+	Audience string `json:"aud"`
+	Nonce    string `json:"nonce,omitempty"`
+	AuthTime int64  `json:"auth_time,omitempty"`
+}
+
+// JWK RSA public key in JSON Web Key format, see RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
 }
@@ -5,11 +5,17 @@ import (
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	b64 "encoding/base64"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"kiwi-user/config"
+	"math/big"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/futurxlab/golanggraph/logger"
 	"github.com/futurxlab/golanggraph/xerror"
@@ -23,23 +29,53 @@ type RSA struct {
 	publicKeyPath  string
 	privateKeyPath string
 
-	publicKey  *rsa.PublicKey
-	privateKey *rsa.PrivateKey
+	// keyDirectory holds the rotating keyset, the single key pair above is used when it is empty
+	keyDirectory         string
+	keyReloadInterval    time.Duration
+	maxTokenExpireSecond int64
+
+	mu        sync.RWMutex
+	keys      map[string]*signingKey
+	activeKid string
+
+	stop chan struct{}
 
 	logger logger.ILogger
 }
 
 func NewRSA(logger logger.ILogger, config *config.Config) *RSA {
 	return &RSA{
-		logger:         logger,
-		publicKeyPath:  config.JWT.PublicKeyPath,
-		privateKeyPath: config.JWT.PrivateKeyPath,
+		logger:               logger,
+		publicKeyPath:        config.JWT.PublicKeyPath,
+		privateKeyPath:       config.JWT.PrivateKeyPath,
+		keyDirectory:         config.JWT.KeyDirectory,
+		keyReloadInterval:    time.Duration(config.JWT.KeyReloadSecond) * time.Second,
+		maxTokenExpireSecond: max(config.JWT.AccessTokenExpireSecond, config.OAuth.IDTokenExpireSecond),
+		keys:                 make(map[string]*signingKey),
 	}
 }
 
-// Init : Init the rsa setting, generate new public private files or load from existing files
+// Init : Init the rsa setting, load the keyset from the key directory or the single key pair files
 func (r *RSA) Init() error {
 
+	if r.keyDirectory != "" {
+		if err := r.initKeyDirectory(); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		if err := r.reload(); err != nil {
+			return xerror.Wrap(err)
+		}
+
+		// other replicas rotate keys through the shared directory
+		if r.keyReloadInterval > 0 {
+			r.stop = make(chan struct{})
+			go r.reloadLoop()
+		}
+
+		return nil
+	}
+
 	_, privateKeyPathErr := os.Stat(r.privateKeyPath)
 
 	_, publicKeyPathErr := os.Stat(r.publicKeyPath)
@@ -58,8 +94,22 @@ func (r *RSA) Init() error {
 			return xerror.Wrap(err)
 		}
 
-		r.publicKey = publicKey
-		r.privateKey = privateKey
+		kid, err := keyThumbprint(publicKey)
+		if err != nil {
+			return xerror.Wrap(err)
+		}
+
+		r.mu.Lock()
+		r.keys = map[string]*signingKey{
+			kid: {
+				Kid:        kid,
+				Status:     KeyStatusActive,
+				publicKey:  publicKey,
+				privateKey: privateKey,
+			},
+		}
+		r.activeKid = kid
+		r.mu.Unlock()
 
 		return nil
 	}
@@ -67,6 +117,13 @@ func (r *RSA) Init() error {
 	return xerror.Wrap(ErrRSAKeyNotExists)
 }
 
+// Close : stop reloading the key directory
+func (r *RSA) Close() {
+	if r.stop != nil {
+		close(r.stop)
+	}
+}
+
 func (r *RSA) loadPublicKeyFromFile(filePath string) (*rsa.PublicKey, error) {
 	keybuffer, err := os.ReadFile(filePath)
 	if err != nil {
@@ -106,55 +163,16 @@ func (r *RSA) loadPrivateKeyFromFile(filePath string) (*rsa.PrivateKey, error) {
 	return privatekey, nil
 }
 
-// func (r *RSA) writePublicKeyToFile(publicKey *rsa.PublicKey, filePath string) error {
-// 	keybytes, err := x509.MarshalPKIXPublicKey(publicKey)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	block := &pem.Block{
-// 		Type:  "PUBLIC KEY",
-// 		Bytes: keybytes,
-// 	}
-// 	file, err := os.Create(filePath)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	err = pem.Encode(file, block)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	return nil
-// }
-
-// func (r *RSA) writePrivatekeyToFile(privateKey *rsa.PrivateKey, filePath string) error {
-// 	var keybytes = x509.MarshalPKCS1PrivateKey(privateKey)
-// 	block := &pem.Block{
-// 		Type:  "RSA PRIVATE KEY",
-// 		Bytes: keybytes,
-// 	}
-// 	file, err := os.Create(filePath)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	err = pem.Encode(file, block)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	return nil
-// }
-
-// func (r *RSA) generateKey() (*rsa.PrivateKey, *rsa.PublicKey, error) {
-// 	privatekey, err := rsa.GenerateKey(rand.Reader, 2048)
-// 	if err != nil {
-// 		return nil, nil, err
-// 	}
-
-// 	publickey := &privatekey.PublicKey
-// 	return privatekey, publickey, nil
-// }
-
-// SignWithPrivtaeKey : Sign the src data with private key
-func (r *RSA) SignWithPrivateKey(src []byte, hash crypto.Hash) (signed []byte, e error) {
+// ActiveKeyID : the key id new tokens are signed with
+func (r *RSA) ActiveKeyID() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.activeKid
+}
+
+// SignWithPrivateKey : Sign the src data with the private key of kid
+func (r *RSA) SignWithPrivateKey(kid string, src []byte, hash crypto.Hash) (signed []byte, e error) {
 	defer func() {
 		if r := recover(); r != nil {
 			switch x := r.(type) {
@@ -167,10 +185,19 @@ func (r *RSA) SignWithPrivateKey(src []byte, hash crypto.Hash) (signed []byte, e
 			}
 		}
 	}()
+
+	r.mu.RLock()
+	key := r.keys[kid]
+	r.mu.RUnlock()
+
+	if key == nil {
+		return []byte{}, xerror.Wrap(ErrRSAKeyNotExists)
+	}
+
 	h := hash.New()
 	h.Write(src)
 	hashed := h.Sum(nil)
-	signed, err := rsa.SignPKCS1v15(rand.Reader, r.privateKey, hash, hashed)
+	signed, err := rsa.SignPKCS1v15(rand.Reader, key.privateKey, hash, hashed)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -178,8 +205,10 @@ func (r *RSA) SignWithPrivateKey(src []byte, hash crypto.Hash) (signed []byte, e
 	return signed, nil
 }
 
-// VerifySignWithPublicKey : verify the signed data with public key
-func (r *RSA) VerifySignWithPublicKey(src, signed []byte, hash crypto.Hash) (e error) {
+// VerifySignWithPublicKey : verify the signed data with the public key of kid, tokens issued before
+// key ids were introduced carry no kid and are checked against every key that signs or has signed.
+// A pending key has never signed, no token may verify with it.
+func (r *RSA) VerifySignWithPublicKey(kid string, src, signed []byte, hash crypto.Hash) (e error) {
 	defer func() {
 		if r := recover(); r != nil {
 			switch x := r.(type) {
@@ -195,16 +224,47 @@ func (r *RSA) VerifySignWithPublicKey(src, signed []byte, hash crypto.Hash) (e e
 	h := hash.New()
 	h.Write(src)
 	hashed := h.Sum(nil)
-	err := rsa.VerifyPKCS1v15(r.publicKey, hash, hashed, signed)
-	if err != nil {
-		return xerror.Wrap(err)
+
+	r.mu.RLock()
+	candidates := make([]*rsa.PublicKey, 0, len(r.keys))
+	if kid != "" {
+		if key, ok := r.keys[kid]; ok && key.Status != KeyStatusPending {
+			candidates = append(candidates, key.publicKey)
+		}
+	} else {
+		for _, key := range r.keys {
+			if key.Status != KeyStatusPending {
+				candidates = append(candidates, key.publicKey)
+			}
+		}
+	}
+	r.mu.RUnlock()
+
+	if len(candidates) == 0 {
+		return xerror.Wrap(ErrRSAKeyNotExists)
 	}
-	return nil
+
+	var err error
+	for _, publicKey := range candidates {
+		if err = rsa.VerifyPKCS1v15(publicKey, hash, hashed, signed); err == nil {
+			return nil
+		}
+	}
+
+	return xerror.Wrap(err)
 }
 
-// GetPublicKey : Get the public key
+// GetPublicKey : Get the public key of the active signing key
 func (r *RSA) GetPublicKey() (string, error) {
-	keybytes, err := x509.MarshalPKIXPublicKey(r.publicKey)
+	r.mu.RLock()
+	key := r.keys[r.activeKid]
+	r.mu.RUnlock()
+
+	if key == nil {
+		return "", xerror.Wrap(ErrRSAKeyNotExists)
+	}
+
+	keybytes, err := x509.MarshalPKIXPublicKey(key.publicKey)
 	if err != nil {
 		return "", err
 	}
@@ -215,3 +275,59 @@ func (r *RSA) GetPublicKey() (string, error) {
 	keybuffer := pem.EncodeToMemory(block)
 	return string(keybuffer), nil
 }
+
+// GetPublicJWKs : Get every key that may verify a live token in JWK format, the active key first
+func (r *RSA) GetPublicJWKs() ([]*JWK, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.keys) == 0 {
+		return nil, xerror.Wrap(ErrRSAKeyNotExists)
+	}
+
+	jwks := make([]*JWK, 0, len(r.keys))
+	if key, ok := r.keys[r.activeKid]; ok {
+		jwks = append(jwks, convertPublicKeyToJWK(key.Kid, key.publicKey))
+	}
+
+	for _, key := range sortedSigningKeys(r.keys) {
+		if key.Kid != r.activeKid {
+			jwks = append(jwks, convertPublicKeyToJWK(key.Kid, key.publicKey))
+		}
+	}
+
+	return jwks, nil
+}
+
+func convertPublicKeyToJWK(kid string, publicKey *rsa.PublicKey) *JWK {
+	return &JWK{
+		Kty: "RSA",
+		Use: "sig",
+		Alg: RS256ALGRAS,
+		Kid: kid,
+		N:   b64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		E:   b64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+	}
+}
+
+// keyThumbprint : RFC 7638 JWK thumbprint, used as a stable key id
+func keyThumbprint(publicKey *rsa.PublicKey) (string, error) {
+	jwk := convertPublicKeyToJWK("", publicKey)
+
+	// members in lexicographic order, no whitespace
+	b, err := json.Marshal(struct {
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{
+		E:   jwk.E,
+		Kty: jwk.Kty,
+		N:   jwk.N,
+	})
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	sum := sha256.Sum256(b)
+	return b64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,29 @@
+package mail
+
+import (
+	"kiwi-user/config"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/client/resend"
+)
+
+// MagicLinkMailer sends login links through the resend mail client. It is a second client with the
+// magic link template and subject, the link takes the place of the verify code in the template.
+type MagicLinkMailer struct {
+	client *resend.ResendClient
+}
+
+func NewMagicLinkMailer(config *config.Config) *MagicLinkMailer {
+	return &MagicLinkMailer{
+		client: resend.NewResendClient(
+			resend.WithAPIKey(config.Mail.ResendAPIKey),
+			resend.WithFrom(config.Mail.ResendFromEmail),
+			resend.WithVerifyCodeTemplate(config.MagicLink.MailTemplate),
+			resend.WithVerifyCodeSubject(config.MagicLink.MailSubject),
+		),
+	}
+}
+
+// Send enqueues the email with the link
+func (m *MagicLinkMailer) Send(email string, link string) error {
+	return m.client.EnqueueVerifyCode(email, link)
+}
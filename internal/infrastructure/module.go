@@ -4,19 +4,29 @@ import (
 	"crypto/tls"
 	"kiwi-user/config"
 	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/infrastructure/apple"
+	"kiwi-user/internal/infrastructure/captcha"
 	"kiwi-user/internal/infrastructure/jwt"
+	"kiwi-user/internal/infrastructure/mail"
+	"kiwi-user/internal/infrastructure/oidc"
+	"kiwi-user/internal/infrastructure/password"
 	"kiwi-user/internal/infrastructure/payment/stripe"
+	"kiwi-user/internal/infrastructure/ratelimit"
+	"kiwi-user/internal/infrastructure/replay"
 	"kiwi-user/internal/infrastructure/repository"
+	"kiwi-user/internal/infrastructure/revocation"
+	"kiwi-user/internal/infrastructure/sms"
+	"kiwi-user/internal/infrastructure/wechat"
 	"net/http"
 	"time"
 
-	"github.com/Yet-Another-AI-Project/kiwi-lib/client/alibaba/captcha"
 	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/cache"
 
 	"github.com/Yet-Another-AI-Project/kiwi-lib/client/resend"
 	"github.com/Yet-Another-AI-Project/kiwi-lib/client/volcengine/msgsms"
 
 	"github.com/posthog/posthog-go"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/fx"
 
 	"github.com/futurxlab/golanggraph/logger"
@@ -79,17 +89,6 @@ func newSmsClient(config *config.Config) msgsms.SmsClient {
 	return smsClient
 }
 
-func newCaptchaClient(config *config.Config) captcha.CaptchaClient {
-	captchaClient, err := captcha.NewCaptchaClient(
-		captcha.WithAccessKeyId(config.Captcha.AccessKeyID),
-		captcha.WithAccessKeySecret(config.Captcha.AccessKeySecret),
-	)
-	if err != nil {
-		return nil
-	}
-	return captchaClient
-}
-
 func newMailClient(config *config.Config) *resend.ResendClient {
 	client := resend.NewResendClient(
 		resend.WithAPIKey(config.Mail.ResendAPIKey),
@@ -118,6 +117,19 @@ func newStripeClient(cfg *config.Config) *stripe.StripeClient {
 	)
 }
 
+// newRedisClient nil without an address, dependents then keep their state in memory
+func newRedisClient(cfg *config.Config) *redis.Client {
+	if cfg.Redis.Addr == "" {
+		return nil
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+}
+
 var Module = fx.Provide(
 	// oss client
 	newOSSClient,
@@ -136,10 +148,45 @@ var Module = fx.Provide(
 		}),
 	),
 
+	// redis client
+	fx.Annotate(
+		newRedisClient,
+		fx.OnStop(func(client *redis.Client) error {
+			if client == nil {
+				return nil
+			}
+			return client.Close()
+		}),
+	),
+
 	// jwt
 	jwt.NewRSA,
 	jwt.NewJWTHelper,
 
+	// access token revocation
+	revocation.NewStore,
+
+	// one-time identifiers
+	replay.NewStore,
+
+	// sliding window rate limits
+	ratelimit.NewLimiter,
+
+	// password hashing
+	password.NewHasher,
+
+	// sign in with apple
+	apple.NewKeySetFetcher,
+	apple.NewClient,
+
+	// generic openid connect federation
+	oidc.NewFetcher,
+	oidc.NewClient,
+
+	// wechat official account scan to follow login
+	wechat.NewOfficialAccountAPI,
+	wechat.NewOfficialAccount,
+
 	// initialize the repository modules
 	fx.Annotate(
 		repository.NewClient,
@@ -225,14 +272,107 @@ var Module = fx.Provide(
 		fx.As(new(contract.IMailVertifyCodeWriteRepository)),
 	),
 
+	fx.Annotate(
+		repository.NewOAuthAuthorizationCodeImpl,
+		fx.As(new(contract.IOAuthAuthorizationCodeRepository)),
+		fx.As(new(contract.IOAuthAuthorizationCodeReadRepository)),
+		fx.As(new(contract.IOAuthAuthorizationCodeWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewOAuthConsentImpl,
+		fx.As(new(contract.IOAuthConsentRepository)),
+		fx.As(new(contract.IOAuthConsentReadRepository)),
+		fx.As(new(contract.IOAuthConsentWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewPasskeyCredentialImpl,
+		fx.As(new(contract.IPasskeyCredentialRepository)),
+		fx.As(new(contract.IPasskeyCredentialReadRepository)),
+		fx.As(new(contract.IPasskeyCredentialWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewWebAuthnChallengeImpl,
+		fx.As(new(contract.IWebAuthnChallengeRepository)),
+		fx.As(new(contract.IWebAuthnChallengeReadRepository)),
+		fx.As(new(contract.IWebAuthnChallengeWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewRotatedRefreshTokenImpl,
+		fx.As(new(contract.IRotatedRefreshTokenRepository)),
+		fx.As(new(contract.IRotatedRefreshTokenReadRepository)),
+		fx.As(new(contract.IRotatedRefreshTokenWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewIdentityProviderImpl,
+		fx.As(new(contract.IIdentityProviderRepository)),
+		fx.As(new(contract.IIdentityProviderReadRepository)),
+		fx.As(new(contract.IIdentityProviderWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewSAMLConnectionImpl,
+		fx.As(new(contract.ISAMLConnectionRepository)),
+		fx.As(new(contract.ISAMLConnectionReadRepository)),
+		fx.As(new(contract.ISAMLConnectionWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewQyWechatCorpImpl,
+		fx.As(new(contract.IQyWechatCorpRepository)),
+		fx.As(new(contract.IQyWechatCorpReadRepository)),
+		fx.As(new(contract.IQyWechatCorpWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewMagicLinkImpl,
+		fx.As(new(contract.IMagicLinkRepository)),
+		fx.As(new(contract.IMagicLinkReadRepository)),
+		fx.As(new(contract.IMagicLinkWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewQRLoginImpl,
+		fx.As(new(contract.IQRLoginRepository)),
+		fx.As(new(contract.IQRLoginReadRepository)),
+		fx.As(new(contract.IQRLoginWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewWechatScanLoginImpl,
+		fx.As(new(contract.IWechatScanLoginRepository)),
+		fx.As(new(contract.IWechatScanLoginReadRepository)),
+		fx.As(new(contract.IWechatScanLoginWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewLoginLockImpl,
+		fx.As(new(contract.ILoginLockRepository)),
+		fx.As(new(contract.ILoginLockReadRepository)),
+		fx.As(new(contract.ILoginLockWriteRepository)),
+	),
+
+	fx.Annotate(
+		repository.NewSmsVerifyCodeImpl,
+		fx.As(new(contract.ISmsVerifyCodeRepository)),
+		fx.As(new(contract.ISmsVerifyCodeReadRepository)),
+		fx.As(new(contract.ISmsVerifyCodeWriteRepository)),
+	),
+
 	// sms
 	newSmsClient,
+	sms.NewSenders,
 
 	// mail
 	newMailClient,
+	mail.NewMagicLinkMailer,
 
 	// captcha
-	newCaptchaClient,
+	captcha.NewVerifier,
 	cache.NewMemCache,
 
 	// stripe client
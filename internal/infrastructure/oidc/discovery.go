@@ -0,0 +1,173 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// Metadata the subset of the provider metadata (OpenID Connect Discovery 1.0) a relying party needs
+type Metadata struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+// Fetcher fetches discovery documents and keysets, injectable so tests run without a provider
+type Fetcher interface {
+	FetchMetadata(ctx context.Context, issuer string) (*Metadata, error)
+	FetchKeySet(ctx context.Context, jwksURI string) (map[string]crypto.PublicKey, error)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type httpFetcher struct {
+	httpClient *xhttp.Client
+}
+
+func NewFetcher(httpClient *xhttp.Client) Fetcher {
+	return &httpFetcher{httpClient: httpClient}
+}
+
+func (f *httpFetcher) FetchMetadata(ctx context.Context, issuer string) (*Metadata, error) {
+	metadata := &Metadata{}
+	if err := f.getJSON(ctx, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", metadata); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	// a document served for another issuer must not be trusted, see section 4.3 of the discovery spec
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, xerror.Wrap(fmt.Errorf("discovered issuer %s does not match %s", metadata.Issuer, issuer))
+	}
+
+	return metadata, nil
+}
+
+func (f *httpFetcher) FetchKeySet(ctx context.Context, jwksURI string) (map[string]crypto.PublicKey, error) {
+	keySet := &jsonWebKeySet{}
+	if err := f.getJSON(ctx, jwksURI, keySet); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(keySet.Keys))
+	for _, key := range keySet.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		publicKey, err := parsePublicKey(key)
+		if err != nil {
+			// keys of unsupported types are skipped, the others still verify
+			continue
+		}
+
+		keys[key.Kid] = publicKey
+	}
+
+	return keys, nil
+}
+
+func (f *httpFetcher) getJSON(ctx context.Context, url string, v any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+	request.Header.Set("Accept", "application/json")
+
+	resp, err := f.httpClient.Do(request)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return xerror.Wrap(fmt.Errorf("get %s failed: %s", url, resp.Status))
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func parsePublicKey(key jsonWebKey) (crypto.PublicKey, error) {
+	switch key.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch key.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, xerror.Wrap(fmt.Errorf("unsupported curve %s", key.Crv))
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(key.Y)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+	default:
+		return nil, xerror.Wrap(fmt.Errorf("unsupported key type %s", key.Kty))
+	}
+}
@@ -0,0 +1,599 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// Relying party of the authorization code flow (OpenID Connect Core 1.0, section 3.1) against any
+// provider registered by an admin. Providers without an id token, GitHub for instance, are supported
+// through their userinfo endpoint: the identity then comes from the access token alone.
+
+const (
+	metadataCacheTTL = time.Hour
+	keySetCacheTTL   = time.Hour
+	// keySetRefreshInterval limits refetches caused by tokens with an unknown kid
+	keySetRefreshInterval = time.Minute
+	// clockSkew tolerated on exp and iat of id tokens
+	clockSkew = time.Minute
+
+	ClaimSubject       = "subject"
+	ClaimEmail         = "email"
+	ClaimEmailVerified = "email_verified"
+	ClaimName          = "name"
+	ClaimPicture       = "picture"
+)
+
+var (
+	ErrInvalidIDToken   = errors.New("invalid id token")
+	ErrSubjectNotFound  = errors.New("subject claim not found")
+	ErrEndpointNotFound = errors.New("provider endpoint not found")
+
+	defaultScopes = []string{"openid", "email", "profile"}
+
+	defaultClaimMapping = map[string]string{
+		ClaimSubject:       "sub",
+		ClaimEmail:         "email",
+		ClaimEmailVerified: "email_verified",
+		ClaimName:          "name",
+		ClaimPicture:       "picture",
+	}
+)
+
+// Provider the registration of a provider, endpoints left empty are discovered from the issuer
+type Provider struct {
+	Issuer                string
+	ClientID              string
+	ClientSecret          string
+	Scopes                []string
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	UserinfoEndpoint      string
+	JWKSURI               string
+	// ClaimMapping claim names by ClaimSubject, ClaimEmail..., the standard claims when missing
+	ClaimMapping map[string]string
+}
+
+// Identity the mapped claims of the end user
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IDToken          string `json:"id_token"`
+	TokenType        string `json:"token_type"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type cachedMetadata struct {
+	metadata  *Metadata
+	fetchedAt time.Time
+}
+
+type cachedKeySet struct {
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+type Client struct {
+	httpClient *xhttp.Client
+	fetcher    Fetcher
+
+	mu       sync.RWMutex
+	metadata map[string]*cachedMetadata
+	keySets  map[string]*cachedKeySet
+}
+
+func NewClient(httpClient *xhttp.Client, fetcher Fetcher) *Client {
+	return &Client{
+		httpClient: httpClient,
+		fetcher:    fetcher,
+		metadata:   make(map[string]*cachedMetadata),
+		keySets:    make(map[string]*cachedKeySet),
+	}
+}
+
+// AuthorizationURL the url the browser is sent to, state and nonce come back with the code and in the id token
+func (c *Client) AuthorizationURL(ctx context.Context, provider *Provider, redirectURI string, state string, nonce string) (string, error) {
+	endpoints, err := c.endpoints(ctx, provider)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	if endpoints.AuthorizationEndpoint == "" {
+		return "", xerror.Wrap(ErrEndpointNotFound)
+	}
+
+	scopes := provider.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	u, err := url.Parse(endpoints.AuthorizationEndpoint)
+	if err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	query := u.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.ClientID)
+	query.Set("redirect_uri", redirectURI)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// Identity exchanges the code and maps the claims of the id token and the userinfo endpoint
+func (c *Client) Identity(ctx context.Context, provider *Provider, code string, redirectURI string, nonce string) (*Identity, error) {
+	endpoints, err := c.endpoints(ctx, provider)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	tokens, err := c.exchange(ctx, provider, endpoints, code, redirectURI)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	claims := map[string]any{}
+
+	if tokens.IDToken != "" {
+		claims, err = c.VerifyIDToken(ctx, provider, endpoints, tokens.IDToken, nonce)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+	} else if endpoints.UserinfoEndpoint == "" {
+		// without an id token nothing but the userinfo endpoint tells who logged in
+		return nil, xerror.Wrap(ErrEndpointNotFound)
+	}
+
+	if endpoints.UserinfoEndpoint != "" && tokens.AccessToken != "" {
+		userinfo, err := c.userinfo(ctx, endpoints.UserinfoEndpoint, tokens.AccessToken)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		// the userinfo subject must be the id token subject, section 5.3.2
+		if sub, ok := claims["sub"]; ok && claimString(userinfo["sub"]) != "" && claimString(userinfo["sub"]) != claimString(sub) {
+			return nil, xerror.Wrap(ErrInvalidIDToken)
+		}
+
+		for name, value := range userinfo {
+			if _, ok := claims[name]; !ok {
+				claims[name] = value
+			}
+		}
+	}
+
+	return MapClaims(claims, provider.ClaimMapping)
+}
+
+// VerifyIDToken checks the signature with the provider keyset and the iss, aud, azp, exp, iat, nbf and nonce claims
+func (c *Client) VerifyIDToken(ctx context.Context, provider *Provider, endpoints *Metadata, idToken string, nonce string) (map[string]any, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	head := struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}{}
+	if err := decodeSegment(parts[0], &head); err != nil {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	if endpoints.JWKSURI == "" {
+		return nil, xerror.Wrap(ErrEndpointNotFound)
+	}
+
+	publicKey, err := c.publicKey(ctx, endpoints.JWKSURI, head.Kid)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if err := verifySignature(head.Alg, publicKey, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	claims := map[string]any{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	if strings.TrimSuffix(claimString(claims["iss"]), "/") != strings.TrimSuffix(provider.Issuer, "/") {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	if !audienceContains(claims["aud"], provider.ClientID) {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	// a token for several audiences must name us as the party it was issued to, section 3.1.3.7
+	azp := claimString(claims["azp"])
+	if audiences, ok := claims["aud"].([]any); (ok && len(audiences) > 1 && azp == "") || (azp != "" && azp != provider.ClientID) {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	now := time.Now()
+
+	expiresAt, ok := numericDate(claims["exp"])
+	if !ok || expiresAt.Add(clockSkew).Before(now) {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	issuedAt, ok := numericDate(claims["iat"])
+	if !ok || issuedAt.After(now.Add(clockSkew)) {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	if _, present := claims["nbf"]; present {
+		notBefore, ok := numericDate(claims["nbf"])
+		if !ok || notBefore.After(now.Add(clockSkew)) {
+			return nil, xerror.Wrap(ErrInvalidIDToken)
+		}
+	}
+
+	if claimString(claims["nonce"]) != nonce {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	return claims, nil
+}
+
+// MapClaims picks the identity out of the claims, numeric subjects such as GitHub user ids become strings
+func MapClaims(claims map[string]any, mapping map[string]string) (*Identity, error) {
+	claimName := func(key string) string {
+		if name := mapping[key]; name != "" {
+			return name
+		}
+		return defaultClaimMapping[key]
+	}
+
+	identity := &Identity{
+		Subject: claimString(claims[claimName(ClaimSubject)]),
+		Email:   claimString(claims[claimName(ClaimEmail)]),
+		Name:    claimString(claims[claimName(ClaimName)]),
+		Picture: claimString(claims[claimName(ClaimPicture)]),
+	}
+
+	switch verified := claims[claimName(ClaimEmailVerified)].(type) {
+	case bool:
+		identity.EmailVerified = verified
+	case string:
+		identity.EmailVerified = verified == "true"
+	}
+
+	if identity.Subject == "" {
+		return nil, xerror.Wrap(ErrSubjectNotFound)
+	}
+
+	return identity, nil
+}
+
+// endpoints the configured endpoints, completed from discovery when one is missing
+func (c *Client) endpoints(ctx context.Context, provider *Provider) (*Metadata, error) {
+	endpoints := &Metadata{
+		Issuer:                provider.Issuer,
+		AuthorizationEndpoint: provider.AuthorizationEndpoint,
+		TokenEndpoint:         provider.TokenEndpoint,
+		UserinfoEndpoint:      provider.UserinfoEndpoint,
+		JWKSURI:               provider.JWKSURI,
+	}
+
+	if endpoints.AuthorizationEndpoint != "" && endpoints.TokenEndpoint != "" &&
+		(endpoints.JWKSURI != "" || endpoints.UserinfoEndpoint != "") {
+		return endpoints, nil
+	}
+
+	metadata, err := c.discover(ctx, provider.Issuer)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if endpoints.AuthorizationEndpoint == "" {
+		endpoints.AuthorizationEndpoint = metadata.AuthorizationEndpoint
+	}
+	if endpoints.TokenEndpoint == "" {
+		endpoints.TokenEndpoint = metadata.TokenEndpoint
+	}
+	if endpoints.UserinfoEndpoint == "" {
+		endpoints.UserinfoEndpoint = metadata.UserinfoEndpoint
+	}
+	if endpoints.JWKSURI == "" {
+		endpoints.JWKSURI = metadata.JWKSURI
+	}
+	endpoints.TokenEndpointAuthMethodsSupported = metadata.TokenEndpointAuthMethodsSupported
+
+	return endpoints, nil
+}
+
+func (c *Client) discover(ctx context.Context, issuer string) (*Metadata, error) {
+	c.mu.RLock()
+	cached, ok := c.metadata[issuer]
+	c.mu.RUnlock()
+
+	if ok && time.Since(cached.fetchedAt) < metadataCacheTTL {
+		return cached.metadata, nil
+	}
+
+	metadata, err := c.fetcher.FetchMetadata(ctx, issuer)
+	if err != nil {
+		// a stale document still works while the provider is unreachable
+		if ok {
+			return cached.metadata, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	c.mu.Lock()
+	c.metadata[issuer] = &cachedMetadata{metadata: metadata, fetchedAt: time.Now()}
+	c.mu.Unlock()
+
+	return metadata, nil
+}
+
+// publicKey looks the kid up in the cached keyset, providers rotate keys so an unknown kid refetches it
+func (c *Client) publicKey(ctx context.Context, jwksURI string, kid string) (crypto.PublicKey, error) {
+	c.mu.RLock()
+	cached, cachedOK := c.keySets[jwksURI]
+	c.mu.RUnlock()
+
+	var key crypto.PublicKey
+	found := false
+	if cachedOK {
+		key, found = cached.keys[kid]
+
+		if found && time.Since(cached.fetchedAt) < keySetCacheTTL {
+			return key, nil
+		}
+
+		if !found && time.Since(cached.fetchedAt) < keySetRefreshInterval {
+			return nil, xerror.Wrap(ErrInvalidIDToken)
+		}
+	}
+
+	keys, err := c.fetcher.FetchKeySet(ctx, jwksURI)
+	if err != nil {
+		if found {
+			return key, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	c.mu.Lock()
+	c.keySets[jwksURI] = &cachedKeySet{keys: keys, fetchedAt: time.Now()}
+	c.mu.Unlock()
+
+	key, found = keys[kid]
+	if !found {
+		return nil, xerror.Wrap(ErrInvalidIDToken)
+	}
+
+	return key, nil
+}
+
+func (c *Client) exchange(ctx context.Context, provider *Provider, endpoints *Metadata, code string, redirectURI string) (*tokenResponse, error) {
+	if endpoints.TokenEndpoint == "" {
+		return nil, xerror.Wrap(ErrEndpointNotFound)
+	}
+
+	data := url.Values{}
+	data.Set("grant_type", "authorization_code")
+	data.Set("code", code)
+	data.Set("redirect_uri", redirectURI)
+
+	// client_secret_post unless the provider only takes client_secret_basic
+	basicAuth := len(endpoints.TokenEndpointAuthMethodsSupported) > 0 &&
+		!slices.Contains(endpoints.TokenEndpointAuthMethodsSupported, "client_secret_post")
+	if !basicAuth {
+		data.Set("client_id", provider.ClientID)
+		data.Set("client_secret", provider.ClientSecret)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoints.TokenEndpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// github answers form encoded without it
+	request.Header.Set("Accept", "application/json")
+
+	if basicAuth {
+		request.SetBasicAuth(url.QueryEscape(provider.ClientID), url.QueryEscape(provider.ClientSecret))
+	}
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	tokens := &tokenResponse{}
+	if err := json.Unmarshal(b, tokens); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if tokens.Error != "" {
+		return nil, xerror.Wrap(fmt.Errorf("failed to exchange code for token: %s - %s", tokens.Error, tokens.ErrorDescription))
+	}
+
+	if tokens.IDToken == "" && tokens.AccessToken == "" {
+		return nil, xerror.Wrap(errors.New("token response is empty"))
+	}
+
+	return tokens, nil
+}
+
+func (c *Client) userinfo(ctx context.Context, userinfoEndpoint string, accessToken string) (map[string]any, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, userinfoEndpoint, nil)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+	request.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, xerror.Wrap(fmt.Errorf("userinfo request failed: %s", resp.Status))
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	claims := map[string]any{}
+	if err := decodeJSON(b, &claims); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return claims, nil
+}
+
+func verifySignature(alg string, publicKey crypto.PublicKey, signingInput []byte, signature []byte) error {
+	if len(alg) != 5 {
+		return ErrInvalidIDToken
+	}
+
+	var hasher hash.Hash
+	var hashFunc crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hasher, hashFunc = sha256.New(), crypto.SHA256
+	case "384":
+		hasher, hashFunc = sha512.New384(), crypto.SHA384
+	case "512":
+		hasher, hashFunc = sha512.New(), crypto.SHA512
+	default:
+		return ErrInvalidIDToken
+	}
+	hasher.Write(signingInput)
+	digest := hasher.Sum(nil)
+
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		switch alg[:2] {
+		case "RS":
+			return rsa.VerifyPKCS1v15(key, hashFunc, digest, signature)
+		case "PS":
+			return rsa.VerifyPSS(key, hashFunc, digest, signature, nil)
+		}
+	case *ecdsa.PublicKey:
+		if alg[:2] != "ES" || len(signature)%2 != 0 {
+			return ErrInvalidIDToken
+		}
+
+		// JWS signatures are the fixed size r || s
+		r := new(big.Int).SetBytes(signature[:len(signature)/2])
+		s := new(big.Int).SetBytes(signature[len(signature)/2:])
+		if ecdsa.Verify(key, digest, r, s) {
+			return nil
+		}
+	}
+
+	// "none" and symmetric algorithms are refused
+	return ErrInvalidIDToken
+}
+
+func audienceContains(aud any, clientID string) bool {
+	switch audience := aud.(type) {
+	case string:
+		return audience == clientID
+	case []any:
+		for _, a := range audience {
+			if claimString(a) == clientID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// numericDate a time claim in seconds since the epoch
+func numericDate(value any) (time.Time, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	seconds, err := number.Int64()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(seconds, 0), true
+}
+
+func claimString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+func decodeSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return decodeJSON(b, v)
+}
+
+// decodeJSON keeps numbers as json.Number, float64 would mangle large numeric subjects
+func decodeJSON(b []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
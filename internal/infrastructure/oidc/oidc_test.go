@@ -0,0 +1,208 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const testIssuer = "https://idp.example.com"
+
+type fakeFetcher struct {
+	metadata *Metadata
+	keys     map[string]crypto.PublicKey
+	fetches  int
+}
+
+func (f *fakeFetcher) FetchMetadata(ctx context.Context, issuer string) (*Metadata, error) {
+	return f.metadata, nil
+}
+
+func (f *fakeFetcher) FetchKeySet(ctx context.Context, jwksURI string) (map[string]crypto.PublicKey, error) {
+	f.fetches++
+	return f.keys, nil
+}
+
+func signToken(t *testing.T, alg string, key crypto.Signer, kid string, claims map[string]any) string {
+	t.Helper()
+
+	head, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid})
+	payload, _ := json.Marshal(claims)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(head) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func newTestClient(t *testing.T) (*Client, *rsa.PrivateKey, *ecdsa.PrivateKey, *fakeFetcher) {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetcher := &fakeFetcher{
+		metadata: &Metadata{
+			Issuer:                testIssuer,
+			AuthorizationEndpoint: testIssuer + "/authorize",
+			TokenEndpoint:         testIssuer + "/token",
+			JWKSURI:               testIssuer + "/jwks",
+		},
+		keys: map[string]crypto.PublicKey{
+			"rsa-1": &rsaKey.PublicKey,
+			"ec-1":  &ecKey.PublicKey,
+		},
+	}
+
+	return NewClient(nil, fetcher), rsaKey, ecKey, fetcher
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"iss":   testIssuer,
+		"sub":   "user-1",
+		"aud":   "client-1",
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"nonce": "nonce-1",
+		"email": "user@example.com",
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	client, rsaKey, ecKey, fetcher := newTestClient(t)
+	provider := &Provider{Issuer: testIssuer, ClientID: "client-1"}
+
+	tests := []struct {
+		name    string
+		alg     string
+		key     crypto.Signer
+		kid     string
+		modify  func(claims map[string]any)
+		wantErr bool
+	}{
+		{name: "rs256", alg: "RS256", key: rsaKey, kid: "rsa-1"},
+		{name: "es256", alg: "ES256", key: ecKey, kid: "ec-1"},
+		{name: "audience list", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["aud"] = []string{"other", "client-1"}; c["azp"] = "client-1" }},
+		{name: "audience list without azp", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["aud"] = []string{"other", "client-1"} }, wantErr: true},
+		{name: "issued to another party", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["aud"] = []string{"other", "client-1"}; c["azp"] = "other" }, wantErr: true},
+		{name: "azp of a single audience", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["azp"] = "client-1" }},
+		{name: "wrong audience", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["aud"] = "other" }, wantErr: true},
+		{name: "wrong issuer", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["iss"] = "https://evil.example.com" }, wantErr: true},
+		{name: "wrong nonce", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["nonce"] = "other" }, wantErr: true},
+		{name: "expired", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["exp"] = time.Now().Add(-time.Hour).Unix() }, wantErr: true},
+		{name: "without iat", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { delete(c, "iat") }, wantErr: true},
+		{name: "issued in the future", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["iat"] = time.Now().Add(time.Hour).Unix() }, wantErr: true},
+		{name: "not yet valid", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["nbf"] = time.Now().Add(time.Hour).Unix() }, wantErr: true},
+		{name: "valid since", alg: "RS256", key: rsaKey, kid: "rsa-1", modify: func(c map[string]any) { c["nbf"] = time.Now().Unix() }},
+		{name: "algorithm of another key", alg: "ES256", key: ecKey, kid: "rsa-1", wantErr: true},
+		{name: "unknown kid", alg: "RS256", key: rsaKey, kid: "rsa-2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validClaims()
+			if tt.modify != nil {
+				tt.modify(claims)
+			}
+
+			token := signToken(t, tt.alg, tt.key, tt.kid, claims)
+
+			endpoints, err := client.endpoints(context.Background(), provider)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = client.VerifyIDToken(context.Background(), provider, endpoints, token, "nonce-1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyIDToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// an unknown kid within the refresh interval of the last fetch does not refetch the keyset
+	if fetcher.fetches != 1 {
+		t.Fatalf("keyset fetched %d times, want 1", fetcher.fetches)
+	}
+}
+
+func TestMapClaims(t *testing.T) {
+	claims := map[string]any{}
+	if err := decodeJSON([]byte(`{"id": 12345678901234567, "login": "octocat", "email": "octo@example.com", "email_verified": "true"}`), &claims); err != nil {
+		t.Fatal(err)
+	}
+
+	identity, err := MapClaims(claims, map[string]string{ClaimSubject: "id", ClaimName: "login"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if identity.Subject != "12345678901234567" || identity.Name != "octocat" || identity.Email != "octo@example.com" || !identity.EmailVerified {
+		t.Fatalf("unexpected identity %+v", identity)
+	}
+
+	if _, err := MapClaims(claims, nil); err == nil {
+		t.Fatal("expected missing subject error")
+	}
+}
+
+func TestAuthorizationURL(t *testing.T) {
+	client, _, _, _ := newTestClient(t)
+
+	authorizationURL, err := client.AuthorizationURL(context.Background(), &Provider{
+		Issuer:   testIssuer,
+		ClientID: "client-1",
+	}, "https://app.example.com/callback", "state-1", "nonce-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(authorizationURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := u.Query()
+	if u.Host != "idp.example.com" || u.Path != "/authorize" ||
+		query.Get("client_id") != "client-1" ||
+		query.Get("scope") != "openid email profile" ||
+		query.Get("state") != "state-1" ||
+		query.Get("nonce") != "nonce-1" ||
+		query.Get("response_type") != "code" {
+		t.Fatalf("unexpected authorization url %s", authorizationURL)
+	}
+}
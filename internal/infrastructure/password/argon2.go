@@ -0,0 +1,69 @@
+package password
+
+import (
+	"crypto/subtle"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idID     = "argon2id"
+	argon2KeyLen   = 32
+	argon2SaltLen  = 16
+	argon2MaxParam = 1 << 22
+)
+
+type argon2idAlgorithm struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+func (a *argon2idAlgorithm) ID() string {
+	return argon2idID
+}
+
+func (a *argon2idAlgorithm) Hash(password string) (string, error) {
+	salt, err := randomSalt(argon2SaltLen)
+	if err != nil {
+		return "", err
+	}
+
+	p := &phc{
+		ID:      argon2idID,
+		Version: argon2.Version,
+		Params: map[string]int{
+			"m": int(a.memory),
+			"t": int(a.iterations),
+			"p": int(a.parallelism),
+		},
+		Salt: salt,
+		Hash: argon2.IDKey([]byte(password), salt, a.iterations, a.memory, a.parallelism, argon2KeyLen),
+	}
+
+	return p.String("m", "t", "p"), nil
+}
+
+func (a *argon2idAlgorithm) Verify(password string, p *phc) (bool, error) {
+	if p.Version != argon2.Version {
+		return false, ErrInvalidHash
+	}
+
+	memory, iterations, parallelism := p.Params["m"], p.Params["t"], p.Params["p"]
+	if memory <= 0 || memory > argon2MaxParam ||
+		iterations <= 0 || iterations > argon2MaxParam ||
+		parallelism <= 0 || parallelism > 255 {
+		return false, ErrInvalidHash
+	}
+
+	key := argon2.IDKey([]byte(password), p.Salt, uint32(iterations), uint32(memory), uint8(parallelism), uint32(len(p.Hash)))
+
+	return subtle.ConstantTimeCompare(key, p.Hash) == 1, nil
+}
+
+func (a *argon2idAlgorithm) NeedsRehash(p *phc) bool {
+	return p.Params["m"] != int(a.memory) ||
+		p.Params["t"] != int(a.iterations) ||
+		p.Params["p"] != int(a.parallelism) ||
+		len(p.Hash) != argon2KeyLen
+}
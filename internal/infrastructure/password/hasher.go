@@ -0,0 +1,99 @@
+package password
+
+import (
+	"crypto/rand"
+	"errors"
+	"kiwi-user/config"
+	"strings"
+)
+
+var ErrUnsupportedAlgorithm = errors.New("unsupported password hash algorithm")
+
+type algorithm interface {
+	// ID the PHC identifier
+	ID() string
+	Hash(password string) (string, error)
+	Verify(password string, p *phc) (bool, error)
+	// NeedsRehash reports hashes made with other parameters than the configured ones
+	NeedsRehash(p *phc) bool
+}
+
+// Hasher hashes new passwords with the configured algorithm and verifies every format ever stored:
+// PHC strings of argon2id and scrypt, bcrypt hashes of imported users and legacy bare scrypt digests
+type Hasher struct {
+	current    algorithm
+	algorithms map[string]algorithm
+}
+
+func NewHasher(config *config.Config) (*Hasher, error) {
+	argon2id := &argon2idAlgorithm{
+		memory:      uint32(config.Password.Argon2Memory),
+		iterations:  uint32(config.Password.Argon2Iterations),
+		parallelism: uint8(config.Password.Argon2Parallelism),
+	}
+
+	scrypt := &scryptAlgorithm{
+		logN: config.Password.ScryptLogN,
+		r:    config.Password.ScryptR,
+		p:    config.Password.ScryptP,
+	}
+
+	h := &Hasher{
+		algorithms: map[string]algorithm{
+			argon2id.ID(): argon2id,
+			scrypt.ID():   scrypt,
+		},
+	}
+
+	current, ok := h.algorithms[config.Password.Algorithm]
+	if !ok {
+		return nil, ErrUnsupportedAlgorithm
+	}
+	h.current = current
+
+	return h, nil
+}
+
+// Hash returns a PHC string, the salt is part of it
+func (h *Hasher) Hash(password string) (string, error) {
+	return h.current.Hash(password)
+}
+
+// Verify checks password against a stored hash. legacySalt is the binding salt of rows written
+// before hashes were PHC strings, it is ignored otherwise. needsRehash is set when the password
+// matched but the hash should be replaced by one of Hash.
+func (h *Hasher) Verify(password string, encoded string, legacySalt string) (ok bool, needsRehash bool, err error) {
+	switch {
+	case !strings.HasPrefix(encoded, "$"):
+		ok, err = verifyLegacyScrypt(password, encoded, legacySalt)
+		return ok, ok, err
+	case isBcryptHash(encoded):
+		ok, err = verifyBcrypt(password, encoded)
+		return ok, ok, err
+	}
+
+	p, err := parsePHC(encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	algorithm, found := h.algorithms[p.ID]
+	if !found {
+		return false, false, ErrUnsupportedAlgorithm
+	}
+
+	ok, err = algorithm.Verify(password, p)
+	if err != nil || !ok {
+		return false, false, err
+	}
+
+	return true, algorithm != h.current || algorithm.NeedsRehash(p), nil
+}
+
+func randomSalt(n int) ([]byte, error) {
+	salt := make([]byte, n)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
@@ -0,0 +1,130 @@
+package password
+
+import (
+	"kiwi-user/config"
+	"kiwi-user/internal/infrastructure/utils"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTestHasher(t *testing.T, algorithm string) *Hasher {
+	t.Helper()
+
+	h, err := NewHasher(&config.Config{
+		Password: &config.PasswordConfig{
+			Algorithm:         algorithm,
+			Argon2Memory:      1024,
+			Argon2Iterations:  1,
+			Argon2Parallelism: 1,
+			ScryptLogN:        10,
+			ScryptR:           8,
+			ScryptP:           1,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewHasher: %v", err)
+	}
+	return h
+}
+
+func TestHashAndVerify(t *testing.T) {
+	for _, algorithm := range []string{argon2idID, scryptID} {
+		h := newTestHasher(t, algorithm)
+
+		encoded, err := h.Hash("correct horse")
+		if err != nil {
+			t.Fatalf("%s: Hash: %v", algorithm, err)
+		}
+
+		if !strings.HasPrefix(encoded, "$"+algorithm+"$") {
+			t.Fatalf("%s: unexpected encoding %q", algorithm, encoded)
+		}
+
+		ok, needsRehash, err := h.Verify("correct horse", encoded, "")
+		if err != nil || !ok || needsRehash {
+			t.Fatalf("%s: Verify = %v, %v, %v", algorithm, ok, needsRehash, err)
+		}
+
+		ok, _, err = h.Verify("wrong horse", encoded, "")
+		if err != nil || ok {
+			t.Fatalf("%s: wrong password verified: %v, %v", algorithm, ok, err)
+		}
+	}
+}
+
+func TestVerifyNeedsRehash(t *testing.T) {
+	scrypt := newTestHasher(t, scryptID)
+	argon2id := newTestHasher(t, argon2idID)
+
+	encoded, err := scrypt.Hash("secret")
+	if err != nil {
+		t.Fatalf("Hash: %v", err)
+	}
+
+	ok, needsRehash, err := argon2id.Verify("secret", encoded, "")
+	if err != nil || !ok || !needsRehash {
+		t.Fatalf("other algorithm: Verify = %v, %v, %v", ok, needsRehash, err)
+	}
+
+	encoded, err = argon2id.Hash("secret")
+	if err != nil {
+		t.Fatalf("Hash: %v", err)
+	}
+
+	argon2id.current.(*argon2idAlgorithm).iterations = 2
+	ok, needsRehash, err = argon2id.Verify("secret", encoded, "")
+	if err != nil || !ok || !needsRehash {
+		t.Fatalf("changed parameters: Verify = %v, %v, %v", ok, needsRehash, err)
+	}
+}
+
+func TestVerifyLegacyScrypt(t *testing.T) {
+	h := newTestHasher(t, argon2idID)
+
+	salt := utils.RandomSalt("alice")
+	digest, err := utils.EncodePassword("secret", salt)
+	if err != nil {
+		t.Fatalf("EncodePassword: %v", err)
+	}
+
+	ok, needsRehash, err := h.Verify("secret", digest, salt)
+	if err != nil || !ok || !needsRehash {
+		t.Fatalf("Verify = %v, %v, %v", ok, needsRehash, err)
+	}
+
+	ok, _, err = h.Verify("other", digest, salt)
+	if err != nil || ok {
+		t.Fatalf("wrong password verified: %v, %v", ok, err)
+	}
+}
+
+func TestVerifyBcrypt(t *testing.T) {
+	h := newTestHasher(t, argon2idID)
+
+	encoded, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword: %v", err)
+	}
+
+	ok, needsRehash, err := h.Verify("secret", string(encoded), "")
+	if err != nil || !ok || !needsRehash {
+		t.Fatalf("Verify = %v, %v, %v", ok, needsRehash, err)
+	}
+
+	ok, _, err = h.Verify("other", string(encoded), "")
+	if err != nil || ok {
+		t.Fatalf("wrong password verified: %v, %v", ok, err)
+	}
+}
+
+func TestVerifyMalformed(t *testing.T) {
+	h := newTestHasher(t, argon2idID)
+
+	for _, encoded := range []string{"$", "$argon2id$v=19$m=1024,t=1,p=1$salt", "$md5$abc$def", "$argon2id$v=19$m=x$c2FsdA$aGFzaA"} {
+		if ok, _, err := h.Verify("secret", encoded, ""); err == nil || ok {
+			t.Fatalf("%q: expected error, got %v, %v", encoded, ok, err)
+		}
+	}
+}
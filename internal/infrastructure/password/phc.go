@@ -0,0 +1,107 @@
+package password
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidHash = errors.New("invalid password hash")
+
+// phc a hash in PHC string format: $<id>[$v=<version>][$<param>=<value>(,<param>=<value>)*][$<salt>[$<hash>]]
+// see https://github.com/P-H-C/phc-string-format
+type phc struct {
+	ID      string
+	Version int
+	Params  map[string]int
+	Salt    []byte
+	Hash    []byte
+}
+
+var phcEncoding = base64.RawStdEncoding
+
+func parsePHC(encoded string) (*phc, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) < 2 || parts[0] != "" || parts[1] == "" {
+		return nil, ErrInvalidHash
+	}
+
+	p := &phc{
+		ID:     parts[1],
+		Params: map[string]int{},
+	}
+	parts = parts[2:]
+
+	if len(parts) > 0 && strings.HasPrefix(parts[0], "v=") {
+		version, err := strconv.Atoi(strings.TrimPrefix(parts[0], "v="))
+		if err != nil {
+			return nil, ErrInvalidHash
+		}
+		p.Version = version
+		parts = parts[1:]
+	}
+
+	if len(parts) > 0 && strings.Contains(parts[0], "=") {
+		for _, param := range strings.Split(parts[0], ",") {
+			name, value, ok := strings.Cut(param, "=")
+			if !ok {
+				return nil, ErrInvalidHash
+			}
+
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, ErrInvalidHash
+			}
+			p.Params[name] = n
+		}
+		parts = parts[1:]
+	}
+
+	if len(parts) != 2 {
+		return nil, ErrInvalidHash
+	}
+
+	var err error
+	if p.Salt, err = phcEncoding.DecodeString(parts[0]); err != nil {
+		return nil, ErrInvalidHash
+	}
+
+	if p.Hash, err = phcEncoding.DecodeString(parts[1]); err != nil || len(p.Hash) == 0 {
+		return nil, ErrInvalidHash
+	}
+
+	return p, nil
+}
+
+// String params are written in the given order, PHC requires a stable order per algorithm
+func (p *phc) String(paramOrder ...string) string {
+	var b strings.Builder
+
+	b.WriteString("$")
+	b.WriteString(p.ID)
+
+	if p.Version != 0 {
+		b.WriteString("$v=")
+		b.WriteString(strconv.Itoa(p.Version))
+	}
+
+	if len(paramOrder) > 0 {
+		b.WriteString("$")
+		for i, name := range paramOrder {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(name)
+			b.WriteString("=")
+			b.WriteString(strconv.Itoa(p.Params[name]))
+		}
+	}
+
+	b.WriteString("$")
+	b.WriteString(phcEncoding.EncodeToString(p.Salt))
+	b.WriteString("$")
+	b.WriteString(phcEncoding.EncodeToString(p.Hash))
+
+	return b.String()
+}
@@ -0,0 +1,95 @@
+package password
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	scryptID      = "scrypt"
+	scryptKeyLen  = 32
+	scryptSaltLen = 16
+	scryptMaxLogN = 20
+
+	// parameters of the bare base64 digests written before hashes were PHC strings
+	legacyScryptN = 16384
+	legacyScryptR = 8
+	legacyScryptP = 1
+)
+
+type scryptAlgorithm struct {
+	logN int
+	r    int
+	p    int
+}
+
+func (s *scryptAlgorithm) ID() string {
+	return scryptID
+}
+
+func (s *scryptAlgorithm) Hash(password string) (string, error) {
+	salt, err := randomSalt(scryptSaltLen)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := scrypt.Key([]byte(password), salt, 1<<s.logN, s.r, s.p, scryptKeyLen)
+	if err != nil {
+		return "", err
+	}
+
+	p := &phc{
+		ID: scryptID,
+		Params: map[string]int{
+			"ln": s.logN,
+			"r":  s.r,
+			"p":  s.p,
+		},
+		Salt: salt,
+		Hash: key,
+	}
+
+	return p.String("ln", "r", "p"), nil
+}
+
+func (s *scryptAlgorithm) Verify(password string, p *phc) (bool, error) {
+	logN, r, parallelism := p.Params["ln"], p.Params["r"], p.Params["p"]
+	if logN <= 0 || logN > scryptMaxLogN || r <= 0 || parallelism <= 0 {
+		return false, ErrInvalidHash
+	}
+
+	key, err := scrypt.Key([]byte(password), p.Salt, 1<<logN, r, parallelism, len(p.Hash))
+	if err != nil {
+		return false, ErrInvalidHash
+	}
+
+	return subtle.ConstantTimeCompare(key, p.Hash) == 1, nil
+}
+
+func (s *scryptAlgorithm) NeedsRehash(p *phc) bool {
+	return p.Params["ln"] != s.logN ||
+		p.Params["r"] != s.r ||
+		p.Params["p"] != s.p ||
+		len(p.Hash) != scryptKeyLen
+}
+
+// verifyLegacyScrypt checks a bare base64url digest, the salt was stored next to it in the binding
+func verifyLegacyScrypt(password string, digest string, salt string) (bool, error) {
+	if salt == "" {
+		return false, ErrInvalidHash
+	}
+
+	expected, err := base64.URLEncoding.DecodeString(digest)
+	if err != nil {
+		return false, ErrInvalidHash
+	}
+
+	key, err := scrypt.Key([]byte(password), []byte(salt), legacyScryptN, legacyScryptR, legacyScryptP, len(expected))
+	if err != nil {
+		return false, ErrInvalidHash
+	}
+
+	return subtle.ConstantTimeCompare(key, expected) == 1, nil
+}
@@ -0,0 +1,35 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+)
+
+// fallbackLimiter counts in process while redis fails. The limits then only hold per instance,
+// an outage of redis must not lift them for code sending and credential checks.
+type fallbackLimiter struct {
+	primary  Limiter
+	fallback Limiter
+	logger   logger.ILogger
+}
+
+func newFallbackLimiter(primary Limiter, fallback Limiter, logger logger.ILogger) *fallbackLimiter {
+	return &fallbackLimiter{
+		primary:  primary,
+		fallback: fallback,
+		logger:   logger,
+	}
+}
+
+func (l *fallbackLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (*Result, error) {
+	result, err := l.primary.Allow(ctx, key, limit, window)
+	if err == nil {
+		return result, nil
+	}
+
+	l.logger.Errorf(ctx, "rate limit %s failed, counting in process: %v", key, err)
+
+	return l.fallback.Allow(ctx, key, limit, window)
+}
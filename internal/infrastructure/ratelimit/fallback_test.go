@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+)
+
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (*Result, error) {
+	return nil, errors.New("redis unavailable")
+}
+
+type countingLogger struct {
+	logger.ILogger
+	errors int
+}
+
+func (l *countingLogger) Errorf(ctx context.Context, format string, args ...any) {
+	l.errors++
+}
+
+func TestFallbackLimiterCountsInProcess(t *testing.T) {
+	ctx := context.Background()
+	log := &countingLogger{}
+	limiter := newFallbackLimiter(failingLimiter{}, newMemoryLimiter(), log)
+
+	for i := 0; i < 2; i++ {
+		result, err := limiter.Allow(ctx, "mfa:ip:1.2.3.4", 2, time.Minute)
+		if err != nil || !result.Allowed {
+			t.Fatalf("expected hit %d to be allowed, got %+v %v", i, result, err)
+		}
+	}
+
+	// redis being down must not lift the limit
+	result, err := limiter.Allow(ctx, "mfa:ip:1.2.3.4", 2, time.Minute)
+	if err != nil || result.Allowed {
+		t.Fatalf("expected the third hit to be rejected, got %+v %v", result, err)
+	}
+
+	if log.errors != 3 {
+		t.Fatalf("expected every failure to be logged, got %d", log.errors)
+	}
+}
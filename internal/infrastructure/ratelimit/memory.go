@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryLimiter fallback without redis, keeps the hits of each window in process
+type memoryLimiter struct {
+	mu        sync.Mutex
+	hits      map[string]*memoryWindow
+	lastPurge time.Time
+}
+
+type memoryWindow struct {
+	// hits ordered by time, the oldest first
+	hits   []time.Time
+	window time.Duration
+}
+
+func newMemoryLimiter() *memoryLimiter {
+	return &memoryLimiter{
+		hits: make(map[string]*memoryWindow),
+	}
+}
+
+func (l *memoryLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (*Result, error) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.purge(now)
+
+	w, ok := l.hits[key]
+	if !ok {
+		w = &memoryWindow{}
+		l.hits[key] = w
+	}
+	w.window = window
+	w.trim(now)
+
+	if len(w.hits) >= limit {
+		return &Result{
+			Allowed:    false,
+			RetryAfter: w.hits[len(w.hits)-limit].Add(window).Sub(now),
+		}, nil
+	}
+
+	w.hits = append(w.hits, now)
+
+	return &Result{
+		Allowed:   true,
+		Remaining: limit - len(w.hits),
+	}, nil
+}
+
+// trim drops the hits that left the window
+func (w *memoryWindow) trim(now time.Time) {
+	start := now.Add(-w.window)
+
+	i := 0
+	for i < len(w.hits) && !w.hits[i].After(start) {
+		i++
+	}
+	w.hits = w.hits[i:]
+}
+
+// purge runs at most once a minute, callers hold the lock
+func (l *memoryLimiter) purge(now time.Time) {
+	if now.Sub(l.lastPurge) < time.Minute {
+		return
+	}
+	l.lastPurge = now
+
+	for key, w := range l.hits {
+		w.trim(now)
+		if len(w.hits) == 0 {
+			delete(l.hits, key)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiterAllow(t *testing.T) {
+	ctx := context.Background()
+	limiter := newMemoryLimiter()
+
+	for i := 0; i < 3; i++ {
+		result, err := limiter.Allow(ctx, "phone:1", 3, time.Minute)
+		if err != nil || !result.Allowed {
+			t.Fatalf("expected hit %d to be allowed, got %+v %v", i, result, err)
+		}
+		if result.Remaining != 2-i {
+			t.Fatalf("expected %d remaining, got %d", 2-i, result.Remaining)
+		}
+	}
+
+	result, err := limiter.Allow(ctx, "phone:1", 3, time.Minute)
+	if err != nil || result.Allowed {
+		t.Fatalf("expected the fourth hit to be rejected, got %+v %v", result, err)
+	}
+	if result.RetryAfter <= 0 || result.RetryAfter > time.Minute {
+		t.Fatalf("expected retry after within the window, got %v", result.RetryAfter)
+	}
+
+	result, _ = limiter.Allow(ctx, "phone:2", 3, time.Minute)
+	if !result.Allowed {
+		t.Fatal("expected other key to be allowed")
+	}
+}
+
+func TestMemoryLimiterWindowSlides(t *testing.T) {
+	ctx := context.Background()
+	limiter := newMemoryLimiter()
+
+	if result, _ := limiter.Allow(ctx, "ip:1", 1, 50*time.Millisecond); !result.Allowed {
+		t.Fatal("expected first hit to be allowed")
+	}
+
+	if result, _ := limiter.Allow(ctx, "ip:1", 1, 50*time.Millisecond); result.Allowed {
+		t.Fatal("expected second hit to be rejected")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	if result, _ := limiter.Allow(ctx, "ip:1", 1, 50*time.Millisecond); !result.Allowed {
+		t.Fatal("expected hit after the window to be allowed")
+	}
+}
+
+func TestMemoryLimiterRejectedHitsAreNotCounted(t *testing.T) {
+	ctx := context.Background()
+	limiter := newMemoryLimiter()
+
+	limiter.Allow(ctx, "email:1", 1, 50*time.Millisecond)
+	time.Sleep(30 * time.Millisecond)
+
+	// retrying while rejected must not extend the window
+	if result, _ := limiter.Allow(ctx, "email:1", 1, 50*time.Millisecond); result.Allowed {
+		t.Fatal("expected hit within the window to be rejected")
+	}
+
+	time.Sleep(30 * time.Millisecond)
+
+	if result, _ := limiter.Allow(ctx, "email:1", 1, 50*time.Millisecond); !result.Allowed {
+		t.Fatal("expected hit after the first one expired to be allowed")
+	}
+}
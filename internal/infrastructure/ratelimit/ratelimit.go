@@ -0,0 +1,46 @@
+package ratelimit
+
+import (
+	"context"
+	"kiwi-user/config"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// Limiter sliding window counter of hits per key. A hit is only counted when it is allowed, so a
+// client retrying while rejected does not push its own window further.
+type Limiter interface {
+	// Allow records a hit on key unless limit hits were already recorded within the window
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (*Result, error)
+}
+
+type Result struct {
+	Allowed bool
+	// Remaining hits left in the window after this one
+	Remaining int
+	// RetryAfter until the oldest hit of the window expires, zero when allowed
+	RetryAfter time.Duration
+}
+
+// NewLimiter counts in redis when a client is configured, limits then hold across all instances.
+// Without redis, or while it fails, every instance counts on its own.
+func NewLimiter(config *config.Config, client *redis.Client, logger logger.ILogger) Limiter {
+	if client == nil {
+		return newMemoryLimiter()
+	}
+
+	return newFallbackLimiter(newRedisLimiter(client, config.Redis.KeyPrefix), newMemoryLimiter(), logger)
+}
+
+// RetryAfterSeconds the wait in whole seconds as sent in the Retry-After header, rounded up so
+// clients do not retry before the window has room
+func RetryAfterSeconds(retryAfter time.Duration) int64 {
+	seconds := int64((retryAfter + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+
+	return seconds
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/redis/go-redis/v9"
+)
+
+// slidingWindowScript keeps the hits of a key in a sorted set scored by milliseconds. It returns
+// whether the hit is allowed, the remaining hits and the milliseconds until the next is allowed.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local member = ARGV[4]
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+
+local count = redis.call('ZCARD', key)
+if count >= limit then
+	local oldest = redis.call('ZRANGE', key, count - limit, count - limit, 'WITHSCORES')
+	return {0, 0, tonumber(oldest[2]) + window - now}
+end
+
+redis.call('ZADD', key, now, member)
+redis.call('PEXPIRE', key, window)
+
+return {1, limit - count - 1, 0}
+`)
+
+type redisLimiter struct {
+	client    *redis.Client
+	keyPrefix string
+	// sequence keeps the members of hits within the same millisecond apart
+	sequence atomic.Uint64
+}
+
+func newRedisLimiter(client *redis.Client, keyPrefix string) *redisLimiter {
+	return &redisLimiter{
+		client:    client,
+		keyPrefix: keyPrefix,
+	}
+}
+
+func (l *redisLimiter) key(key string) string {
+	return l.keyPrefix + "ratelimit:" + key
+}
+
+func (l *redisLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (*Result, error) {
+	now := time.Now().UnixMilli()
+	member := fmt.Sprintf("%d-%d", now, l.sequence.Add(1))
+
+	values, err := slidingWindowScript.Run(
+		ctx,
+		l.client,
+		[]string{l.key(key)},
+		now,
+		window.Milliseconds(),
+		limit,
+		member).Int64Slice()
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if len(values) != 3 {
+		return nil, xerror.New(fmt.Sprintf("unexpected rate limit script result: %v", values))
+	}
+
+	return &Result{
+		Allowed:    values[0] == 1,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Millisecond,
+	}, nil
+}
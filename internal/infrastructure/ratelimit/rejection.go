@@ -0,0 +1,23 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// RejectionContextKey key of the *Rejection the api server puts in the context of a request. It is
+// a string so a *gin.Context passed down as context.Context resolves it.
+const RejectionContextKey = "kiwi_user_rate_limit_rejection"
+
+// Rejection limits checked below the handlers record in it how long the client has to wait, the
+// handlers then answer 429 with Retry-After whatever error the check returned
+type Rejection struct {
+	RetryAfter time.Duration
+}
+
+// Reject records the wait of a request refused by a limit, it is dropped outside of a request
+func Reject(ctx context.Context, retryAfter time.Duration) {
+	if rejection, ok := ctx.Value(RejectionContextKey).(*Rejection); ok {
+		rejection.RetryAfter = retryAfter
+	}
+}
@@ -0,0 +1,54 @@
+package replay
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryStore fallback without redis, entries are dropped once they expired
+type memoryStore struct {
+	mu        sync.Mutex
+	used      map[string]time.Time
+	lastPurge time.Time
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		used: make(map[string]time.Time),
+	}
+}
+
+func (s *memoryStore) Consume(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purge()
+
+	if until, ok := s.used[id]; ok && until.After(time.Now()) {
+		return false, nil
+	}
+
+	// an expired identifier is refused by its own expiry, it still must not be consumed twice
+	if minimum := time.Now().Add(time.Second); expiresAt.Before(minimum) {
+		expiresAt = minimum
+	}
+	s.used[id] = expiresAt
+
+	return true, nil
+}
+
+// purge runs at most once a minute, callers hold the lock
+func (s *memoryStore) purge() {
+	now := time.Now()
+	if now.Sub(s.lastPurge) < time.Minute {
+		return
+	}
+	s.lastPurge = now
+
+	for id, until := range s.used {
+		if !until.After(now) {
+			delete(s.used, id)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package replay
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreConsume(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	expiresAt := time.Now().Add(time.Minute)
+
+	ok, err := store.Consume(ctx, "id-1", expiresAt)
+	if err != nil || !ok {
+		t.Fatalf("expected first use to pass, got %v %v", ok, err)
+	}
+
+	ok, _ = store.Consume(ctx, "id-1", expiresAt)
+	if ok {
+		t.Fatal("expected second use to be refused")
+	}
+
+	ok, _ = store.Consume(ctx, "id-2", expiresAt)
+	if !ok {
+		t.Fatal("expected other id to pass")
+	}
+}
+
+func TestMemoryStoreConsumeConcurrent(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	expiresAt := time.Now().Add(time.Minute)
+
+	var passed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if ok, _ := store.Consume(ctx, "id", expiresAt); ok {
+				passed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if passed.Load() != 1 {
+		t.Fatalf("expected exactly one use to pass, got %d", passed.Load())
+	}
+}
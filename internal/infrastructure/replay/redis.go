@@ -0,0 +1,37 @@
+package replay
+
+import (
+	"context"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/redis/go-redis/v9"
+)
+
+type redisStore struct {
+	client    *redis.Client
+	keyPrefix string
+}
+
+func newRedisStore(client *redis.Client, keyPrefix string) *redisStore {
+	return &redisStore{
+		client:    client,
+		keyPrefix: keyPrefix,
+	}
+}
+
+func (s *redisStore) key(id string) string {
+	return s.keyPrefix + "used:" + id
+}
+
+func (s *redisStore) Consume(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	// an expired identifier is refused by its own expiry, it still must not be consumed twice
+	ttl := max(time.Until(expiresAt), time.Second)
+
+	ok, err := s.client.SetNX(ctx, s.key(id), 1, ttl).Result()
+	if err != nil {
+		return false, xerror.Wrap(err)
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,26 @@
+package replay
+
+import (
+	"context"
+	"kiwi-user/config"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Store remembers one-time identifiers, saml request ids and login tickets, until they expire.
+// Consume is atomic, of concurrent uses of an identifier exactly one succeeds.
+type Store interface {
+	// Consume marks id as used until expiresAt, false when it was used before
+	Consume(ctx context.Context, id string, expiresAt time.Time) (bool, error)
+}
+
+// NewStore keeps the identifiers in redis when a client is configured, they are then shared
+// by all instances. Without redis they only hold in the process that recorded them.
+func NewStore(config *config.Config, client *redis.Client) Store {
+	if client == nil {
+		return newMemoryStore()
+	}
+
+	return newRedisStore(client, config.Redis.KeyPrefix)
+}
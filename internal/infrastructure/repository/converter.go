@@ -9,8 +9,10 @@ import (
 
 func convertApplicationDOToEntity(application *ent.Application) *entity.ApplicationEntity {
 	return &entity.ApplicationEntity{
-		ID:   application.ID,
-		Name: application.Name,
+		ID:               application.ID,
+		Name:             application.Name,
+		ClientSecretHash: application.ClientSecretHash,
+		RedirectURIs:     application.RedirectUris,
 	}
 }
 
@@ -70,6 +72,7 @@ func convertBindingDOToEntity(binding *ent.Binding) *entity.BindingEntity {
 		Email:    binding.Email,
 		Verified: binding.Verified,
 		Salt:     binding.Salt,
+		Secret:   binding.Secret,
 	}
 }
 
@@ -91,6 +94,7 @@ func convertUserDOToEntity(user *ent.User) *entity.UserEntity {
 		Avatar:          user.Avatar,
 		RefferalChannel: user.ReferralChannel,
 		Department:      user.Department,
+		Banned:          user.Banned,
 	}
 }
 
@@ -100,8 +104,14 @@ func convertDeviceDOToEntity(device *ent.Device) *entity.DeviceEntity {
 		DeviceType:            device.DeviceType,
 		DeviceID:              device.DeviceID,
 		OrganizationID:        device.OrganizationID,
-		RefreshToken:          device.RefreshToken,
+		RefreshTokenHash:      device.RefreshTokenHash,
 		RefreshTokenExpiresAt: device.RefreshTokenExpiresAt,
+		RefreshTokenFamily:    device.RefreshTokenFamily,
+		LastIP:                device.LastIP,
+		UserAgent:             device.UserAgent,
+		LastRefreshedAt:       device.LastRefreshedAt,
+		OAuthScope:            device.OauthScope,
+		CreatedAt:             device.CreatedAt,
 	}
 }
 
@@ -227,3 +237,239 @@ func convertOrganizationApplicationDoToEntity(organizationApplication *ent.Organ
 		OrgRoleName:     organizationApplication.OrgRoleName,
 	}
 }
+
+func convertOAuthAuthorizationCodeDOToEntity(code *ent.OAuthAuthorizationCode) *entity.OAuthAuthorizationCodeEntity {
+	if code == nil {
+		return nil
+	}
+
+	return &entity.OAuthAuthorizationCodeEntity{
+		ID:                  code.ID,
+		CodeHash:            code.CodeHash,
+		ApplicationID:       code.ApplicationID,
+		UserID:              code.UserID,
+		RedirectURI:         code.RedirectURI,
+		Scope:               code.Scope,
+		Nonce:               code.Nonce,
+		CodeChallenge:       code.CodeChallenge,
+		CodeChallengeMethod: code.CodeChallengeMethod,
+		ExpiresAt:           code.ExpiresAt,
+	}
+}
+
+func convertOAuthConsentDOToEntity(consent *ent.OAuthConsent) *entity.OAuthConsentEntity {
+	if consent == nil {
+		return nil
+	}
+
+	return &entity.OAuthConsentEntity{
+		ID:            consent.ID,
+		ApplicationID: consent.ApplicationID,
+		UserID:        consent.UserID,
+		Scope:         consent.Scope,
+		UpdatedAt:     consent.UpdatedAt,
+	}
+}
+
+func convertPasskeyCredentialDOToEntity(credential *ent.PasskeyCredential) *entity.PasskeyCredentialEntity {
+	if credential == nil {
+		return nil
+	}
+
+	return &entity.PasskeyCredentialEntity{
+		ID:           credential.ID,
+		UserID:       credential.UserID,
+		CredentialID: credential.CredentialID,
+		PublicKey:    credential.PublicKey,
+		SignCount:    credential.SignCount,
+		Transports:   credential.Transports,
+		AAGUID:       credential.Aaguid,
+		Name:         credential.Name,
+		CreatedAt:    credential.CreatedAt,
+		LastUsedAt:   credential.LastUsedAt,
+	}
+}
+
+func convertWebAuthnChallengeDOToEntity(challenge *ent.WebAuthnChallenge) *entity.WebAuthnChallengeEntity {
+	if challenge == nil {
+		return nil
+	}
+
+	return &entity.WebAuthnChallengeEntity{
+		ID:            challenge.ID,
+		Type:          enum.ParseWebAuthnChallengeType(string(challenge.Type)),
+		Challenge:     challenge.Challenge,
+		ApplicationID: challenge.ApplicationID,
+		UserID:        challenge.UserID,
+		ExpiresAt:     challenge.ExpiresAt,
+	}
+}
+
+func convertLoginLockDOToEntity(lock *ent.LoginLock) *entity.LoginLockEntity {
+	if lock == nil {
+		return nil
+	}
+
+	return &entity.LoginLockEntity{
+		ID:              lock.ID,
+		ApplicationName: lock.ApplicationName,
+		Scope:           enum.ParseLoginLockScope(string(lock.Scope)),
+		Subject:         lock.Subject,
+		Failures:        lock.Failures,
+		LastFailureAt:   lock.LastFailureAt,
+		NextAttemptAt:   lock.NextAttemptAt,
+		LockedUntil:     lock.LockedUntil,
+		CreatedAt:       lock.CreatedAt,
+		UpdatedAt:       lock.UpdatedAt,
+	}
+}
+
+func convertSmsVerifyCodeDOToEntity(code *ent.SmsVerifyCode) *entity.SmsVerifyCodeEntity {
+	if code == nil {
+		return nil
+	}
+
+	return &entity.SmsVerifyCodeEntity{
+		ID:        code.ID,
+		Phone:     code.Phone,
+		CodeHash:  code.CodeHash,
+		Type:      enum.ParseVertificationCodeType(code.Type.String()),
+		Attempts:  code.Attempts,
+		ExpiresAt: code.ExpiresAt,
+	}
+}
+
+func convertRotatedRefreshTokenDOToEntity(token *ent.RotatedRefreshToken) *entity.RotatedRefreshTokenEntity {
+	if token == nil {
+		return nil
+	}
+
+	return &entity.RotatedRefreshTokenEntity{
+		ID:               token.ID,
+		RefreshTokenHash: token.RefreshTokenHash,
+		Family:           token.Family,
+		DeviceID:         token.DeviceID,
+		RotatedAt:        token.RotatedAt,
+		Successor:        token.Successor,
+		ExpiresAt:        token.ExpiresAt,
+	}
+}
+
+func convertIdentityProviderDOToEntity(provider *ent.IdentityProvider) *entity.IdentityProviderEntity {
+	if provider == nil {
+		return nil
+	}
+
+	return &entity.IdentityProviderEntity{
+		ID:                    provider.ID,
+		ApplicationID:         provider.ApplicationID,
+		Name:                  provider.Name,
+		DisplayName:           provider.DisplayName,
+		Issuer:                provider.Issuer,
+		ClientID:              provider.ClientID,
+		ClientSecret:          provider.ClientSecret,
+		Scopes:                provider.Scopes,
+		AuthorizationEndpoint: provider.AuthorizationEndpoint,
+		TokenEndpoint:         provider.TokenEndpoint,
+		UserinfoEndpoint:      provider.UserinfoEndpoint,
+		JWKSURI:               provider.JwksURI,
+		ClaimMapping:          provider.ClaimMapping,
+		Enabled:               provider.Enabled,
+		CreatedAt:             provider.CreatedAt,
+		UpdatedAt:             provider.UpdatedAt,
+	}
+}
+
+func convertSAMLConnectionDOToEntity(connection *ent.SAMLConnection) *entity.SAMLConnectionEntity {
+	if connection == nil {
+		return nil
+	}
+
+	return &entity.SAMLConnectionEntity{
+		ID:               connection.ID,
+		OrganizationID:   connection.OrganizationID,
+		IdPEntityID:      connection.IdpEntityID,
+		IdPSSOURL:        connection.IdpSSOURL,
+		IdPCertificates:  connection.IdpCertificates,
+		IdPMetadata:      connection.IdpMetadata,
+		AttributeMapping: connection.AttributeMapping,
+		RedirectURL:      connection.RedirectURL,
+		Enabled:          connection.Enabled,
+		CreatedAt:        connection.CreatedAt,
+		UpdatedAt:        connection.UpdatedAt,
+	}
+}
+
+func convertQyWechatCorpDOToEntity(corp *ent.QyWechatCorp) *entity.QyWechatCorpEntity {
+	if corp == nil {
+		return nil
+	}
+
+	return &entity.QyWechatCorpEntity{
+		ID:             corp.ID,
+		OrganizationID: corp.OrganizationID,
+		CorpID:         corp.CorpID,
+		CorpSecret:     corp.CorpSecret,
+		Enabled:        corp.Enabled,
+		CreatedAt:      corp.CreatedAt,
+		UpdatedAt:      corp.UpdatedAt,
+	}
+}
+
+func convertMagicLinkDOToEntity(link *ent.MagicLink) *entity.MagicLinkEntity {
+	if link == nil {
+		return nil
+	}
+
+	return &entity.MagicLinkEntity{
+		ID:            link.ID,
+		ApplicationID: link.ApplicationID,
+		Email:         link.Email,
+		DeviceType:    link.DeviceType,
+		DeviceID:      link.DeviceID,
+		PollTokenHash: link.PollTokenHash,
+		ClientIP:      link.ClientIP,
+		UserAgent:     link.UserAgent,
+		Confirmed:     link.Confirmed,
+		ExpiresAt:     link.ExpiresAt,
+		CreatedAt:     link.CreatedAt,
+	}
+}
+
+func convertQRLoginDOToEntity(login *ent.QRLogin) *entity.QRLoginEntity {
+	if login == nil {
+		return nil
+	}
+
+	return &entity.QRLoginEntity{
+		ID:            login.ID,
+		ApplicationID: login.ApplicationID,
+		DeviceType:    login.DeviceType,
+		DeviceID:      login.DeviceID,
+		PollTokenHash: login.PollTokenHash,
+		ClientIP:      login.ClientIP,
+		UserAgent:     login.UserAgent,
+		Status:        enum.ParseQRLoginStatus(login.Status.String()),
+		UserID:        login.UserID,
+		ExpiresAt:     login.ExpiresAt,
+		CreatedAt:     login.CreatedAt,
+	}
+}
+
+func convertWechatScanLoginDOToEntity(login *ent.WechatScanLogin) *entity.WechatScanLoginEntity {
+	if login == nil {
+		return nil
+	}
+
+	return &entity.WechatScanLoginEntity{
+		ID:            login.ID,
+		ApplicationID: login.ApplicationID,
+		DeviceType:    login.DeviceType,
+		DeviceID:      login.DeviceID,
+		PollTokenHash: login.PollTokenHash,
+		OpenID:        login.OpenID,
+		UnionID:       login.UnionID,
+		ExpiresAt:     login.ExpiresAt,
+		CreatedAt:     login.CreatedAt,
+	}
+}
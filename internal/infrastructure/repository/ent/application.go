@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"kiwi-user/internal/infrastructure/repository/ent/application"
 	"kiwi-user/internal/infrastructure/repository/ent/role"
@@ -27,6 +28,10 @@ type Application struct {
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// ClientSecretHash holds the value of the "client_secret_hash" field.
+	ClientSecretHash string `json:"-"`
+	// RedirectUris holds the value of the "redirect_uris" field.
+	RedirectUris []string `json:"redirect_uris,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ApplicationQuery when eager-loading is set.
 	Edges                              ApplicationEdges `json:"edges"`
@@ -131,7 +136,9 @@ func (*Application) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case application.FieldName:
+		case application.FieldRedirectUris:
+			values[i] = new([]byte)
+		case application.FieldName, application.FieldClientSecretHash:
 			values[i] = new(sql.NullString)
 		case application.FieldCreatedAt, application.FieldUpdatedAt, application.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -188,6 +195,20 @@ func (a *Application) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Name = value.String
 			}
+		case application.FieldClientSecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_secret_hash", values[i])
+			} else if value.Valid {
+				a.ClientSecretHash = value.String
+			}
+		case application.FieldRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.RedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field redirect_uris: %w", err)
+				}
+			}
 		case application.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field application_default_personal_role", values[i])
@@ -291,6 +312,11 @@ func (a *Application) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(a.Name)
+	builder.WriteString(", ")
+	builder.WriteString("client_secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", a.RedirectUris))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -23,6 +23,10 @@ const (
 	FieldDeletedAt = "deleted_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldClientSecretHash holds the string denoting the client_secret_hash field in the database.
+	FieldClientSecretHash = "client_secret_hash"
+	// FieldRedirectUris holds the string denoting the redirect_uris field in the database.
+	FieldRedirectUris = "redirect_uris"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
 	// EdgeOrganizations holds the string denoting the organizations edge name in mutations.
@@ -97,6 +101,8 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldDeletedAt,
 	FieldName,
+	FieldClientSecretHash,
+	FieldRedirectUris,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "applications"
@@ -163,6 +169,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByClientSecretHash orders the results by the client_secret_hash field.
+func ByClientSecretHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientSecretHash, opts...).ToFunc()
+}
+
 // ByUsersCount orders the results by users count.
 func ByUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -76,6 +76,11 @@ func Name(v string) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldName, v))
 }
 
+// ClientSecretHash applies equality check predicate on the "client_secret_hash" field. It's identical to ClientSecretHashEQ.
+func ClientSecretHash(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldClientSecretHash, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldCreatedAt, v))
@@ -271,6 +276,91 @@ func NameContainsFold(v string) predicate.Application {
 	return predicate.Application(sql.FieldContainsFold(FieldName, v))
 }
 
+// ClientSecretHashEQ applies the EQ predicate on the "client_secret_hash" field.
+func ClientSecretHashEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashNEQ applies the NEQ predicate on the "client_secret_hash" field.
+func ClientSecretHashNEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashIn applies the In predicate on the "client_secret_hash" field.
+func ClientSecretHashIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldClientSecretHash, vs...))
+}
+
+// ClientSecretHashNotIn applies the NotIn predicate on the "client_secret_hash" field.
+func ClientSecretHashNotIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldClientSecretHash, vs...))
+}
+
+// ClientSecretHashGT applies the GT predicate on the "client_secret_hash" field.
+func ClientSecretHashGT(v string) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashGTE applies the GTE predicate on the "client_secret_hash" field.
+func ClientSecretHashGTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashLT applies the LT predicate on the "client_secret_hash" field.
+func ClientSecretHashLT(v string) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashLTE applies the LTE predicate on the "client_secret_hash" field.
+func ClientSecretHashLTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashContains applies the Contains predicate on the "client_secret_hash" field.
+func ClientSecretHashContains(v string) predicate.Application {
+	return predicate.Application(sql.FieldContains(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashHasPrefix applies the HasPrefix predicate on the "client_secret_hash" field.
+func ClientSecretHashHasPrefix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasPrefix(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashHasSuffix applies the HasSuffix predicate on the "client_secret_hash" field.
+func ClientSecretHashHasSuffix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasSuffix(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashIsNil applies the IsNil predicate on the "client_secret_hash" field.
+func ClientSecretHashIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldClientSecretHash))
+}
+
+// ClientSecretHashNotNil applies the NotNil predicate on the "client_secret_hash" field.
+func ClientSecretHashNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldClientSecretHash))
+}
+
+// ClientSecretHashEqualFold applies the EqualFold predicate on the "client_secret_hash" field.
+func ClientSecretHashEqualFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldEqualFold(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashContainsFold applies the ContainsFold predicate on the "client_secret_hash" field.
+func ClientSecretHashContainsFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldContainsFold(FieldClientSecretHash, v))
+}
+
+// RedirectUrisIsNil applies the IsNil predicate on the "redirect_uris" field.
+func RedirectUrisIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldRedirectUris))
+}
+
+// RedirectUrisNotNil applies the NotNil predicate on the "redirect_uris" field.
+func RedirectUrisNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldRedirectUris))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.Application {
 	return predicate.Application(func(s *sql.Selector) {
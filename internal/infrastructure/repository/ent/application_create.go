@@ -73,6 +73,26 @@ func (ac *ApplicationCreate) SetName(s string) *ApplicationCreate {
 	return ac
 }
 
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (ac *ApplicationCreate) SetClientSecretHash(s string) *ApplicationCreate {
+	ac.mutation.SetClientSecretHash(s)
+	return ac
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableClientSecretHash(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetClientSecretHash(*s)
+	}
+	return ac
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ac *ApplicationCreate) SetRedirectUris(s []string) *ApplicationCreate {
+	ac.mutation.SetRedirectUris(s)
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *ApplicationCreate) SetID(u uuid.UUID) *ApplicationCreate {
 	ac.mutation.SetID(u)
@@ -320,6 +340,14 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		_spec.SetField(application.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := ac.mutation.ClientSecretHash(); ok {
+		_spec.SetField(application.FieldClientSecretHash, field.TypeString, value)
+		_node.ClientSecretHash = value
+	}
+	if value, ok := ac.mutation.RedirectUris(); ok {
+		_spec.SetField(application.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
 	if nodes := ac.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -16,6 +16,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -73,6 +74,44 @@ func (au *ApplicationUpdate) SetNillableName(s *string) *ApplicationUpdate {
 	return au
 }
 
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (au *ApplicationUpdate) SetClientSecretHash(s string) *ApplicationUpdate {
+	au.mutation.SetClientSecretHash(s)
+	return au
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableClientSecretHash(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetClientSecretHash(*s)
+	}
+	return au
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (au *ApplicationUpdate) ClearClientSecretHash() *ApplicationUpdate {
+	au.mutation.ClearClientSecretHash()
+	return au
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (au *ApplicationUpdate) SetRedirectUris(s []string) *ApplicationUpdate {
+	au.mutation.SetRedirectUris(s)
+	return au
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (au *ApplicationUpdate) AppendRedirectUris(s []string) *ApplicationUpdate {
+	au.mutation.AppendRedirectUris(s)
+	return au
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (au *ApplicationUpdate) ClearRedirectUris() *ApplicationUpdate {
+	au.mutation.ClearRedirectUris()
+	return au
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (au *ApplicationUpdate) AddUserIDs(ids ...string) *ApplicationUpdate {
 	au.mutation.AddUserIDs(ids...)
@@ -367,6 +406,23 @@ func (au *ApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Name(); ok {
 		_spec.SetField(application.FieldName, field.TypeString, value)
 	}
+	if value, ok := au.mutation.ClientSecretHash(); ok {
+		_spec.SetField(application.FieldClientSecretHash, field.TypeString, value)
+	}
+	if au.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(application.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := au.mutation.RedirectUris(); ok {
+		_spec.SetField(application.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldRedirectUris, value)
+		})
+	}
+	if au.mutation.RedirectUrisCleared() {
+		_spec.ClearField(application.FieldRedirectUris, field.TypeJSON)
+	}
 	if au.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -694,6 +750,44 @@ func (auo *ApplicationUpdateOne) SetNillableName(s *string) *ApplicationUpdateOn
 	return auo
 }
 
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (auo *ApplicationUpdateOne) SetClientSecretHash(s string) *ApplicationUpdateOne {
+	auo.mutation.SetClientSecretHash(s)
+	return auo
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableClientSecretHash(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetClientSecretHash(*s)
+	}
+	return auo
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (auo *ApplicationUpdateOne) ClearClientSecretHash() *ApplicationUpdateOne {
+	auo.mutation.ClearClientSecretHash()
+	return auo
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (auo *ApplicationUpdateOne) SetRedirectUris(s []string) *ApplicationUpdateOne {
+	auo.mutation.SetRedirectUris(s)
+	return auo
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (auo *ApplicationUpdateOne) AppendRedirectUris(s []string) *ApplicationUpdateOne {
+	auo.mutation.AppendRedirectUris(s)
+	return auo
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (auo *ApplicationUpdateOne) ClearRedirectUris() *ApplicationUpdateOne {
+	auo.mutation.ClearRedirectUris()
+	return auo
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (auo *ApplicationUpdateOne) AddUserIDs(ids ...string) *ApplicationUpdateOne {
 	auo.mutation.AddUserIDs(ids...)
@@ -1018,6 +1112,23 @@ func (auo *ApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Applicatio
 	if value, ok := auo.mutation.Name(); ok {
 		_spec.SetField(application.FieldName, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.ClientSecretHash(); ok {
+		_spec.SetField(application.FieldClientSecretHash, field.TypeString, value)
+	}
+	if auo.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(application.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := auo.mutation.RedirectUris(); ok {
+		_spec.SetField(application.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldRedirectUris, value)
+		})
+	}
+	if auo.mutation.RedirectUrisCleared() {
+		_spec.ClearField(application.FieldRedirectUris, field.TypeJSON)
+	}
 	if auo.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
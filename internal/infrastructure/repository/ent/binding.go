@@ -35,6 +35,8 @@ type Binding struct {
 	Verified bool `json:"verified,omitempty"`
 	// Salt holds the value of the "salt" field.
 	Salt string `json:"salt,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
 	// ApplicationID holds the value of the "application_id" field.
@@ -72,7 +74,7 @@ func (*Binding) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case binding.FieldVerified:
 			values[i] = new(sql.NullBool)
-		case binding.FieldType, binding.FieldIdentity, binding.FieldEmail, binding.FieldSalt, binding.FieldUserID:
+		case binding.FieldType, binding.FieldIdentity, binding.FieldEmail, binding.FieldSalt, binding.FieldSecret, binding.FieldUserID:
 			values[i] = new(sql.NullString)
 		case binding.FieldCreatedAt, binding.FieldUpdatedAt, binding.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -147,6 +149,12 @@ func (b *Binding) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				b.Salt = value.String
 			}
+		case binding.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				b.Secret = value.String
+			}
 		case binding.FieldUserID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -224,6 +232,8 @@ func (b *Binding) String() string {
 	builder.WriteString("salt=")
 	builder.WriteString(b.Salt)
 	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(b.UserID)
 	builder.WriteString(", ")
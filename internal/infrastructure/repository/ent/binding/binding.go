@@ -32,6 +32,8 @@ const (
 	FieldVerified = "verified"
 	// FieldSalt holds the string denoting the salt field in the database.
 	FieldSalt = "salt"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// FieldApplicationID holds the string denoting the application_id field in the database.
@@ -60,6 +62,7 @@ var Columns = []string{
 	FieldEmail,
 	FieldVerified,
 	FieldSalt,
+	FieldSecret,
 	FieldUserID,
 	FieldApplicationID,
 }
@@ -101,6 +104,11 @@ const (
 	TypePassword Type = "password"
 	TypeEmail    Type = "email"
 	TypeGoogle   Type = "google"
+	TypeTotp     Type = "totp"
+	TypeApple    Type = "apple"
+	TypeOidc     Type = "oidc"
+	TypeSaml     Type = "saml"
+	TypeGuest    Type = "guest"
 	TypeUnknown  Type = "unknown"
 )
 
@@ -111,7 +119,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeWechat, TypeQyWechat, TypeWxid, TypePhone, TypePassword, TypeEmail, TypeGoogle, TypeUnknown:
+	case TypeWechat, TypeQyWechat, TypeWxid, TypePhone, TypePassword, TypeEmail, TypeGoogle, TypeTotp, TypeApple, TypeOidc, TypeSaml, TypeGuest, TypeUnknown:
 		return nil
 	default:
 		return fmt.Errorf("binding: invalid enum value for type field: %q", _type)
@@ -166,6 +174,11 @@ func BySalt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSalt, opts...).ToFunc()
 }
 
+// BySecret orders the results by the secret field.
+func BySecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecret, opts...).ToFunc()
+}
+
 // ByUserID orders the results by the user_id field.
 func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
@@ -91,6 +91,11 @@ func Salt(v string) predicate.Binding {
 	return predicate.Binding(sql.FieldEQ(FieldSalt, v))
 }
 
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldEQ(FieldSecret, v))
+}
+
 // UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
 func UserID(v string) predicate.Binding {
 	return predicate.Binding(sql.FieldEQ(FieldUserID, v))
@@ -476,6 +481,81 @@ func SaltContainsFold(v string) predicate.Binding {
 	return predicate.Binding(sql.FieldContainsFold(FieldSalt, v))
 }
 
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.Binding {
+	return predicate.Binding(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.Binding {
+	return predicate.Binding(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretIsNil applies the IsNil predicate on the "secret" field.
+func SecretIsNil() predicate.Binding {
+	return predicate.Binding(sql.FieldIsNull(FieldSecret))
+}
+
+// SecretNotNil applies the NotNil predicate on the "secret" field.
+func SecretNotNil() predicate.Binding {
+	return predicate.Binding(sql.FieldNotNull(FieldSecret))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.Binding {
+	return predicate.Binding(sql.FieldContainsFold(FieldSecret, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v string) predicate.Binding {
 	return predicate.Binding(sql.FieldEQ(FieldUserID, v))
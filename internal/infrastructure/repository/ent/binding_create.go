@@ -118,6 +118,20 @@ func (bc *BindingCreate) SetNillableSalt(s *string) *BindingCreate {
 	return bc
 }
 
+// SetSecret sets the "secret" field.
+func (bc *BindingCreate) SetSecret(s string) *BindingCreate {
+	bc.mutation.SetSecret(s)
+	return bc
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (bc *BindingCreate) SetNillableSecret(s *string) *BindingCreate {
+	if s != nil {
+		bc.SetSecret(*s)
+	}
+	return bc
+}
+
 // SetUserID sets the "user_id" field.
 func (bc *BindingCreate) SetUserID(s string) *BindingCreate {
 	bc.mutation.SetUserID(s)
@@ -310,6 +324,10 @@ func (bc *BindingCreate) createSpec() (*Binding, *sqlgraph.CreateSpec) {
 		_spec.SetField(binding.FieldSalt, field.TypeString, value)
 		_node.Salt = value
 	}
+	if value, ok := bc.mutation.Secret(); ok {
+		_spec.SetField(binding.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
 	if value, ok := bc.mutation.ApplicationID(); ok {
 		_spec.SetField(binding.FieldApplicationID, field.TypeUUID, value)
 		_node.ApplicationID = value
@@ -138,6 +138,26 @@ func (bu *BindingUpdate) ClearSalt() *BindingUpdate {
 	return bu
 }
 
+// SetSecret sets the "secret" field.
+func (bu *BindingUpdate) SetSecret(s string) *BindingUpdate {
+	bu.mutation.SetSecret(s)
+	return bu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (bu *BindingUpdate) SetNillableSecret(s *string) *BindingUpdate {
+	if s != nil {
+		bu.SetSecret(*s)
+	}
+	return bu
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (bu *BindingUpdate) ClearSecret() *BindingUpdate {
+	bu.mutation.ClearSecret()
+	return bu
+}
+
 // SetUserID sets the "user_id" field.
 func (bu *BindingUpdate) SetUserID(s string) *BindingUpdate {
 	bu.mutation.SetUserID(s)
@@ -284,6 +304,12 @@ func (bu *BindingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if bu.mutation.SaltCleared() {
 		_spec.ClearField(binding.FieldSalt, field.TypeString)
 	}
+	if value, ok := bu.mutation.Secret(); ok {
+		_spec.SetField(binding.FieldSecret, field.TypeString, value)
+	}
+	if bu.mutation.SecretCleared() {
+		_spec.ClearField(binding.FieldSecret, field.TypeString)
+	}
 	if value, ok := bu.mutation.ApplicationID(); ok {
 		_spec.SetField(binding.FieldApplicationID, field.TypeUUID, value)
 	}
@@ -447,6 +473,26 @@ func (buo *BindingUpdateOne) ClearSalt() *BindingUpdateOne {
 	return buo
 }
 
+// SetSecret sets the "secret" field.
+func (buo *BindingUpdateOne) SetSecret(s string) *BindingUpdateOne {
+	buo.mutation.SetSecret(s)
+	return buo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (buo *BindingUpdateOne) SetNillableSecret(s *string) *BindingUpdateOne {
+	if s != nil {
+		buo.SetSecret(*s)
+	}
+	return buo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (buo *BindingUpdateOne) ClearSecret() *BindingUpdateOne {
+	buo.mutation.ClearSecret()
+	return buo
+}
+
 // SetUserID sets the "user_id" field.
 func (buo *BindingUpdateOne) SetUserID(s string) *BindingUpdateOne {
 	buo.mutation.SetUserID(s)
@@ -623,6 +669,12 @@ func (buo *BindingUpdateOne) sqlSave(ctx context.Context) (_node *Binding, err e
 	if buo.mutation.SaltCleared() {
 		_spec.ClearField(binding.FieldSalt, field.TypeString)
 	}
+	if value, ok := buo.mutation.Secret(); ok {
+		_spec.SetField(binding.FieldSecret, field.TypeString, value)
+	}
+	if buo.mutation.SecretCleared() {
+		_spec.ClearField(binding.FieldSecret, field.TypeString)
+	}
 	if value, ok := buo.mutation.ApplicationID(); ok {
 		_spec.SetField(binding.FieldApplicationID, field.TypeUUID, value)
 	}
@@ -92,6 +92,11 @@ const (
 	TypePassword Type = "password"
 	TypeEmail    Type = "email"
 	TypeGoogle   Type = "google"
+	TypeTotp     Type = "totp"
+	TypeApple    Type = "apple"
+	TypeOidc     Type = "oidc"
+	TypeSaml     Type = "saml"
+	TypeGuest    Type = "guest"
 	TypeUnknown  Type = "unknown"
 )
 
@@ -102,7 +107,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeWechat, TypeQyWechat, TypeWxid, TypePhone, TypePassword, TypeEmail, TypeGoogle, TypeUnknown:
+	case TypeWechat, TypeQyWechat, TypeWxid, TypePhone, TypePassword, TypeEmail, TypeGoogle, TypeTotp, TypeApple, TypeOidc, TypeSaml, TypeGuest, TypeUnknown:
 		return nil
 	default:
 		return fmt.Errorf("bindingverify: invalid enum value for type field: %q", _type)
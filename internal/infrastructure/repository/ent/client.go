@@ -15,18 +15,31 @@ import (
 	"kiwi-user/internal/infrastructure/repository/ent/binding"
 	"kiwi-user/internal/infrastructure/repository/ent/bindingverify"
 	"kiwi-user/internal/infrastructure/repository/ent/device"
+	"kiwi-user/internal/infrastructure/repository/ent/identityprovider"
+	"kiwi-user/internal/infrastructure/repository/ent/loginlock"
+	"kiwi-user/internal/infrastructure/repository/ent/magiclink"
 	"kiwi-user/internal/infrastructure/repository/ent/mailvertifycode"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthauthorizationcode"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthconsent"
 	"kiwi-user/internal/infrastructure/repository/ent/organization"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationapplication"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationrequest"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationuser"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
 	"kiwi-user/internal/infrastructure/repository/ent/payment"
+	"kiwi-user/internal/infrastructure/repository/ent/qrlogin"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatcorp"
 	"kiwi-user/internal/infrastructure/repository/ent/qywechatuserid"
 	"kiwi-user/internal/infrastructure/repository/ent/role"
+	"kiwi-user/internal/infrastructure/repository/ent/rotatedrefreshtoken"
+	"kiwi-user/internal/infrastructure/repository/ent/samlconnection"
 	"kiwi-user/internal/infrastructure/repository/ent/scope"
+	"kiwi-user/internal/infrastructure/repository/ent/smsverifycode"
 	"kiwi-user/internal/infrastructure/repository/ent/stripeevent"
 	"kiwi-user/internal/infrastructure/repository/ent/user"
+	"kiwi-user/internal/infrastructure/repository/ent/webauthnchallenge"
 	"kiwi-user/internal/infrastructure/repository/ent/wechatopenid"
+	"kiwi-user/internal/infrastructure/repository/ent/wechatscanlogin"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -50,8 +63,18 @@ type Client struct {
 	BindingVerify *BindingVerifyClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// IdentityProvider is the client for interacting with the IdentityProvider builders.
+	IdentityProvider *IdentityProviderClient
+	// LoginLock is the client for interacting with the LoginLock builders.
+	LoginLock *LoginLockClient
+	// MagicLink is the client for interacting with the MagicLink builders.
+	MagicLink *MagicLinkClient
 	// MailVertifyCode is the client for interacting with the MailVertifyCode builders.
 	MailVertifyCode *MailVertifyCodeClient
+	// OAuthAuthorizationCode is the client for interacting with the OAuthAuthorizationCode builders.
+	OAuthAuthorizationCode *OAuthAuthorizationCodeClient
+	// OAuthConsent is the client for interacting with the OAuthConsent builders.
+	OAuthConsent *OAuthConsentClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
 	// OrganizationApplication is the client for interacting with the OrganizationApplication builders.
@@ -60,20 +83,36 @@ type Client struct {
 	OrganizationRequest *OrganizationRequestClient
 	// OrganizationUser is the client for interacting with the OrganizationUser builders.
 	OrganizationUser *OrganizationUserClient
+	// PasskeyCredential is the client for interacting with the PasskeyCredential builders.
+	PasskeyCredential *PasskeyCredentialClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
+	// QRLogin is the client for interacting with the QRLogin builders.
+	QRLogin *QRLoginClient
+	// QyWechatCorp is the client for interacting with the QyWechatCorp builders.
+	QyWechatCorp *QyWechatCorpClient
 	// QyWechatUserID is the client for interacting with the QyWechatUserID builders.
 	QyWechatUserID *QyWechatUserIDClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// RotatedRefreshToken is the client for interacting with the RotatedRefreshToken builders.
+	RotatedRefreshToken *RotatedRefreshTokenClient
+	// SAMLConnection is the client for interacting with the SAMLConnection builders.
+	SAMLConnection *SAMLConnectionClient
 	// Scope is the client for interacting with the Scope builders.
 	Scope *ScopeClient
+	// SmsVerifyCode is the client for interacting with the SmsVerifyCode builders.
+	SmsVerifyCode *SmsVerifyCodeClient
 	// StripeEvent is the client for interacting with the StripeEvent builders.
 	StripeEvent *StripeEventClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WebAuthnChallenge is the client for interacting with the WebAuthnChallenge builders.
+	WebAuthnChallenge *WebAuthnChallengeClient
 	// WechatOpenID is the client for interacting with the WechatOpenID builders.
 	WechatOpenID *WechatOpenIDClient
+	// WechatScanLogin is the client for interacting with the WechatScanLogin builders.
+	WechatScanLogin *WechatScanLoginClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -89,18 +128,31 @@ func (c *Client) init() {
 	c.Binding = NewBindingClient(c.config)
 	c.BindingVerify = NewBindingVerifyClient(c.config)
 	c.Device = NewDeviceClient(c.config)
+	c.IdentityProvider = NewIdentityProviderClient(c.config)
+	c.LoginLock = NewLoginLockClient(c.config)
+	c.MagicLink = NewMagicLinkClient(c.config)
 	c.MailVertifyCode = NewMailVertifyCodeClient(c.config)
+	c.OAuthAuthorizationCode = NewOAuthAuthorizationCodeClient(c.config)
+	c.OAuthConsent = NewOAuthConsentClient(c.config)
 	c.Organization = NewOrganizationClient(c.config)
 	c.OrganizationApplication = NewOrganizationApplicationClient(c.config)
 	c.OrganizationRequest = NewOrganizationRequestClient(c.config)
 	c.OrganizationUser = NewOrganizationUserClient(c.config)
+	c.PasskeyCredential = NewPasskeyCredentialClient(c.config)
 	c.Payment = NewPaymentClient(c.config)
+	c.QRLogin = NewQRLoginClient(c.config)
+	c.QyWechatCorp = NewQyWechatCorpClient(c.config)
 	c.QyWechatUserID = NewQyWechatUserIDClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.RotatedRefreshToken = NewRotatedRefreshTokenClient(c.config)
+	c.SAMLConnection = NewSAMLConnectionClient(c.config)
 	c.Scope = NewScopeClient(c.config)
+	c.SmsVerifyCode = NewSmsVerifyCodeClient(c.config)
 	c.StripeEvent = NewStripeEventClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.WebAuthnChallenge = NewWebAuthnChallengeClient(c.config)
 	c.WechatOpenID = NewWechatOpenIDClient(c.config)
+	c.WechatScanLogin = NewWechatScanLoginClient(c.config)
 }
 
 type (
@@ -197,18 +249,31 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Binding:                 NewBindingClient(cfg),
 		BindingVerify:           NewBindingVerifyClient(cfg),
 		Device:                  NewDeviceClient(cfg),
+		IdentityProvider:        NewIdentityProviderClient(cfg),
+		LoginLock:               NewLoginLockClient(cfg),
+		MagicLink:               NewMagicLinkClient(cfg),
 		MailVertifyCode:         NewMailVertifyCodeClient(cfg),
+		OAuthAuthorizationCode:  NewOAuthAuthorizationCodeClient(cfg),
+		OAuthConsent:            NewOAuthConsentClient(cfg),
 		Organization:            NewOrganizationClient(cfg),
 		OrganizationApplication: NewOrganizationApplicationClient(cfg),
 		OrganizationRequest:     NewOrganizationRequestClient(cfg),
 		OrganizationUser:        NewOrganizationUserClient(cfg),
+		PasskeyCredential:       NewPasskeyCredentialClient(cfg),
 		Payment:                 NewPaymentClient(cfg),
+		QRLogin:                 NewQRLoginClient(cfg),
+		QyWechatCorp:            NewQyWechatCorpClient(cfg),
 		QyWechatUserID:          NewQyWechatUserIDClient(cfg),
 		Role:                    NewRoleClient(cfg),
+		RotatedRefreshToken:     NewRotatedRefreshTokenClient(cfg),
+		SAMLConnection:          NewSAMLConnectionClient(cfg),
 		Scope:                   NewScopeClient(cfg),
+		SmsVerifyCode:           NewSmsVerifyCodeClient(cfg),
 		StripeEvent:             NewStripeEventClient(cfg),
 		User:                    NewUserClient(cfg),
+		WebAuthnChallenge:       NewWebAuthnChallengeClient(cfg),
 		WechatOpenID:            NewWechatOpenIDClient(cfg),
+		WechatScanLogin:         NewWechatScanLoginClient(cfg),
 	}, nil
 }
 
@@ -232,18 +297,31 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Binding:                 NewBindingClient(cfg),
 		BindingVerify:           NewBindingVerifyClient(cfg),
 		Device:                  NewDeviceClient(cfg),
+		IdentityProvider:        NewIdentityProviderClient(cfg),
+		LoginLock:               NewLoginLockClient(cfg),
+		MagicLink:               NewMagicLinkClient(cfg),
 		MailVertifyCode:         NewMailVertifyCodeClient(cfg),
+		OAuthAuthorizationCode:  NewOAuthAuthorizationCodeClient(cfg),
+		OAuthConsent:            NewOAuthConsentClient(cfg),
 		Organization:            NewOrganizationClient(cfg),
 		OrganizationApplication: NewOrganizationApplicationClient(cfg),
 		OrganizationRequest:     NewOrganizationRequestClient(cfg),
 		OrganizationUser:        NewOrganizationUserClient(cfg),
+		PasskeyCredential:       NewPasskeyCredentialClient(cfg),
 		Payment:                 NewPaymentClient(cfg),
+		QRLogin:                 NewQRLoginClient(cfg),
+		QyWechatCorp:            NewQyWechatCorpClient(cfg),
 		QyWechatUserID:          NewQyWechatUserIDClient(cfg),
 		Role:                    NewRoleClient(cfg),
+		RotatedRefreshToken:     NewRotatedRefreshTokenClient(cfg),
+		SAMLConnection:          NewSAMLConnectionClient(cfg),
 		Scope:                   NewScopeClient(cfg),
+		SmsVerifyCode:           NewSmsVerifyCodeClient(cfg),
 		StripeEvent:             NewStripeEventClient(cfg),
 		User:                    NewUserClient(cfg),
+		WebAuthnChallenge:       NewWebAuthnChallengeClient(cfg),
 		WechatOpenID:            NewWechatOpenIDClient(cfg),
+		WechatScanLogin:         NewWechatScanLoginClient(cfg),
 	}, nil
 }
 
@@ -273,10 +351,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Application, c.Binding, c.BindingVerify, c.Device, c.MailVertifyCode,
-		c.Organization, c.OrganizationApplication, c.OrganizationRequest,
-		c.OrganizationUser, c.Payment, c.QyWechatUserID, c.Role, c.Scope,
-		c.StripeEvent, c.User, c.WechatOpenID,
+		c.Application, c.Binding, c.BindingVerify, c.Device, c.IdentityProvider,
+		c.LoginLock, c.MagicLink, c.MailVertifyCode, c.OAuthAuthorizationCode,
+		c.OAuthConsent, c.Organization, c.OrganizationApplication,
+		c.OrganizationRequest, c.OrganizationUser, c.PasskeyCredential, c.Payment,
+		c.QRLogin, c.QyWechatCorp, c.QyWechatUserID, c.Role, c.RotatedRefreshToken,
+		c.SAMLConnection, c.Scope, c.SmsVerifyCode, c.StripeEvent, c.User,
+		c.WebAuthnChallenge, c.WechatOpenID, c.WechatScanLogin,
 	} {
 		n.Use(hooks...)
 	}
@@ -286,10 +367,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Application, c.Binding, c.BindingVerify, c.Device, c.MailVertifyCode,
-		c.Organization, c.OrganizationApplication, c.OrganizationRequest,
-		c.OrganizationUser, c.Payment, c.QyWechatUserID, c.Role, c.Scope,
-		c.StripeEvent, c.User, c.WechatOpenID,
+		c.Application, c.Binding, c.BindingVerify, c.Device, c.IdentityProvider,
+		c.LoginLock, c.MagicLink, c.MailVertifyCode, c.OAuthAuthorizationCode,
+		c.OAuthConsent, c.Organization, c.OrganizationApplication,
+		c.OrganizationRequest, c.OrganizationUser, c.PasskeyCredential, c.Payment,
+		c.QRLogin, c.QyWechatCorp, c.QyWechatUserID, c.Role, c.RotatedRefreshToken,
+		c.SAMLConnection, c.Scope, c.SmsVerifyCode, c.StripeEvent, c.User,
+		c.WebAuthnChallenge, c.WechatOpenID, c.WechatScanLogin,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -306,8 +390,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.BindingVerify.mutate(ctx, m)
 	case *DeviceMutation:
 		return c.Device.mutate(ctx, m)
+	case *IdentityProviderMutation:
+		return c.IdentityProvider.mutate(ctx, m)
+	case *LoginLockMutation:
+		return c.LoginLock.mutate(ctx, m)
+	case *MagicLinkMutation:
+		return c.MagicLink.mutate(ctx, m)
 	case *MailVertifyCodeMutation:
 		return c.MailVertifyCode.mutate(ctx, m)
+	case *OAuthAuthorizationCodeMutation:
+		return c.OAuthAuthorizationCode.mutate(ctx, m)
+	case *OAuthConsentMutation:
+		return c.OAuthConsent.mutate(ctx, m)
 	case *OrganizationMutation:
 		return c.Organization.mutate(ctx, m)
 	case *OrganizationApplicationMutation:
@@ -316,20 +410,36 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.OrganizationRequest.mutate(ctx, m)
 	case *OrganizationUserMutation:
 		return c.OrganizationUser.mutate(ctx, m)
+	case *PasskeyCredentialMutation:
+		return c.PasskeyCredential.mutate(ctx, m)
 	case *PaymentMutation:
 		return c.Payment.mutate(ctx, m)
+	case *QRLoginMutation:
+		return c.QRLogin.mutate(ctx, m)
+	case *QyWechatCorpMutation:
+		return c.QyWechatCorp.mutate(ctx, m)
 	case *QyWechatUserIDMutation:
 		return c.QyWechatUserID.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *RotatedRefreshTokenMutation:
+		return c.RotatedRefreshToken.mutate(ctx, m)
+	case *SAMLConnectionMutation:
+		return c.SAMLConnection.mutate(ctx, m)
 	case *ScopeMutation:
 		return c.Scope.mutate(ctx, m)
+	case *SmsVerifyCodeMutation:
+		return c.SmsVerifyCode.mutate(ctx, m)
 	case *StripeEventMutation:
 		return c.StripeEvent.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *WebAuthnChallengeMutation:
+		return c.WebAuthnChallenge.mutate(ctx, m)
 	case *WechatOpenIDMutation:
 		return c.WechatOpenID.mutate(ctx, m)
+	case *WechatScanLoginMutation:
+		return c.WechatScanLogin.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -1011,107 +1121,107 @@ func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, er
 	}
 }
 
-// MailVertifyCodeClient is a client for the MailVertifyCode schema.
-type MailVertifyCodeClient struct {
+// IdentityProviderClient is a client for the IdentityProvider schema.
+type IdentityProviderClient struct {
 	config
 }
 
-// NewMailVertifyCodeClient returns a client for the MailVertifyCode from the given config.
-func NewMailVertifyCodeClient(c config) *MailVertifyCodeClient {
-	return &MailVertifyCodeClient{config: c}
+// NewIdentityProviderClient returns a client for the IdentityProvider from the given config.
+func NewIdentityProviderClient(c config) *IdentityProviderClient {
+	return &IdentityProviderClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `mailvertifycode.Hooks(f(g(h())))`.
-func (c *MailVertifyCodeClient) Use(hooks ...Hook) {
-	c.hooks.MailVertifyCode = append(c.hooks.MailVertifyCode, hooks...)
+// A call to `Use(f, g, h)` equals to `identityprovider.Hooks(f(g(h())))`.
+func (c *IdentityProviderClient) Use(hooks ...Hook) {
+	c.hooks.IdentityProvider = append(c.hooks.IdentityProvider, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `mailvertifycode.Intercept(f(g(h())))`.
-func (c *MailVertifyCodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MailVertifyCode = append(c.inters.MailVertifyCode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `identityprovider.Intercept(f(g(h())))`.
+func (c *IdentityProviderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdentityProvider = append(c.inters.IdentityProvider, interceptors...)
 }
 
-// Create returns a builder for creating a MailVertifyCode entity.
-func (c *MailVertifyCodeClient) Create() *MailVertifyCodeCreate {
-	mutation := newMailVertifyCodeMutation(c.config, OpCreate)
-	return &MailVertifyCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a IdentityProvider entity.
+func (c *IdentityProviderClient) Create() *IdentityProviderCreate {
+	mutation := newIdentityProviderMutation(c.config, OpCreate)
+	return &IdentityProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MailVertifyCode entities.
-func (c *MailVertifyCodeClient) CreateBulk(builders ...*MailVertifyCodeCreate) *MailVertifyCodeCreateBulk {
-	return &MailVertifyCodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of IdentityProvider entities.
+func (c *IdentityProviderClient) CreateBulk(builders ...*IdentityProviderCreate) *IdentityProviderCreateBulk {
+	return &IdentityProviderCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MailVertifyCodeClient) MapCreateBulk(slice any, setFunc func(*MailVertifyCodeCreate, int)) *MailVertifyCodeCreateBulk {
+func (c *IdentityProviderClient) MapCreateBulk(slice any, setFunc func(*IdentityProviderCreate, int)) *IdentityProviderCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MailVertifyCodeCreateBulk{err: fmt.Errorf("calling to MailVertifyCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IdentityProviderCreateBulk{err: fmt.Errorf("calling to IdentityProviderClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MailVertifyCodeCreate, rv.Len())
+	builders := make([]*IdentityProviderCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MailVertifyCodeCreateBulk{config: c.config, builders: builders}
+	return &IdentityProviderCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MailVertifyCode.
-func (c *MailVertifyCodeClient) Update() *MailVertifyCodeUpdate {
-	mutation := newMailVertifyCodeMutation(c.config, OpUpdate)
-	return &MailVertifyCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for IdentityProvider.
+func (c *IdentityProviderClient) Update() *IdentityProviderUpdate {
+	mutation := newIdentityProviderMutation(c.config, OpUpdate)
+	return &IdentityProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MailVertifyCodeClient) UpdateOne(mvc *MailVertifyCode) *MailVertifyCodeUpdateOne {
-	mutation := newMailVertifyCodeMutation(c.config, OpUpdateOne, withMailVertifyCode(mvc))
-	return &MailVertifyCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdentityProviderClient) UpdateOne(ip *IdentityProvider) *IdentityProviderUpdateOne {
+	mutation := newIdentityProviderMutation(c.config, OpUpdateOne, withIdentityProvider(ip))
+	return &IdentityProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MailVertifyCodeClient) UpdateOneID(id uuid.UUID) *MailVertifyCodeUpdateOne {
-	mutation := newMailVertifyCodeMutation(c.config, OpUpdateOne, withMailVertifyCodeID(id))
-	return &MailVertifyCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IdentityProviderClient) UpdateOneID(id uuid.UUID) *IdentityProviderUpdateOne {
+	mutation := newIdentityProviderMutation(c.config, OpUpdateOne, withIdentityProviderID(id))
+	return &IdentityProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MailVertifyCode.
-func (c *MailVertifyCodeClient) Delete() *MailVertifyCodeDelete {
-	mutation := newMailVertifyCodeMutation(c.config, OpDelete)
-	return &MailVertifyCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for IdentityProvider.
+func (c *IdentityProviderClient) Delete() *IdentityProviderDelete {
+	mutation := newIdentityProviderMutation(c.config, OpDelete)
+	return &IdentityProviderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MailVertifyCodeClient) DeleteOne(mvc *MailVertifyCode) *MailVertifyCodeDeleteOne {
-	return c.DeleteOneID(mvc.ID)
+func (c *IdentityProviderClient) DeleteOne(ip *IdentityProvider) *IdentityProviderDeleteOne {
+	return c.DeleteOneID(ip.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MailVertifyCodeClient) DeleteOneID(id uuid.UUID) *MailVertifyCodeDeleteOne {
-	builder := c.Delete().Where(mailvertifycode.ID(id))
+func (c *IdentityProviderClient) DeleteOneID(id uuid.UUID) *IdentityProviderDeleteOne {
+	builder := c.Delete().Where(identityprovider.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MailVertifyCodeDeleteOne{builder}
+	return &IdentityProviderDeleteOne{builder}
 }
 
-// Query returns a query builder for MailVertifyCode.
-func (c *MailVertifyCodeClient) Query() *MailVertifyCodeQuery {
-	return &MailVertifyCodeQuery{
+// Query returns a query builder for IdentityProvider.
+func (c *IdentityProviderClient) Query() *IdentityProviderQuery {
+	return &IdentityProviderQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMailVertifyCode},
+		ctx:    &QueryContext{Type: TypeIdentityProvider},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MailVertifyCode entity by its id.
-func (c *MailVertifyCodeClient) Get(ctx context.Context, id uuid.UUID) (*MailVertifyCode, error) {
-	return c.Query().Where(mailvertifycode.ID(id)).Only(ctx)
+// Get returns a IdentityProvider entity by its id.
+func (c *IdentityProviderClient) Get(ctx context.Context, id uuid.UUID) (*IdentityProvider, error) {
+	return c.Query().Where(identityprovider.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MailVertifyCodeClient) GetX(ctx context.Context, id uuid.UUID) *MailVertifyCode {
+func (c *IdentityProviderClient) GetX(ctx context.Context, id uuid.UUID) *IdentityProvider {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1120,131 +1230,131 @@ func (c *MailVertifyCodeClient) GetX(ctx context.Context, id uuid.UUID) *MailVer
 }
 
 // Hooks returns the client hooks.
-func (c *MailVertifyCodeClient) Hooks() []Hook {
-	return c.hooks.MailVertifyCode
+func (c *IdentityProviderClient) Hooks() []Hook {
+	return c.hooks.IdentityProvider
 }
 
 // Interceptors returns the client interceptors.
-func (c *MailVertifyCodeClient) Interceptors() []Interceptor {
-	return c.inters.MailVertifyCode
+func (c *IdentityProviderClient) Interceptors() []Interceptor {
+	return c.inters.IdentityProvider
 }
 
-func (c *MailVertifyCodeClient) mutate(ctx context.Context, m *MailVertifyCodeMutation) (Value, error) {
+func (c *IdentityProviderClient) mutate(ctx context.Context, m *IdentityProviderMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MailVertifyCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityProviderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MailVertifyCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MailVertifyCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdentityProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MailVertifyCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&IdentityProviderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MailVertifyCode mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown IdentityProvider mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationClient is a client for the Organization schema.
-type OrganizationClient struct {
+// LoginLockClient is a client for the LoginLock schema.
+type LoginLockClient struct {
 	config
 }
 
-// NewOrganizationClient returns a client for the Organization from the given config.
-func NewOrganizationClient(c config) *OrganizationClient {
-	return &OrganizationClient{config: c}
+// NewLoginLockClient returns a client for the LoginLock from the given config.
+func NewLoginLockClient(c config) *LoginLockClient {
+	return &LoginLockClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
-func (c *OrganizationClient) Use(hooks ...Hook) {
-	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+// A call to `Use(f, g, h)` equals to `loginlock.Hooks(f(g(h())))`.
+func (c *LoginLockClient) Use(hooks ...Hook) {
+	c.hooks.LoginLock = append(c.hooks.LoginLock, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
-func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Organization = append(c.inters.Organization, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `loginlock.Intercept(f(g(h())))`.
+func (c *LoginLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginLock = append(c.inters.LoginLock, interceptors...)
 }
 
-// Create returns a builder for creating a Organization entity.
-func (c *OrganizationClient) Create() *OrganizationCreate {
-	mutation := newOrganizationMutation(c.config, OpCreate)
-	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LoginLock entity.
+func (c *LoginLockClient) Create() *LoginLockCreate {
+	mutation := newLoginLockMutation(c.config, OpCreate)
+	return &LoginLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Organization entities.
-func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LoginLock entities.
+func (c *LoginLockClient) CreateBulk(builders ...*LoginLockCreate) *LoginLockCreateBulk {
+	return &LoginLockCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+func (c *LoginLockClient) MapCreateBulk(slice any, setFunc func(*LoginLockCreate, int)) *LoginLockCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LoginLockCreateBulk{err: fmt.Errorf("calling to LoginLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationCreate, rv.Len())
+	builders := make([]*LoginLockCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+	return &LoginLockCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Organization.
-func (c *OrganizationClient) Update() *OrganizationUpdate {
-	mutation := newOrganizationMutation(c.config, OpUpdate)
-	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LoginLock.
+func (c *LoginLockClient) Update() *LoginLockUpdate {
+	mutation := newLoginLockMutation(c.config, OpUpdate)
+	return &LoginLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LoginLockClient) UpdateOne(ll *LoginLock) *LoginLockUpdateOne {
+	mutation := newLoginLockMutation(c.config, OpUpdateOne, withLoginLock(ll))
+	return &LoginLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationClient) UpdateOneID(id uuid.UUID) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LoginLockClient) UpdateOneID(id uuid.UUID) *LoginLockUpdateOne {
+	mutation := newLoginLockMutation(c.config, OpUpdateOne, withLoginLockID(id))
+	return &LoginLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Organization.
-func (c *OrganizationClient) Delete() *OrganizationDelete {
-	mutation := newOrganizationMutation(c.config, OpDelete)
-	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LoginLock.
+func (c *LoginLockClient) Delete() *LoginLockDelete {
+	mutation := newLoginLockMutation(c.config, OpDelete)
+	return &LoginLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
-	return c.DeleteOneID(o.ID)
+func (c *LoginLockClient) DeleteOne(ll *LoginLock) *LoginLockDeleteOne {
+	return c.DeleteOneID(ll.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationClient) DeleteOneID(id uuid.UUID) *OrganizationDeleteOne {
-	builder := c.Delete().Where(organization.ID(id))
+func (c *LoginLockClient) DeleteOneID(id uuid.UUID) *LoginLockDeleteOne {
+	builder := c.Delete().Where(loginlock.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationDeleteOne{builder}
+	return &LoginLockDeleteOne{builder}
 }
 
-// Query returns a query builder for Organization.
-func (c *OrganizationClient) Query() *OrganizationQuery {
-	return &OrganizationQuery{
+// Query returns a query builder for LoginLock.
+func (c *LoginLockClient) Query() *LoginLockQuery {
+	return &LoginLockQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganization},
+		ctx:    &QueryContext{Type: TypeLoginLock},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Organization entity by its id.
-func (c *OrganizationClient) Get(ctx context.Context, id uuid.UUID) (*Organization, error) {
-	return c.Query().Where(organization.ID(id)).Only(ctx)
+// Get returns a LoginLock entity by its id.
+func (c *LoginLockClient) Get(ctx context.Context, id uuid.UUID) (*LoginLock, error) {
+	return c.Query().Where(loginlock.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationClient) GetX(ctx context.Context, id uuid.UUID) *Organization {
+func (c *LoginLockClient) GetX(ctx context.Context, id uuid.UUID) *LoginLock {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1252,148 +1362,132 @@ func (c *OrganizationClient) GetX(ctx context.Context, id uuid.UUID) *Organizati
 	return obj
 }
 
-// QueryApplication queries the application edge of a Organization.
-func (c *OrganizationClient) QueryApplication(o *Organization) *ApplicationQuery {
-	query := (&ApplicationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(application.Table, application.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organization.ApplicationTable, organization.ApplicationColumn),
-		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *OrganizationClient) Hooks() []Hook {
-	return c.hooks.Organization
+func (c *LoginLockClient) Hooks() []Hook {
+	return c.hooks.LoginLock
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationClient) Interceptors() []Interceptor {
-	return c.inters.Organization
+func (c *LoginLockClient) Interceptors() []Interceptor {
+	return c.inters.LoginLock
 }
 
-func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+func (c *LoginLockClient) mutate(ctx context.Context, m *LoginLockMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LoginLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LoginLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LoginLock mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationApplicationClient is a client for the OrganizationApplication schema.
-type OrganizationApplicationClient struct {
+// MagicLinkClient is a client for the MagicLink schema.
+type MagicLinkClient struct {
 	config
 }
 
-// NewOrganizationApplicationClient returns a client for the OrganizationApplication from the given config.
-func NewOrganizationApplicationClient(c config) *OrganizationApplicationClient {
-	return &OrganizationApplicationClient{config: c}
+// NewMagicLinkClient returns a client for the MagicLink from the given config.
+func NewMagicLinkClient(c config) *MagicLinkClient {
+	return &MagicLinkClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organizationapplication.Hooks(f(g(h())))`.
-func (c *OrganizationApplicationClient) Use(hooks ...Hook) {
-	c.hooks.OrganizationApplication = append(c.hooks.OrganizationApplication, hooks...)
+// A call to `Use(f, g, h)` equals to `magiclink.Hooks(f(g(h())))`.
+func (c *MagicLinkClient) Use(hooks ...Hook) {
+	c.hooks.MagicLink = append(c.hooks.MagicLink, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organizationapplication.Intercept(f(g(h())))`.
-func (c *OrganizationApplicationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrganizationApplication = append(c.inters.OrganizationApplication, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `magiclink.Intercept(f(g(h())))`.
+func (c *MagicLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MagicLink = append(c.inters.MagicLink, interceptors...)
 }
 
-// Create returns a builder for creating a OrganizationApplication entity.
-func (c *OrganizationApplicationClient) Create() *OrganizationApplicationCreate {
-	mutation := newOrganizationApplicationMutation(c.config, OpCreate)
-	return &OrganizationApplicationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MagicLink entity.
+func (c *MagicLinkClient) Create() *MagicLinkCreate {
+	mutation := newMagicLinkMutation(c.config, OpCreate)
+	return &MagicLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrganizationApplication entities.
-func (c *OrganizationApplicationClient) CreateBulk(builders ...*OrganizationApplicationCreate) *OrganizationApplicationCreateBulk {
-	return &OrganizationApplicationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MagicLink entities.
+func (c *MagicLinkClient) CreateBulk(builders ...*MagicLinkCreate) *MagicLinkCreateBulk {
+	return &MagicLinkCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationApplicationClient) MapCreateBulk(slice any, setFunc func(*OrganizationApplicationCreate, int)) *OrganizationApplicationCreateBulk {
+func (c *MagicLinkClient) MapCreateBulk(slice any, setFunc func(*MagicLinkCreate, int)) *MagicLinkCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationApplicationCreateBulk{err: fmt.Errorf("calling to OrganizationApplicationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MagicLinkCreateBulk{err: fmt.Errorf("calling to MagicLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationApplicationCreate, rv.Len())
+	builders := make([]*MagicLinkCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationApplicationCreateBulk{config: c.config, builders: builders}
+	return &MagicLinkCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrganizationApplication.
-func (c *OrganizationApplicationClient) Update() *OrganizationApplicationUpdate {
-	mutation := newOrganizationApplicationMutation(c.config, OpUpdate)
-	return &OrganizationApplicationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MagicLink.
+func (c *MagicLinkClient) Update() *MagicLinkUpdate {
+	mutation := newMagicLinkMutation(c.config, OpUpdate)
+	return &MagicLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationApplicationClient) UpdateOne(oa *OrganizationApplication) *OrganizationApplicationUpdateOne {
-	mutation := newOrganizationApplicationMutation(c.config, OpUpdateOne, withOrganizationApplication(oa))
-	return &OrganizationApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MagicLinkClient) UpdateOne(ml *MagicLink) *MagicLinkUpdateOne {
+	mutation := newMagicLinkMutation(c.config, OpUpdateOne, withMagicLink(ml))
+	return &MagicLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationApplicationClient) UpdateOneID(id uuid.UUID) *OrganizationApplicationUpdateOne {
-	mutation := newOrganizationApplicationMutation(c.config, OpUpdateOne, withOrganizationApplicationID(id))
-	return &OrganizationApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MagicLinkClient) UpdateOneID(id uuid.UUID) *MagicLinkUpdateOne {
+	mutation := newMagicLinkMutation(c.config, OpUpdateOne, withMagicLinkID(id))
+	return &MagicLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrganizationApplication.
-func (c *OrganizationApplicationClient) Delete() *OrganizationApplicationDelete {
-	mutation := newOrganizationApplicationMutation(c.config, OpDelete)
-	return &OrganizationApplicationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for MagicLink.
+func (c *MagicLinkClient) Delete() *MagicLinkDelete {
+	mutation := newMagicLinkMutation(c.config, OpDelete)
+	return &MagicLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationApplicationClient) DeleteOne(oa *OrganizationApplication) *OrganizationApplicationDeleteOne {
-	return c.DeleteOneID(oa.ID)
+func (c *MagicLinkClient) DeleteOne(ml *MagicLink) *MagicLinkDeleteOne {
+	return c.DeleteOneID(ml.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationApplicationClient) DeleteOneID(id uuid.UUID) *OrganizationApplicationDeleteOne {
-	builder := c.Delete().Where(organizationapplication.ID(id))
+func (c *MagicLinkClient) DeleteOneID(id uuid.UUID) *MagicLinkDeleteOne {
+	builder := c.Delete().Where(magiclink.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationApplicationDeleteOne{builder}
+	return &MagicLinkDeleteOne{builder}
 }
 
-// Query returns a query builder for OrganizationApplication.
-func (c *OrganizationApplicationClient) Query() *OrganizationApplicationQuery {
-	return &OrganizationApplicationQuery{
+// Query returns a query builder for MagicLink.
+func (c *MagicLinkClient) Query() *MagicLinkQuery {
+	return &MagicLinkQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganizationApplication},
+		ctx:    &QueryContext{Type: TypeMagicLink},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrganizationApplication entity by its id.
-func (c *OrganizationApplicationClient) Get(ctx context.Context, id uuid.UUID) (*OrganizationApplication, error) {
-	return c.Query().Where(organizationapplication.ID(id)).Only(ctx)
+// Get returns a MagicLink entity by its id.
+func (c *MagicLinkClient) Get(ctx context.Context, id uuid.UUID) (*MagicLink, error) {
+	return c.Query().Where(magiclink.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationApplicationClient) GetX(ctx context.Context, id uuid.UUID) *OrganizationApplication {
+func (c *MagicLinkClient) GetX(ctx context.Context, id uuid.UUID) *MagicLink {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1401,148 +1495,1143 @@ func (c *OrganizationApplicationClient) GetX(ctx context.Context, id uuid.UUID)
 	return obj
 }
 
-// QueryApplication queries the application edge of a OrganizationApplication.
-func (c *OrganizationApplicationClient) QueryApplication(oa *OrganizationApplication) *ApplicationQuery {
-	query := (&ApplicationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := oa.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationapplication.Table, organizationapplication.FieldID, id),
-			sqlgraph.To(application.Table, application.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organizationapplication.ApplicationTable, organizationapplication.ApplicationColumn),
-		)
-		fromV = sqlgraph.Neighbors(oa.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *OrganizationApplicationClient) Hooks() []Hook {
-	return c.hooks.OrganizationApplication
+func (c *MagicLinkClient) Hooks() []Hook {
+	return c.hooks.MagicLink
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationApplicationClient) Interceptors() []Interceptor {
-	return c.inters.OrganizationApplication
+func (c *MagicLinkClient) Interceptors() []Interceptor {
+	return c.inters.MagicLink
 }
 
-func (c *OrganizationApplicationClient) mutate(ctx context.Context, m *OrganizationApplicationMutation) (Value, error) {
+func (c *MagicLinkClient) mutate(ctx context.Context, m *MagicLinkMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationApplicationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MagicLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationApplicationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MagicLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MagicLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationApplicationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MagicLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrganizationApplication mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MagicLink mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationRequestClient is a client for the OrganizationRequest schema.
-type OrganizationRequestClient struct {
+// MailVertifyCodeClient is a client for the MailVertifyCode schema.
+type MailVertifyCodeClient struct {
 	config
 }
 
-// NewOrganizationRequestClient returns a client for the OrganizationRequest from the given config.
-func NewOrganizationRequestClient(c config) *OrganizationRequestClient {
-	return &OrganizationRequestClient{config: c}
+// NewMailVertifyCodeClient returns a client for the MailVertifyCode from the given config.
+func NewMailVertifyCodeClient(c config) *MailVertifyCodeClient {
+	return &MailVertifyCodeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organizationrequest.Hooks(f(g(h())))`.
-func (c *OrganizationRequestClient) Use(hooks ...Hook) {
-	c.hooks.OrganizationRequest = append(c.hooks.OrganizationRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `mailvertifycode.Hooks(f(g(h())))`.
+func (c *MailVertifyCodeClient) Use(hooks ...Hook) {
+	c.hooks.MailVertifyCode = append(c.hooks.MailVertifyCode, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organizationrequest.Intercept(f(g(h())))`.
-func (c *OrganizationRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrganizationRequest = append(c.inters.OrganizationRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `mailvertifycode.Intercept(f(g(h())))`.
+func (c *MailVertifyCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MailVertifyCode = append(c.inters.MailVertifyCode, interceptors...)
 }
 
-// Create returns a builder for creating a OrganizationRequest entity.
-func (c *OrganizationRequestClient) Create() *OrganizationRequestCreate {
-	mutation := newOrganizationRequestMutation(c.config, OpCreate)
-	return &OrganizationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MailVertifyCode entity.
+func (c *MailVertifyCodeClient) Create() *MailVertifyCodeCreate {
+	mutation := newMailVertifyCodeMutation(c.config, OpCreate)
+	return &MailVertifyCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrganizationRequest entities.
-func (c *OrganizationRequestClient) CreateBulk(builders ...*OrganizationRequestCreate) *OrganizationRequestCreateBulk {
-	return &OrganizationRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MailVertifyCode entities.
+func (c *MailVertifyCodeClient) CreateBulk(builders ...*MailVertifyCodeCreate) *MailVertifyCodeCreateBulk {
+	return &MailVertifyCodeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationRequestClient) MapCreateBulk(slice any, setFunc func(*OrganizationRequestCreate, int)) *OrganizationRequestCreateBulk {
+func (c *MailVertifyCodeClient) MapCreateBulk(slice any, setFunc func(*MailVertifyCodeCreate, int)) *MailVertifyCodeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationRequestCreateBulk{err: fmt.Errorf("calling to OrganizationRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MailVertifyCodeCreateBulk{err: fmt.Errorf("calling to MailVertifyCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationRequestCreate, rv.Len())
+	builders := make([]*MailVertifyCodeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationRequestCreateBulk{config: c.config, builders: builders}
+	return &MailVertifyCodeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrganizationRequest.
-func (c *OrganizationRequestClient) Update() *OrganizationRequestUpdate {
-	mutation := newOrganizationRequestMutation(c.config, OpUpdate)
+// Update returns an update builder for MailVertifyCode.
+func (c *MailVertifyCodeClient) Update() *MailVertifyCodeUpdate {
+	mutation := newMailVertifyCodeMutation(c.config, OpUpdate)
+	return &MailVertifyCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MailVertifyCodeClient) UpdateOne(mvc *MailVertifyCode) *MailVertifyCodeUpdateOne {
+	mutation := newMailVertifyCodeMutation(c.config, OpUpdateOne, withMailVertifyCode(mvc))
+	return &MailVertifyCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MailVertifyCodeClient) UpdateOneID(id uuid.UUID) *MailVertifyCodeUpdateOne {
+	mutation := newMailVertifyCodeMutation(c.config, OpUpdateOne, withMailVertifyCodeID(id))
+	return &MailVertifyCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MailVertifyCode.
+func (c *MailVertifyCodeClient) Delete() *MailVertifyCodeDelete {
+	mutation := newMailVertifyCodeMutation(c.config, OpDelete)
+	return &MailVertifyCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MailVertifyCodeClient) DeleteOne(mvc *MailVertifyCode) *MailVertifyCodeDeleteOne {
+	return c.DeleteOneID(mvc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MailVertifyCodeClient) DeleteOneID(id uuid.UUID) *MailVertifyCodeDeleteOne {
+	builder := c.Delete().Where(mailvertifycode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MailVertifyCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for MailVertifyCode.
+func (c *MailVertifyCodeClient) Query() *MailVertifyCodeQuery {
+	return &MailVertifyCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMailVertifyCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MailVertifyCode entity by its id.
+func (c *MailVertifyCodeClient) Get(ctx context.Context, id uuid.UUID) (*MailVertifyCode, error) {
+	return c.Query().Where(mailvertifycode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MailVertifyCodeClient) GetX(ctx context.Context, id uuid.UUID) *MailVertifyCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MailVertifyCodeClient) Hooks() []Hook {
+	return c.hooks.MailVertifyCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *MailVertifyCodeClient) Interceptors() []Interceptor {
+	return c.inters.MailVertifyCode
+}
+
+func (c *MailVertifyCodeClient) mutate(ctx context.Context, m *MailVertifyCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MailVertifyCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MailVertifyCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MailVertifyCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MailVertifyCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MailVertifyCode mutation op: %q", m.Op())
+	}
+}
+
+// OAuthAuthorizationCodeClient is a client for the OAuthAuthorizationCode schema.
+type OAuthAuthorizationCodeClient struct {
+	config
+}
+
+// NewOAuthAuthorizationCodeClient returns a client for the OAuthAuthorizationCode from the given config.
+func NewOAuthAuthorizationCodeClient(c config) *OAuthAuthorizationCodeClient {
+	return &OAuthAuthorizationCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthauthorizationcode.Hooks(f(g(h())))`.
+func (c *OAuthAuthorizationCodeClient) Use(hooks ...Hook) {
+	c.hooks.OAuthAuthorizationCode = append(c.hooks.OAuthAuthorizationCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthauthorizationcode.Intercept(f(g(h())))`.
+func (c *OAuthAuthorizationCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuthAuthorizationCode = append(c.inters.OAuthAuthorizationCode, interceptors...)
+}
+
+// Create returns a builder for creating a OAuthAuthorizationCode entity.
+func (c *OAuthAuthorizationCodeClient) Create() *OAuthAuthorizationCodeCreate {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpCreate)
+	return &OAuthAuthorizationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OAuthAuthorizationCode entities.
+func (c *OAuthAuthorizationCodeClient) CreateBulk(builders ...*OAuthAuthorizationCodeCreate) *OAuthAuthorizationCodeCreateBulk {
+	return &OAuthAuthorizationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OAuthAuthorizationCodeClient) MapCreateBulk(slice any, setFunc func(*OAuthAuthorizationCodeCreate, int)) *OAuthAuthorizationCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OAuthAuthorizationCodeCreateBulk{err: fmt.Errorf("calling to OAuthAuthorizationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OAuthAuthorizationCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OAuthAuthorizationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OAuthAuthorizationCode.
+func (c *OAuthAuthorizationCodeClient) Update() *OAuthAuthorizationCodeUpdate {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpUpdate)
+	return &OAuthAuthorizationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OAuthAuthorizationCodeClient) UpdateOne(oac *OAuthAuthorizationCode) *OAuthAuthorizationCodeUpdateOne {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpUpdateOne, withOAuthAuthorizationCode(oac))
+	return &OAuthAuthorizationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OAuthAuthorizationCodeClient) UpdateOneID(id uuid.UUID) *OAuthAuthorizationCodeUpdateOne {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpUpdateOne, withOAuthAuthorizationCodeID(id))
+	return &OAuthAuthorizationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OAuthAuthorizationCode.
+func (c *OAuthAuthorizationCodeClient) Delete() *OAuthAuthorizationCodeDelete {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpDelete)
+	return &OAuthAuthorizationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OAuthAuthorizationCodeClient) DeleteOne(oac *OAuthAuthorizationCode) *OAuthAuthorizationCodeDeleteOne {
+	return c.DeleteOneID(oac.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OAuthAuthorizationCodeClient) DeleteOneID(id uuid.UUID) *OAuthAuthorizationCodeDeleteOne {
+	builder := c.Delete().Where(oauthauthorizationcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OAuthAuthorizationCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for OAuthAuthorizationCode.
+func (c *OAuthAuthorizationCodeClient) Query() *OAuthAuthorizationCodeQuery {
+	return &OAuthAuthorizationCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOAuthAuthorizationCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OAuthAuthorizationCode entity by its id.
+func (c *OAuthAuthorizationCodeClient) Get(ctx context.Context, id uuid.UUID) (*OAuthAuthorizationCode, error) {
+	return c.Query().Where(oauthauthorizationcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OAuthAuthorizationCodeClient) GetX(ctx context.Context, id uuid.UUID) *OAuthAuthorizationCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OAuthAuthorizationCodeClient) Hooks() []Hook {
+	return c.hooks.OAuthAuthorizationCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *OAuthAuthorizationCodeClient) Interceptors() []Interceptor {
+	return c.inters.OAuthAuthorizationCode
+}
+
+func (c *OAuthAuthorizationCodeClient) mutate(ctx context.Context, m *OAuthAuthorizationCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OAuthAuthorizationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OAuthAuthorizationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OAuthAuthorizationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OAuthAuthorizationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OAuthAuthorizationCode mutation op: %q", m.Op())
+	}
+}
+
+// OAuthConsentClient is a client for the OAuthConsent schema.
+type OAuthConsentClient struct {
+	config
+}
+
+// NewOAuthConsentClient returns a client for the OAuthConsent from the given config.
+func NewOAuthConsentClient(c config) *OAuthConsentClient {
+	return &OAuthConsentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthconsent.Hooks(f(g(h())))`.
+func (c *OAuthConsentClient) Use(hooks ...Hook) {
+	c.hooks.OAuthConsent = append(c.hooks.OAuthConsent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthconsent.Intercept(f(g(h())))`.
+func (c *OAuthConsentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuthConsent = append(c.inters.OAuthConsent, interceptors...)
+}
+
+// Create returns a builder for creating a OAuthConsent entity.
+func (c *OAuthConsentClient) Create() *OAuthConsentCreate {
+	mutation := newOAuthConsentMutation(c.config, OpCreate)
+	return &OAuthConsentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OAuthConsent entities.
+func (c *OAuthConsentClient) CreateBulk(builders ...*OAuthConsentCreate) *OAuthConsentCreateBulk {
+	return &OAuthConsentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OAuthConsentClient) MapCreateBulk(slice any, setFunc func(*OAuthConsentCreate, int)) *OAuthConsentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OAuthConsentCreateBulk{err: fmt.Errorf("calling to OAuthConsentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OAuthConsentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OAuthConsentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OAuthConsent.
+func (c *OAuthConsentClient) Update() *OAuthConsentUpdate {
+	mutation := newOAuthConsentMutation(c.config, OpUpdate)
+	return &OAuthConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OAuthConsentClient) UpdateOne(oc *OAuthConsent) *OAuthConsentUpdateOne {
+	mutation := newOAuthConsentMutation(c.config, OpUpdateOne, withOAuthConsent(oc))
+	return &OAuthConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OAuthConsentClient) UpdateOneID(id uuid.UUID) *OAuthConsentUpdateOne {
+	mutation := newOAuthConsentMutation(c.config, OpUpdateOne, withOAuthConsentID(id))
+	return &OAuthConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OAuthConsent.
+func (c *OAuthConsentClient) Delete() *OAuthConsentDelete {
+	mutation := newOAuthConsentMutation(c.config, OpDelete)
+	return &OAuthConsentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OAuthConsentClient) DeleteOne(oc *OAuthConsent) *OAuthConsentDeleteOne {
+	return c.DeleteOneID(oc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OAuthConsentClient) DeleteOneID(id uuid.UUID) *OAuthConsentDeleteOne {
+	builder := c.Delete().Where(oauthconsent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OAuthConsentDeleteOne{builder}
+}
+
+// Query returns a query builder for OAuthConsent.
+func (c *OAuthConsentClient) Query() *OAuthConsentQuery {
+	return &OAuthConsentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOAuthConsent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OAuthConsent entity by its id.
+func (c *OAuthConsentClient) Get(ctx context.Context, id uuid.UUID) (*OAuthConsent, error) {
+	return c.Query().Where(oauthconsent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OAuthConsentClient) GetX(ctx context.Context, id uuid.UUID) *OAuthConsent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OAuthConsentClient) Hooks() []Hook {
+	return c.hooks.OAuthConsent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OAuthConsentClient) Interceptors() []Interceptor {
+	return c.inters.OAuthConsent
+}
+
+func (c *OAuthConsentClient) mutate(ctx context.Context, m *OAuthConsentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OAuthConsentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OAuthConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OAuthConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OAuthConsentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OAuthConsent mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
+	config
+}
+
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
+}
+
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationClient) UpdateOneID(id uuid.UUID) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationClient) DeleteOneID(id uuid.UUID) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationDeleteOne{builder}
+}
+
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id uuid.UUID) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationClient) GetX(ctx context.Context, id uuid.UUID) *Organization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryApplication queries the application edge of a Organization.
+func (c *OrganizationClient) QueryApplication(o *Organization) *ApplicationQuery {
+	query := (&ApplicationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(application.Table, application.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organization.ApplicationTable, organization.ApplicationColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
+}
+
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationApplicationClient is a client for the OrganizationApplication schema.
+type OrganizationApplicationClient struct {
+	config
+}
+
+// NewOrganizationApplicationClient returns a client for the OrganizationApplication from the given config.
+func NewOrganizationApplicationClient(c config) *OrganizationApplicationClient {
+	return &OrganizationApplicationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organizationapplication.Hooks(f(g(h())))`.
+func (c *OrganizationApplicationClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationApplication = append(c.hooks.OrganizationApplication, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organizationapplication.Intercept(f(g(h())))`.
+func (c *OrganizationApplicationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrganizationApplication = append(c.inters.OrganizationApplication, interceptors...)
+}
+
+// Create returns a builder for creating a OrganizationApplication entity.
+func (c *OrganizationApplicationClient) Create() *OrganizationApplicationCreate {
+	mutation := newOrganizationApplicationMutation(c.config, OpCreate)
+	return &OrganizationApplicationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrganizationApplication entities.
+func (c *OrganizationApplicationClient) CreateBulk(builders ...*OrganizationApplicationCreate) *OrganizationApplicationCreateBulk {
+	return &OrganizationApplicationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationApplicationClient) MapCreateBulk(slice any, setFunc func(*OrganizationApplicationCreate, int)) *OrganizationApplicationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationApplicationCreateBulk{err: fmt.Errorf("calling to OrganizationApplicationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationApplicationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationApplicationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrganizationApplication.
+func (c *OrganizationApplicationClient) Update() *OrganizationApplicationUpdate {
+	mutation := newOrganizationApplicationMutation(c.config, OpUpdate)
+	return &OrganizationApplicationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationApplicationClient) UpdateOne(oa *OrganizationApplication) *OrganizationApplicationUpdateOne {
+	mutation := newOrganizationApplicationMutation(c.config, OpUpdateOne, withOrganizationApplication(oa))
+	return &OrganizationApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationApplicationClient) UpdateOneID(id uuid.UUID) *OrganizationApplicationUpdateOne {
+	mutation := newOrganizationApplicationMutation(c.config, OpUpdateOne, withOrganizationApplicationID(id))
+	return &OrganizationApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrganizationApplication.
+func (c *OrganizationApplicationClient) Delete() *OrganizationApplicationDelete {
+	mutation := newOrganizationApplicationMutation(c.config, OpDelete)
+	return &OrganizationApplicationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationApplicationClient) DeleteOne(oa *OrganizationApplication) *OrganizationApplicationDeleteOne {
+	return c.DeleteOneID(oa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationApplicationClient) DeleteOneID(id uuid.UUID) *OrganizationApplicationDeleteOne {
+	builder := c.Delete().Where(organizationapplication.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationApplicationDeleteOne{builder}
+}
+
+// Query returns a query builder for OrganizationApplication.
+func (c *OrganizationApplicationClient) Query() *OrganizationApplicationQuery {
+	return &OrganizationApplicationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganizationApplication},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrganizationApplication entity by its id.
+func (c *OrganizationApplicationClient) Get(ctx context.Context, id uuid.UUID) (*OrganizationApplication, error) {
+	return c.Query().Where(organizationapplication.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationApplicationClient) GetX(ctx context.Context, id uuid.UUID) *OrganizationApplication {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryApplication queries the application edge of a OrganizationApplication.
+func (c *OrganizationApplicationClient) QueryApplication(oa *OrganizationApplication) *ApplicationQuery {
+	query := (&ApplicationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := oa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationapplication.Table, organizationapplication.FieldID, id),
+			sqlgraph.To(application.Table, application.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationapplication.ApplicationTable, organizationapplication.ApplicationColumn),
+		)
+		fromV = sqlgraph.Neighbors(oa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationApplicationClient) Hooks() []Hook {
+	return c.hooks.OrganizationApplication
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationApplicationClient) Interceptors() []Interceptor {
+	return c.inters.OrganizationApplication
+}
+
+func (c *OrganizationApplicationClient) mutate(ctx context.Context, m *OrganizationApplicationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationApplicationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationApplicationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationApplicationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationApplicationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrganizationApplication mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationRequestClient is a client for the OrganizationRequest schema.
+type OrganizationRequestClient struct {
+	config
+}
+
+// NewOrganizationRequestClient returns a client for the OrganizationRequest from the given config.
+func NewOrganizationRequestClient(c config) *OrganizationRequestClient {
+	return &OrganizationRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organizationrequest.Hooks(f(g(h())))`.
+func (c *OrganizationRequestClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationRequest = append(c.hooks.OrganizationRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organizationrequest.Intercept(f(g(h())))`.
+func (c *OrganizationRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrganizationRequest = append(c.inters.OrganizationRequest, interceptors...)
+}
+
+// Create returns a builder for creating a OrganizationRequest entity.
+func (c *OrganizationRequestClient) Create() *OrganizationRequestCreate {
+	mutation := newOrganizationRequestMutation(c.config, OpCreate)
+	return &OrganizationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrganizationRequest entities.
+func (c *OrganizationRequestClient) CreateBulk(builders ...*OrganizationRequestCreate) *OrganizationRequestCreateBulk {
+	return &OrganizationRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationRequestClient) MapCreateBulk(slice any, setFunc func(*OrganizationRequestCreate, int)) *OrganizationRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationRequestCreateBulk{err: fmt.Errorf("calling to OrganizationRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrganizationRequest.
+func (c *OrganizationRequestClient) Update() *OrganizationRequestUpdate {
+	mutation := newOrganizationRequestMutation(c.config, OpUpdate)
 	return &OrganizationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationRequestClient) UpdateOne(or *OrganizationRequest) *OrganizationRequestUpdateOne {
-	mutation := newOrganizationRequestMutation(c.config, OpUpdateOne, withOrganizationRequest(or))
-	return &OrganizationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationRequestClient) UpdateOne(or *OrganizationRequest) *OrganizationRequestUpdateOne {
+	mutation := newOrganizationRequestMutation(c.config, OpUpdateOne, withOrganizationRequest(or))
+	return &OrganizationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationRequestClient) UpdateOneID(id uuid.UUID) *OrganizationRequestUpdateOne {
+	mutation := newOrganizationRequestMutation(c.config, OpUpdateOne, withOrganizationRequestID(id))
+	return &OrganizationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrganizationRequest.
+func (c *OrganizationRequestClient) Delete() *OrganizationRequestDelete {
+	mutation := newOrganizationRequestMutation(c.config, OpDelete)
+	return &OrganizationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationRequestClient) DeleteOne(or *OrganizationRequest) *OrganizationRequestDeleteOne {
+	return c.DeleteOneID(or.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationRequestClient) DeleteOneID(id uuid.UUID) *OrganizationRequestDeleteOne {
+	builder := c.Delete().Where(organizationrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for OrganizationRequest.
+func (c *OrganizationRequestClient) Query() *OrganizationRequestQuery {
+	return &OrganizationRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganizationRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrganizationRequest entity by its id.
+func (c *OrganizationRequestClient) Get(ctx context.Context, id uuid.UUID) (*OrganizationRequest, error) {
+	return c.Query().Where(organizationrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationRequestClient) GetX(ctx context.Context, id uuid.UUID) *OrganizationRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationRequestClient) Hooks() []Hook {
+	return c.hooks.OrganizationRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationRequestClient) Interceptors() []Interceptor {
+	return c.inters.OrganizationRequest
+}
+
+func (c *OrganizationRequestClient) mutate(ctx context.Context, m *OrganizationRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrganizationRequest mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationUserClient is a client for the OrganizationUser schema.
+type OrganizationUserClient struct {
+	config
+}
+
+// NewOrganizationUserClient returns a client for the OrganizationUser from the given config.
+func NewOrganizationUserClient(c config) *OrganizationUserClient {
+	return &OrganizationUserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organizationuser.Hooks(f(g(h())))`.
+func (c *OrganizationUserClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationUser = append(c.hooks.OrganizationUser, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organizationuser.Intercept(f(g(h())))`.
+func (c *OrganizationUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrganizationUser = append(c.inters.OrganizationUser, interceptors...)
+}
+
+// Create returns a builder for creating a OrganizationUser entity.
+func (c *OrganizationUserClient) Create() *OrganizationUserCreate {
+	mutation := newOrganizationUserMutation(c.config, OpCreate)
+	return &OrganizationUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrganizationUser entities.
+func (c *OrganizationUserClient) CreateBulk(builders ...*OrganizationUserCreate) *OrganizationUserCreateBulk {
+	return &OrganizationUserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationUserClient) MapCreateBulk(slice any, setFunc func(*OrganizationUserCreate, int)) *OrganizationUserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationUserCreateBulk{err: fmt.Errorf("calling to OrganizationUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationUserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationUserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrganizationUser.
+func (c *OrganizationUserClient) Update() *OrganizationUserUpdate {
+	mutation := newOrganizationUserMutation(c.config, OpUpdate)
+	return &OrganizationUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationUserClient) UpdateOne(ou *OrganizationUser) *OrganizationUserUpdateOne {
+	mutation := newOrganizationUserMutation(c.config, OpUpdateOne, withOrganizationUser(ou))
+	return &OrganizationUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationUserClient) UpdateOneID(id uuid.UUID) *OrganizationUserUpdateOne {
+	mutation := newOrganizationUserMutation(c.config, OpUpdateOne, withOrganizationUserID(id))
+	return &OrganizationUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrganizationUser.
+func (c *OrganizationUserClient) Delete() *OrganizationUserDelete {
+	mutation := newOrganizationUserMutation(c.config, OpDelete)
+	return &OrganizationUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationUserClient) DeleteOne(ou *OrganizationUser) *OrganizationUserDeleteOne {
+	return c.DeleteOneID(ou.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationUserClient) DeleteOneID(id uuid.UUID) *OrganizationUserDeleteOne {
+	builder := c.Delete().Where(organizationuser.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationUserDeleteOne{builder}
+}
+
+// Query returns a query builder for OrganizationUser.
+func (c *OrganizationUserClient) Query() *OrganizationUserQuery {
+	return &OrganizationUserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganizationUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrganizationUser entity by its id.
+func (c *OrganizationUserClient) Get(ctx context.Context, id uuid.UUID) (*OrganizationUser, error) {
+	return c.Query().Where(organizationuser.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationUserClient) GetX(ctx context.Context, id uuid.UUID) *OrganizationUser {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a OrganizationUser.
+func (c *OrganizationUserClient) QueryOrganization(ou *OrganizationUser) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ou.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationuser.Table, organizationuser.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, organizationuser.OrganizationTable, organizationuser.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(ou.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a OrganizationUser.
+func (c *OrganizationUserClient) QueryUser(ou *OrganizationUser) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ou.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationuser.Table, organizationuser.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, organizationuser.UserTable, organizationuser.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ou.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRole queries the role edge of a OrganizationUser.
+func (c *OrganizationUserClient) QueryRole(ou *OrganizationUser) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ou.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizationuser.Table, organizationuser.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, organizationuser.RoleTable, organizationuser.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(ou.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationUserClient) Hooks() []Hook {
+	return c.hooks.OrganizationUser
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationUserClient) Interceptors() []Interceptor {
+	return c.inters.OrganizationUser
+}
+
+func (c *OrganizationUserClient) mutate(ctx context.Context, m *OrganizationUserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrganizationUser mutation op: %q", m.Op())
+	}
+}
+
+// PasskeyCredentialClient is a client for the PasskeyCredential schema.
+type PasskeyCredentialClient struct {
+	config
+}
+
+// NewPasskeyCredentialClient returns a client for the PasskeyCredential from the given config.
+func NewPasskeyCredentialClient(c config) *PasskeyCredentialClient {
+	return &PasskeyCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passkeycredential.Hooks(f(g(h())))`.
+func (c *PasskeyCredentialClient) Use(hooks ...Hook) {
+	c.hooks.PasskeyCredential = append(c.hooks.PasskeyCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passkeycredential.Intercept(f(g(h())))`.
+func (c *PasskeyCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasskeyCredential = append(c.inters.PasskeyCredential, interceptors...)
+}
+
+// Create returns a builder for creating a PasskeyCredential entity.
+func (c *PasskeyCredentialClient) Create() *PasskeyCredentialCreate {
+	mutation := newPasskeyCredentialMutation(c.config, OpCreate)
+	return &PasskeyCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasskeyCredential entities.
+func (c *PasskeyCredentialClient) CreateBulk(builders ...*PasskeyCredentialCreate) *PasskeyCredentialCreateBulk {
+	return &PasskeyCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasskeyCredentialClient) MapCreateBulk(slice any, setFunc func(*PasskeyCredentialCreate, int)) *PasskeyCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasskeyCredentialCreateBulk{err: fmt.Errorf("calling to PasskeyCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasskeyCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasskeyCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasskeyCredential.
+func (c *PasskeyCredentialClient) Update() *PasskeyCredentialUpdate {
+	mutation := newPasskeyCredentialMutation(c.config, OpUpdate)
+	return &PasskeyCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasskeyCredentialClient) UpdateOne(pc *PasskeyCredential) *PasskeyCredentialUpdateOne {
+	mutation := newPasskeyCredentialMutation(c.config, OpUpdateOne, withPasskeyCredential(pc))
+	return &PasskeyCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationRequestClient) UpdateOneID(id uuid.UUID) *OrganizationRequestUpdateOne {
-	mutation := newOrganizationRequestMutation(c.config, OpUpdateOne, withOrganizationRequestID(id))
-	return &OrganizationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PasskeyCredentialClient) UpdateOneID(id uuid.UUID) *PasskeyCredentialUpdateOne {
+	mutation := newPasskeyCredentialMutation(c.config, OpUpdateOne, withPasskeyCredentialID(id))
+	return &PasskeyCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrganizationRequest.
-func (c *OrganizationRequestClient) Delete() *OrganizationRequestDelete {
-	mutation := newOrganizationRequestMutation(c.config, OpDelete)
-	return &OrganizationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PasskeyCredential.
+func (c *PasskeyCredentialClient) Delete() *PasskeyCredentialDelete {
+	mutation := newPasskeyCredentialMutation(c.config, OpDelete)
+	return &PasskeyCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationRequestClient) DeleteOne(or *OrganizationRequest) *OrganizationRequestDeleteOne {
-	return c.DeleteOneID(or.ID)
+func (c *PasskeyCredentialClient) DeleteOne(pc *PasskeyCredential) *PasskeyCredentialDeleteOne {
+	return c.DeleteOneID(pc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationRequestClient) DeleteOneID(id uuid.UUID) *OrganizationRequestDeleteOne {
-	builder := c.Delete().Where(organizationrequest.ID(id))
+func (c *PasskeyCredentialClient) DeleteOneID(id uuid.UUID) *PasskeyCredentialDeleteOne {
+	builder := c.Delete().Where(passkeycredential.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationRequestDeleteOne{builder}
+	return &PasskeyCredentialDeleteOne{builder}
 }
 
-// Query returns a query builder for OrganizationRequest.
-func (c *OrganizationRequestClient) Query() *OrganizationRequestQuery {
-	return &OrganizationRequestQuery{
+// Query returns a query builder for PasskeyCredential.
+func (c *PasskeyCredentialClient) Query() *PasskeyCredentialQuery {
+	return &PasskeyCredentialQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganizationRequest},
+		ctx:    &QueryContext{Type: TypePasskeyCredential},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrganizationRequest entity by its id.
-func (c *OrganizationRequestClient) Get(ctx context.Context, id uuid.UUID) (*OrganizationRequest, error) {
-	return c.Query().Where(organizationrequest.ID(id)).Only(ctx)
+// Get returns a PasskeyCredential entity by its id.
+func (c *PasskeyCredentialClient) Get(ctx context.Context, id uuid.UUID) (*PasskeyCredential, error) {
+	return c.Query().Where(passkeycredential.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationRequestClient) GetX(ctx context.Context, id uuid.UUID) *OrganizationRequest {
+func (c *PasskeyCredentialClient) GetX(ctx context.Context, id uuid.UUID) *PasskeyCredential {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1550,132 +2639,148 @@ func (c *OrganizationRequestClient) GetX(ctx context.Context, id uuid.UUID) *Org
 	return obj
 }
 
+// QueryUser queries the user edge of a PasskeyCredential.
+func (c *PasskeyCredentialClient) QueryUser(pc *PasskeyCredential) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passkeycredential.Table, passkeycredential.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, passkeycredential.UserTable, passkeycredential.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *OrganizationRequestClient) Hooks() []Hook {
-	return c.hooks.OrganizationRequest
+func (c *PasskeyCredentialClient) Hooks() []Hook {
+	return c.hooks.PasskeyCredential
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationRequestClient) Interceptors() []Interceptor {
-	return c.inters.OrganizationRequest
+func (c *PasskeyCredentialClient) Interceptors() []Interceptor {
+	return c.inters.PasskeyCredential
 }
 
-func (c *OrganizationRequestClient) mutate(ctx context.Context, m *OrganizationRequestMutation) (Value, error) {
+func (c *PasskeyCredentialClient) mutate(ctx context.Context, m *PasskeyCredentialMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PasskeyCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PasskeyCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PasskeyCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PasskeyCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrganizationRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PasskeyCredential mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationUserClient is a client for the OrganizationUser schema.
-type OrganizationUserClient struct {
+// PaymentClient is a client for the Payment schema.
+type PaymentClient struct {
 	config
 }
 
-// NewOrganizationUserClient returns a client for the OrganizationUser from the given config.
-func NewOrganizationUserClient(c config) *OrganizationUserClient {
-	return &OrganizationUserClient{config: c}
+// NewPaymentClient returns a client for the Payment from the given config.
+func NewPaymentClient(c config) *PaymentClient {
+	return &PaymentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organizationuser.Hooks(f(g(h())))`.
-func (c *OrganizationUserClient) Use(hooks ...Hook) {
-	c.hooks.OrganizationUser = append(c.hooks.OrganizationUser, hooks...)
+// A call to `Use(f, g, h)` equals to `payment.Hooks(f(g(h())))`.
+func (c *PaymentClient) Use(hooks ...Hook) {
+	c.hooks.Payment = append(c.hooks.Payment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organizationuser.Intercept(f(g(h())))`.
-func (c *OrganizationUserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrganizationUser = append(c.inters.OrganizationUser, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `payment.Intercept(f(g(h())))`.
+func (c *PaymentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Payment = append(c.inters.Payment, interceptors...)
 }
 
-// Create returns a builder for creating a OrganizationUser entity.
-func (c *OrganizationUserClient) Create() *OrganizationUserCreate {
-	mutation := newOrganizationUserMutation(c.config, OpCreate)
-	return &OrganizationUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Payment entity.
+func (c *PaymentClient) Create() *PaymentCreate {
+	mutation := newPaymentMutation(c.config, OpCreate)
+	return &PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrganizationUser entities.
-func (c *OrganizationUserClient) CreateBulk(builders ...*OrganizationUserCreate) *OrganizationUserCreateBulk {
-	return &OrganizationUserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Payment entities.
+func (c *PaymentClient) CreateBulk(builders ...*PaymentCreate) *PaymentCreateBulk {
+	return &PaymentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationUserClient) MapCreateBulk(slice any, setFunc func(*OrganizationUserCreate, int)) *OrganizationUserCreateBulk {
+func (c *PaymentClient) MapCreateBulk(slice any, setFunc func(*PaymentCreate, int)) *PaymentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationUserCreateBulk{err: fmt.Errorf("calling to OrganizationUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PaymentCreateBulk{err: fmt.Errorf("calling to PaymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationUserCreate, rv.Len())
+	builders := make([]*PaymentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationUserCreateBulk{config: c.config, builders: builders}
+	return &PaymentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrganizationUser.
-func (c *OrganizationUserClient) Update() *OrganizationUserUpdate {
-	mutation := newOrganizationUserMutation(c.config, OpUpdate)
-	return &OrganizationUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Payment.
+func (c *PaymentClient) Update() *PaymentUpdate {
+	mutation := newPaymentMutation(c.config, OpUpdate)
+	return &PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationUserClient) UpdateOne(ou *OrganizationUser) *OrganizationUserUpdateOne {
-	mutation := newOrganizationUserMutation(c.config, OpUpdateOne, withOrganizationUser(ou))
-	return &OrganizationUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentClient) UpdateOne(pa *Payment) *PaymentUpdateOne {
+	mutation := newPaymentMutation(c.config, OpUpdateOne, withPayment(pa))
+	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationUserClient) UpdateOneID(id uuid.UUID) *OrganizationUserUpdateOne {
-	mutation := newOrganizationUserMutation(c.config, OpUpdateOne, withOrganizationUserID(id))
-	return &OrganizationUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PaymentClient) UpdateOneID(id int) *PaymentUpdateOne {
+	mutation := newPaymentMutation(c.config, OpUpdateOne, withPaymentID(id))
+	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrganizationUser.
-func (c *OrganizationUserClient) Delete() *OrganizationUserDelete {
-	mutation := newOrganizationUserMutation(c.config, OpDelete)
-	return &OrganizationUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Payment.
+func (c *PaymentClient) Delete() *PaymentDelete {
+	mutation := newPaymentMutation(c.config, OpDelete)
+	return &PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationUserClient) DeleteOne(ou *OrganizationUser) *OrganizationUserDeleteOne {
-	return c.DeleteOneID(ou.ID)
+func (c *PaymentClient) DeleteOne(pa *Payment) *PaymentDeleteOne {
+	return c.DeleteOneID(pa.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationUserClient) DeleteOneID(id uuid.UUID) *OrganizationUserDeleteOne {
-	builder := c.Delete().Where(organizationuser.ID(id))
+func (c *PaymentClient) DeleteOneID(id int) *PaymentDeleteOne {
+	builder := c.Delete().Where(payment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationUserDeleteOne{builder}
+	return &PaymentDeleteOne{builder}
 }
 
-// Query returns a query builder for OrganizationUser.
-func (c *OrganizationUserClient) Query() *OrganizationUserQuery {
-	return &OrganizationUserQuery{
+// Query returns a query builder for Payment.
+func (c *PaymentClient) Query() *PaymentQuery {
+	return &PaymentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganizationUser},
+		ctx:    &QueryContext{Type: TypePayment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrganizationUser entity by its id.
-func (c *OrganizationUserClient) Get(ctx context.Context, id uuid.UUID) (*OrganizationUser, error) {
-	return c.Query().Where(organizationuser.ID(id)).Only(ctx)
+// Get returns a Payment entity by its id.
+func (c *PaymentClient) Get(ctx context.Context, id int) (*Payment, error) {
+	return c.Query().Where(payment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationUserClient) GetX(ctx context.Context, id uuid.UUID) *OrganizationUser {
+func (c *PaymentClient) GetX(ctx context.Context, id int) *Payment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1683,180 +2788,281 @@ func (c *OrganizationUserClient) GetX(ctx context.Context, id uuid.UUID) *Organi
 	return obj
 }
 
-// QueryOrganization queries the organization edge of a OrganizationUser.
-func (c *OrganizationUserClient) QueryOrganization(ou *OrganizationUser) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryUser queries the user edge of a Payment.
+func (c *PaymentClient) QueryUser(pa *Payment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ou.ID
+		id := pa.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationuser.Table, organizationuser.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, organizationuser.OrganizationTable, organizationuser.OrganizationColumn),
+			sqlgraph.From(payment.Table, payment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, payment.UserTable, payment.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(ou.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryUser queries the user edge of a OrganizationUser.
-func (c *OrganizationUserClient) QueryUser(ou *OrganizationUser) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ou.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationuser.Table, organizationuser.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, organizationuser.UserTable, organizationuser.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(ou.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *PaymentClient) Hooks() []Hook {
+	return c.hooks.Payment
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentClient) Interceptors() []Interceptor {
+	return c.inters.Payment
+}
+
+func (c *PaymentClient) mutate(ctx context.Context, m *PaymentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Payment mutation op: %q", m.Op())
+	}
+}
+
+// QRLoginClient is a client for the QRLogin schema.
+type QRLoginClient struct {
+	config
+}
+
+// NewQRLoginClient returns a client for the QRLogin from the given config.
+func NewQRLoginClient(c config) *QRLoginClient {
+	return &QRLoginClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `qrlogin.Hooks(f(g(h())))`.
+func (c *QRLoginClient) Use(hooks ...Hook) {
+	c.hooks.QRLogin = append(c.hooks.QRLogin, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `qrlogin.Intercept(f(g(h())))`.
+func (c *QRLoginClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QRLogin = append(c.inters.QRLogin, interceptors...)
+}
+
+// Create returns a builder for creating a QRLogin entity.
+func (c *QRLoginClient) Create() *QRLoginCreate {
+	mutation := newQRLoginMutation(c.config, OpCreate)
+	return &QRLoginCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QRLogin entities.
+func (c *QRLoginClient) CreateBulk(builders ...*QRLoginCreate) *QRLoginCreateBulk {
+	return &QRLoginCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QRLoginClient) MapCreateBulk(slice any, setFunc func(*QRLoginCreate, int)) *QRLoginCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QRLoginCreateBulk{err: fmt.Errorf("calling to QRLoginClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QRLoginCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QRLoginCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QRLogin.
+func (c *QRLoginClient) Update() *QRLoginUpdate {
+	mutation := newQRLoginMutation(c.config, OpUpdate)
+	return &QRLoginUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QRLoginClient) UpdateOne(ql *QRLogin) *QRLoginUpdateOne {
+	mutation := newQRLoginMutation(c.config, OpUpdateOne, withQRLogin(ql))
+	return &QRLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QRLoginClient) UpdateOneID(id uuid.UUID) *QRLoginUpdateOne {
+	mutation := newQRLoginMutation(c.config, OpUpdateOne, withQRLoginID(id))
+	return &QRLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QRLogin.
+func (c *QRLoginClient) Delete() *QRLoginDelete {
+	mutation := newQRLoginMutation(c.config, OpDelete)
+	return &QRLoginDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QRLoginClient) DeleteOne(ql *QRLogin) *QRLoginDeleteOne {
+	return c.DeleteOneID(ql.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QRLoginClient) DeleteOneID(id uuid.UUID) *QRLoginDeleteOne {
+	builder := c.Delete().Where(qrlogin.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QRLoginDeleteOne{builder}
+}
+
+// Query returns a query builder for QRLogin.
+func (c *QRLoginClient) Query() *QRLoginQuery {
+	return &QRLoginQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQRLogin},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryRole queries the role edge of a OrganizationUser.
-func (c *OrganizationUserClient) QueryRole(ou *OrganizationUser) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ou.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationuser.Table, organizationuser.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, organizationuser.RoleTable, organizationuser.RoleColumn),
-		)
-		fromV = sqlgraph.Neighbors(ou.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a QRLogin entity by its id.
+func (c *QRLoginClient) Get(ctx context.Context, id uuid.UUID) (*QRLogin, error) {
+	return c.Query().Where(qrlogin.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QRLoginClient) GetX(ctx context.Context, id uuid.UUID) *QRLogin {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationUserClient) Hooks() []Hook {
-	return c.hooks.OrganizationUser
+func (c *QRLoginClient) Hooks() []Hook {
+	return c.hooks.QRLogin
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationUserClient) Interceptors() []Interceptor {
-	return c.inters.OrganizationUser
+func (c *QRLoginClient) Interceptors() []Interceptor {
+	return c.inters.QRLogin
 }
 
-func (c *OrganizationUserClient) mutate(ctx context.Context, m *OrganizationUserMutation) (Value, error) {
+func (c *QRLoginClient) mutate(ctx context.Context, m *QRLoginMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QRLoginCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QRLoginUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QRLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&QRLoginDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrganizationUser mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown QRLogin mutation op: %q", m.Op())
 	}
 }
 
-// PaymentClient is a client for the Payment schema.
-type PaymentClient struct {
+// QyWechatCorpClient is a client for the QyWechatCorp schema.
+type QyWechatCorpClient struct {
 	config
 }
 
-// NewPaymentClient returns a client for the Payment from the given config.
-func NewPaymentClient(c config) *PaymentClient {
-	return &PaymentClient{config: c}
+// NewQyWechatCorpClient returns a client for the QyWechatCorp from the given config.
+func NewQyWechatCorpClient(c config) *QyWechatCorpClient {
+	return &QyWechatCorpClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `payment.Hooks(f(g(h())))`.
-func (c *PaymentClient) Use(hooks ...Hook) {
-	c.hooks.Payment = append(c.hooks.Payment, hooks...)
+// A call to `Use(f, g, h)` equals to `qywechatcorp.Hooks(f(g(h())))`.
+func (c *QyWechatCorpClient) Use(hooks ...Hook) {
+	c.hooks.QyWechatCorp = append(c.hooks.QyWechatCorp, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `payment.Intercept(f(g(h())))`.
-func (c *PaymentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Payment = append(c.inters.Payment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `qywechatcorp.Intercept(f(g(h())))`.
+func (c *QyWechatCorpClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QyWechatCorp = append(c.inters.QyWechatCorp, interceptors...)
 }
 
-// Create returns a builder for creating a Payment entity.
-func (c *PaymentClient) Create() *PaymentCreate {
-	mutation := newPaymentMutation(c.config, OpCreate)
-	return &PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a QyWechatCorp entity.
+func (c *QyWechatCorpClient) Create() *QyWechatCorpCreate {
+	mutation := newQyWechatCorpMutation(c.config, OpCreate)
+	return &QyWechatCorpCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Payment entities.
-func (c *PaymentClient) CreateBulk(builders ...*PaymentCreate) *PaymentCreateBulk {
-	return &PaymentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of QyWechatCorp entities.
+func (c *QyWechatCorpClient) CreateBulk(builders ...*QyWechatCorpCreate) *QyWechatCorpCreateBulk {
+	return &QyWechatCorpCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentClient) MapCreateBulk(slice any, setFunc func(*PaymentCreate, int)) *PaymentCreateBulk {
+func (c *QyWechatCorpClient) MapCreateBulk(slice any, setFunc func(*QyWechatCorpCreate, int)) *QyWechatCorpCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentCreateBulk{err: fmt.Errorf("calling to PaymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QyWechatCorpCreateBulk{err: fmt.Errorf("calling to QyWechatCorpClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentCreate, rv.Len())
+	builders := make([]*QyWechatCorpCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentCreateBulk{config: c.config, builders: builders}
+	return &QyWechatCorpCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Payment.
-func (c *PaymentClient) Update() *PaymentUpdate {
-	mutation := newPaymentMutation(c.config, OpUpdate)
-	return &PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for QyWechatCorp.
+func (c *QyWechatCorpClient) Update() *QyWechatCorpUpdate {
+	mutation := newQyWechatCorpMutation(c.config, OpUpdate)
+	return &QyWechatCorpUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentClient) UpdateOne(pa *Payment) *PaymentUpdateOne {
-	mutation := newPaymentMutation(c.config, OpUpdateOne, withPayment(pa))
-	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QyWechatCorpClient) UpdateOne(qwc *QyWechatCorp) *QyWechatCorpUpdateOne {
+	mutation := newQyWechatCorpMutation(c.config, OpUpdateOne, withQyWechatCorp(qwc))
+	return &QyWechatCorpUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentClient) UpdateOneID(id int) *PaymentUpdateOne {
-	mutation := newPaymentMutation(c.config, OpUpdateOne, withPaymentID(id))
-	return &PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QyWechatCorpClient) UpdateOneID(id uuid.UUID) *QyWechatCorpUpdateOne {
+	mutation := newQyWechatCorpMutation(c.config, OpUpdateOne, withQyWechatCorpID(id))
+	return &QyWechatCorpUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Payment.
-func (c *PaymentClient) Delete() *PaymentDelete {
-	mutation := newPaymentMutation(c.config, OpDelete)
-	return &PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for QyWechatCorp.
+func (c *QyWechatCorpClient) Delete() *QyWechatCorpDelete {
+	mutation := newQyWechatCorpMutation(c.config, OpDelete)
+	return &QyWechatCorpDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentClient) DeleteOne(pa *Payment) *PaymentDeleteOne {
-	return c.DeleteOneID(pa.ID)
+func (c *QyWechatCorpClient) DeleteOne(qwc *QyWechatCorp) *QyWechatCorpDeleteOne {
+	return c.DeleteOneID(qwc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentClient) DeleteOneID(id int) *PaymentDeleteOne {
-	builder := c.Delete().Where(payment.ID(id))
+func (c *QyWechatCorpClient) DeleteOneID(id uuid.UUID) *QyWechatCorpDeleteOne {
+	builder := c.Delete().Where(qywechatcorp.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentDeleteOne{builder}
+	return &QyWechatCorpDeleteOne{builder}
 }
 
-// Query returns a query builder for Payment.
-func (c *PaymentClient) Query() *PaymentQuery {
-	return &PaymentQuery{
+// Query returns a query builder for QyWechatCorp.
+func (c *QyWechatCorpClient) Query() *QyWechatCorpQuery {
+	return &QyWechatCorpQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePayment},
+		ctx:    &QueryContext{Type: TypeQyWechatCorp},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Payment entity by its id.
-func (c *PaymentClient) Get(ctx context.Context, id int) (*Payment, error) {
-	return c.Query().Where(payment.ID(id)).Only(ctx)
+// Get returns a QyWechatCorp entity by its id.
+func (c *QyWechatCorpClient) Get(ctx context.Context, id uuid.UUID) (*QyWechatCorp, error) {
+	return c.Query().Where(qywechatcorp.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentClient) GetX(ctx context.Context, id int) *Payment {
+func (c *QyWechatCorpClient) GetX(ctx context.Context, id uuid.UUID) *QyWechatCorp {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1864,44 +3070,28 @@ func (c *PaymentClient) GetX(ctx context.Context, id int) *Payment {
 	return obj
 }
 
-// QueryUser queries the user edge of a Payment.
-func (c *PaymentClient) QueryUser(pa *Payment) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pa.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(payment.Table, payment.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, payment.UserTable, payment.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PaymentClient) Hooks() []Hook {
-	return c.hooks.Payment
+func (c *QyWechatCorpClient) Hooks() []Hook {
+	return c.hooks.QyWechatCorp
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentClient) Interceptors() []Interceptor {
-	return c.inters.Payment
+func (c *QyWechatCorpClient) Interceptors() []Interceptor {
+	return c.inters.QyWechatCorp
 }
 
-func (c *PaymentClient) mutate(ctx context.Context, m *PaymentMutation) (Value, error) {
+func (c *QyWechatCorpClient) mutate(ctx context.Context, m *QyWechatCorpMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QyWechatCorpCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QyWechatCorpUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QyWechatCorpUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&QyWechatCorpDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Payment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown QyWechatCorp mutation op: %q", m.Op())
 	}
 }
 
@@ -2191,31 +3381,297 @@ func (c *RoleClient) QueryApplication(r *Role) *ApplicationQuery {
 		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
+}
+
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+	}
+}
+
+// RotatedRefreshTokenClient is a client for the RotatedRefreshToken schema.
+type RotatedRefreshTokenClient struct {
+	config
+}
+
+// NewRotatedRefreshTokenClient returns a client for the RotatedRefreshToken from the given config.
+func NewRotatedRefreshTokenClient(c config) *RotatedRefreshTokenClient {
+	return &RotatedRefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rotatedrefreshtoken.Hooks(f(g(h())))`.
+func (c *RotatedRefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RotatedRefreshToken = append(c.hooks.RotatedRefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rotatedrefreshtoken.Intercept(f(g(h())))`.
+func (c *RotatedRefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RotatedRefreshToken = append(c.inters.RotatedRefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RotatedRefreshToken entity.
+func (c *RotatedRefreshTokenClient) Create() *RotatedRefreshTokenCreate {
+	mutation := newRotatedRefreshTokenMutation(c.config, OpCreate)
+	return &RotatedRefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RotatedRefreshToken entities.
+func (c *RotatedRefreshTokenClient) CreateBulk(builders ...*RotatedRefreshTokenCreate) *RotatedRefreshTokenCreateBulk {
+	return &RotatedRefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RotatedRefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RotatedRefreshTokenCreate, int)) *RotatedRefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RotatedRefreshTokenCreateBulk{err: fmt.Errorf("calling to RotatedRefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RotatedRefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RotatedRefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RotatedRefreshToken.
+func (c *RotatedRefreshTokenClient) Update() *RotatedRefreshTokenUpdate {
+	mutation := newRotatedRefreshTokenMutation(c.config, OpUpdate)
+	return &RotatedRefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RotatedRefreshTokenClient) UpdateOne(rrt *RotatedRefreshToken) *RotatedRefreshTokenUpdateOne {
+	mutation := newRotatedRefreshTokenMutation(c.config, OpUpdateOne, withRotatedRefreshToken(rrt))
+	return &RotatedRefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RotatedRefreshTokenClient) UpdateOneID(id uuid.UUID) *RotatedRefreshTokenUpdateOne {
+	mutation := newRotatedRefreshTokenMutation(c.config, OpUpdateOne, withRotatedRefreshTokenID(id))
+	return &RotatedRefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RotatedRefreshToken.
+func (c *RotatedRefreshTokenClient) Delete() *RotatedRefreshTokenDelete {
+	mutation := newRotatedRefreshTokenMutation(c.config, OpDelete)
+	return &RotatedRefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RotatedRefreshTokenClient) DeleteOne(rrt *RotatedRefreshToken) *RotatedRefreshTokenDeleteOne {
+	return c.DeleteOneID(rrt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RotatedRefreshTokenClient) DeleteOneID(id uuid.UUID) *RotatedRefreshTokenDeleteOne {
+	builder := c.Delete().Where(rotatedrefreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RotatedRefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RotatedRefreshToken.
+func (c *RotatedRefreshTokenClient) Query() *RotatedRefreshTokenQuery {
+	return &RotatedRefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRotatedRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RotatedRefreshToken entity by its id.
+func (c *RotatedRefreshTokenClient) Get(ctx context.Context, id uuid.UUID) (*RotatedRefreshToken, error) {
+	return c.Query().Where(rotatedrefreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RotatedRefreshTokenClient) GetX(ctx context.Context, id uuid.UUID) *RotatedRefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RotatedRefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RotatedRefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RotatedRefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RotatedRefreshToken
+}
+
+func (c *RotatedRefreshTokenClient) mutate(ctx context.Context, m *RotatedRefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RotatedRefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RotatedRefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RotatedRefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RotatedRefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RotatedRefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// SAMLConnectionClient is a client for the SAMLConnection schema.
+type SAMLConnectionClient struct {
+	config
+}
+
+// NewSAMLConnectionClient returns a client for the SAMLConnection from the given config.
+func NewSAMLConnectionClient(c config) *SAMLConnectionClient {
+	return &SAMLConnectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `samlconnection.Hooks(f(g(h())))`.
+func (c *SAMLConnectionClient) Use(hooks ...Hook) {
+	c.hooks.SAMLConnection = append(c.hooks.SAMLConnection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `samlconnection.Intercept(f(g(h())))`.
+func (c *SAMLConnectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SAMLConnection = append(c.inters.SAMLConnection, interceptors...)
+}
+
+// Create returns a builder for creating a SAMLConnection entity.
+func (c *SAMLConnectionClient) Create() *SAMLConnectionCreate {
+	mutation := newSAMLConnectionMutation(c.config, OpCreate)
+	return &SAMLConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SAMLConnection entities.
+func (c *SAMLConnectionClient) CreateBulk(builders ...*SAMLConnectionCreate) *SAMLConnectionCreateBulk {
+	return &SAMLConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SAMLConnectionClient) MapCreateBulk(slice any, setFunc func(*SAMLConnectionCreate, int)) *SAMLConnectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SAMLConnectionCreateBulk{err: fmt.Errorf("calling to SAMLConnectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SAMLConnectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SAMLConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SAMLConnection.
+func (c *SAMLConnectionClient) Update() *SAMLConnectionUpdate {
+	mutation := newSAMLConnectionMutation(c.config, OpUpdate)
+	return &SAMLConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SAMLConnectionClient) UpdateOne(sc *SAMLConnection) *SAMLConnectionUpdateOne {
+	mutation := newSAMLConnectionMutation(c.config, OpUpdateOne, withSAMLConnection(sc))
+	return &SAMLConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SAMLConnectionClient) UpdateOneID(id uuid.UUID) *SAMLConnectionUpdateOne {
+	mutation := newSAMLConnectionMutation(c.config, OpUpdateOne, withSAMLConnectionID(id))
+	return &SAMLConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SAMLConnection.
+func (c *SAMLConnectionClient) Delete() *SAMLConnectionDelete {
+	mutation := newSAMLConnectionMutation(c.config, OpDelete)
+	return &SAMLConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SAMLConnectionClient) DeleteOne(sc *SAMLConnection) *SAMLConnectionDeleteOne {
+	return c.DeleteOneID(sc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SAMLConnectionClient) DeleteOneID(id uuid.UUID) *SAMLConnectionDeleteOne {
+	builder := c.Delete().Where(samlconnection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SAMLConnectionDeleteOne{builder}
+}
+
+// Query returns a query builder for SAMLConnection.
+func (c *SAMLConnectionClient) Query() *SAMLConnectionQuery {
+	return &SAMLConnectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSAMLConnection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SAMLConnection entity by its id.
+func (c *SAMLConnectionClient) Get(ctx context.Context, id uuid.UUID) (*SAMLConnection, error) {
+	return c.Query().Where(samlconnection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SAMLConnectionClient) GetX(ctx context.Context, id uuid.UUID) *SAMLConnection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *RoleClient) Hooks() []Hook {
-	return c.hooks.Role
+func (c *SAMLConnectionClient) Hooks() []Hook {
+	return c.hooks.SAMLConnection
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleClient) Interceptors() []Interceptor {
-	return c.inters.Role
+func (c *SAMLConnectionClient) Interceptors() []Interceptor {
+	return c.inters.SAMLConnection
 }
 
-func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+func (c *SAMLConnectionClient) mutate(ctx context.Context, m *SAMLConnectionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SAMLConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SAMLConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SAMLConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SAMLConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SAMLConnection mutation op: %q", m.Op())
 	}
 }
 
@@ -2368,6 +3824,139 @@ func (c *ScopeClient) mutate(ctx context.Context, m *ScopeMutation) (Value, erro
 	}
 }
 
+// SmsVerifyCodeClient is a client for the SmsVerifyCode schema.
+type SmsVerifyCodeClient struct {
+	config
+}
+
+// NewSmsVerifyCodeClient returns a client for the SmsVerifyCode from the given config.
+func NewSmsVerifyCodeClient(c config) *SmsVerifyCodeClient {
+	return &SmsVerifyCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `smsverifycode.Hooks(f(g(h())))`.
+func (c *SmsVerifyCodeClient) Use(hooks ...Hook) {
+	c.hooks.SmsVerifyCode = append(c.hooks.SmsVerifyCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `smsverifycode.Intercept(f(g(h())))`.
+func (c *SmsVerifyCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SmsVerifyCode = append(c.inters.SmsVerifyCode, interceptors...)
+}
+
+// Create returns a builder for creating a SmsVerifyCode entity.
+func (c *SmsVerifyCodeClient) Create() *SmsVerifyCodeCreate {
+	mutation := newSmsVerifyCodeMutation(c.config, OpCreate)
+	return &SmsVerifyCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SmsVerifyCode entities.
+func (c *SmsVerifyCodeClient) CreateBulk(builders ...*SmsVerifyCodeCreate) *SmsVerifyCodeCreateBulk {
+	return &SmsVerifyCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SmsVerifyCodeClient) MapCreateBulk(slice any, setFunc func(*SmsVerifyCodeCreate, int)) *SmsVerifyCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SmsVerifyCodeCreateBulk{err: fmt.Errorf("calling to SmsVerifyCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SmsVerifyCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SmsVerifyCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SmsVerifyCode.
+func (c *SmsVerifyCodeClient) Update() *SmsVerifyCodeUpdate {
+	mutation := newSmsVerifyCodeMutation(c.config, OpUpdate)
+	return &SmsVerifyCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SmsVerifyCodeClient) UpdateOne(svc *SmsVerifyCode) *SmsVerifyCodeUpdateOne {
+	mutation := newSmsVerifyCodeMutation(c.config, OpUpdateOne, withSmsVerifyCode(svc))
+	return &SmsVerifyCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SmsVerifyCodeClient) UpdateOneID(id uuid.UUID) *SmsVerifyCodeUpdateOne {
+	mutation := newSmsVerifyCodeMutation(c.config, OpUpdateOne, withSmsVerifyCodeID(id))
+	return &SmsVerifyCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SmsVerifyCode.
+func (c *SmsVerifyCodeClient) Delete() *SmsVerifyCodeDelete {
+	mutation := newSmsVerifyCodeMutation(c.config, OpDelete)
+	return &SmsVerifyCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SmsVerifyCodeClient) DeleteOne(svc *SmsVerifyCode) *SmsVerifyCodeDeleteOne {
+	return c.DeleteOneID(svc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SmsVerifyCodeClient) DeleteOneID(id uuid.UUID) *SmsVerifyCodeDeleteOne {
+	builder := c.Delete().Where(smsverifycode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SmsVerifyCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for SmsVerifyCode.
+func (c *SmsVerifyCodeClient) Query() *SmsVerifyCodeQuery {
+	return &SmsVerifyCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSmsVerifyCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SmsVerifyCode entity by its id.
+func (c *SmsVerifyCodeClient) Get(ctx context.Context, id uuid.UUID) (*SmsVerifyCode, error) {
+	return c.Query().Where(smsverifycode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SmsVerifyCodeClient) GetX(ctx context.Context, id uuid.UUID) *SmsVerifyCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SmsVerifyCodeClient) Hooks() []Hook {
+	return c.hooks.SmsVerifyCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *SmsVerifyCodeClient) Interceptors() []Interceptor {
+	return c.inters.SmsVerifyCode
+}
+
+func (c *SmsVerifyCodeClient) mutate(ctx context.Context, m *SmsVerifyCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SmsVerifyCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SmsVerifyCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SmsVerifyCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SmsVerifyCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SmsVerifyCode mutation op: %q", m.Op())
+	}
+}
+
 // StripeEventClient is a client for the StripeEvent schema.
 type StripeEventClient struct {
 	config
@@ -2705,6 +4294,22 @@ func (c *UserClient) QueryPayments(u *User) *PaymentQuery {
 	return query
 }
 
+// QueryPasskeyCredentials queries the passkey_credentials edge of a User.
+func (c *UserClient) QueryPasskeyCredentials(u *User) *PasskeyCredentialQuery {
+	query := (&PasskeyCredentialClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(passkeycredential.Table, passkeycredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PasskeyCredentialsTable, user.PasskeyCredentialsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryApplication queries the application edge of a User.
 func (c *UserClient) QueryApplication(u *User) *ApplicationQuery {
 	query := (&ApplicationClient{config: c.config}).Query()
@@ -2762,6 +4367,139 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// WebAuthnChallengeClient is a client for the WebAuthnChallenge schema.
+type WebAuthnChallengeClient struct {
+	config
+}
+
+// NewWebAuthnChallengeClient returns a client for the WebAuthnChallenge from the given config.
+func NewWebAuthnChallengeClient(c config) *WebAuthnChallengeClient {
+	return &WebAuthnChallengeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webauthnchallenge.Hooks(f(g(h())))`.
+func (c *WebAuthnChallengeClient) Use(hooks ...Hook) {
+	c.hooks.WebAuthnChallenge = append(c.hooks.WebAuthnChallenge, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webauthnchallenge.Intercept(f(g(h())))`.
+func (c *WebAuthnChallengeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebAuthnChallenge = append(c.inters.WebAuthnChallenge, interceptors...)
+}
+
+// Create returns a builder for creating a WebAuthnChallenge entity.
+func (c *WebAuthnChallengeClient) Create() *WebAuthnChallengeCreate {
+	mutation := newWebAuthnChallengeMutation(c.config, OpCreate)
+	return &WebAuthnChallengeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebAuthnChallenge entities.
+func (c *WebAuthnChallengeClient) CreateBulk(builders ...*WebAuthnChallengeCreate) *WebAuthnChallengeCreateBulk {
+	return &WebAuthnChallengeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebAuthnChallengeClient) MapCreateBulk(slice any, setFunc func(*WebAuthnChallengeCreate, int)) *WebAuthnChallengeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebAuthnChallengeCreateBulk{err: fmt.Errorf("calling to WebAuthnChallengeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebAuthnChallengeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebAuthnChallengeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebAuthnChallenge.
+func (c *WebAuthnChallengeClient) Update() *WebAuthnChallengeUpdate {
+	mutation := newWebAuthnChallengeMutation(c.config, OpUpdate)
+	return &WebAuthnChallengeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebAuthnChallengeClient) UpdateOne(wac *WebAuthnChallenge) *WebAuthnChallengeUpdateOne {
+	mutation := newWebAuthnChallengeMutation(c.config, OpUpdateOne, withWebAuthnChallenge(wac))
+	return &WebAuthnChallengeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebAuthnChallengeClient) UpdateOneID(id uuid.UUID) *WebAuthnChallengeUpdateOne {
+	mutation := newWebAuthnChallengeMutation(c.config, OpUpdateOne, withWebAuthnChallengeID(id))
+	return &WebAuthnChallengeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebAuthnChallenge.
+func (c *WebAuthnChallengeClient) Delete() *WebAuthnChallengeDelete {
+	mutation := newWebAuthnChallengeMutation(c.config, OpDelete)
+	return &WebAuthnChallengeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebAuthnChallengeClient) DeleteOne(wac *WebAuthnChallenge) *WebAuthnChallengeDeleteOne {
+	return c.DeleteOneID(wac.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebAuthnChallengeClient) DeleteOneID(id uuid.UUID) *WebAuthnChallengeDeleteOne {
+	builder := c.Delete().Where(webauthnchallenge.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebAuthnChallengeDeleteOne{builder}
+}
+
+// Query returns a query builder for WebAuthnChallenge.
+func (c *WebAuthnChallengeClient) Query() *WebAuthnChallengeQuery {
+	return &WebAuthnChallengeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebAuthnChallenge},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebAuthnChallenge entity by its id.
+func (c *WebAuthnChallengeClient) Get(ctx context.Context, id uuid.UUID) (*WebAuthnChallenge, error) {
+	return c.Query().Where(webauthnchallenge.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebAuthnChallengeClient) GetX(ctx context.Context, id uuid.UUID) *WebAuthnChallenge {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebAuthnChallengeClient) Hooks() []Hook {
+	return c.hooks.WebAuthnChallenge
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebAuthnChallengeClient) Interceptors() []Interceptor {
+	return c.inters.WebAuthnChallenge
+}
+
+func (c *WebAuthnChallengeClient) mutate(ctx context.Context, m *WebAuthnChallengeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebAuthnChallengeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebAuthnChallengeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebAuthnChallengeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebAuthnChallengeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebAuthnChallenge mutation op: %q", m.Op())
+	}
+}
+
 // WechatOpenIDClient is a client for the WechatOpenID schema.
 type WechatOpenIDClient struct {
 	config
@@ -2911,17 +4649,156 @@ func (c *WechatOpenIDClient) mutate(ctx context.Context, m *WechatOpenIDMutation
 	}
 }
 
+// WechatScanLoginClient is a client for the WechatScanLogin schema.
+type WechatScanLoginClient struct {
+	config
+}
+
+// NewWechatScanLoginClient returns a client for the WechatScanLogin from the given config.
+func NewWechatScanLoginClient(c config) *WechatScanLoginClient {
+	return &WechatScanLoginClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wechatscanlogin.Hooks(f(g(h())))`.
+func (c *WechatScanLoginClient) Use(hooks ...Hook) {
+	c.hooks.WechatScanLogin = append(c.hooks.WechatScanLogin, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wechatscanlogin.Intercept(f(g(h())))`.
+func (c *WechatScanLoginClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WechatScanLogin = append(c.inters.WechatScanLogin, interceptors...)
+}
+
+// Create returns a builder for creating a WechatScanLogin entity.
+func (c *WechatScanLoginClient) Create() *WechatScanLoginCreate {
+	mutation := newWechatScanLoginMutation(c.config, OpCreate)
+	return &WechatScanLoginCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WechatScanLogin entities.
+func (c *WechatScanLoginClient) CreateBulk(builders ...*WechatScanLoginCreate) *WechatScanLoginCreateBulk {
+	return &WechatScanLoginCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WechatScanLoginClient) MapCreateBulk(slice any, setFunc func(*WechatScanLoginCreate, int)) *WechatScanLoginCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WechatScanLoginCreateBulk{err: fmt.Errorf("calling to WechatScanLoginClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WechatScanLoginCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WechatScanLoginCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WechatScanLogin.
+func (c *WechatScanLoginClient) Update() *WechatScanLoginUpdate {
+	mutation := newWechatScanLoginMutation(c.config, OpUpdate)
+	return &WechatScanLoginUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WechatScanLoginClient) UpdateOne(wsl *WechatScanLogin) *WechatScanLoginUpdateOne {
+	mutation := newWechatScanLoginMutation(c.config, OpUpdateOne, withWechatScanLogin(wsl))
+	return &WechatScanLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WechatScanLoginClient) UpdateOneID(id uuid.UUID) *WechatScanLoginUpdateOne {
+	mutation := newWechatScanLoginMutation(c.config, OpUpdateOne, withWechatScanLoginID(id))
+	return &WechatScanLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WechatScanLogin.
+func (c *WechatScanLoginClient) Delete() *WechatScanLoginDelete {
+	mutation := newWechatScanLoginMutation(c.config, OpDelete)
+	return &WechatScanLoginDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WechatScanLoginClient) DeleteOne(wsl *WechatScanLogin) *WechatScanLoginDeleteOne {
+	return c.DeleteOneID(wsl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WechatScanLoginClient) DeleteOneID(id uuid.UUID) *WechatScanLoginDeleteOne {
+	builder := c.Delete().Where(wechatscanlogin.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WechatScanLoginDeleteOne{builder}
+}
+
+// Query returns a query builder for WechatScanLogin.
+func (c *WechatScanLoginClient) Query() *WechatScanLoginQuery {
+	return &WechatScanLoginQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWechatScanLogin},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WechatScanLogin entity by its id.
+func (c *WechatScanLoginClient) Get(ctx context.Context, id uuid.UUID) (*WechatScanLogin, error) {
+	return c.Query().Where(wechatscanlogin.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WechatScanLoginClient) GetX(ctx context.Context, id uuid.UUID) *WechatScanLogin {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WechatScanLoginClient) Hooks() []Hook {
+	return c.hooks.WechatScanLogin
+}
+
+// Interceptors returns the client interceptors.
+func (c *WechatScanLoginClient) Interceptors() []Interceptor {
+	return c.inters.WechatScanLogin
+}
+
+func (c *WechatScanLoginClient) mutate(ctx context.Context, m *WechatScanLoginMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WechatScanLoginCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WechatScanLoginUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WechatScanLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WechatScanLoginDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WechatScanLogin mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Application, Binding, BindingVerify, Device, MailVertifyCode, Organization,
-		OrganizationApplication, OrganizationRequest, OrganizationUser, Payment,
-		QyWechatUserID, Role, Scope, StripeEvent, User, WechatOpenID []ent.Hook
+		Application, Binding, BindingVerify, Device, IdentityProvider, LoginLock,
+		MagicLink, MailVertifyCode, OAuthAuthorizationCode, OAuthConsent, Organization,
+		OrganizationApplication, OrganizationRequest, OrganizationUser,
+		PasskeyCredential, Payment, QRLogin, QyWechatCorp, QyWechatUserID, Role,
+		RotatedRefreshToken, SAMLConnection, Scope, SmsVerifyCode, StripeEvent, User,
+		WebAuthnChallenge, WechatOpenID, WechatScanLogin []ent.Hook
 	}
 	inters struct {
-		Application, Binding, BindingVerify, Device, MailVertifyCode, Organization,
-		OrganizationApplication, OrganizationRequest, OrganizationUser, Payment,
-		QyWechatUserID, Role, Scope, StripeEvent, User, WechatOpenID []ent.Interceptor
+		Application, Binding, BindingVerify, Device, IdentityProvider, LoginLock,
+		MagicLink, MailVertifyCode, OAuthAuthorizationCode, OAuthConsent, Organization,
+		OrganizationApplication, OrganizationRequest, OrganizationUser,
+		PasskeyCredential, Payment, QRLogin, QyWechatCorp, QyWechatUserID, Role,
+		RotatedRefreshToken, SAMLConnection, Scope, SmsVerifyCode, StripeEvent, User,
+		WebAuthnChallenge, WechatOpenID, WechatScanLogin []ent.Interceptor
 	}
 )
 
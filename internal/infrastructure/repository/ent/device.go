@@ -35,8 +35,20 @@ type Device struct {
 	DeviceID string `json:"device_id,omitempty"`
 	// RefreshToken holds the value of the "refresh_token" field.
 	RefreshToken string `json:"refresh_token,omitempty"`
+	// RefreshTokenHash holds the value of the "refresh_token_hash" field.
+	RefreshTokenHash string `json:"refresh_token_hash,omitempty"`
 	// RefreshTokenExpiresAt holds the value of the "refresh_token_expires_at" field.
 	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at,omitempty"`
+	// RefreshTokenFamily holds the value of the "refresh_token_family" field.
+	RefreshTokenFamily uuid.UUID `json:"refresh_token_family,omitempty"`
+	// LastIP holds the value of the "last_ip" field.
+	LastIP string `json:"last_ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// LastRefreshedAt holds the value of the "last_refreshed_at" field.
+	LastRefreshedAt time.Time `json:"last_refreshed_at,omitempty"`
+	// OauthScope holds the value of the "oauth_scope" field.
+	OauthScope string `json:"oauth_scope,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeviceQuery when eager-loading is set.
 	Edges        DeviceEdges `json:"edges"`
@@ -70,11 +82,11 @@ func (*Device) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case device.FieldID:
 			values[i] = new(sql.NullInt64)
-		case device.FieldUserID, device.FieldDeviceType, device.FieldDeviceID, device.FieldRefreshToken:
+		case device.FieldUserID, device.FieldDeviceType, device.FieldDeviceID, device.FieldRefreshToken, device.FieldRefreshTokenHash, device.FieldLastIP, device.FieldUserAgent, device.FieldOauthScope:
 			values[i] = new(sql.NullString)
-		case device.FieldCreatedAt, device.FieldUpdatedAt, device.FieldDeletedAt, device.FieldRefreshTokenExpiresAt:
+		case device.FieldCreatedAt, device.FieldUpdatedAt, device.FieldDeletedAt, device.FieldRefreshTokenExpiresAt, device.FieldLastRefreshedAt:
 			values[i] = new(sql.NullTime)
-		case device.FieldOrganizationID:
+		case device.FieldOrganizationID, device.FieldRefreshTokenFamily:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -145,12 +157,48 @@ func (d *Device) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.RefreshToken = value.String
 			}
+		case device.FieldRefreshTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_token_hash", values[i])
+			} else if value.Valid {
+				d.RefreshTokenHash = value.String
+			}
 		case device.FieldRefreshTokenExpiresAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field refresh_token_expires_at", values[i])
 			} else if value.Valid {
 				d.RefreshTokenExpiresAt = value.Time
 			}
+		case device.FieldRefreshTokenFamily:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_token_family", values[i])
+			} else if value != nil {
+				d.RefreshTokenFamily = *value
+			}
+		case device.FieldLastIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_ip", values[i])
+			} else if value.Valid {
+				d.LastIP = value.String
+			}
+		case device.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				d.UserAgent = value.String
+			}
+		case device.FieldLastRefreshedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_refreshed_at", values[i])
+			} else if value.Valid {
+				d.LastRefreshedAt = value.Time
+			}
+		case device.FieldOauthScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field oauth_scope", values[i])
+			} else if value.Valid {
+				d.OauthScope = value.String
+			}
 		default:
 			d.selectValues.Set(columns[i], values[i])
 		}
@@ -216,8 +264,26 @@ func (d *Device) String() string {
 	builder.WriteString("refresh_token=")
 	builder.WriteString(d.RefreshToken)
 	builder.WriteString(", ")
+	builder.WriteString("refresh_token_hash=")
+	builder.WriteString(d.RefreshTokenHash)
+	builder.WriteString(", ")
 	builder.WriteString("refresh_token_expires_at=")
 	builder.WriteString(d.RefreshTokenExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("refresh_token_family=")
+	builder.WriteString(fmt.Sprintf("%v", d.RefreshTokenFamily))
+	builder.WriteString(", ")
+	builder.WriteString("last_ip=")
+	builder.WriteString(d.LastIP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(d.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("last_refreshed_at=")
+	builder.WriteString(d.LastRefreshedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("oauth_scope=")
+	builder.WriteString(d.OauthScope)
 	builder.WriteByte(')')
 	return builder.String()
 }
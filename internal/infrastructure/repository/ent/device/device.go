@@ -30,8 +30,20 @@ const (
 	FieldDeviceID = "device_id"
 	// FieldRefreshToken holds the string denoting the refresh_token field in the database.
 	FieldRefreshToken = "refresh_token"
+	// FieldRefreshTokenHash holds the string denoting the refresh_token_hash field in the database.
+	FieldRefreshTokenHash = "refresh_token_hash"
 	// FieldRefreshTokenExpiresAt holds the string denoting the refresh_token_expires_at field in the database.
 	FieldRefreshTokenExpiresAt = "refresh_token_expires_at"
+	// FieldRefreshTokenFamily holds the string denoting the refresh_token_family field in the database.
+	FieldRefreshTokenFamily = "refresh_token_family"
+	// FieldLastIP holds the string denoting the last_ip field in the database.
+	FieldLastIP = "last_ip"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldLastRefreshedAt holds the string denoting the last_refreshed_at field in the database.
+	FieldLastRefreshedAt = "last_refreshed_at"
+	// FieldOauthScope holds the string denoting the oauth_scope field in the database.
+	FieldOauthScope = "oauth_scope"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// Table holds the table name of the device in the database.
@@ -56,7 +68,13 @@ var Columns = []string{
 	FieldDeviceType,
 	FieldDeviceID,
 	FieldRefreshToken,
+	FieldRefreshTokenHash,
 	FieldRefreshTokenExpiresAt,
+	FieldRefreshTokenFamily,
+	FieldLastIP,
+	FieldUserAgent,
+	FieldLastRefreshedAt,
+	FieldOauthScope,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,8 +98,6 @@ var (
 	DeviceTypeValidator func(string) error
 	// DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
 	DeviceIDValidator func(string) error
-	// RefreshTokenValidator is a validator for the "refresh_token" field. It is called by the builders before save.
-	RefreshTokenValidator func(string) error
 	// DefaultRefreshTokenExpiresAt holds the default value on creation for the "refresh_token_expires_at" field.
 	DefaultRefreshTokenExpiresAt func() time.Time
 )
@@ -134,11 +150,41 @@ func ByRefreshToken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRefreshToken, opts...).ToFunc()
 }
 
+// ByRefreshTokenHash orders the results by the refresh_token_hash field.
+func ByRefreshTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefreshTokenHash, opts...).ToFunc()
+}
+
 // ByRefreshTokenExpiresAt orders the results by the refresh_token_expires_at field.
 func ByRefreshTokenExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRefreshTokenExpiresAt, opts...).ToFunc()
 }
 
+// ByRefreshTokenFamily orders the results by the refresh_token_family field.
+func ByRefreshTokenFamily(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefreshTokenFamily, opts...).ToFunc()
+}
+
+// ByLastIP orders the results by the last_ip field.
+func ByLastIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastIP, opts...).ToFunc()
+}
+
+// ByUserAgent orders the results by the user_agent field.
+func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgent, opts...).ToFunc()
+}
+
+// ByLastRefreshedAt orders the results by the last_refreshed_at field.
+func ByLastRefreshedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRefreshedAt, opts...).ToFunc()
+}
+
+// ByOauthScope orders the results by the oauth_scope field.
+func ByOauthScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOauthScope, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
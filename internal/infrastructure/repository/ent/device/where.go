@@ -96,11 +96,41 @@ func RefreshToken(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldRefreshToken, v))
 }
 
+// RefreshTokenHash applies equality check predicate on the "refresh_token_hash" field. It's identical to RefreshTokenHashEQ.
+func RefreshTokenHash(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRefreshTokenHash, v))
+}
+
 // RefreshTokenExpiresAt applies equality check predicate on the "refresh_token_expires_at" field. It's identical to RefreshTokenExpiresAtEQ.
 func RefreshTokenExpiresAt(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldRefreshTokenExpiresAt, v))
 }
 
+// RefreshTokenFamily applies equality check predicate on the "refresh_token_family" field. It's identical to RefreshTokenFamilyEQ.
+func RefreshTokenFamily(v uuid.UUID) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRefreshTokenFamily, v))
+}
+
+// LastIP applies equality check predicate on the "last_ip" field. It's identical to LastIPEQ.
+func LastIP(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastIP, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// LastRefreshedAt applies equality check predicate on the "last_refreshed_at" field. It's identical to LastRefreshedAtEQ.
+func LastRefreshedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastRefreshedAt, v))
+}
+
+// OauthScope applies equality check predicate on the "oauth_scope" field. It's identical to OauthScopeEQ.
+func OauthScope(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldOauthScope, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
@@ -531,6 +561,16 @@ func RefreshTokenHasSuffix(v string) predicate.Device {
 	return predicate.Device(sql.FieldHasSuffix(FieldRefreshToken, v))
 }
 
+// RefreshTokenIsNil applies the IsNil predicate on the "refresh_token" field.
+func RefreshTokenIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldRefreshToken))
+}
+
+// RefreshTokenNotNil applies the NotNil predicate on the "refresh_token" field.
+func RefreshTokenNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldRefreshToken))
+}
+
 // RefreshTokenEqualFold applies the EqualFold predicate on the "refresh_token" field.
 func RefreshTokenEqualFold(v string) predicate.Device {
 	return predicate.Device(sql.FieldEqualFold(FieldRefreshToken, v))
@@ -541,6 +581,81 @@ func RefreshTokenContainsFold(v string) predicate.Device {
 	return predicate.Device(sql.FieldContainsFold(FieldRefreshToken, v))
 }
 
+// RefreshTokenHashEQ applies the EQ predicate on the "refresh_token_hash" field.
+func RefreshTokenHashEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashNEQ applies the NEQ predicate on the "refresh_token_hash" field.
+func RefreshTokenHashNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashIn applies the In predicate on the "refresh_token_hash" field.
+func RefreshTokenHashIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldRefreshTokenHash, vs...))
+}
+
+// RefreshTokenHashNotIn applies the NotIn predicate on the "refresh_token_hash" field.
+func RefreshTokenHashNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldRefreshTokenHash, vs...))
+}
+
+// RefreshTokenHashGT applies the GT predicate on the "refresh_token_hash" field.
+func RefreshTokenHashGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashGTE applies the GTE predicate on the "refresh_token_hash" field.
+func RefreshTokenHashGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashLT applies the LT predicate on the "refresh_token_hash" field.
+func RefreshTokenHashLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashLTE applies the LTE predicate on the "refresh_token_hash" field.
+func RefreshTokenHashLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashContains applies the Contains predicate on the "refresh_token_hash" field.
+func RefreshTokenHashContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashHasPrefix applies the HasPrefix predicate on the "refresh_token_hash" field.
+func RefreshTokenHashHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashHasSuffix applies the HasSuffix predicate on the "refresh_token_hash" field.
+func RefreshTokenHashHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashIsNil applies the IsNil predicate on the "refresh_token_hash" field.
+func RefreshTokenHashIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldRefreshTokenHash))
+}
+
+// RefreshTokenHashNotNil applies the NotNil predicate on the "refresh_token_hash" field.
+func RefreshTokenHashNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldRefreshTokenHash))
+}
+
+// RefreshTokenHashEqualFold applies the EqualFold predicate on the "refresh_token_hash" field.
+func RefreshTokenHashEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashContainsFold applies the ContainsFold predicate on the "refresh_token_hash" field.
+func RefreshTokenHashContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldRefreshTokenHash, v))
+}
+
 // RefreshTokenExpiresAtEQ applies the EQ predicate on the "refresh_token_expires_at" field.
 func RefreshTokenExpiresAtEQ(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldRefreshTokenExpiresAt, v))
@@ -581,6 +696,331 @@ func RefreshTokenExpiresAtLTE(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldLTE(FieldRefreshTokenExpiresAt, v))
 }
 
+// RefreshTokenFamilyEQ applies the EQ predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyEQ(v uuid.UUID) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRefreshTokenFamily, v))
+}
+
+// RefreshTokenFamilyNEQ applies the NEQ predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyNEQ(v uuid.UUID) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldRefreshTokenFamily, v))
+}
+
+// RefreshTokenFamilyIn applies the In predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyIn(vs ...uuid.UUID) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldRefreshTokenFamily, vs...))
+}
+
+// RefreshTokenFamilyNotIn applies the NotIn predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyNotIn(vs ...uuid.UUID) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldRefreshTokenFamily, vs...))
+}
+
+// RefreshTokenFamilyGT applies the GT predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyGT(v uuid.UUID) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldRefreshTokenFamily, v))
+}
+
+// RefreshTokenFamilyGTE applies the GTE predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyGTE(v uuid.UUID) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldRefreshTokenFamily, v))
+}
+
+// RefreshTokenFamilyLT applies the LT predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyLT(v uuid.UUID) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldRefreshTokenFamily, v))
+}
+
+// RefreshTokenFamilyLTE applies the LTE predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyLTE(v uuid.UUID) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldRefreshTokenFamily, v))
+}
+
+// RefreshTokenFamilyIsNil applies the IsNil predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldRefreshTokenFamily))
+}
+
+// RefreshTokenFamilyNotNil applies the NotNil predicate on the "refresh_token_family" field.
+func RefreshTokenFamilyNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldRefreshTokenFamily))
+}
+
+// LastIPEQ applies the EQ predicate on the "last_ip" field.
+func LastIPEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastIP, v))
+}
+
+// LastIPNEQ applies the NEQ predicate on the "last_ip" field.
+func LastIPNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastIP, v))
+}
+
+// LastIPIn applies the In predicate on the "last_ip" field.
+func LastIPIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastIP, vs...))
+}
+
+// LastIPNotIn applies the NotIn predicate on the "last_ip" field.
+func LastIPNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastIP, vs...))
+}
+
+// LastIPGT applies the GT predicate on the "last_ip" field.
+func LastIPGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastIP, v))
+}
+
+// LastIPGTE applies the GTE predicate on the "last_ip" field.
+func LastIPGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastIP, v))
+}
+
+// LastIPLT applies the LT predicate on the "last_ip" field.
+func LastIPLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastIP, v))
+}
+
+// LastIPLTE applies the LTE predicate on the "last_ip" field.
+func LastIPLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastIP, v))
+}
+
+// LastIPContains applies the Contains predicate on the "last_ip" field.
+func LastIPContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldLastIP, v))
+}
+
+// LastIPHasPrefix applies the HasPrefix predicate on the "last_ip" field.
+func LastIPHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldLastIP, v))
+}
+
+// LastIPHasSuffix applies the HasSuffix predicate on the "last_ip" field.
+func LastIPHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldLastIP, v))
+}
+
+// LastIPIsNil applies the IsNil predicate on the "last_ip" field.
+func LastIPIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastIP))
+}
+
+// LastIPNotNil applies the NotNil predicate on the "last_ip" field.
+func LastIPNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastIP))
+}
+
+// LastIPEqualFold applies the EqualFold predicate on the "last_ip" field.
+func LastIPEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldLastIP, v))
+}
+
+// LastIPContainsFold applies the ContainsFold predicate on the "last_ip" field.
+func LastIPContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldLastIP, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// LastRefreshedAtEQ applies the EQ predicate on the "last_refreshed_at" field.
+func LastRefreshedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastRefreshedAt, v))
+}
+
+// LastRefreshedAtNEQ applies the NEQ predicate on the "last_refreshed_at" field.
+func LastRefreshedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastRefreshedAt, v))
+}
+
+// LastRefreshedAtIn applies the In predicate on the "last_refreshed_at" field.
+func LastRefreshedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastRefreshedAt, vs...))
+}
+
+// LastRefreshedAtNotIn applies the NotIn predicate on the "last_refreshed_at" field.
+func LastRefreshedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastRefreshedAt, vs...))
+}
+
+// LastRefreshedAtGT applies the GT predicate on the "last_refreshed_at" field.
+func LastRefreshedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastRefreshedAt, v))
+}
+
+// LastRefreshedAtGTE applies the GTE predicate on the "last_refreshed_at" field.
+func LastRefreshedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastRefreshedAt, v))
+}
+
+// LastRefreshedAtLT applies the LT predicate on the "last_refreshed_at" field.
+func LastRefreshedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastRefreshedAt, v))
+}
+
+// LastRefreshedAtLTE applies the LTE predicate on the "last_refreshed_at" field.
+func LastRefreshedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastRefreshedAt, v))
+}
+
+// LastRefreshedAtIsNil applies the IsNil predicate on the "last_refreshed_at" field.
+func LastRefreshedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastRefreshedAt))
+}
+
+// LastRefreshedAtNotNil applies the NotNil predicate on the "last_refreshed_at" field.
+func LastRefreshedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastRefreshedAt))
+}
+
+// OauthScopeEQ applies the EQ predicate on the "oauth_scope" field.
+func OauthScopeEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldOauthScope, v))
+}
+
+// OauthScopeNEQ applies the NEQ predicate on the "oauth_scope" field.
+func OauthScopeNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldOauthScope, v))
+}
+
+// OauthScopeIn applies the In predicate on the "oauth_scope" field.
+func OauthScopeIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldOauthScope, vs...))
+}
+
+// OauthScopeNotIn applies the NotIn predicate on the "oauth_scope" field.
+func OauthScopeNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldOauthScope, vs...))
+}
+
+// OauthScopeGT applies the GT predicate on the "oauth_scope" field.
+func OauthScopeGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldOauthScope, v))
+}
+
+// OauthScopeGTE applies the GTE predicate on the "oauth_scope" field.
+func OauthScopeGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldOauthScope, v))
+}
+
+// OauthScopeLT applies the LT predicate on the "oauth_scope" field.
+func OauthScopeLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldOauthScope, v))
+}
+
+// OauthScopeLTE applies the LTE predicate on the "oauth_scope" field.
+func OauthScopeLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldOauthScope, v))
+}
+
+// OauthScopeContains applies the Contains predicate on the "oauth_scope" field.
+func OauthScopeContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldOauthScope, v))
+}
+
+// OauthScopeHasPrefix applies the HasPrefix predicate on the "oauth_scope" field.
+func OauthScopeHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldOauthScope, v))
+}
+
+// OauthScopeHasSuffix applies the HasSuffix predicate on the "oauth_scope" field.
+func OauthScopeHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldOauthScope, v))
+}
+
+// OauthScopeIsNil applies the IsNil predicate on the "oauth_scope" field.
+func OauthScopeIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldOauthScope))
+}
+
+// OauthScopeNotNil applies the NotNil predicate on the "oauth_scope" field.
+func OauthScopeNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldOauthScope))
+}
+
+// OauthScopeEqualFold applies the EqualFold predicate on the "oauth_scope" field.
+func OauthScopeEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldOauthScope, v))
+}
+
+// OauthScopeContainsFold applies the ContainsFold predicate on the "oauth_scope" field.
+func OauthScopeContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldOauthScope, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Device {
 	return predicate.Device(func(s *sql.Selector) {
@@ -102,6 +102,28 @@ func (dc *DeviceCreate) SetRefreshToken(s string) *DeviceCreate {
 	return dc
 }
 
+// SetNillableRefreshToken sets the "refresh_token" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableRefreshToken(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetRefreshToken(*s)
+	}
+	return dc
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (dc *DeviceCreate) SetRefreshTokenHash(s string) *DeviceCreate {
+	dc.mutation.SetRefreshTokenHash(s)
+	return dc
+}
+
+// SetNillableRefreshTokenHash sets the "refresh_token_hash" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableRefreshTokenHash(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetRefreshTokenHash(*s)
+	}
+	return dc
+}
+
 // SetRefreshTokenExpiresAt sets the "refresh_token_expires_at" field.
 func (dc *DeviceCreate) SetRefreshTokenExpiresAt(t time.Time) *DeviceCreate {
 	dc.mutation.SetRefreshTokenExpiresAt(t)
@@ -116,6 +138,76 @@ func (dc *DeviceCreate) SetNillableRefreshTokenExpiresAt(t *time.Time) *DeviceCr
 	return dc
 }
 
+// SetRefreshTokenFamily sets the "refresh_token_family" field.
+func (dc *DeviceCreate) SetRefreshTokenFamily(u uuid.UUID) *DeviceCreate {
+	dc.mutation.SetRefreshTokenFamily(u)
+	return dc
+}
+
+// SetNillableRefreshTokenFamily sets the "refresh_token_family" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableRefreshTokenFamily(u *uuid.UUID) *DeviceCreate {
+	if u != nil {
+		dc.SetRefreshTokenFamily(*u)
+	}
+	return dc
+}
+
+// SetLastIP sets the "last_ip" field.
+func (dc *DeviceCreate) SetLastIP(s string) *DeviceCreate {
+	dc.mutation.SetLastIP(s)
+	return dc
+}
+
+// SetNillableLastIP sets the "last_ip" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastIP(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetLastIP(*s)
+	}
+	return dc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (dc *DeviceCreate) SetUserAgent(s string) *DeviceCreate {
+	dc.mutation.SetUserAgent(s)
+	return dc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableUserAgent(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetUserAgent(*s)
+	}
+	return dc
+}
+
+// SetLastRefreshedAt sets the "last_refreshed_at" field.
+func (dc *DeviceCreate) SetLastRefreshedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetLastRefreshedAt(t)
+	return dc
+}
+
+// SetNillableLastRefreshedAt sets the "last_refreshed_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastRefreshedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetLastRefreshedAt(*t)
+	}
+	return dc
+}
+
+// SetOauthScope sets the "oauth_scope" field.
+func (dc *DeviceCreate) SetOauthScope(s string) *DeviceCreate {
+	dc.mutation.SetOauthScope(s)
+	return dc
+}
+
+// SetNillableOauthScope sets the "oauth_scope" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableOauthScope(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetOauthScope(*s)
+	}
+	return dc
+}
+
 // SetID sets the "id" field.
 func (dc *DeviceCreate) SetID(i int64) *DeviceCreate {
 	dc.mutation.SetID(i)
@@ -203,14 +295,6 @@ func (dc *DeviceCreate) check() error {
 			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "Device.device_id": %w`, err)}
 		}
 	}
-	if _, ok := dc.mutation.RefreshToken(); !ok {
-		return &ValidationError{Name: "refresh_token", err: errors.New(`ent: missing required field "Device.refresh_token"`)}
-	}
-	if v, ok := dc.mutation.RefreshToken(); ok {
-		if err := device.RefreshTokenValidator(v); err != nil {
-			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "Device.refresh_token": %w`, err)}
-		}
-	}
 	if _, ok := dc.mutation.RefreshTokenExpiresAt(); !ok {
 		return &ValidationError{Name: "refresh_token_expires_at", err: errors.New(`ent: missing required field "Device.refresh_token_expires_at"`)}
 	}
@@ -277,10 +361,34 @@ func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldRefreshToken, field.TypeString, value)
 		_node.RefreshToken = value
 	}
+	if value, ok := dc.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(device.FieldRefreshTokenHash, field.TypeString, value)
+		_node.RefreshTokenHash = value
+	}
 	if value, ok := dc.mutation.RefreshTokenExpiresAt(); ok {
 		_spec.SetField(device.FieldRefreshTokenExpiresAt, field.TypeTime, value)
 		_node.RefreshTokenExpiresAt = value
 	}
+	if value, ok := dc.mutation.RefreshTokenFamily(); ok {
+		_spec.SetField(device.FieldRefreshTokenFamily, field.TypeUUID, value)
+		_node.RefreshTokenFamily = value
+	}
+	if value, ok := dc.mutation.LastIP(); ok {
+		_spec.SetField(device.FieldLastIP, field.TypeString, value)
+		_node.LastIP = value
+	}
+	if value, ok := dc.mutation.UserAgent(); ok {
+		_spec.SetField(device.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := dc.mutation.LastRefreshedAt(); ok {
+		_spec.SetField(device.FieldLastRefreshedAt, field.TypeTime, value)
+		_node.LastRefreshedAt = value
+	}
+	if value, ok := dc.mutation.OauthScope(); ok {
+		_spec.SetField(device.FieldOauthScope, field.TypeString, value)
+		_node.OauthScope = value
+	}
 	if nodes := dc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
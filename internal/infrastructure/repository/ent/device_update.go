@@ -132,6 +132,32 @@ func (du *DeviceUpdate) SetNillableRefreshToken(s *string) *DeviceUpdate {
 	return du
 }
 
+// ClearRefreshToken clears the value of the "refresh_token" field.
+func (du *DeviceUpdate) ClearRefreshToken() *DeviceUpdate {
+	du.mutation.ClearRefreshToken()
+	return du
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (du *DeviceUpdate) SetRefreshTokenHash(s string) *DeviceUpdate {
+	du.mutation.SetRefreshTokenHash(s)
+	return du
+}
+
+// SetNillableRefreshTokenHash sets the "refresh_token_hash" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableRefreshTokenHash(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetRefreshTokenHash(*s)
+	}
+	return du
+}
+
+// ClearRefreshTokenHash clears the value of the "refresh_token_hash" field.
+func (du *DeviceUpdate) ClearRefreshTokenHash() *DeviceUpdate {
+	du.mutation.ClearRefreshTokenHash()
+	return du
+}
+
 // SetRefreshTokenExpiresAt sets the "refresh_token_expires_at" field.
 func (du *DeviceUpdate) SetRefreshTokenExpiresAt(t time.Time) *DeviceUpdate {
 	du.mutation.SetRefreshTokenExpiresAt(t)
@@ -146,6 +172,106 @@ func (du *DeviceUpdate) SetNillableRefreshTokenExpiresAt(t *time.Time) *DeviceUp
 	return du
 }
 
+// SetRefreshTokenFamily sets the "refresh_token_family" field.
+func (du *DeviceUpdate) SetRefreshTokenFamily(u uuid.UUID) *DeviceUpdate {
+	du.mutation.SetRefreshTokenFamily(u)
+	return du
+}
+
+// SetNillableRefreshTokenFamily sets the "refresh_token_family" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableRefreshTokenFamily(u *uuid.UUID) *DeviceUpdate {
+	if u != nil {
+		du.SetRefreshTokenFamily(*u)
+	}
+	return du
+}
+
+// ClearRefreshTokenFamily clears the value of the "refresh_token_family" field.
+func (du *DeviceUpdate) ClearRefreshTokenFamily() *DeviceUpdate {
+	du.mutation.ClearRefreshTokenFamily()
+	return du
+}
+
+// SetLastIP sets the "last_ip" field.
+func (du *DeviceUpdate) SetLastIP(s string) *DeviceUpdate {
+	du.mutation.SetLastIP(s)
+	return du
+}
+
+// SetNillableLastIP sets the "last_ip" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastIP(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetLastIP(*s)
+	}
+	return du
+}
+
+// ClearLastIP clears the value of the "last_ip" field.
+func (du *DeviceUpdate) ClearLastIP() *DeviceUpdate {
+	du.mutation.ClearLastIP()
+	return du
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (du *DeviceUpdate) SetUserAgent(s string) *DeviceUpdate {
+	du.mutation.SetUserAgent(s)
+	return du
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableUserAgent(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetUserAgent(*s)
+	}
+	return du
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (du *DeviceUpdate) ClearUserAgent() *DeviceUpdate {
+	du.mutation.ClearUserAgent()
+	return du
+}
+
+// SetLastRefreshedAt sets the "last_refreshed_at" field.
+func (du *DeviceUpdate) SetLastRefreshedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetLastRefreshedAt(t)
+	return du
+}
+
+// SetNillableLastRefreshedAt sets the "last_refreshed_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastRefreshedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetLastRefreshedAt(*t)
+	}
+	return du
+}
+
+// ClearLastRefreshedAt clears the value of the "last_refreshed_at" field.
+func (du *DeviceUpdate) ClearLastRefreshedAt() *DeviceUpdate {
+	du.mutation.ClearLastRefreshedAt()
+	return du
+}
+
+// SetOauthScope sets the "oauth_scope" field.
+func (du *DeviceUpdate) SetOauthScope(s string) *DeviceUpdate {
+	du.mutation.SetOauthScope(s)
+	return du
+}
+
+// SetNillableOauthScope sets the "oauth_scope" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableOauthScope(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetOauthScope(*s)
+	}
+	return du
+}
+
+// ClearOauthScope clears the value of the "oauth_scope" field.
+func (du *DeviceUpdate) ClearOauthScope() *DeviceUpdate {
+	du.mutation.ClearOauthScope()
+	return du
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (du *DeviceUpdate) SetUser(u *User) *DeviceUpdate {
 	return du.SetUserID(u.ID)
@@ -210,11 +336,6 @@ func (du *DeviceUpdate) check() error {
 			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "Device.device_id": %w`, err)}
 		}
 	}
-	if v, ok := du.mutation.RefreshToken(); ok {
-		if err := device.RefreshTokenValidator(v); err != nil {
-			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "Device.refresh_token": %w`, err)}
-		}
-	}
 	if du.mutation.UserCleared() && len(du.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Device.user"`)
 	}
@@ -257,9 +378,48 @@ func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.RefreshToken(); ok {
 		_spec.SetField(device.FieldRefreshToken, field.TypeString, value)
 	}
+	if du.mutation.RefreshTokenCleared() {
+		_spec.ClearField(device.FieldRefreshToken, field.TypeString)
+	}
+	if value, ok := du.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(device.FieldRefreshTokenHash, field.TypeString, value)
+	}
+	if du.mutation.RefreshTokenHashCleared() {
+		_spec.ClearField(device.FieldRefreshTokenHash, field.TypeString)
+	}
 	if value, ok := du.mutation.RefreshTokenExpiresAt(); ok {
 		_spec.SetField(device.FieldRefreshTokenExpiresAt, field.TypeTime, value)
 	}
+	if value, ok := du.mutation.RefreshTokenFamily(); ok {
+		_spec.SetField(device.FieldRefreshTokenFamily, field.TypeUUID, value)
+	}
+	if du.mutation.RefreshTokenFamilyCleared() {
+		_spec.ClearField(device.FieldRefreshTokenFamily, field.TypeUUID)
+	}
+	if value, ok := du.mutation.LastIP(); ok {
+		_spec.SetField(device.FieldLastIP, field.TypeString, value)
+	}
+	if du.mutation.LastIPCleared() {
+		_spec.ClearField(device.FieldLastIP, field.TypeString)
+	}
+	if value, ok := du.mutation.UserAgent(); ok {
+		_spec.SetField(device.FieldUserAgent, field.TypeString, value)
+	}
+	if du.mutation.UserAgentCleared() {
+		_spec.ClearField(device.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := du.mutation.LastRefreshedAt(); ok {
+		_spec.SetField(device.FieldLastRefreshedAt, field.TypeTime, value)
+	}
+	if du.mutation.LastRefreshedAtCleared() {
+		_spec.ClearField(device.FieldLastRefreshedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.OauthScope(); ok {
+		_spec.SetField(device.FieldOauthScope, field.TypeString, value)
+	}
+	if du.mutation.OauthScopeCleared() {
+		_spec.ClearField(device.FieldOauthScope, field.TypeString)
+	}
 	if du.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -411,6 +571,32 @@ func (duo *DeviceUpdateOne) SetNillableRefreshToken(s *string) *DeviceUpdateOne
 	return duo
 }
 
+// ClearRefreshToken clears the value of the "refresh_token" field.
+func (duo *DeviceUpdateOne) ClearRefreshToken() *DeviceUpdateOne {
+	duo.mutation.ClearRefreshToken()
+	return duo
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (duo *DeviceUpdateOne) SetRefreshTokenHash(s string) *DeviceUpdateOne {
+	duo.mutation.SetRefreshTokenHash(s)
+	return duo
+}
+
+// SetNillableRefreshTokenHash sets the "refresh_token_hash" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableRefreshTokenHash(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetRefreshTokenHash(*s)
+	}
+	return duo
+}
+
+// ClearRefreshTokenHash clears the value of the "refresh_token_hash" field.
+func (duo *DeviceUpdateOne) ClearRefreshTokenHash() *DeviceUpdateOne {
+	duo.mutation.ClearRefreshTokenHash()
+	return duo
+}
+
 // SetRefreshTokenExpiresAt sets the "refresh_token_expires_at" field.
 func (duo *DeviceUpdateOne) SetRefreshTokenExpiresAt(t time.Time) *DeviceUpdateOne {
 	duo.mutation.SetRefreshTokenExpiresAt(t)
@@ -425,6 +611,106 @@ func (duo *DeviceUpdateOne) SetNillableRefreshTokenExpiresAt(t *time.Time) *Devi
 	return duo
 }
 
+// SetRefreshTokenFamily sets the "refresh_token_family" field.
+func (duo *DeviceUpdateOne) SetRefreshTokenFamily(u uuid.UUID) *DeviceUpdateOne {
+	duo.mutation.SetRefreshTokenFamily(u)
+	return duo
+}
+
+// SetNillableRefreshTokenFamily sets the "refresh_token_family" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableRefreshTokenFamily(u *uuid.UUID) *DeviceUpdateOne {
+	if u != nil {
+		duo.SetRefreshTokenFamily(*u)
+	}
+	return duo
+}
+
+// ClearRefreshTokenFamily clears the value of the "refresh_token_family" field.
+func (duo *DeviceUpdateOne) ClearRefreshTokenFamily() *DeviceUpdateOne {
+	duo.mutation.ClearRefreshTokenFamily()
+	return duo
+}
+
+// SetLastIP sets the "last_ip" field.
+func (duo *DeviceUpdateOne) SetLastIP(s string) *DeviceUpdateOne {
+	duo.mutation.SetLastIP(s)
+	return duo
+}
+
+// SetNillableLastIP sets the "last_ip" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastIP(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetLastIP(*s)
+	}
+	return duo
+}
+
+// ClearLastIP clears the value of the "last_ip" field.
+func (duo *DeviceUpdateOne) ClearLastIP() *DeviceUpdateOne {
+	duo.mutation.ClearLastIP()
+	return duo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (duo *DeviceUpdateOne) SetUserAgent(s string) *DeviceUpdateOne {
+	duo.mutation.SetUserAgent(s)
+	return duo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableUserAgent(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetUserAgent(*s)
+	}
+	return duo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (duo *DeviceUpdateOne) ClearUserAgent() *DeviceUpdateOne {
+	duo.mutation.ClearUserAgent()
+	return duo
+}
+
+// SetLastRefreshedAt sets the "last_refreshed_at" field.
+func (duo *DeviceUpdateOne) SetLastRefreshedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetLastRefreshedAt(t)
+	return duo
+}
+
+// SetNillableLastRefreshedAt sets the "last_refreshed_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastRefreshedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetLastRefreshedAt(*t)
+	}
+	return duo
+}
+
+// ClearLastRefreshedAt clears the value of the "last_refreshed_at" field.
+func (duo *DeviceUpdateOne) ClearLastRefreshedAt() *DeviceUpdateOne {
+	duo.mutation.ClearLastRefreshedAt()
+	return duo
+}
+
+// SetOauthScope sets the "oauth_scope" field.
+func (duo *DeviceUpdateOne) SetOauthScope(s string) *DeviceUpdateOne {
+	duo.mutation.SetOauthScope(s)
+	return duo
+}
+
+// SetNillableOauthScope sets the "oauth_scope" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableOauthScope(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetOauthScope(*s)
+	}
+	return duo
+}
+
+// ClearOauthScope clears the value of the "oauth_scope" field.
+func (duo *DeviceUpdateOne) ClearOauthScope() *DeviceUpdateOne {
+	duo.mutation.ClearOauthScope()
+	return duo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (duo *DeviceUpdateOne) SetUser(u *User) *DeviceUpdateOne {
 	return duo.SetUserID(u.ID)
@@ -502,11 +788,6 @@ func (duo *DeviceUpdateOne) check() error {
 			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "Device.device_id": %w`, err)}
 		}
 	}
-	if v, ok := duo.mutation.RefreshToken(); ok {
-		if err := device.RefreshTokenValidator(v); err != nil {
-			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "Device.refresh_token": %w`, err)}
-		}
-	}
 	if duo.mutation.UserCleared() && len(duo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Device.user"`)
 	}
@@ -566,9 +847,48 @@ func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err err
 	if value, ok := duo.mutation.RefreshToken(); ok {
 		_spec.SetField(device.FieldRefreshToken, field.TypeString, value)
 	}
+	if duo.mutation.RefreshTokenCleared() {
+		_spec.ClearField(device.FieldRefreshToken, field.TypeString)
+	}
+	if value, ok := duo.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(device.FieldRefreshTokenHash, field.TypeString, value)
+	}
+	if duo.mutation.RefreshTokenHashCleared() {
+		_spec.ClearField(device.FieldRefreshTokenHash, field.TypeString)
+	}
 	if value, ok := duo.mutation.RefreshTokenExpiresAt(); ok {
 		_spec.SetField(device.FieldRefreshTokenExpiresAt, field.TypeTime, value)
 	}
+	if value, ok := duo.mutation.RefreshTokenFamily(); ok {
+		_spec.SetField(device.FieldRefreshTokenFamily, field.TypeUUID, value)
+	}
+	if duo.mutation.RefreshTokenFamilyCleared() {
+		_spec.ClearField(device.FieldRefreshTokenFamily, field.TypeUUID)
+	}
+	if value, ok := duo.mutation.LastIP(); ok {
+		_spec.SetField(device.FieldLastIP, field.TypeString, value)
+	}
+	if duo.mutation.LastIPCleared() {
+		_spec.ClearField(device.FieldLastIP, field.TypeString)
+	}
+	if value, ok := duo.mutation.UserAgent(); ok {
+		_spec.SetField(device.FieldUserAgent, field.TypeString, value)
+	}
+	if duo.mutation.UserAgentCleared() {
+		_spec.ClearField(device.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := duo.mutation.LastRefreshedAt(); ok {
+		_spec.SetField(device.FieldLastRefreshedAt, field.TypeTime, value)
+	}
+	if duo.mutation.LastRefreshedAtCleared() {
+		_spec.ClearField(device.FieldLastRefreshedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.OauthScope(); ok {
+		_spec.SetField(device.FieldOauthScope, field.TypeString, value)
+	}
+	if duo.mutation.OauthScopeCleared() {
+		_spec.ClearField(device.FieldOauthScope, field.TypeString)
+	}
 	if duo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
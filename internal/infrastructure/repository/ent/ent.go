@@ -10,18 +10,31 @@ import (
 	"kiwi-user/internal/infrastructure/repository/ent/binding"
 	"kiwi-user/internal/infrastructure/repository/ent/bindingverify"
 	"kiwi-user/internal/infrastructure/repository/ent/device"
+	"kiwi-user/internal/infrastructure/repository/ent/identityprovider"
+	"kiwi-user/internal/infrastructure/repository/ent/loginlock"
+	"kiwi-user/internal/infrastructure/repository/ent/magiclink"
 	"kiwi-user/internal/infrastructure/repository/ent/mailvertifycode"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthauthorizationcode"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthconsent"
 	"kiwi-user/internal/infrastructure/repository/ent/organization"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationapplication"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationrequest"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationuser"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
 	"kiwi-user/internal/infrastructure/repository/ent/payment"
+	"kiwi-user/internal/infrastructure/repository/ent/qrlogin"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatcorp"
 	"kiwi-user/internal/infrastructure/repository/ent/qywechatuserid"
 	"kiwi-user/internal/infrastructure/repository/ent/role"
+	"kiwi-user/internal/infrastructure/repository/ent/rotatedrefreshtoken"
+	"kiwi-user/internal/infrastructure/repository/ent/samlconnection"
 	"kiwi-user/internal/infrastructure/repository/ent/scope"
+	"kiwi-user/internal/infrastructure/repository/ent/smsverifycode"
 	"kiwi-user/internal/infrastructure/repository/ent/stripeevent"
 	"kiwi-user/internal/infrastructure/repository/ent/user"
+	"kiwi-user/internal/infrastructure/repository/ent/webauthnchallenge"
 	"kiwi-user/internal/infrastructure/repository/ent/wechatopenid"
+	"kiwi-user/internal/infrastructure/repository/ent/wechatscanlogin"
 	"reflect"
 	"sync"
 
@@ -92,18 +105,31 @@ func checkColumn(table, column string) error {
 			binding.Table:                 binding.ValidColumn,
 			bindingverify.Table:           bindingverify.ValidColumn,
 			device.Table:                  device.ValidColumn,
+			identityprovider.Table:        identityprovider.ValidColumn,
+			loginlock.Table:               loginlock.ValidColumn,
+			magiclink.Table:               magiclink.ValidColumn,
 			mailvertifycode.Table:         mailvertifycode.ValidColumn,
+			oauthauthorizationcode.Table:  oauthauthorizationcode.ValidColumn,
+			oauthconsent.Table:            oauthconsent.ValidColumn,
 			organization.Table:            organization.ValidColumn,
 			organizationapplication.Table: organizationapplication.ValidColumn,
 			organizationrequest.Table:     organizationrequest.ValidColumn,
 			organizationuser.Table:        organizationuser.ValidColumn,
+			passkeycredential.Table:       passkeycredential.ValidColumn,
 			payment.Table:                 payment.ValidColumn,
+			qrlogin.Table:                 qrlogin.ValidColumn,
+			qywechatcorp.Table:            qywechatcorp.ValidColumn,
 			qywechatuserid.Table:          qywechatuserid.ValidColumn,
 			role.Table:                    role.ValidColumn,
+			rotatedrefreshtoken.Table:     rotatedrefreshtoken.ValidColumn,
+			samlconnection.Table:          samlconnection.ValidColumn,
 			scope.Table:                   scope.ValidColumn,
+			smsverifycode.Table:           smsverifycode.ValidColumn,
 			stripeevent.Table:             stripeevent.ValidColumn,
 			user.Table:                    user.ValidColumn,
+			webauthnchallenge.Table:       webauthnchallenge.ValidColumn,
 			wechatopenid.Table:            wechatopenid.ValidColumn,
+			wechatscanlogin.Table:         wechatscanlogin.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -56,6 +56,42 @@ func (f DeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceMutation", m)
 }
 
+// The IdentityProviderFunc type is an adapter to allow the use of ordinary
+// function as IdentityProvider mutator.
+type IdentityProviderFunc func(context.Context, *ent.IdentityProviderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdentityProviderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdentityProviderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdentityProviderMutation", m)
+}
+
+// The LoginLockFunc type is an adapter to allow the use of ordinary
+// function as LoginLock mutator.
+type LoginLockFunc func(context.Context, *ent.LoginLockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoginLockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoginLockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginLockMutation", m)
+}
+
+// The MagicLinkFunc type is an adapter to allow the use of ordinary
+// function as MagicLink mutator.
+type MagicLinkFunc func(context.Context, *ent.MagicLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MagicLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MagicLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MagicLinkMutation", m)
+}
+
 // The MailVertifyCodeFunc type is an adapter to allow the use of ordinary
 // function as MailVertifyCode mutator.
 type MailVertifyCodeFunc func(context.Context, *ent.MailVertifyCodeMutation) (ent.Value, error)
@@ -68,6 +104,30 @@ func (f MailVertifyCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MailVertifyCodeMutation", m)
 }
 
+// The OAuthAuthorizationCodeFunc type is an adapter to allow the use of ordinary
+// function as OAuthAuthorizationCode mutator.
+type OAuthAuthorizationCodeFunc func(context.Context, *ent.OAuthAuthorizationCodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OAuthAuthorizationCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OAuthAuthorizationCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OAuthAuthorizationCodeMutation", m)
+}
+
+// The OAuthConsentFunc type is an adapter to allow the use of ordinary
+// function as OAuthConsent mutator.
+type OAuthConsentFunc func(context.Context, *ent.OAuthConsentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OAuthConsentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OAuthConsentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OAuthConsentMutation", m)
+}
+
 // The OrganizationFunc type is an adapter to allow the use of ordinary
 // function as Organization mutator.
 type OrganizationFunc func(context.Context, *ent.OrganizationMutation) (ent.Value, error)
@@ -116,6 +176,18 @@ func (f OrganizationUserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrganizationUserMutation", m)
 }
 
+// The PasskeyCredentialFunc type is an adapter to allow the use of ordinary
+// function as PasskeyCredential mutator.
+type PasskeyCredentialFunc func(context.Context, *ent.PasskeyCredentialMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PasskeyCredentialFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PasskeyCredentialMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PasskeyCredentialMutation", m)
+}
+
 // The PaymentFunc type is an adapter to allow the use of ordinary
 // function as Payment mutator.
 type PaymentFunc func(context.Context, *ent.PaymentMutation) (ent.Value, error)
@@ -128,6 +200,30 @@ func (f PaymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentMutation", m)
 }
 
+// The QRLoginFunc type is an adapter to allow the use of ordinary
+// function as QRLogin mutator.
+type QRLoginFunc func(context.Context, *ent.QRLoginMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QRLoginFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QRLoginMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QRLoginMutation", m)
+}
+
+// The QyWechatCorpFunc type is an adapter to allow the use of ordinary
+// function as QyWechatCorp mutator.
+type QyWechatCorpFunc func(context.Context, *ent.QyWechatCorpMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QyWechatCorpFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QyWechatCorpMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QyWechatCorpMutation", m)
+}
+
 // The QyWechatUserIDFunc type is an adapter to allow the use of ordinary
 // function as QyWechatUserID mutator.
 type QyWechatUserIDFunc func(context.Context, *ent.QyWechatUserIDMutation) (ent.Value, error)
@@ -152,6 +248,30 @@ func (f RoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleMutation", m)
 }
 
+// The RotatedRefreshTokenFunc type is an adapter to allow the use of ordinary
+// function as RotatedRefreshToken mutator.
+type RotatedRefreshTokenFunc func(context.Context, *ent.RotatedRefreshTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RotatedRefreshTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RotatedRefreshTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RotatedRefreshTokenMutation", m)
+}
+
+// The SAMLConnectionFunc type is an adapter to allow the use of ordinary
+// function as SAMLConnection mutator.
+type SAMLConnectionFunc func(context.Context, *ent.SAMLConnectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SAMLConnectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SAMLConnectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SAMLConnectionMutation", m)
+}
+
 // The ScopeFunc type is an adapter to allow the use of ordinary
 // function as Scope mutator.
 type ScopeFunc func(context.Context, *ent.ScopeMutation) (ent.Value, error)
@@ -164,6 +284,18 @@ func (f ScopeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScopeMutation", m)
 }
 
+// The SmsVerifyCodeFunc type is an adapter to allow the use of ordinary
+// function as SmsVerifyCode mutator.
+type SmsVerifyCodeFunc func(context.Context, *ent.SmsVerifyCodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SmsVerifyCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SmsVerifyCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SmsVerifyCodeMutation", m)
+}
+
 // The StripeEventFunc type is an adapter to allow the use of ordinary
 // function as StripeEvent mutator.
 type StripeEventFunc func(context.Context, *ent.StripeEventMutation) (ent.Value, error)
@@ -188,6 +320,18 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The WebAuthnChallengeFunc type is an adapter to allow the use of ordinary
+// function as WebAuthnChallenge mutator.
+type WebAuthnChallengeFunc func(context.Context, *ent.WebAuthnChallengeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebAuthnChallengeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebAuthnChallengeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebAuthnChallengeMutation", m)
+}
+
 // The WechatOpenIDFunc type is an adapter to allow the use of ordinary
 // function as WechatOpenID mutator.
 type WechatOpenIDFunc func(context.Context, *ent.WechatOpenIDMutation) (ent.Value, error)
@@ -200,6 +344,18 @@ func (f WechatOpenIDFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WechatOpenIDMutation", m)
 }
 
+// The WechatScanLoginFunc type is an adapter to allow the use of ordinary
+// function as WechatScanLogin mutator.
+type WechatScanLoginFunc func(context.Context, *ent.WechatScanLoginMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WechatScanLoginFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WechatScanLoginMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WechatScanLoginMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
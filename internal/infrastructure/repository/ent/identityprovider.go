@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/identityprovider"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// IdentityProvider is the model entity for the IdentityProvider schema.
+type IdentityProvider struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ApplicationID holds the value of the "application_id" field.
+	ApplicationID uuid.UUID `json:"application_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// Issuer holds the value of the "issuer" field.
+	Issuer string `json:"issuer,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// ClientSecret holds the value of the "client_secret" field.
+	ClientSecret string `json:"-"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// AuthorizationEndpoint holds the value of the "authorization_endpoint" field.
+	AuthorizationEndpoint string `json:"authorization_endpoint,omitempty"`
+	// TokenEndpoint holds the value of the "token_endpoint" field.
+	TokenEndpoint string `json:"token_endpoint,omitempty"`
+	// UserinfoEndpoint holds the value of the "userinfo_endpoint" field.
+	UserinfoEndpoint string `json:"userinfo_endpoint,omitempty"`
+	// JwksURI holds the value of the "jwks_uri" field.
+	JwksURI string `json:"jwks_uri,omitempty"`
+	// ClaimMapping holds the value of the "claim_mapping" field.
+	ClaimMapping map[string]string `json:"claim_mapping,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled      bool `json:"enabled,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdentityProvider) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case identityprovider.FieldScopes, identityprovider.FieldClaimMapping:
+			values[i] = new([]byte)
+		case identityprovider.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case identityprovider.FieldName, identityprovider.FieldDisplayName, identityprovider.FieldIssuer, identityprovider.FieldClientID, identityprovider.FieldClientSecret, identityprovider.FieldAuthorizationEndpoint, identityprovider.FieldTokenEndpoint, identityprovider.FieldUserinfoEndpoint, identityprovider.FieldJwksURI:
+			values[i] = new(sql.NullString)
+		case identityprovider.FieldCreatedAt, identityprovider.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case identityprovider.FieldID, identityprovider.FieldApplicationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdentityProvider fields.
+func (ip *IdentityProvider) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case identityprovider.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ip.ID = *value
+			}
+		case identityprovider.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ip.CreatedAt = value.Time
+			}
+		case identityprovider.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ip.UpdatedAt = value.Time
+			}
+		case identityprovider.FieldApplicationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field application_id", values[i])
+			} else if value != nil {
+				ip.ApplicationID = *value
+			}
+		case identityprovider.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ip.Name = value.String
+			}
+		case identityprovider.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				ip.DisplayName = value.String
+			}
+		case identityprovider.FieldIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer", values[i])
+			} else if value.Valid {
+				ip.Issuer = value.String
+			}
+		case identityprovider.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				ip.ClientID = value.String
+			}
+		case identityprovider.FieldClientSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_secret", values[i])
+			} else if value.Valid {
+				ip.ClientSecret = value.String
+			}
+		case identityprovider.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ip.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case identityprovider.FieldAuthorizationEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field authorization_endpoint", values[i])
+			} else if value.Valid {
+				ip.AuthorizationEndpoint = value.String
+			}
+		case identityprovider.FieldTokenEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_endpoint", values[i])
+			} else if value.Valid {
+				ip.TokenEndpoint = value.String
+			}
+		case identityprovider.FieldUserinfoEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field userinfo_endpoint", values[i])
+			} else if value.Valid {
+				ip.UserinfoEndpoint = value.String
+			}
+		case identityprovider.FieldJwksURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field jwks_uri", values[i])
+			} else if value.Valid {
+				ip.JwksURI = value.String
+			}
+		case identityprovider.FieldClaimMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ip.ClaimMapping); err != nil {
+					return fmt.Errorf("unmarshal field claim_mapping: %w", err)
+				}
+			}
+		case identityprovider.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ip.Enabled = value.Bool
+			}
+		default:
+			ip.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IdentityProvider.
+// This includes values selected through modifiers, order, etc.
+func (ip *IdentityProvider) Value(name string) (ent.Value, error) {
+	return ip.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this IdentityProvider.
+// Note that you need to call IdentityProvider.Unwrap() before calling this method if this IdentityProvider
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ip *IdentityProvider) Update() *IdentityProviderUpdateOne {
+	return NewIdentityProviderClient(ip.config).UpdateOne(ip)
+}
+
+// Unwrap unwraps the IdentityProvider entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ip *IdentityProvider) Unwrap() *IdentityProvider {
+	_tx, ok := ip.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IdentityProvider is not a transactional entity")
+	}
+	ip.config.driver = _tx.drv
+	return ip
+}
+
+// String implements the fmt.Stringer.
+func (ip *IdentityProvider) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdentityProvider(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ip.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ip.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ip.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("application_id=")
+	builder.WriteString(fmt.Sprintf("%v", ip.ApplicationID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ip.Name)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(ip.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("issuer=")
+	builder.WriteString(ip.Issuer)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(ip.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("client_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", ip.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("authorization_endpoint=")
+	builder.WriteString(ip.AuthorizationEndpoint)
+	builder.WriteString(", ")
+	builder.WriteString("token_endpoint=")
+	builder.WriteString(ip.TokenEndpoint)
+	builder.WriteString(", ")
+	builder.WriteString("userinfo_endpoint=")
+	builder.WriteString(ip.UserinfoEndpoint)
+	builder.WriteString(", ")
+	builder.WriteString("jwks_uri=")
+	builder.WriteString(ip.JwksURI)
+	builder.WriteString(", ")
+	builder.WriteString("claim_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", ip.ClaimMapping))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ip.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdentityProviders is a parsable slice of IdentityProvider.
+type IdentityProviders []*IdentityProvider
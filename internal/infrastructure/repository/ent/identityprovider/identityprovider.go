@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package identityprovider
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the identityprovider type in the database.
+	Label = "identity_provider"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldApplicationID holds the string denoting the application_id field in the database.
+	FieldApplicationID = "application_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldIssuer holds the string denoting the issuer field in the database.
+	FieldIssuer = "issuer"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldClientSecret holds the string denoting the client_secret field in the database.
+	FieldClientSecret = "client_secret"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldAuthorizationEndpoint holds the string denoting the authorization_endpoint field in the database.
+	FieldAuthorizationEndpoint = "authorization_endpoint"
+	// FieldTokenEndpoint holds the string denoting the token_endpoint field in the database.
+	FieldTokenEndpoint = "token_endpoint"
+	// FieldUserinfoEndpoint holds the string denoting the userinfo_endpoint field in the database.
+	FieldUserinfoEndpoint = "userinfo_endpoint"
+	// FieldJwksURI holds the string denoting the jwks_uri field in the database.
+	FieldJwksURI = "jwks_uri"
+	// FieldClaimMapping holds the string denoting the claim_mapping field in the database.
+	FieldClaimMapping = "claim_mapping"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// Table holds the table name of the identityprovider in the database.
+	Table = "identity_providers"
+)
+
+// Columns holds all SQL columns for identityprovider fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldApplicationID,
+	FieldName,
+	FieldDisplayName,
+	FieldIssuer,
+	FieldClientID,
+	FieldClientSecret,
+	FieldScopes,
+	FieldAuthorizationEndpoint,
+	FieldTokenEndpoint,
+	FieldUserinfoEndpoint,
+	FieldJwksURI,
+	FieldClaimMapping,
+	FieldEnabled,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	IssuerValidator func(string) error
+	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	ClientIDValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the IdentityProvider queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByApplicationID orders the results by the application_id field.
+func ByApplicationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByIssuer orders the results by the issuer field.
+func ByIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuer, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByClientSecret orders the results by the client_secret field.
+func ByClientSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientSecret, opts...).ToFunc()
+}
+
+// ByAuthorizationEndpoint orders the results by the authorization_endpoint field.
+func ByAuthorizationEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorizationEndpoint, opts...).ToFunc()
+}
+
+// ByTokenEndpoint orders the results by the token_endpoint field.
+func ByTokenEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenEndpoint, opts...).ToFunc()
+}
+
+// ByUserinfoEndpoint orders the results by the userinfo_endpoint field.
+func ByUserinfoEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserinfoEndpoint, opts...).ToFunc()
+}
+
+// ByJwksURI orders the results by the jwks_uri field.
+func ByJwksURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJwksURI, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
@@ -0,0 +1,931 @@
+// Code generated by ent, DO NOT EDIT.
+
+package identityprovider
+
+import (
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ApplicationID applies equality check predicate on the "application_id" field. It's identical to ApplicationIDEQ.
+func ApplicationID(v uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldName, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// Issuer applies equality check predicate on the "issuer" field. It's identical to IssuerEQ.
+func Issuer(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldIssuer, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientSecret applies equality check predicate on the "client_secret" field. It's identical to ClientSecretEQ.
+func ClientSecret(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// AuthorizationEndpoint applies equality check predicate on the "authorization_endpoint" field. It's identical to AuthorizationEndpointEQ.
+func AuthorizationEndpoint(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldAuthorizationEndpoint, v))
+}
+
+// TokenEndpoint applies equality check predicate on the "token_endpoint" field. It's identical to TokenEndpointEQ.
+func TokenEndpoint(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldTokenEndpoint, v))
+}
+
+// UserinfoEndpoint applies equality check predicate on the "userinfo_endpoint" field. It's identical to UserinfoEndpointEQ.
+func UserinfoEndpoint(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldUserinfoEndpoint, v))
+}
+
+// JwksURI applies equality check predicate on the "jwks_uri" field. It's identical to JwksURIEQ.
+func JwksURI(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldJwksURI, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ApplicationIDEQ applies the EQ predicate on the "application_id" field.
+func ApplicationIDEQ(v uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDNEQ applies the NEQ predicate on the "application_id" field.
+func ApplicationIDNEQ(v uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDIn applies the In predicate on the "application_id" field.
+func ApplicationIDIn(vs ...uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDNotIn applies the NotIn predicate on the "application_id" field.
+func ApplicationIDNotIn(vs ...uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDGT applies the GT predicate on the "application_id" field.
+func ApplicationIDGT(v uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldApplicationID, v))
+}
+
+// ApplicationIDGTE applies the GTE predicate on the "application_id" field.
+func ApplicationIDGTE(v uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldApplicationID, v))
+}
+
+// ApplicationIDLT applies the LT predicate on the "application_id" field.
+func ApplicationIDLT(v uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldApplicationID, v))
+}
+
+// ApplicationIDLTE applies the LTE predicate on the "application_id" field.
+func ApplicationIDLTE(v uuid.UUID) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldApplicationID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContainsFold(FieldName, v))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameIsNil applies the IsNil predicate on the "display_name" field.
+func DisplayNameIsNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIsNull(FieldDisplayName))
+}
+
+// DisplayNameNotNil applies the NotNil predicate on the "display_name" field.
+func DisplayNameNotNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotNull(FieldDisplayName))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// IssuerEQ applies the EQ predicate on the "issuer" field.
+func IssuerEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldIssuer, v))
+}
+
+// IssuerNEQ applies the NEQ predicate on the "issuer" field.
+func IssuerNEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldIssuer, v))
+}
+
+// IssuerIn applies the In predicate on the "issuer" field.
+func IssuerIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldIssuer, vs...))
+}
+
+// IssuerNotIn applies the NotIn predicate on the "issuer" field.
+func IssuerNotIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldIssuer, vs...))
+}
+
+// IssuerGT applies the GT predicate on the "issuer" field.
+func IssuerGT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldIssuer, v))
+}
+
+// IssuerGTE applies the GTE predicate on the "issuer" field.
+func IssuerGTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldIssuer, v))
+}
+
+// IssuerLT applies the LT predicate on the "issuer" field.
+func IssuerLT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldIssuer, v))
+}
+
+// IssuerLTE applies the LTE predicate on the "issuer" field.
+func IssuerLTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldIssuer, v))
+}
+
+// IssuerContains applies the Contains predicate on the "issuer" field.
+func IssuerContains(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContains(FieldIssuer, v))
+}
+
+// IssuerHasPrefix applies the HasPrefix predicate on the "issuer" field.
+func IssuerHasPrefix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasPrefix(FieldIssuer, v))
+}
+
+// IssuerHasSuffix applies the HasSuffix predicate on the "issuer" field.
+func IssuerHasSuffix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasSuffix(FieldIssuer, v))
+}
+
+// IssuerEqualFold applies the EqualFold predicate on the "issuer" field.
+func IssuerEqualFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEqualFold(FieldIssuer, v))
+}
+
+// IssuerContainsFold applies the ContainsFold predicate on the "issuer" field.
+func IssuerContainsFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContainsFold(FieldIssuer, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ClientSecretEQ applies the EQ predicate on the "client_secret" field.
+func ClientSecretEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// ClientSecretNEQ applies the NEQ predicate on the "client_secret" field.
+func ClientSecretNEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldClientSecret, v))
+}
+
+// ClientSecretIn applies the In predicate on the "client_secret" field.
+func ClientSecretIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretNotIn applies the NotIn predicate on the "client_secret" field.
+func ClientSecretNotIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretGT applies the GT predicate on the "client_secret" field.
+func ClientSecretGT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldClientSecret, v))
+}
+
+// ClientSecretGTE applies the GTE predicate on the "client_secret" field.
+func ClientSecretGTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldClientSecret, v))
+}
+
+// ClientSecretLT applies the LT predicate on the "client_secret" field.
+func ClientSecretLT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldClientSecret, v))
+}
+
+// ClientSecretLTE applies the LTE predicate on the "client_secret" field.
+func ClientSecretLTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldClientSecret, v))
+}
+
+// ClientSecretContains applies the Contains predicate on the "client_secret" field.
+func ClientSecretContains(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContains(FieldClientSecret, v))
+}
+
+// ClientSecretHasPrefix applies the HasPrefix predicate on the "client_secret" field.
+func ClientSecretHasPrefix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasPrefix(FieldClientSecret, v))
+}
+
+// ClientSecretHasSuffix applies the HasSuffix predicate on the "client_secret" field.
+func ClientSecretHasSuffix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasSuffix(FieldClientSecret, v))
+}
+
+// ClientSecretIsNil applies the IsNil predicate on the "client_secret" field.
+func ClientSecretIsNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIsNull(FieldClientSecret))
+}
+
+// ClientSecretNotNil applies the NotNil predicate on the "client_secret" field.
+func ClientSecretNotNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotNull(FieldClientSecret))
+}
+
+// ClientSecretEqualFold applies the EqualFold predicate on the "client_secret" field.
+func ClientSecretEqualFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEqualFold(FieldClientSecret, v))
+}
+
+// ClientSecretContainsFold applies the ContainsFold predicate on the "client_secret" field.
+func ClientSecretContainsFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContainsFold(FieldClientSecret, v))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotNull(FieldScopes))
+}
+
+// AuthorizationEndpointEQ applies the EQ predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointNEQ applies the NEQ predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointNEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointIn applies the In predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldAuthorizationEndpoint, vs...))
+}
+
+// AuthorizationEndpointNotIn applies the NotIn predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointNotIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldAuthorizationEndpoint, vs...))
+}
+
+// AuthorizationEndpointGT applies the GT predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointGT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointGTE applies the GTE predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointGTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointLT applies the LT predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointLT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointLTE applies the LTE predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointLTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointContains applies the Contains predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointContains(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContains(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointHasPrefix applies the HasPrefix predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointHasPrefix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasPrefix(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointHasSuffix applies the HasSuffix predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointHasSuffix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasSuffix(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointIsNil applies the IsNil predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointIsNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIsNull(FieldAuthorizationEndpoint))
+}
+
+// AuthorizationEndpointNotNil applies the NotNil predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointNotNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotNull(FieldAuthorizationEndpoint))
+}
+
+// AuthorizationEndpointEqualFold applies the EqualFold predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointEqualFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEqualFold(FieldAuthorizationEndpoint, v))
+}
+
+// AuthorizationEndpointContainsFold applies the ContainsFold predicate on the "authorization_endpoint" field.
+func AuthorizationEndpointContainsFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContainsFold(FieldAuthorizationEndpoint, v))
+}
+
+// TokenEndpointEQ applies the EQ predicate on the "token_endpoint" field.
+func TokenEndpointEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointNEQ applies the NEQ predicate on the "token_endpoint" field.
+func TokenEndpointNEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointIn applies the In predicate on the "token_endpoint" field.
+func TokenEndpointIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldTokenEndpoint, vs...))
+}
+
+// TokenEndpointNotIn applies the NotIn predicate on the "token_endpoint" field.
+func TokenEndpointNotIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldTokenEndpoint, vs...))
+}
+
+// TokenEndpointGT applies the GT predicate on the "token_endpoint" field.
+func TokenEndpointGT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointGTE applies the GTE predicate on the "token_endpoint" field.
+func TokenEndpointGTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointLT applies the LT predicate on the "token_endpoint" field.
+func TokenEndpointLT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointLTE applies the LTE predicate on the "token_endpoint" field.
+func TokenEndpointLTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointContains applies the Contains predicate on the "token_endpoint" field.
+func TokenEndpointContains(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContains(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointHasPrefix applies the HasPrefix predicate on the "token_endpoint" field.
+func TokenEndpointHasPrefix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasPrefix(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointHasSuffix applies the HasSuffix predicate on the "token_endpoint" field.
+func TokenEndpointHasSuffix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasSuffix(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointIsNil applies the IsNil predicate on the "token_endpoint" field.
+func TokenEndpointIsNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIsNull(FieldTokenEndpoint))
+}
+
+// TokenEndpointNotNil applies the NotNil predicate on the "token_endpoint" field.
+func TokenEndpointNotNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotNull(FieldTokenEndpoint))
+}
+
+// TokenEndpointEqualFold applies the EqualFold predicate on the "token_endpoint" field.
+func TokenEndpointEqualFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEqualFold(FieldTokenEndpoint, v))
+}
+
+// TokenEndpointContainsFold applies the ContainsFold predicate on the "token_endpoint" field.
+func TokenEndpointContainsFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContainsFold(FieldTokenEndpoint, v))
+}
+
+// UserinfoEndpointEQ applies the EQ predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointNEQ applies the NEQ predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointNEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointIn applies the In predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldUserinfoEndpoint, vs...))
+}
+
+// UserinfoEndpointNotIn applies the NotIn predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointNotIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldUserinfoEndpoint, vs...))
+}
+
+// UserinfoEndpointGT applies the GT predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointGT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointGTE applies the GTE predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointGTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointLT applies the LT predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointLT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointLTE applies the LTE predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointLTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointContains applies the Contains predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointContains(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContains(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointHasPrefix applies the HasPrefix predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointHasPrefix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasPrefix(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointHasSuffix applies the HasSuffix predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointHasSuffix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasSuffix(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointIsNil applies the IsNil predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointIsNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIsNull(FieldUserinfoEndpoint))
+}
+
+// UserinfoEndpointNotNil applies the NotNil predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointNotNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotNull(FieldUserinfoEndpoint))
+}
+
+// UserinfoEndpointEqualFold applies the EqualFold predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointEqualFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEqualFold(FieldUserinfoEndpoint, v))
+}
+
+// UserinfoEndpointContainsFold applies the ContainsFold predicate on the "userinfo_endpoint" field.
+func UserinfoEndpointContainsFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContainsFold(FieldUserinfoEndpoint, v))
+}
+
+// JwksURIEQ applies the EQ predicate on the "jwks_uri" field.
+func JwksURIEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldJwksURI, v))
+}
+
+// JwksURINEQ applies the NEQ predicate on the "jwks_uri" field.
+func JwksURINEQ(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldJwksURI, v))
+}
+
+// JwksURIIn applies the In predicate on the "jwks_uri" field.
+func JwksURIIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIn(FieldJwksURI, vs...))
+}
+
+// JwksURINotIn applies the NotIn predicate on the "jwks_uri" field.
+func JwksURINotIn(vs ...string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotIn(FieldJwksURI, vs...))
+}
+
+// JwksURIGT applies the GT predicate on the "jwks_uri" field.
+func JwksURIGT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGT(FieldJwksURI, v))
+}
+
+// JwksURIGTE applies the GTE predicate on the "jwks_uri" field.
+func JwksURIGTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldGTE(FieldJwksURI, v))
+}
+
+// JwksURILT applies the LT predicate on the "jwks_uri" field.
+func JwksURILT(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLT(FieldJwksURI, v))
+}
+
+// JwksURILTE applies the LTE predicate on the "jwks_uri" field.
+func JwksURILTE(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldLTE(FieldJwksURI, v))
+}
+
+// JwksURIContains applies the Contains predicate on the "jwks_uri" field.
+func JwksURIContains(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContains(FieldJwksURI, v))
+}
+
+// JwksURIHasPrefix applies the HasPrefix predicate on the "jwks_uri" field.
+func JwksURIHasPrefix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasPrefix(FieldJwksURI, v))
+}
+
+// JwksURIHasSuffix applies the HasSuffix predicate on the "jwks_uri" field.
+func JwksURIHasSuffix(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldHasSuffix(FieldJwksURI, v))
+}
+
+// JwksURIIsNil applies the IsNil predicate on the "jwks_uri" field.
+func JwksURIIsNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIsNull(FieldJwksURI))
+}
+
+// JwksURINotNil applies the NotNil predicate on the "jwks_uri" field.
+func JwksURINotNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotNull(FieldJwksURI))
+}
+
+// JwksURIEqualFold applies the EqualFold predicate on the "jwks_uri" field.
+func JwksURIEqualFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEqualFold(FieldJwksURI, v))
+}
+
+// JwksURIContainsFold applies the ContainsFold predicate on the "jwks_uri" field.
+func JwksURIContainsFold(v string) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldContainsFold(FieldJwksURI, v))
+}
+
+// ClaimMappingIsNil applies the IsNil predicate on the "claim_mapping" field.
+func ClaimMappingIsNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldIsNull(FieldClaimMapping))
+}
+
+// ClaimMappingNotNil applies the NotNil predicate on the "claim_mapping" field.
+func ClaimMappingNotNil() predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNotNull(FieldClaimMapping))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IdentityProvider) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IdentityProvider) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IdentityProvider) predicate.IdentityProvider {
+	return predicate.IdentityProvider(sql.NotPredicates(p))
+}
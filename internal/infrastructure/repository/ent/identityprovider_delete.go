@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kiwi-user/internal/infrastructure/repository/ent/identityprovider"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IdentityProviderDelete is the builder for deleting a IdentityProvider entity.
+type IdentityProviderDelete struct {
+	config
+	hooks    []Hook
+	mutation *IdentityProviderMutation
+}
+
+// Where appends a list predicates to the IdentityProviderDelete builder.
+func (ipd *IdentityProviderDelete) Where(ps ...predicate.IdentityProvider) *IdentityProviderDelete {
+	ipd.mutation.Where(ps...)
+	return ipd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ipd *IdentityProviderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ipd.sqlExec, ipd.mutation, ipd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipd *IdentityProviderDelete) ExecX(ctx context.Context) int {
+	n, err := ipd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ipd *IdentityProviderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(identityprovider.Table, sqlgraph.NewFieldSpec(identityprovider.FieldID, field.TypeUUID))
+	if ps := ipd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ipd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ipd.mutation.done = true
+	return affected, err
+}
+
+// IdentityProviderDeleteOne is the builder for deleting a single IdentityProvider entity.
+type IdentityProviderDeleteOne struct {
+	ipd *IdentityProviderDelete
+}
+
+// Where appends a list predicates to the IdentityProviderDelete builder.
+func (ipdo *IdentityProviderDeleteOne) Where(ps ...predicate.IdentityProvider) *IdentityProviderDeleteOne {
+	ipdo.ipd.mutation.Where(ps...)
+	return ipdo
+}
+
+// Exec executes the deletion query.
+func (ipdo *IdentityProviderDeleteOne) Exec(ctx context.Context) error {
+	n, err := ipdo.ipd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{identityprovider.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipdo *IdentityProviderDeleteOne) ExecX(ctx context.Context) {
+	if err := ipdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,792 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/identityprovider"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// IdentityProviderUpdate is the builder for updating IdentityProvider entities.
+type IdentityProviderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdentityProviderMutation
+}
+
+// Where appends a list predicates to the IdentityProviderUpdate builder.
+func (ipu *IdentityProviderUpdate) Where(ps ...predicate.IdentityProvider) *IdentityProviderUpdate {
+	ipu.mutation.Where(ps...)
+	return ipu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ipu *IdentityProviderUpdate) SetUpdatedAt(t time.Time) *IdentityProviderUpdate {
+	ipu.mutation.SetUpdatedAt(t)
+	return ipu
+}
+
+// SetApplicationID sets the "application_id" field.
+func (ipu *IdentityProviderUpdate) SetApplicationID(u uuid.UUID) *IdentityProviderUpdate {
+	ipu.mutation.SetApplicationID(u)
+	return ipu
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableApplicationID(u *uuid.UUID) *IdentityProviderUpdate {
+	if u != nil {
+		ipu.SetApplicationID(*u)
+	}
+	return ipu
+}
+
+// SetDisplayName sets the "display_name" field.
+func (ipu *IdentityProviderUpdate) SetDisplayName(s string) *IdentityProviderUpdate {
+	ipu.mutation.SetDisplayName(s)
+	return ipu
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableDisplayName(s *string) *IdentityProviderUpdate {
+	if s != nil {
+		ipu.SetDisplayName(*s)
+	}
+	return ipu
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (ipu *IdentityProviderUpdate) ClearDisplayName() *IdentityProviderUpdate {
+	ipu.mutation.ClearDisplayName()
+	return ipu
+}
+
+// SetIssuer sets the "issuer" field.
+func (ipu *IdentityProviderUpdate) SetIssuer(s string) *IdentityProviderUpdate {
+	ipu.mutation.SetIssuer(s)
+	return ipu
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableIssuer(s *string) *IdentityProviderUpdate {
+	if s != nil {
+		ipu.SetIssuer(*s)
+	}
+	return ipu
+}
+
+// SetClientID sets the "client_id" field.
+func (ipu *IdentityProviderUpdate) SetClientID(s string) *IdentityProviderUpdate {
+	ipu.mutation.SetClientID(s)
+	return ipu
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableClientID(s *string) *IdentityProviderUpdate {
+	if s != nil {
+		ipu.SetClientID(*s)
+	}
+	return ipu
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (ipu *IdentityProviderUpdate) SetClientSecret(s string) *IdentityProviderUpdate {
+	ipu.mutation.SetClientSecret(s)
+	return ipu
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableClientSecret(s *string) *IdentityProviderUpdate {
+	if s != nil {
+		ipu.SetClientSecret(*s)
+	}
+	return ipu
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (ipu *IdentityProviderUpdate) ClearClientSecret() *IdentityProviderUpdate {
+	ipu.mutation.ClearClientSecret()
+	return ipu
+}
+
+// SetScopes sets the "scopes" field.
+func (ipu *IdentityProviderUpdate) SetScopes(s []string) *IdentityProviderUpdate {
+	ipu.mutation.SetScopes(s)
+	return ipu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ipu *IdentityProviderUpdate) AppendScopes(s []string) *IdentityProviderUpdate {
+	ipu.mutation.AppendScopes(s)
+	return ipu
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (ipu *IdentityProviderUpdate) ClearScopes() *IdentityProviderUpdate {
+	ipu.mutation.ClearScopes()
+	return ipu
+}
+
+// SetAuthorizationEndpoint sets the "authorization_endpoint" field.
+func (ipu *IdentityProviderUpdate) SetAuthorizationEndpoint(s string) *IdentityProviderUpdate {
+	ipu.mutation.SetAuthorizationEndpoint(s)
+	return ipu
+}
+
+// SetNillableAuthorizationEndpoint sets the "authorization_endpoint" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableAuthorizationEndpoint(s *string) *IdentityProviderUpdate {
+	if s != nil {
+		ipu.SetAuthorizationEndpoint(*s)
+	}
+	return ipu
+}
+
+// ClearAuthorizationEndpoint clears the value of the "authorization_endpoint" field.
+func (ipu *IdentityProviderUpdate) ClearAuthorizationEndpoint() *IdentityProviderUpdate {
+	ipu.mutation.ClearAuthorizationEndpoint()
+	return ipu
+}
+
+// SetTokenEndpoint sets the "token_endpoint" field.
+func (ipu *IdentityProviderUpdate) SetTokenEndpoint(s string) *IdentityProviderUpdate {
+	ipu.mutation.SetTokenEndpoint(s)
+	return ipu
+}
+
+// SetNillableTokenEndpoint sets the "token_endpoint" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableTokenEndpoint(s *string) *IdentityProviderUpdate {
+	if s != nil {
+		ipu.SetTokenEndpoint(*s)
+	}
+	return ipu
+}
+
+// ClearTokenEndpoint clears the value of the "token_endpoint" field.
+func (ipu *IdentityProviderUpdate) ClearTokenEndpoint() *IdentityProviderUpdate {
+	ipu.mutation.ClearTokenEndpoint()
+	return ipu
+}
+
+// SetUserinfoEndpoint sets the "userinfo_endpoint" field.
+func (ipu *IdentityProviderUpdate) SetUserinfoEndpoint(s string) *IdentityProviderUpdate {
+	ipu.mutation.SetUserinfoEndpoint(s)
+	return ipu
+}
+
+// SetNillableUserinfoEndpoint sets the "userinfo_endpoint" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableUserinfoEndpoint(s *string) *IdentityProviderUpdate {
+	if s != nil {
+		ipu.SetUserinfoEndpoint(*s)
+	}
+	return ipu
+}
+
+// ClearUserinfoEndpoint clears the value of the "userinfo_endpoint" field.
+func (ipu *IdentityProviderUpdate) ClearUserinfoEndpoint() *IdentityProviderUpdate {
+	ipu.mutation.ClearUserinfoEndpoint()
+	return ipu
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (ipu *IdentityProviderUpdate) SetJwksURI(s string) *IdentityProviderUpdate {
+	ipu.mutation.SetJwksURI(s)
+	return ipu
+}
+
+// SetNillableJwksURI sets the "jwks_uri" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableJwksURI(s *string) *IdentityProviderUpdate {
+	if s != nil {
+		ipu.SetJwksURI(*s)
+	}
+	return ipu
+}
+
+// ClearJwksURI clears the value of the "jwks_uri" field.
+func (ipu *IdentityProviderUpdate) ClearJwksURI() *IdentityProviderUpdate {
+	ipu.mutation.ClearJwksURI()
+	return ipu
+}
+
+// SetClaimMapping sets the "claim_mapping" field.
+func (ipu *IdentityProviderUpdate) SetClaimMapping(m map[string]string) *IdentityProviderUpdate {
+	ipu.mutation.SetClaimMapping(m)
+	return ipu
+}
+
+// ClearClaimMapping clears the value of the "claim_mapping" field.
+func (ipu *IdentityProviderUpdate) ClearClaimMapping() *IdentityProviderUpdate {
+	ipu.mutation.ClearClaimMapping()
+	return ipu
+}
+
+// SetEnabled sets the "enabled" field.
+func (ipu *IdentityProviderUpdate) SetEnabled(b bool) *IdentityProviderUpdate {
+	ipu.mutation.SetEnabled(b)
+	return ipu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ipu *IdentityProviderUpdate) SetNillableEnabled(b *bool) *IdentityProviderUpdate {
+	if b != nil {
+		ipu.SetEnabled(*b)
+	}
+	return ipu
+}
+
+// Mutation returns the IdentityProviderMutation object of the builder.
+func (ipu *IdentityProviderUpdate) Mutation() *IdentityProviderMutation {
+	return ipu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ipu *IdentityProviderUpdate) Save(ctx context.Context) (int, error) {
+	ipu.defaults()
+	return withHooks(ctx, ipu.sqlSave, ipu.mutation, ipu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipu *IdentityProviderUpdate) SaveX(ctx context.Context) int {
+	affected, err := ipu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ipu *IdentityProviderUpdate) Exec(ctx context.Context) error {
+	_, err := ipu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipu *IdentityProviderUpdate) ExecX(ctx context.Context) {
+	if err := ipu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ipu *IdentityProviderUpdate) defaults() {
+	if _, ok := ipu.mutation.UpdatedAt(); !ok {
+		v := identityprovider.UpdateDefaultUpdatedAt()
+		ipu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ipu *IdentityProviderUpdate) check() error {
+	if v, ok := ipu.mutation.Issuer(); ok {
+		if err := identityprovider.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "IdentityProvider.issuer": %w`, err)}
+		}
+	}
+	if v, ok := ipu.mutation.ClientID(); ok {
+		if err := identityprovider.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "IdentityProvider.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ipu *IdentityProviderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ipu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identityprovider.Table, identityprovider.Columns, sqlgraph.NewFieldSpec(identityprovider.FieldID, field.TypeUUID))
+	if ps := ipu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ipu.mutation.UpdatedAt(); ok {
+		_spec.SetField(identityprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ipu.mutation.ApplicationID(); ok {
+		_spec.SetField(identityprovider.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := ipu.mutation.DisplayName(); ok {
+		_spec.SetField(identityprovider.FieldDisplayName, field.TypeString, value)
+	}
+	if ipu.mutation.DisplayNameCleared() {
+		_spec.ClearField(identityprovider.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := ipu.mutation.Issuer(); ok {
+		_spec.SetField(identityprovider.FieldIssuer, field.TypeString, value)
+	}
+	if value, ok := ipu.mutation.ClientID(); ok {
+		_spec.SetField(identityprovider.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := ipu.mutation.ClientSecret(); ok {
+		_spec.SetField(identityprovider.FieldClientSecret, field.TypeString, value)
+	}
+	if ipu.mutation.ClientSecretCleared() {
+		_spec.ClearField(identityprovider.FieldClientSecret, field.TypeString)
+	}
+	if value, ok := ipu.mutation.Scopes(); ok {
+		_spec.SetField(identityprovider.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ipu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, identityprovider.FieldScopes, value)
+		})
+	}
+	if ipu.mutation.ScopesCleared() {
+		_spec.ClearField(identityprovider.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := ipu.mutation.AuthorizationEndpoint(); ok {
+		_spec.SetField(identityprovider.FieldAuthorizationEndpoint, field.TypeString, value)
+	}
+	if ipu.mutation.AuthorizationEndpointCleared() {
+		_spec.ClearField(identityprovider.FieldAuthorizationEndpoint, field.TypeString)
+	}
+	if value, ok := ipu.mutation.TokenEndpoint(); ok {
+		_spec.SetField(identityprovider.FieldTokenEndpoint, field.TypeString, value)
+	}
+	if ipu.mutation.TokenEndpointCleared() {
+		_spec.ClearField(identityprovider.FieldTokenEndpoint, field.TypeString)
+	}
+	if value, ok := ipu.mutation.UserinfoEndpoint(); ok {
+		_spec.SetField(identityprovider.FieldUserinfoEndpoint, field.TypeString, value)
+	}
+	if ipu.mutation.UserinfoEndpointCleared() {
+		_spec.ClearField(identityprovider.FieldUserinfoEndpoint, field.TypeString)
+	}
+	if value, ok := ipu.mutation.JwksURI(); ok {
+		_spec.SetField(identityprovider.FieldJwksURI, field.TypeString, value)
+	}
+	if ipu.mutation.JwksURICleared() {
+		_spec.ClearField(identityprovider.FieldJwksURI, field.TypeString)
+	}
+	if value, ok := ipu.mutation.ClaimMapping(); ok {
+		_spec.SetField(identityprovider.FieldClaimMapping, field.TypeJSON, value)
+	}
+	if ipu.mutation.ClaimMappingCleared() {
+		_spec.ClearField(identityprovider.FieldClaimMapping, field.TypeJSON)
+	}
+	if value, ok := ipu.mutation.Enabled(); ok {
+		_spec.SetField(identityprovider.FieldEnabled, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ipu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identityprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ipu.mutation.done = true
+	return n, nil
+}
+
+// IdentityProviderUpdateOne is the builder for updating a single IdentityProvider entity.
+type IdentityProviderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdentityProviderMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ipuo *IdentityProviderUpdateOne) SetUpdatedAt(t time.Time) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetUpdatedAt(t)
+	return ipuo
+}
+
+// SetApplicationID sets the "application_id" field.
+func (ipuo *IdentityProviderUpdateOne) SetApplicationID(u uuid.UUID) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetApplicationID(u)
+	return ipuo
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableApplicationID(u *uuid.UUID) *IdentityProviderUpdateOne {
+	if u != nil {
+		ipuo.SetApplicationID(*u)
+	}
+	return ipuo
+}
+
+// SetDisplayName sets the "display_name" field.
+func (ipuo *IdentityProviderUpdateOne) SetDisplayName(s string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetDisplayName(s)
+	return ipuo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableDisplayName(s *string) *IdentityProviderUpdateOne {
+	if s != nil {
+		ipuo.SetDisplayName(*s)
+	}
+	return ipuo
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (ipuo *IdentityProviderUpdateOne) ClearDisplayName() *IdentityProviderUpdateOne {
+	ipuo.mutation.ClearDisplayName()
+	return ipuo
+}
+
+// SetIssuer sets the "issuer" field.
+func (ipuo *IdentityProviderUpdateOne) SetIssuer(s string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetIssuer(s)
+	return ipuo
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableIssuer(s *string) *IdentityProviderUpdateOne {
+	if s != nil {
+		ipuo.SetIssuer(*s)
+	}
+	return ipuo
+}
+
+// SetClientID sets the "client_id" field.
+func (ipuo *IdentityProviderUpdateOne) SetClientID(s string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetClientID(s)
+	return ipuo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableClientID(s *string) *IdentityProviderUpdateOne {
+	if s != nil {
+		ipuo.SetClientID(*s)
+	}
+	return ipuo
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (ipuo *IdentityProviderUpdateOne) SetClientSecret(s string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetClientSecret(s)
+	return ipuo
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableClientSecret(s *string) *IdentityProviderUpdateOne {
+	if s != nil {
+		ipuo.SetClientSecret(*s)
+	}
+	return ipuo
+}
+
+// ClearClientSecret clears the value of the "client_secret" field.
+func (ipuo *IdentityProviderUpdateOne) ClearClientSecret() *IdentityProviderUpdateOne {
+	ipuo.mutation.ClearClientSecret()
+	return ipuo
+}
+
+// SetScopes sets the "scopes" field.
+func (ipuo *IdentityProviderUpdateOne) SetScopes(s []string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetScopes(s)
+	return ipuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ipuo *IdentityProviderUpdateOne) AppendScopes(s []string) *IdentityProviderUpdateOne {
+	ipuo.mutation.AppendScopes(s)
+	return ipuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (ipuo *IdentityProviderUpdateOne) ClearScopes() *IdentityProviderUpdateOne {
+	ipuo.mutation.ClearScopes()
+	return ipuo
+}
+
+// SetAuthorizationEndpoint sets the "authorization_endpoint" field.
+func (ipuo *IdentityProviderUpdateOne) SetAuthorizationEndpoint(s string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetAuthorizationEndpoint(s)
+	return ipuo
+}
+
+// SetNillableAuthorizationEndpoint sets the "authorization_endpoint" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableAuthorizationEndpoint(s *string) *IdentityProviderUpdateOne {
+	if s != nil {
+		ipuo.SetAuthorizationEndpoint(*s)
+	}
+	return ipuo
+}
+
+// ClearAuthorizationEndpoint clears the value of the "authorization_endpoint" field.
+func (ipuo *IdentityProviderUpdateOne) ClearAuthorizationEndpoint() *IdentityProviderUpdateOne {
+	ipuo.mutation.ClearAuthorizationEndpoint()
+	return ipuo
+}
+
+// SetTokenEndpoint sets the "token_endpoint" field.
+func (ipuo *IdentityProviderUpdateOne) SetTokenEndpoint(s string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetTokenEndpoint(s)
+	return ipuo
+}
+
+// SetNillableTokenEndpoint sets the "token_endpoint" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableTokenEndpoint(s *string) *IdentityProviderUpdateOne {
+	if s != nil {
+		ipuo.SetTokenEndpoint(*s)
+	}
+	return ipuo
+}
+
+// ClearTokenEndpoint clears the value of the "token_endpoint" field.
+func (ipuo *IdentityProviderUpdateOne) ClearTokenEndpoint() *IdentityProviderUpdateOne {
+	ipuo.mutation.ClearTokenEndpoint()
+	return ipuo
+}
+
+// SetUserinfoEndpoint sets the "userinfo_endpoint" field.
+func (ipuo *IdentityProviderUpdateOne) SetUserinfoEndpoint(s string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetUserinfoEndpoint(s)
+	return ipuo
+}
+
+// SetNillableUserinfoEndpoint sets the "userinfo_endpoint" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableUserinfoEndpoint(s *string) *IdentityProviderUpdateOne {
+	if s != nil {
+		ipuo.SetUserinfoEndpoint(*s)
+	}
+	return ipuo
+}
+
+// ClearUserinfoEndpoint clears the value of the "userinfo_endpoint" field.
+func (ipuo *IdentityProviderUpdateOne) ClearUserinfoEndpoint() *IdentityProviderUpdateOne {
+	ipuo.mutation.ClearUserinfoEndpoint()
+	return ipuo
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (ipuo *IdentityProviderUpdateOne) SetJwksURI(s string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetJwksURI(s)
+	return ipuo
+}
+
+// SetNillableJwksURI sets the "jwks_uri" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableJwksURI(s *string) *IdentityProviderUpdateOne {
+	if s != nil {
+		ipuo.SetJwksURI(*s)
+	}
+	return ipuo
+}
+
+// ClearJwksURI clears the value of the "jwks_uri" field.
+func (ipuo *IdentityProviderUpdateOne) ClearJwksURI() *IdentityProviderUpdateOne {
+	ipuo.mutation.ClearJwksURI()
+	return ipuo
+}
+
+// SetClaimMapping sets the "claim_mapping" field.
+func (ipuo *IdentityProviderUpdateOne) SetClaimMapping(m map[string]string) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetClaimMapping(m)
+	return ipuo
+}
+
+// ClearClaimMapping clears the value of the "claim_mapping" field.
+func (ipuo *IdentityProviderUpdateOne) ClearClaimMapping() *IdentityProviderUpdateOne {
+	ipuo.mutation.ClearClaimMapping()
+	return ipuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (ipuo *IdentityProviderUpdateOne) SetEnabled(b bool) *IdentityProviderUpdateOne {
+	ipuo.mutation.SetEnabled(b)
+	return ipuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ipuo *IdentityProviderUpdateOne) SetNillableEnabled(b *bool) *IdentityProviderUpdateOne {
+	if b != nil {
+		ipuo.SetEnabled(*b)
+	}
+	return ipuo
+}
+
+// Mutation returns the IdentityProviderMutation object of the builder.
+func (ipuo *IdentityProviderUpdateOne) Mutation() *IdentityProviderMutation {
+	return ipuo.mutation
+}
+
+// Where appends a list predicates to the IdentityProviderUpdate builder.
+func (ipuo *IdentityProviderUpdateOne) Where(ps ...predicate.IdentityProvider) *IdentityProviderUpdateOne {
+	ipuo.mutation.Where(ps...)
+	return ipuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ipuo *IdentityProviderUpdateOne) Select(field string, fields ...string) *IdentityProviderUpdateOne {
+	ipuo.fields = append([]string{field}, fields...)
+	return ipuo
+}
+
+// Save executes the query and returns the updated IdentityProvider entity.
+func (ipuo *IdentityProviderUpdateOne) Save(ctx context.Context) (*IdentityProvider, error) {
+	ipuo.defaults()
+	return withHooks(ctx, ipuo.sqlSave, ipuo.mutation, ipuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipuo *IdentityProviderUpdateOne) SaveX(ctx context.Context) *IdentityProvider {
+	node, err := ipuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ipuo *IdentityProviderUpdateOne) Exec(ctx context.Context) error {
+	_, err := ipuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipuo *IdentityProviderUpdateOne) ExecX(ctx context.Context) {
+	if err := ipuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ipuo *IdentityProviderUpdateOne) defaults() {
+	if _, ok := ipuo.mutation.UpdatedAt(); !ok {
+		v := identityprovider.UpdateDefaultUpdatedAt()
+		ipuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ipuo *IdentityProviderUpdateOne) check() error {
+	if v, ok := ipuo.mutation.Issuer(); ok {
+		if err := identityprovider.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "IdentityProvider.issuer": %w`, err)}
+		}
+	}
+	if v, ok := ipuo.mutation.ClientID(); ok {
+		if err := identityprovider.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "IdentityProvider.client_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ipuo *IdentityProviderUpdateOne) sqlSave(ctx context.Context) (_node *IdentityProvider, err error) {
+	if err := ipuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identityprovider.Table, identityprovider.Columns, sqlgraph.NewFieldSpec(identityprovider.FieldID, field.TypeUUID))
+	id, ok := ipuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdentityProvider.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ipuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, identityprovider.FieldID)
+		for _, f := range fields {
+			if !identityprovider.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != identityprovider.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ipuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ipuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(identityprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ipuo.mutation.ApplicationID(); ok {
+		_spec.SetField(identityprovider.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := ipuo.mutation.DisplayName(); ok {
+		_spec.SetField(identityprovider.FieldDisplayName, field.TypeString, value)
+	}
+	if ipuo.mutation.DisplayNameCleared() {
+		_spec.ClearField(identityprovider.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := ipuo.mutation.Issuer(); ok {
+		_spec.SetField(identityprovider.FieldIssuer, field.TypeString, value)
+	}
+	if value, ok := ipuo.mutation.ClientID(); ok {
+		_spec.SetField(identityprovider.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := ipuo.mutation.ClientSecret(); ok {
+		_spec.SetField(identityprovider.FieldClientSecret, field.TypeString, value)
+	}
+	if ipuo.mutation.ClientSecretCleared() {
+		_spec.ClearField(identityprovider.FieldClientSecret, field.TypeString)
+	}
+	if value, ok := ipuo.mutation.Scopes(); ok {
+		_spec.SetField(identityprovider.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ipuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, identityprovider.FieldScopes, value)
+		})
+	}
+	if ipuo.mutation.ScopesCleared() {
+		_spec.ClearField(identityprovider.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := ipuo.mutation.AuthorizationEndpoint(); ok {
+		_spec.SetField(identityprovider.FieldAuthorizationEndpoint, field.TypeString, value)
+	}
+	if ipuo.mutation.AuthorizationEndpointCleared() {
+		_spec.ClearField(identityprovider.FieldAuthorizationEndpoint, field.TypeString)
+	}
+	if value, ok := ipuo.mutation.TokenEndpoint(); ok {
+		_spec.SetField(identityprovider.FieldTokenEndpoint, field.TypeString, value)
+	}
+	if ipuo.mutation.TokenEndpointCleared() {
+		_spec.ClearField(identityprovider.FieldTokenEndpoint, field.TypeString)
+	}
+	if value, ok := ipuo.mutation.UserinfoEndpoint(); ok {
+		_spec.SetField(identityprovider.FieldUserinfoEndpoint, field.TypeString, value)
+	}
+	if ipuo.mutation.UserinfoEndpointCleared() {
+		_spec.ClearField(identityprovider.FieldUserinfoEndpoint, field.TypeString)
+	}
+	if value, ok := ipuo.mutation.JwksURI(); ok {
+		_spec.SetField(identityprovider.FieldJwksURI, field.TypeString, value)
+	}
+	if ipuo.mutation.JwksURICleared() {
+		_spec.ClearField(identityprovider.FieldJwksURI, field.TypeString)
+	}
+	if value, ok := ipuo.mutation.ClaimMapping(); ok {
+		_spec.SetField(identityprovider.FieldClaimMapping, field.TypeJSON, value)
+	}
+	if ipuo.mutation.ClaimMappingCleared() {
+		_spec.ClearField(identityprovider.FieldClaimMapping, field.TypeJSON)
+	}
+	if value, ok := ipuo.mutation.Enabled(); ok {
+		_spec.SetField(identityprovider.FieldEnabled, field.TypeBool, value)
+	}
+	_node = &IdentityProvider{config: ipuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ipuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identityprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ipuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/loginlock"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// LoginLock is the model entity for the LoginLock schema.
+type LoginLock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ApplicationName holds the value of the "application_name" field.
+	ApplicationName string `json:"application_name,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope loginlock.Scope `json:"scope,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// Failures holds the value of the "failures" field.
+	Failures int `json:"failures,omitempty"`
+	// LastFailureAt holds the value of the "last_failure_at" field.
+	LastFailureAt time.Time `json:"last_failure_at,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil  time.Time `json:"locked_until,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginLock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginlock.FieldFailures:
+			values[i] = new(sql.NullInt64)
+		case loginlock.FieldApplicationName, loginlock.FieldScope, loginlock.FieldSubject:
+			values[i] = new(sql.NullString)
+		case loginlock.FieldCreatedAt, loginlock.FieldUpdatedAt, loginlock.FieldLastFailureAt, loginlock.FieldNextAttemptAt, loginlock.FieldLockedUntil:
+			values[i] = new(sql.NullTime)
+		case loginlock.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginLock fields.
+func (ll *LoginLock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginlock.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ll.ID = *value
+			}
+		case loginlock.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ll.CreatedAt = value.Time
+			}
+		case loginlock.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ll.UpdatedAt = value.Time
+			}
+		case loginlock.FieldApplicationName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_name", values[i])
+			} else if value.Valid {
+				ll.ApplicationName = value.String
+			}
+		case loginlock.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				ll.Scope = loginlock.Scope(value.String)
+			}
+		case loginlock.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				ll.Subject = value.String
+			}
+		case loginlock.FieldFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failures", values[i])
+			} else if value.Valid {
+				ll.Failures = int(value.Int64)
+			}
+		case loginlock.FieldLastFailureAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failure_at", values[i])
+			} else if value.Valid {
+				ll.LastFailureAt = value.Time
+			}
+		case loginlock.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				ll.NextAttemptAt = value.Time
+			}
+		case loginlock.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				ll.LockedUntil = value.Time
+			}
+		default:
+			ll.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginLock.
+// This includes values selected through modifiers, order, etc.
+func (ll *LoginLock) Value(name string) (ent.Value, error) {
+	return ll.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LoginLock.
+// Note that you need to call LoginLock.Unwrap() before calling this method if this LoginLock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ll *LoginLock) Update() *LoginLockUpdateOne {
+	return NewLoginLockClient(ll.config).UpdateOne(ll)
+}
+
+// Unwrap unwraps the LoginLock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ll *LoginLock) Unwrap() *LoginLock {
+	_tx, ok := ll.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginLock is not a transactional entity")
+	}
+	ll.config.driver = _tx.drv
+	return ll
+}
+
+// String implements the fmt.Stringer.
+func (ll *LoginLock) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginLock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ll.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ll.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ll.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("application_name=")
+	builder.WriteString(ll.ApplicationName)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(fmt.Sprintf("%v", ll.Scope))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(ll.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("failures=")
+	builder.WriteString(fmt.Sprintf("%v", ll.Failures))
+	builder.WriteString(", ")
+	builder.WriteString("last_failure_at=")
+	builder.WriteString(ll.LastFailureAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(ll.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("locked_until=")
+	builder.WriteString(ll.LockedUntil.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginLocks is a parsable slice of LoginLock.
+type LoginLocks []*LoginLock
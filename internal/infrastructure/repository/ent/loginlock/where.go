@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loginlock
+
+import (
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ApplicationName applies equality check predicate on the "application_name" field. It's identical to ApplicationNameEQ.
+func ApplicationName(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldApplicationName, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldSubject, v))
+}
+
+// Failures applies equality check predicate on the "failures" field. It's identical to FailuresEQ.
+func Failures(v int) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldFailures, v))
+}
+
+// LastFailureAt applies equality check predicate on the "last_failure_at" field. It's identical to LastFailureAtEQ.
+func LastFailureAt(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldLastFailureAt, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ApplicationNameEQ applies the EQ predicate on the "application_name" field.
+func ApplicationNameEQ(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldApplicationName, v))
+}
+
+// ApplicationNameNEQ applies the NEQ predicate on the "application_name" field.
+func ApplicationNameNEQ(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldApplicationName, v))
+}
+
+// ApplicationNameIn applies the In predicate on the "application_name" field.
+func ApplicationNameIn(vs ...string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldApplicationName, vs...))
+}
+
+// ApplicationNameNotIn applies the NotIn predicate on the "application_name" field.
+func ApplicationNameNotIn(vs ...string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldApplicationName, vs...))
+}
+
+// ApplicationNameGT applies the GT predicate on the "application_name" field.
+func ApplicationNameGT(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGT(FieldApplicationName, v))
+}
+
+// ApplicationNameGTE applies the GTE predicate on the "application_name" field.
+func ApplicationNameGTE(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGTE(FieldApplicationName, v))
+}
+
+// ApplicationNameLT applies the LT predicate on the "application_name" field.
+func ApplicationNameLT(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLT(FieldApplicationName, v))
+}
+
+// ApplicationNameLTE applies the LTE predicate on the "application_name" field.
+func ApplicationNameLTE(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLTE(FieldApplicationName, v))
+}
+
+// ApplicationNameContains applies the Contains predicate on the "application_name" field.
+func ApplicationNameContains(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldContains(FieldApplicationName, v))
+}
+
+// ApplicationNameHasPrefix applies the HasPrefix predicate on the "application_name" field.
+func ApplicationNameHasPrefix(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldHasPrefix(FieldApplicationName, v))
+}
+
+// ApplicationNameHasSuffix applies the HasSuffix predicate on the "application_name" field.
+func ApplicationNameHasSuffix(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldHasSuffix(FieldApplicationName, v))
+}
+
+// ApplicationNameEqualFold applies the EqualFold predicate on the "application_name" field.
+func ApplicationNameEqualFold(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEqualFold(FieldApplicationName, v))
+}
+
+// ApplicationNameContainsFold applies the ContainsFold predicate on the "application_name" field.
+func ApplicationNameContainsFold(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldContainsFold(FieldApplicationName, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v Scope) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v Scope) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...Scope) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...Scope) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// FailuresEQ applies the EQ predicate on the "failures" field.
+func FailuresEQ(v int) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldFailures, v))
+}
+
+// FailuresNEQ applies the NEQ predicate on the "failures" field.
+func FailuresNEQ(v int) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldFailures, v))
+}
+
+// FailuresIn applies the In predicate on the "failures" field.
+func FailuresIn(vs ...int) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldFailures, vs...))
+}
+
+// FailuresNotIn applies the NotIn predicate on the "failures" field.
+func FailuresNotIn(vs ...int) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldFailures, vs...))
+}
+
+// FailuresGT applies the GT predicate on the "failures" field.
+func FailuresGT(v int) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGT(FieldFailures, v))
+}
+
+// FailuresGTE applies the GTE predicate on the "failures" field.
+func FailuresGTE(v int) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGTE(FieldFailures, v))
+}
+
+// FailuresLT applies the LT predicate on the "failures" field.
+func FailuresLT(v int) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLT(FieldFailures, v))
+}
+
+// FailuresLTE applies the LTE predicate on the "failures" field.
+func FailuresLTE(v int) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLTE(FieldFailures, v))
+}
+
+// LastFailureAtEQ applies the EQ predicate on the "last_failure_at" field.
+func LastFailureAtEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldLastFailureAt, v))
+}
+
+// LastFailureAtNEQ applies the NEQ predicate on the "last_failure_at" field.
+func LastFailureAtNEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldLastFailureAt, v))
+}
+
+// LastFailureAtIn applies the In predicate on the "last_failure_at" field.
+func LastFailureAtIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldLastFailureAt, vs...))
+}
+
+// LastFailureAtNotIn applies the NotIn predicate on the "last_failure_at" field.
+func LastFailureAtNotIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldLastFailureAt, vs...))
+}
+
+// LastFailureAtGT applies the GT predicate on the "last_failure_at" field.
+func LastFailureAtGT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGT(FieldLastFailureAt, v))
+}
+
+// LastFailureAtGTE applies the GTE predicate on the "last_failure_at" field.
+func LastFailureAtGTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGTE(FieldLastFailureAt, v))
+}
+
+// LastFailureAtLT applies the LT predicate on the "last_failure_at" field.
+func LastFailureAtLT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLT(FieldLastFailureAt, v))
+}
+
+// LastFailureAtLTE applies the LTE predicate on the "last_failure_at" field.
+func LastFailureAtLTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLTE(FieldLastFailureAt, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.LoginLock {
+	return predicate.LoginLock(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoginLock) predicate.LoginLock {
+	return predicate.LoginLock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoginLock) predicate.LoginLock {
+	return predicate.LoginLock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoginLock) predicate.LoginLock {
+	return predicate.LoginLock(sql.NotPredicates(p))
+}
@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/loginlock"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoginLockCreate is the builder for creating a LoginLock entity.
+type LoginLockCreate struct {
+	config
+	mutation *LoginLockMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (llc *LoginLockCreate) SetCreatedAt(t time.Time) *LoginLockCreate {
+	llc.mutation.SetCreatedAt(t)
+	return llc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (llc *LoginLockCreate) SetNillableCreatedAt(t *time.Time) *LoginLockCreate {
+	if t != nil {
+		llc.SetCreatedAt(*t)
+	}
+	return llc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (llc *LoginLockCreate) SetUpdatedAt(t time.Time) *LoginLockCreate {
+	llc.mutation.SetUpdatedAt(t)
+	return llc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (llc *LoginLockCreate) SetNillableUpdatedAt(t *time.Time) *LoginLockCreate {
+	if t != nil {
+		llc.SetUpdatedAt(*t)
+	}
+	return llc
+}
+
+// SetApplicationName sets the "application_name" field.
+func (llc *LoginLockCreate) SetApplicationName(s string) *LoginLockCreate {
+	llc.mutation.SetApplicationName(s)
+	return llc
+}
+
+// SetScope sets the "scope" field.
+func (llc *LoginLockCreate) SetScope(l loginlock.Scope) *LoginLockCreate {
+	llc.mutation.SetScope(l)
+	return llc
+}
+
+// SetSubject sets the "subject" field.
+func (llc *LoginLockCreate) SetSubject(s string) *LoginLockCreate {
+	llc.mutation.SetSubject(s)
+	return llc
+}
+
+// SetFailures sets the "failures" field.
+func (llc *LoginLockCreate) SetFailures(i int) *LoginLockCreate {
+	llc.mutation.SetFailures(i)
+	return llc
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (llc *LoginLockCreate) SetNillableFailures(i *int) *LoginLockCreate {
+	if i != nil {
+		llc.SetFailures(*i)
+	}
+	return llc
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (llc *LoginLockCreate) SetLastFailureAt(t time.Time) *LoginLockCreate {
+	llc.mutation.SetLastFailureAt(t)
+	return llc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (llc *LoginLockCreate) SetNextAttemptAt(t time.Time) *LoginLockCreate {
+	llc.mutation.SetNextAttemptAt(t)
+	return llc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (llc *LoginLockCreate) SetNillableNextAttemptAt(t *time.Time) *LoginLockCreate {
+	if t != nil {
+		llc.SetNextAttemptAt(*t)
+	}
+	return llc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (llc *LoginLockCreate) SetLockedUntil(t time.Time) *LoginLockCreate {
+	llc.mutation.SetLockedUntil(t)
+	return llc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (llc *LoginLockCreate) SetNillableLockedUntil(t *time.Time) *LoginLockCreate {
+	if t != nil {
+		llc.SetLockedUntil(*t)
+	}
+	return llc
+}
+
+// SetID sets the "id" field.
+func (llc *LoginLockCreate) SetID(u uuid.UUID) *LoginLockCreate {
+	llc.mutation.SetID(u)
+	return llc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (llc *LoginLockCreate) SetNillableID(u *uuid.UUID) *LoginLockCreate {
+	if u != nil {
+		llc.SetID(*u)
+	}
+	return llc
+}
+
+// Mutation returns the LoginLockMutation object of the builder.
+func (llc *LoginLockCreate) Mutation() *LoginLockMutation {
+	return llc.mutation
+}
+
+// Save creates the LoginLock in the database.
+func (llc *LoginLockCreate) Save(ctx context.Context) (*LoginLock, error) {
+	llc.defaults()
+	return withHooks(ctx, llc.sqlSave, llc.mutation, llc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (llc *LoginLockCreate) SaveX(ctx context.Context) *LoginLock {
+	v, err := llc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (llc *LoginLockCreate) Exec(ctx context.Context) error {
+	_, err := llc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (llc *LoginLockCreate) ExecX(ctx context.Context) {
+	if err := llc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (llc *LoginLockCreate) defaults() {
+	if _, ok := llc.mutation.CreatedAt(); !ok {
+		v := loginlock.DefaultCreatedAt()
+		llc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := llc.mutation.UpdatedAt(); !ok {
+		v := loginlock.DefaultUpdatedAt()
+		llc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := llc.mutation.Failures(); !ok {
+		v := loginlock.DefaultFailures
+		llc.mutation.SetFailures(v)
+	}
+	if _, ok := llc.mutation.ID(); !ok {
+		v := loginlock.DefaultID()
+		llc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (llc *LoginLockCreate) check() error {
+	if _, ok := llc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginLock.created_at"`)}
+	}
+	if _, ok := llc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LoginLock.updated_at"`)}
+	}
+	if _, ok := llc.mutation.ApplicationName(); !ok {
+		return &ValidationError{Name: "application_name", err: errors.New(`ent: missing required field "LoginLock.application_name"`)}
+	}
+	if v, ok := llc.mutation.ApplicationName(); ok {
+		if err := loginlock.ApplicationNameValidator(v); err != nil {
+			return &ValidationError{Name: "application_name", err: fmt.Errorf(`ent: validator failed for field "LoginLock.application_name": %w`, err)}
+		}
+	}
+	if _, ok := llc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "LoginLock.scope"`)}
+	}
+	if v, ok := llc.mutation.Scope(); ok {
+		if err := loginlock.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "LoginLock.scope": %w`, err)}
+		}
+	}
+	if _, ok := llc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "LoginLock.subject"`)}
+	}
+	if v, ok := llc.mutation.Subject(); ok {
+		if err := loginlock.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "LoginLock.subject": %w`, err)}
+		}
+	}
+	if _, ok := llc.mutation.Failures(); !ok {
+		return &ValidationError{Name: "failures", err: errors.New(`ent: missing required field "LoginLock.failures"`)}
+	}
+	if _, ok := llc.mutation.LastFailureAt(); !ok {
+		return &ValidationError{Name: "last_failure_at", err: errors.New(`ent: missing required field "LoginLock.last_failure_at"`)}
+	}
+	return nil
+}
+
+func (llc *LoginLockCreate) sqlSave(ctx context.Context) (*LoginLock, error) {
+	if err := llc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := llc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, llc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	llc.mutation.id = &_node.ID
+	llc.mutation.done = true
+	return _node, nil
+}
+
+func (llc *LoginLockCreate) createSpec() (*LoginLock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginLock{config: llc.config}
+		_spec = sqlgraph.NewCreateSpec(loginlock.Table, sqlgraph.NewFieldSpec(loginlock.FieldID, field.TypeUUID))
+	)
+	if id, ok := llc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := llc.mutation.CreatedAt(); ok {
+		_spec.SetField(loginlock.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := llc.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginlock.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := llc.mutation.ApplicationName(); ok {
+		_spec.SetField(loginlock.FieldApplicationName, field.TypeString, value)
+		_node.ApplicationName = value
+	}
+	if value, ok := llc.mutation.Scope(); ok {
+		_spec.SetField(loginlock.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := llc.mutation.Subject(); ok {
+		_spec.SetField(loginlock.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := llc.mutation.Failures(); ok {
+		_spec.SetField(loginlock.FieldFailures, field.TypeInt, value)
+		_node.Failures = value
+	}
+	if value, ok := llc.mutation.LastFailureAt(); ok {
+		_spec.SetField(loginlock.FieldLastFailureAt, field.TypeTime, value)
+		_node.LastFailureAt = value
+	}
+	if value, ok := llc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(loginlock.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := llc.mutation.LockedUntil(); ok {
+		_spec.SetField(loginlock.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = value
+	}
+	return _node, _spec
+}
+
+// LoginLockCreateBulk is the builder for creating many LoginLock entities in bulk.
+type LoginLockCreateBulk struct {
+	config
+	err      error
+	builders []*LoginLockCreate
+}
+
+// Save creates the LoginLock entities in the database.
+func (llcb *LoginLockCreateBulk) Save(ctx context.Context) ([]*LoginLock, error) {
+	if llcb.err != nil {
+		return nil, llcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(llcb.builders))
+	nodes := make([]*LoginLock, len(llcb.builders))
+	mutators := make([]Mutator, len(llcb.builders))
+	for i := range llcb.builders {
+		func(i int, root context.Context) {
+			builder := llcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginLockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, llcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, llcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, llcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (llcb *LoginLockCreateBulk) SaveX(ctx context.Context) []*LoginLock {
+	v, err := llcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (llcb *LoginLockCreateBulk) Exec(ctx context.Context) error {
+	_, err := llcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (llcb *LoginLockCreateBulk) ExecX(ctx context.Context) {
+	if err := llcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
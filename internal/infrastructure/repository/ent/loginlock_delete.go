@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kiwi-user/internal/infrastructure/repository/ent/loginlock"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginLockDelete is the builder for deleting a LoginLock entity.
+type LoginLockDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoginLockMutation
+}
+
+// Where appends a list predicates to the LoginLockDelete builder.
+func (lld *LoginLockDelete) Where(ps ...predicate.LoginLock) *LoginLockDelete {
+	lld.mutation.Where(ps...)
+	return lld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lld *LoginLockDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lld.sqlExec, lld.mutation, lld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lld *LoginLockDelete) ExecX(ctx context.Context) int {
+	n, err := lld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lld *LoginLockDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(loginlock.Table, sqlgraph.NewFieldSpec(loginlock.FieldID, field.TypeUUID))
+	if ps := lld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lld.mutation.done = true
+	return affected, err
+}
+
+// LoginLockDeleteOne is the builder for deleting a single LoginLock entity.
+type LoginLockDeleteOne struct {
+	lld *LoginLockDelete
+}
+
+// Where appends a list predicates to the LoginLockDelete builder.
+func (lldo *LoginLockDeleteOne) Where(ps ...predicate.LoginLock) *LoginLockDeleteOne {
+	lldo.lld.mutation.Where(ps...)
+	return lldo
+}
+
+// Exec executes the deletion query.
+func (lldo *LoginLockDeleteOne) Exec(ctx context.Context) error {
+	n, err := lldo.lld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loginlock.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lldo *LoginLockDeleteOne) ExecX(ctx context.Context) {
+	if err := lldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
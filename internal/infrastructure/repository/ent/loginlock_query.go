@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/loginlock"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoginLockQuery is the builder for querying LoginLock entities.
+type LoginLockQuery struct {
+	config
+	ctx        *QueryContext
+	order      []loginlock.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LoginLock
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoginLockQuery builder.
+func (llq *LoginLockQuery) Where(ps ...predicate.LoginLock) *LoginLockQuery {
+	llq.predicates = append(llq.predicates, ps...)
+	return llq
+}
+
+// Limit the number of records to be returned by this query.
+func (llq *LoginLockQuery) Limit(limit int) *LoginLockQuery {
+	llq.ctx.Limit = &limit
+	return llq
+}
+
+// Offset to start from.
+func (llq *LoginLockQuery) Offset(offset int) *LoginLockQuery {
+	llq.ctx.Offset = &offset
+	return llq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (llq *LoginLockQuery) Unique(unique bool) *LoginLockQuery {
+	llq.ctx.Unique = &unique
+	return llq
+}
+
+// Order specifies how the records should be ordered.
+func (llq *LoginLockQuery) Order(o ...loginlock.OrderOption) *LoginLockQuery {
+	llq.order = append(llq.order, o...)
+	return llq
+}
+
+// First returns the first LoginLock entity from the query.
+// Returns a *NotFoundError when no LoginLock was found.
+func (llq *LoginLockQuery) First(ctx context.Context) (*LoginLock, error) {
+	nodes, err := llq.Limit(1).All(setContextOp(ctx, llq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loginlock.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (llq *LoginLockQuery) FirstX(ctx context.Context) *LoginLock {
+	node, err := llq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoginLock ID from the query.
+// Returns a *NotFoundError when no LoginLock ID was found.
+func (llq *LoginLockQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = llq.Limit(1).IDs(setContextOp(ctx, llq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loginlock.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (llq *LoginLockQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := llq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoginLock entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoginLock entity is found.
+// Returns a *NotFoundError when no LoginLock entities are found.
+func (llq *LoginLockQuery) Only(ctx context.Context) (*LoginLock, error) {
+	nodes, err := llq.Limit(2).All(setContextOp(ctx, llq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loginlock.Label}
+	default:
+		return nil, &NotSingularError{loginlock.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (llq *LoginLockQuery) OnlyX(ctx context.Context) *LoginLock {
+	node, err := llq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoginLock ID in the query.
+// Returns a *NotSingularError when more than one LoginLock ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (llq *LoginLockQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = llq.Limit(2).IDs(setContextOp(ctx, llq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loginlock.Label}
+	default:
+		err = &NotSingularError{loginlock.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (llq *LoginLockQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := llq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoginLocks.
+func (llq *LoginLockQuery) All(ctx context.Context) ([]*LoginLock, error) {
+	ctx = setContextOp(ctx, llq.ctx, ent.OpQueryAll)
+	if err := llq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoginLock, *LoginLockQuery]()
+	return withInterceptors[[]*LoginLock](ctx, llq, qr, llq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (llq *LoginLockQuery) AllX(ctx context.Context) []*LoginLock {
+	nodes, err := llq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoginLock IDs.
+func (llq *LoginLockQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if llq.ctx.Unique == nil && llq.path != nil {
+		llq.Unique(true)
+	}
+	ctx = setContextOp(ctx, llq.ctx, ent.OpQueryIDs)
+	if err = llq.Select(loginlock.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (llq *LoginLockQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := llq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (llq *LoginLockQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, llq.ctx, ent.OpQueryCount)
+	if err := llq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, llq, querierCount[*LoginLockQuery](), llq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (llq *LoginLockQuery) CountX(ctx context.Context) int {
+	count, err := llq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (llq *LoginLockQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, llq.ctx, ent.OpQueryExist)
+	switch _, err := llq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (llq *LoginLockQuery) ExistX(ctx context.Context) bool {
+	exist, err := llq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoginLockQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (llq *LoginLockQuery) Clone() *LoginLockQuery {
+	if llq == nil {
+		return nil
+	}
+	return &LoginLockQuery{
+		config:     llq.config,
+		ctx:        llq.ctx.Clone(),
+		order:      append([]loginlock.OrderOption{}, llq.order...),
+		inters:     append([]Interceptor{}, llq.inters...),
+		predicates: append([]predicate.LoginLock{}, llq.predicates...),
+		// clone intermediate query.
+		sql:  llq.sql.Clone(),
+		path: llq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoginLock.Query().
+//		GroupBy(loginlock.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (llq *LoginLockQuery) GroupBy(field string, fields ...string) *LoginLockGroupBy {
+	llq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoginLockGroupBy{build: llq}
+	grbuild.flds = &llq.ctx.Fields
+	grbuild.label = loginlock.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.LoginLock.Query().
+//		Select(loginlock.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (llq *LoginLockQuery) Select(fields ...string) *LoginLockSelect {
+	llq.ctx.Fields = append(llq.ctx.Fields, fields...)
+	sbuild := &LoginLockSelect{LoginLockQuery: llq}
+	sbuild.label = loginlock.Label
+	sbuild.flds, sbuild.scan = &llq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoginLockSelect configured with the given aggregations.
+func (llq *LoginLockQuery) Aggregate(fns ...AggregateFunc) *LoginLockSelect {
+	return llq.Select().Aggregate(fns...)
+}
+
+func (llq *LoginLockQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range llq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, llq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range llq.ctx.Fields {
+		if !loginlock.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if llq.path != nil {
+		prev, err := llq.path(ctx)
+		if err != nil {
+			return err
+		}
+		llq.sql = prev
+	}
+	return nil
+}
+
+func (llq *LoginLockQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoginLock, error) {
+	var (
+		nodes = []*LoginLock{}
+		_spec = llq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoginLock).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoginLock{config: llq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(llq.modifiers) > 0 {
+		_spec.Modifiers = llq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, llq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (llq *LoginLockQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := llq.querySpec()
+	if len(llq.modifiers) > 0 {
+		_spec.Modifiers = llq.modifiers
+	}
+	_spec.Node.Columns = llq.ctx.Fields
+	if len(llq.ctx.Fields) > 0 {
+		_spec.Unique = llq.ctx.Unique != nil && *llq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, llq.driver, _spec)
+}
+
+func (llq *LoginLockQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(loginlock.Table, loginlock.Columns, sqlgraph.NewFieldSpec(loginlock.FieldID, field.TypeUUID))
+	_spec.From = llq.sql
+	if unique := llq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if llq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := llq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginlock.FieldID)
+		for i := range fields {
+			if fields[i] != loginlock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := llq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := llq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := llq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := llq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (llq *LoginLockQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(llq.driver.Dialect())
+	t1 := builder.Table(loginlock.Table)
+	columns := llq.ctx.Fields
+	if len(columns) == 0 {
+		columns = loginlock.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if llq.sql != nil {
+		selector = llq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if llq.ctx.Unique != nil && *llq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range llq.modifiers {
+		m(selector)
+	}
+	for _, p := range llq.predicates {
+		p(selector)
+	}
+	for _, p := range llq.order {
+		p(selector)
+	}
+	if offset := llq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := llq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (llq *LoginLockQuery) ForUpdate(opts ...sql.LockOption) *LoginLockQuery {
+	if llq.driver.Dialect() == dialect.Postgres {
+		llq.Unique(false)
+	}
+	llq.modifiers = append(llq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return llq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (llq *LoginLockQuery) ForShare(opts ...sql.LockOption) *LoginLockQuery {
+	if llq.driver.Dialect() == dialect.Postgres {
+		llq.Unique(false)
+	}
+	llq.modifiers = append(llq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return llq
+}
+
+// LoginLockGroupBy is the group-by builder for LoginLock entities.
+type LoginLockGroupBy struct {
+	selector
+	build *LoginLockQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (llgb *LoginLockGroupBy) Aggregate(fns ...AggregateFunc) *LoginLockGroupBy {
+	llgb.fns = append(llgb.fns, fns...)
+	return llgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (llgb *LoginLockGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, llgb.build.ctx, ent.OpQueryGroupBy)
+	if err := llgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginLockQuery, *LoginLockGroupBy](ctx, llgb.build, llgb, llgb.build.inters, v)
+}
+
+func (llgb *LoginLockGroupBy) sqlScan(ctx context.Context, root *LoginLockQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(llgb.fns))
+	for _, fn := range llgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*llgb.flds)+len(llgb.fns))
+		for _, f := range *llgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*llgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := llgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoginLockSelect is the builder for selecting fields of LoginLock entities.
+type LoginLockSelect struct {
+	*LoginLockQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lls *LoginLockSelect) Aggregate(fns ...AggregateFunc) *LoginLockSelect {
+	lls.fns = append(lls.fns, fns...)
+	return lls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lls *LoginLockSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lls.ctx, ent.OpQuerySelect)
+	if err := lls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginLockQuery, *LoginLockSelect](ctx, lls.LoginLockQuery, lls, lls.inters, v)
+}
+
+func (lls *LoginLockSelect) sqlScan(ctx context.Context, root *LoginLockQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lls.fns))
+	for _, fn := range lls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/loginlock"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginLockUpdate is the builder for updating LoginLock entities.
+type LoginLockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginLockMutation
+}
+
+// Where appends a list predicates to the LoginLockUpdate builder.
+func (llu *LoginLockUpdate) Where(ps ...predicate.LoginLock) *LoginLockUpdate {
+	llu.mutation.Where(ps...)
+	return llu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (llu *LoginLockUpdate) SetUpdatedAt(t time.Time) *LoginLockUpdate {
+	llu.mutation.SetUpdatedAt(t)
+	return llu
+}
+
+// SetApplicationName sets the "application_name" field.
+func (llu *LoginLockUpdate) SetApplicationName(s string) *LoginLockUpdate {
+	llu.mutation.SetApplicationName(s)
+	return llu
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (llu *LoginLockUpdate) SetNillableApplicationName(s *string) *LoginLockUpdate {
+	if s != nil {
+		llu.SetApplicationName(*s)
+	}
+	return llu
+}
+
+// SetScope sets the "scope" field.
+func (llu *LoginLockUpdate) SetScope(l loginlock.Scope) *LoginLockUpdate {
+	llu.mutation.SetScope(l)
+	return llu
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (llu *LoginLockUpdate) SetNillableScope(l *loginlock.Scope) *LoginLockUpdate {
+	if l != nil {
+		llu.SetScope(*l)
+	}
+	return llu
+}
+
+// SetSubject sets the "subject" field.
+func (llu *LoginLockUpdate) SetSubject(s string) *LoginLockUpdate {
+	llu.mutation.SetSubject(s)
+	return llu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (llu *LoginLockUpdate) SetNillableSubject(s *string) *LoginLockUpdate {
+	if s != nil {
+		llu.SetSubject(*s)
+	}
+	return llu
+}
+
+// SetFailures sets the "failures" field.
+func (llu *LoginLockUpdate) SetFailures(i int) *LoginLockUpdate {
+	llu.mutation.ResetFailures()
+	llu.mutation.SetFailures(i)
+	return llu
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (llu *LoginLockUpdate) SetNillableFailures(i *int) *LoginLockUpdate {
+	if i != nil {
+		llu.SetFailures(*i)
+	}
+	return llu
+}
+
+// AddFailures adds i to the "failures" field.
+func (llu *LoginLockUpdate) AddFailures(i int) *LoginLockUpdate {
+	llu.mutation.AddFailures(i)
+	return llu
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (llu *LoginLockUpdate) SetLastFailureAt(t time.Time) *LoginLockUpdate {
+	llu.mutation.SetLastFailureAt(t)
+	return llu
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (llu *LoginLockUpdate) SetNillableLastFailureAt(t *time.Time) *LoginLockUpdate {
+	if t != nil {
+		llu.SetLastFailureAt(*t)
+	}
+	return llu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (llu *LoginLockUpdate) SetNextAttemptAt(t time.Time) *LoginLockUpdate {
+	llu.mutation.SetNextAttemptAt(t)
+	return llu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (llu *LoginLockUpdate) SetNillableNextAttemptAt(t *time.Time) *LoginLockUpdate {
+	if t != nil {
+		llu.SetNextAttemptAt(*t)
+	}
+	return llu
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (llu *LoginLockUpdate) ClearNextAttemptAt() *LoginLockUpdate {
+	llu.mutation.ClearNextAttemptAt()
+	return llu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (llu *LoginLockUpdate) SetLockedUntil(t time.Time) *LoginLockUpdate {
+	llu.mutation.SetLockedUntil(t)
+	return llu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (llu *LoginLockUpdate) SetNillableLockedUntil(t *time.Time) *LoginLockUpdate {
+	if t != nil {
+		llu.SetLockedUntil(*t)
+	}
+	return llu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (llu *LoginLockUpdate) ClearLockedUntil() *LoginLockUpdate {
+	llu.mutation.ClearLockedUntil()
+	return llu
+}
+
+// Mutation returns the LoginLockMutation object of the builder.
+func (llu *LoginLockUpdate) Mutation() *LoginLockMutation {
+	return llu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (llu *LoginLockUpdate) Save(ctx context.Context) (int, error) {
+	llu.defaults()
+	return withHooks(ctx, llu.sqlSave, llu.mutation, llu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (llu *LoginLockUpdate) SaveX(ctx context.Context) int {
+	affected, err := llu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (llu *LoginLockUpdate) Exec(ctx context.Context) error {
+	_, err := llu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (llu *LoginLockUpdate) ExecX(ctx context.Context) {
+	if err := llu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (llu *LoginLockUpdate) defaults() {
+	if _, ok := llu.mutation.UpdatedAt(); !ok {
+		v := loginlock.UpdateDefaultUpdatedAt()
+		llu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (llu *LoginLockUpdate) check() error {
+	if v, ok := llu.mutation.ApplicationName(); ok {
+		if err := loginlock.ApplicationNameValidator(v); err != nil {
+			return &ValidationError{Name: "application_name", err: fmt.Errorf(`ent: validator failed for field "LoginLock.application_name": %w`, err)}
+		}
+	}
+	if v, ok := llu.mutation.Scope(); ok {
+		if err := loginlock.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "LoginLock.scope": %w`, err)}
+		}
+	}
+	if v, ok := llu.mutation.Subject(); ok {
+		if err := loginlock.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "LoginLock.subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (llu *LoginLockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := llu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginlock.Table, loginlock.Columns, sqlgraph.NewFieldSpec(loginlock.FieldID, field.TypeUUID))
+	if ps := llu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := llu.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginlock.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := llu.mutation.ApplicationName(); ok {
+		_spec.SetField(loginlock.FieldApplicationName, field.TypeString, value)
+	}
+	if value, ok := llu.mutation.Scope(); ok {
+		_spec.SetField(loginlock.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := llu.mutation.Subject(); ok {
+		_spec.SetField(loginlock.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := llu.mutation.Failures(); ok {
+		_spec.SetField(loginlock.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := llu.mutation.AddedFailures(); ok {
+		_spec.AddField(loginlock.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := llu.mutation.LastFailureAt(); ok {
+		_spec.SetField(loginlock.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if value, ok := llu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(loginlock.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if llu.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(loginlock.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := llu.mutation.LockedUntil(); ok {
+		_spec.SetField(loginlock.FieldLockedUntil, field.TypeTime, value)
+	}
+	if llu.mutation.LockedUntilCleared() {
+		_spec.ClearField(loginlock.FieldLockedUntil, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, llu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginlock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	llu.mutation.done = true
+	return n, nil
+}
+
+// LoginLockUpdateOne is the builder for updating a single LoginLock entity.
+type LoginLockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginLockMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lluo *LoginLockUpdateOne) SetUpdatedAt(t time.Time) *LoginLockUpdateOne {
+	lluo.mutation.SetUpdatedAt(t)
+	return lluo
+}
+
+// SetApplicationName sets the "application_name" field.
+func (lluo *LoginLockUpdateOne) SetApplicationName(s string) *LoginLockUpdateOne {
+	lluo.mutation.SetApplicationName(s)
+	return lluo
+}
+
+// SetNillableApplicationName sets the "application_name" field if the given value is not nil.
+func (lluo *LoginLockUpdateOne) SetNillableApplicationName(s *string) *LoginLockUpdateOne {
+	if s != nil {
+		lluo.SetApplicationName(*s)
+	}
+	return lluo
+}
+
+// SetScope sets the "scope" field.
+func (lluo *LoginLockUpdateOne) SetScope(l loginlock.Scope) *LoginLockUpdateOne {
+	lluo.mutation.SetScope(l)
+	return lluo
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (lluo *LoginLockUpdateOne) SetNillableScope(l *loginlock.Scope) *LoginLockUpdateOne {
+	if l != nil {
+		lluo.SetScope(*l)
+	}
+	return lluo
+}
+
+// SetSubject sets the "subject" field.
+func (lluo *LoginLockUpdateOne) SetSubject(s string) *LoginLockUpdateOne {
+	lluo.mutation.SetSubject(s)
+	return lluo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (lluo *LoginLockUpdateOne) SetNillableSubject(s *string) *LoginLockUpdateOne {
+	if s != nil {
+		lluo.SetSubject(*s)
+	}
+	return lluo
+}
+
+// SetFailures sets the "failures" field.
+func (lluo *LoginLockUpdateOne) SetFailures(i int) *LoginLockUpdateOne {
+	lluo.mutation.ResetFailures()
+	lluo.mutation.SetFailures(i)
+	return lluo
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (lluo *LoginLockUpdateOne) SetNillableFailures(i *int) *LoginLockUpdateOne {
+	if i != nil {
+		lluo.SetFailures(*i)
+	}
+	return lluo
+}
+
+// AddFailures adds i to the "failures" field.
+func (lluo *LoginLockUpdateOne) AddFailures(i int) *LoginLockUpdateOne {
+	lluo.mutation.AddFailures(i)
+	return lluo
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (lluo *LoginLockUpdateOne) SetLastFailureAt(t time.Time) *LoginLockUpdateOne {
+	lluo.mutation.SetLastFailureAt(t)
+	return lluo
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (lluo *LoginLockUpdateOne) SetNillableLastFailureAt(t *time.Time) *LoginLockUpdateOne {
+	if t != nil {
+		lluo.SetLastFailureAt(*t)
+	}
+	return lluo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (lluo *LoginLockUpdateOne) SetNextAttemptAt(t time.Time) *LoginLockUpdateOne {
+	lluo.mutation.SetNextAttemptAt(t)
+	return lluo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (lluo *LoginLockUpdateOne) SetNillableNextAttemptAt(t *time.Time) *LoginLockUpdateOne {
+	if t != nil {
+		lluo.SetNextAttemptAt(*t)
+	}
+	return lluo
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (lluo *LoginLockUpdateOne) ClearNextAttemptAt() *LoginLockUpdateOne {
+	lluo.mutation.ClearNextAttemptAt()
+	return lluo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (lluo *LoginLockUpdateOne) SetLockedUntil(t time.Time) *LoginLockUpdateOne {
+	lluo.mutation.SetLockedUntil(t)
+	return lluo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (lluo *LoginLockUpdateOne) SetNillableLockedUntil(t *time.Time) *LoginLockUpdateOne {
+	if t != nil {
+		lluo.SetLockedUntil(*t)
+	}
+	return lluo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (lluo *LoginLockUpdateOne) ClearLockedUntil() *LoginLockUpdateOne {
+	lluo.mutation.ClearLockedUntil()
+	return lluo
+}
+
+// Mutation returns the LoginLockMutation object of the builder.
+func (lluo *LoginLockUpdateOne) Mutation() *LoginLockMutation {
+	return lluo.mutation
+}
+
+// Where appends a list predicates to the LoginLockUpdate builder.
+func (lluo *LoginLockUpdateOne) Where(ps ...predicate.LoginLock) *LoginLockUpdateOne {
+	lluo.mutation.Where(ps...)
+	return lluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lluo *LoginLockUpdateOne) Select(field string, fields ...string) *LoginLockUpdateOne {
+	lluo.fields = append([]string{field}, fields...)
+	return lluo
+}
+
+// Save executes the query and returns the updated LoginLock entity.
+func (lluo *LoginLockUpdateOne) Save(ctx context.Context) (*LoginLock, error) {
+	lluo.defaults()
+	return withHooks(ctx, lluo.sqlSave, lluo.mutation, lluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lluo *LoginLockUpdateOne) SaveX(ctx context.Context) *LoginLock {
+	node, err := lluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lluo *LoginLockUpdateOne) Exec(ctx context.Context) error {
+	_, err := lluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lluo *LoginLockUpdateOne) ExecX(ctx context.Context) {
+	if err := lluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lluo *LoginLockUpdateOne) defaults() {
+	if _, ok := lluo.mutation.UpdatedAt(); !ok {
+		v := loginlock.UpdateDefaultUpdatedAt()
+		lluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lluo *LoginLockUpdateOne) check() error {
+	if v, ok := lluo.mutation.ApplicationName(); ok {
+		if err := loginlock.ApplicationNameValidator(v); err != nil {
+			return &ValidationError{Name: "application_name", err: fmt.Errorf(`ent: validator failed for field "LoginLock.application_name": %w`, err)}
+		}
+	}
+	if v, ok := lluo.mutation.Scope(); ok {
+		if err := loginlock.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "LoginLock.scope": %w`, err)}
+		}
+	}
+	if v, ok := lluo.mutation.Subject(); ok {
+		if err := loginlock.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "LoginLock.subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lluo *LoginLockUpdateOne) sqlSave(ctx context.Context) (_node *LoginLock, err error) {
+	if err := lluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginlock.Table, loginlock.Columns, sqlgraph.NewFieldSpec(loginlock.FieldID, field.TypeUUID))
+	id, ok := lluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginLock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginlock.FieldID)
+		for _, f := range fields {
+			if !loginlock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginlock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginlock.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := lluo.mutation.ApplicationName(); ok {
+		_spec.SetField(loginlock.FieldApplicationName, field.TypeString, value)
+	}
+	if value, ok := lluo.mutation.Scope(); ok {
+		_spec.SetField(loginlock.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := lluo.mutation.Subject(); ok {
+		_spec.SetField(loginlock.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := lluo.mutation.Failures(); ok {
+		_spec.SetField(loginlock.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := lluo.mutation.AddedFailures(); ok {
+		_spec.AddField(loginlock.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := lluo.mutation.LastFailureAt(); ok {
+		_spec.SetField(loginlock.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if value, ok := lluo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(loginlock.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if lluo.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(loginlock.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := lluo.mutation.LockedUntil(); ok {
+		_spec.SetField(loginlock.FieldLockedUntil, field.TypeTime, value)
+	}
+	if lluo.mutation.LockedUntilCleared() {
+		_spec.ClearField(loginlock.FieldLockedUntil, field.TypeTime)
+	}
+	_node = &LoginLock{config: lluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginlock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lluo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/magiclink"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// MagicLink is the model entity for the MagicLink schema.
+type MagicLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ApplicationID holds the value of the "application_id" field.
+	ApplicationID uuid.UUID `json:"application_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// DeviceType holds the value of the "device_type" field.
+	DeviceType string `json:"device_type,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID string `json:"device_id,omitempty"`
+	// PollTokenHash holds the value of the "poll_token_hash" field.
+	PollTokenHash string `json:"poll_token_hash,omitempty"`
+	// ClientIP holds the value of the "client_ip" field.
+	ClientIP string `json:"client_ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Confirmed holds the value of the "confirmed" field.
+	Confirmed bool `json:"confirmed,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MagicLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case magiclink.FieldConfirmed:
+			values[i] = new(sql.NullBool)
+		case magiclink.FieldEmail, magiclink.FieldDeviceType, magiclink.FieldDeviceID, magiclink.FieldPollTokenHash, magiclink.FieldClientIP, magiclink.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case magiclink.FieldCreatedAt, magiclink.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case magiclink.FieldID, magiclink.FieldApplicationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MagicLink fields.
+func (ml *MagicLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case magiclink.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ml.ID = *value
+			}
+		case magiclink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ml.CreatedAt = value.Time
+			}
+		case magiclink.FieldApplicationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field application_id", values[i])
+			} else if value != nil {
+				ml.ApplicationID = *value
+			}
+		case magiclink.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ml.Email = value.String
+			}
+		case magiclink.FieldDeviceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_type", values[i])
+			} else if value.Valid {
+				ml.DeviceType = value.String
+			}
+		case magiclink.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				ml.DeviceID = value.String
+			}
+		case magiclink.FieldPollTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_token_hash", values[i])
+			} else if value.Valid {
+				ml.PollTokenHash = value.String
+			}
+		case magiclink.FieldClientIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_ip", values[i])
+			} else if value.Valid {
+				ml.ClientIP = value.String
+			}
+		case magiclink.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				ml.UserAgent = value.String
+			}
+		case magiclink.FieldConfirmed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed", values[i])
+			} else if value.Valid {
+				ml.Confirmed = value.Bool
+			}
+		case magiclink.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ml.ExpiresAt = value.Time
+			}
+		default:
+			ml.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MagicLink.
+// This includes values selected through modifiers, order, etc.
+func (ml *MagicLink) Value(name string) (ent.Value, error) {
+	return ml.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MagicLink.
+// Note that you need to call MagicLink.Unwrap() before calling this method if this MagicLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ml *MagicLink) Update() *MagicLinkUpdateOne {
+	return NewMagicLinkClient(ml.config).UpdateOne(ml)
+}
+
+// Unwrap unwraps the MagicLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ml *MagicLink) Unwrap() *MagicLink {
+	_tx, ok := ml.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MagicLink is not a transactional entity")
+	}
+	ml.config.driver = _tx.drv
+	return ml
+}
+
+// String implements the fmt.Stringer.
+func (ml *MagicLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("MagicLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ml.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ml.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("application_id=")
+	builder.WriteString(fmt.Sprintf("%v", ml.ApplicationID))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(ml.Email)
+	builder.WriteString(", ")
+	builder.WriteString("device_type=")
+	builder.WriteString(ml.DeviceType)
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(ml.DeviceID)
+	builder.WriteString(", ")
+	builder.WriteString("poll_token_hash=")
+	builder.WriteString(ml.PollTokenHash)
+	builder.WriteString(", ")
+	builder.WriteString("client_ip=")
+	builder.WriteString(ml.ClientIP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(ml.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("confirmed=")
+	builder.WriteString(fmt.Sprintf("%v", ml.Confirmed))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ml.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MagicLinks is a parsable slice of MagicLink.
+type MagicLinks []*MagicLink
@@ -0,0 +1,661 @@
+// Code generated by ent, DO NOT EDIT.
+
+package magiclink
+
+import (
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ApplicationID applies equality check predicate on the "application_id" field. It's identical to ApplicationIDEQ.
+func ApplicationID(v uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldEmail, v))
+}
+
+// DeviceType applies equality check predicate on the "device_type" field. It's identical to DeviceTypeEQ.
+func DeviceType(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldDeviceType, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// PollTokenHash applies equality check predicate on the "poll_token_hash" field. It's identical to PollTokenHashEQ.
+func PollTokenHash(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldPollTokenHash, v))
+}
+
+// ClientIP applies equality check predicate on the "client_ip" field. It's identical to ClientIPEQ.
+func ClientIP(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldClientIP, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// Confirmed applies equality check predicate on the "confirmed" field. It's identical to ConfirmedEQ.
+func Confirmed(v bool) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldConfirmed, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ApplicationIDEQ applies the EQ predicate on the "application_id" field.
+func ApplicationIDEQ(v uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDNEQ applies the NEQ predicate on the "application_id" field.
+func ApplicationIDNEQ(v uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDIn applies the In predicate on the "application_id" field.
+func ApplicationIDIn(vs ...uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDNotIn applies the NotIn predicate on the "application_id" field.
+func ApplicationIDNotIn(vs ...uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDGT applies the GT predicate on the "application_id" field.
+func ApplicationIDGT(v uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldApplicationID, v))
+}
+
+// ApplicationIDGTE applies the GTE predicate on the "application_id" field.
+func ApplicationIDGTE(v uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldApplicationID, v))
+}
+
+// ApplicationIDLT applies the LT predicate on the "application_id" field.
+func ApplicationIDLT(v uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldApplicationID, v))
+}
+
+// ApplicationIDLTE applies the LTE predicate on the "application_id" field.
+func ApplicationIDLTE(v uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldApplicationID, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// DeviceTypeEQ applies the EQ predicate on the "device_type" field.
+func DeviceTypeEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldDeviceType, v))
+}
+
+// DeviceTypeNEQ applies the NEQ predicate on the "device_type" field.
+func DeviceTypeNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldDeviceType, v))
+}
+
+// DeviceTypeIn applies the In predicate on the "device_type" field.
+func DeviceTypeIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldDeviceType, vs...))
+}
+
+// DeviceTypeNotIn applies the NotIn predicate on the "device_type" field.
+func DeviceTypeNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldDeviceType, vs...))
+}
+
+// DeviceTypeGT applies the GT predicate on the "device_type" field.
+func DeviceTypeGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldDeviceType, v))
+}
+
+// DeviceTypeGTE applies the GTE predicate on the "device_type" field.
+func DeviceTypeGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldDeviceType, v))
+}
+
+// DeviceTypeLT applies the LT predicate on the "device_type" field.
+func DeviceTypeLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldDeviceType, v))
+}
+
+// DeviceTypeLTE applies the LTE predicate on the "device_type" field.
+func DeviceTypeLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldDeviceType, v))
+}
+
+// DeviceTypeContains applies the Contains predicate on the "device_type" field.
+func DeviceTypeContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldDeviceType, v))
+}
+
+// DeviceTypeHasPrefix applies the HasPrefix predicate on the "device_type" field.
+func DeviceTypeHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldDeviceType, v))
+}
+
+// DeviceTypeHasSuffix applies the HasSuffix predicate on the "device_type" field.
+func DeviceTypeHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldDeviceType, v))
+}
+
+// DeviceTypeEqualFold applies the EqualFold predicate on the "device_type" field.
+func DeviceTypeEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldDeviceType, v))
+}
+
+// DeviceTypeContainsFold applies the ContainsFold predicate on the "device_type" field.
+func DeviceTypeContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldDeviceType, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// PollTokenHashEQ applies the EQ predicate on the "poll_token_hash" field.
+func PollTokenHashEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldPollTokenHash, v))
+}
+
+// PollTokenHashNEQ applies the NEQ predicate on the "poll_token_hash" field.
+func PollTokenHashNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldPollTokenHash, v))
+}
+
+// PollTokenHashIn applies the In predicate on the "poll_token_hash" field.
+func PollTokenHashIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldPollTokenHash, vs...))
+}
+
+// PollTokenHashNotIn applies the NotIn predicate on the "poll_token_hash" field.
+func PollTokenHashNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldPollTokenHash, vs...))
+}
+
+// PollTokenHashGT applies the GT predicate on the "poll_token_hash" field.
+func PollTokenHashGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldPollTokenHash, v))
+}
+
+// PollTokenHashGTE applies the GTE predicate on the "poll_token_hash" field.
+func PollTokenHashGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldPollTokenHash, v))
+}
+
+// PollTokenHashLT applies the LT predicate on the "poll_token_hash" field.
+func PollTokenHashLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldPollTokenHash, v))
+}
+
+// PollTokenHashLTE applies the LTE predicate on the "poll_token_hash" field.
+func PollTokenHashLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldPollTokenHash, v))
+}
+
+// PollTokenHashContains applies the Contains predicate on the "poll_token_hash" field.
+func PollTokenHashContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldPollTokenHash, v))
+}
+
+// PollTokenHashHasPrefix applies the HasPrefix predicate on the "poll_token_hash" field.
+func PollTokenHashHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldPollTokenHash, v))
+}
+
+// PollTokenHashHasSuffix applies the HasSuffix predicate on the "poll_token_hash" field.
+func PollTokenHashHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldPollTokenHash, v))
+}
+
+// PollTokenHashEqualFold applies the EqualFold predicate on the "poll_token_hash" field.
+func PollTokenHashEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldPollTokenHash, v))
+}
+
+// PollTokenHashContainsFold applies the ContainsFold predicate on the "poll_token_hash" field.
+func PollTokenHashContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldPollTokenHash, v))
+}
+
+// ClientIPEQ applies the EQ predicate on the "client_ip" field.
+func ClientIPEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldClientIP, v))
+}
+
+// ClientIPNEQ applies the NEQ predicate on the "client_ip" field.
+func ClientIPNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldClientIP, v))
+}
+
+// ClientIPIn applies the In predicate on the "client_ip" field.
+func ClientIPIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldClientIP, vs...))
+}
+
+// ClientIPNotIn applies the NotIn predicate on the "client_ip" field.
+func ClientIPNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldClientIP, vs...))
+}
+
+// ClientIPGT applies the GT predicate on the "client_ip" field.
+func ClientIPGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldClientIP, v))
+}
+
+// ClientIPGTE applies the GTE predicate on the "client_ip" field.
+func ClientIPGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldClientIP, v))
+}
+
+// ClientIPLT applies the LT predicate on the "client_ip" field.
+func ClientIPLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldClientIP, v))
+}
+
+// ClientIPLTE applies the LTE predicate on the "client_ip" field.
+func ClientIPLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldClientIP, v))
+}
+
+// ClientIPContains applies the Contains predicate on the "client_ip" field.
+func ClientIPContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldClientIP, v))
+}
+
+// ClientIPHasPrefix applies the HasPrefix predicate on the "client_ip" field.
+func ClientIPHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldClientIP, v))
+}
+
+// ClientIPHasSuffix applies the HasSuffix predicate on the "client_ip" field.
+func ClientIPHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldClientIP, v))
+}
+
+// ClientIPIsNil applies the IsNil predicate on the "client_ip" field.
+func ClientIPIsNil() predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIsNull(FieldClientIP))
+}
+
+// ClientIPNotNil applies the NotNil predicate on the "client_ip" field.
+func ClientIPNotNil() predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotNull(FieldClientIP))
+}
+
+// ClientIPEqualFold applies the EqualFold predicate on the "client_ip" field.
+func ClientIPEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldClientIP, v))
+}
+
+// ClientIPContainsFold applies the ContainsFold predicate on the "client_ip" field.
+func ClientIPContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldClientIP, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// ConfirmedEQ applies the EQ predicate on the "confirmed" field.
+func ConfirmedEQ(v bool) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldConfirmed, v))
+}
+
+// ConfirmedNEQ applies the NEQ predicate on the "confirmed" field.
+func ConfirmedNEQ(v bool) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldConfirmed, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MagicLink) predicate.MagicLink {
+	return predicate.MagicLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MagicLink) predicate.MagicLink {
+	return predicate.MagicLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MagicLink) predicate.MagicLink {
+	return predicate.MagicLink(sql.NotPredicates(p))
+}
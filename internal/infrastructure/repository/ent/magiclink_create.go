@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/magiclink"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MagicLinkCreate is the builder for creating a MagicLink entity.
+type MagicLinkCreate struct {
+	config
+	mutation *MagicLinkMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mlc *MagicLinkCreate) SetCreatedAt(t time.Time) *MagicLinkCreate {
+	mlc.mutation.SetCreatedAt(t)
+	return mlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mlc *MagicLinkCreate) SetNillableCreatedAt(t *time.Time) *MagicLinkCreate {
+	if t != nil {
+		mlc.SetCreatedAt(*t)
+	}
+	return mlc
+}
+
+// SetApplicationID sets the "application_id" field.
+func (mlc *MagicLinkCreate) SetApplicationID(u uuid.UUID) *MagicLinkCreate {
+	mlc.mutation.SetApplicationID(u)
+	return mlc
+}
+
+// SetEmail sets the "email" field.
+func (mlc *MagicLinkCreate) SetEmail(s string) *MagicLinkCreate {
+	mlc.mutation.SetEmail(s)
+	return mlc
+}
+
+// SetDeviceType sets the "device_type" field.
+func (mlc *MagicLinkCreate) SetDeviceType(s string) *MagicLinkCreate {
+	mlc.mutation.SetDeviceType(s)
+	return mlc
+}
+
+// SetDeviceID sets the "device_id" field.
+func (mlc *MagicLinkCreate) SetDeviceID(s string) *MagicLinkCreate {
+	mlc.mutation.SetDeviceID(s)
+	return mlc
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (mlc *MagicLinkCreate) SetPollTokenHash(s string) *MagicLinkCreate {
+	mlc.mutation.SetPollTokenHash(s)
+	return mlc
+}
+
+// SetClientIP sets the "client_ip" field.
+func (mlc *MagicLinkCreate) SetClientIP(s string) *MagicLinkCreate {
+	mlc.mutation.SetClientIP(s)
+	return mlc
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (mlc *MagicLinkCreate) SetNillableClientIP(s *string) *MagicLinkCreate {
+	if s != nil {
+		mlc.SetClientIP(*s)
+	}
+	return mlc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (mlc *MagicLinkCreate) SetUserAgent(s string) *MagicLinkCreate {
+	mlc.mutation.SetUserAgent(s)
+	return mlc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (mlc *MagicLinkCreate) SetNillableUserAgent(s *string) *MagicLinkCreate {
+	if s != nil {
+		mlc.SetUserAgent(*s)
+	}
+	return mlc
+}
+
+// SetConfirmed sets the "confirmed" field.
+func (mlc *MagicLinkCreate) SetConfirmed(b bool) *MagicLinkCreate {
+	mlc.mutation.SetConfirmed(b)
+	return mlc
+}
+
+// SetNillableConfirmed sets the "confirmed" field if the given value is not nil.
+func (mlc *MagicLinkCreate) SetNillableConfirmed(b *bool) *MagicLinkCreate {
+	if b != nil {
+		mlc.SetConfirmed(*b)
+	}
+	return mlc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (mlc *MagicLinkCreate) SetExpiresAt(t time.Time) *MagicLinkCreate {
+	mlc.mutation.SetExpiresAt(t)
+	return mlc
+}
+
+// SetID sets the "id" field.
+func (mlc *MagicLinkCreate) SetID(u uuid.UUID) *MagicLinkCreate {
+	mlc.mutation.SetID(u)
+	return mlc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mlc *MagicLinkCreate) SetNillableID(u *uuid.UUID) *MagicLinkCreate {
+	if u != nil {
+		mlc.SetID(*u)
+	}
+	return mlc
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (mlc *MagicLinkCreate) Mutation() *MagicLinkMutation {
+	return mlc.mutation
+}
+
+// Save creates the MagicLink in the database.
+func (mlc *MagicLinkCreate) Save(ctx context.Context) (*MagicLink, error) {
+	mlc.defaults()
+	return withHooks(ctx, mlc.sqlSave, mlc.mutation, mlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mlc *MagicLinkCreate) SaveX(ctx context.Context) *MagicLink {
+	v, err := mlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mlc *MagicLinkCreate) Exec(ctx context.Context) error {
+	_, err := mlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mlc *MagicLinkCreate) ExecX(ctx context.Context) {
+	if err := mlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mlc *MagicLinkCreate) defaults() {
+	if _, ok := mlc.mutation.CreatedAt(); !ok {
+		v := magiclink.DefaultCreatedAt()
+		mlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mlc.mutation.Confirmed(); !ok {
+		v := magiclink.DefaultConfirmed
+		mlc.mutation.SetConfirmed(v)
+	}
+	if _, ok := mlc.mutation.ID(); !ok {
+		v := magiclink.DefaultID()
+		mlc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mlc *MagicLinkCreate) check() error {
+	if _, ok := mlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MagicLink.created_at"`)}
+	}
+	if _, ok := mlc.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application_id", err: errors.New(`ent: missing required field "MagicLink.application_id"`)}
+	}
+	if _, ok := mlc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "MagicLink.email"`)}
+	}
+	if v, ok := mlc.mutation.Email(); ok {
+		if err := magiclink.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "MagicLink.email": %w`, err)}
+		}
+	}
+	if _, ok := mlc.mutation.DeviceType(); !ok {
+		return &ValidationError{Name: "device_type", err: errors.New(`ent: missing required field "MagicLink.device_type"`)}
+	}
+	if v, ok := mlc.mutation.DeviceType(); ok {
+		if err := magiclink.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "MagicLink.device_type": %w`, err)}
+		}
+	}
+	if _, ok := mlc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "MagicLink.device_id"`)}
+	}
+	if v, ok := mlc.mutation.DeviceID(); ok {
+		if err := magiclink.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "MagicLink.device_id": %w`, err)}
+		}
+	}
+	if _, ok := mlc.mutation.PollTokenHash(); !ok {
+		return &ValidationError{Name: "poll_token_hash", err: errors.New(`ent: missing required field "MagicLink.poll_token_hash"`)}
+	}
+	if v, ok := mlc.mutation.PollTokenHash(); ok {
+		if err := magiclink.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "MagicLink.poll_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := mlc.mutation.Confirmed(); !ok {
+		return &ValidationError{Name: "confirmed", err: errors.New(`ent: missing required field "MagicLink.confirmed"`)}
+	}
+	if _, ok := mlc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "MagicLink.expires_at"`)}
+	}
+	return nil
+}
+
+func (mlc *MagicLinkCreate) sqlSave(ctx context.Context) (*MagicLink, error) {
+	if err := mlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mlc.mutation.id = &_node.ID
+	mlc.mutation.done = true
+	return _node, nil
+}
+
+func (mlc *MagicLinkCreate) createSpec() (*MagicLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MagicLink{config: mlc.config}
+		_spec = sqlgraph.NewCreateSpec(magiclink.Table, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeUUID))
+	)
+	if id, ok := mlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mlc.mutation.CreatedAt(); ok {
+		_spec.SetField(magiclink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mlc.mutation.ApplicationID(); ok {
+		_spec.SetField(magiclink.FieldApplicationID, field.TypeUUID, value)
+		_node.ApplicationID = value
+	}
+	if value, ok := mlc.mutation.Email(); ok {
+		_spec.SetField(magiclink.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := mlc.mutation.DeviceType(); ok {
+		_spec.SetField(magiclink.FieldDeviceType, field.TypeString, value)
+		_node.DeviceType = value
+	}
+	if value, ok := mlc.mutation.DeviceID(); ok {
+		_spec.SetField(magiclink.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := mlc.mutation.PollTokenHash(); ok {
+		_spec.SetField(magiclink.FieldPollTokenHash, field.TypeString, value)
+		_node.PollTokenHash = value
+	}
+	if value, ok := mlc.mutation.ClientIP(); ok {
+		_spec.SetField(magiclink.FieldClientIP, field.TypeString, value)
+		_node.ClientIP = value
+	}
+	if value, ok := mlc.mutation.UserAgent(); ok {
+		_spec.SetField(magiclink.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := mlc.mutation.Confirmed(); ok {
+		_spec.SetField(magiclink.FieldConfirmed, field.TypeBool, value)
+		_node.Confirmed = value
+	}
+	if value, ok := mlc.mutation.ExpiresAt(); ok {
+		_spec.SetField(magiclink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// MagicLinkCreateBulk is the builder for creating many MagicLink entities in bulk.
+type MagicLinkCreateBulk struct {
+	config
+	err      error
+	builders []*MagicLinkCreate
+}
+
+// Save creates the MagicLink entities in the database.
+func (mlcb *MagicLinkCreateBulk) Save(ctx context.Context) ([]*MagicLink, error) {
+	if mlcb.err != nil {
+		return nil, mlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mlcb.builders))
+	nodes := make([]*MagicLink, len(mlcb.builders))
+	mutators := make([]Mutator, len(mlcb.builders))
+	for i := range mlcb.builders {
+		func(i int, root context.Context) {
+			builder := mlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MagicLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mlcb *MagicLinkCreateBulk) SaveX(ctx context.Context) []*MagicLink {
+	v, err := mlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mlcb *MagicLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := mlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mlcb *MagicLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := mlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
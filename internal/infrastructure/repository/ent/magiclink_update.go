@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/magiclink"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MagicLinkUpdate is the builder for updating MagicLink entities.
+type MagicLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MagicLinkMutation
+}
+
+// Where appends a list predicates to the MagicLinkUpdate builder.
+func (mlu *MagicLinkUpdate) Where(ps ...predicate.MagicLink) *MagicLinkUpdate {
+	mlu.mutation.Where(ps...)
+	return mlu
+}
+
+// SetApplicationID sets the "application_id" field.
+func (mlu *MagicLinkUpdate) SetApplicationID(u uuid.UUID) *MagicLinkUpdate {
+	mlu.mutation.SetApplicationID(u)
+	return mlu
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableApplicationID(u *uuid.UUID) *MagicLinkUpdate {
+	if u != nil {
+		mlu.SetApplicationID(*u)
+	}
+	return mlu
+}
+
+// SetEmail sets the "email" field.
+func (mlu *MagicLinkUpdate) SetEmail(s string) *MagicLinkUpdate {
+	mlu.mutation.SetEmail(s)
+	return mlu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableEmail(s *string) *MagicLinkUpdate {
+	if s != nil {
+		mlu.SetEmail(*s)
+	}
+	return mlu
+}
+
+// SetDeviceType sets the "device_type" field.
+func (mlu *MagicLinkUpdate) SetDeviceType(s string) *MagicLinkUpdate {
+	mlu.mutation.SetDeviceType(s)
+	return mlu
+}
+
+// SetNillableDeviceType sets the "device_type" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableDeviceType(s *string) *MagicLinkUpdate {
+	if s != nil {
+		mlu.SetDeviceType(*s)
+	}
+	return mlu
+}
+
+// SetDeviceID sets the "device_id" field.
+func (mlu *MagicLinkUpdate) SetDeviceID(s string) *MagicLinkUpdate {
+	mlu.mutation.SetDeviceID(s)
+	return mlu
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableDeviceID(s *string) *MagicLinkUpdate {
+	if s != nil {
+		mlu.SetDeviceID(*s)
+	}
+	return mlu
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (mlu *MagicLinkUpdate) SetPollTokenHash(s string) *MagicLinkUpdate {
+	mlu.mutation.SetPollTokenHash(s)
+	return mlu
+}
+
+// SetNillablePollTokenHash sets the "poll_token_hash" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillablePollTokenHash(s *string) *MagicLinkUpdate {
+	if s != nil {
+		mlu.SetPollTokenHash(*s)
+	}
+	return mlu
+}
+
+// SetClientIP sets the "client_ip" field.
+func (mlu *MagicLinkUpdate) SetClientIP(s string) *MagicLinkUpdate {
+	mlu.mutation.SetClientIP(s)
+	return mlu
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableClientIP(s *string) *MagicLinkUpdate {
+	if s != nil {
+		mlu.SetClientIP(*s)
+	}
+	return mlu
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (mlu *MagicLinkUpdate) ClearClientIP() *MagicLinkUpdate {
+	mlu.mutation.ClearClientIP()
+	return mlu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (mlu *MagicLinkUpdate) SetUserAgent(s string) *MagicLinkUpdate {
+	mlu.mutation.SetUserAgent(s)
+	return mlu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableUserAgent(s *string) *MagicLinkUpdate {
+	if s != nil {
+		mlu.SetUserAgent(*s)
+	}
+	return mlu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (mlu *MagicLinkUpdate) ClearUserAgent() *MagicLinkUpdate {
+	mlu.mutation.ClearUserAgent()
+	return mlu
+}
+
+// SetConfirmed sets the "confirmed" field.
+func (mlu *MagicLinkUpdate) SetConfirmed(b bool) *MagicLinkUpdate {
+	mlu.mutation.SetConfirmed(b)
+	return mlu
+}
+
+// SetNillableConfirmed sets the "confirmed" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableConfirmed(b *bool) *MagicLinkUpdate {
+	if b != nil {
+		mlu.SetConfirmed(*b)
+	}
+	return mlu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (mlu *MagicLinkUpdate) SetExpiresAt(t time.Time) *MagicLinkUpdate {
+	mlu.mutation.SetExpiresAt(t)
+	return mlu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (mlu *MagicLinkUpdate) SetNillableExpiresAt(t *time.Time) *MagicLinkUpdate {
+	if t != nil {
+		mlu.SetExpiresAt(*t)
+	}
+	return mlu
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (mlu *MagicLinkUpdate) Mutation() *MagicLinkMutation {
+	return mlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mlu *MagicLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mlu.sqlSave, mlu.mutation, mlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mlu *MagicLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := mlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mlu *MagicLinkUpdate) Exec(ctx context.Context) error {
+	_, err := mlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mlu *MagicLinkUpdate) ExecX(ctx context.Context) {
+	if err := mlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mlu *MagicLinkUpdate) check() error {
+	if v, ok := mlu.mutation.Email(); ok {
+		if err := magiclink.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "MagicLink.email": %w`, err)}
+		}
+	}
+	if v, ok := mlu.mutation.DeviceType(); ok {
+		if err := magiclink.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "MagicLink.device_type": %w`, err)}
+		}
+	}
+	if v, ok := mlu.mutation.DeviceID(); ok {
+		if err := magiclink.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "MagicLink.device_id": %w`, err)}
+		}
+	}
+	if v, ok := mlu.mutation.PollTokenHash(); ok {
+		if err := magiclink.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "MagicLink.poll_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mlu *MagicLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(magiclink.Table, magiclink.Columns, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeUUID))
+	if ps := mlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mlu.mutation.ApplicationID(); ok {
+		_spec.SetField(magiclink.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := mlu.mutation.Email(); ok {
+		_spec.SetField(magiclink.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := mlu.mutation.DeviceType(); ok {
+		_spec.SetField(magiclink.FieldDeviceType, field.TypeString, value)
+	}
+	if value, ok := mlu.mutation.DeviceID(); ok {
+		_spec.SetField(magiclink.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := mlu.mutation.PollTokenHash(); ok {
+		_spec.SetField(magiclink.FieldPollTokenHash, field.TypeString, value)
+	}
+	if value, ok := mlu.mutation.ClientIP(); ok {
+		_spec.SetField(magiclink.FieldClientIP, field.TypeString, value)
+	}
+	if mlu.mutation.ClientIPCleared() {
+		_spec.ClearField(magiclink.FieldClientIP, field.TypeString)
+	}
+	if value, ok := mlu.mutation.UserAgent(); ok {
+		_spec.SetField(magiclink.FieldUserAgent, field.TypeString, value)
+	}
+	if mlu.mutation.UserAgentCleared() {
+		_spec.ClearField(magiclink.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := mlu.mutation.Confirmed(); ok {
+		_spec.SetField(magiclink.FieldConfirmed, field.TypeBool, value)
+	}
+	if value, ok := mlu.mutation.ExpiresAt(); ok {
+		_spec.SetField(magiclink.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{magiclink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mlu.mutation.done = true
+	return n, nil
+}
+
+// MagicLinkUpdateOne is the builder for updating a single MagicLink entity.
+type MagicLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MagicLinkMutation
+}
+
+// SetApplicationID sets the "application_id" field.
+func (mluo *MagicLinkUpdateOne) SetApplicationID(u uuid.UUID) *MagicLinkUpdateOne {
+	mluo.mutation.SetApplicationID(u)
+	return mluo
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableApplicationID(u *uuid.UUID) *MagicLinkUpdateOne {
+	if u != nil {
+		mluo.SetApplicationID(*u)
+	}
+	return mluo
+}
+
+// SetEmail sets the "email" field.
+func (mluo *MagicLinkUpdateOne) SetEmail(s string) *MagicLinkUpdateOne {
+	mluo.mutation.SetEmail(s)
+	return mluo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableEmail(s *string) *MagicLinkUpdateOne {
+	if s != nil {
+		mluo.SetEmail(*s)
+	}
+	return mluo
+}
+
+// SetDeviceType sets the "device_type" field.
+func (mluo *MagicLinkUpdateOne) SetDeviceType(s string) *MagicLinkUpdateOne {
+	mluo.mutation.SetDeviceType(s)
+	return mluo
+}
+
+// SetNillableDeviceType sets the "device_type" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableDeviceType(s *string) *MagicLinkUpdateOne {
+	if s != nil {
+		mluo.SetDeviceType(*s)
+	}
+	return mluo
+}
+
+// SetDeviceID sets the "device_id" field.
+func (mluo *MagicLinkUpdateOne) SetDeviceID(s string) *MagicLinkUpdateOne {
+	mluo.mutation.SetDeviceID(s)
+	return mluo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableDeviceID(s *string) *MagicLinkUpdateOne {
+	if s != nil {
+		mluo.SetDeviceID(*s)
+	}
+	return mluo
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (mluo *MagicLinkUpdateOne) SetPollTokenHash(s string) *MagicLinkUpdateOne {
+	mluo.mutation.SetPollTokenHash(s)
+	return mluo
+}
+
+// SetNillablePollTokenHash sets the "poll_token_hash" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillablePollTokenHash(s *string) *MagicLinkUpdateOne {
+	if s != nil {
+		mluo.SetPollTokenHash(*s)
+	}
+	return mluo
+}
+
+// SetClientIP sets the "client_ip" field.
+func (mluo *MagicLinkUpdateOne) SetClientIP(s string) *MagicLinkUpdateOne {
+	mluo.mutation.SetClientIP(s)
+	return mluo
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableClientIP(s *string) *MagicLinkUpdateOne {
+	if s != nil {
+		mluo.SetClientIP(*s)
+	}
+	return mluo
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (mluo *MagicLinkUpdateOne) ClearClientIP() *MagicLinkUpdateOne {
+	mluo.mutation.ClearClientIP()
+	return mluo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (mluo *MagicLinkUpdateOne) SetUserAgent(s string) *MagicLinkUpdateOne {
+	mluo.mutation.SetUserAgent(s)
+	return mluo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableUserAgent(s *string) *MagicLinkUpdateOne {
+	if s != nil {
+		mluo.SetUserAgent(*s)
+	}
+	return mluo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (mluo *MagicLinkUpdateOne) ClearUserAgent() *MagicLinkUpdateOne {
+	mluo.mutation.ClearUserAgent()
+	return mluo
+}
+
+// SetConfirmed sets the "confirmed" field.
+func (mluo *MagicLinkUpdateOne) SetConfirmed(b bool) *MagicLinkUpdateOne {
+	mluo.mutation.SetConfirmed(b)
+	return mluo
+}
+
+// SetNillableConfirmed sets the "confirmed" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableConfirmed(b *bool) *MagicLinkUpdateOne {
+	if b != nil {
+		mluo.SetConfirmed(*b)
+	}
+	return mluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (mluo *MagicLinkUpdateOne) SetExpiresAt(t time.Time) *MagicLinkUpdateOne {
+	mluo.mutation.SetExpiresAt(t)
+	return mluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (mluo *MagicLinkUpdateOne) SetNillableExpiresAt(t *time.Time) *MagicLinkUpdateOne {
+	if t != nil {
+		mluo.SetExpiresAt(*t)
+	}
+	return mluo
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (mluo *MagicLinkUpdateOne) Mutation() *MagicLinkMutation {
+	return mluo.mutation
+}
+
+// Where appends a list predicates to the MagicLinkUpdate builder.
+func (mluo *MagicLinkUpdateOne) Where(ps ...predicate.MagicLink) *MagicLinkUpdateOne {
+	mluo.mutation.Where(ps...)
+	return mluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mluo *MagicLinkUpdateOne) Select(field string, fields ...string) *MagicLinkUpdateOne {
+	mluo.fields = append([]string{field}, fields...)
+	return mluo
+}
+
+// Save executes the query and returns the updated MagicLink entity.
+func (mluo *MagicLinkUpdateOne) Save(ctx context.Context) (*MagicLink, error) {
+	return withHooks(ctx, mluo.sqlSave, mluo.mutation, mluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mluo *MagicLinkUpdateOne) SaveX(ctx context.Context) *MagicLink {
+	node, err := mluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mluo *MagicLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := mluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mluo *MagicLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := mluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mluo *MagicLinkUpdateOne) check() error {
+	if v, ok := mluo.mutation.Email(); ok {
+		if err := magiclink.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "MagicLink.email": %w`, err)}
+		}
+	}
+	if v, ok := mluo.mutation.DeviceType(); ok {
+		if err := magiclink.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "MagicLink.device_type": %w`, err)}
+		}
+	}
+	if v, ok := mluo.mutation.DeviceID(); ok {
+		if err := magiclink.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "MagicLink.device_id": %w`, err)}
+		}
+	}
+	if v, ok := mluo.mutation.PollTokenHash(); ok {
+		if err := magiclink.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "MagicLink.poll_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mluo *MagicLinkUpdateOne) sqlSave(ctx context.Context) (_node *MagicLink, err error) {
+	if err := mluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(magiclink.Table, magiclink.Columns, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeUUID))
+	id, ok := mluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MagicLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, magiclink.FieldID)
+		for _, f := range fields {
+			if !magiclink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != magiclink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mluo.mutation.ApplicationID(); ok {
+		_spec.SetField(magiclink.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := mluo.mutation.Email(); ok {
+		_spec.SetField(magiclink.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := mluo.mutation.DeviceType(); ok {
+		_spec.SetField(magiclink.FieldDeviceType, field.TypeString, value)
+	}
+	if value, ok := mluo.mutation.DeviceID(); ok {
+		_spec.SetField(magiclink.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := mluo.mutation.PollTokenHash(); ok {
+		_spec.SetField(magiclink.FieldPollTokenHash, field.TypeString, value)
+	}
+	if value, ok := mluo.mutation.ClientIP(); ok {
+		_spec.SetField(magiclink.FieldClientIP, field.TypeString, value)
+	}
+	if mluo.mutation.ClientIPCleared() {
+		_spec.ClearField(magiclink.FieldClientIP, field.TypeString)
+	}
+	if value, ok := mluo.mutation.UserAgent(); ok {
+		_spec.SetField(magiclink.FieldUserAgent, field.TypeString, value)
+	}
+	if mluo.mutation.UserAgentCleared() {
+		_spec.ClearField(magiclink.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := mluo.mutation.Confirmed(); ok {
+		_spec.SetField(magiclink.FieldConfirmed, field.TypeBool, value)
+	}
+	if value, ok := mluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(magiclink.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &MagicLink{config: mluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{magiclink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mluo.mutation.done = true
+	return _node, nil
+}
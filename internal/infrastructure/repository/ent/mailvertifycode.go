@@ -28,8 +28,10 @@ type MailVertifyCode struct {
 	Type mailvertifycode.Type `json:"type,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
-	// Code holds the value of the "code" field.
-	Code string `json:"code,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"code_hash,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
 	ExpiresAt    time.Time `json:"expires_at,omitempty"`
 	selectValues sql.SelectValues
@@ -40,7 +42,9 @@ func (*MailVertifyCode) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case mailvertifycode.FieldType, mailvertifycode.FieldEmail, mailvertifycode.FieldCode:
+		case mailvertifycode.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case mailvertifycode.FieldType, mailvertifycode.FieldEmail, mailvertifycode.FieldCodeHash:
 			values[i] = new(sql.NullString)
 		case mailvertifycode.FieldCreatedAt, mailvertifycode.FieldUpdatedAt, mailvertifycode.FieldDeletedAt, mailvertifycode.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
@@ -97,11 +101,17 @@ func (mvc *MailVertifyCode) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				mvc.Email = value.String
 			}
-		case mailvertifycode.FieldCode:
+		case mailvertifycode.FieldCodeHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field code", values[i])
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
 			} else if value.Valid {
-				mvc.Code = value.String
+				mvc.CodeHash = value.String
+			}
+		case mailvertifycode.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				mvc.Attempts = int(value.Int64)
 			}
 		case mailvertifycode.FieldExpiresAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -160,8 +170,11 @@ func (mvc *MailVertifyCode) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(mvc.Email)
 	builder.WriteString(", ")
-	builder.WriteString("code=")
-	builder.WriteString(mvc.Code)
+	builder.WriteString("code_hash=")
+	builder.WriteString(mvc.CodeHash)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", mvc.Attempts))
 	builder.WriteString(", ")
 	builder.WriteString("expires_at=")
 	builder.WriteString(mvc.ExpiresAt.Format(time.ANSIC))
@@ -25,8 +25,10 @@ const (
 	FieldType = "type"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
-	// FieldCode holds the string denoting the code field in the database.
-	FieldCode = "code"
+	// FieldCodeHash holds the string denoting the code_hash field in the database.
+	FieldCodeHash = "code_hash"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
 	// Table holds the table name of the mailvertifycode in the database.
@@ -41,7 +43,8 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldType,
 	FieldEmail,
-	FieldCode,
+	FieldCodeHash,
+	FieldAttempts,
 	FieldExpiresAt,
 }
 
@@ -64,8 +67,10 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
-	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
-	CodeValidator func(string) error
+	// CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	CodeHashValidator func(string) error
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -75,8 +80,11 @@ type Type string
 
 // Type values.
 const (
-	TypeLogin   Type = "login"
-	TypeUnknown Type = "unknown"
+	TypeLogin         Type = "login"
+	TypePasswordReset Type = "password_reset"
+	TypeBindEmail     Type = "bind_email"
+	TypeChangeEmail   Type = "change_email"
+	TypeUnknown       Type = "unknown"
 )
 
 func (_type Type) String() string {
@@ -86,7 +94,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeLogin, TypeUnknown:
+	case TypeLogin, TypePasswordReset, TypeBindEmail, TypeChangeEmail, TypeUnknown:
 		return nil
 	default:
 		return fmt.Errorf("mailvertifycode: invalid enum value for type field: %q", _type)
@@ -126,9 +134,14 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
-// ByCode orders the results by the code field.
-func ByCode(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCode, opts...).ToFunc()
+// ByCodeHash orders the results by the code_hash field.
+func ByCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodeHash, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
 }
 
 // ByExpiresAt orders the results by the expires_at field.
@@ -75,9 +75,14 @@ func Email(v string) predicate.MailVertifyCode {
 	return predicate.MailVertifyCode(sql.FieldEQ(FieldEmail, v))
 }
 
-// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
-func Code(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldEQ(FieldCode, v))
+// CodeHash applies equality check predicate on the "code_hash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldEQ(FieldAttempts, v))
 }
 
 // ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
@@ -300,69 +305,109 @@ func EmailContainsFold(v string) predicate.MailVertifyCode {
 	return predicate.MailVertifyCode(sql.FieldContainsFold(FieldEmail, v))
 }
 
-// CodeEQ applies the EQ predicate on the "code" field.
-func CodeEQ(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldEQ(FieldCode, v))
+// CodeHashEQ applies the EQ predicate on the "code_hash" field.
+func CodeHashEQ(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "code_hash" field.
+func CodeHashNEQ(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldNEQ(FieldCodeHash, v))
+}
+
+// CodeHashIn applies the In predicate on the "code_hash" field.
+func CodeHashIn(vs ...string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldIn(FieldCodeHash, vs...))
+}
+
+// CodeHashNotIn applies the NotIn predicate on the "code_hash" field.
+func CodeHashNotIn(vs ...string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldNotIn(FieldCodeHash, vs...))
+}
+
+// CodeHashGT applies the GT predicate on the "code_hash" field.
+func CodeHashGT(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldGT(FieldCodeHash, v))
+}
+
+// CodeHashGTE applies the GTE predicate on the "code_hash" field.
+func CodeHashGTE(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldGTE(FieldCodeHash, v))
+}
+
+// CodeHashLT applies the LT predicate on the "code_hash" field.
+func CodeHashLT(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldLT(FieldCodeHash, v))
+}
+
+// CodeHashLTE applies the LTE predicate on the "code_hash" field.
+func CodeHashLTE(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldLTE(FieldCodeHash, v))
+}
+
+// CodeHashContains applies the Contains predicate on the "code_hash" field.
+func CodeHashContains(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldContains(FieldCodeHash, v))
 }
 
-// CodeNEQ applies the NEQ predicate on the "code" field.
-func CodeNEQ(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldNEQ(FieldCode, v))
+// CodeHashHasPrefix applies the HasPrefix predicate on the "code_hash" field.
+func CodeHashHasPrefix(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldHasPrefix(FieldCodeHash, v))
 }
 
-// CodeIn applies the In predicate on the "code" field.
-func CodeIn(vs ...string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldIn(FieldCode, vs...))
+// CodeHashHasSuffix applies the HasSuffix predicate on the "code_hash" field.
+func CodeHashHasSuffix(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldHasSuffix(FieldCodeHash, v))
 }
 
-// CodeNotIn applies the NotIn predicate on the "code" field.
-func CodeNotIn(vs ...string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldNotIn(FieldCode, vs...))
+// CodeHashEqualFold applies the EqualFold predicate on the "code_hash" field.
+func CodeHashEqualFold(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldEqualFold(FieldCodeHash, v))
 }
 
-// CodeGT applies the GT predicate on the "code" field.
-func CodeGT(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldGT(FieldCode, v))
+// CodeHashContainsFold applies the ContainsFold predicate on the "code_hash" field.
+func CodeHashContainsFold(v string) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldContainsFold(FieldCodeHash, v))
 }
 
-// CodeGTE applies the GTE predicate on the "code" field.
-func CodeGTE(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldGTE(FieldCode, v))
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldEQ(FieldAttempts, v))
 }
 
-// CodeLT applies the LT predicate on the "code" field.
-func CodeLT(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldLT(FieldCode, v))
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldNEQ(FieldAttempts, v))
 }
 
-// CodeLTE applies the LTE predicate on the "code" field.
-func CodeLTE(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldLTE(FieldCode, v))
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldIn(FieldAttempts, vs...))
 }
 
-// CodeContains applies the Contains predicate on the "code" field.
-func CodeContains(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldContains(FieldCode, v))
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldNotIn(FieldAttempts, vs...))
 }
 
-// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
-func CodeHasPrefix(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldHasPrefix(FieldCode, v))
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldGT(FieldAttempts, v))
 }
 
-// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
-func CodeHasSuffix(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldHasSuffix(FieldCode, v))
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldGTE(FieldAttempts, v))
 }
 
-// CodeEqualFold applies the EqualFold predicate on the "code" field.
-func CodeEqualFold(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldEqualFold(FieldCode, v))
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldLT(FieldAttempts, v))
 }
 
-// CodeContainsFold applies the ContainsFold predicate on the "code" field.
-func CodeContainsFold(v string) predicate.MailVertifyCode {
-	return predicate.MailVertifyCode(sql.FieldContainsFold(FieldCode, v))
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.MailVertifyCode {
+	return predicate.MailVertifyCode(sql.FieldLTE(FieldAttempts, v))
 }
 
 // ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
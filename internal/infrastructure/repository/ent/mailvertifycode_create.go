@@ -75,9 +75,23 @@ func (mvcc *MailVertifyCodeCreate) SetEmail(s string) *MailVertifyCodeCreate {
 	return mvcc
 }
 
-// SetCode sets the "code" field.
-func (mvcc *MailVertifyCodeCreate) SetCode(s string) *MailVertifyCodeCreate {
-	mvcc.mutation.SetCode(s)
+// SetCodeHash sets the "code_hash" field.
+func (mvcc *MailVertifyCodeCreate) SetCodeHash(s string) *MailVertifyCodeCreate {
+	mvcc.mutation.SetCodeHash(s)
+	return mvcc
+}
+
+// SetAttempts sets the "attempts" field.
+func (mvcc *MailVertifyCodeCreate) SetAttempts(i int) *MailVertifyCodeCreate {
+	mvcc.mutation.SetAttempts(i)
+	return mvcc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mvcc *MailVertifyCodeCreate) SetNillableAttempts(i *int) *MailVertifyCodeCreate {
+	if i != nil {
+		mvcc.SetAttempts(*i)
+	}
 	return mvcc
 }
 
@@ -144,6 +158,10 @@ func (mvcc *MailVertifyCodeCreate) defaults() {
 		v := mailvertifycode.DefaultUpdatedAt()
 		mvcc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := mvcc.mutation.Attempts(); !ok {
+		v := mailvertifycode.DefaultAttempts
+		mvcc.mutation.SetAttempts(v)
+	}
 	if _, ok := mvcc.mutation.ID(); !ok {
 		v := mailvertifycode.DefaultID()
 		mvcc.mutation.SetID(v)
@@ -174,14 +192,17 @@ func (mvcc *MailVertifyCodeCreate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "MailVertifyCode.email": %w`, err)}
 		}
 	}
-	if _, ok := mvcc.mutation.Code(); !ok {
-		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "MailVertifyCode.code"`)}
+	if _, ok := mvcc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "MailVertifyCode.code_hash"`)}
 	}
-	if v, ok := mvcc.mutation.Code(); ok {
-		if err := mailvertifycode.CodeValidator(v); err != nil {
-			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "MailVertifyCode.code": %w`, err)}
+	if v, ok := mvcc.mutation.CodeHash(); ok {
+		if err := mailvertifycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "MailVertifyCode.code_hash": %w`, err)}
 		}
 	}
+	if _, ok := mvcc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "MailVertifyCode.attempts"`)}
+	}
 	if _, ok := mvcc.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "MailVertifyCode.expires_at"`)}
 	}
@@ -240,9 +261,13 @@ func (mvcc *MailVertifyCodeCreate) createSpec() (*MailVertifyCode, *sqlgraph.Cre
 		_spec.SetField(mailvertifycode.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
-	if value, ok := mvcc.mutation.Code(); ok {
-		_spec.SetField(mailvertifycode.FieldCode, field.TypeString, value)
-		_node.Code = value
+	if value, ok := mvcc.mutation.CodeHash(); ok {
+		_spec.SetField(mailvertifycode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := mvcc.mutation.Attempts(); ok {
+		_spec.SetField(mailvertifycode.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
 	}
 	if value, ok := mvcc.mutation.ExpiresAt(); ok {
 		_spec.SetField(mailvertifycode.FieldExpiresAt, field.TypeTime, value)
@@ -96,20 +96,41 @@ func (mvcu *MailVertifyCodeUpdate) SetNillableEmail(s *string) *MailVertifyCodeU
 	return mvcu
 }
 
-// SetCode sets the "code" field.
-func (mvcu *MailVertifyCodeUpdate) SetCode(s string) *MailVertifyCodeUpdate {
-	mvcu.mutation.SetCode(s)
+// SetCodeHash sets the "code_hash" field.
+func (mvcu *MailVertifyCodeUpdate) SetCodeHash(s string) *MailVertifyCodeUpdate {
+	mvcu.mutation.SetCodeHash(s)
 	return mvcu
 }
 
-// SetNillableCode sets the "code" field if the given value is not nil.
-func (mvcu *MailVertifyCodeUpdate) SetNillableCode(s *string) *MailVertifyCodeUpdate {
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (mvcu *MailVertifyCodeUpdate) SetNillableCodeHash(s *string) *MailVertifyCodeUpdate {
 	if s != nil {
-		mvcu.SetCode(*s)
+		mvcu.SetCodeHash(*s)
 	}
 	return mvcu
 }
 
+// SetAttempts sets the "attempts" field.
+func (mvcu *MailVertifyCodeUpdate) SetAttempts(i int) *MailVertifyCodeUpdate {
+	mvcu.mutation.ResetAttempts()
+	mvcu.mutation.SetAttempts(i)
+	return mvcu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mvcu *MailVertifyCodeUpdate) SetNillableAttempts(i *int) *MailVertifyCodeUpdate {
+	if i != nil {
+		mvcu.SetAttempts(*i)
+	}
+	return mvcu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (mvcu *MailVertifyCodeUpdate) AddAttempts(i int) *MailVertifyCodeUpdate {
+	mvcu.mutation.AddAttempts(i)
+	return mvcu
+}
+
 // SetExpiresAt sets the "expires_at" field.
 func (mvcu *MailVertifyCodeUpdate) SetExpiresAt(t time.Time) *MailVertifyCodeUpdate {
 	mvcu.mutation.SetExpiresAt(t)
@@ -177,9 +198,9 @@ func (mvcu *MailVertifyCodeUpdate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "MailVertifyCode.email": %w`, err)}
 		}
 	}
-	if v, ok := mvcu.mutation.Code(); ok {
-		if err := mailvertifycode.CodeValidator(v); err != nil {
-			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "MailVertifyCode.code": %w`, err)}
+	if v, ok := mvcu.mutation.CodeHash(); ok {
+		if err := mailvertifycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "MailVertifyCode.code_hash": %w`, err)}
 		}
 	}
 	return nil
@@ -215,8 +236,14 @@ func (mvcu *MailVertifyCodeUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if value, ok := mvcu.mutation.Email(); ok {
 		_spec.SetField(mailvertifycode.FieldEmail, field.TypeString, value)
 	}
-	if value, ok := mvcu.mutation.Code(); ok {
-		_spec.SetField(mailvertifycode.FieldCode, field.TypeString, value)
+	if value, ok := mvcu.mutation.CodeHash(); ok {
+		_spec.SetField(mailvertifycode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := mvcu.mutation.Attempts(); ok {
+		_spec.SetField(mailvertifycode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mvcu.mutation.AddedAttempts(); ok {
+		_spec.AddField(mailvertifycode.FieldAttempts, field.TypeInt, value)
 	}
 	if value, ok := mvcu.mutation.ExpiresAt(); ok {
 		_spec.SetField(mailvertifycode.FieldExpiresAt, field.TypeTime, value)
@@ -309,20 +336,41 @@ func (mvcuo *MailVertifyCodeUpdateOne) SetNillableEmail(s *string) *MailVertifyC
 	return mvcuo
 }
 
-// SetCode sets the "code" field.
-func (mvcuo *MailVertifyCodeUpdateOne) SetCode(s string) *MailVertifyCodeUpdateOne {
-	mvcuo.mutation.SetCode(s)
+// SetCodeHash sets the "code_hash" field.
+func (mvcuo *MailVertifyCodeUpdateOne) SetCodeHash(s string) *MailVertifyCodeUpdateOne {
+	mvcuo.mutation.SetCodeHash(s)
 	return mvcuo
 }
 
-// SetNillableCode sets the "code" field if the given value is not nil.
-func (mvcuo *MailVertifyCodeUpdateOne) SetNillableCode(s *string) *MailVertifyCodeUpdateOne {
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (mvcuo *MailVertifyCodeUpdateOne) SetNillableCodeHash(s *string) *MailVertifyCodeUpdateOne {
 	if s != nil {
-		mvcuo.SetCode(*s)
+		mvcuo.SetCodeHash(*s)
 	}
 	return mvcuo
 }
 
+// SetAttempts sets the "attempts" field.
+func (mvcuo *MailVertifyCodeUpdateOne) SetAttempts(i int) *MailVertifyCodeUpdateOne {
+	mvcuo.mutation.ResetAttempts()
+	mvcuo.mutation.SetAttempts(i)
+	return mvcuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mvcuo *MailVertifyCodeUpdateOne) SetNillableAttempts(i *int) *MailVertifyCodeUpdateOne {
+	if i != nil {
+		mvcuo.SetAttempts(*i)
+	}
+	return mvcuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (mvcuo *MailVertifyCodeUpdateOne) AddAttempts(i int) *MailVertifyCodeUpdateOne {
+	mvcuo.mutation.AddAttempts(i)
+	return mvcuo
+}
+
 // SetExpiresAt sets the "expires_at" field.
 func (mvcuo *MailVertifyCodeUpdateOne) SetExpiresAt(t time.Time) *MailVertifyCodeUpdateOne {
 	mvcuo.mutation.SetExpiresAt(t)
@@ -403,9 +451,9 @@ func (mvcuo *MailVertifyCodeUpdateOne) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "MailVertifyCode.email": %w`, err)}
 		}
 	}
-	if v, ok := mvcuo.mutation.Code(); ok {
-		if err := mailvertifycode.CodeValidator(v); err != nil {
-			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "MailVertifyCode.code": %w`, err)}
+	if v, ok := mvcuo.mutation.CodeHash(); ok {
+		if err := mailvertifycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "MailVertifyCode.code_hash": %w`, err)}
 		}
 	}
 	return nil
@@ -458,8 +506,14 @@ func (mvcuo *MailVertifyCodeUpdateOne) sqlSave(ctx context.Context) (_node *Mail
 	if value, ok := mvcuo.mutation.Email(); ok {
 		_spec.SetField(mailvertifycode.FieldEmail, field.TypeString, value)
 	}
-	if value, ok := mvcuo.mutation.Code(); ok {
-		_spec.SetField(mailvertifycode.FieldCode, field.TypeString, value)
+	if value, ok := mvcuo.mutation.CodeHash(); ok {
+		_spec.SetField(mailvertifycode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := mvcuo.mutation.Attempts(); ok {
+		_spec.SetField(mailvertifycode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mvcuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(mailvertifycode.FieldAttempts, field.TypeInt, value)
 	}
 	if value, ok := mvcuo.mutation.ExpiresAt(); ok {
 		_spec.SetField(mailvertifycode.FieldExpiresAt, field.TypeTime, value)
@@ -0,0 +1,21 @@
+-- Modify "applications" table
+ALTER TABLE "applications" ADD COLUMN "client_secret_hash" character varying NULL, ADD COLUMN "redirect_uris" jsonb NULL;
+-- Create "oauth_authorization_codes" table
+CREATE TABLE "oauth_authorization_codes" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "code_hash" character varying NOT NULL,
+  "application_id" uuid NOT NULL,
+  "user_id" character varying NOT NULL,
+  "redirect_uri" character varying NOT NULL,
+  "scope" character varying NULL,
+  "nonce" character varying NULL,
+  "code_challenge" character varying NOT NULL,
+  "code_challenge_method" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "oauthauthorizationcode_code_hash" to table: "oauth_authorization_codes"
+CREATE UNIQUE INDEX "oauthauthorizationcode_code_hash" ON "oauth_authorization_codes" ("code_hash");
+-- Create index "oauthauthorizationcode_expires_at" to table: "oauth_authorization_codes"
+CREATE INDEX "oauthauthorizationcode_expires_at" ON "oauth_authorization_codes" ("expires_at");
@@ -0,0 +1,2 @@
+-- Modify "bindings" table
+ALTER TABLE "bindings" ADD COLUMN "secret" character varying NULL;
@@ -0,0 +1,12 @@
+-- Create "passkey_credentials" table
+CREATE TABLE "passkey_credentials" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "credential_id" character varying NOT NULL, "public_key" bytea NOT NULL, "sign_count" bigint NOT NULL DEFAULT 0, "transports" jsonb NULL, "aaguid" character varying NULL, "name" character varying NULL, "last_used_at" timestamptz NULL, "user_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "passkey_credentials_users_passkey_credentials" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "passkeycredential_credential_id" to table: "passkey_credentials"
+CREATE UNIQUE INDEX "passkeycredential_credential_id" ON "passkey_credentials" ("credential_id");
+-- Create index "passkeycredential_user_id" to table: "passkey_credentials"
+CREATE INDEX "passkeycredential_user_id" ON "passkey_credentials" ("user_id");
+-- Create "web_authn_challenges" table
+CREATE TABLE "web_authn_challenges" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "type" character varying NOT NULL, "challenge" character varying NOT NULL, "application_id" uuid NOT NULL, "user_id" character varying NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "webauthnchallenge_challenge" to table: "web_authn_challenges"
+CREATE UNIQUE INDEX "webauthnchallenge_challenge" ON "web_authn_challenges" ("challenge");
+-- Create index "webauthnchallenge_expires_at" to table: "web_authn_challenges"
+CREATE INDEX "webauthnchallenge_expires_at" ON "web_authn_challenges" ("expires_at");
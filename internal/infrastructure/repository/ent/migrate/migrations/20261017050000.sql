@@ -0,0 +1,6 @@
+-- Create "login_locks" table
+CREATE TABLE "login_locks" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "application_name" character varying NOT NULL, "scope" character varying NOT NULL, "subject" character varying NOT NULL, "failures" bigint NOT NULL DEFAULT 0, "last_failure_at" timestamptz NOT NULL, "next_attempt_at" timestamptz NULL, "locked_until" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "loginlock_application_name_scope_subject" to table: "login_locks"
+CREATE UNIQUE INDEX "loginlock_application_name_scope_subject" ON "login_locks" ("application_name", "scope", "subject");
+-- Create index "loginlock_locked_until" to table: "login_locks"
+CREATE INDEX "loginlock_locked_until" ON "login_locks" ("locked_until");
@@ -0,0 +1,10 @@
+-- Modify "devices" table
+ALTER TABLE "devices" ADD COLUMN "refresh_token_family" uuid NULL;
+-- Create "rotated_refresh_tokens" table
+CREATE TABLE "rotated_refresh_tokens" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "refresh_token" character varying NOT NULL, "family" uuid NOT NULL, "device_id" bigint NOT NULL, "rotated_at" timestamptz NOT NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "rotatedrefreshtoken_refresh_token" to table: "rotated_refresh_tokens"
+CREATE UNIQUE INDEX "rotatedrefreshtoken_refresh_token" ON "rotated_refresh_tokens" ("refresh_token");
+-- Create index "rotatedrefreshtoken_family" to table: "rotated_refresh_tokens"
+CREATE INDEX "rotatedrefreshtoken_family" ON "rotated_refresh_tokens" ("family");
+-- Create index "rotatedrefreshtoken_expires_at" to table: "rotated_refresh_tokens"
+CREATE INDEX "rotatedrefreshtoken_expires_at" ON "rotated_refresh_tokens" ("expires_at");
@@ -0,0 +1,10 @@
+-- Modify "devices" table
+ALTER TABLE "devices" ALTER COLUMN "refresh_token" DROP NOT NULL, ADD COLUMN "refresh_token_hash" character varying NULL;
+-- Create index "device_refresh_token_hash" to table: "devices"
+CREATE UNIQUE INDEX "device_refresh_token_hash" ON "devices" ("refresh_token_hash");
+-- Rotated tokens were stored in clear, they only matter for a grace window and can be dropped
+DELETE FROM "rotated_refresh_tokens";
+-- Modify "rotated_refresh_tokens" table
+ALTER TABLE "rotated_refresh_tokens" RENAME COLUMN "refresh_token" TO "refresh_token_hash";
+-- Rename index "rotatedrefreshtoken_refresh_token" to "rotatedrefreshtoken_refresh_token_hash"
+ALTER INDEX "rotatedrefreshtoken_refresh_token" RENAME TO "rotatedrefreshtoken_refresh_token_hash";
@@ -0,0 +1,2 @@
+-- Modify "devices" table
+ALTER TABLE "devices" ADD COLUMN "last_ip" character varying NULL, ADD COLUMN "user_agent" character varying NULL, ADD COLUMN "last_refreshed_at" timestamptz NULL;
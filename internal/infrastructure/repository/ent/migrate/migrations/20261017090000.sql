@@ -0,0 +1,2 @@
+-- Backfill "bindings" of users linked after sign up, logins look bindings up by application
+UPDATE "bindings" SET "application_id" = "users"."application_id" FROM "users" WHERE "bindings"."user_id" = "users"."id" AND "bindings"."application_id" IS NULL;
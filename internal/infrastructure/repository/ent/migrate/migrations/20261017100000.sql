@@ -0,0 +1,4 @@
+-- Create "identity_providers" table
+CREATE TABLE "identity_providers" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "application_id" uuid NOT NULL, "name" character varying NOT NULL, "display_name" character varying NULL, "issuer" character varying NOT NULL, "client_id" character varying NOT NULL, "client_secret" character varying NULL, "scopes" jsonb NULL, "authorization_endpoint" character varying NULL, "token_endpoint" character varying NULL, "userinfo_endpoint" character varying NULL, "jwks_uri" character varying NULL, "claim_mapping" jsonb NULL, "enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+-- Create index "identityprovider_application_id_name" to table: "identity_providers"
+CREATE UNIQUE INDEX "identityprovider_application_id_name" ON "identity_providers" ("application_id", "name");
@@ -0,0 +1,6 @@
+-- Modify "organization_users" table
+ALTER TABLE "organization_users" ADD COLUMN "department" character varying NULL;
+-- Create "saml_connections" table
+CREATE TABLE "saml_connections" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "organization_id" uuid NOT NULL, "idp_entity_id" character varying NOT NULL, "idp_sso_url" character varying NOT NULL, "idp_certificates" jsonb NOT NULL, "idp_metadata" text NULL, "attribute_mapping" jsonb NULL, "redirect_url" character varying NOT NULL, "enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+-- Create index "saml_connections_organization_id_key" to table: "saml_connections"
+CREATE UNIQUE INDEX "saml_connections_organization_id_key" ON "saml_connections" ("organization_id");
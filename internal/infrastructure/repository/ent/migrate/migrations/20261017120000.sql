@@ -0,0 +1,4 @@
+-- Create "magic_links" table
+CREATE TABLE "magic_links" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "application_id" uuid NOT NULL, "email" character varying NOT NULL, "device_type" character varying NOT NULL, "device_id" character varying NOT NULL, "poll_token_hash" character varying NOT NULL, "confirmed" boolean NOT NULL DEFAULT false, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "magiclink_expires_at" to table: "magic_links"
+CREATE INDEX "magiclink_expires_at" ON "magic_links" ("expires_at");
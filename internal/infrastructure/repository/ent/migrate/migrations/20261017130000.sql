@@ -0,0 +1,4 @@
+-- Create "qr_logins" table
+CREATE TABLE "qr_logins" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "application_id" uuid NOT NULL, "device_type" character varying NOT NULL, "device_id" character varying NOT NULL, "poll_token_hash" character varying NOT NULL, "client_ip" character varying NULL, "user_agent" character varying NULL, "status" character varying NOT NULL, "user_id" character varying NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "qrlogin_expires_at" to table: "qr_logins"
+CREATE INDEX "qrlogin_expires_at" ON "qr_logins" ("expires_at");
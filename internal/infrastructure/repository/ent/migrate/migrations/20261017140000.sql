@@ -0,0 +1,6 @@
+-- Create "qy_wechat_corps" table
+CREATE TABLE "qy_wechat_corps" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "organization_id" uuid NOT NULL, "corp_id" character varying NOT NULL, "corp_secret" character varying NOT NULL, "enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+-- Create index "qy_wechat_corps_corp_id_key" to table: "qy_wechat_corps"
+CREATE UNIQUE INDEX "qy_wechat_corps_corp_id_key" ON "qy_wechat_corps" ("corp_id");
+-- Create index "qy_wechat_corps_organization_id_key" to table: "qy_wechat_corps"
+CREATE UNIQUE INDEX "qy_wechat_corps_organization_id_key" ON "qy_wechat_corps" ("organization_id");
@@ -0,0 +1,4 @@
+-- Create "wechat_scan_logins" table
+CREATE TABLE "wechat_scan_logins" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "application_id" uuid NOT NULL, "device_type" character varying NOT NULL, "device_id" character varying NOT NULL, "poll_token_hash" character varying NOT NULL, "open_id" character varying NULL, "union_id" character varying NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "wechatscanlogin_expires_at" to table: "wechat_scan_logins"
+CREATE INDEX "wechatscanlogin_expires_at" ON "wechat_scan_logins" ("expires_at");
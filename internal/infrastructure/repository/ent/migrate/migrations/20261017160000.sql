@@ -0,0 +1,4 @@
+-- Drop the plaintext codes in flight, they can not be hashed without being sent again
+DELETE FROM "mail_vertify_codes";
+-- Modify "mail_vertify_codes" table
+ALTER TABLE "mail_vertify_codes" DROP COLUMN "code", ADD COLUMN "code_hash" character varying NOT NULL, ADD COLUMN "attempts" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Create "sms_verify_codes" table
+CREATE TABLE "sms_verify_codes" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "type" character varying NOT NULL, "phone" character varying NOT NULL, "code_hash" character varying NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "smsverifycode_type_phone" to table: "sms_verify_codes"
+CREATE UNIQUE INDEX "smsverifycode_type_phone" ON "sms_verify_codes" ("type", "phone");
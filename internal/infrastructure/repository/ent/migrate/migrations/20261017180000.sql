@@ -0,0 +1,2 @@
+-- Modify "magic_links" table
+ALTER TABLE "magic_links" ADD COLUMN "client_ip" character varying NULL, ADD COLUMN "user_agent" character varying NULL;
@@ -0,0 +1,2 @@
+-- Modify "rotated_refresh_tokens" table
+ALTER TABLE "rotated_refresh_tokens" ADD COLUMN "successor" character varying NULL;
@@ -0,0 +1,14 @@
+-- Modify "devices" table
+ALTER TABLE "devices" ADD COLUMN "oauth_scope" character varying NULL;
+-- Create "oauth_consents" table
+CREATE TABLE "oauth_consents" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "application_id" uuid NOT NULL,
+  "user_id" character varying NOT NULL,
+  "scope" character varying NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "oauthconsent_user_id_application_id" to table: "oauth_consents"
+CREATE UNIQUE INDEX "oauthconsent_user_id_application_id" ON "oauth_consents" ("user_id", "application_id");
@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "banned" boolean NOT NULL DEFAULT false;
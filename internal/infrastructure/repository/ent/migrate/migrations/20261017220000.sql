@@ -0,0 +1,2 @@
+-- Qualify "bindings" of openid connect providers by the issuer instead of the provider name
+UPDATE "bindings" SET "identity" = regexp_replace("identity_providers"."issuer", '/$', '') || '#' || substr("bindings"."identity", length("identity_providers"."name") + 2) FROM "identity_providers" WHERE "bindings"."type" = 'oidc' AND "bindings"."application_id" = "identity_providers"."application_id" AND starts_with("bindings"."identity", "identity_providers"."name" || ':');
@@ -16,6 +16,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "client_secret_hash", Type: field.TypeString, Nullable: true},
+		{Name: "redirect_uris", Type: field.TypeJSON, Nullable: true},
 		{Name: "application_default_personal_role", Type: field.TypeUUID, Nullable: true},
 		{Name: "application_default_org_role", Type: field.TypeUUID, Nullable: true},
 		{Name: "application_default_org_admin_role", Type: field.TypeUUID, Nullable: true},
@@ -28,19 +30,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "applications_roles_default_personal_role",
-				Columns:    []*schema.Column{ApplicationsColumns[5]},
+				Columns:    []*schema.Column{ApplicationsColumns[7]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "applications_roles_default_org_role",
-				Columns:    []*schema.Column{ApplicationsColumns[6]},
+				Columns:    []*schema.Column{ApplicationsColumns[8]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "applications_roles_default_org_admin_role",
-				Columns:    []*schema.Column{ApplicationsColumns[7]},
+				Columns:    []*schema.Column{ApplicationsColumns[9]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -59,11 +61,12 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"wechat", "qy_wechat", "wxid", "phone", "password", "email", "google", "unknown"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"wechat", "qy_wechat", "wxid", "phone", "password", "email", "google", "totp", "apple", "oidc", "saml", "guest", "unknown"}},
 		{Name: "identity", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Nullable: true},
 		{Name: "verified", Type: field.TypeBool, Default: false},
 		{Name: "salt", Type: field.TypeString, Nullable: true},
+		{Name: "secret", Type: field.TypeString, Nullable: true},
 		{Name: "application_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_id", Type: field.TypeString},
 	}
@@ -75,7 +78,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "bindings_users_bindings",
-				Columns:    []*schema.Column{BindingsColumns[10]},
+				Columns:    []*schema.Column{BindingsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -94,7 +97,7 @@ var (
 			{
 				Name:    "binding_user_id_type",
 				Unique:  true,
-				Columns: []*schema.Column{BindingsColumns[10], BindingsColumns[4]},
+				Columns: []*schema.Column{BindingsColumns[11], BindingsColumns[4]},
 			},
 		},
 	}
@@ -105,7 +108,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_id", Type: field.TypeString},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"wechat", "qy_wechat", "wxid", "phone", "password", "email", "google", "unknown"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"wechat", "qy_wechat", "wxid", "phone", "password", "email", "google", "totp", "apple", "oidc", "saml", "guest", "unknown"}},
 		{Name: "identity", Type: field.TypeString},
 		{Name: "code", Type: field.TypeString},
 		{Name: "expires_at", Type: field.TypeTime},
@@ -133,8 +136,14 @@ var (
 		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "device_type", Type: field.TypeString},
 		{Name: "device_id", Type: field.TypeString},
-		{Name: "refresh_token", Type: field.TypeString},
+		{Name: "refresh_token", Type: field.TypeString, Nullable: true},
+		{Name: "refresh_token_hash", Type: field.TypeString, Nullable: true},
 		{Name: "refresh_token_expires_at", Type: field.TypeTime},
+		{Name: "refresh_token_family", Type: field.TypeUUID, Nullable: true},
+		{Name: "last_ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "last_refreshed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "oauth_scope", Type: field.TypeString, Nullable: true},
 		{Name: "user_id", Type: field.TypeString},
 	}
 	// DevicesTable holds the schema information for the "devices" table.
@@ -145,7 +154,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "devices_users_devices",
-				Columns:    []*schema.Column{DevicesColumns[9]},
+				Columns:    []*schema.Column{DevicesColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -156,10 +165,105 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{DevicesColumns[7]},
 			},
+			{
+				Name:    "device_refresh_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{DevicesColumns[8]},
+			},
 			{
 				Name:    "device_user_id_device_type_device_id",
 				Unique:  true,
-				Columns: []*schema.Column{DevicesColumns[9], DevicesColumns[5], DevicesColumns[6]},
+				Columns: []*schema.Column{DevicesColumns[15], DevicesColumns[5], DevicesColumns[6]},
+			},
+		},
+	}
+	// IdentityProvidersColumns holds the columns for the "identity_providers" table.
+	IdentityProvidersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "application_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "display_name", Type: field.TypeString, Nullable: true},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "client_id", Type: field.TypeString},
+		{Name: "client_secret", Type: field.TypeString, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "authorization_endpoint", Type: field.TypeString, Nullable: true},
+		{Name: "token_endpoint", Type: field.TypeString, Nullable: true},
+		{Name: "userinfo_endpoint", Type: field.TypeString, Nullable: true},
+		{Name: "jwks_uri", Type: field.TypeString, Nullable: true},
+		{Name: "claim_mapping", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// IdentityProvidersTable holds the schema information for the "identity_providers" table.
+	IdentityProvidersTable = &schema.Table{
+		Name:       "identity_providers",
+		Columns:    IdentityProvidersColumns,
+		PrimaryKey: []*schema.Column{IdentityProvidersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identityprovider_application_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{IdentityProvidersColumns[3], IdentityProvidersColumns[4]},
+			},
+		},
+	}
+	// LoginLocksColumns holds the columns for the "login_locks" table.
+	LoginLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "application_name", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"user", "ip", "unknown"}},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_failure_at", Type: field.TypeTime},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+	}
+	// LoginLocksTable holds the schema information for the "login_locks" table.
+	LoginLocksTable = &schema.Table{
+		Name:       "login_locks",
+		Columns:    LoginLocksColumns,
+		PrimaryKey: []*schema.Column{LoginLocksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginlock_application_name_scope_subject",
+				Unique:  true,
+				Columns: []*schema.Column{LoginLocksColumns[3], LoginLocksColumns[4], LoginLocksColumns[5]},
+			},
+			{
+				Name:    "loginlock_locked_until",
+				Unique:  false,
+				Columns: []*schema.Column{LoginLocksColumns[9]},
+			},
+		},
+	}
+	// MagicLinksColumns holds the columns for the "magic_links" table.
+	MagicLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "application_id", Type: field.TypeUUID},
+		{Name: "email", Type: field.TypeString},
+		{Name: "device_type", Type: field.TypeString},
+		{Name: "device_id", Type: field.TypeString},
+		{Name: "poll_token_hash", Type: field.TypeString},
+		{Name: "client_ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "confirmed", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// MagicLinksTable holds the schema information for the "magic_links" table.
+	MagicLinksTable = &schema.Table{
+		Name:       "magic_links",
+		Columns:    MagicLinksColumns,
+		PrimaryKey: []*schema.Column{MagicLinksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "magiclink_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{MagicLinksColumns[10]},
 			},
 		},
 	}
@@ -169,9 +273,10 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"login", "unknown"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"login", "password_reset", "bind_email", "change_email", "unknown"}},
 		{Name: "email", Type: field.TypeString},
-		{Name: "code", Type: field.TypeString},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
 		{Name: "expires_at", Type: field.TypeTime},
 	}
 	// MailVertifyCodesTable holds the schema information for the "mail_vertify_codes" table.
@@ -187,6 +292,60 @@ var (
 			},
 		},
 	}
+	// OauthAuthorizationCodesColumns holds the columns for the "oauth_authorization_codes" table.
+	OauthAuthorizationCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "application_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "redirect_uri", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString, Nullable: true},
+		{Name: "nonce", Type: field.TypeString, Nullable: true},
+		{Name: "code_challenge", Type: field.TypeString},
+		{Name: "code_challenge_method", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// OauthAuthorizationCodesTable holds the schema information for the "oauth_authorization_codes" table.
+	OauthAuthorizationCodesTable = &schema.Table{
+		Name:       "oauth_authorization_codes",
+		Columns:    OauthAuthorizationCodesColumns,
+		PrimaryKey: []*schema.Column{OauthAuthorizationCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthauthorizationcode_code_hash",
+				Unique:  true,
+				Columns: []*schema.Column{OauthAuthorizationCodesColumns[2]},
+			},
+			{
+				Name:    "oauthauthorizationcode_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthAuthorizationCodesColumns[10]},
+			},
+		},
+	}
+	// OauthConsentsColumns holds the columns for the "oauth_consents" table.
+	OauthConsentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "application_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString, Nullable: true},
+	}
+	// OauthConsentsTable holds the schema information for the "oauth_consents" table.
+	OauthConsentsTable = &schema.Table{
+		Name:       "oauth_consents",
+		Columns:    OauthConsentsColumns,
+		PrimaryKey: []*schema.Column{OauthConsentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthconsent_user_id_application_id",
+				Unique:  true,
+				Columns: []*schema.Column{OauthConsentsColumns[4], OauthConsentsColumns[3]},
+			},
+		},
+	}
 	// OrganizationsColumns holds the columns for the "organizations" table.
 	OrganizationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -297,6 +456,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "department", Type: field.TypeString, Nullable: true},
 		{Name: "organization_id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeString},
 		{Name: "organization_user_role", Type: field.TypeUUID, Nullable: true},
@@ -309,19 +469,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "organization_users_organizations_organization",
-				Columns:    []*schema.Column{OrganizationUsersColumns[4]},
+				Columns:    []*schema.Column{OrganizationUsersColumns[5]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "organization_users_users_user",
-				Columns:    []*schema.Column{OrganizationUsersColumns[5]},
+				Columns:    []*schema.Column{OrganizationUsersColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "organization_users_roles_role",
-				Columns:    []*schema.Column{OrganizationUsersColumns[6]},
+				Columns:    []*schema.Column{OrganizationUsersColumns[7]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -330,7 +490,47 @@ var (
 			{
 				Name:    "organizationuser_user_id_organization_id",
 				Unique:  true,
-				Columns: []*schema.Column{OrganizationUsersColumns[5], OrganizationUsersColumns[4]},
+				Columns: []*schema.Column{OrganizationUsersColumns[6], OrganizationUsersColumns[5]},
+			},
+		},
+	}
+	// PasskeyCredentialsColumns holds the columns for the "passkey_credentials" table.
+	PasskeyCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "credential_id", Type: field.TypeString},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "aaguid", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// PasskeyCredentialsTable holds the schema information for the "passkey_credentials" table.
+	PasskeyCredentialsTable = &schema.Table{
+		Name:       "passkey_credentials",
+		Columns:    PasskeyCredentialsColumns,
+		PrimaryKey: []*schema.Column{PasskeyCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "passkey_credentials_users_passkey_credentials",
+				Columns:    []*schema.Column{PasskeyCredentialsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passkeycredential_credential_id",
+				Unique:  true,
+				Columns: []*schema.Column{PasskeyCredentialsColumns[3]},
+			},
+			{
+				Name:    "passkeycredential_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeyCredentialsColumns[10]},
 			},
 		},
 	}
@@ -399,6 +599,49 @@ var (
 			},
 		},
 	}
+	// QrLoginsColumns holds the columns for the "qr_logins" table.
+	QrLoginsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "application_id", Type: field.TypeUUID},
+		{Name: "device_type", Type: field.TypeString},
+		{Name: "device_id", Type: field.TypeString},
+		{Name: "poll_token_hash", Type: field.TypeString},
+		{Name: "client_ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "scanned", "approved", "rejected", "unknown"}},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// QrLoginsTable holds the schema information for the "qr_logins" table.
+	QrLoginsTable = &schema.Table{
+		Name:       "qr_logins",
+		Columns:    QrLoginsColumns,
+		PrimaryKey: []*schema.Column{QrLoginsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "qrlogin_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{QrLoginsColumns[10]},
+			},
+		},
+	}
+	// QyWechatCorpsColumns holds the columns for the "qy_wechat_corps" table.
+	QyWechatCorpsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID, Unique: true},
+		{Name: "corp_id", Type: field.TypeString, Unique: true},
+		{Name: "corp_secret", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// QyWechatCorpsTable holds the schema information for the "qy_wechat_corps" table.
+	QyWechatCorpsTable = &schema.Table{
+		Name:       "qy_wechat_corps",
+		Columns:    QyWechatCorpsColumns,
+		PrimaryKey: []*schema.Column{QyWechatCorpsColumns[0]},
+	}
 	// QyWechatUserIdsColumns holds the columns for the "qy_wechat_user_ids" table.
 	QyWechatUserIdsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -466,6 +709,60 @@ var (
 			},
 		},
 	}
+	// RotatedRefreshTokensColumns holds the columns for the "rotated_refresh_tokens" table.
+	RotatedRefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "refresh_token_hash", Type: field.TypeString},
+		{Name: "family", Type: field.TypeUUID},
+		{Name: "device_id", Type: field.TypeInt64},
+		{Name: "rotated_at", Type: field.TypeTime},
+		{Name: "successor", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// RotatedRefreshTokensTable holds the schema information for the "rotated_refresh_tokens" table.
+	RotatedRefreshTokensTable = &schema.Table{
+		Name:       "rotated_refresh_tokens",
+		Columns:    RotatedRefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RotatedRefreshTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rotatedrefreshtoken_refresh_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{RotatedRefreshTokensColumns[2]},
+			},
+			{
+				Name:    "rotatedrefreshtoken_family",
+				Unique:  false,
+				Columns: []*schema.Column{RotatedRefreshTokensColumns[3]},
+			},
+			{
+				Name:    "rotatedrefreshtoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RotatedRefreshTokensColumns[7]},
+			},
+		},
+	}
+	// SamlConnectionsColumns holds the columns for the "saml_connections" table.
+	SamlConnectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID, Unique: true},
+		{Name: "idp_entity_id", Type: field.TypeString},
+		{Name: "idp_sso_url", Type: field.TypeString},
+		{Name: "idp_certificates", Type: field.TypeJSON},
+		{Name: "idp_metadata", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "attribute_mapping", Type: field.TypeJSON, Nullable: true},
+		{Name: "redirect_url", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// SamlConnectionsTable holds the schema information for the "saml_connections" table.
+	SamlConnectionsTable = &schema.Table{
+		Name:       "saml_connections",
+		Columns:    SamlConnectionsColumns,
+		PrimaryKey: []*schema.Column{SamlConnectionsColumns[0]},
+	}
 	// ScopesColumns holds the columns for the "scopes" table.
 	ScopesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -497,6 +794,30 @@ var (
 			},
 		},
 	}
+	// SmsVerifyCodesColumns holds the columns for the "sms_verify_codes" table.
+	SmsVerifyCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"login", "password_reset", "bind_email", "change_email", "unknown"}},
+		{Name: "phone", Type: field.TypeString},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// SmsVerifyCodesTable holds the schema information for the "sms_verify_codes" table.
+	SmsVerifyCodesTable = &schema.Table{
+		Name:       "sms_verify_codes",
+		Columns:    SmsVerifyCodesColumns,
+		PrimaryKey: []*schema.Column{SmsVerifyCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "smsverifycode_type_phone",
+				Unique:  true,
+				Columns: []*schema.Column{SmsVerifyCodesColumns[3], SmsVerifyCodesColumns[4]},
+			},
+		},
+	}
 	// StripeEventsColumns holds the columns for the "stripe_events" table.
 	StripeEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -537,6 +858,7 @@ var (
 		{Name: "avatar", Type: field.TypeString, Nullable: true},
 		{Name: "referral_channel", Type: field.TypeJSON, Nullable: true},
 		{Name: "department", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "banned", Type: field.TypeBool, Default: false},
 		{Name: "application_id", Type: field.TypeUUID},
 		{Name: "user_personal_role", Type: field.TypeUUID, Nullable: true},
 	}
@@ -548,13 +870,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_applications_users",
-				Columns:    []*schema.Column{UsersColumns[9]},
+				Columns:    []*schema.Column{UsersColumns[10]},
 				RefColumns: []*schema.Column{ApplicationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "users_roles_personal_role",
-				Columns:    []*schema.Column{UsersColumns[10]},
+				Columns:    []*schema.Column{UsersColumns[11]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -563,7 +885,7 @@ var (
 			{
 				Name:    "user_application_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[9], UsersColumns[4]},
+				Columns: []*schema.Column{UsersColumns[10], UsersColumns[4]},
 			},
 			{
 				Name:    "user_name",
@@ -572,6 +894,34 @@ var (
 			},
 		},
 	}
+	// WebAuthnChallengesColumns holds the columns for the "web_authn_challenges" table.
+	WebAuthnChallengesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"registration", "login", "unknown"}},
+		{Name: "challenge", Type: field.TypeString},
+		{Name: "application_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// WebAuthnChallengesTable holds the schema information for the "web_authn_challenges" table.
+	WebAuthnChallengesTable = &schema.Table{
+		Name:       "web_authn_challenges",
+		Columns:    WebAuthnChallengesColumns,
+		PrimaryKey: []*schema.Column{WebAuthnChallengesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webauthnchallenge_challenge",
+				Unique:  true,
+				Columns: []*schema.Column{WebAuthnChallengesColumns[3]},
+			},
+			{
+				Name:    "webauthnchallenge_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebAuthnChallengesColumns[6]},
+			},
+		},
+	}
 	// WechatOpenIdsColumns holds the columns for the "wechat_open_ids" table.
 	WechatOpenIdsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -609,24 +959,62 @@ var (
 			},
 		},
 	}
+	// WechatScanLoginsColumns holds the columns for the "wechat_scan_logins" table.
+	WechatScanLoginsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "application_id", Type: field.TypeUUID},
+		{Name: "device_type", Type: field.TypeString},
+		{Name: "device_id", Type: field.TypeString},
+		{Name: "poll_token_hash", Type: field.TypeString},
+		{Name: "open_id", Type: field.TypeString, Nullable: true},
+		{Name: "union_id", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// WechatScanLoginsTable holds the schema information for the "wechat_scan_logins" table.
+	WechatScanLoginsTable = &schema.Table{
+		Name:       "wechat_scan_logins",
+		Columns:    WechatScanLoginsColumns,
+		PrimaryKey: []*schema.Column{WechatScanLoginsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wechatscanlogin_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{WechatScanLoginsColumns[8]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ApplicationsTable,
 		BindingsTable,
 		BindingVerifiesTable,
 		DevicesTable,
+		IdentityProvidersTable,
+		LoginLocksTable,
+		MagicLinksTable,
 		MailVertifyCodesTable,
+		OauthAuthorizationCodesTable,
+		OauthConsentsTable,
 		OrganizationsTable,
 		OrganizationApplicationsTable,
 		OrganizationRequestsTable,
 		OrganizationUsersTable,
+		PasskeyCredentialsTable,
 		PaymentsTable,
+		QrLoginsTable,
+		QyWechatCorpsTable,
 		QyWechatUserIdsTable,
 		RolesTable,
+		RotatedRefreshTokensTable,
+		SamlConnectionsTable,
 		ScopesTable,
+		SmsVerifyCodesTable,
 		StripeEventsTable,
 		UsersTable,
+		WebAuthnChallengesTable,
 		WechatOpenIdsTable,
+		WechatScanLoginsTable,
 	}
 )
 
@@ -641,6 +1029,7 @@ func init() {
 	OrganizationUsersTable.ForeignKeys[0].RefTable = OrganizationsTable
 	OrganizationUsersTable.ForeignKeys[1].RefTable = UsersTable
 	OrganizationUsersTable.ForeignKeys[2].RefTable = RolesTable
+	PasskeyCredentialsTable.ForeignKeys[0].RefTable = UsersTable
 	PaymentsTable.ForeignKeys[0].RefTable = UsersTable
 	QyWechatUserIdsTable.ForeignKeys[0].RefTable = UsersTable
 	RolesTable.ForeignKeys[0].RefTable = ApplicationsTable
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthauthorizationcode"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// OAuthAuthorizationCode is the model entity for the OAuthAuthorizationCode schema.
+type OAuthAuthorizationCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"code_hash,omitempty"`
+	// ApplicationID holds the value of the "application_id" field.
+	ApplicationID uuid.UUID `json:"application_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// RedirectURI holds the value of the "redirect_uri" field.
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope string `json:"scope,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce string `json:"nonce,omitempty"`
+	// CodeChallenge holds the value of the "code_challenge" field.
+	CodeChallenge string `json:"code_challenge,omitempty"`
+	// CodeChallengeMethod holds the value of the "code_challenge_method" field.
+	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthAuthorizationCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthauthorizationcode.FieldCodeHash, oauthauthorizationcode.FieldUserID, oauthauthorizationcode.FieldRedirectURI, oauthauthorizationcode.FieldScope, oauthauthorizationcode.FieldNonce, oauthauthorizationcode.FieldCodeChallenge, oauthauthorizationcode.FieldCodeChallengeMethod:
+			values[i] = new(sql.NullString)
+		case oauthauthorizationcode.FieldCreatedAt, oauthauthorizationcode.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case oauthauthorizationcode.FieldID, oauthauthorizationcode.FieldApplicationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthAuthorizationCode fields.
+func (oac *OAuthAuthorizationCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthauthorizationcode.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				oac.ID = *value
+			}
+		case oauthauthorizationcode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oac.CreatedAt = value.Time
+			}
+		case oauthauthorizationcode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				oac.CodeHash = value.String
+			}
+		case oauthauthorizationcode.FieldApplicationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field application_id", values[i])
+			} else if value != nil {
+				oac.ApplicationID = *value
+			}
+		case oauthauthorizationcode.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				oac.UserID = value.String
+			}
+		case oauthauthorizationcode.FieldRedirectURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uri", values[i])
+			} else if value.Valid {
+				oac.RedirectURI = value.String
+			}
+		case oauthauthorizationcode.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				oac.Scope = value.String
+			}
+		case oauthauthorizationcode.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				oac.Nonce = value.String
+			}
+		case oauthauthorizationcode.FieldCodeChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge", values[i])
+			} else if value.Valid {
+				oac.CodeChallenge = value.String
+			}
+		case oauthauthorizationcode.FieldCodeChallengeMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge_method", values[i])
+			} else if value.Valid {
+				oac.CodeChallengeMethod = value.String
+			}
+		case oauthauthorizationcode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				oac.ExpiresAt = value.Time
+			}
+		default:
+			oac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthAuthorizationCode.
+// This includes values selected through modifiers, order, etc.
+func (oac *OAuthAuthorizationCode) Value(name string) (ent.Value, error) {
+	return oac.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OAuthAuthorizationCode.
+// Note that you need to call OAuthAuthorizationCode.Unwrap() before calling this method if this OAuthAuthorizationCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oac *OAuthAuthorizationCode) Update() *OAuthAuthorizationCodeUpdateOne {
+	return NewOAuthAuthorizationCodeClient(oac.config).UpdateOne(oac)
+}
+
+// Unwrap unwraps the OAuthAuthorizationCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oac *OAuthAuthorizationCode) Unwrap() *OAuthAuthorizationCode {
+	_tx, ok := oac.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthAuthorizationCode is not a transactional entity")
+	}
+	oac.config.driver = _tx.drv
+	return oac
+}
+
+// String implements the fmt.Stringer.
+func (oac *OAuthAuthorizationCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthAuthorizationCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oac.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(oac.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=")
+	builder.WriteString(oac.CodeHash)
+	builder.WriteString(", ")
+	builder.WriteString("application_id=")
+	builder.WriteString(fmt.Sprintf("%v", oac.ApplicationID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(oac.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uri=")
+	builder.WriteString(oac.RedirectURI)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(oac.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("nonce=")
+	builder.WriteString(oac.Nonce)
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge=")
+	builder.WriteString(oac.CodeChallenge)
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge_method=")
+	builder.WriteString(oac.CodeChallengeMethod)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(oac.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthAuthorizationCodes is a parsable slice of OAuthAuthorizationCode.
+type OAuthAuthorizationCodes []*OAuthAuthorizationCode
@@ -0,0 +1,716 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthauthorizationcode
+
+import (
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CodeHash applies equality check predicate on the "code_hash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// ApplicationID applies equality check predicate on the "application_id" field. It's identical to ApplicationIDEQ.
+func ApplicationID(v uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldUserID, v))
+}
+
+// RedirectURI applies equality check predicate on the "redirect_uri" field. It's identical to RedirectURIEQ.
+func RedirectURI(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldRedirectURI, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldScope, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldNonce, v))
+}
+
+// CodeChallenge applies equality check predicate on the "code_challenge" field. It's identical to CodeChallengeEQ.
+func CodeChallenge(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeChallenge, v))
+}
+
+// CodeChallengeMethod applies equality check predicate on the "code_challenge_method" field. It's identical to CodeChallengeMethodEQ.
+func CodeChallengeMethod(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeChallengeMethod, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CodeHashEQ applies the EQ predicate on the "code_hash" field.
+func CodeHashEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "code_hash" field.
+func CodeHashNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldCodeHash, v))
+}
+
+// CodeHashIn applies the In predicate on the "code_hash" field.
+func CodeHashIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldCodeHash, vs...))
+}
+
+// CodeHashNotIn applies the NotIn predicate on the "code_hash" field.
+func CodeHashNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldCodeHash, vs...))
+}
+
+// CodeHashGT applies the GT predicate on the "code_hash" field.
+func CodeHashGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldCodeHash, v))
+}
+
+// CodeHashGTE applies the GTE predicate on the "code_hash" field.
+func CodeHashGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldCodeHash, v))
+}
+
+// CodeHashLT applies the LT predicate on the "code_hash" field.
+func CodeHashLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldCodeHash, v))
+}
+
+// CodeHashLTE applies the LTE predicate on the "code_hash" field.
+func CodeHashLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldCodeHash, v))
+}
+
+// CodeHashContains applies the Contains predicate on the "code_hash" field.
+func CodeHashContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldCodeHash, v))
+}
+
+// CodeHashHasPrefix applies the HasPrefix predicate on the "code_hash" field.
+func CodeHashHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldCodeHash, v))
+}
+
+// CodeHashHasSuffix applies the HasSuffix predicate on the "code_hash" field.
+func CodeHashHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldCodeHash, v))
+}
+
+// CodeHashEqualFold applies the EqualFold predicate on the "code_hash" field.
+func CodeHashEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldCodeHash, v))
+}
+
+// CodeHashContainsFold applies the ContainsFold predicate on the "code_hash" field.
+func CodeHashContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldCodeHash, v))
+}
+
+// ApplicationIDEQ applies the EQ predicate on the "application_id" field.
+func ApplicationIDEQ(v uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDNEQ applies the NEQ predicate on the "application_id" field.
+func ApplicationIDNEQ(v uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDIn applies the In predicate on the "application_id" field.
+func ApplicationIDIn(vs ...uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDNotIn applies the NotIn predicate on the "application_id" field.
+func ApplicationIDNotIn(vs ...uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDGT applies the GT predicate on the "application_id" field.
+func ApplicationIDGT(v uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldApplicationID, v))
+}
+
+// ApplicationIDGTE applies the GTE predicate on the "application_id" field.
+func ApplicationIDGTE(v uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldApplicationID, v))
+}
+
+// ApplicationIDLT applies the LT predicate on the "application_id" field.
+func ApplicationIDLT(v uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldApplicationID, v))
+}
+
+// ApplicationIDLTE applies the LTE predicate on the "application_id" field.
+func ApplicationIDLTE(v uuid.UUID) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldApplicationID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// RedirectURIEQ applies the EQ predicate on the "redirect_uri" field.
+func RedirectURIEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldRedirectURI, v))
+}
+
+// RedirectURINEQ applies the NEQ predicate on the "redirect_uri" field.
+func RedirectURINEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldRedirectURI, v))
+}
+
+// RedirectURIIn applies the In predicate on the "redirect_uri" field.
+func RedirectURIIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldRedirectURI, vs...))
+}
+
+// RedirectURINotIn applies the NotIn predicate on the "redirect_uri" field.
+func RedirectURINotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldRedirectURI, vs...))
+}
+
+// RedirectURIGT applies the GT predicate on the "redirect_uri" field.
+func RedirectURIGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldRedirectURI, v))
+}
+
+// RedirectURIGTE applies the GTE predicate on the "redirect_uri" field.
+func RedirectURIGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldRedirectURI, v))
+}
+
+// RedirectURILT applies the LT predicate on the "redirect_uri" field.
+func RedirectURILT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldRedirectURI, v))
+}
+
+// RedirectURILTE applies the LTE predicate on the "redirect_uri" field.
+func RedirectURILTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldRedirectURI, v))
+}
+
+// RedirectURIContains applies the Contains predicate on the "redirect_uri" field.
+func RedirectURIContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldRedirectURI, v))
+}
+
+// RedirectURIHasPrefix applies the HasPrefix predicate on the "redirect_uri" field.
+func RedirectURIHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldRedirectURI, v))
+}
+
+// RedirectURIHasSuffix applies the HasSuffix predicate on the "redirect_uri" field.
+func RedirectURIHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldRedirectURI, v))
+}
+
+// RedirectURIEqualFold applies the EqualFold predicate on the "redirect_uri" field.
+func RedirectURIEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldRedirectURI, v))
+}
+
+// RedirectURIContainsFold applies the ContainsFold predicate on the "redirect_uri" field.
+func RedirectURIContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldRedirectURI, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeIsNil applies the IsNil predicate on the "scope" field.
+func ScopeIsNil() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIsNull(FieldScope))
+}
+
+// ScopeNotNil applies the NotNil predicate on the "scope" field.
+func ScopeNotNil() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotNull(FieldScope))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldScope, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceIsNil applies the IsNil predicate on the "nonce" field.
+func NonceIsNil() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIsNull(FieldNonce))
+}
+
+// NonceNotNil applies the NotNil predicate on the "nonce" field.
+func NonceNotNil() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotNull(FieldNonce))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// CodeChallengeEQ applies the EQ predicate on the "code_challenge" field.
+func CodeChallengeEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeChallenge, v))
+}
+
+// CodeChallengeNEQ applies the NEQ predicate on the "code_challenge" field.
+func CodeChallengeNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldCodeChallenge, v))
+}
+
+// CodeChallengeIn applies the In predicate on the "code_challenge" field.
+func CodeChallengeIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldCodeChallenge, vs...))
+}
+
+// CodeChallengeNotIn applies the NotIn predicate on the "code_challenge" field.
+func CodeChallengeNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldCodeChallenge, vs...))
+}
+
+// CodeChallengeGT applies the GT predicate on the "code_challenge" field.
+func CodeChallengeGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldCodeChallenge, v))
+}
+
+// CodeChallengeGTE applies the GTE predicate on the "code_challenge" field.
+func CodeChallengeGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldCodeChallenge, v))
+}
+
+// CodeChallengeLT applies the LT predicate on the "code_challenge" field.
+func CodeChallengeLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldCodeChallenge, v))
+}
+
+// CodeChallengeLTE applies the LTE predicate on the "code_challenge" field.
+func CodeChallengeLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldCodeChallenge, v))
+}
+
+// CodeChallengeContains applies the Contains predicate on the "code_challenge" field.
+func CodeChallengeContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldCodeChallenge, v))
+}
+
+// CodeChallengeHasPrefix applies the HasPrefix predicate on the "code_challenge" field.
+func CodeChallengeHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldCodeChallenge, v))
+}
+
+// CodeChallengeHasSuffix applies the HasSuffix predicate on the "code_challenge" field.
+func CodeChallengeHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldCodeChallenge, v))
+}
+
+// CodeChallengeEqualFold applies the EqualFold predicate on the "code_challenge" field.
+func CodeChallengeEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldCodeChallenge, v))
+}
+
+// CodeChallengeContainsFold applies the ContainsFold predicate on the "code_challenge" field.
+func CodeChallengeContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldCodeChallenge, v))
+}
+
+// CodeChallengeMethodEQ applies the EQ predicate on the "code_challenge_method" field.
+func CodeChallengeMethodEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodNEQ applies the NEQ predicate on the "code_challenge_method" field.
+func CodeChallengeMethodNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodIn applies the In predicate on the "code_challenge_method" field.
+func CodeChallengeMethodIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldCodeChallengeMethod, vs...))
+}
+
+// CodeChallengeMethodNotIn applies the NotIn predicate on the "code_challenge_method" field.
+func CodeChallengeMethodNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldCodeChallengeMethod, vs...))
+}
+
+// CodeChallengeMethodGT applies the GT predicate on the "code_challenge_method" field.
+func CodeChallengeMethodGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodGTE applies the GTE predicate on the "code_challenge_method" field.
+func CodeChallengeMethodGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodLT applies the LT predicate on the "code_challenge_method" field.
+func CodeChallengeMethodLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodLTE applies the LTE predicate on the "code_challenge_method" field.
+func CodeChallengeMethodLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodContains applies the Contains predicate on the "code_challenge_method" field.
+func CodeChallengeMethodContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodHasPrefix applies the HasPrefix predicate on the "code_challenge_method" field.
+func CodeChallengeMethodHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodHasSuffix applies the HasSuffix predicate on the "code_challenge_method" field.
+func CodeChallengeMethodHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodEqualFold applies the EqualFold predicate on the "code_challenge_method" field.
+func CodeChallengeMethodEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodContainsFold applies the ContainsFold predicate on the "code_challenge_method" field.
+func CodeChallengeMethodContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldCodeChallengeMethod, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OAuthAuthorizationCode) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OAuthAuthorizationCode) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OAuthAuthorizationCode) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.NotPredicates(p))
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthauthorizationcode"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OAuthAuthorizationCodeCreate is the builder for creating a OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeCreate struct {
+	config
+	mutation *OAuthAuthorizationCodeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetCreatedAt(t time.Time) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetCreatedAt(t)
+	return oacc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oacc *OAuthAuthorizationCodeCreate) SetNillableCreatedAt(t *time.Time) *OAuthAuthorizationCodeCreate {
+	if t != nil {
+		oacc.SetCreatedAt(*t)
+	}
+	return oacc
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetCodeHash(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetCodeHash(s)
+	return oacc
+}
+
+// SetApplicationID sets the "application_id" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetApplicationID(u uuid.UUID) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetApplicationID(u)
+	return oacc
+}
+
+// SetUserID sets the "user_id" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetUserID(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetUserID(s)
+	return oacc
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetRedirectURI(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetRedirectURI(s)
+	return oacc
+}
+
+// SetScope sets the "scope" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetScope(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetScope(s)
+	return oacc
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (oacc *OAuthAuthorizationCodeCreate) SetNillableScope(s *string) *OAuthAuthorizationCodeCreate {
+	if s != nil {
+		oacc.SetScope(*s)
+	}
+	return oacc
+}
+
+// SetNonce sets the "nonce" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetNonce(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetNonce(s)
+	return oacc
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (oacc *OAuthAuthorizationCodeCreate) SetNillableNonce(s *string) *OAuthAuthorizationCodeCreate {
+	if s != nil {
+		oacc.SetNonce(*s)
+	}
+	return oacc
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetCodeChallenge(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetCodeChallenge(s)
+	return oacc
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetCodeChallengeMethod(s string) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetCodeChallengeMethod(s)
+	return oacc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetExpiresAt(t time.Time) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetExpiresAt(t)
+	return oacc
+}
+
+// SetID sets the "id" field.
+func (oacc *OAuthAuthorizationCodeCreate) SetID(u uuid.UUID) *OAuthAuthorizationCodeCreate {
+	oacc.mutation.SetID(u)
+	return oacc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (oacc *OAuthAuthorizationCodeCreate) SetNillableID(u *uuid.UUID) *OAuthAuthorizationCodeCreate {
+	if u != nil {
+		oacc.SetID(*u)
+	}
+	return oacc
+}
+
+// Mutation returns the OAuthAuthorizationCodeMutation object of the builder.
+func (oacc *OAuthAuthorizationCodeCreate) Mutation() *OAuthAuthorizationCodeMutation {
+	return oacc.mutation
+}
+
+// Save creates the OAuthAuthorizationCode in the database.
+func (oacc *OAuthAuthorizationCodeCreate) Save(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	oacc.defaults()
+	return withHooks(ctx, oacc.sqlSave, oacc.mutation, oacc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oacc *OAuthAuthorizationCodeCreate) SaveX(ctx context.Context) *OAuthAuthorizationCode {
+	v, err := oacc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oacc *OAuthAuthorizationCodeCreate) Exec(ctx context.Context) error {
+	_, err := oacc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacc *OAuthAuthorizationCodeCreate) ExecX(ctx context.Context) {
+	if err := oacc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oacc *OAuthAuthorizationCodeCreate) defaults() {
+	if _, ok := oacc.mutation.CreatedAt(); !ok {
+		v := oauthauthorizationcode.DefaultCreatedAt()
+		oacc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oacc.mutation.ID(); !ok {
+		v := oauthauthorizationcode.DefaultID()
+		oacc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oacc *OAuthAuthorizationCodeCreate) check() error {
+	if _, ok := oacc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.created_at"`)}
+	}
+	if _, ok := oacc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.code_hash"`)}
+	}
+	if v, ok := oacc.mutation.CodeHash(); ok {
+		if err := oauthauthorizationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application_id", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.application_id"`)}
+	}
+	if _, ok := oacc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.user_id"`)}
+	}
+	if v, ok := oacc.mutation.UserID(); ok {
+		if err := oauthauthorizationcode.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.user_id": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.RedirectURI(); !ok {
+		return &ValidationError{Name: "redirect_uri", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.redirect_uri"`)}
+	}
+	if v, ok := oacc.mutation.RedirectURI(); ok {
+		if err := oauthauthorizationcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.redirect_uri": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.CodeChallenge(); !ok {
+		return &ValidationError{Name: "code_challenge", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.code_challenge"`)}
+	}
+	if v, ok := oacc.mutation.CodeChallenge(); ok {
+		if err := oauthauthorizationcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.CodeChallengeMethod(); !ok {
+		return &ValidationError{Name: "code_challenge_method", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.code_challenge_method"`)}
+	}
+	if v, ok := oacc.mutation.CodeChallengeMethod(); ok {
+		if err := oauthauthorizationcode.CodeChallengeMethodValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge_method", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge_method": %w`, err)}
+		}
+	}
+	if _, ok := oacc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.expires_at"`)}
+	}
+	return nil
+}
+
+func (oacc *OAuthAuthorizationCodeCreate) sqlSave(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	if err := oacc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oacc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oacc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	oacc.mutation.id = &_node.ID
+	oacc.mutation.done = true
+	return _node, nil
+}
+
+func (oacc *OAuthAuthorizationCodeCreate) createSpec() (*OAuthAuthorizationCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthAuthorizationCode{config: oacc.config}
+		_spec = sqlgraph.NewCreateSpec(oauthauthorizationcode.Table, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeUUID))
+	)
+	if id, ok := oacc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := oacc.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oacc.mutation.CodeHash(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := oacc.mutation.ApplicationID(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldApplicationID, field.TypeUUID, value)
+		_node.ApplicationID = value
+	}
+	if value, ok := oacc.mutation.UserID(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := oacc.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldRedirectURI, field.TypeString, value)
+		_node.RedirectURI = value
+	}
+	if value, ok := oacc.mutation.Scope(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := oacc.mutation.Nonce(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := oacc.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString, value)
+		_node.CodeChallenge = value
+	}
+	if value, ok := oacc.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+		_node.CodeChallengeMethod = value
+	}
+	if value, ok := oacc.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// OAuthAuthorizationCodeCreateBulk is the builder for creating many OAuthAuthorizationCode entities in bulk.
+type OAuthAuthorizationCodeCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthAuthorizationCodeCreate
+}
+
+// Save creates the OAuthAuthorizationCode entities in the database.
+func (oaccb *OAuthAuthorizationCodeCreateBulk) Save(ctx context.Context) ([]*OAuthAuthorizationCode, error) {
+	if oaccb.err != nil {
+		return nil, oaccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oaccb.builders))
+	nodes := make([]*OAuthAuthorizationCode, len(oaccb.builders))
+	mutators := make([]Mutator, len(oaccb.builders))
+	for i := range oaccb.builders {
+		func(i int, root context.Context) {
+			builder := oaccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthAuthorizationCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oaccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oaccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oaccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oaccb *OAuthAuthorizationCodeCreateBulk) SaveX(ctx context.Context) []*OAuthAuthorizationCode {
+	v, err := oaccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oaccb *OAuthAuthorizationCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := oaccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oaccb *OAuthAuthorizationCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := oaccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,585 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthauthorizationcode"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OAuthAuthorizationCodeUpdate is the builder for updating OAuthAuthorizationCode entities.
+type OAuthAuthorizationCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OAuthAuthorizationCodeMutation
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeUpdate builder.
+func (oacu *OAuthAuthorizationCodeUpdate) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.Where(ps...)
+	return oacu
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetCodeHash(s string) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetCodeHash(s)
+	return oacu
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableCodeHash(s *string) *OAuthAuthorizationCodeUpdate {
+	if s != nil {
+		oacu.SetCodeHash(*s)
+	}
+	return oacu
+}
+
+// SetApplicationID sets the "application_id" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetApplicationID(u uuid.UUID) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetApplicationID(u)
+	return oacu
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableApplicationID(u *uuid.UUID) *OAuthAuthorizationCodeUpdate {
+	if u != nil {
+		oacu.SetApplicationID(*u)
+	}
+	return oacu
+}
+
+// SetUserID sets the "user_id" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetUserID(s string) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetUserID(s)
+	return oacu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableUserID(s *string) *OAuthAuthorizationCodeUpdate {
+	if s != nil {
+		oacu.SetUserID(*s)
+	}
+	return oacu
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetRedirectURI(s string) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetRedirectURI(s)
+	return oacu
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableRedirectURI(s *string) *OAuthAuthorizationCodeUpdate {
+	if s != nil {
+		oacu.SetRedirectURI(*s)
+	}
+	return oacu
+}
+
+// SetScope sets the "scope" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetScope(s string) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetScope(s)
+	return oacu
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableScope(s *string) *OAuthAuthorizationCodeUpdate {
+	if s != nil {
+		oacu.SetScope(*s)
+	}
+	return oacu
+}
+
+// ClearScope clears the value of the "scope" field.
+func (oacu *OAuthAuthorizationCodeUpdate) ClearScope() *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.ClearScope()
+	return oacu
+}
+
+// SetNonce sets the "nonce" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNonce(s string) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetNonce(s)
+	return oacu
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableNonce(s *string) *OAuthAuthorizationCodeUpdate {
+	if s != nil {
+		oacu.SetNonce(*s)
+	}
+	return oacu
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (oacu *OAuthAuthorizationCodeUpdate) ClearNonce() *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.ClearNonce()
+	return oacu
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetCodeChallenge(s string) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetCodeChallenge(s)
+	return oacu
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableCodeChallenge(s *string) *OAuthAuthorizationCodeUpdate {
+	if s != nil {
+		oacu.SetCodeChallenge(*s)
+	}
+	return oacu
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetCodeChallengeMethod(s string) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetCodeChallengeMethod(s)
+	return oacu
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableCodeChallengeMethod(s *string) *OAuthAuthorizationCodeUpdate {
+	if s != nil {
+		oacu.SetCodeChallengeMethod(*s)
+	}
+	return oacu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (oacu *OAuthAuthorizationCodeUpdate) SetExpiresAt(t time.Time) *OAuthAuthorizationCodeUpdate {
+	oacu.mutation.SetExpiresAt(t)
+	return oacu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (oacu *OAuthAuthorizationCodeUpdate) SetNillableExpiresAt(t *time.Time) *OAuthAuthorizationCodeUpdate {
+	if t != nil {
+		oacu.SetExpiresAt(*t)
+	}
+	return oacu
+}
+
+// Mutation returns the OAuthAuthorizationCodeMutation object of the builder.
+func (oacu *OAuthAuthorizationCodeUpdate) Mutation() *OAuthAuthorizationCodeMutation {
+	return oacu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oacu *OAuthAuthorizationCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oacu.sqlSave, oacu.mutation, oacu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oacu *OAuthAuthorizationCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := oacu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oacu *OAuthAuthorizationCodeUpdate) Exec(ctx context.Context) error {
+	_, err := oacu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacu *OAuthAuthorizationCodeUpdate) ExecX(ctx context.Context) {
+	if err := oacu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oacu *OAuthAuthorizationCodeUpdate) check() error {
+	if v, ok := oacu.mutation.CodeHash(); ok {
+		if err := oauthauthorizationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_hash": %w`, err)}
+		}
+	}
+	if v, ok := oacu.mutation.UserID(); ok {
+		if err := oauthauthorizationcode.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.user_id": %w`, err)}
+		}
+	}
+	if v, ok := oacu.mutation.RedirectURI(); ok {
+		if err := oauthauthorizationcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.redirect_uri": %w`, err)}
+		}
+	}
+	if v, ok := oacu.mutation.CodeChallenge(); ok {
+		if err := oauthauthorizationcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge": %w`, err)}
+		}
+	}
+	if v, ok := oacu.mutation.CodeChallengeMethod(); ok {
+		if err := oauthauthorizationcode.CodeChallengeMethodValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge_method", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge_method": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oacu *OAuthAuthorizationCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oacu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeUUID))
+	if ps := oacu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oacu.mutation.CodeHash(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := oacu.mutation.ApplicationID(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := oacu.mutation.UserID(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := oacu.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := oacu.mutation.Scope(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldScope, field.TypeString, value)
+	}
+	if oacu.mutation.ScopeCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldScope, field.TypeString)
+	}
+	if value, ok := oacu.mutation.Nonce(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldNonce, field.TypeString, value)
+	}
+	if oacu.mutation.NonceCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldNonce, field.TypeString)
+	}
+	if value, ok := oacu.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString, value)
+	}
+	if value, ok := oacu.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if value, ok := oacu.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oacu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthauthorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oacu.mutation.done = true
+	return n, nil
+}
+
+// OAuthAuthorizationCodeUpdateOne is the builder for updating a single OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OAuthAuthorizationCodeMutation
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetCodeHash(s string) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetCodeHash(s)
+	return oacuo
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableCodeHash(s *string) *OAuthAuthorizationCodeUpdateOne {
+	if s != nil {
+		oacuo.SetCodeHash(*s)
+	}
+	return oacuo
+}
+
+// SetApplicationID sets the "application_id" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetApplicationID(u uuid.UUID) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetApplicationID(u)
+	return oacuo
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableApplicationID(u *uuid.UUID) *OAuthAuthorizationCodeUpdateOne {
+	if u != nil {
+		oacuo.SetApplicationID(*u)
+	}
+	return oacuo
+}
+
+// SetUserID sets the "user_id" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetUserID(s string) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetUserID(s)
+	return oacuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableUserID(s *string) *OAuthAuthorizationCodeUpdateOne {
+	if s != nil {
+		oacuo.SetUserID(*s)
+	}
+	return oacuo
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetRedirectURI(s string) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetRedirectURI(s)
+	return oacuo
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableRedirectURI(s *string) *OAuthAuthorizationCodeUpdateOne {
+	if s != nil {
+		oacuo.SetRedirectURI(*s)
+	}
+	return oacuo
+}
+
+// SetScope sets the "scope" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetScope(s string) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetScope(s)
+	return oacuo
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableScope(s *string) *OAuthAuthorizationCodeUpdateOne {
+	if s != nil {
+		oacuo.SetScope(*s)
+	}
+	return oacuo
+}
+
+// ClearScope clears the value of the "scope" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) ClearScope() *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.ClearScope()
+	return oacuo
+}
+
+// SetNonce sets the "nonce" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNonce(s string) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetNonce(s)
+	return oacuo
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableNonce(s *string) *OAuthAuthorizationCodeUpdateOne {
+	if s != nil {
+		oacuo.SetNonce(*s)
+	}
+	return oacuo
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) ClearNonce() *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.ClearNonce()
+	return oacuo
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetCodeChallenge(s string) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetCodeChallenge(s)
+	return oacuo
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableCodeChallenge(s *string) *OAuthAuthorizationCodeUpdateOne {
+	if s != nil {
+		oacuo.SetCodeChallenge(*s)
+	}
+	return oacuo
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetCodeChallengeMethod(s string) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetCodeChallengeMethod(s)
+	return oacuo
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableCodeChallengeMethod(s *string) *OAuthAuthorizationCodeUpdateOne {
+	if s != nil {
+		oacuo.SetCodeChallengeMethod(*s)
+	}
+	return oacuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetExpiresAt(t time.Time) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.SetExpiresAt(t)
+	return oacuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SetNillableExpiresAt(t *time.Time) *OAuthAuthorizationCodeUpdateOne {
+	if t != nil {
+		oacuo.SetExpiresAt(*t)
+	}
+	return oacuo
+}
+
+// Mutation returns the OAuthAuthorizationCodeMutation object of the builder.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Mutation() *OAuthAuthorizationCodeMutation {
+	return oacuo.mutation
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeUpdate builder.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.mutation.Where(ps...)
+	return oacuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Select(field string, fields ...string) *OAuthAuthorizationCodeUpdateOne {
+	oacuo.fields = append([]string{field}, fields...)
+	return oacuo
+}
+
+// Save executes the query and returns the updated OAuthAuthorizationCode entity.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Save(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	return withHooks(ctx, oacuo.sqlSave, oacuo.mutation, oacuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) SaveX(ctx context.Context) *OAuthAuthorizationCode {
+	node, err := oacuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := oacuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := oacuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oacuo *OAuthAuthorizationCodeUpdateOne) check() error {
+	if v, ok := oacuo.mutation.CodeHash(); ok {
+		if err := oauthauthorizationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_hash": %w`, err)}
+		}
+	}
+	if v, ok := oacuo.mutation.UserID(); ok {
+		if err := oauthauthorizationcode.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.user_id": %w`, err)}
+		}
+	}
+	if v, ok := oacuo.mutation.RedirectURI(); ok {
+		if err := oauthauthorizationcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.redirect_uri": %w`, err)}
+		}
+	}
+	if v, ok := oacuo.mutation.CodeChallenge(); ok {
+		if err := oauthauthorizationcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge": %w`, err)}
+		}
+	}
+	if v, ok := oacuo.mutation.CodeChallengeMethod(); ok {
+		if err := oauthauthorizationcode.CodeChallengeMethodValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge_method", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge_method": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oacuo *OAuthAuthorizationCodeUpdateOne) sqlSave(ctx context.Context) (_node *OAuthAuthorizationCode, err error) {
+	if err := oacuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeUUID))
+	id, ok := oacuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthAuthorizationCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oacuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthauthorizationcode.FieldID)
+		for _, f := range fields {
+			if !oauthauthorizationcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthauthorizationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oacuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oacuo.mutation.CodeHash(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := oacuo.mutation.ApplicationID(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := oacuo.mutation.UserID(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := oacuo.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := oacuo.mutation.Scope(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldScope, field.TypeString, value)
+	}
+	if oacuo.mutation.ScopeCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldScope, field.TypeString)
+	}
+	if value, ok := oacuo.mutation.Nonce(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldNonce, field.TypeString, value)
+	}
+	if oacuo.mutation.NonceCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldNonce, field.TypeString)
+	}
+	if value, ok := oacuo.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString, value)
+	}
+	if value, ok := oacuo.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if value, ok := oacuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &OAuthAuthorizationCode{config: oacuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oacuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthauthorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oacuo.mutation.done = true
+	return _node, nil
+}
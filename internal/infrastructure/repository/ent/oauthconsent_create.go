@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthconsent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OAuthConsentCreate is the builder for creating a OAuthConsent entity.
+type OAuthConsentCreate struct {
+	config
+	mutation *OAuthConsentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (occ *OAuthConsentCreate) SetCreatedAt(t time.Time) *OAuthConsentCreate {
+	occ.mutation.SetCreatedAt(t)
+	return occ
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (occ *OAuthConsentCreate) SetNillableCreatedAt(t *time.Time) *OAuthConsentCreate {
+	if t != nil {
+		occ.SetCreatedAt(*t)
+	}
+	return occ
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (occ *OAuthConsentCreate) SetUpdatedAt(t time.Time) *OAuthConsentCreate {
+	occ.mutation.SetUpdatedAt(t)
+	return occ
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (occ *OAuthConsentCreate) SetNillableUpdatedAt(t *time.Time) *OAuthConsentCreate {
+	if t != nil {
+		occ.SetUpdatedAt(*t)
+	}
+	return occ
+}
+
+// SetApplicationID sets the "application_id" field.
+func (occ *OAuthConsentCreate) SetApplicationID(u uuid.UUID) *OAuthConsentCreate {
+	occ.mutation.SetApplicationID(u)
+	return occ
+}
+
+// SetUserID sets the "user_id" field.
+func (occ *OAuthConsentCreate) SetUserID(s string) *OAuthConsentCreate {
+	occ.mutation.SetUserID(s)
+	return occ
+}
+
+// SetScope sets the "scope" field.
+func (occ *OAuthConsentCreate) SetScope(s string) *OAuthConsentCreate {
+	occ.mutation.SetScope(s)
+	return occ
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (occ *OAuthConsentCreate) SetNillableScope(s *string) *OAuthConsentCreate {
+	if s != nil {
+		occ.SetScope(*s)
+	}
+	return occ
+}
+
+// SetID sets the "id" field.
+func (occ *OAuthConsentCreate) SetID(u uuid.UUID) *OAuthConsentCreate {
+	occ.mutation.SetID(u)
+	return occ
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (occ *OAuthConsentCreate) SetNillableID(u *uuid.UUID) *OAuthConsentCreate {
+	if u != nil {
+		occ.SetID(*u)
+	}
+	return occ
+}
+
+// Mutation returns the OAuthConsentMutation object of the builder.
+func (occ *OAuthConsentCreate) Mutation() *OAuthConsentMutation {
+	return occ.mutation
+}
+
+// Save creates the OAuthConsent in the database.
+func (occ *OAuthConsentCreate) Save(ctx context.Context) (*OAuthConsent, error) {
+	occ.defaults()
+	return withHooks(ctx, occ.sqlSave, occ.mutation, occ.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (occ *OAuthConsentCreate) SaveX(ctx context.Context) *OAuthConsent {
+	v, err := occ.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occ *OAuthConsentCreate) Exec(ctx context.Context) error {
+	_, err := occ.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occ *OAuthConsentCreate) ExecX(ctx context.Context) {
+	if err := occ.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (occ *OAuthConsentCreate) defaults() {
+	if _, ok := occ.mutation.CreatedAt(); !ok {
+		v := oauthconsent.DefaultCreatedAt()
+		occ.mutation.SetCreatedAt(v)
+	}
+	if _, ok := occ.mutation.UpdatedAt(); !ok {
+		v := oauthconsent.DefaultUpdatedAt()
+		occ.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := occ.mutation.ID(); !ok {
+		v := oauthconsent.DefaultID()
+		occ.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (occ *OAuthConsentCreate) check() error {
+	if _, ok := occ.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OAuthConsent.created_at"`)}
+	}
+	if _, ok := occ.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OAuthConsent.updated_at"`)}
+	}
+	if _, ok := occ.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application_id", err: errors.New(`ent: missing required field "OAuthConsent.application_id"`)}
+	}
+	if _, ok := occ.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OAuthConsent.user_id"`)}
+	}
+	if v, ok := occ.mutation.UserID(); ok {
+		if err := oauthconsent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OAuthConsent.user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (occ *OAuthConsentCreate) sqlSave(ctx context.Context) (*OAuthConsent, error) {
+	if err := occ.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := occ.createSpec()
+	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	occ.mutation.id = &_node.ID
+	occ.mutation.done = true
+	return _node, nil
+}
+
+func (occ *OAuthConsentCreate) createSpec() (*OAuthConsent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthConsent{config: occ.config}
+		_spec = sqlgraph.NewCreateSpec(oauthconsent.Table, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeUUID))
+	)
+	if id, ok := occ.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := occ.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthconsent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := occ.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthconsent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := occ.mutation.ApplicationID(); ok {
+		_spec.SetField(oauthconsent.FieldApplicationID, field.TypeUUID, value)
+		_node.ApplicationID = value
+	}
+	if value, ok := occ.mutation.UserID(); ok {
+		_spec.SetField(oauthconsent.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := occ.mutation.Scope(); ok {
+		_spec.SetField(oauthconsent.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	return _node, _spec
+}
+
+// OAuthConsentCreateBulk is the builder for creating many OAuthConsent entities in bulk.
+type OAuthConsentCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthConsentCreate
+}
+
+// Save creates the OAuthConsent entities in the database.
+func (occb *OAuthConsentCreateBulk) Save(ctx context.Context) ([]*OAuthConsent, error) {
+	if occb.err != nil {
+		return nil, occb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
+	nodes := make([]*OAuthConsent, len(occb.builders))
+	mutators := make([]Mutator, len(occb.builders))
+	for i := range occb.builders {
+		func(i int, root context.Context) {
+			builder := occb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthConsentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, occb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, occb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (occb *OAuthConsentCreateBulk) SaveX(ctx context.Context) []*OAuthConsent {
+	v, err := occb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occb *OAuthConsentCreateBulk) Exec(ctx context.Context) error {
+	_, err := occb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occb *OAuthConsentCreateBulk) ExecX(ctx context.Context) {
+	if err := occb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthconsent"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthConsentDelete is the builder for deleting a OAuthConsent entity.
+type OAuthConsentDelete struct {
+	config
+	hooks    []Hook
+	mutation *OAuthConsentMutation
+}
+
+// Where appends a list predicates to the OAuthConsentDelete builder.
+func (ocd *OAuthConsentDelete) Where(ps ...predicate.OAuthConsent) *OAuthConsentDelete {
+	ocd.mutation.Where(ps...)
+	return ocd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ocd *OAuthConsentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ocd.sqlExec, ocd.mutation, ocd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocd *OAuthConsentDelete) ExecX(ctx context.Context) int {
+	n, err := ocd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ocd *OAuthConsentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(oauthconsent.Table, sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeUUID))
+	if ps := ocd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ocd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ocd.mutation.done = true
+	return affected, err
+}
+
+// OAuthConsentDeleteOne is the builder for deleting a single OAuthConsent entity.
+type OAuthConsentDeleteOne struct {
+	ocd *OAuthConsentDelete
+}
+
+// Where appends a list predicates to the OAuthConsentDelete builder.
+func (ocdo *OAuthConsentDeleteOne) Where(ps ...predicate.OAuthConsent) *OAuthConsentDeleteOne {
+	ocdo.ocd.mutation.Where(ps...)
+	return ocdo
+}
+
+// Exec executes the deletion query.
+func (ocdo *OAuthConsentDeleteOne) Exec(ctx context.Context) error {
+	n, err := ocdo.ocd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{oauthconsent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocdo *OAuthConsentDeleteOne) ExecX(ctx context.Context) {
+	if err := ocdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
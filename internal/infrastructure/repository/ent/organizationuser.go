@@ -31,6 +31,8 @@ type OrganizationUser struct {
 	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
+	// Department holds the value of the "department" field.
+	Department string `json:"department,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrganizationUserQuery when eager-loading is set.
 	Edges                  OrganizationUserEdges `json:"edges"`
@@ -89,7 +91,7 @@ func (*OrganizationUser) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case organizationuser.FieldUserID:
+		case organizationuser.FieldUserID, organizationuser.FieldDepartment:
 			values[i] = new(sql.NullString)
 		case organizationuser.FieldCreatedAt, organizationuser.FieldUpdatedAt, organizationuser.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -148,6 +150,12 @@ func (ou *OrganizationUser) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ou.UserID = value.String
 			}
+		case organizationuser.FieldDepartment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field department", values[i])
+			} else if value.Valid {
+				ou.Department = value.String
+			}
 		case organizationuser.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field organization_user_role", values[i])
@@ -220,6 +228,9 @@ func (ou *OrganizationUser) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(ou.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("department=")
+	builder.WriteString(ou.Department)
 	builder.WriteByte(')')
 	return builder.String()
 }
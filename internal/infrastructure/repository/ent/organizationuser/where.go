@@ -81,6 +81,11 @@ func UserID(v string) predicate.OrganizationUser {
 	return predicate.OrganizationUser(sql.FieldEQ(FieldUserID, v))
 }
 
+// Department applies equality check predicate on the "department" field. It's identical to DepartmentEQ.
+func Department(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldEQ(FieldDepartment, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.OrganizationUser {
 	return predicate.OrganizationUser(sql.FieldEQ(FieldCreatedAt, v))
@@ -296,6 +301,81 @@ func UserIDContainsFold(v string) predicate.OrganizationUser {
 	return predicate.OrganizationUser(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// DepartmentEQ applies the EQ predicate on the "department" field.
+func DepartmentEQ(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldEQ(FieldDepartment, v))
+}
+
+// DepartmentNEQ applies the NEQ predicate on the "department" field.
+func DepartmentNEQ(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldNEQ(FieldDepartment, v))
+}
+
+// DepartmentIn applies the In predicate on the "department" field.
+func DepartmentIn(vs ...string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldIn(FieldDepartment, vs...))
+}
+
+// DepartmentNotIn applies the NotIn predicate on the "department" field.
+func DepartmentNotIn(vs ...string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldNotIn(FieldDepartment, vs...))
+}
+
+// DepartmentGT applies the GT predicate on the "department" field.
+func DepartmentGT(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldGT(FieldDepartment, v))
+}
+
+// DepartmentGTE applies the GTE predicate on the "department" field.
+func DepartmentGTE(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldGTE(FieldDepartment, v))
+}
+
+// DepartmentLT applies the LT predicate on the "department" field.
+func DepartmentLT(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldLT(FieldDepartment, v))
+}
+
+// DepartmentLTE applies the LTE predicate on the "department" field.
+func DepartmentLTE(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldLTE(FieldDepartment, v))
+}
+
+// DepartmentContains applies the Contains predicate on the "department" field.
+func DepartmentContains(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldContains(FieldDepartment, v))
+}
+
+// DepartmentHasPrefix applies the HasPrefix predicate on the "department" field.
+func DepartmentHasPrefix(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldHasPrefix(FieldDepartment, v))
+}
+
+// DepartmentHasSuffix applies the HasSuffix predicate on the "department" field.
+func DepartmentHasSuffix(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldHasSuffix(FieldDepartment, v))
+}
+
+// DepartmentIsNil applies the IsNil predicate on the "department" field.
+func DepartmentIsNil() predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldIsNull(FieldDepartment))
+}
+
+// DepartmentNotNil applies the NotNil predicate on the "department" field.
+func DepartmentNotNil() predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldNotNull(FieldDepartment))
+}
+
+// DepartmentEqualFold applies the EqualFold predicate on the "department" field.
+func DepartmentEqualFold(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldEqualFold(FieldDepartment, v))
+}
+
+// DepartmentContainsFold applies the ContainsFold predicate on the "department" field.
+func DepartmentContainsFold(v string) predicate.OrganizationUser {
+	return predicate.OrganizationUser(sql.FieldContainsFold(FieldDepartment, v))
+}
+
 // HasOrganization applies the HasEdge predicate on the "organization" edge.
 func HasOrganization() predicate.OrganizationUser {
 	return predicate.OrganizationUser(func(s *sql.Selector) {
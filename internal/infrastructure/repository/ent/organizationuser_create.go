@@ -78,6 +78,20 @@ func (ouc *OrganizationUserCreate) SetUserID(s string) *OrganizationUserCreate {
 	return ouc
 }
 
+// SetDepartment sets the "department" field.
+func (ouc *OrganizationUserCreate) SetDepartment(s string) *OrganizationUserCreate {
+	ouc.mutation.SetDepartment(s)
+	return ouc
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (ouc *OrganizationUserCreate) SetNillableDepartment(s *string) *OrganizationUserCreate {
+	if s != nil {
+		ouc.SetDepartment(*s)
+	}
+	return ouc
+}
+
 // SetID sets the "id" field.
 func (ouc *OrganizationUserCreate) SetID(u uuid.UUID) *OrganizationUserCreate {
 	ouc.mutation.SetID(u)
@@ -237,6 +251,10 @@ func (ouc *OrganizationUserCreate) createSpec() (*OrganizationUser, *sqlgraph.Cr
 		_spec.SetField(organizationuser.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
 	}
+	if value, ok := ouc.mutation.Department(); ok {
+		_spec.SetField(organizationuser.FieldDepartment, field.TypeString, value)
+		_node.Department = value
+	}
 	if nodes := ouc.mutation.OrganizationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
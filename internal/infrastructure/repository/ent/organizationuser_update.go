@@ -86,6 +86,26 @@ func (ouu *OrganizationUserUpdate) SetNillableUserID(s *string) *OrganizationUse
 	return ouu
 }
 
+// SetDepartment sets the "department" field.
+func (ouu *OrganizationUserUpdate) SetDepartment(s string) *OrganizationUserUpdate {
+	ouu.mutation.SetDepartment(s)
+	return ouu
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (ouu *OrganizationUserUpdate) SetNillableDepartment(s *string) *OrganizationUserUpdate {
+	if s != nil {
+		ouu.SetDepartment(*s)
+	}
+	return ouu
+}
+
+// ClearDepartment clears the value of the "department" field.
+func (ouu *OrganizationUserUpdate) ClearDepartment() *OrganizationUserUpdate {
+	ouu.mutation.ClearDepartment()
+	return ouu
+}
+
 // SetOrganization sets the "organization" edge to the Organization entity.
 func (ouu *OrganizationUserUpdate) SetOrganization(o *Organization) *OrganizationUserUpdate {
 	return ouu.SetOrganizationID(o.ID)
@@ -206,6 +226,12 @@ func (ouu *OrganizationUserUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if ouu.mutation.DeletedAtCleared() {
 		_spec.ClearField(organizationuser.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := ouu.mutation.Department(); ok {
+		_spec.SetField(organizationuser.FieldDepartment, field.TypeString, value)
+	}
+	if ouu.mutation.DepartmentCleared() {
+		_spec.ClearField(organizationuser.FieldDepartment, field.TypeString)
+	}
 	if ouu.mutation.OrganizationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -367,6 +393,26 @@ func (ouuo *OrganizationUserUpdateOne) SetNillableUserID(s *string) *Organizatio
 	return ouuo
 }
 
+// SetDepartment sets the "department" field.
+func (ouuo *OrganizationUserUpdateOne) SetDepartment(s string) *OrganizationUserUpdateOne {
+	ouuo.mutation.SetDepartment(s)
+	return ouuo
+}
+
+// SetNillableDepartment sets the "department" field if the given value is not nil.
+func (ouuo *OrganizationUserUpdateOne) SetNillableDepartment(s *string) *OrganizationUserUpdateOne {
+	if s != nil {
+		ouuo.SetDepartment(*s)
+	}
+	return ouuo
+}
+
+// ClearDepartment clears the value of the "department" field.
+func (ouuo *OrganizationUserUpdateOne) ClearDepartment() *OrganizationUserUpdateOne {
+	ouuo.mutation.ClearDepartment()
+	return ouuo
+}
+
 // SetOrganization sets the "organization" edge to the Organization entity.
 func (ouuo *OrganizationUserUpdateOne) SetOrganization(o *Organization) *OrganizationUserUpdateOne {
 	return ouuo.SetOrganizationID(o.ID)
@@ -517,6 +563,12 @@ func (ouuo *OrganizationUserUpdateOne) sqlSave(ctx context.Context) (_node *Orga
 	if ouuo.mutation.DeletedAtCleared() {
 		_spec.ClearField(organizationuser.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := ouuo.mutation.Department(); ok {
+		_spec.SetField(organizationuser.FieldDepartment, field.TypeString, value)
+	}
+	if ouuo.mutation.DepartmentCleared() {
+		_spec.ClearField(organizationuser.FieldDepartment, field.TypeString)
+	}
 	if ouuo.mutation.OrganizationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
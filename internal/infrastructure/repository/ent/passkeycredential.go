@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
+	"kiwi-user/internal/infrastructure/repository/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PasskeyCredential is the model entity for the PasskeyCredential schema.
+type PasskeyCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// CredentialID holds the value of the "credential_id" field.
+	CredentialID string `json:"credential_id,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey []byte `json:"public_key,omitempty"`
+	// SignCount holds the value of the "sign_count" field.
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// Transports holds the value of the "transports" field.
+	Transports []string `json:"transports,omitempty"`
+	// Aaguid holds the value of the "aaguid" field.
+	Aaguid string `json:"aaguid,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt time.Time `json:"last_used_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PasskeyCredentialQuery when eager-loading is set.
+	Edges        PasskeyCredentialEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PasskeyCredentialEdges holds the relations/edges for other nodes in the graph.
+type PasskeyCredentialEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PasskeyCredentialEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PasskeyCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case passkeycredential.FieldPublicKey, passkeycredential.FieldTransports:
+			values[i] = new([]byte)
+		case passkeycredential.FieldSignCount:
+			values[i] = new(sql.NullInt64)
+		case passkeycredential.FieldUserID, passkeycredential.FieldCredentialID, passkeycredential.FieldAaguid, passkeycredential.FieldName:
+			values[i] = new(sql.NullString)
+		case passkeycredential.FieldCreatedAt, passkeycredential.FieldUpdatedAt, passkeycredential.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		case passkeycredential.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PasskeyCredential fields.
+func (pc *PasskeyCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case passkeycredential.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pc.ID = *value
+			}
+		case passkeycredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pc.CreatedAt = value.Time
+			}
+		case passkeycredential.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pc.UpdatedAt = value.Time
+			}
+		case passkeycredential.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pc.UserID = value.String
+			}
+		case passkeycredential.FieldCredentialID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_id", values[i])
+			} else if value.Valid {
+				pc.CredentialID = value.String
+			}
+		case passkeycredential.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				pc.PublicKey = *value
+			}
+		case passkeycredential.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				pc.SignCount = uint32(value.Int64)
+			}
+		case passkeycredential.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pc.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case passkeycredential.FieldAaguid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value.Valid {
+				pc.Aaguid = value.String
+			}
+		case passkeycredential.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pc.Name = value.String
+			}
+		case passkeycredential.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				pc.LastUsedAt = value.Time
+			}
+		default:
+			pc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PasskeyCredential.
+// This includes values selected through modifiers, order, etc.
+func (pc *PasskeyCredential) Value(name string) (ent.Value, error) {
+	return pc.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PasskeyCredential entity.
+func (pc *PasskeyCredential) QueryUser() *UserQuery {
+	return NewPasskeyCredentialClient(pc.config).QueryUser(pc)
+}
+
+// Update returns a builder for updating this PasskeyCredential.
+// Note that you need to call PasskeyCredential.Unwrap() before calling this method if this PasskeyCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pc *PasskeyCredential) Update() *PasskeyCredentialUpdateOne {
+	return NewPasskeyCredentialClient(pc.config).UpdateOne(pc)
+}
+
+// Unwrap unwraps the PasskeyCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pc *PasskeyCredential) Unwrap() *PasskeyCredential {
+	_tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PasskeyCredential is not a transactional entity")
+	}
+	pc.config.driver = _tx.drv
+	return pc
+}
+
+// String implements the fmt.Stringer.
+func (pc *PasskeyCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("PasskeyCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(pc.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("credential_id=")
+	builder.WriteString(pc.CredentialID)
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(fmt.Sprintf("%v", pc.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", pc.SignCount))
+	builder.WriteString(", ")
+	builder.WriteString("transports=")
+	builder.WriteString(fmt.Sprintf("%v", pc.Transports))
+	builder.WriteString(", ")
+	builder.WriteString("aaguid=")
+	builder.WriteString(pc.Aaguid)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("last_used_at=")
+	builder.WriteString(pc.LastUsedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PasskeyCredentials is a parsable slice of PasskeyCredential.
+type PasskeyCredentials []*PasskeyCredential
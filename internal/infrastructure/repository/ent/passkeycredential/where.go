@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package passkeycredential
+
+import (
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// CredentialID applies equality check predicate on the "credential_id" field. It's identical to CredentialIDEQ.
+func CredentialID(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v []byte) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// SignCount applies equality check predicate on the "sign_count" field. It's identical to SignCountEQ.
+func SignCount(v uint32) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldSignCount, v))
+}
+
+// Aaguid applies equality check predicate on the "aaguid" field. It's identical to AaguidEQ.
+func Aaguid(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldAaguid, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldName, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// CredentialIDEQ applies the EQ predicate on the "credential_id" field.
+func CredentialIDEQ(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// CredentialIDNEQ applies the NEQ predicate on the "credential_id" field.
+func CredentialIDNEQ(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldCredentialID, v))
+}
+
+// CredentialIDIn applies the In predicate on the "credential_id" field.
+func CredentialIDIn(vs ...string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDNotIn applies the NotIn predicate on the "credential_id" field.
+func CredentialIDNotIn(vs ...string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDGT applies the GT predicate on the "credential_id" field.
+func CredentialIDGT(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldCredentialID, v))
+}
+
+// CredentialIDGTE applies the GTE predicate on the "credential_id" field.
+func CredentialIDGTE(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldCredentialID, v))
+}
+
+// CredentialIDLT applies the LT predicate on the "credential_id" field.
+func CredentialIDLT(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldCredentialID, v))
+}
+
+// CredentialIDLTE applies the LTE predicate on the "credential_id" field.
+func CredentialIDLTE(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldCredentialID, v))
+}
+
+// CredentialIDContains applies the Contains predicate on the "credential_id" field.
+func CredentialIDContains(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldContains(FieldCredentialID, v))
+}
+
+// CredentialIDHasPrefix applies the HasPrefix predicate on the "credential_id" field.
+func CredentialIDHasPrefix(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldHasPrefix(FieldCredentialID, v))
+}
+
+// CredentialIDHasSuffix applies the HasSuffix predicate on the "credential_id" field.
+func CredentialIDHasSuffix(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldHasSuffix(FieldCredentialID, v))
+}
+
+// CredentialIDEqualFold applies the EqualFold predicate on the "credential_id" field.
+func CredentialIDEqualFold(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEqualFold(FieldCredentialID, v))
+}
+
+// CredentialIDContainsFold applies the ContainsFold predicate on the "credential_id" field.
+func CredentialIDContainsFold(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldContainsFold(FieldCredentialID, v))
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v []byte) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v []byte) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldPublicKey, v))
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...[]byte) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...[]byte) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v []byte) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldPublicKey, v))
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v []byte) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldPublicKey, v))
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v []byte) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldPublicKey, v))
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v []byte) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldPublicKey, v))
+}
+
+// SignCountEQ applies the EQ predicate on the "sign_count" field.
+func SignCountEQ(v uint32) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldSignCount, v))
+}
+
+// SignCountNEQ applies the NEQ predicate on the "sign_count" field.
+func SignCountNEQ(v uint32) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldSignCount, v))
+}
+
+// SignCountIn applies the In predicate on the "sign_count" field.
+func SignCountIn(vs ...uint32) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldSignCount, vs...))
+}
+
+// SignCountNotIn applies the NotIn predicate on the "sign_count" field.
+func SignCountNotIn(vs ...uint32) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldSignCount, vs...))
+}
+
+// SignCountGT applies the GT predicate on the "sign_count" field.
+func SignCountGT(v uint32) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldSignCount, v))
+}
+
+// SignCountGTE applies the GTE predicate on the "sign_count" field.
+func SignCountGTE(v uint32) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldSignCount, v))
+}
+
+// SignCountLT applies the LT predicate on the "sign_count" field.
+func SignCountLT(v uint32) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldSignCount, v))
+}
+
+// SignCountLTE applies the LTE predicate on the "sign_count" field.
+func SignCountLTE(v uint32) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldSignCount, v))
+}
+
+// TransportsIsNil applies the IsNil predicate on the "transports" field.
+func TransportsIsNil() predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIsNull(FieldTransports))
+}
+
+// TransportsNotNil applies the NotNil predicate on the "transports" field.
+func TransportsNotNil() predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotNull(FieldTransports))
+}
+
+// AaguidEQ applies the EQ predicate on the "aaguid" field.
+func AaguidEQ(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldAaguid, v))
+}
+
+// AaguidNEQ applies the NEQ predicate on the "aaguid" field.
+func AaguidNEQ(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldAaguid, v))
+}
+
+// AaguidIn applies the In predicate on the "aaguid" field.
+func AaguidIn(vs ...string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldAaguid, vs...))
+}
+
+// AaguidNotIn applies the NotIn predicate on the "aaguid" field.
+func AaguidNotIn(vs ...string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldAaguid, vs...))
+}
+
+// AaguidGT applies the GT predicate on the "aaguid" field.
+func AaguidGT(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldAaguid, v))
+}
+
+// AaguidGTE applies the GTE predicate on the "aaguid" field.
+func AaguidGTE(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldAaguid, v))
+}
+
+// AaguidLT applies the LT predicate on the "aaguid" field.
+func AaguidLT(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldAaguid, v))
+}
+
+// AaguidLTE applies the LTE predicate on the "aaguid" field.
+func AaguidLTE(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldAaguid, v))
+}
+
+// AaguidContains applies the Contains predicate on the "aaguid" field.
+func AaguidContains(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldContains(FieldAaguid, v))
+}
+
+// AaguidHasPrefix applies the HasPrefix predicate on the "aaguid" field.
+func AaguidHasPrefix(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldHasPrefix(FieldAaguid, v))
+}
+
+// AaguidHasSuffix applies the HasSuffix predicate on the "aaguid" field.
+func AaguidHasSuffix(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldHasSuffix(FieldAaguid, v))
+}
+
+// AaguidIsNil applies the IsNil predicate on the "aaguid" field.
+func AaguidIsNil() predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIsNull(FieldAaguid))
+}
+
+// AaguidNotNil applies the NotNil predicate on the "aaguid" field.
+func AaguidNotNil() predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotNull(FieldAaguid))
+}
+
+// AaguidEqualFold applies the EqualFold predicate on the "aaguid" field.
+func AaguidEqualFold(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEqualFold(FieldAaguid, v))
+}
+
+// AaguidContainsFold applies the ContainsFold predicate on the "aaguid" field.
+func AaguidContainsFold(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldContainsFold(FieldAaguid, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldContainsFold(FieldName, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PasskeyCredential) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PasskeyCredential) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PasskeyCredential) predicate.PasskeyCredential {
+	return predicate.PasskeyCredential(sql.NotPredicates(p))
+}
@@ -0,0 +1,411 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
+	"kiwi-user/internal/infrastructure/repository/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PasskeyCredentialCreate is the builder for creating a PasskeyCredential entity.
+type PasskeyCredentialCreate struct {
+	config
+	mutation *PasskeyCredentialMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pcc *PasskeyCredentialCreate) SetCreatedAt(t time.Time) *PasskeyCredentialCreate {
+	pcc.mutation.SetCreatedAt(t)
+	return pcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pcc *PasskeyCredentialCreate) SetNillableCreatedAt(t *time.Time) *PasskeyCredentialCreate {
+	if t != nil {
+		pcc.SetCreatedAt(*t)
+	}
+	return pcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcc *PasskeyCredentialCreate) SetUpdatedAt(t time.Time) *PasskeyCredentialCreate {
+	pcc.mutation.SetUpdatedAt(t)
+	return pcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pcc *PasskeyCredentialCreate) SetNillableUpdatedAt(t *time.Time) *PasskeyCredentialCreate {
+	if t != nil {
+		pcc.SetUpdatedAt(*t)
+	}
+	return pcc
+}
+
+// SetUserID sets the "user_id" field.
+func (pcc *PasskeyCredentialCreate) SetUserID(s string) *PasskeyCredentialCreate {
+	pcc.mutation.SetUserID(s)
+	return pcc
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (pcc *PasskeyCredentialCreate) SetCredentialID(s string) *PasskeyCredentialCreate {
+	pcc.mutation.SetCredentialID(s)
+	return pcc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (pcc *PasskeyCredentialCreate) SetPublicKey(b []byte) *PasskeyCredentialCreate {
+	pcc.mutation.SetPublicKey(b)
+	return pcc
+}
+
+// SetSignCount sets the "sign_count" field.
+func (pcc *PasskeyCredentialCreate) SetSignCount(u uint32) *PasskeyCredentialCreate {
+	pcc.mutation.SetSignCount(u)
+	return pcc
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (pcc *PasskeyCredentialCreate) SetNillableSignCount(u *uint32) *PasskeyCredentialCreate {
+	if u != nil {
+		pcc.SetSignCount(*u)
+	}
+	return pcc
+}
+
+// SetTransports sets the "transports" field.
+func (pcc *PasskeyCredentialCreate) SetTransports(s []string) *PasskeyCredentialCreate {
+	pcc.mutation.SetTransports(s)
+	return pcc
+}
+
+// SetAaguid sets the "aaguid" field.
+func (pcc *PasskeyCredentialCreate) SetAaguid(s string) *PasskeyCredentialCreate {
+	pcc.mutation.SetAaguid(s)
+	return pcc
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (pcc *PasskeyCredentialCreate) SetNillableAaguid(s *string) *PasskeyCredentialCreate {
+	if s != nil {
+		pcc.SetAaguid(*s)
+	}
+	return pcc
+}
+
+// SetName sets the "name" field.
+func (pcc *PasskeyCredentialCreate) SetName(s string) *PasskeyCredentialCreate {
+	pcc.mutation.SetName(s)
+	return pcc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pcc *PasskeyCredentialCreate) SetNillableName(s *string) *PasskeyCredentialCreate {
+	if s != nil {
+		pcc.SetName(*s)
+	}
+	return pcc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (pcc *PasskeyCredentialCreate) SetLastUsedAt(t time.Time) *PasskeyCredentialCreate {
+	pcc.mutation.SetLastUsedAt(t)
+	return pcc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (pcc *PasskeyCredentialCreate) SetNillableLastUsedAt(t *time.Time) *PasskeyCredentialCreate {
+	if t != nil {
+		pcc.SetLastUsedAt(*t)
+	}
+	return pcc
+}
+
+// SetID sets the "id" field.
+func (pcc *PasskeyCredentialCreate) SetID(u uuid.UUID) *PasskeyCredentialCreate {
+	pcc.mutation.SetID(u)
+	return pcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pcc *PasskeyCredentialCreate) SetNillableID(u *uuid.UUID) *PasskeyCredentialCreate {
+	if u != nil {
+		pcc.SetID(*u)
+	}
+	return pcc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pcc *PasskeyCredentialCreate) SetUser(u *User) *PasskeyCredentialCreate {
+	return pcc.SetUserID(u.ID)
+}
+
+// Mutation returns the PasskeyCredentialMutation object of the builder.
+func (pcc *PasskeyCredentialCreate) Mutation() *PasskeyCredentialMutation {
+	return pcc.mutation
+}
+
+// Save creates the PasskeyCredential in the database.
+func (pcc *PasskeyCredentialCreate) Save(ctx context.Context) (*PasskeyCredential, error) {
+	pcc.defaults()
+	return withHooks(ctx, pcc.sqlSave, pcc.mutation, pcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pcc *PasskeyCredentialCreate) SaveX(ctx context.Context) *PasskeyCredential {
+	v, err := pcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcc *PasskeyCredentialCreate) Exec(ctx context.Context) error {
+	_, err := pcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcc *PasskeyCredentialCreate) ExecX(ctx context.Context) {
+	if err := pcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcc *PasskeyCredentialCreate) defaults() {
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		v := passkeycredential.DefaultCreatedAt()
+		pcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pcc.mutation.UpdatedAt(); !ok {
+		v := passkeycredential.DefaultUpdatedAt()
+		pcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pcc.mutation.SignCount(); !ok {
+		v := passkeycredential.DefaultSignCount
+		pcc.mutation.SetSignCount(v)
+	}
+	if _, ok := pcc.mutation.ID(); !ok {
+		v := passkeycredential.DefaultID()
+		pcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcc *PasskeyCredentialCreate) check() error {
+	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PasskeyCredential.created_at"`)}
+	}
+	if _, ok := pcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PasskeyCredential.updated_at"`)}
+	}
+	if _, ok := pcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PasskeyCredential.user_id"`)}
+	}
+	if v, ok := pcc.mutation.UserID(); ok {
+		if err := passkeycredential.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PasskeyCredential.user_id": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`ent: missing required field "PasskeyCredential.credential_id"`)}
+	}
+	if v, ok := pcc.mutation.CredentialID(); ok {
+		if err := passkeycredential.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "PasskeyCredential.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := pcc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "PasskeyCredential.public_key"`)}
+	}
+	if _, ok := pcc.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`ent: missing required field "PasskeyCredential.sign_count"`)}
+	}
+	if len(pcc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PasskeyCredential.user"`)}
+	}
+	return nil
+}
+
+func (pcc *PasskeyCredentialCreate) sqlSave(ctx context.Context) (*PasskeyCredential, error) {
+	if err := pcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pcc.mutation.id = &_node.ID
+	pcc.mutation.done = true
+	return _node, nil
+}
+
+func (pcc *PasskeyCredentialCreate) createSpec() (*PasskeyCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PasskeyCredential{config: pcc.config}
+		_spec = sqlgraph.NewCreateSpec(passkeycredential.Table, sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID))
+	)
+	if id, ok := pcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pcc.mutation.CreatedAt(); ok {
+		_spec.SetField(passkeycredential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkeycredential.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pcc.mutation.CredentialID(); ok {
+		_spec.SetField(passkeycredential.FieldCredentialID, field.TypeString, value)
+		_node.CredentialID = value
+	}
+	if value, ok := pcc.mutation.PublicKey(); ok {
+		_spec.SetField(passkeycredential.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := pcc.mutation.SignCount(); ok {
+		_spec.SetField(passkeycredential.FieldSignCount, field.TypeUint32, value)
+		_node.SignCount = value
+	}
+	if value, ok := pcc.mutation.Transports(); ok {
+		_spec.SetField(passkeycredential.FieldTransports, field.TypeJSON, value)
+		_node.Transports = value
+	}
+	if value, ok := pcc.mutation.Aaguid(); ok {
+		_spec.SetField(passkeycredential.FieldAaguid, field.TypeString, value)
+		_node.Aaguid = value
+	}
+	if value, ok := pcc.mutation.Name(); ok {
+		_spec.SetField(passkeycredential.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pcc.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkeycredential.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = value
+	}
+	if nodes := pcc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkeycredential.UserTable,
+			Columns: []string{passkeycredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PasskeyCredentialCreateBulk is the builder for creating many PasskeyCredential entities in bulk.
+type PasskeyCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*PasskeyCredentialCreate
+}
+
+// Save creates the PasskeyCredential entities in the database.
+func (pccb *PasskeyCredentialCreateBulk) Save(ctx context.Context) ([]*PasskeyCredential, error) {
+	if pccb.err != nil {
+		return nil, pccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pccb.builders))
+	nodes := make([]*PasskeyCredential, len(pccb.builders))
+	mutators := make([]Mutator, len(pccb.builders))
+	for i := range pccb.builders {
+		func(i int, root context.Context) {
+			builder := pccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasskeyCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pccb *PasskeyCredentialCreateBulk) SaveX(ctx context.Context) []*PasskeyCredential {
+	v, err := pccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pccb *PasskeyCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := pccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pccb *PasskeyCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := pccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PasskeyCredentialDelete is the builder for deleting a PasskeyCredential entity.
+type PasskeyCredentialDelete struct {
+	config
+	hooks    []Hook
+	mutation *PasskeyCredentialMutation
+}
+
+// Where appends a list predicates to the PasskeyCredentialDelete builder.
+func (pcd *PasskeyCredentialDelete) Where(ps ...predicate.PasskeyCredential) *PasskeyCredentialDelete {
+	pcd.mutation.Where(ps...)
+	return pcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pcd *PasskeyCredentialDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcd.sqlExec, pcd.mutation, pcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcd *PasskeyCredentialDelete) ExecX(ctx context.Context) int {
+	n, err := pcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pcd *PasskeyCredentialDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(passkeycredential.Table, sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID))
+	if ps := pcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pcd.mutation.done = true
+	return affected, err
+}
+
+// PasskeyCredentialDeleteOne is the builder for deleting a single PasskeyCredential entity.
+type PasskeyCredentialDeleteOne struct {
+	pcd *PasskeyCredentialDelete
+}
+
+// Where appends a list predicates to the PasskeyCredentialDelete builder.
+func (pcdo *PasskeyCredentialDeleteOne) Where(ps ...predicate.PasskeyCredential) *PasskeyCredentialDeleteOne {
+	pcdo.pcd.mutation.Where(ps...)
+	return pcdo
+}
+
+// Exec executes the deletion query.
+func (pcdo *PasskeyCredentialDeleteOne) Exec(ctx context.Context) error {
+	n, err := pcdo.pcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{passkeycredential.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcdo *PasskeyCredentialDeleteOne) ExecX(ctx context.Context) {
+	if err := pcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PasskeyCredentialQuery is the builder for querying PasskeyCredential entities.
+type PasskeyCredentialQuery struct {
+	config
+	ctx        *QueryContext
+	order      []passkeycredential.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PasskeyCredential
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PasskeyCredentialQuery builder.
+func (pcq *PasskeyCredentialQuery) Where(ps ...predicate.PasskeyCredential) *PasskeyCredentialQuery {
+	pcq.predicates = append(pcq.predicates, ps...)
+	return pcq
+}
+
+// Limit the number of records to be returned by this query.
+func (pcq *PasskeyCredentialQuery) Limit(limit int) *PasskeyCredentialQuery {
+	pcq.ctx.Limit = &limit
+	return pcq
+}
+
+// Offset to start from.
+func (pcq *PasskeyCredentialQuery) Offset(offset int) *PasskeyCredentialQuery {
+	pcq.ctx.Offset = &offset
+	return pcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pcq *PasskeyCredentialQuery) Unique(unique bool) *PasskeyCredentialQuery {
+	pcq.ctx.Unique = &unique
+	return pcq
+}
+
+// Order specifies how the records should be ordered.
+func (pcq *PasskeyCredentialQuery) Order(o ...passkeycredential.OrderOption) *PasskeyCredentialQuery {
+	pcq.order = append(pcq.order, o...)
+	return pcq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (pcq *PasskeyCredentialQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: pcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passkeycredential.Table, passkeycredential.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, passkeycredential.UserTable, passkeycredential.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PasskeyCredential entity from the query.
+// Returns a *NotFoundError when no PasskeyCredential was found.
+func (pcq *PasskeyCredentialQuery) First(ctx context.Context) (*PasskeyCredential, error) {
+	nodes, err := pcq.Limit(1).All(setContextOp(ctx, pcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{passkeycredential.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pcq *PasskeyCredentialQuery) FirstX(ctx context.Context) *PasskeyCredential {
+	node, err := pcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PasskeyCredential ID from the query.
+// Returns a *NotFoundError when no PasskeyCredential ID was found.
+func (pcq *PasskeyCredentialQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pcq.Limit(1).IDs(setContextOp(ctx, pcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{passkeycredential.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pcq *PasskeyCredentialQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PasskeyCredential entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PasskeyCredential entity is found.
+// Returns a *NotFoundError when no PasskeyCredential entities are found.
+func (pcq *PasskeyCredentialQuery) Only(ctx context.Context) (*PasskeyCredential, error) {
+	nodes, err := pcq.Limit(2).All(setContextOp(ctx, pcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{passkeycredential.Label}
+	default:
+		return nil, &NotSingularError{passkeycredential.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pcq *PasskeyCredentialQuery) OnlyX(ctx context.Context) *PasskeyCredential {
+	node, err := pcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PasskeyCredential ID in the query.
+// Returns a *NotSingularError when more than one PasskeyCredential ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pcq *PasskeyCredentialQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pcq.Limit(2).IDs(setContextOp(ctx, pcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{passkeycredential.Label}
+	default:
+		err = &NotSingularError{passkeycredential.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pcq *PasskeyCredentialQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PasskeyCredentials.
+func (pcq *PasskeyCredentialQuery) All(ctx context.Context) ([]*PasskeyCredential, error) {
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryAll)
+	if err := pcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PasskeyCredential, *PasskeyCredentialQuery]()
+	return withInterceptors[[]*PasskeyCredential](ctx, pcq, qr, pcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pcq *PasskeyCredentialQuery) AllX(ctx context.Context) []*PasskeyCredential {
+	nodes, err := pcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PasskeyCredential IDs.
+func (pcq *PasskeyCredentialQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if pcq.ctx.Unique == nil && pcq.path != nil {
+		pcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryIDs)
+	if err = pcq.Select(passkeycredential.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pcq *PasskeyCredentialQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pcq *PasskeyCredentialQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryCount)
+	if err := pcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pcq, querierCount[*PasskeyCredentialQuery](), pcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pcq *PasskeyCredentialQuery) CountX(ctx context.Context) int {
+	count, err := pcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pcq *PasskeyCredentialQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pcq.ctx, ent.OpQueryExist)
+	switch _, err := pcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pcq *PasskeyCredentialQuery) ExistX(ctx context.Context) bool {
+	exist, err := pcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PasskeyCredentialQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pcq *PasskeyCredentialQuery) Clone() *PasskeyCredentialQuery {
+	if pcq == nil {
+		return nil
+	}
+	return &PasskeyCredentialQuery{
+		config:     pcq.config,
+		ctx:        pcq.ctx.Clone(),
+		order:      append([]passkeycredential.OrderOption{}, pcq.order...),
+		inters:     append([]Interceptor{}, pcq.inters...),
+		predicates: append([]predicate.PasskeyCredential{}, pcq.predicates...),
+		withUser:   pcq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  pcq.sql.Clone(),
+		path: pcq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (pcq *PasskeyCredentialQuery) WithUser(opts ...func(*UserQuery)) *PasskeyCredentialQuery {
+	query := (&UserClient{config: pcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pcq.withUser = query
+	return pcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PasskeyCredential.Query().
+//		GroupBy(passkeycredential.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pcq *PasskeyCredentialQuery) GroupBy(field string, fields ...string) *PasskeyCredentialGroupBy {
+	pcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PasskeyCredentialGroupBy{build: pcq}
+	grbuild.flds = &pcq.ctx.Fields
+	grbuild.label = passkeycredential.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PasskeyCredential.Query().
+//		Select(passkeycredential.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pcq *PasskeyCredentialQuery) Select(fields ...string) *PasskeyCredentialSelect {
+	pcq.ctx.Fields = append(pcq.ctx.Fields, fields...)
+	sbuild := &PasskeyCredentialSelect{PasskeyCredentialQuery: pcq}
+	sbuild.label = passkeycredential.Label
+	sbuild.flds, sbuild.scan = &pcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PasskeyCredentialSelect configured with the given aggregations.
+func (pcq *PasskeyCredentialQuery) Aggregate(fns ...AggregateFunc) *PasskeyCredentialSelect {
+	return pcq.Select().Aggregate(fns...)
+}
+
+func (pcq *PasskeyCredentialQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pcq.ctx.Fields {
+		if !passkeycredential.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pcq.path != nil {
+		prev, err := pcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pcq.sql = prev
+	}
+	return nil
+}
+
+func (pcq *PasskeyCredentialQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PasskeyCredential, error) {
+	var (
+		nodes       = []*PasskeyCredential{}
+		_spec       = pcq.querySpec()
+		loadedTypes = [1]bool{
+			pcq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PasskeyCredential).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PasskeyCredential{config: pcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pcq.withUser; query != nil {
+		if err := pcq.loadUser(ctx, query, nodes, nil,
+			func(n *PasskeyCredential, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pcq *PasskeyCredentialQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*PasskeyCredential, init func(*PasskeyCredential), assign func(*PasskeyCredential, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*PasskeyCredential)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pcq *PasskeyCredentialQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pcq.querySpec()
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
+	_spec.Node.Columns = pcq.ctx.Fields
+	if len(pcq.ctx.Fields) > 0 {
+		_spec.Unique = pcq.ctx.Unique != nil && *pcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pcq.driver, _spec)
+}
+
+func (pcq *PasskeyCredentialQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(passkeycredential.Table, passkeycredential.Columns, sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID))
+	_spec.From = pcq.sql
+	if unique := pcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passkeycredential.FieldID)
+		for i := range fields {
+			if fields[i] != passkeycredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pcq.withUser != nil {
+			_spec.Node.AddColumnOnce(passkeycredential.FieldUserID)
+		}
+	}
+	if ps := pcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pcq *PasskeyCredentialQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pcq.driver.Dialect())
+	t1 := builder.Table(passkeycredential.Table)
+	columns := pcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = passkeycredential.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pcq.sql != nil {
+		selector = pcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pcq.ctx.Unique != nil && *pcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pcq.modifiers {
+		m(selector)
+	}
+	for _, p := range pcq.predicates {
+		p(selector)
+	}
+	for _, p := range pcq.order {
+		p(selector)
+	}
+	if offset := pcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pcq *PasskeyCredentialQuery) ForUpdate(opts ...sql.LockOption) *PasskeyCredentialQuery {
+	if pcq.driver.Dialect() == dialect.Postgres {
+		pcq.Unique(false)
+	}
+	pcq.modifiers = append(pcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pcq *PasskeyCredentialQuery) ForShare(opts ...sql.LockOption) *PasskeyCredentialQuery {
+	if pcq.driver.Dialect() == dialect.Postgres {
+		pcq.Unique(false)
+	}
+	pcq.modifiers = append(pcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pcq
+}
+
+// PasskeyCredentialGroupBy is the group-by builder for PasskeyCredential entities.
+type PasskeyCredentialGroupBy struct {
+	selector
+	build *PasskeyCredentialQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pcgb *PasskeyCredentialGroupBy) Aggregate(fns ...AggregateFunc) *PasskeyCredentialGroupBy {
+	pcgb.fns = append(pcgb.fns, fns...)
+	return pcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcgb *PasskeyCredentialGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PasskeyCredentialQuery, *PasskeyCredentialGroupBy](ctx, pcgb.build, pcgb, pcgb.build.inters, v)
+}
+
+func (pcgb *PasskeyCredentialGroupBy) sqlScan(ctx context.Context, root *PasskeyCredentialQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pcgb.fns))
+	for _, fn := range pcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pcgb.flds)+len(pcgb.fns))
+		for _, f := range *pcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PasskeyCredentialSelect is the builder for selecting fields of PasskeyCredential entities.
+type PasskeyCredentialSelect struct {
+	*PasskeyCredentialQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pcs *PasskeyCredentialSelect) Aggregate(fns ...AggregateFunc) *PasskeyCredentialSelect {
+	pcs.fns = append(pcs.fns, fns...)
+	return pcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pcs *PasskeyCredentialSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pcs.ctx, ent.OpQuerySelect)
+	if err := pcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PasskeyCredentialQuery, *PasskeyCredentialSelect](ctx, pcs.PasskeyCredentialQuery, pcs, pcs.inters, v)
+}
+
+func (pcs *PasskeyCredentialSelect) sqlScan(ctx context.Context, root *PasskeyCredentialQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pcs.fns))
+	for _, fn := range pcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
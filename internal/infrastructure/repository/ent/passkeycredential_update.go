@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PasskeyCredentialUpdate is the builder for updating PasskeyCredential entities.
+type PasskeyCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasskeyCredentialMutation
+}
+
+// Where appends a list predicates to the PasskeyCredentialUpdate builder.
+func (pcu *PasskeyCredentialUpdate) Where(ps ...predicate.PasskeyCredential) *PasskeyCredentialUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcu *PasskeyCredentialUpdate) SetUpdatedAt(t time.Time) *PasskeyCredentialUpdate {
+	pcu.mutation.SetUpdatedAt(t)
+	return pcu
+}
+
+// SetUserID sets the "user_id" field.
+func (pcu *PasskeyCredentialUpdate) SetUserID(s string) *PasskeyCredentialUpdate {
+	pcu.mutation.SetUserID(s)
+	return pcu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pcu *PasskeyCredentialUpdate) SetNillableUserID(s *string) *PasskeyCredentialUpdate {
+	if s != nil {
+		pcu.SetUserID(*s)
+	}
+	return pcu
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (pcu *PasskeyCredentialUpdate) SetCredentialID(s string) *PasskeyCredentialUpdate {
+	pcu.mutation.SetCredentialID(s)
+	return pcu
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (pcu *PasskeyCredentialUpdate) SetNillableCredentialID(s *string) *PasskeyCredentialUpdate {
+	if s != nil {
+		pcu.SetCredentialID(*s)
+	}
+	return pcu
+}
+
+// SetPublicKey sets the "public_key" field.
+func (pcu *PasskeyCredentialUpdate) SetPublicKey(b []byte) *PasskeyCredentialUpdate {
+	pcu.mutation.SetPublicKey(b)
+	return pcu
+}
+
+// SetSignCount sets the "sign_count" field.
+func (pcu *PasskeyCredentialUpdate) SetSignCount(u uint32) *PasskeyCredentialUpdate {
+	pcu.mutation.ResetSignCount()
+	pcu.mutation.SetSignCount(u)
+	return pcu
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (pcu *PasskeyCredentialUpdate) SetNillableSignCount(u *uint32) *PasskeyCredentialUpdate {
+	if u != nil {
+		pcu.SetSignCount(*u)
+	}
+	return pcu
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (pcu *PasskeyCredentialUpdate) AddSignCount(u int32) *PasskeyCredentialUpdate {
+	pcu.mutation.AddSignCount(u)
+	return pcu
+}
+
+// SetTransports sets the "transports" field.
+func (pcu *PasskeyCredentialUpdate) SetTransports(s []string) *PasskeyCredentialUpdate {
+	pcu.mutation.SetTransports(s)
+	return pcu
+}
+
+// AppendTransports appends s to the "transports" field.
+func (pcu *PasskeyCredentialUpdate) AppendTransports(s []string) *PasskeyCredentialUpdate {
+	pcu.mutation.AppendTransports(s)
+	return pcu
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (pcu *PasskeyCredentialUpdate) ClearTransports() *PasskeyCredentialUpdate {
+	pcu.mutation.ClearTransports()
+	return pcu
+}
+
+// SetAaguid sets the "aaguid" field.
+func (pcu *PasskeyCredentialUpdate) SetAaguid(s string) *PasskeyCredentialUpdate {
+	pcu.mutation.SetAaguid(s)
+	return pcu
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (pcu *PasskeyCredentialUpdate) SetNillableAaguid(s *string) *PasskeyCredentialUpdate {
+	if s != nil {
+		pcu.SetAaguid(*s)
+	}
+	return pcu
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (pcu *PasskeyCredentialUpdate) ClearAaguid() *PasskeyCredentialUpdate {
+	pcu.mutation.ClearAaguid()
+	return pcu
+}
+
+// SetName sets the "name" field.
+func (pcu *PasskeyCredentialUpdate) SetName(s string) *PasskeyCredentialUpdate {
+	pcu.mutation.SetName(s)
+	return pcu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pcu *PasskeyCredentialUpdate) SetNillableName(s *string) *PasskeyCredentialUpdate {
+	if s != nil {
+		pcu.SetName(*s)
+	}
+	return pcu
+}
+
+// ClearName clears the value of the "name" field.
+func (pcu *PasskeyCredentialUpdate) ClearName() *PasskeyCredentialUpdate {
+	pcu.mutation.ClearName()
+	return pcu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (pcu *PasskeyCredentialUpdate) SetLastUsedAt(t time.Time) *PasskeyCredentialUpdate {
+	pcu.mutation.SetLastUsedAt(t)
+	return pcu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (pcu *PasskeyCredentialUpdate) SetNillableLastUsedAt(t *time.Time) *PasskeyCredentialUpdate {
+	if t != nil {
+		pcu.SetLastUsedAt(*t)
+	}
+	return pcu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (pcu *PasskeyCredentialUpdate) ClearLastUsedAt() *PasskeyCredentialUpdate {
+	pcu.mutation.ClearLastUsedAt()
+	return pcu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pcu *PasskeyCredentialUpdate) SetUser(u *User) *PasskeyCredentialUpdate {
+	return pcu.SetUserID(u.ID)
+}
+
+// Mutation returns the PasskeyCredentialMutation object of the builder.
+func (pcu *PasskeyCredentialUpdate) Mutation() *PasskeyCredentialMutation {
+	return pcu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pcu *PasskeyCredentialUpdate) ClearUser() *PasskeyCredentialUpdate {
+	pcu.mutation.ClearUser()
+	return pcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *PasskeyCredentialUpdate) Save(ctx context.Context) (int, error) {
+	pcu.defaults()
+	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *PasskeyCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *PasskeyCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *PasskeyCredentialUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcu *PasskeyCredentialUpdate) defaults() {
+	if _, ok := pcu.mutation.UpdatedAt(); !ok {
+		v := passkeycredential.UpdateDefaultUpdatedAt()
+		pcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcu *PasskeyCredentialUpdate) check() error {
+	if v, ok := pcu.mutation.UserID(); ok {
+		if err := passkeycredential.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PasskeyCredential.user_id": %w`, err)}
+		}
+	}
+	if v, ok := pcu.mutation.CredentialID(); ok {
+		if err := passkeycredential.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "PasskeyCredential.credential_id": %w`, err)}
+		}
+	}
+	if pcu.mutation.UserCleared() && len(pcu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PasskeyCredential.user"`)
+	}
+	return nil
+}
+
+func (pcu *PasskeyCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkeycredential.Table, passkeycredential.Columns, sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID))
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkeycredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pcu.mutation.CredentialID(); ok {
+		_spec.SetField(passkeycredential.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := pcu.mutation.PublicKey(); ok {
+		_spec.SetField(passkeycredential.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := pcu.mutation.SignCount(); ok {
+		_spec.SetField(passkeycredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := pcu.mutation.AddedSignCount(); ok {
+		_spec.AddField(passkeycredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := pcu.mutation.Transports(); ok {
+		_spec.SetField(passkeycredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := pcu.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passkeycredential.FieldTransports, value)
+		})
+	}
+	if pcu.mutation.TransportsCleared() {
+		_spec.ClearField(passkeycredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := pcu.mutation.Aaguid(); ok {
+		_spec.SetField(passkeycredential.FieldAaguid, field.TypeString, value)
+	}
+	if pcu.mutation.AaguidCleared() {
+		_spec.ClearField(passkeycredential.FieldAaguid, field.TypeString)
+	}
+	if value, ok := pcu.mutation.Name(); ok {
+		_spec.SetField(passkeycredential.FieldName, field.TypeString, value)
+	}
+	if pcu.mutation.NameCleared() {
+		_spec.ClearField(passkeycredential.FieldName, field.TypeString)
+	}
+	if value, ok := pcu.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkeycredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if pcu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(passkeycredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if pcu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkeycredential.UserTable,
+			Columns: []string{passkeycredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkeycredential.UserTable,
+			Columns: []string{passkeycredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkeycredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcu.mutation.done = true
+	return n, nil
+}
+
+// PasskeyCredentialUpdateOne is the builder for updating a single PasskeyCredential entity.
+type PasskeyCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasskeyCredentialMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pcuo *PasskeyCredentialUpdateOne) SetUpdatedAt(t time.Time) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.SetUpdatedAt(t)
+	return pcuo
+}
+
+// SetUserID sets the "user_id" field.
+func (pcuo *PasskeyCredentialUpdateOne) SetUserID(s string) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.SetUserID(s)
+	return pcuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pcuo *PasskeyCredentialUpdateOne) SetNillableUserID(s *string) *PasskeyCredentialUpdateOne {
+	if s != nil {
+		pcuo.SetUserID(*s)
+	}
+	return pcuo
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (pcuo *PasskeyCredentialUpdateOne) SetCredentialID(s string) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.SetCredentialID(s)
+	return pcuo
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (pcuo *PasskeyCredentialUpdateOne) SetNillableCredentialID(s *string) *PasskeyCredentialUpdateOne {
+	if s != nil {
+		pcuo.SetCredentialID(*s)
+	}
+	return pcuo
+}
+
+// SetPublicKey sets the "public_key" field.
+func (pcuo *PasskeyCredentialUpdateOne) SetPublicKey(b []byte) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.SetPublicKey(b)
+	return pcuo
+}
+
+// SetSignCount sets the "sign_count" field.
+func (pcuo *PasskeyCredentialUpdateOne) SetSignCount(u uint32) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.ResetSignCount()
+	pcuo.mutation.SetSignCount(u)
+	return pcuo
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (pcuo *PasskeyCredentialUpdateOne) SetNillableSignCount(u *uint32) *PasskeyCredentialUpdateOne {
+	if u != nil {
+		pcuo.SetSignCount(*u)
+	}
+	return pcuo
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (pcuo *PasskeyCredentialUpdateOne) AddSignCount(u int32) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.AddSignCount(u)
+	return pcuo
+}
+
+// SetTransports sets the "transports" field.
+func (pcuo *PasskeyCredentialUpdateOne) SetTransports(s []string) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.SetTransports(s)
+	return pcuo
+}
+
+// AppendTransports appends s to the "transports" field.
+func (pcuo *PasskeyCredentialUpdateOne) AppendTransports(s []string) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.AppendTransports(s)
+	return pcuo
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (pcuo *PasskeyCredentialUpdateOne) ClearTransports() *PasskeyCredentialUpdateOne {
+	pcuo.mutation.ClearTransports()
+	return pcuo
+}
+
+// SetAaguid sets the "aaguid" field.
+func (pcuo *PasskeyCredentialUpdateOne) SetAaguid(s string) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.SetAaguid(s)
+	return pcuo
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (pcuo *PasskeyCredentialUpdateOne) SetNillableAaguid(s *string) *PasskeyCredentialUpdateOne {
+	if s != nil {
+		pcuo.SetAaguid(*s)
+	}
+	return pcuo
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (pcuo *PasskeyCredentialUpdateOne) ClearAaguid() *PasskeyCredentialUpdateOne {
+	pcuo.mutation.ClearAaguid()
+	return pcuo
+}
+
+// SetName sets the "name" field.
+func (pcuo *PasskeyCredentialUpdateOne) SetName(s string) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.SetName(s)
+	return pcuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pcuo *PasskeyCredentialUpdateOne) SetNillableName(s *string) *PasskeyCredentialUpdateOne {
+	if s != nil {
+		pcuo.SetName(*s)
+	}
+	return pcuo
+}
+
+// ClearName clears the value of the "name" field.
+func (pcuo *PasskeyCredentialUpdateOne) ClearName() *PasskeyCredentialUpdateOne {
+	pcuo.mutation.ClearName()
+	return pcuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (pcuo *PasskeyCredentialUpdateOne) SetLastUsedAt(t time.Time) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.SetLastUsedAt(t)
+	return pcuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (pcuo *PasskeyCredentialUpdateOne) SetNillableLastUsedAt(t *time.Time) *PasskeyCredentialUpdateOne {
+	if t != nil {
+		pcuo.SetLastUsedAt(*t)
+	}
+	return pcuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (pcuo *PasskeyCredentialUpdateOne) ClearLastUsedAt() *PasskeyCredentialUpdateOne {
+	pcuo.mutation.ClearLastUsedAt()
+	return pcuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pcuo *PasskeyCredentialUpdateOne) SetUser(u *User) *PasskeyCredentialUpdateOne {
+	return pcuo.SetUserID(u.ID)
+}
+
+// Mutation returns the PasskeyCredentialMutation object of the builder.
+func (pcuo *PasskeyCredentialUpdateOne) Mutation() *PasskeyCredentialMutation {
+	return pcuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pcuo *PasskeyCredentialUpdateOne) ClearUser() *PasskeyCredentialUpdateOne {
+	pcuo.mutation.ClearUser()
+	return pcuo
+}
+
+// Where appends a list predicates to the PasskeyCredentialUpdate builder.
+func (pcuo *PasskeyCredentialUpdateOne) Where(ps ...predicate.PasskeyCredential) *PasskeyCredentialUpdateOne {
+	pcuo.mutation.Where(ps...)
+	return pcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *PasskeyCredentialUpdateOne) Select(field string, fields ...string) *PasskeyCredentialUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated PasskeyCredential entity.
+func (pcuo *PasskeyCredentialUpdateOne) Save(ctx context.Context) (*PasskeyCredential, error) {
+	pcuo.defaults()
+	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *PasskeyCredentialUpdateOne) SaveX(ctx context.Context) *PasskeyCredential {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *PasskeyCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *PasskeyCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pcuo *PasskeyCredentialUpdateOne) defaults() {
+	if _, ok := pcuo.mutation.UpdatedAt(); !ok {
+		v := passkeycredential.UpdateDefaultUpdatedAt()
+		pcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcuo *PasskeyCredentialUpdateOne) check() error {
+	if v, ok := pcuo.mutation.UserID(); ok {
+		if err := passkeycredential.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PasskeyCredential.user_id": %w`, err)}
+		}
+	}
+	if v, ok := pcuo.mutation.CredentialID(); ok {
+		if err := passkeycredential.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "PasskeyCredential.credential_id": %w`, err)}
+		}
+	}
+	if pcuo.mutation.UserCleared() && len(pcuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PasskeyCredential.user"`)
+	}
+	return nil
+}
+
+func (pcuo *PasskeyCredentialUpdateOne) sqlSave(ctx context.Context) (_node *PasskeyCredential, err error) {
+	if err := pcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkeycredential.Table, passkeycredential.Columns, sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID))
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasskeyCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passkeycredential.FieldID)
+		for _, f := range fields {
+			if !passkeycredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passkeycredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkeycredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pcuo.mutation.CredentialID(); ok {
+		_spec.SetField(passkeycredential.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := pcuo.mutation.PublicKey(); ok {
+		_spec.SetField(passkeycredential.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := pcuo.mutation.SignCount(); ok {
+		_spec.SetField(passkeycredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := pcuo.mutation.AddedSignCount(); ok {
+		_spec.AddField(passkeycredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := pcuo.mutation.Transports(); ok {
+		_spec.SetField(passkeycredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := pcuo.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passkeycredential.FieldTransports, value)
+		})
+	}
+	if pcuo.mutation.TransportsCleared() {
+		_spec.ClearField(passkeycredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := pcuo.mutation.Aaguid(); ok {
+		_spec.SetField(passkeycredential.FieldAaguid, field.TypeString, value)
+	}
+	if pcuo.mutation.AaguidCleared() {
+		_spec.ClearField(passkeycredential.FieldAaguid, field.TypeString)
+	}
+	if value, ok := pcuo.mutation.Name(); ok {
+		_spec.SetField(passkeycredential.FieldName, field.TypeString, value)
+	}
+	if pcuo.mutation.NameCleared() {
+		_spec.ClearField(passkeycredential.FieldName, field.TypeString)
+	}
+	if value, ok := pcuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkeycredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if pcuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(passkeycredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if pcuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkeycredential.UserTable,
+			Columns: []string{passkeycredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkeycredential.UserTable,
+			Columns: []string{passkeycredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PasskeyCredential{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkeycredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcuo.mutation.done = true
+	return _node, nil
+}
@@ -18,9 +18,24 @@ type BindingVerify func(*sql.Selector)
 // Device is the predicate function for device builders.
 type Device func(*sql.Selector)
 
+// IdentityProvider is the predicate function for identityprovider builders.
+type IdentityProvider func(*sql.Selector)
+
+// LoginLock is the predicate function for loginlock builders.
+type LoginLock func(*sql.Selector)
+
+// MagicLink is the predicate function for magiclink builders.
+type MagicLink func(*sql.Selector)
+
 // MailVertifyCode is the predicate function for mailvertifycode builders.
 type MailVertifyCode func(*sql.Selector)
 
+// OAuthAuthorizationCode is the predicate function for oauthauthorizationcode builders.
+type OAuthAuthorizationCode func(*sql.Selector)
+
+// OAuthConsent is the predicate function for oauthconsent builders.
+type OAuthConsent func(*sql.Selector)
+
 // Organization is the predicate function for organization builders.
 type Organization func(*sql.Selector)
 
@@ -33,23 +48,47 @@ type OrganizationRequest func(*sql.Selector)
 // OrganizationUser is the predicate function for organizationuser builders.
 type OrganizationUser func(*sql.Selector)
 
+// PasskeyCredential is the predicate function for passkeycredential builders.
+type PasskeyCredential func(*sql.Selector)
+
 // Payment is the predicate function for payment builders.
 type Payment func(*sql.Selector)
 
+// QRLogin is the predicate function for qrlogin builders.
+type QRLogin func(*sql.Selector)
+
+// QyWechatCorp is the predicate function for qywechatcorp builders.
+type QyWechatCorp func(*sql.Selector)
+
 // QyWechatUserID is the predicate function for qywechatuserid builders.
 type QyWechatUserID func(*sql.Selector)
 
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// RotatedRefreshToken is the predicate function for rotatedrefreshtoken builders.
+type RotatedRefreshToken func(*sql.Selector)
+
+// SAMLConnection is the predicate function for samlconnection builders.
+type SAMLConnection func(*sql.Selector)
+
 // Scope is the predicate function for scope builders.
 type Scope func(*sql.Selector)
 
+// SmsVerifyCode is the predicate function for smsverifycode builders.
+type SmsVerifyCode func(*sql.Selector)
+
 // StripeEvent is the predicate function for stripeevent builders.
 type StripeEvent func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// WebAuthnChallenge is the predicate function for webauthnchallenge builders.
+type WebAuthnChallenge func(*sql.Selector)
+
 // WechatOpenID is the predicate function for wechatopenid builders.
 type WechatOpenID func(*sql.Selector)
+
+// WechatScanLogin is the predicate function for wechatscanlogin builders.
+type WechatScanLogin func(*sql.Selector)
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/qrlogin"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// QRLogin is the model entity for the QRLogin schema.
+type QRLogin struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ApplicationID holds the value of the "application_id" field.
+	ApplicationID uuid.UUID `json:"application_id,omitempty"`
+	// DeviceType holds the value of the "device_type" field.
+	DeviceType string `json:"device_type,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID string `json:"device_id,omitempty"`
+	// PollTokenHash holds the value of the "poll_token_hash" field.
+	PollTokenHash string `json:"poll_token_hash,omitempty"`
+	// ClientIP holds the value of the "client_ip" field.
+	ClientIP string `json:"client_ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Status holds the value of the "status" field.
+	Status qrlogin.Status `json:"status,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QRLogin) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case qrlogin.FieldDeviceType, qrlogin.FieldDeviceID, qrlogin.FieldPollTokenHash, qrlogin.FieldClientIP, qrlogin.FieldUserAgent, qrlogin.FieldStatus, qrlogin.FieldUserID:
+			values[i] = new(sql.NullString)
+		case qrlogin.FieldCreatedAt, qrlogin.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case qrlogin.FieldID, qrlogin.FieldApplicationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QRLogin fields.
+func (ql *QRLogin) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case qrlogin.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ql.ID = *value
+			}
+		case qrlogin.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ql.CreatedAt = value.Time
+			}
+		case qrlogin.FieldApplicationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field application_id", values[i])
+			} else if value != nil {
+				ql.ApplicationID = *value
+			}
+		case qrlogin.FieldDeviceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_type", values[i])
+			} else if value.Valid {
+				ql.DeviceType = value.String
+			}
+		case qrlogin.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				ql.DeviceID = value.String
+			}
+		case qrlogin.FieldPollTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_token_hash", values[i])
+			} else if value.Valid {
+				ql.PollTokenHash = value.String
+			}
+		case qrlogin.FieldClientIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_ip", values[i])
+			} else if value.Valid {
+				ql.ClientIP = value.String
+			}
+		case qrlogin.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				ql.UserAgent = value.String
+			}
+		case qrlogin.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ql.Status = qrlogin.Status(value.String)
+			}
+		case qrlogin.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ql.UserID = value.String
+			}
+		case qrlogin.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ql.ExpiresAt = value.Time
+			}
+		default:
+			ql.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QRLogin.
+// This includes values selected through modifiers, order, etc.
+func (ql *QRLogin) Value(name string) (ent.Value, error) {
+	return ql.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this QRLogin.
+// Note that you need to call QRLogin.Unwrap() before calling this method if this QRLogin
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ql *QRLogin) Update() *QRLoginUpdateOne {
+	return NewQRLoginClient(ql.config).UpdateOne(ql)
+}
+
+// Unwrap unwraps the QRLogin entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ql *QRLogin) Unwrap() *QRLogin {
+	_tx, ok := ql.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QRLogin is not a transactional entity")
+	}
+	ql.config.driver = _tx.drv
+	return ql
+}
+
+// String implements the fmt.Stringer.
+func (ql *QRLogin) String() string {
+	var builder strings.Builder
+	builder.WriteString("QRLogin(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ql.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ql.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("application_id=")
+	builder.WriteString(fmt.Sprintf("%v", ql.ApplicationID))
+	builder.WriteString(", ")
+	builder.WriteString("device_type=")
+	builder.WriteString(ql.DeviceType)
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(ql.DeviceID)
+	builder.WriteString(", ")
+	builder.WriteString("poll_token_hash=")
+	builder.WriteString(ql.PollTokenHash)
+	builder.WriteString(", ")
+	builder.WriteString("client_ip=")
+	builder.WriteString(ql.ClientIP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(ql.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ql.Status))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(ql.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ql.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QRLogins is a parsable slice of QRLogin.
+type QRLogins []*QRLogin
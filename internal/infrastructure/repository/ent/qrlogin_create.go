@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/qrlogin"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QRLoginCreate is the builder for creating a QRLogin entity.
+type QRLoginCreate struct {
+	config
+	mutation *QRLoginMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qlc *QRLoginCreate) SetCreatedAt(t time.Time) *QRLoginCreate {
+	qlc.mutation.SetCreatedAt(t)
+	return qlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qlc *QRLoginCreate) SetNillableCreatedAt(t *time.Time) *QRLoginCreate {
+	if t != nil {
+		qlc.SetCreatedAt(*t)
+	}
+	return qlc
+}
+
+// SetApplicationID sets the "application_id" field.
+func (qlc *QRLoginCreate) SetApplicationID(u uuid.UUID) *QRLoginCreate {
+	qlc.mutation.SetApplicationID(u)
+	return qlc
+}
+
+// SetDeviceType sets the "device_type" field.
+func (qlc *QRLoginCreate) SetDeviceType(s string) *QRLoginCreate {
+	qlc.mutation.SetDeviceType(s)
+	return qlc
+}
+
+// SetDeviceID sets the "device_id" field.
+func (qlc *QRLoginCreate) SetDeviceID(s string) *QRLoginCreate {
+	qlc.mutation.SetDeviceID(s)
+	return qlc
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (qlc *QRLoginCreate) SetPollTokenHash(s string) *QRLoginCreate {
+	qlc.mutation.SetPollTokenHash(s)
+	return qlc
+}
+
+// SetClientIP sets the "client_ip" field.
+func (qlc *QRLoginCreate) SetClientIP(s string) *QRLoginCreate {
+	qlc.mutation.SetClientIP(s)
+	return qlc
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (qlc *QRLoginCreate) SetNillableClientIP(s *string) *QRLoginCreate {
+	if s != nil {
+		qlc.SetClientIP(*s)
+	}
+	return qlc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (qlc *QRLoginCreate) SetUserAgent(s string) *QRLoginCreate {
+	qlc.mutation.SetUserAgent(s)
+	return qlc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (qlc *QRLoginCreate) SetNillableUserAgent(s *string) *QRLoginCreate {
+	if s != nil {
+		qlc.SetUserAgent(*s)
+	}
+	return qlc
+}
+
+// SetStatus sets the "status" field.
+func (qlc *QRLoginCreate) SetStatus(q qrlogin.Status) *QRLoginCreate {
+	qlc.mutation.SetStatus(q)
+	return qlc
+}
+
+// SetUserID sets the "user_id" field.
+func (qlc *QRLoginCreate) SetUserID(s string) *QRLoginCreate {
+	qlc.mutation.SetUserID(s)
+	return qlc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (qlc *QRLoginCreate) SetNillableUserID(s *string) *QRLoginCreate {
+	if s != nil {
+		qlc.SetUserID(*s)
+	}
+	return qlc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (qlc *QRLoginCreate) SetExpiresAt(t time.Time) *QRLoginCreate {
+	qlc.mutation.SetExpiresAt(t)
+	return qlc
+}
+
+// SetID sets the "id" field.
+func (qlc *QRLoginCreate) SetID(u uuid.UUID) *QRLoginCreate {
+	qlc.mutation.SetID(u)
+	return qlc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (qlc *QRLoginCreate) SetNillableID(u *uuid.UUID) *QRLoginCreate {
+	if u != nil {
+		qlc.SetID(*u)
+	}
+	return qlc
+}
+
+// Mutation returns the QRLoginMutation object of the builder.
+func (qlc *QRLoginCreate) Mutation() *QRLoginMutation {
+	return qlc.mutation
+}
+
+// Save creates the QRLogin in the database.
+func (qlc *QRLoginCreate) Save(ctx context.Context) (*QRLogin, error) {
+	qlc.defaults()
+	return withHooks(ctx, qlc.sqlSave, qlc.mutation, qlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qlc *QRLoginCreate) SaveX(ctx context.Context) *QRLogin {
+	v, err := qlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qlc *QRLoginCreate) Exec(ctx context.Context) error {
+	_, err := qlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlc *QRLoginCreate) ExecX(ctx context.Context) {
+	if err := qlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qlc *QRLoginCreate) defaults() {
+	if _, ok := qlc.mutation.CreatedAt(); !ok {
+		v := qrlogin.DefaultCreatedAt()
+		qlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qlc.mutation.ID(); !ok {
+		v := qrlogin.DefaultID()
+		qlc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qlc *QRLoginCreate) check() error {
+	if _, ok := qlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QRLogin.created_at"`)}
+	}
+	if _, ok := qlc.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application_id", err: errors.New(`ent: missing required field "QRLogin.application_id"`)}
+	}
+	if _, ok := qlc.mutation.DeviceType(); !ok {
+		return &ValidationError{Name: "device_type", err: errors.New(`ent: missing required field "QRLogin.device_type"`)}
+	}
+	if v, ok := qlc.mutation.DeviceType(); ok {
+		if err := qrlogin.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "QRLogin.device_type": %w`, err)}
+		}
+	}
+	if _, ok := qlc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "QRLogin.device_id"`)}
+	}
+	if v, ok := qlc.mutation.DeviceID(); ok {
+		if err := qrlogin.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "QRLogin.device_id": %w`, err)}
+		}
+	}
+	if _, ok := qlc.mutation.PollTokenHash(); !ok {
+		return &ValidationError{Name: "poll_token_hash", err: errors.New(`ent: missing required field "QRLogin.poll_token_hash"`)}
+	}
+	if v, ok := qlc.mutation.PollTokenHash(); ok {
+		if err := qrlogin.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "QRLogin.poll_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := qlc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "QRLogin.status"`)}
+	}
+	if v, ok := qlc.mutation.Status(); ok {
+		if err := qrlogin.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QRLogin.status": %w`, err)}
+		}
+	}
+	if _, ok := qlc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "QRLogin.expires_at"`)}
+	}
+	return nil
+}
+
+func (qlc *QRLoginCreate) sqlSave(ctx context.Context) (*QRLogin, error) {
+	if err := qlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	qlc.mutation.id = &_node.ID
+	qlc.mutation.done = true
+	return _node, nil
+}
+
+func (qlc *QRLoginCreate) createSpec() (*QRLogin, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QRLogin{config: qlc.config}
+		_spec = sqlgraph.NewCreateSpec(qrlogin.Table, sqlgraph.NewFieldSpec(qrlogin.FieldID, field.TypeUUID))
+	)
+	if id, ok := qlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := qlc.mutation.CreatedAt(); ok {
+		_spec.SetField(qrlogin.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qlc.mutation.ApplicationID(); ok {
+		_spec.SetField(qrlogin.FieldApplicationID, field.TypeUUID, value)
+		_node.ApplicationID = value
+	}
+	if value, ok := qlc.mutation.DeviceType(); ok {
+		_spec.SetField(qrlogin.FieldDeviceType, field.TypeString, value)
+		_node.DeviceType = value
+	}
+	if value, ok := qlc.mutation.DeviceID(); ok {
+		_spec.SetField(qrlogin.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := qlc.mutation.PollTokenHash(); ok {
+		_spec.SetField(qrlogin.FieldPollTokenHash, field.TypeString, value)
+		_node.PollTokenHash = value
+	}
+	if value, ok := qlc.mutation.ClientIP(); ok {
+		_spec.SetField(qrlogin.FieldClientIP, field.TypeString, value)
+		_node.ClientIP = value
+	}
+	if value, ok := qlc.mutation.UserAgent(); ok {
+		_spec.SetField(qrlogin.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := qlc.mutation.Status(); ok {
+		_spec.SetField(qrlogin.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := qlc.mutation.UserID(); ok {
+		_spec.SetField(qrlogin.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := qlc.mutation.ExpiresAt(); ok {
+		_spec.SetField(qrlogin.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// QRLoginCreateBulk is the builder for creating many QRLogin entities in bulk.
+type QRLoginCreateBulk struct {
+	config
+	err      error
+	builders []*QRLoginCreate
+}
+
+// Save creates the QRLogin entities in the database.
+func (qlcb *QRLoginCreateBulk) Save(ctx context.Context) ([]*QRLogin, error) {
+	if qlcb.err != nil {
+		return nil, qlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qlcb.builders))
+	nodes := make([]*QRLogin, len(qlcb.builders))
+	mutators := make([]Mutator, len(qlcb.builders))
+	for i := range qlcb.builders {
+		func(i int, root context.Context) {
+			builder := qlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QRLoginMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qlcb *QRLoginCreateBulk) SaveX(ctx context.Context) []*QRLogin {
+	v, err := qlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qlcb *QRLoginCreateBulk) Exec(ctx context.Context) error {
+	_, err := qlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlcb *QRLoginCreateBulk) ExecX(ctx context.Context) {
+	if err := qlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
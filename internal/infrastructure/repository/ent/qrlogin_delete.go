@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/qrlogin"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QRLoginDelete is the builder for deleting a QRLogin entity.
+type QRLoginDelete struct {
+	config
+	hooks    []Hook
+	mutation *QRLoginMutation
+}
+
+// Where appends a list predicates to the QRLoginDelete builder.
+func (qld *QRLoginDelete) Where(ps ...predicate.QRLogin) *QRLoginDelete {
+	qld.mutation.Where(ps...)
+	return qld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qld *QRLoginDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qld.sqlExec, qld.mutation, qld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qld *QRLoginDelete) ExecX(ctx context.Context) int {
+	n, err := qld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qld *QRLoginDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(qrlogin.Table, sqlgraph.NewFieldSpec(qrlogin.FieldID, field.TypeUUID))
+	if ps := qld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qld.mutation.done = true
+	return affected, err
+}
+
+// QRLoginDeleteOne is the builder for deleting a single QRLogin entity.
+type QRLoginDeleteOne struct {
+	qld *QRLoginDelete
+}
+
+// Where appends a list predicates to the QRLoginDelete builder.
+func (qldo *QRLoginDeleteOne) Where(ps ...predicate.QRLogin) *QRLoginDeleteOne {
+	qldo.qld.mutation.Where(ps...)
+	return qldo
+}
+
+// Exec executes the deletion query.
+func (qldo *QRLoginDeleteOne) Exec(ctx context.Context) error {
+	n, err := qldo.qld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{qrlogin.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qldo *QRLoginDeleteOne) ExecX(ctx context.Context) {
+	if err := qldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
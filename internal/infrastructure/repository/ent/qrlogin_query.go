@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/qrlogin"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QRLoginQuery is the builder for querying QRLogin entities.
+type QRLoginQuery struct {
+	config
+	ctx        *QueryContext
+	order      []qrlogin.OrderOption
+	inters     []Interceptor
+	predicates []predicate.QRLogin
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QRLoginQuery builder.
+func (qlq *QRLoginQuery) Where(ps ...predicate.QRLogin) *QRLoginQuery {
+	qlq.predicates = append(qlq.predicates, ps...)
+	return qlq
+}
+
+// Limit the number of records to be returned by this query.
+func (qlq *QRLoginQuery) Limit(limit int) *QRLoginQuery {
+	qlq.ctx.Limit = &limit
+	return qlq
+}
+
+// Offset to start from.
+func (qlq *QRLoginQuery) Offset(offset int) *QRLoginQuery {
+	qlq.ctx.Offset = &offset
+	return qlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qlq *QRLoginQuery) Unique(unique bool) *QRLoginQuery {
+	qlq.ctx.Unique = &unique
+	return qlq
+}
+
+// Order specifies how the records should be ordered.
+func (qlq *QRLoginQuery) Order(o ...qrlogin.OrderOption) *QRLoginQuery {
+	qlq.order = append(qlq.order, o...)
+	return qlq
+}
+
+// First returns the first QRLogin entity from the query.
+// Returns a *NotFoundError when no QRLogin was found.
+func (qlq *QRLoginQuery) First(ctx context.Context) (*QRLogin, error) {
+	nodes, err := qlq.Limit(1).All(setContextOp(ctx, qlq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{qrlogin.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qlq *QRLoginQuery) FirstX(ctx context.Context) *QRLogin {
+	node, err := qlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QRLogin ID from the query.
+// Returns a *NotFoundError when no QRLogin ID was found.
+func (qlq *QRLoginQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = qlq.Limit(1).IDs(setContextOp(ctx, qlq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{qrlogin.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qlq *QRLoginQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := qlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QRLogin entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QRLogin entity is found.
+// Returns a *NotFoundError when no QRLogin entities are found.
+func (qlq *QRLoginQuery) Only(ctx context.Context) (*QRLogin, error) {
+	nodes, err := qlq.Limit(2).All(setContextOp(ctx, qlq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{qrlogin.Label}
+	default:
+		return nil, &NotSingularError{qrlogin.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qlq *QRLoginQuery) OnlyX(ctx context.Context) *QRLogin {
+	node, err := qlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QRLogin ID in the query.
+// Returns a *NotSingularError when more than one QRLogin ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qlq *QRLoginQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = qlq.Limit(2).IDs(setContextOp(ctx, qlq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{qrlogin.Label}
+	default:
+		err = &NotSingularError{qrlogin.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qlq *QRLoginQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := qlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QRLogins.
+func (qlq *QRLoginQuery) All(ctx context.Context) ([]*QRLogin, error) {
+	ctx = setContextOp(ctx, qlq.ctx, ent.OpQueryAll)
+	if err := qlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QRLogin, *QRLoginQuery]()
+	return withInterceptors[[]*QRLogin](ctx, qlq, qr, qlq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qlq *QRLoginQuery) AllX(ctx context.Context) []*QRLogin {
+	nodes, err := qlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QRLogin IDs.
+func (qlq *QRLoginQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if qlq.ctx.Unique == nil && qlq.path != nil {
+		qlq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qlq.ctx, ent.OpQueryIDs)
+	if err = qlq.Select(qrlogin.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qlq *QRLoginQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := qlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qlq *QRLoginQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qlq.ctx, ent.OpQueryCount)
+	if err := qlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qlq, querierCount[*QRLoginQuery](), qlq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qlq *QRLoginQuery) CountX(ctx context.Context) int {
+	count, err := qlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qlq *QRLoginQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qlq.ctx, ent.OpQueryExist)
+	switch _, err := qlq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qlq *QRLoginQuery) ExistX(ctx context.Context) bool {
+	exist, err := qlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QRLoginQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qlq *QRLoginQuery) Clone() *QRLoginQuery {
+	if qlq == nil {
+		return nil
+	}
+	return &QRLoginQuery{
+		config:     qlq.config,
+		ctx:        qlq.ctx.Clone(),
+		order:      append([]qrlogin.OrderOption{}, qlq.order...),
+		inters:     append([]Interceptor{}, qlq.inters...),
+		predicates: append([]predicate.QRLogin{}, qlq.predicates...),
+		// clone intermediate query.
+		sql:  qlq.sql.Clone(),
+		path: qlq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QRLogin.Query().
+//		GroupBy(qrlogin.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qlq *QRLoginQuery) GroupBy(field string, fields ...string) *QRLoginGroupBy {
+	qlq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QRLoginGroupBy{build: qlq}
+	grbuild.flds = &qlq.ctx.Fields
+	grbuild.label = qrlogin.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.QRLogin.Query().
+//		Select(qrlogin.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (qlq *QRLoginQuery) Select(fields ...string) *QRLoginSelect {
+	qlq.ctx.Fields = append(qlq.ctx.Fields, fields...)
+	sbuild := &QRLoginSelect{QRLoginQuery: qlq}
+	sbuild.label = qrlogin.Label
+	sbuild.flds, sbuild.scan = &qlq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QRLoginSelect configured with the given aggregations.
+func (qlq *QRLoginQuery) Aggregate(fns ...AggregateFunc) *QRLoginSelect {
+	return qlq.Select().Aggregate(fns...)
+}
+
+func (qlq *QRLoginQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qlq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qlq.ctx.Fields {
+		if !qrlogin.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qlq.path != nil {
+		prev, err := qlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qlq.sql = prev
+	}
+	return nil
+}
+
+func (qlq *QRLoginQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QRLogin, error) {
+	var (
+		nodes = []*QRLogin{}
+		_spec = qlq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QRLogin).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QRLogin{config: qlq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(qlq.modifiers) > 0 {
+		_spec.Modifiers = qlq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (qlq *QRLoginQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qlq.querySpec()
+	if len(qlq.modifiers) > 0 {
+		_spec.Modifiers = qlq.modifiers
+	}
+	_spec.Node.Columns = qlq.ctx.Fields
+	if len(qlq.ctx.Fields) > 0 {
+		_spec.Unique = qlq.ctx.Unique != nil && *qlq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qlq.driver, _spec)
+}
+
+func (qlq *QRLoginQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(qrlogin.Table, qrlogin.Columns, sqlgraph.NewFieldSpec(qrlogin.FieldID, field.TypeUUID))
+	_spec.From = qlq.sql
+	if unique := qlq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qlq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qlq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qrlogin.FieldID)
+		for i := range fields {
+			if fields[i] != qrlogin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qlq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qlq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qlq *QRLoginQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qlq.driver.Dialect())
+	t1 := builder.Table(qrlogin.Table)
+	columns := qlq.ctx.Fields
+	if len(columns) == 0 {
+		columns = qrlogin.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qlq.sql != nil {
+		selector = qlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qlq.ctx.Unique != nil && *qlq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range qlq.modifiers {
+		m(selector)
+	}
+	for _, p := range qlq.predicates {
+		p(selector)
+	}
+	for _, p := range qlq.order {
+		p(selector)
+	}
+	if offset := qlq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qlq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qlq *QRLoginQuery) ForUpdate(opts ...sql.LockOption) *QRLoginQuery {
+	if qlq.driver.Dialect() == dialect.Postgres {
+		qlq.Unique(false)
+	}
+	qlq.modifiers = append(qlq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qlq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qlq *QRLoginQuery) ForShare(opts ...sql.LockOption) *QRLoginQuery {
+	if qlq.driver.Dialect() == dialect.Postgres {
+		qlq.Unique(false)
+	}
+	qlq.modifiers = append(qlq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qlq
+}
+
+// QRLoginGroupBy is the group-by builder for QRLogin entities.
+type QRLoginGroupBy struct {
+	selector
+	build *QRLoginQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qlgb *QRLoginGroupBy) Aggregate(fns ...AggregateFunc) *QRLoginGroupBy {
+	qlgb.fns = append(qlgb.fns, fns...)
+	return qlgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qlgb *QRLoginGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qlgb.build.ctx, ent.OpQueryGroupBy)
+	if err := qlgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QRLoginQuery, *QRLoginGroupBy](ctx, qlgb.build, qlgb, qlgb.build.inters, v)
+}
+
+func (qlgb *QRLoginGroupBy) sqlScan(ctx context.Context, root *QRLoginQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qlgb.fns))
+	for _, fn := range qlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qlgb.flds)+len(qlgb.fns))
+		for _, f := range *qlgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qlgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qlgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QRLoginSelect is the builder for selecting fields of QRLogin entities.
+type QRLoginSelect struct {
+	*QRLoginQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qls *QRLoginSelect) Aggregate(fns ...AggregateFunc) *QRLoginSelect {
+	qls.fns = append(qls.fns, fns...)
+	return qls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qls *QRLoginSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qls.ctx, ent.OpQuerySelect)
+	if err := qls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QRLoginQuery, *QRLoginSelect](ctx, qls.QRLoginQuery, qls, qls.inters, v)
+}
+
+func (qls *QRLoginSelect) sqlScan(ctx context.Context, root *QRLoginQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qls.fns))
+	for _, fn := range qls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
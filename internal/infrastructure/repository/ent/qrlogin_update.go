@@ -0,0 +1,593 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/qrlogin"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QRLoginUpdate is the builder for updating QRLogin entities.
+type QRLoginUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QRLoginMutation
+}
+
+// Where appends a list predicates to the QRLoginUpdate builder.
+func (qlu *QRLoginUpdate) Where(ps ...predicate.QRLogin) *QRLoginUpdate {
+	qlu.mutation.Where(ps...)
+	return qlu
+}
+
+// SetApplicationID sets the "application_id" field.
+func (qlu *QRLoginUpdate) SetApplicationID(u uuid.UUID) *QRLoginUpdate {
+	qlu.mutation.SetApplicationID(u)
+	return qlu
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (qlu *QRLoginUpdate) SetNillableApplicationID(u *uuid.UUID) *QRLoginUpdate {
+	if u != nil {
+		qlu.SetApplicationID(*u)
+	}
+	return qlu
+}
+
+// SetDeviceType sets the "device_type" field.
+func (qlu *QRLoginUpdate) SetDeviceType(s string) *QRLoginUpdate {
+	qlu.mutation.SetDeviceType(s)
+	return qlu
+}
+
+// SetNillableDeviceType sets the "device_type" field if the given value is not nil.
+func (qlu *QRLoginUpdate) SetNillableDeviceType(s *string) *QRLoginUpdate {
+	if s != nil {
+		qlu.SetDeviceType(*s)
+	}
+	return qlu
+}
+
+// SetDeviceID sets the "device_id" field.
+func (qlu *QRLoginUpdate) SetDeviceID(s string) *QRLoginUpdate {
+	qlu.mutation.SetDeviceID(s)
+	return qlu
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (qlu *QRLoginUpdate) SetNillableDeviceID(s *string) *QRLoginUpdate {
+	if s != nil {
+		qlu.SetDeviceID(*s)
+	}
+	return qlu
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (qlu *QRLoginUpdate) SetPollTokenHash(s string) *QRLoginUpdate {
+	qlu.mutation.SetPollTokenHash(s)
+	return qlu
+}
+
+// SetNillablePollTokenHash sets the "poll_token_hash" field if the given value is not nil.
+func (qlu *QRLoginUpdate) SetNillablePollTokenHash(s *string) *QRLoginUpdate {
+	if s != nil {
+		qlu.SetPollTokenHash(*s)
+	}
+	return qlu
+}
+
+// SetClientIP sets the "client_ip" field.
+func (qlu *QRLoginUpdate) SetClientIP(s string) *QRLoginUpdate {
+	qlu.mutation.SetClientIP(s)
+	return qlu
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (qlu *QRLoginUpdate) SetNillableClientIP(s *string) *QRLoginUpdate {
+	if s != nil {
+		qlu.SetClientIP(*s)
+	}
+	return qlu
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (qlu *QRLoginUpdate) ClearClientIP() *QRLoginUpdate {
+	qlu.mutation.ClearClientIP()
+	return qlu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (qlu *QRLoginUpdate) SetUserAgent(s string) *QRLoginUpdate {
+	qlu.mutation.SetUserAgent(s)
+	return qlu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (qlu *QRLoginUpdate) SetNillableUserAgent(s *string) *QRLoginUpdate {
+	if s != nil {
+		qlu.SetUserAgent(*s)
+	}
+	return qlu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (qlu *QRLoginUpdate) ClearUserAgent() *QRLoginUpdate {
+	qlu.mutation.ClearUserAgent()
+	return qlu
+}
+
+// SetStatus sets the "status" field.
+func (qlu *QRLoginUpdate) SetStatus(q qrlogin.Status) *QRLoginUpdate {
+	qlu.mutation.SetStatus(q)
+	return qlu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qlu *QRLoginUpdate) SetNillableStatus(q *qrlogin.Status) *QRLoginUpdate {
+	if q != nil {
+		qlu.SetStatus(*q)
+	}
+	return qlu
+}
+
+// SetUserID sets the "user_id" field.
+func (qlu *QRLoginUpdate) SetUserID(s string) *QRLoginUpdate {
+	qlu.mutation.SetUserID(s)
+	return qlu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (qlu *QRLoginUpdate) SetNillableUserID(s *string) *QRLoginUpdate {
+	if s != nil {
+		qlu.SetUserID(*s)
+	}
+	return qlu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (qlu *QRLoginUpdate) ClearUserID() *QRLoginUpdate {
+	qlu.mutation.ClearUserID()
+	return qlu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (qlu *QRLoginUpdate) SetExpiresAt(t time.Time) *QRLoginUpdate {
+	qlu.mutation.SetExpiresAt(t)
+	return qlu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (qlu *QRLoginUpdate) SetNillableExpiresAt(t *time.Time) *QRLoginUpdate {
+	if t != nil {
+		qlu.SetExpiresAt(*t)
+	}
+	return qlu
+}
+
+// Mutation returns the QRLoginMutation object of the builder.
+func (qlu *QRLoginUpdate) Mutation() *QRLoginMutation {
+	return qlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qlu *QRLoginUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, qlu.sqlSave, qlu.mutation, qlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qlu *QRLoginUpdate) SaveX(ctx context.Context) int {
+	affected, err := qlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qlu *QRLoginUpdate) Exec(ctx context.Context) error {
+	_, err := qlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qlu *QRLoginUpdate) ExecX(ctx context.Context) {
+	if err := qlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qlu *QRLoginUpdate) check() error {
+	if v, ok := qlu.mutation.DeviceType(); ok {
+		if err := qrlogin.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "QRLogin.device_type": %w`, err)}
+		}
+	}
+	if v, ok := qlu.mutation.DeviceID(); ok {
+		if err := qrlogin.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "QRLogin.device_id": %w`, err)}
+		}
+	}
+	if v, ok := qlu.mutation.PollTokenHash(); ok {
+		if err := qrlogin.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "QRLogin.poll_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := qlu.mutation.Status(); ok {
+		if err := qrlogin.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QRLogin.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (qlu *QRLoginUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qrlogin.Table, qrlogin.Columns, sqlgraph.NewFieldSpec(qrlogin.FieldID, field.TypeUUID))
+	if ps := qlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qlu.mutation.ApplicationID(); ok {
+		_spec.SetField(qrlogin.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := qlu.mutation.DeviceType(); ok {
+		_spec.SetField(qrlogin.FieldDeviceType, field.TypeString, value)
+	}
+	if value, ok := qlu.mutation.DeviceID(); ok {
+		_spec.SetField(qrlogin.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := qlu.mutation.PollTokenHash(); ok {
+		_spec.SetField(qrlogin.FieldPollTokenHash, field.TypeString, value)
+	}
+	if value, ok := qlu.mutation.ClientIP(); ok {
+		_spec.SetField(qrlogin.FieldClientIP, field.TypeString, value)
+	}
+	if qlu.mutation.ClientIPCleared() {
+		_spec.ClearField(qrlogin.FieldClientIP, field.TypeString)
+	}
+	if value, ok := qlu.mutation.UserAgent(); ok {
+		_spec.SetField(qrlogin.FieldUserAgent, field.TypeString, value)
+	}
+	if qlu.mutation.UserAgentCleared() {
+		_spec.ClearField(qrlogin.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := qlu.mutation.Status(); ok {
+		_spec.SetField(qrlogin.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := qlu.mutation.UserID(); ok {
+		_spec.SetField(qrlogin.FieldUserID, field.TypeString, value)
+	}
+	if qlu.mutation.UserIDCleared() {
+		_spec.ClearField(qrlogin.FieldUserID, field.TypeString)
+	}
+	if value, ok := qlu.mutation.ExpiresAt(); ok {
+		_spec.SetField(qrlogin.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qrlogin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qlu.mutation.done = true
+	return n, nil
+}
+
+// QRLoginUpdateOne is the builder for updating a single QRLogin entity.
+type QRLoginUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QRLoginMutation
+}
+
+// SetApplicationID sets the "application_id" field.
+func (qluo *QRLoginUpdateOne) SetApplicationID(u uuid.UUID) *QRLoginUpdateOne {
+	qluo.mutation.SetApplicationID(u)
+	return qluo
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (qluo *QRLoginUpdateOne) SetNillableApplicationID(u *uuid.UUID) *QRLoginUpdateOne {
+	if u != nil {
+		qluo.SetApplicationID(*u)
+	}
+	return qluo
+}
+
+// SetDeviceType sets the "device_type" field.
+func (qluo *QRLoginUpdateOne) SetDeviceType(s string) *QRLoginUpdateOne {
+	qluo.mutation.SetDeviceType(s)
+	return qluo
+}
+
+// SetNillableDeviceType sets the "device_type" field if the given value is not nil.
+func (qluo *QRLoginUpdateOne) SetNillableDeviceType(s *string) *QRLoginUpdateOne {
+	if s != nil {
+		qluo.SetDeviceType(*s)
+	}
+	return qluo
+}
+
+// SetDeviceID sets the "device_id" field.
+func (qluo *QRLoginUpdateOne) SetDeviceID(s string) *QRLoginUpdateOne {
+	qluo.mutation.SetDeviceID(s)
+	return qluo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (qluo *QRLoginUpdateOne) SetNillableDeviceID(s *string) *QRLoginUpdateOne {
+	if s != nil {
+		qluo.SetDeviceID(*s)
+	}
+	return qluo
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (qluo *QRLoginUpdateOne) SetPollTokenHash(s string) *QRLoginUpdateOne {
+	qluo.mutation.SetPollTokenHash(s)
+	return qluo
+}
+
+// SetNillablePollTokenHash sets the "poll_token_hash" field if the given value is not nil.
+func (qluo *QRLoginUpdateOne) SetNillablePollTokenHash(s *string) *QRLoginUpdateOne {
+	if s != nil {
+		qluo.SetPollTokenHash(*s)
+	}
+	return qluo
+}
+
+// SetClientIP sets the "client_ip" field.
+func (qluo *QRLoginUpdateOne) SetClientIP(s string) *QRLoginUpdateOne {
+	qluo.mutation.SetClientIP(s)
+	return qluo
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (qluo *QRLoginUpdateOne) SetNillableClientIP(s *string) *QRLoginUpdateOne {
+	if s != nil {
+		qluo.SetClientIP(*s)
+	}
+	return qluo
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (qluo *QRLoginUpdateOne) ClearClientIP() *QRLoginUpdateOne {
+	qluo.mutation.ClearClientIP()
+	return qluo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (qluo *QRLoginUpdateOne) SetUserAgent(s string) *QRLoginUpdateOne {
+	qluo.mutation.SetUserAgent(s)
+	return qluo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (qluo *QRLoginUpdateOne) SetNillableUserAgent(s *string) *QRLoginUpdateOne {
+	if s != nil {
+		qluo.SetUserAgent(*s)
+	}
+	return qluo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (qluo *QRLoginUpdateOne) ClearUserAgent() *QRLoginUpdateOne {
+	qluo.mutation.ClearUserAgent()
+	return qluo
+}
+
+// SetStatus sets the "status" field.
+func (qluo *QRLoginUpdateOne) SetStatus(q qrlogin.Status) *QRLoginUpdateOne {
+	qluo.mutation.SetStatus(q)
+	return qluo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qluo *QRLoginUpdateOne) SetNillableStatus(q *qrlogin.Status) *QRLoginUpdateOne {
+	if q != nil {
+		qluo.SetStatus(*q)
+	}
+	return qluo
+}
+
+// SetUserID sets the "user_id" field.
+func (qluo *QRLoginUpdateOne) SetUserID(s string) *QRLoginUpdateOne {
+	qluo.mutation.SetUserID(s)
+	return qluo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (qluo *QRLoginUpdateOne) SetNillableUserID(s *string) *QRLoginUpdateOne {
+	if s != nil {
+		qluo.SetUserID(*s)
+	}
+	return qluo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (qluo *QRLoginUpdateOne) ClearUserID() *QRLoginUpdateOne {
+	qluo.mutation.ClearUserID()
+	return qluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (qluo *QRLoginUpdateOne) SetExpiresAt(t time.Time) *QRLoginUpdateOne {
+	qluo.mutation.SetExpiresAt(t)
+	return qluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (qluo *QRLoginUpdateOne) SetNillableExpiresAt(t *time.Time) *QRLoginUpdateOne {
+	if t != nil {
+		qluo.SetExpiresAt(*t)
+	}
+	return qluo
+}
+
+// Mutation returns the QRLoginMutation object of the builder.
+func (qluo *QRLoginUpdateOne) Mutation() *QRLoginMutation {
+	return qluo.mutation
+}
+
+// Where appends a list predicates to the QRLoginUpdate builder.
+func (qluo *QRLoginUpdateOne) Where(ps ...predicate.QRLogin) *QRLoginUpdateOne {
+	qluo.mutation.Where(ps...)
+	return qluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qluo *QRLoginUpdateOne) Select(field string, fields ...string) *QRLoginUpdateOne {
+	qluo.fields = append([]string{field}, fields...)
+	return qluo
+}
+
+// Save executes the query and returns the updated QRLogin entity.
+func (qluo *QRLoginUpdateOne) Save(ctx context.Context) (*QRLogin, error) {
+	return withHooks(ctx, qluo.sqlSave, qluo.mutation, qluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qluo *QRLoginUpdateOne) SaveX(ctx context.Context) *QRLogin {
+	node, err := qluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qluo *QRLoginUpdateOne) Exec(ctx context.Context) error {
+	_, err := qluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qluo *QRLoginUpdateOne) ExecX(ctx context.Context) {
+	if err := qluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qluo *QRLoginUpdateOne) check() error {
+	if v, ok := qluo.mutation.DeviceType(); ok {
+		if err := qrlogin.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "QRLogin.device_type": %w`, err)}
+		}
+	}
+	if v, ok := qluo.mutation.DeviceID(); ok {
+		if err := qrlogin.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "QRLogin.device_id": %w`, err)}
+		}
+	}
+	if v, ok := qluo.mutation.PollTokenHash(); ok {
+		if err := qrlogin.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "QRLogin.poll_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := qluo.mutation.Status(); ok {
+		if err := qrlogin.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QRLogin.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (qluo *QRLoginUpdateOne) sqlSave(ctx context.Context) (_node *QRLogin, err error) {
+	if err := qluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qrlogin.Table, qrlogin.Columns, sqlgraph.NewFieldSpec(qrlogin.FieldID, field.TypeUUID))
+	id, ok := qluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QRLogin.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qrlogin.FieldID)
+		for _, f := range fields {
+			if !qrlogin.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != qrlogin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qluo.mutation.ApplicationID(); ok {
+		_spec.SetField(qrlogin.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := qluo.mutation.DeviceType(); ok {
+		_spec.SetField(qrlogin.FieldDeviceType, field.TypeString, value)
+	}
+	if value, ok := qluo.mutation.DeviceID(); ok {
+		_spec.SetField(qrlogin.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := qluo.mutation.PollTokenHash(); ok {
+		_spec.SetField(qrlogin.FieldPollTokenHash, field.TypeString, value)
+	}
+	if value, ok := qluo.mutation.ClientIP(); ok {
+		_spec.SetField(qrlogin.FieldClientIP, field.TypeString, value)
+	}
+	if qluo.mutation.ClientIPCleared() {
+		_spec.ClearField(qrlogin.FieldClientIP, field.TypeString)
+	}
+	if value, ok := qluo.mutation.UserAgent(); ok {
+		_spec.SetField(qrlogin.FieldUserAgent, field.TypeString, value)
+	}
+	if qluo.mutation.UserAgentCleared() {
+		_spec.ClearField(qrlogin.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := qluo.mutation.Status(); ok {
+		_spec.SetField(qrlogin.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := qluo.mutation.UserID(); ok {
+		_spec.SetField(qrlogin.FieldUserID, field.TypeString, value)
+	}
+	if qluo.mutation.UserIDCleared() {
+		_spec.ClearField(qrlogin.FieldUserID, field.TypeString)
+	}
+	if value, ok := qluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(qrlogin.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &QRLogin{config: qluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qrlogin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qluo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatcorp"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// QyWechatCorp is the model entity for the QyWechatCorp schema.
+type QyWechatCorp struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// CorpID holds the value of the "corp_id" field.
+	CorpID string `json:"corp_id,omitempty"`
+	// CorpSecret holds the value of the "corp_secret" field.
+	CorpSecret string `json:"-"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled      bool `json:"enabled,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QyWechatCorp) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case qywechatcorp.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case qywechatcorp.FieldCorpID, qywechatcorp.FieldCorpSecret:
+			values[i] = new(sql.NullString)
+		case qywechatcorp.FieldCreatedAt, qywechatcorp.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case qywechatcorp.FieldID, qywechatcorp.FieldOrganizationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QyWechatCorp fields.
+func (qwc *QyWechatCorp) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case qywechatcorp.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				qwc.ID = *value
+			}
+		case qywechatcorp.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				qwc.CreatedAt = value.Time
+			}
+		case qywechatcorp.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				qwc.UpdatedAt = value.Time
+			}
+		case qywechatcorp.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				qwc.OrganizationID = *value
+			}
+		case qywechatcorp.FieldCorpID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field corp_id", values[i])
+			} else if value.Valid {
+				qwc.CorpID = value.String
+			}
+		case qywechatcorp.FieldCorpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field corp_secret", values[i])
+			} else if value.Valid {
+				qwc.CorpSecret = value.String
+			}
+		case qywechatcorp.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				qwc.Enabled = value.Bool
+			}
+		default:
+			qwc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QyWechatCorp.
+// This includes values selected through modifiers, order, etc.
+func (qwc *QyWechatCorp) Value(name string) (ent.Value, error) {
+	return qwc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this QyWechatCorp.
+// Note that you need to call QyWechatCorp.Unwrap() before calling this method if this QyWechatCorp
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qwc *QyWechatCorp) Update() *QyWechatCorpUpdateOne {
+	return NewQyWechatCorpClient(qwc.config).UpdateOne(qwc)
+}
+
+// Unwrap unwraps the QyWechatCorp entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qwc *QyWechatCorp) Unwrap() *QyWechatCorp {
+	_tx, ok := qwc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QyWechatCorp is not a transactional entity")
+	}
+	qwc.config.driver = _tx.drv
+	return qwc
+}
+
+// String implements the fmt.Stringer.
+func (qwc *QyWechatCorp) String() string {
+	var builder strings.Builder
+	builder.WriteString("QyWechatCorp(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qwc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(qwc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(qwc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", qwc.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("corp_id=")
+	builder.WriteString(qwc.CorpID)
+	builder.WriteString(", ")
+	builder.WriteString("corp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", qwc.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QyWechatCorps is a parsable slice of QyWechatCorp.
+type QyWechatCorps []*QyWechatCorp
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package qywechatcorp
+
+import (
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// CorpID applies equality check predicate on the "corp_id" field. It's identical to CorpIDEQ.
+func CorpID(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldCorpID, v))
+}
+
+// CorpSecret applies equality check predicate on the "corp_secret" field. It's identical to CorpSecretEQ.
+func CorpSecret(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldCorpSecret, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v uuid.UUID) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// CorpIDEQ applies the EQ predicate on the "corp_id" field.
+func CorpIDEQ(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldCorpID, v))
+}
+
+// CorpIDNEQ applies the NEQ predicate on the "corp_id" field.
+func CorpIDNEQ(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNEQ(FieldCorpID, v))
+}
+
+// CorpIDIn applies the In predicate on the "corp_id" field.
+func CorpIDIn(vs ...string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldIn(FieldCorpID, vs...))
+}
+
+// CorpIDNotIn applies the NotIn predicate on the "corp_id" field.
+func CorpIDNotIn(vs ...string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNotIn(FieldCorpID, vs...))
+}
+
+// CorpIDGT applies the GT predicate on the "corp_id" field.
+func CorpIDGT(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGT(FieldCorpID, v))
+}
+
+// CorpIDGTE applies the GTE predicate on the "corp_id" field.
+func CorpIDGTE(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGTE(FieldCorpID, v))
+}
+
+// CorpIDLT applies the LT predicate on the "corp_id" field.
+func CorpIDLT(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLT(FieldCorpID, v))
+}
+
+// CorpIDLTE applies the LTE predicate on the "corp_id" field.
+func CorpIDLTE(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLTE(FieldCorpID, v))
+}
+
+// CorpIDContains applies the Contains predicate on the "corp_id" field.
+func CorpIDContains(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldContains(FieldCorpID, v))
+}
+
+// CorpIDHasPrefix applies the HasPrefix predicate on the "corp_id" field.
+func CorpIDHasPrefix(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldHasPrefix(FieldCorpID, v))
+}
+
+// CorpIDHasSuffix applies the HasSuffix predicate on the "corp_id" field.
+func CorpIDHasSuffix(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldHasSuffix(FieldCorpID, v))
+}
+
+// CorpIDEqualFold applies the EqualFold predicate on the "corp_id" field.
+func CorpIDEqualFold(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEqualFold(FieldCorpID, v))
+}
+
+// CorpIDContainsFold applies the ContainsFold predicate on the "corp_id" field.
+func CorpIDContainsFold(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldContainsFold(FieldCorpID, v))
+}
+
+// CorpSecretEQ applies the EQ predicate on the "corp_secret" field.
+func CorpSecretEQ(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldCorpSecret, v))
+}
+
+// CorpSecretNEQ applies the NEQ predicate on the "corp_secret" field.
+func CorpSecretNEQ(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNEQ(FieldCorpSecret, v))
+}
+
+// CorpSecretIn applies the In predicate on the "corp_secret" field.
+func CorpSecretIn(vs ...string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldIn(FieldCorpSecret, vs...))
+}
+
+// CorpSecretNotIn applies the NotIn predicate on the "corp_secret" field.
+func CorpSecretNotIn(vs ...string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNotIn(FieldCorpSecret, vs...))
+}
+
+// CorpSecretGT applies the GT predicate on the "corp_secret" field.
+func CorpSecretGT(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGT(FieldCorpSecret, v))
+}
+
+// CorpSecretGTE applies the GTE predicate on the "corp_secret" field.
+func CorpSecretGTE(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldGTE(FieldCorpSecret, v))
+}
+
+// CorpSecretLT applies the LT predicate on the "corp_secret" field.
+func CorpSecretLT(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLT(FieldCorpSecret, v))
+}
+
+// CorpSecretLTE applies the LTE predicate on the "corp_secret" field.
+func CorpSecretLTE(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldLTE(FieldCorpSecret, v))
+}
+
+// CorpSecretContains applies the Contains predicate on the "corp_secret" field.
+func CorpSecretContains(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldContains(FieldCorpSecret, v))
+}
+
+// CorpSecretHasPrefix applies the HasPrefix predicate on the "corp_secret" field.
+func CorpSecretHasPrefix(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldHasPrefix(FieldCorpSecret, v))
+}
+
+// CorpSecretHasSuffix applies the HasSuffix predicate on the "corp_secret" field.
+func CorpSecretHasSuffix(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldHasSuffix(FieldCorpSecret, v))
+}
+
+// CorpSecretEqualFold applies the EqualFold predicate on the "corp_secret" field.
+func CorpSecretEqualFold(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEqualFold(FieldCorpSecret, v))
+}
+
+// CorpSecretContainsFold applies the ContainsFold predicate on the "corp_secret" field.
+func CorpSecretContainsFold(v string) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldContainsFold(FieldCorpSecret, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QyWechatCorp) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QyWechatCorp) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QyWechatCorp) predicate.QyWechatCorp {
+	return predicate.QyWechatCorp(sql.NotPredicates(p))
+}
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatcorp"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QyWechatCorpCreate is the builder for creating a QyWechatCorp entity.
+type QyWechatCorpCreate struct {
+	config
+	mutation *QyWechatCorpMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qwcc *QyWechatCorpCreate) SetCreatedAt(t time.Time) *QyWechatCorpCreate {
+	qwcc.mutation.SetCreatedAt(t)
+	return qwcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qwcc *QyWechatCorpCreate) SetNillableCreatedAt(t *time.Time) *QyWechatCorpCreate {
+	if t != nil {
+		qwcc.SetCreatedAt(*t)
+	}
+	return qwcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qwcc *QyWechatCorpCreate) SetUpdatedAt(t time.Time) *QyWechatCorpCreate {
+	qwcc.mutation.SetUpdatedAt(t)
+	return qwcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qwcc *QyWechatCorpCreate) SetNillableUpdatedAt(t *time.Time) *QyWechatCorpCreate {
+	if t != nil {
+		qwcc.SetUpdatedAt(*t)
+	}
+	return qwcc
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (qwcc *QyWechatCorpCreate) SetOrganizationID(u uuid.UUID) *QyWechatCorpCreate {
+	qwcc.mutation.SetOrganizationID(u)
+	return qwcc
+}
+
+// SetCorpID sets the "corp_id" field.
+func (qwcc *QyWechatCorpCreate) SetCorpID(s string) *QyWechatCorpCreate {
+	qwcc.mutation.SetCorpID(s)
+	return qwcc
+}
+
+// SetCorpSecret sets the "corp_secret" field.
+func (qwcc *QyWechatCorpCreate) SetCorpSecret(s string) *QyWechatCorpCreate {
+	qwcc.mutation.SetCorpSecret(s)
+	return qwcc
+}
+
+// SetEnabled sets the "enabled" field.
+func (qwcc *QyWechatCorpCreate) SetEnabled(b bool) *QyWechatCorpCreate {
+	qwcc.mutation.SetEnabled(b)
+	return qwcc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (qwcc *QyWechatCorpCreate) SetNillableEnabled(b *bool) *QyWechatCorpCreate {
+	if b != nil {
+		qwcc.SetEnabled(*b)
+	}
+	return qwcc
+}
+
+// SetID sets the "id" field.
+func (qwcc *QyWechatCorpCreate) SetID(u uuid.UUID) *QyWechatCorpCreate {
+	qwcc.mutation.SetID(u)
+	return qwcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (qwcc *QyWechatCorpCreate) SetNillableID(u *uuid.UUID) *QyWechatCorpCreate {
+	if u != nil {
+		qwcc.SetID(*u)
+	}
+	return qwcc
+}
+
+// Mutation returns the QyWechatCorpMutation object of the builder.
+func (qwcc *QyWechatCorpCreate) Mutation() *QyWechatCorpMutation {
+	return qwcc.mutation
+}
+
+// Save creates the QyWechatCorp in the database.
+func (qwcc *QyWechatCorpCreate) Save(ctx context.Context) (*QyWechatCorp, error) {
+	qwcc.defaults()
+	return withHooks(ctx, qwcc.sqlSave, qwcc.mutation, qwcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qwcc *QyWechatCorpCreate) SaveX(ctx context.Context) *QyWechatCorp {
+	v, err := qwcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qwcc *QyWechatCorpCreate) Exec(ctx context.Context) error {
+	_, err := qwcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qwcc *QyWechatCorpCreate) ExecX(ctx context.Context) {
+	if err := qwcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qwcc *QyWechatCorpCreate) defaults() {
+	if _, ok := qwcc.mutation.CreatedAt(); !ok {
+		v := qywechatcorp.DefaultCreatedAt()
+		qwcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qwcc.mutation.UpdatedAt(); !ok {
+		v := qywechatcorp.DefaultUpdatedAt()
+		qwcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := qwcc.mutation.Enabled(); !ok {
+		v := qywechatcorp.DefaultEnabled
+		qwcc.mutation.SetEnabled(v)
+	}
+	if _, ok := qwcc.mutation.ID(); !ok {
+		v := qywechatcorp.DefaultID()
+		qwcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qwcc *QyWechatCorpCreate) check() error {
+	if _, ok := qwcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QyWechatCorp.created_at"`)}
+	}
+	if _, ok := qwcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QyWechatCorp.updated_at"`)}
+	}
+	if _, ok := qwcc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "QyWechatCorp.organization_id"`)}
+	}
+	if _, ok := qwcc.mutation.CorpID(); !ok {
+		return &ValidationError{Name: "corp_id", err: errors.New(`ent: missing required field "QyWechatCorp.corp_id"`)}
+	}
+	if v, ok := qwcc.mutation.CorpID(); ok {
+		if err := qywechatcorp.CorpIDValidator(v); err != nil {
+			return &ValidationError{Name: "corp_id", err: fmt.Errorf(`ent: validator failed for field "QyWechatCorp.corp_id": %w`, err)}
+		}
+	}
+	if _, ok := qwcc.mutation.CorpSecret(); !ok {
+		return &ValidationError{Name: "corp_secret", err: errors.New(`ent: missing required field "QyWechatCorp.corp_secret"`)}
+	}
+	if v, ok := qwcc.mutation.CorpSecret(); ok {
+		if err := qywechatcorp.CorpSecretValidator(v); err != nil {
+			return &ValidationError{Name: "corp_secret", err: fmt.Errorf(`ent: validator failed for field "QyWechatCorp.corp_secret": %w`, err)}
+		}
+	}
+	if _, ok := qwcc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "QyWechatCorp.enabled"`)}
+	}
+	return nil
+}
+
+func (qwcc *QyWechatCorpCreate) sqlSave(ctx context.Context) (*QyWechatCorp, error) {
+	if err := qwcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qwcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qwcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	qwcc.mutation.id = &_node.ID
+	qwcc.mutation.done = true
+	return _node, nil
+}
+
+func (qwcc *QyWechatCorpCreate) createSpec() (*QyWechatCorp, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QyWechatCorp{config: qwcc.config}
+		_spec = sqlgraph.NewCreateSpec(qywechatcorp.Table, sqlgraph.NewFieldSpec(qywechatcorp.FieldID, field.TypeUUID))
+	)
+	if id, ok := qwcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := qwcc.mutation.CreatedAt(); ok {
+		_spec.SetField(qywechatcorp.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qwcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(qywechatcorp.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := qwcc.mutation.OrganizationID(); ok {
+		_spec.SetField(qywechatcorp.FieldOrganizationID, field.TypeUUID, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := qwcc.mutation.CorpID(); ok {
+		_spec.SetField(qywechatcorp.FieldCorpID, field.TypeString, value)
+		_node.CorpID = value
+	}
+	if value, ok := qwcc.mutation.CorpSecret(); ok {
+		_spec.SetField(qywechatcorp.FieldCorpSecret, field.TypeString, value)
+		_node.CorpSecret = value
+	}
+	if value, ok := qwcc.mutation.Enabled(); ok {
+		_spec.SetField(qywechatcorp.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// QyWechatCorpCreateBulk is the builder for creating many QyWechatCorp entities in bulk.
+type QyWechatCorpCreateBulk struct {
+	config
+	err      error
+	builders []*QyWechatCorpCreate
+}
+
+// Save creates the QyWechatCorp entities in the database.
+func (qwccb *QyWechatCorpCreateBulk) Save(ctx context.Context) ([]*QyWechatCorp, error) {
+	if qwccb.err != nil {
+		return nil, qwccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qwccb.builders))
+	nodes := make([]*QyWechatCorp, len(qwccb.builders))
+	mutators := make([]Mutator, len(qwccb.builders))
+	for i := range qwccb.builders {
+		func(i int, root context.Context) {
+			builder := qwccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QyWechatCorpMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qwccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qwccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qwccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qwccb *QyWechatCorpCreateBulk) SaveX(ctx context.Context) []*QyWechatCorp {
+	v, err := qwccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qwccb *QyWechatCorpCreateBulk) Exec(ctx context.Context) error {
+	_, err := qwccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qwccb *QyWechatCorpCreateBulk) ExecX(ctx context.Context) {
+	if err := qwccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatcorp"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QyWechatCorpDelete is the builder for deleting a QyWechatCorp entity.
+type QyWechatCorpDelete struct {
+	config
+	hooks    []Hook
+	mutation *QyWechatCorpMutation
+}
+
+// Where appends a list predicates to the QyWechatCorpDelete builder.
+func (qwcd *QyWechatCorpDelete) Where(ps ...predicate.QyWechatCorp) *QyWechatCorpDelete {
+	qwcd.mutation.Where(ps...)
+	return qwcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qwcd *QyWechatCorpDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qwcd.sqlExec, qwcd.mutation, qwcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qwcd *QyWechatCorpDelete) ExecX(ctx context.Context) int {
+	n, err := qwcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qwcd *QyWechatCorpDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(qywechatcorp.Table, sqlgraph.NewFieldSpec(qywechatcorp.FieldID, field.TypeUUID))
+	if ps := qwcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qwcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qwcd.mutation.done = true
+	return affected, err
+}
+
+// QyWechatCorpDeleteOne is the builder for deleting a single QyWechatCorp entity.
+type QyWechatCorpDeleteOne struct {
+	qwcd *QyWechatCorpDelete
+}
+
+// Where appends a list predicates to the QyWechatCorpDelete builder.
+func (qwcdo *QyWechatCorpDeleteOne) Where(ps ...predicate.QyWechatCorp) *QyWechatCorpDeleteOne {
+	qwcdo.qwcd.mutation.Where(ps...)
+	return qwcdo
+}
+
+// Exec executes the deletion query.
+func (qwcdo *QyWechatCorpDeleteOne) Exec(ctx context.Context) error {
+	n, err := qwcdo.qwcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{qywechatcorp.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qwcdo *QyWechatCorpDeleteOne) ExecX(ctx context.Context) {
+	if err := qwcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatcorp"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QyWechatCorpQuery is the builder for querying QyWechatCorp entities.
+type QyWechatCorpQuery struct {
+	config
+	ctx        *QueryContext
+	order      []qywechatcorp.OrderOption
+	inters     []Interceptor
+	predicates []predicate.QyWechatCorp
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QyWechatCorpQuery builder.
+func (qwcq *QyWechatCorpQuery) Where(ps ...predicate.QyWechatCorp) *QyWechatCorpQuery {
+	qwcq.predicates = append(qwcq.predicates, ps...)
+	return qwcq
+}
+
+// Limit the number of records to be returned by this query.
+func (qwcq *QyWechatCorpQuery) Limit(limit int) *QyWechatCorpQuery {
+	qwcq.ctx.Limit = &limit
+	return qwcq
+}
+
+// Offset to start from.
+func (qwcq *QyWechatCorpQuery) Offset(offset int) *QyWechatCorpQuery {
+	qwcq.ctx.Offset = &offset
+	return qwcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qwcq *QyWechatCorpQuery) Unique(unique bool) *QyWechatCorpQuery {
+	qwcq.ctx.Unique = &unique
+	return qwcq
+}
+
+// Order specifies how the records should be ordered.
+func (qwcq *QyWechatCorpQuery) Order(o ...qywechatcorp.OrderOption) *QyWechatCorpQuery {
+	qwcq.order = append(qwcq.order, o...)
+	return qwcq
+}
+
+// First returns the first QyWechatCorp entity from the query.
+// Returns a *NotFoundError when no QyWechatCorp was found.
+func (qwcq *QyWechatCorpQuery) First(ctx context.Context) (*QyWechatCorp, error) {
+	nodes, err := qwcq.Limit(1).All(setContextOp(ctx, qwcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{qywechatcorp.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qwcq *QyWechatCorpQuery) FirstX(ctx context.Context) *QyWechatCorp {
+	node, err := qwcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QyWechatCorp ID from the query.
+// Returns a *NotFoundError when no QyWechatCorp ID was found.
+func (qwcq *QyWechatCorpQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = qwcq.Limit(1).IDs(setContextOp(ctx, qwcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{qywechatcorp.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qwcq *QyWechatCorpQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := qwcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QyWechatCorp entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QyWechatCorp entity is found.
+// Returns a *NotFoundError when no QyWechatCorp entities are found.
+func (qwcq *QyWechatCorpQuery) Only(ctx context.Context) (*QyWechatCorp, error) {
+	nodes, err := qwcq.Limit(2).All(setContextOp(ctx, qwcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{qywechatcorp.Label}
+	default:
+		return nil, &NotSingularError{qywechatcorp.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qwcq *QyWechatCorpQuery) OnlyX(ctx context.Context) *QyWechatCorp {
+	node, err := qwcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QyWechatCorp ID in the query.
+// Returns a *NotSingularError when more than one QyWechatCorp ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qwcq *QyWechatCorpQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = qwcq.Limit(2).IDs(setContextOp(ctx, qwcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{qywechatcorp.Label}
+	default:
+		err = &NotSingularError{qywechatcorp.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qwcq *QyWechatCorpQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := qwcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QyWechatCorps.
+func (qwcq *QyWechatCorpQuery) All(ctx context.Context) ([]*QyWechatCorp, error) {
+	ctx = setContextOp(ctx, qwcq.ctx, ent.OpQueryAll)
+	if err := qwcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QyWechatCorp, *QyWechatCorpQuery]()
+	return withInterceptors[[]*QyWechatCorp](ctx, qwcq, qr, qwcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qwcq *QyWechatCorpQuery) AllX(ctx context.Context) []*QyWechatCorp {
+	nodes, err := qwcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QyWechatCorp IDs.
+func (qwcq *QyWechatCorpQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if qwcq.ctx.Unique == nil && qwcq.path != nil {
+		qwcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qwcq.ctx, ent.OpQueryIDs)
+	if err = qwcq.Select(qywechatcorp.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qwcq *QyWechatCorpQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := qwcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qwcq *QyWechatCorpQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qwcq.ctx, ent.OpQueryCount)
+	if err := qwcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qwcq, querierCount[*QyWechatCorpQuery](), qwcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qwcq *QyWechatCorpQuery) CountX(ctx context.Context) int {
+	count, err := qwcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qwcq *QyWechatCorpQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qwcq.ctx, ent.OpQueryExist)
+	switch _, err := qwcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qwcq *QyWechatCorpQuery) ExistX(ctx context.Context) bool {
+	exist, err := qwcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QyWechatCorpQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qwcq *QyWechatCorpQuery) Clone() *QyWechatCorpQuery {
+	if qwcq == nil {
+		return nil
+	}
+	return &QyWechatCorpQuery{
+		config:     qwcq.config,
+		ctx:        qwcq.ctx.Clone(),
+		order:      append([]qywechatcorp.OrderOption{}, qwcq.order...),
+		inters:     append([]Interceptor{}, qwcq.inters...),
+		predicates: append([]predicate.QyWechatCorp{}, qwcq.predicates...),
+		// clone intermediate query.
+		sql:  qwcq.sql.Clone(),
+		path: qwcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QyWechatCorp.Query().
+//		GroupBy(qywechatcorp.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qwcq *QyWechatCorpQuery) GroupBy(field string, fields ...string) *QyWechatCorpGroupBy {
+	qwcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QyWechatCorpGroupBy{build: qwcq}
+	grbuild.flds = &qwcq.ctx.Fields
+	grbuild.label = qywechatcorp.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.QyWechatCorp.Query().
+//		Select(qywechatcorp.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (qwcq *QyWechatCorpQuery) Select(fields ...string) *QyWechatCorpSelect {
+	qwcq.ctx.Fields = append(qwcq.ctx.Fields, fields...)
+	sbuild := &QyWechatCorpSelect{QyWechatCorpQuery: qwcq}
+	sbuild.label = qywechatcorp.Label
+	sbuild.flds, sbuild.scan = &qwcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QyWechatCorpSelect configured with the given aggregations.
+func (qwcq *QyWechatCorpQuery) Aggregate(fns ...AggregateFunc) *QyWechatCorpSelect {
+	return qwcq.Select().Aggregate(fns...)
+}
+
+func (qwcq *QyWechatCorpQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qwcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qwcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qwcq.ctx.Fields {
+		if !qywechatcorp.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qwcq.path != nil {
+		prev, err := qwcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qwcq.sql = prev
+	}
+	return nil
+}
+
+func (qwcq *QyWechatCorpQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QyWechatCorp, error) {
+	var (
+		nodes = []*QyWechatCorp{}
+		_spec = qwcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QyWechatCorp).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QyWechatCorp{config: qwcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(qwcq.modifiers) > 0 {
+		_spec.Modifiers = qwcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qwcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (qwcq *QyWechatCorpQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qwcq.querySpec()
+	if len(qwcq.modifiers) > 0 {
+		_spec.Modifiers = qwcq.modifiers
+	}
+	_spec.Node.Columns = qwcq.ctx.Fields
+	if len(qwcq.ctx.Fields) > 0 {
+		_spec.Unique = qwcq.ctx.Unique != nil && *qwcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qwcq.driver, _spec)
+}
+
+func (qwcq *QyWechatCorpQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(qywechatcorp.Table, qywechatcorp.Columns, sqlgraph.NewFieldSpec(qywechatcorp.FieldID, field.TypeUUID))
+	_spec.From = qwcq.sql
+	if unique := qwcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qwcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qwcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qywechatcorp.FieldID)
+		for i := range fields {
+			if fields[i] != qywechatcorp.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qwcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qwcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qwcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qwcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qwcq *QyWechatCorpQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qwcq.driver.Dialect())
+	t1 := builder.Table(qywechatcorp.Table)
+	columns := qwcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = qywechatcorp.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qwcq.sql != nil {
+		selector = qwcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qwcq.ctx.Unique != nil && *qwcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range qwcq.modifiers {
+		m(selector)
+	}
+	for _, p := range qwcq.predicates {
+		p(selector)
+	}
+	for _, p := range qwcq.order {
+		p(selector)
+	}
+	if offset := qwcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qwcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (qwcq *QyWechatCorpQuery) ForUpdate(opts ...sql.LockOption) *QyWechatCorpQuery {
+	if qwcq.driver.Dialect() == dialect.Postgres {
+		qwcq.Unique(false)
+	}
+	qwcq.modifiers = append(qwcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return qwcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (qwcq *QyWechatCorpQuery) ForShare(opts ...sql.LockOption) *QyWechatCorpQuery {
+	if qwcq.driver.Dialect() == dialect.Postgres {
+		qwcq.Unique(false)
+	}
+	qwcq.modifiers = append(qwcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return qwcq
+}
+
+// QyWechatCorpGroupBy is the group-by builder for QyWechatCorp entities.
+type QyWechatCorpGroupBy struct {
+	selector
+	build *QyWechatCorpQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qwcgb *QyWechatCorpGroupBy) Aggregate(fns ...AggregateFunc) *QyWechatCorpGroupBy {
+	qwcgb.fns = append(qwcgb.fns, fns...)
+	return qwcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qwcgb *QyWechatCorpGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qwcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := qwcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QyWechatCorpQuery, *QyWechatCorpGroupBy](ctx, qwcgb.build, qwcgb, qwcgb.build.inters, v)
+}
+
+func (qwcgb *QyWechatCorpGroupBy) sqlScan(ctx context.Context, root *QyWechatCorpQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qwcgb.fns))
+	for _, fn := range qwcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qwcgb.flds)+len(qwcgb.fns))
+		for _, f := range *qwcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qwcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qwcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QyWechatCorpSelect is the builder for selecting fields of QyWechatCorp entities.
+type QyWechatCorpSelect struct {
+	*QyWechatCorpQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qwcs *QyWechatCorpSelect) Aggregate(fns ...AggregateFunc) *QyWechatCorpSelect {
+	qwcs.fns = append(qwcs.fns, fns...)
+	return qwcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qwcs *QyWechatCorpSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qwcs.ctx, ent.OpQuerySelect)
+	if err := qwcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QyWechatCorpQuery, *QyWechatCorpSelect](ctx, qwcs.QyWechatCorpQuery, qwcs, qwcs.inters, v)
+}
+
+func (qwcs *QyWechatCorpSelect) sqlScan(ctx context.Context, root *QyWechatCorpQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qwcs.fns))
+	for _, fn := range qwcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qwcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qwcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatcorp"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QyWechatCorpUpdate is the builder for updating QyWechatCorp entities.
+type QyWechatCorpUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QyWechatCorpMutation
+}
+
+// Where appends a list predicates to the QyWechatCorpUpdate builder.
+func (qwcu *QyWechatCorpUpdate) Where(ps ...predicate.QyWechatCorp) *QyWechatCorpUpdate {
+	qwcu.mutation.Where(ps...)
+	return qwcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qwcu *QyWechatCorpUpdate) SetUpdatedAt(t time.Time) *QyWechatCorpUpdate {
+	qwcu.mutation.SetUpdatedAt(t)
+	return qwcu
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (qwcu *QyWechatCorpUpdate) SetOrganizationID(u uuid.UUID) *QyWechatCorpUpdate {
+	qwcu.mutation.SetOrganizationID(u)
+	return qwcu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (qwcu *QyWechatCorpUpdate) SetNillableOrganizationID(u *uuid.UUID) *QyWechatCorpUpdate {
+	if u != nil {
+		qwcu.SetOrganizationID(*u)
+	}
+	return qwcu
+}
+
+// SetCorpID sets the "corp_id" field.
+func (qwcu *QyWechatCorpUpdate) SetCorpID(s string) *QyWechatCorpUpdate {
+	qwcu.mutation.SetCorpID(s)
+	return qwcu
+}
+
+// SetNillableCorpID sets the "corp_id" field if the given value is not nil.
+func (qwcu *QyWechatCorpUpdate) SetNillableCorpID(s *string) *QyWechatCorpUpdate {
+	if s != nil {
+		qwcu.SetCorpID(*s)
+	}
+	return qwcu
+}
+
+// SetCorpSecret sets the "corp_secret" field.
+func (qwcu *QyWechatCorpUpdate) SetCorpSecret(s string) *QyWechatCorpUpdate {
+	qwcu.mutation.SetCorpSecret(s)
+	return qwcu
+}
+
+// SetNillableCorpSecret sets the "corp_secret" field if the given value is not nil.
+func (qwcu *QyWechatCorpUpdate) SetNillableCorpSecret(s *string) *QyWechatCorpUpdate {
+	if s != nil {
+		qwcu.SetCorpSecret(*s)
+	}
+	return qwcu
+}
+
+// SetEnabled sets the "enabled" field.
+func (qwcu *QyWechatCorpUpdate) SetEnabled(b bool) *QyWechatCorpUpdate {
+	qwcu.mutation.SetEnabled(b)
+	return qwcu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (qwcu *QyWechatCorpUpdate) SetNillableEnabled(b *bool) *QyWechatCorpUpdate {
+	if b != nil {
+		qwcu.SetEnabled(*b)
+	}
+	return qwcu
+}
+
+// Mutation returns the QyWechatCorpMutation object of the builder.
+func (qwcu *QyWechatCorpUpdate) Mutation() *QyWechatCorpMutation {
+	return qwcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qwcu *QyWechatCorpUpdate) Save(ctx context.Context) (int, error) {
+	qwcu.defaults()
+	return withHooks(ctx, qwcu.sqlSave, qwcu.mutation, qwcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qwcu *QyWechatCorpUpdate) SaveX(ctx context.Context) int {
+	affected, err := qwcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qwcu *QyWechatCorpUpdate) Exec(ctx context.Context) error {
+	_, err := qwcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qwcu *QyWechatCorpUpdate) ExecX(ctx context.Context) {
+	if err := qwcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qwcu *QyWechatCorpUpdate) defaults() {
+	if _, ok := qwcu.mutation.UpdatedAt(); !ok {
+		v := qywechatcorp.UpdateDefaultUpdatedAt()
+		qwcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qwcu *QyWechatCorpUpdate) check() error {
+	if v, ok := qwcu.mutation.CorpID(); ok {
+		if err := qywechatcorp.CorpIDValidator(v); err != nil {
+			return &ValidationError{Name: "corp_id", err: fmt.Errorf(`ent: validator failed for field "QyWechatCorp.corp_id": %w`, err)}
+		}
+	}
+	if v, ok := qwcu.mutation.CorpSecret(); ok {
+		if err := qywechatcorp.CorpSecretValidator(v); err != nil {
+			return &ValidationError{Name: "corp_secret", err: fmt.Errorf(`ent: validator failed for field "QyWechatCorp.corp_secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (qwcu *QyWechatCorpUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qwcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qywechatcorp.Table, qywechatcorp.Columns, sqlgraph.NewFieldSpec(qywechatcorp.FieldID, field.TypeUUID))
+	if ps := qwcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qwcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(qywechatcorp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := qwcu.mutation.OrganizationID(); ok {
+		_spec.SetField(qywechatcorp.FieldOrganizationID, field.TypeUUID, value)
+	}
+	if value, ok := qwcu.mutation.CorpID(); ok {
+		_spec.SetField(qywechatcorp.FieldCorpID, field.TypeString, value)
+	}
+	if value, ok := qwcu.mutation.CorpSecret(); ok {
+		_spec.SetField(qywechatcorp.FieldCorpSecret, field.TypeString, value)
+	}
+	if value, ok := qwcu.mutation.Enabled(); ok {
+		_spec.SetField(qywechatcorp.FieldEnabled, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qwcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qywechatcorp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qwcu.mutation.done = true
+	return n, nil
+}
+
+// QyWechatCorpUpdateOne is the builder for updating a single QyWechatCorp entity.
+type QyWechatCorpUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QyWechatCorpMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qwcuo *QyWechatCorpUpdateOne) SetUpdatedAt(t time.Time) *QyWechatCorpUpdateOne {
+	qwcuo.mutation.SetUpdatedAt(t)
+	return qwcuo
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (qwcuo *QyWechatCorpUpdateOne) SetOrganizationID(u uuid.UUID) *QyWechatCorpUpdateOne {
+	qwcuo.mutation.SetOrganizationID(u)
+	return qwcuo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (qwcuo *QyWechatCorpUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *QyWechatCorpUpdateOne {
+	if u != nil {
+		qwcuo.SetOrganizationID(*u)
+	}
+	return qwcuo
+}
+
+// SetCorpID sets the "corp_id" field.
+func (qwcuo *QyWechatCorpUpdateOne) SetCorpID(s string) *QyWechatCorpUpdateOne {
+	qwcuo.mutation.SetCorpID(s)
+	return qwcuo
+}
+
+// SetNillableCorpID sets the "corp_id" field if the given value is not nil.
+func (qwcuo *QyWechatCorpUpdateOne) SetNillableCorpID(s *string) *QyWechatCorpUpdateOne {
+	if s != nil {
+		qwcuo.SetCorpID(*s)
+	}
+	return qwcuo
+}
+
+// SetCorpSecret sets the "corp_secret" field.
+func (qwcuo *QyWechatCorpUpdateOne) SetCorpSecret(s string) *QyWechatCorpUpdateOne {
+	qwcuo.mutation.SetCorpSecret(s)
+	return qwcuo
+}
+
+// SetNillableCorpSecret sets the "corp_secret" field if the given value is not nil.
+func (qwcuo *QyWechatCorpUpdateOne) SetNillableCorpSecret(s *string) *QyWechatCorpUpdateOne {
+	if s != nil {
+		qwcuo.SetCorpSecret(*s)
+	}
+	return qwcuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (qwcuo *QyWechatCorpUpdateOne) SetEnabled(b bool) *QyWechatCorpUpdateOne {
+	qwcuo.mutation.SetEnabled(b)
+	return qwcuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (qwcuo *QyWechatCorpUpdateOne) SetNillableEnabled(b *bool) *QyWechatCorpUpdateOne {
+	if b != nil {
+		qwcuo.SetEnabled(*b)
+	}
+	return qwcuo
+}
+
+// Mutation returns the QyWechatCorpMutation object of the builder.
+func (qwcuo *QyWechatCorpUpdateOne) Mutation() *QyWechatCorpMutation {
+	return qwcuo.mutation
+}
+
+// Where appends a list predicates to the QyWechatCorpUpdate builder.
+func (qwcuo *QyWechatCorpUpdateOne) Where(ps ...predicate.QyWechatCorp) *QyWechatCorpUpdateOne {
+	qwcuo.mutation.Where(ps...)
+	return qwcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qwcuo *QyWechatCorpUpdateOne) Select(field string, fields ...string) *QyWechatCorpUpdateOne {
+	qwcuo.fields = append([]string{field}, fields...)
+	return qwcuo
+}
+
+// Save executes the query and returns the updated QyWechatCorp entity.
+func (qwcuo *QyWechatCorpUpdateOne) Save(ctx context.Context) (*QyWechatCorp, error) {
+	qwcuo.defaults()
+	return withHooks(ctx, qwcuo.sqlSave, qwcuo.mutation, qwcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qwcuo *QyWechatCorpUpdateOne) SaveX(ctx context.Context) *QyWechatCorp {
+	node, err := qwcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qwcuo *QyWechatCorpUpdateOne) Exec(ctx context.Context) error {
+	_, err := qwcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qwcuo *QyWechatCorpUpdateOne) ExecX(ctx context.Context) {
+	if err := qwcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qwcuo *QyWechatCorpUpdateOne) defaults() {
+	if _, ok := qwcuo.mutation.UpdatedAt(); !ok {
+		v := qywechatcorp.UpdateDefaultUpdatedAt()
+		qwcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qwcuo *QyWechatCorpUpdateOne) check() error {
+	if v, ok := qwcuo.mutation.CorpID(); ok {
+		if err := qywechatcorp.CorpIDValidator(v); err != nil {
+			return &ValidationError{Name: "corp_id", err: fmt.Errorf(`ent: validator failed for field "QyWechatCorp.corp_id": %w`, err)}
+		}
+	}
+	if v, ok := qwcuo.mutation.CorpSecret(); ok {
+		if err := qywechatcorp.CorpSecretValidator(v); err != nil {
+			return &ValidationError{Name: "corp_secret", err: fmt.Errorf(`ent: validator failed for field "QyWechatCorp.corp_secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (qwcuo *QyWechatCorpUpdateOne) sqlSave(ctx context.Context) (_node *QyWechatCorp, err error) {
+	if err := qwcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qywechatcorp.Table, qywechatcorp.Columns, sqlgraph.NewFieldSpec(qywechatcorp.FieldID, field.TypeUUID))
+	id, ok := qwcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QyWechatCorp.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qwcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qywechatcorp.FieldID)
+		for _, f := range fields {
+			if !qywechatcorp.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != qywechatcorp.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qwcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qwcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(qywechatcorp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := qwcuo.mutation.OrganizationID(); ok {
+		_spec.SetField(qywechatcorp.FieldOrganizationID, field.TypeUUID, value)
+	}
+	if value, ok := qwcuo.mutation.CorpID(); ok {
+		_spec.SetField(qywechatcorp.FieldCorpID, field.TypeString, value)
+	}
+	if value, ok := qwcuo.mutation.CorpSecret(); ok {
+		_spec.SetField(qywechatcorp.FieldCorpSecret, field.TypeString, value)
+	}
+	if value, ok := qwcuo.mutation.Enabled(); ok {
+		_spec.SetField(qywechatcorp.FieldEnabled, field.TypeBool, value)
+	}
+	_node = &QyWechatCorp{config: qwcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qwcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qywechatcorp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qwcuo.mutation.done = true
+	return _node, nil
+}
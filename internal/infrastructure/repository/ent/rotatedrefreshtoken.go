@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/rotatedrefreshtoken"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// RotatedRefreshToken is the model entity for the RotatedRefreshToken schema.
+type RotatedRefreshToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// RefreshTokenHash holds the value of the "refresh_token_hash" field.
+	RefreshTokenHash string `json:"refresh_token_hash,omitempty"`
+	// Family holds the value of the "family" field.
+	Family uuid.UUID `json:"family,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID int64 `json:"device_id,omitempty"`
+	// RotatedAt holds the value of the "rotated_at" field.
+	RotatedAt time.Time `json:"rotated_at,omitempty"`
+	// Successor holds the value of the "successor" field.
+	Successor string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RotatedRefreshToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rotatedrefreshtoken.FieldDeviceID:
+			values[i] = new(sql.NullInt64)
+		case rotatedrefreshtoken.FieldRefreshTokenHash, rotatedrefreshtoken.FieldSuccessor:
+			values[i] = new(sql.NullString)
+		case rotatedrefreshtoken.FieldCreatedAt, rotatedrefreshtoken.FieldRotatedAt, rotatedrefreshtoken.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case rotatedrefreshtoken.FieldID, rotatedrefreshtoken.FieldFamily:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RotatedRefreshToken fields.
+func (rrt *RotatedRefreshToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rotatedrefreshtoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rrt.ID = *value
+			}
+		case rotatedrefreshtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rrt.CreatedAt = value.Time
+			}
+		case rotatedrefreshtoken.FieldRefreshTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_token_hash", values[i])
+			} else if value.Valid {
+				rrt.RefreshTokenHash = value.String
+			}
+		case rotatedrefreshtoken.FieldFamily:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field family", values[i])
+			} else if value != nil {
+				rrt.Family = *value
+			}
+		case rotatedrefreshtoken.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				rrt.DeviceID = value.Int64
+			}
+		case rotatedrefreshtoken.FieldRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_at", values[i])
+			} else if value.Valid {
+				rrt.RotatedAt = value.Time
+			}
+		case rotatedrefreshtoken.FieldSuccessor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field successor", values[i])
+			} else if value.Valid {
+				rrt.Successor = value.String
+			}
+		case rotatedrefreshtoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rrt.ExpiresAt = value.Time
+			}
+		default:
+			rrt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RotatedRefreshToken.
+// This includes values selected through modifiers, order, etc.
+func (rrt *RotatedRefreshToken) Value(name string) (ent.Value, error) {
+	return rrt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RotatedRefreshToken.
+// Note that you need to call RotatedRefreshToken.Unwrap() before calling this method if this RotatedRefreshToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rrt *RotatedRefreshToken) Update() *RotatedRefreshTokenUpdateOne {
+	return NewRotatedRefreshTokenClient(rrt.config).UpdateOne(rrt)
+}
+
+// Unwrap unwraps the RotatedRefreshToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rrt *RotatedRefreshToken) Unwrap() *RotatedRefreshToken {
+	_tx, ok := rrt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RotatedRefreshToken is not a transactional entity")
+	}
+	rrt.config.driver = _tx.drv
+	return rrt
+}
+
+// String implements the fmt.Stringer.
+func (rrt *RotatedRefreshToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("RotatedRefreshToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rrt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rrt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("refresh_token_hash=")
+	builder.WriteString(rrt.RefreshTokenHash)
+	builder.WriteString(", ")
+	builder.WriteString("family=")
+	builder.WriteString(fmt.Sprintf("%v", rrt.Family))
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(fmt.Sprintf("%v", rrt.DeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("rotated_at=")
+	builder.WriteString(rrt.RotatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("successor=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(rrt.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RotatedRefreshTokens is a parsable slice of RotatedRefreshToken.
+type RotatedRefreshTokens []*RotatedRefreshToken
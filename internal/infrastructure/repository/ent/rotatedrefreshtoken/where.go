@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rotatedrefreshtoken
+
+import (
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RefreshTokenHash applies equality check predicate on the "refresh_token_hash" field. It's identical to RefreshTokenHashEQ.
+func RefreshTokenHash(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldRefreshTokenHash, v))
+}
+
+// Family applies equality check predicate on the "family" field. It's identical to FamilyEQ.
+func Family(v uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldFamily, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v int64) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// RotatedAt applies equality check predicate on the "rotated_at" field. It's identical to RotatedAtEQ.
+func RotatedAt(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldRotatedAt, v))
+}
+
+// Successor applies equality check predicate on the "successor" field. It's identical to SuccessorEQ.
+func Successor(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldSuccessor, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RefreshTokenHashEQ applies the EQ predicate on the "refresh_token_hash" field.
+func RefreshTokenHashEQ(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashNEQ applies the NEQ predicate on the "refresh_token_hash" field.
+func RefreshTokenHashNEQ(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNEQ(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashIn applies the In predicate on the "refresh_token_hash" field.
+func RefreshTokenHashIn(vs ...string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldIn(FieldRefreshTokenHash, vs...))
+}
+
+// RefreshTokenHashNotIn applies the NotIn predicate on the "refresh_token_hash" field.
+func RefreshTokenHashNotIn(vs ...string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNotIn(FieldRefreshTokenHash, vs...))
+}
+
+// RefreshTokenHashGT applies the GT predicate on the "refresh_token_hash" field.
+func RefreshTokenHashGT(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGT(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashGTE applies the GTE predicate on the "refresh_token_hash" field.
+func RefreshTokenHashGTE(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGTE(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashLT applies the LT predicate on the "refresh_token_hash" field.
+func RefreshTokenHashLT(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLT(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashLTE applies the LTE predicate on the "refresh_token_hash" field.
+func RefreshTokenHashLTE(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLTE(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashContains applies the Contains predicate on the "refresh_token_hash" field.
+func RefreshTokenHashContains(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldContains(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashHasPrefix applies the HasPrefix predicate on the "refresh_token_hash" field.
+func RefreshTokenHashHasPrefix(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldHasPrefix(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashHasSuffix applies the HasSuffix predicate on the "refresh_token_hash" field.
+func RefreshTokenHashHasSuffix(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldHasSuffix(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashEqualFold applies the EqualFold predicate on the "refresh_token_hash" field.
+func RefreshTokenHashEqualFold(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEqualFold(FieldRefreshTokenHash, v))
+}
+
+// RefreshTokenHashContainsFold applies the ContainsFold predicate on the "refresh_token_hash" field.
+func RefreshTokenHashContainsFold(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldContainsFold(FieldRefreshTokenHash, v))
+}
+
+// FamilyEQ applies the EQ predicate on the "family" field.
+func FamilyEQ(v uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldFamily, v))
+}
+
+// FamilyNEQ applies the NEQ predicate on the "family" field.
+func FamilyNEQ(v uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNEQ(FieldFamily, v))
+}
+
+// FamilyIn applies the In predicate on the "family" field.
+func FamilyIn(vs ...uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldIn(FieldFamily, vs...))
+}
+
+// FamilyNotIn applies the NotIn predicate on the "family" field.
+func FamilyNotIn(vs ...uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNotIn(FieldFamily, vs...))
+}
+
+// FamilyGT applies the GT predicate on the "family" field.
+func FamilyGT(v uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGT(FieldFamily, v))
+}
+
+// FamilyGTE applies the GTE predicate on the "family" field.
+func FamilyGTE(v uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGTE(FieldFamily, v))
+}
+
+// FamilyLT applies the LT predicate on the "family" field.
+func FamilyLT(v uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLT(FieldFamily, v))
+}
+
+// FamilyLTE applies the LTE predicate on the "family" field.
+func FamilyLTE(v uuid.UUID) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLTE(FieldFamily, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v int64) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v int64) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...int64) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...int64) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v int64) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v int64) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v int64) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v int64) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// RotatedAtEQ applies the EQ predicate on the "rotated_at" field.
+func RotatedAtEQ(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldRotatedAt, v))
+}
+
+// RotatedAtNEQ applies the NEQ predicate on the "rotated_at" field.
+func RotatedAtNEQ(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNEQ(FieldRotatedAt, v))
+}
+
+// RotatedAtIn applies the In predicate on the "rotated_at" field.
+func RotatedAtIn(vs ...time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldIn(FieldRotatedAt, vs...))
+}
+
+// RotatedAtNotIn applies the NotIn predicate on the "rotated_at" field.
+func RotatedAtNotIn(vs ...time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNotIn(FieldRotatedAt, vs...))
+}
+
+// RotatedAtGT applies the GT predicate on the "rotated_at" field.
+func RotatedAtGT(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGT(FieldRotatedAt, v))
+}
+
+// RotatedAtGTE applies the GTE predicate on the "rotated_at" field.
+func RotatedAtGTE(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGTE(FieldRotatedAt, v))
+}
+
+// RotatedAtLT applies the LT predicate on the "rotated_at" field.
+func RotatedAtLT(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLT(FieldRotatedAt, v))
+}
+
+// RotatedAtLTE applies the LTE predicate on the "rotated_at" field.
+func RotatedAtLTE(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLTE(FieldRotatedAt, v))
+}
+
+// SuccessorEQ applies the EQ predicate on the "successor" field.
+func SuccessorEQ(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldSuccessor, v))
+}
+
+// SuccessorNEQ applies the NEQ predicate on the "successor" field.
+func SuccessorNEQ(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNEQ(FieldSuccessor, v))
+}
+
+// SuccessorIn applies the In predicate on the "successor" field.
+func SuccessorIn(vs ...string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldIn(FieldSuccessor, vs...))
+}
+
+// SuccessorNotIn applies the NotIn predicate on the "successor" field.
+func SuccessorNotIn(vs ...string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNotIn(FieldSuccessor, vs...))
+}
+
+// SuccessorGT applies the GT predicate on the "successor" field.
+func SuccessorGT(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGT(FieldSuccessor, v))
+}
+
+// SuccessorGTE applies the GTE predicate on the "successor" field.
+func SuccessorGTE(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGTE(FieldSuccessor, v))
+}
+
+// SuccessorLT applies the LT predicate on the "successor" field.
+func SuccessorLT(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLT(FieldSuccessor, v))
+}
+
+// SuccessorLTE applies the LTE predicate on the "successor" field.
+func SuccessorLTE(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLTE(FieldSuccessor, v))
+}
+
+// SuccessorContains applies the Contains predicate on the "successor" field.
+func SuccessorContains(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldContains(FieldSuccessor, v))
+}
+
+// SuccessorHasPrefix applies the HasPrefix predicate on the "successor" field.
+func SuccessorHasPrefix(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldHasPrefix(FieldSuccessor, v))
+}
+
+// SuccessorHasSuffix applies the HasSuffix predicate on the "successor" field.
+func SuccessorHasSuffix(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldHasSuffix(FieldSuccessor, v))
+}
+
+// SuccessorIsNil applies the IsNil predicate on the "successor" field.
+func SuccessorIsNil() predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldIsNull(FieldSuccessor))
+}
+
+// SuccessorNotNil applies the NotNil predicate on the "successor" field.
+func SuccessorNotNil() predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNotNull(FieldSuccessor))
+}
+
+// SuccessorEqualFold applies the EqualFold predicate on the "successor" field.
+func SuccessorEqualFold(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEqualFold(FieldSuccessor, v))
+}
+
+// SuccessorContainsFold applies the ContainsFold predicate on the "successor" field.
+func SuccessorContainsFold(v string) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldContainsFold(FieldSuccessor, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RotatedRefreshToken) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RotatedRefreshToken) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RotatedRefreshToken) predicate.RotatedRefreshToken {
+	return predicate.RotatedRefreshToken(sql.NotPredicates(p))
+}
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/rotatedrefreshtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RotatedRefreshTokenCreate is the builder for creating a RotatedRefreshToken entity.
+type RotatedRefreshTokenCreate struct {
+	config
+	mutation *RotatedRefreshTokenMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrtc *RotatedRefreshTokenCreate) SetCreatedAt(t time.Time) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetCreatedAt(t)
+	return rrtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrtc *RotatedRefreshTokenCreate) SetNillableCreatedAt(t *time.Time) *RotatedRefreshTokenCreate {
+	if t != nil {
+		rrtc.SetCreatedAt(*t)
+	}
+	return rrtc
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (rrtc *RotatedRefreshTokenCreate) SetRefreshTokenHash(s string) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetRefreshTokenHash(s)
+	return rrtc
+}
+
+// SetFamily sets the "family" field.
+func (rrtc *RotatedRefreshTokenCreate) SetFamily(u uuid.UUID) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetFamily(u)
+	return rrtc
+}
+
+// SetDeviceID sets the "device_id" field.
+func (rrtc *RotatedRefreshTokenCreate) SetDeviceID(i int64) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetDeviceID(i)
+	return rrtc
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (rrtc *RotatedRefreshTokenCreate) SetRotatedAt(t time.Time) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetRotatedAt(t)
+	return rrtc
+}
+
+// SetSuccessor sets the "successor" field.
+func (rrtc *RotatedRefreshTokenCreate) SetSuccessor(s string) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetSuccessor(s)
+	return rrtc
+}
+
+// SetNillableSuccessor sets the "successor" field if the given value is not nil.
+func (rrtc *RotatedRefreshTokenCreate) SetNillableSuccessor(s *string) *RotatedRefreshTokenCreate {
+	if s != nil {
+		rrtc.SetSuccessor(*s)
+	}
+	return rrtc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rrtc *RotatedRefreshTokenCreate) SetExpiresAt(t time.Time) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetExpiresAt(t)
+	return rrtc
+}
+
+// SetID sets the "id" field.
+func (rrtc *RotatedRefreshTokenCreate) SetID(u uuid.UUID) *RotatedRefreshTokenCreate {
+	rrtc.mutation.SetID(u)
+	return rrtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rrtc *RotatedRefreshTokenCreate) SetNillableID(u *uuid.UUID) *RotatedRefreshTokenCreate {
+	if u != nil {
+		rrtc.SetID(*u)
+	}
+	return rrtc
+}
+
+// Mutation returns the RotatedRefreshTokenMutation object of the builder.
+func (rrtc *RotatedRefreshTokenCreate) Mutation() *RotatedRefreshTokenMutation {
+	return rrtc.mutation
+}
+
+// Save creates the RotatedRefreshToken in the database.
+func (rrtc *RotatedRefreshTokenCreate) Save(ctx context.Context) (*RotatedRefreshToken, error) {
+	rrtc.defaults()
+	return withHooks(ctx, rrtc.sqlSave, rrtc.mutation, rrtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrtc *RotatedRefreshTokenCreate) SaveX(ctx context.Context) *RotatedRefreshToken {
+	v, err := rrtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrtc *RotatedRefreshTokenCreate) Exec(ctx context.Context) error {
+	_, err := rrtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtc *RotatedRefreshTokenCreate) ExecX(ctx context.Context) {
+	if err := rrtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrtc *RotatedRefreshTokenCreate) defaults() {
+	if _, ok := rrtc.mutation.CreatedAt(); !ok {
+		v := rotatedrefreshtoken.DefaultCreatedAt()
+		rrtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrtc.mutation.ID(); !ok {
+		v := rotatedrefreshtoken.DefaultID()
+		rrtc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrtc *RotatedRefreshTokenCreate) check() error {
+	if _, ok := rrtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RotatedRefreshToken.created_at"`)}
+	}
+	if _, ok := rrtc.mutation.RefreshTokenHash(); !ok {
+		return &ValidationError{Name: "refresh_token_hash", err: errors.New(`ent: missing required field "RotatedRefreshToken.refresh_token_hash"`)}
+	}
+	if v, ok := rrtc.mutation.RefreshTokenHash(); ok {
+		if err := rotatedrefreshtoken.RefreshTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token_hash", err: fmt.Errorf(`ent: validator failed for field "RotatedRefreshToken.refresh_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := rrtc.mutation.Family(); !ok {
+		return &ValidationError{Name: "family", err: errors.New(`ent: missing required field "RotatedRefreshToken.family"`)}
+	}
+	if _, ok := rrtc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "RotatedRefreshToken.device_id"`)}
+	}
+	if _, ok := rrtc.mutation.RotatedAt(); !ok {
+		return &ValidationError{Name: "rotated_at", err: errors.New(`ent: missing required field "RotatedRefreshToken.rotated_at"`)}
+	}
+	if _, ok := rrtc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "RotatedRefreshToken.expires_at"`)}
+	}
+	return nil
+}
+
+func (rrtc *RotatedRefreshTokenCreate) sqlSave(ctx context.Context) (*RotatedRefreshToken, error) {
+	if err := rrtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rrtc.mutation.id = &_node.ID
+	rrtc.mutation.done = true
+	return _node, nil
+}
+
+func (rrtc *RotatedRefreshTokenCreate) createSpec() (*RotatedRefreshToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RotatedRefreshToken{config: rrtc.config}
+		_spec = sqlgraph.NewCreateSpec(rotatedrefreshtoken.Table, sqlgraph.NewFieldSpec(rotatedrefreshtoken.FieldID, field.TypeUUID))
+	)
+	if id, ok := rrtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rrtc.mutation.CreatedAt(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrtc.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldRefreshTokenHash, field.TypeString, value)
+		_node.RefreshTokenHash = value
+	}
+	if value, ok := rrtc.mutation.Family(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldFamily, field.TypeUUID, value)
+		_node.Family = value
+	}
+	if value, ok := rrtc.mutation.DeviceID(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldDeviceID, field.TypeInt64, value)
+		_node.DeviceID = value
+	}
+	if value, ok := rrtc.mutation.RotatedAt(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = value
+	}
+	if value, ok := rrtc.mutation.Successor(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldSuccessor, field.TypeString, value)
+		_node.Successor = value
+	}
+	if value, ok := rrtc.mutation.ExpiresAt(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// RotatedRefreshTokenCreateBulk is the builder for creating many RotatedRefreshToken entities in bulk.
+type RotatedRefreshTokenCreateBulk struct {
+	config
+	err      error
+	builders []*RotatedRefreshTokenCreate
+}
+
+// Save creates the RotatedRefreshToken entities in the database.
+func (rrtcb *RotatedRefreshTokenCreateBulk) Save(ctx context.Context) ([]*RotatedRefreshToken, error) {
+	if rrtcb.err != nil {
+		return nil, rrtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrtcb.builders))
+	nodes := make([]*RotatedRefreshToken, len(rrtcb.builders))
+	mutators := make([]Mutator, len(rrtcb.builders))
+	for i := range rrtcb.builders {
+		func(i int, root context.Context) {
+			builder := rrtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RotatedRefreshTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrtcb *RotatedRefreshTokenCreateBulk) SaveX(ctx context.Context) []*RotatedRefreshToken {
+	v, err := rrtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrtcb *RotatedRefreshTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtcb *RotatedRefreshTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := rrtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
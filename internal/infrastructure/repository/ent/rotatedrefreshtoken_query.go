@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/rotatedrefreshtoken"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RotatedRefreshTokenQuery is the builder for querying RotatedRefreshToken entities.
+type RotatedRefreshTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []rotatedrefreshtoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RotatedRefreshToken
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RotatedRefreshTokenQuery builder.
+func (rrtq *RotatedRefreshTokenQuery) Where(ps ...predicate.RotatedRefreshToken) *RotatedRefreshTokenQuery {
+	rrtq.predicates = append(rrtq.predicates, ps...)
+	return rrtq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrtq *RotatedRefreshTokenQuery) Limit(limit int) *RotatedRefreshTokenQuery {
+	rrtq.ctx.Limit = &limit
+	return rrtq
+}
+
+// Offset to start from.
+func (rrtq *RotatedRefreshTokenQuery) Offset(offset int) *RotatedRefreshTokenQuery {
+	rrtq.ctx.Offset = &offset
+	return rrtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrtq *RotatedRefreshTokenQuery) Unique(unique bool) *RotatedRefreshTokenQuery {
+	rrtq.ctx.Unique = &unique
+	return rrtq
+}
+
+// Order specifies how the records should be ordered.
+func (rrtq *RotatedRefreshTokenQuery) Order(o ...rotatedrefreshtoken.OrderOption) *RotatedRefreshTokenQuery {
+	rrtq.order = append(rrtq.order, o...)
+	return rrtq
+}
+
+// First returns the first RotatedRefreshToken entity from the query.
+// Returns a *NotFoundError when no RotatedRefreshToken was found.
+func (rrtq *RotatedRefreshTokenQuery) First(ctx context.Context) (*RotatedRefreshToken, error) {
+	nodes, err := rrtq.Limit(1).All(setContextOp(ctx, rrtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{rotatedrefreshtoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrtq *RotatedRefreshTokenQuery) FirstX(ctx context.Context) *RotatedRefreshToken {
+	node, err := rrtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RotatedRefreshToken ID from the query.
+// Returns a *NotFoundError when no RotatedRefreshToken ID was found.
+func (rrtq *RotatedRefreshTokenQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrtq.Limit(1).IDs(setContextOp(ctx, rrtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{rotatedrefreshtoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrtq *RotatedRefreshTokenQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rrtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RotatedRefreshToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RotatedRefreshToken entity is found.
+// Returns a *NotFoundError when no RotatedRefreshToken entities are found.
+func (rrtq *RotatedRefreshTokenQuery) Only(ctx context.Context) (*RotatedRefreshToken, error) {
+	nodes, err := rrtq.Limit(2).All(setContextOp(ctx, rrtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{rotatedrefreshtoken.Label}
+	default:
+		return nil, &NotSingularError{rotatedrefreshtoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrtq *RotatedRefreshTokenQuery) OnlyX(ctx context.Context) *RotatedRefreshToken {
+	node, err := rrtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RotatedRefreshToken ID in the query.
+// Returns a *NotSingularError when more than one RotatedRefreshToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrtq *RotatedRefreshTokenQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrtq.Limit(2).IDs(setContextOp(ctx, rrtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{rotatedrefreshtoken.Label}
+	default:
+		err = &NotSingularError{rotatedrefreshtoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrtq *RotatedRefreshTokenQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rrtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RotatedRefreshTokens.
+func (rrtq *RotatedRefreshTokenQuery) All(ctx context.Context) ([]*RotatedRefreshToken, error) {
+	ctx = setContextOp(ctx, rrtq.ctx, ent.OpQueryAll)
+	if err := rrtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RotatedRefreshToken, *RotatedRefreshTokenQuery]()
+	return withInterceptors[[]*RotatedRefreshToken](ctx, rrtq, qr, rrtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrtq *RotatedRefreshTokenQuery) AllX(ctx context.Context) []*RotatedRefreshToken {
+	nodes, err := rrtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RotatedRefreshToken IDs.
+func (rrtq *RotatedRefreshTokenQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rrtq.ctx.Unique == nil && rrtq.path != nil {
+		rrtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrtq.ctx, ent.OpQueryIDs)
+	if err = rrtq.Select(rotatedrefreshtoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrtq *RotatedRefreshTokenQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rrtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrtq *RotatedRefreshTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrtq.ctx, ent.OpQueryCount)
+	if err := rrtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrtq, querierCount[*RotatedRefreshTokenQuery](), rrtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrtq *RotatedRefreshTokenQuery) CountX(ctx context.Context) int {
+	count, err := rrtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrtq *RotatedRefreshTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrtq.ctx, ent.OpQueryExist)
+	switch _, err := rrtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrtq *RotatedRefreshTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RotatedRefreshTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrtq *RotatedRefreshTokenQuery) Clone() *RotatedRefreshTokenQuery {
+	if rrtq == nil {
+		return nil
+	}
+	return &RotatedRefreshTokenQuery{
+		config:     rrtq.config,
+		ctx:        rrtq.ctx.Clone(),
+		order:      append([]rotatedrefreshtoken.OrderOption{}, rrtq.order...),
+		inters:     append([]Interceptor{}, rrtq.inters...),
+		predicates: append([]predicate.RotatedRefreshToken{}, rrtq.predicates...),
+		// clone intermediate query.
+		sql:  rrtq.sql.Clone(),
+		path: rrtq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RotatedRefreshToken.Query().
+//		GroupBy(rotatedrefreshtoken.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rrtq *RotatedRefreshTokenQuery) GroupBy(field string, fields ...string) *RotatedRefreshTokenGroupBy {
+	rrtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RotatedRefreshTokenGroupBy{build: rrtq}
+	grbuild.flds = &rrtq.ctx.Fields
+	grbuild.label = rotatedrefreshtoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.RotatedRefreshToken.Query().
+//		Select(rotatedrefreshtoken.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rrtq *RotatedRefreshTokenQuery) Select(fields ...string) *RotatedRefreshTokenSelect {
+	rrtq.ctx.Fields = append(rrtq.ctx.Fields, fields...)
+	sbuild := &RotatedRefreshTokenSelect{RotatedRefreshTokenQuery: rrtq}
+	sbuild.label = rotatedrefreshtoken.Label
+	sbuild.flds, sbuild.scan = &rrtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RotatedRefreshTokenSelect configured with the given aggregations.
+func (rrtq *RotatedRefreshTokenQuery) Aggregate(fns ...AggregateFunc) *RotatedRefreshTokenSelect {
+	return rrtq.Select().Aggregate(fns...)
+}
+
+func (rrtq *RotatedRefreshTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrtq.ctx.Fields {
+		if !rotatedrefreshtoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rrtq.path != nil {
+		prev, err := rrtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrtq.sql = prev
+	}
+	return nil
+}
+
+func (rrtq *RotatedRefreshTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RotatedRefreshToken, error) {
+	var (
+		nodes = []*RotatedRefreshToken{}
+		_spec = rrtq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RotatedRefreshToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RotatedRefreshToken{config: rrtq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(rrtq.modifiers) > 0 {
+		_spec.Modifiers = rrtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rrtq *RotatedRefreshTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrtq.querySpec()
+	if len(rrtq.modifiers) > 0 {
+		_spec.Modifiers = rrtq.modifiers
+	}
+	_spec.Node.Columns = rrtq.ctx.Fields
+	if len(rrtq.ctx.Fields) > 0 {
+		_spec.Unique = rrtq.ctx.Unique != nil && *rrtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrtq.driver, _spec)
+}
+
+func (rrtq *RotatedRefreshTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(rotatedrefreshtoken.Table, rotatedrefreshtoken.Columns, sqlgraph.NewFieldSpec(rotatedrefreshtoken.FieldID, field.TypeUUID))
+	_spec.From = rrtq.sql
+	if unique := rrtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rotatedrefreshtoken.FieldID)
+		for i := range fields {
+			if fields[i] != rotatedrefreshtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rrtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrtq *RotatedRefreshTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrtq.driver.Dialect())
+	t1 := builder.Table(rotatedrefreshtoken.Table)
+	columns := rrtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = rotatedrefreshtoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrtq.sql != nil {
+		selector = rrtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrtq.ctx.Unique != nil && *rrtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rrtq.modifiers {
+		m(selector)
+	}
+	for _, p := range rrtq.predicates {
+		p(selector)
+	}
+	for _, p := range rrtq.order {
+		p(selector)
+	}
+	if offset := rrtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rrtq *RotatedRefreshTokenQuery) ForUpdate(opts ...sql.LockOption) *RotatedRefreshTokenQuery {
+	if rrtq.driver.Dialect() == dialect.Postgres {
+		rrtq.Unique(false)
+	}
+	rrtq.modifiers = append(rrtq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rrtq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rrtq *RotatedRefreshTokenQuery) ForShare(opts ...sql.LockOption) *RotatedRefreshTokenQuery {
+	if rrtq.driver.Dialect() == dialect.Postgres {
+		rrtq.Unique(false)
+	}
+	rrtq.modifiers = append(rrtq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rrtq
+}
+
+// RotatedRefreshTokenGroupBy is the group-by builder for RotatedRefreshToken entities.
+type RotatedRefreshTokenGroupBy struct {
+	selector
+	build *RotatedRefreshTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrtgb *RotatedRefreshTokenGroupBy) Aggregate(fns ...AggregateFunc) *RotatedRefreshTokenGroupBy {
+	rrtgb.fns = append(rrtgb.fns, fns...)
+	return rrtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrtgb *RotatedRefreshTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rrtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RotatedRefreshTokenQuery, *RotatedRefreshTokenGroupBy](ctx, rrtgb.build, rrtgb, rrtgb.build.inters, v)
+}
+
+func (rrtgb *RotatedRefreshTokenGroupBy) sqlScan(ctx context.Context, root *RotatedRefreshTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrtgb.fns))
+	for _, fn := range rrtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrtgb.flds)+len(rrtgb.fns))
+		for _, f := range *rrtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RotatedRefreshTokenSelect is the builder for selecting fields of RotatedRefreshToken entities.
+type RotatedRefreshTokenSelect struct {
+	*RotatedRefreshTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrts *RotatedRefreshTokenSelect) Aggregate(fns ...AggregateFunc) *RotatedRefreshTokenSelect {
+	rrts.fns = append(rrts.fns, fns...)
+	return rrts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrts *RotatedRefreshTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrts.ctx, ent.OpQuerySelect)
+	if err := rrts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RotatedRefreshTokenQuery, *RotatedRefreshTokenSelect](ctx, rrts.RotatedRefreshTokenQuery, rrts, rrts.inters, v)
+}
+
+func (rrts *RotatedRefreshTokenSelect) sqlScan(ctx context.Context, root *RotatedRefreshTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrts.fns))
+	for _, fn := range rrts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
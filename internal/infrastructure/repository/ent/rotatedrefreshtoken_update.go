@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/rotatedrefreshtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RotatedRefreshTokenUpdate is the builder for updating RotatedRefreshToken entities.
+type RotatedRefreshTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RotatedRefreshTokenMutation
+}
+
+// Where appends a list predicates to the RotatedRefreshTokenUpdate builder.
+func (rrtu *RotatedRefreshTokenUpdate) Where(ps ...predicate.RotatedRefreshToken) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.Where(ps...)
+	return rrtu
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (rrtu *RotatedRefreshTokenUpdate) SetRefreshTokenHash(s string) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.SetRefreshTokenHash(s)
+	return rrtu
+}
+
+// SetNillableRefreshTokenHash sets the "refresh_token_hash" field if the given value is not nil.
+func (rrtu *RotatedRefreshTokenUpdate) SetNillableRefreshTokenHash(s *string) *RotatedRefreshTokenUpdate {
+	if s != nil {
+		rrtu.SetRefreshTokenHash(*s)
+	}
+	return rrtu
+}
+
+// SetFamily sets the "family" field.
+func (rrtu *RotatedRefreshTokenUpdate) SetFamily(u uuid.UUID) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.SetFamily(u)
+	return rrtu
+}
+
+// SetNillableFamily sets the "family" field if the given value is not nil.
+func (rrtu *RotatedRefreshTokenUpdate) SetNillableFamily(u *uuid.UUID) *RotatedRefreshTokenUpdate {
+	if u != nil {
+		rrtu.SetFamily(*u)
+	}
+	return rrtu
+}
+
+// SetDeviceID sets the "device_id" field.
+func (rrtu *RotatedRefreshTokenUpdate) SetDeviceID(i int64) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.ResetDeviceID()
+	rrtu.mutation.SetDeviceID(i)
+	return rrtu
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (rrtu *RotatedRefreshTokenUpdate) SetNillableDeviceID(i *int64) *RotatedRefreshTokenUpdate {
+	if i != nil {
+		rrtu.SetDeviceID(*i)
+	}
+	return rrtu
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (rrtu *RotatedRefreshTokenUpdate) AddDeviceID(i int64) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.AddDeviceID(i)
+	return rrtu
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (rrtu *RotatedRefreshTokenUpdate) SetRotatedAt(t time.Time) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.SetRotatedAt(t)
+	return rrtu
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (rrtu *RotatedRefreshTokenUpdate) SetNillableRotatedAt(t *time.Time) *RotatedRefreshTokenUpdate {
+	if t != nil {
+		rrtu.SetRotatedAt(*t)
+	}
+	return rrtu
+}
+
+// SetSuccessor sets the "successor" field.
+func (rrtu *RotatedRefreshTokenUpdate) SetSuccessor(s string) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.SetSuccessor(s)
+	return rrtu
+}
+
+// SetNillableSuccessor sets the "successor" field if the given value is not nil.
+func (rrtu *RotatedRefreshTokenUpdate) SetNillableSuccessor(s *string) *RotatedRefreshTokenUpdate {
+	if s != nil {
+		rrtu.SetSuccessor(*s)
+	}
+	return rrtu
+}
+
+// ClearSuccessor clears the value of the "successor" field.
+func (rrtu *RotatedRefreshTokenUpdate) ClearSuccessor() *RotatedRefreshTokenUpdate {
+	rrtu.mutation.ClearSuccessor()
+	return rrtu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rrtu *RotatedRefreshTokenUpdate) SetExpiresAt(t time.Time) *RotatedRefreshTokenUpdate {
+	rrtu.mutation.SetExpiresAt(t)
+	return rrtu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rrtu *RotatedRefreshTokenUpdate) SetNillableExpiresAt(t *time.Time) *RotatedRefreshTokenUpdate {
+	if t != nil {
+		rrtu.SetExpiresAt(*t)
+	}
+	return rrtu
+}
+
+// Mutation returns the RotatedRefreshTokenMutation object of the builder.
+func (rrtu *RotatedRefreshTokenUpdate) Mutation() *RotatedRefreshTokenMutation {
+	return rrtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rrtu *RotatedRefreshTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrtu.sqlSave, rrtu.mutation, rrtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrtu *RotatedRefreshTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rrtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rrtu *RotatedRefreshTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rrtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtu *RotatedRefreshTokenUpdate) ExecX(ctx context.Context) {
+	if err := rrtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrtu *RotatedRefreshTokenUpdate) check() error {
+	if v, ok := rrtu.mutation.RefreshTokenHash(); ok {
+		if err := rotatedrefreshtoken.RefreshTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token_hash", err: fmt.Errorf(`ent: validator failed for field "RotatedRefreshToken.refresh_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rrtu *RotatedRefreshTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rrtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rotatedrefreshtoken.Table, rotatedrefreshtoken.Columns, sqlgraph.NewFieldSpec(rotatedrefreshtoken.FieldID, field.TypeUUID))
+	if ps := rrtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rrtu.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldRefreshTokenHash, field.TypeString, value)
+	}
+	if value, ok := rrtu.mutation.Family(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldFamily, field.TypeUUID, value)
+	}
+	if value, ok := rrtu.mutation.DeviceID(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldDeviceID, field.TypeInt64, value)
+	}
+	if value, ok := rrtu.mutation.AddedDeviceID(); ok {
+		_spec.AddField(rotatedrefreshtoken.FieldDeviceID, field.TypeInt64, value)
+	}
+	if value, ok := rrtu.mutation.RotatedAt(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldRotatedAt, field.TypeTime, value)
+	}
+	if value, ok := rrtu.mutation.Successor(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldSuccessor, field.TypeString, value)
+	}
+	if rrtu.mutation.SuccessorCleared() {
+		_spec.ClearField(rotatedrefreshtoken.FieldSuccessor, field.TypeString)
+	}
+	if value, ok := rrtu.mutation.ExpiresAt(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rrtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rotatedrefreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rrtu.mutation.done = true
+	return n, nil
+}
+
+// RotatedRefreshTokenUpdateOne is the builder for updating a single RotatedRefreshToken entity.
+type RotatedRefreshTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RotatedRefreshTokenMutation
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetRefreshTokenHash(s string) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.SetRefreshTokenHash(s)
+	return rrtuo
+}
+
+// SetNillableRefreshTokenHash sets the "refresh_token_hash" field if the given value is not nil.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetNillableRefreshTokenHash(s *string) *RotatedRefreshTokenUpdateOne {
+	if s != nil {
+		rrtuo.SetRefreshTokenHash(*s)
+	}
+	return rrtuo
+}
+
+// SetFamily sets the "family" field.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetFamily(u uuid.UUID) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.SetFamily(u)
+	return rrtuo
+}
+
+// SetNillableFamily sets the "family" field if the given value is not nil.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetNillableFamily(u *uuid.UUID) *RotatedRefreshTokenUpdateOne {
+	if u != nil {
+		rrtuo.SetFamily(*u)
+	}
+	return rrtuo
+}
+
+// SetDeviceID sets the "device_id" field.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetDeviceID(i int64) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.ResetDeviceID()
+	rrtuo.mutation.SetDeviceID(i)
+	return rrtuo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetNillableDeviceID(i *int64) *RotatedRefreshTokenUpdateOne {
+	if i != nil {
+		rrtuo.SetDeviceID(*i)
+	}
+	return rrtuo
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (rrtuo *RotatedRefreshTokenUpdateOne) AddDeviceID(i int64) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.AddDeviceID(i)
+	return rrtuo
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetRotatedAt(t time.Time) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.SetRotatedAt(t)
+	return rrtuo
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetNillableRotatedAt(t *time.Time) *RotatedRefreshTokenUpdateOne {
+	if t != nil {
+		rrtuo.SetRotatedAt(*t)
+	}
+	return rrtuo
+}
+
+// SetSuccessor sets the "successor" field.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetSuccessor(s string) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.SetSuccessor(s)
+	return rrtuo
+}
+
+// SetNillableSuccessor sets the "successor" field if the given value is not nil.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetNillableSuccessor(s *string) *RotatedRefreshTokenUpdateOne {
+	if s != nil {
+		rrtuo.SetSuccessor(*s)
+	}
+	return rrtuo
+}
+
+// ClearSuccessor clears the value of the "successor" field.
+func (rrtuo *RotatedRefreshTokenUpdateOne) ClearSuccessor() *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.ClearSuccessor()
+	return rrtuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetExpiresAt(t time.Time) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.SetExpiresAt(t)
+	return rrtuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *RotatedRefreshTokenUpdateOne {
+	if t != nil {
+		rrtuo.SetExpiresAt(*t)
+	}
+	return rrtuo
+}
+
+// Mutation returns the RotatedRefreshTokenMutation object of the builder.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Mutation() *RotatedRefreshTokenMutation {
+	return rrtuo.mutation
+}
+
+// Where appends a list predicates to the RotatedRefreshTokenUpdate builder.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Where(ps ...predicate.RotatedRefreshToken) *RotatedRefreshTokenUpdateOne {
+	rrtuo.mutation.Where(ps...)
+	return rrtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Select(field string, fields ...string) *RotatedRefreshTokenUpdateOne {
+	rrtuo.fields = append([]string{field}, fields...)
+	return rrtuo
+}
+
+// Save executes the query and returns the updated RotatedRefreshToken entity.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Save(ctx context.Context) (*RotatedRefreshToken, error) {
+	return withHooks(ctx, rrtuo.sqlSave, rrtuo.mutation, rrtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrtuo *RotatedRefreshTokenUpdateOne) SaveX(ctx context.Context) *RotatedRefreshToken {
+	node, err := rrtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rrtuo *RotatedRefreshTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rrtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrtuo *RotatedRefreshTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rrtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrtuo *RotatedRefreshTokenUpdateOne) check() error {
+	if v, ok := rrtuo.mutation.RefreshTokenHash(); ok {
+		if err := rotatedrefreshtoken.RefreshTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token_hash", err: fmt.Errorf(`ent: validator failed for field "RotatedRefreshToken.refresh_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rrtuo *RotatedRefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RotatedRefreshToken, err error) {
+	if err := rrtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rotatedrefreshtoken.Table, rotatedrefreshtoken.Columns, sqlgraph.NewFieldSpec(rotatedrefreshtoken.FieldID, field.TypeUUID))
+	id, ok := rrtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RotatedRefreshToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rrtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rotatedrefreshtoken.FieldID)
+		for _, f := range fields {
+			if !rotatedrefreshtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rotatedrefreshtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rrtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rrtuo.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldRefreshTokenHash, field.TypeString, value)
+	}
+	if value, ok := rrtuo.mutation.Family(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldFamily, field.TypeUUID, value)
+	}
+	if value, ok := rrtuo.mutation.DeviceID(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldDeviceID, field.TypeInt64, value)
+	}
+	if value, ok := rrtuo.mutation.AddedDeviceID(); ok {
+		_spec.AddField(rotatedrefreshtoken.FieldDeviceID, field.TypeInt64, value)
+	}
+	if value, ok := rrtuo.mutation.RotatedAt(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldRotatedAt, field.TypeTime, value)
+	}
+	if value, ok := rrtuo.mutation.Successor(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldSuccessor, field.TypeString, value)
+	}
+	if rrtuo.mutation.SuccessorCleared() {
+		_spec.ClearField(rotatedrefreshtoken.FieldSuccessor, field.TypeString)
+	}
+	if value, ok := rrtuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(rotatedrefreshtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &RotatedRefreshToken{config: rrtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rrtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rotatedrefreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rrtuo.mutation.done = true
+	return _node, nil
+}
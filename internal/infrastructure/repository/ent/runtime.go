@@ -7,19 +7,32 @@ import (
 	"kiwi-user/internal/infrastructure/repository/ent/binding"
 	"kiwi-user/internal/infrastructure/repository/ent/bindingverify"
 	"kiwi-user/internal/infrastructure/repository/ent/device"
+	"kiwi-user/internal/infrastructure/repository/ent/identityprovider"
+	"kiwi-user/internal/infrastructure/repository/ent/loginlock"
+	"kiwi-user/internal/infrastructure/repository/ent/magiclink"
 	"kiwi-user/internal/infrastructure/repository/ent/mailvertifycode"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthauthorizationcode"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthconsent"
 	"kiwi-user/internal/infrastructure/repository/ent/organization"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationapplication"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationrequest"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationuser"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
 	"kiwi-user/internal/infrastructure/repository/ent/payment"
+	"kiwi-user/internal/infrastructure/repository/ent/qrlogin"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatcorp"
 	"kiwi-user/internal/infrastructure/repository/ent/qywechatuserid"
 	"kiwi-user/internal/infrastructure/repository/ent/role"
+	"kiwi-user/internal/infrastructure/repository/ent/rotatedrefreshtoken"
+	"kiwi-user/internal/infrastructure/repository/ent/samlconnection"
 	"kiwi-user/internal/infrastructure/repository/ent/schema"
 	"kiwi-user/internal/infrastructure/repository/ent/scope"
+	"kiwi-user/internal/infrastructure/repository/ent/smsverifycode"
 	"kiwi-user/internal/infrastructure/repository/ent/stripeevent"
 	"kiwi-user/internal/infrastructure/repository/ent/user"
+	"kiwi-user/internal/infrastructure/repository/ent/webauthnchallenge"
 	"kiwi-user/internal/infrastructure/repository/ent/wechatopenid"
+	"kiwi-user/internal/infrastructure/repository/ent/wechatscanlogin"
 	"time"
 
 	"github.com/google/uuid"
@@ -125,14 +138,100 @@ func init() {
 	deviceDescDeviceID := deviceFields[7].Descriptor()
 	// device.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
 	device.DeviceIDValidator = deviceDescDeviceID.Validators[0].(func(string) error)
-	// deviceDescRefreshToken is the schema descriptor for refresh_token field.
-	deviceDescRefreshToken := deviceFields[8].Descriptor()
-	// device.RefreshTokenValidator is a validator for the "refresh_token" field. It is called by the builders before save.
-	device.RefreshTokenValidator = deviceDescRefreshToken.Validators[0].(func(string) error)
 	// deviceDescRefreshTokenExpiresAt is the schema descriptor for refresh_token_expires_at field.
-	deviceDescRefreshTokenExpiresAt := deviceFields[9].Descriptor()
+	deviceDescRefreshTokenExpiresAt := deviceFields[10].Descriptor()
 	// device.DefaultRefreshTokenExpiresAt holds the default value on creation for the refresh_token_expires_at field.
 	device.DefaultRefreshTokenExpiresAt = deviceDescRefreshTokenExpiresAt.Default.(func() time.Time)
+	identityproviderFields := schema.IdentityProvider{}.Fields()
+	_ = identityproviderFields
+	// identityproviderDescCreatedAt is the schema descriptor for created_at field.
+	identityproviderDescCreatedAt := identityproviderFields[1].Descriptor()
+	// identityprovider.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identityprovider.DefaultCreatedAt = identityproviderDescCreatedAt.Default.(func() time.Time)
+	// identityproviderDescUpdatedAt is the schema descriptor for updated_at field.
+	identityproviderDescUpdatedAt := identityproviderFields[2].Descriptor()
+	// identityprovider.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	identityprovider.DefaultUpdatedAt = identityproviderDescUpdatedAt.Default.(func() time.Time)
+	// identityprovider.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	identityprovider.UpdateDefaultUpdatedAt = identityproviderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// identityproviderDescName is the schema descriptor for name field.
+	identityproviderDescName := identityproviderFields[4].Descriptor()
+	// identityprovider.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	identityprovider.NameValidator = identityproviderDescName.Validators[0].(func(string) error)
+	// identityproviderDescIssuer is the schema descriptor for issuer field.
+	identityproviderDescIssuer := identityproviderFields[6].Descriptor()
+	// identityprovider.IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	identityprovider.IssuerValidator = identityproviderDescIssuer.Validators[0].(func(string) error)
+	// identityproviderDescClientID is the schema descriptor for client_id field.
+	identityproviderDescClientID := identityproviderFields[7].Descriptor()
+	// identityprovider.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	identityprovider.ClientIDValidator = identityproviderDescClientID.Validators[0].(func(string) error)
+	// identityproviderDescEnabled is the schema descriptor for enabled field.
+	identityproviderDescEnabled := identityproviderFields[15].Descriptor()
+	// identityprovider.DefaultEnabled holds the default value on creation for the enabled field.
+	identityprovider.DefaultEnabled = identityproviderDescEnabled.Default.(bool)
+	// identityproviderDescID is the schema descriptor for id field.
+	identityproviderDescID := identityproviderFields[0].Descriptor()
+	// identityprovider.DefaultID holds the default value on creation for the id field.
+	identityprovider.DefaultID = identityproviderDescID.Default.(func() uuid.UUID)
+	loginlockFields := schema.LoginLock{}.Fields()
+	_ = loginlockFields
+	// loginlockDescCreatedAt is the schema descriptor for created_at field.
+	loginlockDescCreatedAt := loginlockFields[1].Descriptor()
+	// loginlock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginlock.DefaultCreatedAt = loginlockDescCreatedAt.Default.(func() time.Time)
+	// loginlockDescUpdatedAt is the schema descriptor for updated_at field.
+	loginlockDescUpdatedAt := loginlockFields[2].Descriptor()
+	// loginlock.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loginlock.DefaultUpdatedAt = loginlockDescUpdatedAt.Default.(func() time.Time)
+	// loginlock.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loginlock.UpdateDefaultUpdatedAt = loginlockDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loginlockDescApplicationName is the schema descriptor for application_name field.
+	loginlockDescApplicationName := loginlockFields[3].Descriptor()
+	// loginlock.ApplicationNameValidator is a validator for the "application_name" field. It is called by the builders before save.
+	loginlock.ApplicationNameValidator = loginlockDescApplicationName.Validators[0].(func(string) error)
+	// loginlockDescSubject is the schema descriptor for subject field.
+	loginlockDescSubject := loginlockFields[5].Descriptor()
+	// loginlock.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	loginlock.SubjectValidator = loginlockDescSubject.Validators[0].(func(string) error)
+	// loginlockDescFailures is the schema descriptor for failures field.
+	loginlockDescFailures := loginlockFields[6].Descriptor()
+	// loginlock.DefaultFailures holds the default value on creation for the failures field.
+	loginlock.DefaultFailures = loginlockDescFailures.Default.(int)
+	// loginlockDescID is the schema descriptor for id field.
+	loginlockDescID := loginlockFields[0].Descriptor()
+	// loginlock.DefaultID holds the default value on creation for the id field.
+	loginlock.DefaultID = loginlockDescID.Default.(func() uuid.UUID)
+	magiclinkFields := schema.MagicLink{}.Fields()
+	_ = magiclinkFields
+	// magiclinkDescCreatedAt is the schema descriptor for created_at field.
+	magiclinkDescCreatedAt := magiclinkFields[1].Descriptor()
+	// magiclink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	magiclink.DefaultCreatedAt = magiclinkDescCreatedAt.Default.(func() time.Time)
+	// magiclinkDescEmail is the schema descriptor for email field.
+	magiclinkDescEmail := magiclinkFields[3].Descriptor()
+	// magiclink.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	magiclink.EmailValidator = magiclinkDescEmail.Validators[0].(func(string) error)
+	// magiclinkDescDeviceType is the schema descriptor for device_type field.
+	magiclinkDescDeviceType := magiclinkFields[4].Descriptor()
+	// magiclink.DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
+	magiclink.DeviceTypeValidator = magiclinkDescDeviceType.Validators[0].(func(string) error)
+	// magiclinkDescDeviceID is the schema descriptor for device_id field.
+	magiclinkDescDeviceID := magiclinkFields[5].Descriptor()
+	// magiclink.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	magiclink.DeviceIDValidator = magiclinkDescDeviceID.Validators[0].(func(string) error)
+	// magiclinkDescPollTokenHash is the schema descriptor for poll_token_hash field.
+	magiclinkDescPollTokenHash := magiclinkFields[6].Descriptor()
+	// magiclink.PollTokenHashValidator is a validator for the "poll_token_hash" field. It is called by the builders before save.
+	magiclink.PollTokenHashValidator = magiclinkDescPollTokenHash.Validators[0].(func(string) error)
+	// magiclinkDescConfirmed is the schema descriptor for confirmed field.
+	magiclinkDescConfirmed := magiclinkFields[9].Descriptor()
+	// magiclink.DefaultConfirmed holds the default value on creation for the confirmed field.
+	magiclink.DefaultConfirmed = magiclinkDescConfirmed.Default.(bool)
+	// magiclinkDescID is the schema descriptor for id field.
+	magiclinkDescID := magiclinkFields[0].Descriptor()
+	// magiclink.DefaultID holds the default value on creation for the id field.
+	magiclink.DefaultID = magiclinkDescID.Default.(func() uuid.UUID)
 	mailvertifycodeFields := schema.MailVertifyCode{}.Fields()
 	_ = mailvertifycodeFields
 	// mailvertifycodeDescCreatedAt is the schema descriptor for created_at field.
@@ -149,14 +248,68 @@ func init() {
 	mailvertifycodeDescEmail := mailvertifycodeFields[5].Descriptor()
 	// mailvertifycode.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	mailvertifycode.EmailValidator = mailvertifycodeDescEmail.Validators[0].(func(string) error)
-	// mailvertifycodeDescCode is the schema descriptor for code field.
-	mailvertifycodeDescCode := mailvertifycodeFields[6].Descriptor()
-	// mailvertifycode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
-	mailvertifycode.CodeValidator = mailvertifycodeDescCode.Validators[0].(func(string) error)
+	// mailvertifycodeDescCodeHash is the schema descriptor for code_hash field.
+	mailvertifycodeDescCodeHash := mailvertifycodeFields[6].Descriptor()
+	// mailvertifycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	mailvertifycode.CodeHashValidator = mailvertifycodeDescCodeHash.Validators[0].(func(string) error)
+	// mailvertifycodeDescAttempts is the schema descriptor for attempts field.
+	mailvertifycodeDescAttempts := mailvertifycodeFields[7].Descriptor()
+	// mailvertifycode.DefaultAttempts holds the default value on creation for the attempts field.
+	mailvertifycode.DefaultAttempts = mailvertifycodeDescAttempts.Default.(int)
 	// mailvertifycodeDescID is the schema descriptor for id field.
 	mailvertifycodeDescID := mailvertifycodeFields[0].Descriptor()
 	// mailvertifycode.DefaultID holds the default value on creation for the id field.
 	mailvertifycode.DefaultID = mailvertifycodeDescID.Default.(func() uuid.UUID)
+	oauthauthorizationcodeFields := schema.OAuthAuthorizationCode{}.Fields()
+	_ = oauthauthorizationcodeFields
+	// oauthauthorizationcodeDescCreatedAt is the schema descriptor for created_at field.
+	oauthauthorizationcodeDescCreatedAt := oauthauthorizationcodeFields[1].Descriptor()
+	// oauthauthorizationcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthauthorizationcode.DefaultCreatedAt = oauthauthorizationcodeDescCreatedAt.Default.(func() time.Time)
+	// oauthauthorizationcodeDescCodeHash is the schema descriptor for code_hash field.
+	oauthauthorizationcodeDescCodeHash := oauthauthorizationcodeFields[2].Descriptor()
+	// oauthauthorizationcode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	oauthauthorizationcode.CodeHashValidator = oauthauthorizationcodeDescCodeHash.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescUserID is the schema descriptor for user_id field.
+	oauthauthorizationcodeDescUserID := oauthauthorizationcodeFields[4].Descriptor()
+	// oauthauthorizationcode.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	oauthauthorizationcode.UserIDValidator = oauthauthorizationcodeDescUserID.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescRedirectURI is the schema descriptor for redirect_uri field.
+	oauthauthorizationcodeDescRedirectURI := oauthauthorizationcodeFields[5].Descriptor()
+	// oauthauthorizationcode.RedirectURIValidator is a validator for the "redirect_uri" field. It is called by the builders before save.
+	oauthauthorizationcode.RedirectURIValidator = oauthauthorizationcodeDescRedirectURI.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescCodeChallenge is the schema descriptor for code_challenge field.
+	oauthauthorizationcodeDescCodeChallenge := oauthauthorizationcodeFields[8].Descriptor()
+	// oauthauthorizationcode.CodeChallengeValidator is a validator for the "code_challenge" field. It is called by the builders before save.
+	oauthauthorizationcode.CodeChallengeValidator = oauthauthorizationcodeDescCodeChallenge.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescCodeChallengeMethod is the schema descriptor for code_challenge_method field.
+	oauthauthorizationcodeDescCodeChallengeMethod := oauthauthorizationcodeFields[9].Descriptor()
+	// oauthauthorizationcode.CodeChallengeMethodValidator is a validator for the "code_challenge_method" field. It is called by the builders before save.
+	oauthauthorizationcode.CodeChallengeMethodValidator = oauthauthorizationcodeDescCodeChallengeMethod.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescID is the schema descriptor for id field.
+	oauthauthorizationcodeDescID := oauthauthorizationcodeFields[0].Descriptor()
+	// oauthauthorizationcode.DefaultID holds the default value on creation for the id field.
+	oauthauthorizationcode.DefaultID = oauthauthorizationcodeDescID.Default.(func() uuid.UUID)
+	oauthconsentFields := schema.OAuthConsent{}.Fields()
+	_ = oauthconsentFields
+	// oauthconsentDescCreatedAt is the schema descriptor for created_at field.
+	oauthconsentDescCreatedAt := oauthconsentFields[1].Descriptor()
+	// oauthconsent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthconsent.DefaultCreatedAt = oauthconsentDescCreatedAt.Default.(func() time.Time)
+	// oauthconsentDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthconsentDescUpdatedAt := oauthconsentFields[2].Descriptor()
+	// oauthconsent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthconsent.DefaultUpdatedAt = oauthconsentDescUpdatedAt.Default.(func() time.Time)
+	// oauthconsent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthconsent.UpdateDefaultUpdatedAt = oauthconsentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthconsentDescUserID is the schema descriptor for user_id field.
+	oauthconsentDescUserID := oauthconsentFields[4].Descriptor()
+	// oauthconsent.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	oauthconsent.UserIDValidator = oauthconsentDescUserID.Validators[0].(func(string) error)
+	// oauthconsentDescID is the schema descriptor for id field.
+	oauthconsentDescID := oauthconsentFields[0].Descriptor()
+	// oauthconsent.DefaultID holds the default value on creation for the id field.
+	oauthconsent.DefaultID = oauthconsentDescID.Default.(func() uuid.UUID)
 	organizationFields := schema.Organization{}.Fields()
 	_ = organizationFields
 	// organizationDescCreatedAt is the schema descriptor for created_at field.
@@ -291,6 +444,34 @@ func init() {
 	organizationuserDescID := organizationuserFields[0].Descriptor()
 	// organizationuser.DefaultID holds the default value on creation for the id field.
 	organizationuser.DefaultID = organizationuserDescID.Default.(func() uuid.UUID)
+	passkeycredentialFields := schema.PasskeyCredential{}.Fields()
+	_ = passkeycredentialFields
+	// passkeycredentialDescCreatedAt is the schema descriptor for created_at field.
+	passkeycredentialDescCreatedAt := passkeycredentialFields[1].Descriptor()
+	// passkeycredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passkeycredential.DefaultCreatedAt = passkeycredentialDescCreatedAt.Default.(func() time.Time)
+	// passkeycredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	passkeycredentialDescUpdatedAt := passkeycredentialFields[2].Descriptor()
+	// passkeycredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passkeycredential.DefaultUpdatedAt = passkeycredentialDescUpdatedAt.Default.(func() time.Time)
+	// passkeycredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passkeycredential.UpdateDefaultUpdatedAt = passkeycredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passkeycredentialDescUserID is the schema descriptor for user_id field.
+	passkeycredentialDescUserID := passkeycredentialFields[3].Descriptor()
+	// passkeycredential.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	passkeycredential.UserIDValidator = passkeycredentialDescUserID.Validators[0].(func(string) error)
+	// passkeycredentialDescCredentialID is the schema descriptor for credential_id field.
+	passkeycredentialDescCredentialID := passkeycredentialFields[4].Descriptor()
+	// passkeycredential.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	passkeycredential.CredentialIDValidator = passkeycredentialDescCredentialID.Validators[0].(func(string) error)
+	// passkeycredentialDescSignCount is the schema descriptor for sign_count field.
+	passkeycredentialDescSignCount := passkeycredentialFields[6].Descriptor()
+	// passkeycredential.DefaultSignCount holds the default value on creation for the sign_count field.
+	passkeycredential.DefaultSignCount = passkeycredentialDescSignCount.Default.(uint32)
+	// passkeycredentialDescID is the schema descriptor for id field.
+	passkeycredentialDescID := passkeycredentialFields[0].Descriptor()
+	// passkeycredential.DefaultID holds the default value on creation for the id field.
+	passkeycredential.DefaultID = passkeycredentialDescID.Default.(func() uuid.UUID)
 	paymentFields := schema.Payment{}.Fields()
 	_ = paymentFields
 	// paymentDescCreatedAt is the schema descriptor for created_at field.
@@ -331,6 +512,56 @@ func init() {
 	paymentDescStripeCancelAtPeriodEnd := paymentFields[18].Descriptor()
 	// payment.DefaultStripeCancelAtPeriodEnd holds the default value on creation for the stripe_cancel_at_period_end field.
 	payment.DefaultStripeCancelAtPeriodEnd = paymentDescStripeCancelAtPeriodEnd.Default.(bool)
+	qrloginFields := schema.QRLogin{}.Fields()
+	_ = qrloginFields
+	// qrloginDescCreatedAt is the schema descriptor for created_at field.
+	qrloginDescCreatedAt := qrloginFields[1].Descriptor()
+	// qrlogin.DefaultCreatedAt holds the default value on creation for the created_at field.
+	qrlogin.DefaultCreatedAt = qrloginDescCreatedAt.Default.(func() time.Time)
+	// qrloginDescDeviceType is the schema descriptor for device_type field.
+	qrloginDescDeviceType := qrloginFields[3].Descriptor()
+	// qrlogin.DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
+	qrlogin.DeviceTypeValidator = qrloginDescDeviceType.Validators[0].(func(string) error)
+	// qrloginDescDeviceID is the schema descriptor for device_id field.
+	qrloginDescDeviceID := qrloginFields[4].Descriptor()
+	// qrlogin.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	qrlogin.DeviceIDValidator = qrloginDescDeviceID.Validators[0].(func(string) error)
+	// qrloginDescPollTokenHash is the schema descriptor for poll_token_hash field.
+	qrloginDescPollTokenHash := qrloginFields[5].Descriptor()
+	// qrlogin.PollTokenHashValidator is a validator for the "poll_token_hash" field. It is called by the builders before save.
+	qrlogin.PollTokenHashValidator = qrloginDescPollTokenHash.Validators[0].(func(string) error)
+	// qrloginDescID is the schema descriptor for id field.
+	qrloginDescID := qrloginFields[0].Descriptor()
+	// qrlogin.DefaultID holds the default value on creation for the id field.
+	qrlogin.DefaultID = qrloginDescID.Default.(func() uuid.UUID)
+	qywechatcorpFields := schema.QyWechatCorp{}.Fields()
+	_ = qywechatcorpFields
+	// qywechatcorpDescCreatedAt is the schema descriptor for created_at field.
+	qywechatcorpDescCreatedAt := qywechatcorpFields[1].Descriptor()
+	// qywechatcorp.DefaultCreatedAt holds the default value on creation for the created_at field.
+	qywechatcorp.DefaultCreatedAt = qywechatcorpDescCreatedAt.Default.(func() time.Time)
+	// qywechatcorpDescUpdatedAt is the schema descriptor for updated_at field.
+	qywechatcorpDescUpdatedAt := qywechatcorpFields[2].Descriptor()
+	// qywechatcorp.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	qywechatcorp.DefaultUpdatedAt = qywechatcorpDescUpdatedAt.Default.(func() time.Time)
+	// qywechatcorp.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	qywechatcorp.UpdateDefaultUpdatedAt = qywechatcorpDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// qywechatcorpDescCorpID is the schema descriptor for corp_id field.
+	qywechatcorpDescCorpID := qywechatcorpFields[4].Descriptor()
+	// qywechatcorp.CorpIDValidator is a validator for the "corp_id" field. It is called by the builders before save.
+	qywechatcorp.CorpIDValidator = qywechatcorpDescCorpID.Validators[0].(func(string) error)
+	// qywechatcorpDescCorpSecret is the schema descriptor for corp_secret field.
+	qywechatcorpDescCorpSecret := qywechatcorpFields[5].Descriptor()
+	// qywechatcorp.CorpSecretValidator is a validator for the "corp_secret" field. It is called by the builders before save.
+	qywechatcorp.CorpSecretValidator = qywechatcorpDescCorpSecret.Validators[0].(func(string) error)
+	// qywechatcorpDescEnabled is the schema descriptor for enabled field.
+	qywechatcorpDescEnabled := qywechatcorpFields[6].Descriptor()
+	// qywechatcorp.DefaultEnabled holds the default value on creation for the enabled field.
+	qywechatcorp.DefaultEnabled = qywechatcorpDescEnabled.Default.(bool)
+	// qywechatcorpDescID is the schema descriptor for id field.
+	qywechatcorpDescID := qywechatcorpFields[0].Descriptor()
+	// qywechatcorp.DefaultID holds the default value on creation for the id field.
+	qywechatcorp.DefaultID = qywechatcorpDescID.Default.(func() uuid.UUID)
 	qywechatuseridFields := schema.QyWechatUserID{}.Fields()
 	_ = qywechatuseridFields
 	// qywechatuseridDescCreatedAt is the schema descriptor for created_at field.
@@ -371,6 +602,52 @@ func init() {
 	roleDescID := roleFields[0].Descriptor()
 	// role.DefaultID holds the default value on creation for the id field.
 	role.DefaultID = roleDescID.Default.(func() uuid.UUID)
+	rotatedrefreshtokenFields := schema.RotatedRefreshToken{}.Fields()
+	_ = rotatedrefreshtokenFields
+	// rotatedrefreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	rotatedrefreshtokenDescCreatedAt := rotatedrefreshtokenFields[1].Descriptor()
+	// rotatedrefreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rotatedrefreshtoken.DefaultCreatedAt = rotatedrefreshtokenDescCreatedAt.Default.(func() time.Time)
+	// rotatedrefreshtokenDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
+	rotatedrefreshtokenDescRefreshTokenHash := rotatedrefreshtokenFields[2].Descriptor()
+	// rotatedrefreshtoken.RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	rotatedrefreshtoken.RefreshTokenHashValidator = rotatedrefreshtokenDescRefreshTokenHash.Validators[0].(func(string) error)
+	// rotatedrefreshtokenDescID is the schema descriptor for id field.
+	rotatedrefreshtokenDescID := rotatedrefreshtokenFields[0].Descriptor()
+	// rotatedrefreshtoken.DefaultID holds the default value on creation for the id field.
+	rotatedrefreshtoken.DefaultID = rotatedrefreshtokenDescID.Default.(func() uuid.UUID)
+	samlconnectionFields := schema.SAMLConnection{}.Fields()
+	_ = samlconnectionFields
+	// samlconnectionDescCreatedAt is the schema descriptor for created_at field.
+	samlconnectionDescCreatedAt := samlconnectionFields[1].Descriptor()
+	// samlconnection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	samlconnection.DefaultCreatedAt = samlconnectionDescCreatedAt.Default.(func() time.Time)
+	// samlconnectionDescUpdatedAt is the schema descriptor for updated_at field.
+	samlconnectionDescUpdatedAt := samlconnectionFields[2].Descriptor()
+	// samlconnection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	samlconnection.DefaultUpdatedAt = samlconnectionDescUpdatedAt.Default.(func() time.Time)
+	// samlconnection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	samlconnection.UpdateDefaultUpdatedAt = samlconnectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// samlconnectionDescIdpEntityID is the schema descriptor for idp_entity_id field.
+	samlconnectionDescIdpEntityID := samlconnectionFields[4].Descriptor()
+	// samlconnection.IdpEntityIDValidator is a validator for the "idp_entity_id" field. It is called by the builders before save.
+	samlconnection.IdpEntityIDValidator = samlconnectionDescIdpEntityID.Validators[0].(func(string) error)
+	// samlconnectionDescIdpSSOURL is the schema descriptor for idp_sso_url field.
+	samlconnectionDescIdpSSOURL := samlconnectionFields[5].Descriptor()
+	// samlconnection.IdpSSOURLValidator is a validator for the "idp_sso_url" field. It is called by the builders before save.
+	samlconnection.IdpSSOURLValidator = samlconnectionDescIdpSSOURL.Validators[0].(func(string) error)
+	// samlconnectionDescRedirectURL is the schema descriptor for redirect_url field.
+	samlconnectionDescRedirectURL := samlconnectionFields[9].Descriptor()
+	// samlconnection.RedirectURLValidator is a validator for the "redirect_url" field. It is called by the builders before save.
+	samlconnection.RedirectURLValidator = samlconnectionDescRedirectURL.Validators[0].(func(string) error)
+	// samlconnectionDescEnabled is the schema descriptor for enabled field.
+	samlconnectionDescEnabled := samlconnectionFields[10].Descriptor()
+	// samlconnection.DefaultEnabled holds the default value on creation for the enabled field.
+	samlconnection.DefaultEnabled = samlconnectionDescEnabled.Default.(bool)
+	// samlconnectionDescID is the schema descriptor for id field.
+	samlconnectionDescID := samlconnectionFields[0].Descriptor()
+	// samlconnection.DefaultID holds the default value on creation for the id field.
+	samlconnection.DefaultID = samlconnectionDescID.Default.(func() uuid.UUID)
 	scopeFields := schema.Scope{}.Fields()
 	_ = scopeFields
 	// scopeDescCreatedAt is the schema descriptor for created_at field.
@@ -395,6 +672,34 @@ func init() {
 	scopeDescID := scopeFields[0].Descriptor()
 	// scope.DefaultID holds the default value on creation for the id field.
 	scope.DefaultID = scopeDescID.Default.(func() uuid.UUID)
+	smsverifycodeFields := schema.SmsVerifyCode{}.Fields()
+	_ = smsverifycodeFields
+	// smsverifycodeDescCreatedAt is the schema descriptor for created_at field.
+	smsverifycodeDescCreatedAt := smsverifycodeFields[1].Descriptor()
+	// smsverifycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	smsverifycode.DefaultCreatedAt = smsverifycodeDescCreatedAt.Default.(func() time.Time)
+	// smsverifycodeDescUpdatedAt is the schema descriptor for updated_at field.
+	smsverifycodeDescUpdatedAt := smsverifycodeFields[2].Descriptor()
+	// smsverifycode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	smsverifycode.DefaultUpdatedAt = smsverifycodeDescUpdatedAt.Default.(func() time.Time)
+	// smsverifycode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	smsverifycode.UpdateDefaultUpdatedAt = smsverifycodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// smsverifycodeDescPhone is the schema descriptor for phone field.
+	smsverifycodeDescPhone := smsverifycodeFields[4].Descriptor()
+	// smsverifycode.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	smsverifycode.PhoneValidator = smsverifycodeDescPhone.Validators[0].(func(string) error)
+	// smsverifycodeDescCodeHash is the schema descriptor for code_hash field.
+	smsverifycodeDescCodeHash := smsverifycodeFields[5].Descriptor()
+	// smsverifycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	smsverifycode.CodeHashValidator = smsverifycodeDescCodeHash.Validators[0].(func(string) error)
+	// smsverifycodeDescAttempts is the schema descriptor for attempts field.
+	smsverifycodeDescAttempts := smsverifycodeFields[6].Descriptor()
+	// smsverifycode.DefaultAttempts holds the default value on creation for the attempts field.
+	smsverifycode.DefaultAttempts = smsverifycodeDescAttempts.Default.(int)
+	// smsverifycodeDescID is the schema descriptor for id field.
+	smsverifycodeDescID := smsverifycodeFields[0].Descriptor()
+	// smsverifycode.DefaultID holds the default value on creation for the id field.
+	smsverifycode.DefaultID = smsverifycodeDescID.Default.(func() uuid.UUID)
 	stripeeventFields := schema.StripeEvent{}.Fields()
 	_ = stripeeventFields
 	// stripeeventDescCreatedAt is the schema descriptor for created_at field.
@@ -433,6 +738,24 @@ func init() {
 	userDescDepartment := userFields[9].Descriptor()
 	// user.DefaultDepartment holds the default value on creation for the department field.
 	user.DefaultDepartment = userDescDepartment.Default.(string)
+	// userDescBanned is the schema descriptor for banned field.
+	userDescBanned := userFields[10].Descriptor()
+	// user.DefaultBanned holds the default value on creation for the banned field.
+	user.DefaultBanned = userDescBanned.Default.(bool)
+	webauthnchallengeFields := schema.WebAuthnChallenge{}.Fields()
+	_ = webauthnchallengeFields
+	// webauthnchallengeDescCreatedAt is the schema descriptor for created_at field.
+	webauthnchallengeDescCreatedAt := webauthnchallengeFields[1].Descriptor()
+	// webauthnchallenge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthnchallenge.DefaultCreatedAt = webauthnchallengeDescCreatedAt.Default.(func() time.Time)
+	// webauthnchallengeDescChallenge is the schema descriptor for challenge field.
+	webauthnchallengeDescChallenge := webauthnchallengeFields[3].Descriptor()
+	// webauthnchallenge.ChallengeValidator is a validator for the "challenge" field. It is called by the builders before save.
+	webauthnchallenge.ChallengeValidator = webauthnchallengeDescChallenge.Validators[0].(func(string) error)
+	// webauthnchallengeDescID is the schema descriptor for id field.
+	webauthnchallengeDescID := webauthnchallengeFields[0].Descriptor()
+	// webauthnchallenge.DefaultID holds the default value on creation for the id field.
+	webauthnchallenge.DefaultID = webauthnchallengeDescID.Default.(func() uuid.UUID)
 	wechatopenidFields := schema.WechatOpenID{}.Fields()
 	_ = wechatopenidFields
 	// wechatopenidDescCreatedAt is the schema descriptor for created_at field.
@@ -457,4 +780,26 @@ func init() {
 	wechatopenidDescID := wechatopenidFields[0].Descriptor()
 	// wechatopenid.DefaultID holds the default value on creation for the id field.
 	wechatopenid.DefaultID = wechatopenidDescID.Default.(func() uuid.UUID)
+	wechatscanloginFields := schema.WechatScanLogin{}.Fields()
+	_ = wechatscanloginFields
+	// wechatscanloginDescCreatedAt is the schema descriptor for created_at field.
+	wechatscanloginDescCreatedAt := wechatscanloginFields[1].Descriptor()
+	// wechatscanlogin.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wechatscanlogin.DefaultCreatedAt = wechatscanloginDescCreatedAt.Default.(func() time.Time)
+	// wechatscanloginDescDeviceType is the schema descriptor for device_type field.
+	wechatscanloginDescDeviceType := wechatscanloginFields[3].Descriptor()
+	// wechatscanlogin.DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
+	wechatscanlogin.DeviceTypeValidator = wechatscanloginDescDeviceType.Validators[0].(func(string) error)
+	// wechatscanloginDescDeviceID is the schema descriptor for device_id field.
+	wechatscanloginDescDeviceID := wechatscanloginFields[4].Descriptor()
+	// wechatscanlogin.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	wechatscanlogin.DeviceIDValidator = wechatscanloginDescDeviceID.Validators[0].(func(string) error)
+	// wechatscanloginDescPollTokenHash is the schema descriptor for poll_token_hash field.
+	wechatscanloginDescPollTokenHash := wechatscanloginFields[5].Descriptor()
+	// wechatscanlogin.PollTokenHashValidator is a validator for the "poll_token_hash" field. It is called by the builders before save.
+	wechatscanlogin.PollTokenHashValidator = wechatscanloginDescPollTokenHash.Validators[0].(func(string) error)
+	// wechatscanloginDescID is the schema descriptor for id field.
+	wechatscanloginDescID := wechatscanloginFields[0].Descriptor()
+	// wechatscanlogin.DefaultID holds the default value on creation for the id field.
+	wechatscanlogin.DefaultID = wechatscanloginDescID.Default.(func() uuid.UUID)
 }
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/samlconnection"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SAMLConnection is the model entity for the SAMLConnection schema.
+type SAMLConnection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// IdpEntityID holds the value of the "idp_entity_id" field.
+	IdpEntityID string `json:"idp_entity_id,omitempty"`
+	// IdpSSOURL holds the value of the "idp_sso_url" field.
+	IdpSSOURL string `json:"idp_sso_url,omitempty"`
+	// IdpCertificates holds the value of the "idp_certificates" field.
+	IdpCertificates []string `json:"idp_certificates,omitempty"`
+	// IdpMetadata holds the value of the "idp_metadata" field.
+	IdpMetadata string `json:"idp_metadata,omitempty"`
+	// AttributeMapping holds the value of the "attribute_mapping" field.
+	AttributeMapping map[string]string `json:"attribute_mapping,omitempty"`
+	// RedirectURL holds the value of the "redirect_url" field.
+	RedirectURL string `json:"redirect_url,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled      bool `json:"enabled,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SAMLConnection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case samlconnection.FieldIdpCertificates, samlconnection.FieldAttributeMapping:
+			values[i] = new([]byte)
+		case samlconnection.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case samlconnection.FieldIdpEntityID, samlconnection.FieldIdpSSOURL, samlconnection.FieldIdpMetadata, samlconnection.FieldRedirectURL:
+			values[i] = new(sql.NullString)
+		case samlconnection.FieldCreatedAt, samlconnection.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case samlconnection.FieldID, samlconnection.FieldOrganizationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SAMLConnection fields.
+func (sc *SAMLConnection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case samlconnection.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sc.ID = *value
+			}
+		case samlconnection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sc.CreatedAt = value.Time
+			}
+		case samlconnection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sc.UpdatedAt = value.Time
+			}
+		case samlconnection.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				sc.OrganizationID = *value
+			}
+		case samlconnection.FieldIdpEntityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_entity_id", values[i])
+			} else if value.Valid {
+				sc.IdpEntityID = value.String
+			}
+		case samlconnection.FieldIdpSSOURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_sso_url", values[i])
+			} else if value.Valid {
+				sc.IdpSSOURL = value.String
+			}
+		case samlconnection.FieldIdpCertificates:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_certificates", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.IdpCertificates); err != nil {
+					return fmt.Errorf("unmarshal field idp_certificates: %w", err)
+				}
+			}
+		case samlconnection.FieldIdpMetadata:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idp_metadata", values[i])
+			} else if value.Valid {
+				sc.IdpMetadata = value.String
+			}
+		case samlconnection.FieldAttributeMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sc.AttributeMapping); err != nil {
+					return fmt.Errorf("unmarshal field attribute_mapping: %w", err)
+				}
+			}
+		case samlconnection.FieldRedirectURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_url", values[i])
+			} else if value.Valid {
+				sc.RedirectURL = value.String
+			}
+		case samlconnection.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				sc.Enabled = value.Bool
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SAMLConnection.
+// This includes values selected through modifiers, order, etc.
+func (sc *SAMLConnection) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SAMLConnection.
+// Note that you need to call SAMLConnection.Unwrap() before calling this method if this SAMLConnection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *SAMLConnection) Update() *SAMLConnectionUpdateOne {
+	return NewSAMLConnectionClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the SAMLConnection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *SAMLConnection) Unwrap() *SAMLConnection {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SAMLConnection is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *SAMLConnection) String() string {
+	var builder strings.Builder
+	builder.WriteString("SAMLConnection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", sc.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("idp_entity_id=")
+	builder.WriteString(sc.IdpEntityID)
+	builder.WriteString(", ")
+	builder.WriteString("idp_sso_url=")
+	builder.WriteString(sc.IdpSSOURL)
+	builder.WriteString(", ")
+	builder.WriteString("idp_certificates=")
+	builder.WriteString(fmt.Sprintf("%v", sc.IdpCertificates))
+	builder.WriteString(", ")
+	builder.WriteString("idp_metadata=")
+	builder.WriteString(sc.IdpMetadata)
+	builder.WriteString(", ")
+	builder.WriteString("attribute_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", sc.AttributeMapping))
+	builder.WriteString(", ")
+	builder.WriteString("redirect_url=")
+	builder.WriteString(sc.RedirectURL)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SAMLConnections is a parsable slice of SAMLConnection.
+type SAMLConnections []*SAMLConnection
@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlconnection
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the samlconnection type in the database.
+	Label = "saml_connection"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldIdpEntityID holds the string denoting the idp_entity_id field in the database.
+	FieldIdpEntityID = "idp_entity_id"
+	// FieldIdpSSOURL holds the string denoting the idp_sso_url field in the database.
+	FieldIdpSSOURL = "idp_sso_url"
+	// FieldIdpCertificates holds the string denoting the idp_certificates field in the database.
+	FieldIdpCertificates = "idp_certificates"
+	// FieldIdpMetadata holds the string denoting the idp_metadata field in the database.
+	FieldIdpMetadata = "idp_metadata"
+	// FieldAttributeMapping holds the string denoting the attribute_mapping field in the database.
+	FieldAttributeMapping = "attribute_mapping"
+	// FieldRedirectURL holds the string denoting the redirect_url field in the database.
+	FieldRedirectURL = "redirect_url"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// Table holds the table name of the samlconnection in the database.
+	Table = "saml_connections"
+)
+
+// Columns holds all SQL columns for samlconnection fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldOrganizationID,
+	FieldIdpEntityID,
+	FieldIdpSSOURL,
+	FieldIdpCertificates,
+	FieldIdpMetadata,
+	FieldAttributeMapping,
+	FieldRedirectURL,
+	FieldEnabled,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IdpEntityIDValidator is a validator for the "idp_entity_id" field. It is called by the builders before save.
+	IdpEntityIDValidator func(string) error
+	// IdpSSOURLValidator is a validator for the "idp_sso_url" field. It is called by the builders before save.
+	IdpSSOURLValidator func(string) error
+	// RedirectURLValidator is a validator for the "redirect_url" field. It is called by the builders before save.
+	RedirectURLValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SAMLConnection queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByIdpEntityID orders the results by the idp_entity_id field.
+func ByIdpEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpEntityID, opts...).ToFunc()
+}
+
+// ByIdpSSOURL orders the results by the idp_sso_url field.
+func ByIdpSSOURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpSSOURL, opts...).ToFunc()
+}
+
+// ByIdpMetadata orders the results by the idp_metadata field.
+func ByIdpMetadata(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdpMetadata, opts...).ToFunc()
+}
+
+// ByRedirectURL orders the results by the redirect_url field.
+func ByRedirectURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedirectURL, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
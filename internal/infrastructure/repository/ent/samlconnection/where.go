@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package samlconnection
+
+import (
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// IdpEntityID applies equality check predicate on the "idp_entity_id" field. It's identical to IdpEntityIDEQ.
+func IdpEntityID(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldIdpEntityID, v))
+}
+
+// IdpSSOURL applies equality check predicate on the "idp_sso_url" field. It's identical to IdpSSOURLEQ.
+func IdpSSOURL(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldIdpSSOURL, v))
+}
+
+// IdpMetadata applies equality check predicate on the "idp_metadata" field. It's identical to IdpMetadataEQ.
+func IdpMetadata(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldIdpMetadata, v))
+}
+
+// RedirectURL applies equality check predicate on the "redirect_url" field. It's identical to RedirectURLEQ.
+func RedirectURL(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldRedirectURL, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v uuid.UUID) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// IdpEntityIDEQ applies the EQ predicate on the "idp_entity_id" field.
+func IdpEntityIDEQ(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDNEQ applies the NEQ predicate on the "idp_entity_id" field.
+func IdpEntityIDNEQ(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNEQ(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDIn applies the In predicate on the "idp_entity_id" field.
+func IdpEntityIDIn(vs ...string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIn(FieldIdpEntityID, vs...))
+}
+
+// IdpEntityIDNotIn applies the NotIn predicate on the "idp_entity_id" field.
+func IdpEntityIDNotIn(vs ...string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotIn(FieldIdpEntityID, vs...))
+}
+
+// IdpEntityIDGT applies the GT predicate on the "idp_entity_id" field.
+func IdpEntityIDGT(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGT(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDGTE applies the GTE predicate on the "idp_entity_id" field.
+func IdpEntityIDGTE(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGTE(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDLT applies the LT predicate on the "idp_entity_id" field.
+func IdpEntityIDLT(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLT(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDLTE applies the LTE predicate on the "idp_entity_id" field.
+func IdpEntityIDLTE(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLTE(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDContains applies the Contains predicate on the "idp_entity_id" field.
+func IdpEntityIDContains(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldContains(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDHasPrefix applies the HasPrefix predicate on the "idp_entity_id" field.
+func IdpEntityIDHasPrefix(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldHasPrefix(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDHasSuffix applies the HasSuffix predicate on the "idp_entity_id" field.
+func IdpEntityIDHasSuffix(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldHasSuffix(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDEqualFold applies the EqualFold predicate on the "idp_entity_id" field.
+func IdpEntityIDEqualFold(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEqualFold(FieldIdpEntityID, v))
+}
+
+// IdpEntityIDContainsFold applies the ContainsFold predicate on the "idp_entity_id" field.
+func IdpEntityIDContainsFold(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldContainsFold(FieldIdpEntityID, v))
+}
+
+// IdpSSOURLEQ applies the EQ predicate on the "idp_sso_url" field.
+func IdpSSOURLEQ(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLNEQ applies the NEQ predicate on the "idp_sso_url" field.
+func IdpSSOURLNEQ(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNEQ(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLIn applies the In predicate on the "idp_sso_url" field.
+func IdpSSOURLIn(vs ...string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIn(FieldIdpSSOURL, vs...))
+}
+
+// IdpSSOURLNotIn applies the NotIn predicate on the "idp_sso_url" field.
+func IdpSSOURLNotIn(vs ...string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotIn(FieldIdpSSOURL, vs...))
+}
+
+// IdpSSOURLGT applies the GT predicate on the "idp_sso_url" field.
+func IdpSSOURLGT(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGT(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLGTE applies the GTE predicate on the "idp_sso_url" field.
+func IdpSSOURLGTE(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGTE(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLLT applies the LT predicate on the "idp_sso_url" field.
+func IdpSSOURLLT(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLT(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLLTE applies the LTE predicate on the "idp_sso_url" field.
+func IdpSSOURLLTE(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLTE(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLContains applies the Contains predicate on the "idp_sso_url" field.
+func IdpSSOURLContains(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldContains(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLHasPrefix applies the HasPrefix predicate on the "idp_sso_url" field.
+func IdpSSOURLHasPrefix(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldHasPrefix(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLHasSuffix applies the HasSuffix predicate on the "idp_sso_url" field.
+func IdpSSOURLHasSuffix(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldHasSuffix(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLEqualFold applies the EqualFold predicate on the "idp_sso_url" field.
+func IdpSSOURLEqualFold(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEqualFold(FieldIdpSSOURL, v))
+}
+
+// IdpSSOURLContainsFold applies the ContainsFold predicate on the "idp_sso_url" field.
+func IdpSSOURLContainsFold(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldContainsFold(FieldIdpSSOURL, v))
+}
+
+// IdpMetadataEQ applies the EQ predicate on the "idp_metadata" field.
+func IdpMetadataEQ(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldIdpMetadata, v))
+}
+
+// IdpMetadataNEQ applies the NEQ predicate on the "idp_metadata" field.
+func IdpMetadataNEQ(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNEQ(FieldIdpMetadata, v))
+}
+
+// IdpMetadataIn applies the In predicate on the "idp_metadata" field.
+func IdpMetadataIn(vs ...string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIn(FieldIdpMetadata, vs...))
+}
+
+// IdpMetadataNotIn applies the NotIn predicate on the "idp_metadata" field.
+func IdpMetadataNotIn(vs ...string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotIn(FieldIdpMetadata, vs...))
+}
+
+// IdpMetadataGT applies the GT predicate on the "idp_metadata" field.
+func IdpMetadataGT(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGT(FieldIdpMetadata, v))
+}
+
+// IdpMetadataGTE applies the GTE predicate on the "idp_metadata" field.
+func IdpMetadataGTE(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGTE(FieldIdpMetadata, v))
+}
+
+// IdpMetadataLT applies the LT predicate on the "idp_metadata" field.
+func IdpMetadataLT(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLT(FieldIdpMetadata, v))
+}
+
+// IdpMetadataLTE applies the LTE predicate on the "idp_metadata" field.
+func IdpMetadataLTE(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLTE(FieldIdpMetadata, v))
+}
+
+// IdpMetadataContains applies the Contains predicate on the "idp_metadata" field.
+func IdpMetadataContains(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldContains(FieldIdpMetadata, v))
+}
+
+// IdpMetadataHasPrefix applies the HasPrefix predicate on the "idp_metadata" field.
+func IdpMetadataHasPrefix(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldHasPrefix(FieldIdpMetadata, v))
+}
+
+// IdpMetadataHasSuffix applies the HasSuffix predicate on the "idp_metadata" field.
+func IdpMetadataHasSuffix(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldHasSuffix(FieldIdpMetadata, v))
+}
+
+// IdpMetadataIsNil applies the IsNil predicate on the "idp_metadata" field.
+func IdpMetadataIsNil() predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIsNull(FieldIdpMetadata))
+}
+
+// IdpMetadataNotNil applies the NotNil predicate on the "idp_metadata" field.
+func IdpMetadataNotNil() predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotNull(FieldIdpMetadata))
+}
+
+// IdpMetadataEqualFold applies the EqualFold predicate on the "idp_metadata" field.
+func IdpMetadataEqualFold(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEqualFold(FieldIdpMetadata, v))
+}
+
+// IdpMetadataContainsFold applies the ContainsFold predicate on the "idp_metadata" field.
+func IdpMetadataContainsFold(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldContainsFold(FieldIdpMetadata, v))
+}
+
+// AttributeMappingIsNil applies the IsNil predicate on the "attribute_mapping" field.
+func AttributeMappingIsNil() predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIsNull(FieldAttributeMapping))
+}
+
+// AttributeMappingNotNil applies the NotNil predicate on the "attribute_mapping" field.
+func AttributeMappingNotNil() predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotNull(FieldAttributeMapping))
+}
+
+// RedirectURLEQ applies the EQ predicate on the "redirect_url" field.
+func RedirectURLEQ(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldRedirectURL, v))
+}
+
+// RedirectURLNEQ applies the NEQ predicate on the "redirect_url" field.
+func RedirectURLNEQ(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNEQ(FieldRedirectURL, v))
+}
+
+// RedirectURLIn applies the In predicate on the "redirect_url" field.
+func RedirectURLIn(vs ...string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldIn(FieldRedirectURL, vs...))
+}
+
+// RedirectURLNotIn applies the NotIn predicate on the "redirect_url" field.
+func RedirectURLNotIn(vs ...string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNotIn(FieldRedirectURL, vs...))
+}
+
+// RedirectURLGT applies the GT predicate on the "redirect_url" field.
+func RedirectURLGT(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGT(FieldRedirectURL, v))
+}
+
+// RedirectURLGTE applies the GTE predicate on the "redirect_url" field.
+func RedirectURLGTE(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldGTE(FieldRedirectURL, v))
+}
+
+// RedirectURLLT applies the LT predicate on the "redirect_url" field.
+func RedirectURLLT(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLT(FieldRedirectURL, v))
+}
+
+// RedirectURLLTE applies the LTE predicate on the "redirect_url" field.
+func RedirectURLLTE(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldLTE(FieldRedirectURL, v))
+}
+
+// RedirectURLContains applies the Contains predicate on the "redirect_url" field.
+func RedirectURLContains(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldContains(FieldRedirectURL, v))
+}
+
+// RedirectURLHasPrefix applies the HasPrefix predicate on the "redirect_url" field.
+func RedirectURLHasPrefix(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldHasPrefix(FieldRedirectURL, v))
+}
+
+// RedirectURLHasSuffix applies the HasSuffix predicate on the "redirect_url" field.
+func RedirectURLHasSuffix(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldHasSuffix(FieldRedirectURL, v))
+}
+
+// RedirectURLEqualFold applies the EqualFold predicate on the "redirect_url" field.
+func RedirectURLEqualFold(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEqualFold(FieldRedirectURL, v))
+}
+
+// RedirectURLContainsFold applies the ContainsFold predicate on the "redirect_url" field.
+func RedirectURLContainsFold(v string) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldContainsFold(FieldRedirectURL, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SAMLConnection) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SAMLConnection) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SAMLConnection) predicate.SAMLConnection {
+	return predicate.SAMLConnection(sql.NotPredicates(p))
+}
@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/samlconnection"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SAMLConnectionCreate is the builder for creating a SAMLConnection entity.
+type SAMLConnectionCreate struct {
+	config
+	mutation *SAMLConnectionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (scc *SAMLConnectionCreate) SetCreatedAt(t time.Time) *SAMLConnectionCreate {
+	scc.mutation.SetCreatedAt(t)
+	return scc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (scc *SAMLConnectionCreate) SetNillableCreatedAt(t *time.Time) *SAMLConnectionCreate {
+	if t != nil {
+		scc.SetCreatedAt(*t)
+	}
+	return scc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scc *SAMLConnectionCreate) SetUpdatedAt(t time.Time) *SAMLConnectionCreate {
+	scc.mutation.SetUpdatedAt(t)
+	return scc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (scc *SAMLConnectionCreate) SetNillableUpdatedAt(t *time.Time) *SAMLConnectionCreate {
+	if t != nil {
+		scc.SetUpdatedAt(*t)
+	}
+	return scc
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (scc *SAMLConnectionCreate) SetOrganizationID(u uuid.UUID) *SAMLConnectionCreate {
+	scc.mutation.SetOrganizationID(u)
+	return scc
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (scc *SAMLConnectionCreate) SetIdpEntityID(s string) *SAMLConnectionCreate {
+	scc.mutation.SetIdpEntityID(s)
+	return scc
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (scc *SAMLConnectionCreate) SetIdpSSOURL(s string) *SAMLConnectionCreate {
+	scc.mutation.SetIdpSSOURL(s)
+	return scc
+}
+
+// SetIdpCertificates sets the "idp_certificates" field.
+func (scc *SAMLConnectionCreate) SetIdpCertificates(s []string) *SAMLConnectionCreate {
+	scc.mutation.SetIdpCertificates(s)
+	return scc
+}
+
+// SetIdpMetadata sets the "idp_metadata" field.
+func (scc *SAMLConnectionCreate) SetIdpMetadata(s string) *SAMLConnectionCreate {
+	scc.mutation.SetIdpMetadata(s)
+	return scc
+}
+
+// SetNillableIdpMetadata sets the "idp_metadata" field if the given value is not nil.
+func (scc *SAMLConnectionCreate) SetNillableIdpMetadata(s *string) *SAMLConnectionCreate {
+	if s != nil {
+		scc.SetIdpMetadata(*s)
+	}
+	return scc
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (scc *SAMLConnectionCreate) SetAttributeMapping(m map[string]string) *SAMLConnectionCreate {
+	scc.mutation.SetAttributeMapping(m)
+	return scc
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (scc *SAMLConnectionCreate) SetRedirectURL(s string) *SAMLConnectionCreate {
+	scc.mutation.SetRedirectURL(s)
+	return scc
+}
+
+// SetEnabled sets the "enabled" field.
+func (scc *SAMLConnectionCreate) SetEnabled(b bool) *SAMLConnectionCreate {
+	scc.mutation.SetEnabled(b)
+	return scc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (scc *SAMLConnectionCreate) SetNillableEnabled(b *bool) *SAMLConnectionCreate {
+	if b != nil {
+		scc.SetEnabled(*b)
+	}
+	return scc
+}
+
+// SetID sets the "id" field.
+func (scc *SAMLConnectionCreate) SetID(u uuid.UUID) *SAMLConnectionCreate {
+	scc.mutation.SetID(u)
+	return scc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (scc *SAMLConnectionCreate) SetNillableID(u *uuid.UUID) *SAMLConnectionCreate {
+	if u != nil {
+		scc.SetID(*u)
+	}
+	return scc
+}
+
+// Mutation returns the SAMLConnectionMutation object of the builder.
+func (scc *SAMLConnectionCreate) Mutation() *SAMLConnectionMutation {
+	return scc.mutation
+}
+
+// Save creates the SAMLConnection in the database.
+func (scc *SAMLConnectionCreate) Save(ctx context.Context) (*SAMLConnection, error) {
+	scc.defaults()
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *SAMLConnectionCreate) SaveX(ctx context.Context) *SAMLConnection {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *SAMLConnectionCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *SAMLConnectionCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scc *SAMLConnectionCreate) defaults() {
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		v := samlconnection.DefaultCreatedAt()
+		scc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := scc.mutation.UpdatedAt(); !ok {
+		v := samlconnection.DefaultUpdatedAt()
+		scc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := scc.mutation.Enabled(); !ok {
+		v := samlconnection.DefaultEnabled
+		scc.mutation.SetEnabled(v)
+	}
+	if _, ok := scc.mutation.ID(); !ok {
+		v := samlconnection.DefaultID()
+		scc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *SAMLConnectionCreate) check() error {
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SAMLConnection.created_at"`)}
+	}
+	if _, ok := scc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SAMLConnection.updated_at"`)}
+	}
+	if _, ok := scc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "SAMLConnection.organization_id"`)}
+	}
+	if _, ok := scc.mutation.IdpEntityID(); !ok {
+		return &ValidationError{Name: "idp_entity_id", err: errors.New(`ent: missing required field "SAMLConnection.idp_entity_id"`)}
+	}
+	if v, ok := scc.mutation.IdpEntityID(); ok {
+		if err := samlconnection.IdpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "idp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SAMLConnection.idp_entity_id": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.IdpSSOURL(); !ok {
+		return &ValidationError{Name: "idp_sso_url", err: errors.New(`ent: missing required field "SAMLConnection.idp_sso_url"`)}
+	}
+	if v, ok := scc.mutation.IdpSSOURL(); ok {
+		if err := samlconnection.IdpSSOURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_sso_url", err: fmt.Errorf(`ent: validator failed for field "SAMLConnection.idp_sso_url": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.IdpCertificates(); !ok {
+		return &ValidationError{Name: "idp_certificates", err: errors.New(`ent: missing required field "SAMLConnection.idp_certificates"`)}
+	}
+	if _, ok := scc.mutation.RedirectURL(); !ok {
+		return &ValidationError{Name: "redirect_url", err: errors.New(`ent: missing required field "SAMLConnection.redirect_url"`)}
+	}
+	if v, ok := scc.mutation.RedirectURL(); ok {
+		if err := samlconnection.RedirectURLValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_url", err: fmt.Errorf(`ent: validator failed for field "SAMLConnection.redirect_url": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "SAMLConnection.enabled"`)}
+	}
+	return nil
+}
+
+func (scc *SAMLConnectionCreate) sqlSave(ctx context.Context) (*SAMLConnection, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *SAMLConnectionCreate) createSpec() (*SAMLConnection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SAMLConnection{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(samlconnection.Table, sqlgraph.NewFieldSpec(samlconnection.FieldID, field.TypeUUID))
+	)
+	if id, ok := scc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := scc.mutation.CreatedAt(); ok {
+		_spec.SetField(samlconnection.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := scc.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlconnection.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := scc.mutation.OrganizationID(); ok {
+		_spec.SetField(samlconnection.FieldOrganizationID, field.TypeUUID, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := scc.mutation.IdpEntityID(); ok {
+		_spec.SetField(samlconnection.FieldIdpEntityID, field.TypeString, value)
+		_node.IdpEntityID = value
+	}
+	if value, ok := scc.mutation.IdpSSOURL(); ok {
+		_spec.SetField(samlconnection.FieldIdpSSOURL, field.TypeString, value)
+		_node.IdpSSOURL = value
+	}
+	if value, ok := scc.mutation.IdpCertificates(); ok {
+		_spec.SetField(samlconnection.FieldIdpCertificates, field.TypeJSON, value)
+		_node.IdpCertificates = value
+	}
+	if value, ok := scc.mutation.IdpMetadata(); ok {
+		_spec.SetField(samlconnection.FieldIdpMetadata, field.TypeString, value)
+		_node.IdpMetadata = value
+	}
+	if value, ok := scc.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlconnection.FieldAttributeMapping, field.TypeJSON, value)
+		_node.AttributeMapping = value
+	}
+	if value, ok := scc.mutation.RedirectURL(); ok {
+		_spec.SetField(samlconnection.FieldRedirectURL, field.TypeString, value)
+		_node.RedirectURL = value
+	}
+	if value, ok := scc.mutation.Enabled(); ok {
+		_spec.SetField(samlconnection.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// SAMLConnectionCreateBulk is the builder for creating many SAMLConnection entities in bulk.
+type SAMLConnectionCreateBulk struct {
+	config
+	err      error
+	builders []*SAMLConnectionCreate
+}
+
+// Save creates the SAMLConnection entities in the database.
+func (sccb *SAMLConnectionCreateBulk) Save(ctx context.Context) ([]*SAMLConnection, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*SAMLConnection, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SAMLConnectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *SAMLConnectionCreateBulk) SaveX(ctx context.Context) []*SAMLConnection {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *SAMLConnectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *SAMLConnectionCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/samlconnection"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SAMLConnectionDelete is the builder for deleting a SAMLConnection entity.
+type SAMLConnectionDelete struct {
+	config
+	hooks    []Hook
+	mutation *SAMLConnectionMutation
+}
+
+// Where appends a list predicates to the SAMLConnectionDelete builder.
+func (scd *SAMLConnectionDelete) Where(ps ...predicate.SAMLConnection) *SAMLConnectionDelete {
+	scd.mutation.Where(ps...)
+	return scd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (scd *SAMLConnectionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, scd.sqlExec, scd.mutation, scd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scd *SAMLConnectionDelete) ExecX(ctx context.Context) int {
+	n, err := scd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (scd *SAMLConnectionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(samlconnection.Table, sqlgraph.NewFieldSpec(samlconnection.FieldID, field.TypeUUID))
+	if ps := scd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, scd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	scd.mutation.done = true
+	return affected, err
+}
+
+// SAMLConnectionDeleteOne is the builder for deleting a single SAMLConnection entity.
+type SAMLConnectionDeleteOne struct {
+	scd *SAMLConnectionDelete
+}
+
+// Where appends a list predicates to the SAMLConnectionDelete builder.
+func (scdo *SAMLConnectionDeleteOne) Where(ps ...predicate.SAMLConnection) *SAMLConnectionDeleteOne {
+	scdo.scd.mutation.Where(ps...)
+	return scdo
+}
+
+// Exec executes the deletion query.
+func (scdo *SAMLConnectionDeleteOne) Exec(ctx context.Context) error {
+	n, err := scdo.scd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{samlconnection.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scdo *SAMLConnectionDeleteOne) ExecX(ctx context.Context) {
+	if err := scdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/samlconnection"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SAMLConnectionUpdate is the builder for updating SAMLConnection entities.
+type SAMLConnectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SAMLConnectionMutation
+}
+
+// Where appends a list predicates to the SAMLConnectionUpdate builder.
+func (scu *SAMLConnectionUpdate) Where(ps ...predicate.SAMLConnection) *SAMLConnectionUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scu *SAMLConnectionUpdate) SetUpdatedAt(t time.Time) *SAMLConnectionUpdate {
+	scu.mutation.SetUpdatedAt(t)
+	return scu
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (scu *SAMLConnectionUpdate) SetOrganizationID(u uuid.UUID) *SAMLConnectionUpdate {
+	scu.mutation.SetOrganizationID(u)
+	return scu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (scu *SAMLConnectionUpdate) SetNillableOrganizationID(u *uuid.UUID) *SAMLConnectionUpdate {
+	if u != nil {
+		scu.SetOrganizationID(*u)
+	}
+	return scu
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (scu *SAMLConnectionUpdate) SetIdpEntityID(s string) *SAMLConnectionUpdate {
+	scu.mutation.SetIdpEntityID(s)
+	return scu
+}
+
+// SetNillableIdpEntityID sets the "idp_entity_id" field if the given value is not nil.
+func (scu *SAMLConnectionUpdate) SetNillableIdpEntityID(s *string) *SAMLConnectionUpdate {
+	if s != nil {
+		scu.SetIdpEntityID(*s)
+	}
+	return scu
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (scu *SAMLConnectionUpdate) SetIdpSSOURL(s string) *SAMLConnectionUpdate {
+	scu.mutation.SetIdpSSOURL(s)
+	return scu
+}
+
+// SetNillableIdpSSOURL sets the "idp_sso_url" field if the given value is not nil.
+func (scu *SAMLConnectionUpdate) SetNillableIdpSSOURL(s *string) *SAMLConnectionUpdate {
+	if s != nil {
+		scu.SetIdpSSOURL(*s)
+	}
+	return scu
+}
+
+// SetIdpCertificates sets the "idp_certificates" field.
+func (scu *SAMLConnectionUpdate) SetIdpCertificates(s []string) *SAMLConnectionUpdate {
+	scu.mutation.SetIdpCertificates(s)
+	return scu
+}
+
+// AppendIdpCertificates appends s to the "idp_certificates" field.
+func (scu *SAMLConnectionUpdate) AppendIdpCertificates(s []string) *SAMLConnectionUpdate {
+	scu.mutation.AppendIdpCertificates(s)
+	return scu
+}
+
+// SetIdpMetadata sets the "idp_metadata" field.
+func (scu *SAMLConnectionUpdate) SetIdpMetadata(s string) *SAMLConnectionUpdate {
+	scu.mutation.SetIdpMetadata(s)
+	return scu
+}
+
+// SetNillableIdpMetadata sets the "idp_metadata" field if the given value is not nil.
+func (scu *SAMLConnectionUpdate) SetNillableIdpMetadata(s *string) *SAMLConnectionUpdate {
+	if s != nil {
+		scu.SetIdpMetadata(*s)
+	}
+	return scu
+}
+
+// ClearIdpMetadata clears the value of the "idp_metadata" field.
+func (scu *SAMLConnectionUpdate) ClearIdpMetadata() *SAMLConnectionUpdate {
+	scu.mutation.ClearIdpMetadata()
+	return scu
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (scu *SAMLConnectionUpdate) SetAttributeMapping(m map[string]string) *SAMLConnectionUpdate {
+	scu.mutation.SetAttributeMapping(m)
+	return scu
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (scu *SAMLConnectionUpdate) ClearAttributeMapping() *SAMLConnectionUpdate {
+	scu.mutation.ClearAttributeMapping()
+	return scu
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (scu *SAMLConnectionUpdate) SetRedirectURL(s string) *SAMLConnectionUpdate {
+	scu.mutation.SetRedirectURL(s)
+	return scu
+}
+
+// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
+func (scu *SAMLConnectionUpdate) SetNillableRedirectURL(s *string) *SAMLConnectionUpdate {
+	if s != nil {
+		scu.SetRedirectURL(*s)
+	}
+	return scu
+}
+
+// SetEnabled sets the "enabled" field.
+func (scu *SAMLConnectionUpdate) SetEnabled(b bool) *SAMLConnectionUpdate {
+	scu.mutation.SetEnabled(b)
+	return scu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (scu *SAMLConnectionUpdate) SetNillableEnabled(b *bool) *SAMLConnectionUpdate {
+	if b != nil {
+		scu.SetEnabled(*b)
+	}
+	return scu
+}
+
+// Mutation returns the SAMLConnectionMutation object of the builder.
+func (scu *SAMLConnectionUpdate) Mutation() *SAMLConnectionMutation {
+	return scu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *SAMLConnectionUpdate) Save(ctx context.Context) (int, error) {
+	scu.defaults()
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *SAMLConnectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *SAMLConnectionUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *SAMLConnectionUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scu *SAMLConnectionUpdate) defaults() {
+	if _, ok := scu.mutation.UpdatedAt(); !ok {
+		v := samlconnection.UpdateDefaultUpdatedAt()
+		scu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *SAMLConnectionUpdate) check() error {
+	if v, ok := scu.mutation.IdpEntityID(); ok {
+		if err := samlconnection.IdpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "idp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SAMLConnection.idp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := scu.mutation.IdpSSOURL(); ok {
+		if err := samlconnection.IdpSSOURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_sso_url", err: fmt.Errorf(`ent: validator failed for field "SAMLConnection.idp_sso_url": %w`, err)}
+		}
+	}
+	if v, ok := scu.mutation.RedirectURL(); ok {
+		if err := samlconnection.RedirectURLValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_url", err: fmt.Errorf(`ent: validator failed for field "SAMLConnection.redirect_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scu *SAMLConnectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(samlconnection.Table, samlconnection.Columns, sqlgraph.NewFieldSpec(samlconnection.FieldID, field.TypeUUID))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlconnection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := scu.mutation.OrganizationID(); ok {
+		_spec.SetField(samlconnection.FieldOrganizationID, field.TypeUUID, value)
+	}
+	if value, ok := scu.mutation.IdpEntityID(); ok {
+		_spec.SetField(samlconnection.FieldIdpEntityID, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.IdpSSOURL(); ok {
+		_spec.SetField(samlconnection.FieldIdpSSOURL, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.IdpCertificates(); ok {
+		_spec.SetField(samlconnection.FieldIdpCertificates, field.TypeJSON, value)
+	}
+	if value, ok := scu.mutation.AppendedIdpCertificates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, samlconnection.FieldIdpCertificates, value)
+		})
+	}
+	if value, ok := scu.mutation.IdpMetadata(); ok {
+		_spec.SetField(samlconnection.FieldIdpMetadata, field.TypeString, value)
+	}
+	if scu.mutation.IdpMetadataCleared() {
+		_spec.ClearField(samlconnection.FieldIdpMetadata, field.TypeString)
+	}
+	if value, ok := scu.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlconnection.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if scu.mutation.AttributeMappingCleared() {
+		_spec.ClearField(samlconnection.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := scu.mutation.RedirectURL(); ok {
+		_spec.SetField(samlconnection.FieldRedirectURL, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.Enabled(); ok {
+		_spec.SetField(samlconnection.FieldEnabled, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlconnection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// SAMLConnectionUpdateOne is the builder for updating a single SAMLConnection entity.
+type SAMLConnectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SAMLConnectionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scuo *SAMLConnectionUpdateOne) SetUpdatedAt(t time.Time) *SAMLConnectionUpdateOne {
+	scuo.mutation.SetUpdatedAt(t)
+	return scuo
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (scuo *SAMLConnectionUpdateOne) SetOrganizationID(u uuid.UUID) *SAMLConnectionUpdateOne {
+	scuo.mutation.SetOrganizationID(u)
+	return scuo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (scuo *SAMLConnectionUpdateOne) SetNillableOrganizationID(u *uuid.UUID) *SAMLConnectionUpdateOne {
+	if u != nil {
+		scuo.SetOrganizationID(*u)
+	}
+	return scuo
+}
+
+// SetIdpEntityID sets the "idp_entity_id" field.
+func (scuo *SAMLConnectionUpdateOne) SetIdpEntityID(s string) *SAMLConnectionUpdateOne {
+	scuo.mutation.SetIdpEntityID(s)
+	return scuo
+}
+
+// SetNillableIdpEntityID sets the "idp_entity_id" field if the given value is not nil.
+func (scuo *SAMLConnectionUpdateOne) SetNillableIdpEntityID(s *string) *SAMLConnectionUpdateOne {
+	if s != nil {
+		scuo.SetIdpEntityID(*s)
+	}
+	return scuo
+}
+
+// SetIdpSSOURL sets the "idp_sso_url" field.
+func (scuo *SAMLConnectionUpdateOne) SetIdpSSOURL(s string) *SAMLConnectionUpdateOne {
+	scuo.mutation.SetIdpSSOURL(s)
+	return scuo
+}
+
+// SetNillableIdpSSOURL sets the "idp_sso_url" field if the given value is not nil.
+func (scuo *SAMLConnectionUpdateOne) SetNillableIdpSSOURL(s *string) *SAMLConnectionUpdateOne {
+	if s != nil {
+		scuo.SetIdpSSOURL(*s)
+	}
+	return scuo
+}
+
+// SetIdpCertificates sets the "idp_certificates" field.
+func (scuo *SAMLConnectionUpdateOne) SetIdpCertificates(s []string) *SAMLConnectionUpdateOne {
+	scuo.mutation.SetIdpCertificates(s)
+	return scuo
+}
+
+// AppendIdpCertificates appends s to the "idp_certificates" field.
+func (scuo *SAMLConnectionUpdateOne) AppendIdpCertificates(s []string) *SAMLConnectionUpdateOne {
+	scuo.mutation.AppendIdpCertificates(s)
+	return scuo
+}
+
+// SetIdpMetadata sets the "idp_metadata" field.
+func (scuo *SAMLConnectionUpdateOne) SetIdpMetadata(s string) *SAMLConnectionUpdateOne {
+	scuo.mutation.SetIdpMetadata(s)
+	return scuo
+}
+
+// SetNillableIdpMetadata sets the "idp_metadata" field if the given value is not nil.
+func (scuo *SAMLConnectionUpdateOne) SetNillableIdpMetadata(s *string) *SAMLConnectionUpdateOne {
+	if s != nil {
+		scuo.SetIdpMetadata(*s)
+	}
+	return scuo
+}
+
+// ClearIdpMetadata clears the value of the "idp_metadata" field.
+func (scuo *SAMLConnectionUpdateOne) ClearIdpMetadata() *SAMLConnectionUpdateOne {
+	scuo.mutation.ClearIdpMetadata()
+	return scuo
+}
+
+// SetAttributeMapping sets the "attribute_mapping" field.
+func (scuo *SAMLConnectionUpdateOne) SetAttributeMapping(m map[string]string) *SAMLConnectionUpdateOne {
+	scuo.mutation.SetAttributeMapping(m)
+	return scuo
+}
+
+// ClearAttributeMapping clears the value of the "attribute_mapping" field.
+func (scuo *SAMLConnectionUpdateOne) ClearAttributeMapping() *SAMLConnectionUpdateOne {
+	scuo.mutation.ClearAttributeMapping()
+	return scuo
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (scuo *SAMLConnectionUpdateOne) SetRedirectURL(s string) *SAMLConnectionUpdateOne {
+	scuo.mutation.SetRedirectURL(s)
+	return scuo
+}
+
+// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
+func (scuo *SAMLConnectionUpdateOne) SetNillableRedirectURL(s *string) *SAMLConnectionUpdateOne {
+	if s != nil {
+		scuo.SetRedirectURL(*s)
+	}
+	return scuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (scuo *SAMLConnectionUpdateOne) SetEnabled(b bool) *SAMLConnectionUpdateOne {
+	scuo.mutation.SetEnabled(b)
+	return scuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (scuo *SAMLConnectionUpdateOne) SetNillableEnabled(b *bool) *SAMLConnectionUpdateOne {
+	if b != nil {
+		scuo.SetEnabled(*b)
+	}
+	return scuo
+}
+
+// Mutation returns the SAMLConnectionMutation object of the builder.
+func (scuo *SAMLConnectionUpdateOne) Mutation() *SAMLConnectionMutation {
+	return scuo.mutation
+}
+
+// Where appends a list predicates to the SAMLConnectionUpdate builder.
+func (scuo *SAMLConnectionUpdateOne) Where(ps ...predicate.SAMLConnection) *SAMLConnectionUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *SAMLConnectionUpdateOne) Select(field string, fields ...string) *SAMLConnectionUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated SAMLConnection entity.
+func (scuo *SAMLConnectionUpdateOne) Save(ctx context.Context) (*SAMLConnection, error) {
+	scuo.defaults()
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *SAMLConnectionUpdateOne) SaveX(ctx context.Context) *SAMLConnection {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *SAMLConnectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *SAMLConnectionUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scuo *SAMLConnectionUpdateOne) defaults() {
+	if _, ok := scuo.mutation.UpdatedAt(); !ok {
+		v := samlconnection.UpdateDefaultUpdatedAt()
+		scuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *SAMLConnectionUpdateOne) check() error {
+	if v, ok := scuo.mutation.IdpEntityID(); ok {
+		if err := samlconnection.IdpEntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "idp_entity_id", err: fmt.Errorf(`ent: validator failed for field "SAMLConnection.idp_entity_id": %w`, err)}
+		}
+	}
+	if v, ok := scuo.mutation.IdpSSOURL(); ok {
+		if err := samlconnection.IdpSSOURLValidator(v); err != nil {
+			return &ValidationError{Name: "idp_sso_url", err: fmt.Errorf(`ent: validator failed for field "SAMLConnection.idp_sso_url": %w`, err)}
+		}
+	}
+	if v, ok := scuo.mutation.RedirectURL(); ok {
+		if err := samlconnection.RedirectURLValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_url", err: fmt.Errorf(`ent: validator failed for field "SAMLConnection.redirect_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scuo *SAMLConnectionUpdateOne) sqlSave(ctx context.Context) (_node *SAMLConnection, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(samlconnection.Table, samlconnection.Columns, sqlgraph.NewFieldSpec(samlconnection.FieldID, field.TypeUUID))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SAMLConnection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, samlconnection.FieldID)
+		for _, f := range fields {
+			if !samlconnection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != samlconnection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(samlconnection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := scuo.mutation.OrganizationID(); ok {
+		_spec.SetField(samlconnection.FieldOrganizationID, field.TypeUUID, value)
+	}
+	if value, ok := scuo.mutation.IdpEntityID(); ok {
+		_spec.SetField(samlconnection.FieldIdpEntityID, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.IdpSSOURL(); ok {
+		_spec.SetField(samlconnection.FieldIdpSSOURL, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.IdpCertificates(); ok {
+		_spec.SetField(samlconnection.FieldIdpCertificates, field.TypeJSON, value)
+	}
+	if value, ok := scuo.mutation.AppendedIdpCertificates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, samlconnection.FieldIdpCertificates, value)
+		})
+	}
+	if value, ok := scuo.mutation.IdpMetadata(); ok {
+		_spec.SetField(samlconnection.FieldIdpMetadata, field.TypeString, value)
+	}
+	if scuo.mutation.IdpMetadataCleared() {
+		_spec.ClearField(samlconnection.FieldIdpMetadata, field.TypeString)
+	}
+	if value, ok := scuo.mutation.AttributeMapping(); ok {
+		_spec.SetField(samlconnection.FieldAttributeMapping, field.TypeJSON, value)
+	}
+	if scuo.mutation.AttributeMappingCleared() {
+		_spec.ClearField(samlconnection.FieldAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := scuo.mutation.RedirectURL(); ok {
+		_spec.SetField(samlconnection.FieldRedirectURL, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.Enabled(); ok {
+		_spec.SetField(samlconnection.FieldEnabled, field.TypeBool, value)
+	}
+	_node = &SAMLConnection{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{samlconnection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
@@ -26,6 +26,9 @@ func (Application) Fields() []ent.Field {
 			UpdateDefault(time.Now),
 		field.Time("deleted_at").Optional(),
 		field.String("name").NotEmpty(),
+		// oauth client, client_id is the application name
+		field.String("client_secret_hash").Optional().Sensitive(),
+		field.Strings("redirect_uris").Optional(),
 	}
 }
 
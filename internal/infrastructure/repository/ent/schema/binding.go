@@ -32,6 +32,8 @@ func (Binding) Fields() []ent.Field {
 		field.String("email").Optional(),
 		field.Bool("verified").Default(false),
 		field.String("salt").Optional(),
+		// encrypted shared secret of second factor bindings
+		field.String("secret").Optional().Sensitive(),
 		field.String("user_id"),
 		field.UUID("application_id", uuid.UUID{}).Optional(),
 	}
@@ -28,8 +28,19 @@ func (Device) Fields() []ent.Field {
 		field.UUID("organization_id", uuid.UUID{}).Optional(),
 		field.String("device_type").NotEmpty(),
 		field.String("device_id").NotEmpty(),
-		field.String("refresh_token").NotEmpty(),
+		// legacy plaintext token, hashed into refresh_token_hash on startup
+		field.String("refresh_token").Optional(),
+		// keyed hash of the refresh token, the token itself is never stored
+		field.String("refresh_token_hash").Optional(),
 		field.Time("refresh_token_expires_at").Default(timeOneDayLater),
+		// every login starts a new family, rotated refresh tokens stay in it
+		field.UUID("refresh_token_family", uuid.UUID{}).Optional(),
+		// client of the latest login or refresh, shown in the session list
+		field.String("last_ip").Optional(),
+		field.String("user_agent").Optional(),
+		field.Time("last_refreshed_at").Optional(),
+		// scope granted to the client of an oauth session, empty for first party sessions
+		field.String("oauth_scope").Optional(),
 	}
 }
 
@@ -46,6 +57,7 @@ func (Device) Edges() []ent.Edge {
 func (Device) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("refresh_token").Unique(),
+		index.Fields("refresh_token_hash").Unique(),
 		index.Fields("user_id", "device_type", "device_id").Unique(),
 	}
 }
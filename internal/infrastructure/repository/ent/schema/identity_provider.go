@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdentityProvider an external OpenID Connect provider users of an application can log in with
+type IdentityProvider struct {
+	ent.Schema
+}
+
+func (IdentityProvider) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.UUID("application_id", uuid.UUID{}),
+		// qualifies the binding identities of the provider, never changes
+		field.String("name").NotEmpty().Immutable(),
+		field.String("display_name").Optional(),
+		field.String("issuer").NotEmpty(),
+		field.String("client_id").NotEmpty(),
+		// encrypted with the federation secret encryption key
+		field.String("client_secret").Optional().Sensitive(),
+		field.Strings("scopes").Optional(),
+		// override the discovered endpoints, providers without discovery such as github set them all
+		field.String("authorization_endpoint").Optional(),
+		field.String("token_endpoint").Optional(),
+		field.String("userinfo_endpoint").Optional(),
+		field.String("jwks_uri").Optional(),
+		// claim names of subject, email, email_verified, name and picture
+		field.JSON("claim_mapping", map[string]string{}).Optional(),
+		field.Bool("enabled").Default(true),
+	}
+}
+
+func (IdentityProvider) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("application_id", "name").Unique(),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LoginLock failed password logins of a user name or a client ip within an application
+type LoginLock struct {
+	ent.Schema
+}
+
+func (LoginLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.String("application_name").NotEmpty(),
+		field.Enum("scope").Values(convertStingerSliceToStringSlice(enum.GetAllLoginLockScopes())...),
+		// user name or client ip
+		field.String("subject").NotEmpty(),
+		field.Int("failures").Default(0),
+		field.Time("last_failure_at"),
+		// progressive delay, no attempt is accepted before
+		field.Time("next_attempt_at").Optional(),
+		// set once the failures reach the limit
+		field.Time("locked_until").Optional(),
+	}
+}
+
+func (LoginLock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("application_name", "scope", "subject").Unique(),
+		index.Fields("locked_until"),
+	}
+}
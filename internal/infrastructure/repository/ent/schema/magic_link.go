@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MagicLink a passwordless email login, confirmed by opening the link and completed by the
+// requesting device
+type MagicLink struct {
+	ent.Schema
+}
+
+func (MagicLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.UUID("application_id", uuid.UUID{}),
+		field.String("email").NotEmpty(),
+		// the requesting device, only it can complete the login
+		field.String("device_type").NotEmpty(),
+		field.String("device_id").NotEmpty(),
+		field.String("poll_token_hash").NotEmpty(),
+		// shown on the landing page of the link, the user confirms only a login they recognize
+		field.String("client_ip").Optional(),
+		field.String("user_agent").Optional(),
+		field.Bool("confirmed").Default(false),
+		field.Time("expires_at"),
+	}
+}
+
+func (MagicLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -26,7 +26,10 @@ func (MailVertifyCode) Fields() []ent.Field {
 		field.Time("deleted_at").Optional(),
 		field.Enum("type").Values(convertStingerSliceToStringSlice(enum.GetAllVertificationCodeTypes())...),
 		field.String("email").NotEmpty(),
-		field.String("code").NotEmpty(),
+		// keyed hash of the code, the code itself is only in the email
+		field.String("code_hash").NotEmpty(),
+		// wrong guesses of the current code
+		field.Int("attempts").Default(0),
 		field.Time("expires_at"),
 	}
 }
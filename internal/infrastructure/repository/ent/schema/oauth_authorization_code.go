@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type OAuthAuthorizationCode struct {
+	ent.Schema
+}
+
+func (OAuthAuthorizationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.String("code_hash").NotEmpty(),
+		field.UUID("application_id", uuid.UUID{}),
+		field.String("user_id").NotEmpty(),
+		field.String("redirect_uri").NotEmpty(),
+		field.String("scope").Optional(),
+		field.String("nonce").Optional(),
+		field.String("code_challenge").NotEmpty(),
+		field.String("code_challenge_method").NotEmpty(),
+		field.Time("expires_at"),
+	}
+}
+
+func (OAuthAuthorizationCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code_hash").Unique(),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OAuthConsent the scopes a user allowed an oauth client, later authorizations within them skip the consent page
+type OAuthConsent struct {
+	ent.Schema
+}
+
+func (OAuthConsent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.UUID("application_id", uuid.UUID{}),
+		field.String("user_id").NotEmpty(),
+		field.String("scope").Optional(),
+	}
+}
+
+func (OAuthConsent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "application_id").Unique(),
+	}
+}
@@ -27,6 +27,8 @@ func (OrganizationUser) Fields() []ent.Field {
 		field.Time("deleted_at").Optional(),
 		field.UUID("organization_id", uuid.UUID{}),
 		field.String("user_id"),
+		// synced from the identity provider of saml logins
+		field.String("department").Optional(),
 	}
 }
 
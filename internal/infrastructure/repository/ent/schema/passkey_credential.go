@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type PasskeyCredential struct {
+	ent.Schema
+}
+
+func (PasskeyCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.String("user_id").NotEmpty(),
+		// base64url encoded credential id chosen by the authenticator
+		field.String("credential_id").NotEmpty(),
+		// COSE encoded public key
+		field.Bytes("public_key"),
+		field.Uint32("sign_count").Default(0),
+		field.Strings("transports").Optional(),
+		field.String("aaguid").Optional(),
+		field.String("name").Optional(),
+		field.Time("last_used_at").Optional(),
+	}
+}
+
+func (PasskeyCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("passkey_credentials").
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+func (PasskeyCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("credential_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QRLogin a login of a browser shown as qr code, approved from the app of a signed in user
+type QRLogin struct {
+	ent.Schema
+}
+
+func (QRLogin) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.UUID("application_id", uuid.UUID{}),
+		// the browser waiting for the approval, only it can complete the login
+		field.String("device_type").NotEmpty(),
+		field.String("device_id").NotEmpty(),
+		field.String("poll_token_hash").NotEmpty(),
+		// shown to the approving user
+		field.String("client_ip").Optional(),
+		field.String("user_agent").Optional(),
+		field.Enum("status").Values(convertStingerSliceToStringSlice(enum.GetAllQRLoginStatuses())...),
+		// set when scanned, the user the browser is logged in as
+		field.String("user_id").Optional(),
+		field.Time("expires_at"),
+	}
+}
+
+func (QRLogin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QyWechatCorp the WeCom corp members of an organization log in from
+type QyWechatCorp struct {
+	ent.Schema
+}
+
+func (QyWechatCorp) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.UUID("organization_id", uuid.UUID{}).Unique(),
+		// qualifies the binding identities of the corp, userids are only unique per corp
+		field.String("corp_id").NotEmpty().Unique(),
+		// encrypted with the federation secret encryption key
+		field.String("corp_secret").NotEmpty().Sensitive(),
+		field.Bool("enabled").Default(true),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RotatedRefreshToken refresh tokens replaced by a rotation, presenting one again reveals a leaked token
+type RotatedRefreshToken struct {
+	ent.Schema
+}
+
+func (RotatedRefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.String("refresh_token_hash").NotEmpty(),
+		field.UUID("family", uuid.UUID{}),
+		field.Int64("device_id"),
+		field.Time("rotated_at"),
+		// the token that replaced it, encrypted under this token: a concurrent refresh with this
+		// token within the grace window gets it back
+		field.String("successor").Optional().Sensitive(),
+		// the expiry the token had, after it the row is useless
+		field.Time("expires_at"),
+	}
+}
+
+func (RotatedRefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("refresh_token_hash").Unique(),
+		index.Fields("family"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SAMLConnection the corporate SAML identity provider members of an organization log in with
+type SAMLConnection struct {
+	ent.Schema
+}
+
+func (SAMLConnection) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.UUID("organization_id", uuid.UUID{}).Unique(),
+		// read from the uploaded identity provider metadata
+		field.String("idp_entity_id").NotEmpty(),
+		field.String("idp_sso_url").NotEmpty(),
+		field.Strings("idp_certificates"),
+		field.Text("idp_metadata").Optional(),
+		// saml attribute names of name, email and department
+		field.JSON("attribute_mapping", map[string]string{}).Optional(),
+		// the frontend page finishing the login with the ticket of the assertion consumer service
+		field.String("redirect_url").NotEmpty(),
+		field.Bool("enabled").Default(true),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SmsVerifyCode a code sent to a phone number, one per purpose
+type SmsVerifyCode struct {
+	ent.Schema
+}
+
+func (SmsVerifyCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Enum("type").Values(convertStingerSliceToStringSlice(enum.GetAllVertificationCodeTypes())...),
+		field.String("phone").NotEmpty(),
+		// keyed hash of the code, the code itself is only in the message
+		field.String("code_hash").NotEmpty(),
+		// wrong guesses of the current code
+		field.Int("attempts").Default(0),
+		field.Time("expires_at"),
+	}
+}
+
+func (SmsVerifyCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("type", "phone").Unique(),
+	}
+}
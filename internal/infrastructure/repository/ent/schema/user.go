@@ -35,6 +35,8 @@ func (User) Fields() []ent.Field {
 			Default(""). // 默认为空字符串
 			Optional().  // 设为可选，使其在数据库中 NULLable
 			Comment("部门"),
+		// banned users are refused new tokens
+		field.Bool("banned").Default(false),
 	}
 }
 
@@ -53,6 +55,7 @@ func (User) Edges() []ent.Edge {
 		edge.To("open_ids", WechatOpenID.Type),
 		edge.To("qy_wechat_user_ids", QyWechatUserID.Type),
 		edge.To("payments", Payment.Type),
+		edge.To("passkey_credentials", PasskeyCredential.Type),
 
 		edge.From("application", Application.Type).
 			Ref("users").
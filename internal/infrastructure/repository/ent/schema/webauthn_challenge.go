@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"kiwi-user/internal/domain/model/enum"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type WebAuthnChallenge struct {
+	ent.Schema
+}
+
+func (WebAuthnChallenge) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Enum("type").Values(convertStingerSliceToStringSlice(enum.GetAllWebAuthnChallengeTypes())...),
+		field.String("challenge").NotEmpty(),
+		field.UUID("application_id", uuid.UUID{}),
+		// set for registrations, logins resolve the user from the credential
+		field.String("user_id").Optional(),
+		field.Time("expires_at"),
+	}
+}
+
+func (WebAuthnChallenge) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("challenge").Unique(),
+		index.Fields("expires_at"),
+	}
+}
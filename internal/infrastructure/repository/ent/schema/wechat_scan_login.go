@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WechatScanLogin a login of a browser shown as the parametric qr code of the wechat official account,
+// completed by the event wechat pushes when the code is scanned
+type WechatScanLogin struct {
+	ent.Schema
+}
+
+func (WechatScanLogin) Fields() []ent.Field {
+	return []ent.Field{
+		// also the scene of the qr code
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.UUID("application_id", uuid.UUID{}),
+		// the browser waiting for the scan, only it can complete the login
+		field.String("device_type").NotEmpty(),
+		field.String("device_id").NotEmpty(),
+		field.String("poll_token_hash").NotEmpty(),
+		// set by the scan event
+		field.String("open_id").Optional(),
+		field.String("union_id").Optional(),
+		field.Time("expires_at"),
+	}
+}
+
+func (WechatScanLogin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/smsverifycode"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SmsVerifyCode is the model entity for the SmsVerifyCode schema.
+type SmsVerifyCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Type holds the value of the "type" field.
+	Type smsverifycode.Type `json:"type,omitempty"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"code_hash,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SmsVerifyCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case smsverifycode.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case smsverifycode.FieldType, smsverifycode.FieldPhone, smsverifycode.FieldCodeHash:
+			values[i] = new(sql.NullString)
+		case smsverifycode.FieldCreatedAt, smsverifycode.FieldUpdatedAt, smsverifycode.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case smsverifycode.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SmsVerifyCode fields.
+func (svc *SmsVerifyCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case smsverifycode.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				svc.ID = *value
+			}
+		case smsverifycode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				svc.CreatedAt = value.Time
+			}
+		case smsverifycode.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				svc.UpdatedAt = value.Time
+			}
+		case smsverifycode.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				svc.Type = smsverifycode.Type(value.String)
+			}
+		case smsverifycode.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				svc.Phone = value.String
+			}
+		case smsverifycode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				svc.CodeHash = value.String
+			}
+		case smsverifycode.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				svc.Attempts = int(value.Int64)
+			}
+		case smsverifycode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				svc.ExpiresAt = value.Time
+			}
+		default:
+			svc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SmsVerifyCode.
+// This includes values selected through modifiers, order, etc.
+func (svc *SmsVerifyCode) Value(name string) (ent.Value, error) {
+	return svc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SmsVerifyCode.
+// Note that you need to call SmsVerifyCode.Unwrap() before calling this method if this SmsVerifyCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (svc *SmsVerifyCode) Update() *SmsVerifyCodeUpdateOne {
+	return NewSmsVerifyCodeClient(svc.config).UpdateOne(svc)
+}
+
+// Unwrap unwraps the SmsVerifyCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (svc *SmsVerifyCode) Unwrap() *SmsVerifyCode {
+	_tx, ok := svc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SmsVerifyCode is not a transactional entity")
+	}
+	svc.config.driver = _tx.drv
+	return svc
+}
+
+// String implements the fmt.Stringer.
+func (svc *SmsVerifyCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("SmsVerifyCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", svc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(svc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(svc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", svc.Type))
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(svc.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=")
+	builder.WriteString(svc.CodeHash)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", svc.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(svc.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SmsVerifyCodes is a parsable slice of SmsVerifyCode.
+type SmsVerifyCodes []*SmsVerifyCode
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/smsverifycode"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SmsVerifyCodeCreate is the builder for creating a SmsVerifyCode entity.
+type SmsVerifyCodeCreate struct {
+	config
+	mutation *SmsVerifyCodeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (svcc *SmsVerifyCodeCreate) SetCreatedAt(t time.Time) *SmsVerifyCodeCreate {
+	svcc.mutation.SetCreatedAt(t)
+	return svcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (svcc *SmsVerifyCodeCreate) SetNillableCreatedAt(t *time.Time) *SmsVerifyCodeCreate {
+	if t != nil {
+		svcc.SetCreatedAt(*t)
+	}
+	return svcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (svcc *SmsVerifyCodeCreate) SetUpdatedAt(t time.Time) *SmsVerifyCodeCreate {
+	svcc.mutation.SetUpdatedAt(t)
+	return svcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (svcc *SmsVerifyCodeCreate) SetNillableUpdatedAt(t *time.Time) *SmsVerifyCodeCreate {
+	if t != nil {
+		svcc.SetUpdatedAt(*t)
+	}
+	return svcc
+}
+
+// SetType sets the "type" field.
+func (svcc *SmsVerifyCodeCreate) SetType(s smsverifycode.Type) *SmsVerifyCodeCreate {
+	svcc.mutation.SetType(s)
+	return svcc
+}
+
+// SetPhone sets the "phone" field.
+func (svcc *SmsVerifyCodeCreate) SetPhone(s string) *SmsVerifyCodeCreate {
+	svcc.mutation.SetPhone(s)
+	return svcc
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (svcc *SmsVerifyCodeCreate) SetCodeHash(s string) *SmsVerifyCodeCreate {
+	svcc.mutation.SetCodeHash(s)
+	return svcc
+}
+
+// SetAttempts sets the "attempts" field.
+func (svcc *SmsVerifyCodeCreate) SetAttempts(i int) *SmsVerifyCodeCreate {
+	svcc.mutation.SetAttempts(i)
+	return svcc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (svcc *SmsVerifyCodeCreate) SetNillableAttempts(i *int) *SmsVerifyCodeCreate {
+	if i != nil {
+		svcc.SetAttempts(*i)
+	}
+	return svcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (svcc *SmsVerifyCodeCreate) SetExpiresAt(t time.Time) *SmsVerifyCodeCreate {
+	svcc.mutation.SetExpiresAt(t)
+	return svcc
+}
+
+// SetID sets the "id" field.
+func (svcc *SmsVerifyCodeCreate) SetID(u uuid.UUID) *SmsVerifyCodeCreate {
+	svcc.mutation.SetID(u)
+	return svcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (svcc *SmsVerifyCodeCreate) SetNillableID(u *uuid.UUID) *SmsVerifyCodeCreate {
+	if u != nil {
+		svcc.SetID(*u)
+	}
+	return svcc
+}
+
+// Mutation returns the SmsVerifyCodeMutation object of the builder.
+func (svcc *SmsVerifyCodeCreate) Mutation() *SmsVerifyCodeMutation {
+	return svcc.mutation
+}
+
+// Save creates the SmsVerifyCode in the database.
+func (svcc *SmsVerifyCodeCreate) Save(ctx context.Context) (*SmsVerifyCode, error) {
+	svcc.defaults()
+	return withHooks(ctx, svcc.sqlSave, svcc.mutation, svcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (svcc *SmsVerifyCodeCreate) SaveX(ctx context.Context) *SmsVerifyCode {
+	v, err := svcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (svcc *SmsVerifyCodeCreate) Exec(ctx context.Context) error {
+	_, err := svcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svcc *SmsVerifyCodeCreate) ExecX(ctx context.Context) {
+	if err := svcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (svcc *SmsVerifyCodeCreate) defaults() {
+	if _, ok := svcc.mutation.CreatedAt(); !ok {
+		v := smsverifycode.DefaultCreatedAt()
+		svcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := svcc.mutation.UpdatedAt(); !ok {
+		v := smsverifycode.DefaultUpdatedAt()
+		svcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := svcc.mutation.Attempts(); !ok {
+		v := smsverifycode.DefaultAttempts
+		svcc.mutation.SetAttempts(v)
+	}
+	if _, ok := svcc.mutation.ID(); !ok {
+		v := smsverifycode.DefaultID()
+		svcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (svcc *SmsVerifyCodeCreate) check() error {
+	if _, ok := svcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SmsVerifyCode.created_at"`)}
+	}
+	if _, ok := svcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SmsVerifyCode.updated_at"`)}
+	}
+	if _, ok := svcc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "SmsVerifyCode.type"`)}
+	}
+	if v, ok := svcc.mutation.GetType(); ok {
+		if err := smsverifycode.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SmsVerifyCode.type": %w`, err)}
+		}
+	}
+	if _, ok := svcc.mutation.Phone(); !ok {
+		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "SmsVerifyCode.phone"`)}
+	}
+	if v, ok := svcc.mutation.Phone(); ok {
+		if err := smsverifycode.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "SmsVerifyCode.phone": %w`, err)}
+		}
+	}
+	if _, ok := svcc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "SmsVerifyCode.code_hash"`)}
+	}
+	if v, ok := svcc.mutation.CodeHash(); ok {
+		if err := smsverifycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "SmsVerifyCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := svcc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "SmsVerifyCode.attempts"`)}
+	}
+	if _, ok := svcc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "SmsVerifyCode.expires_at"`)}
+	}
+	return nil
+}
+
+func (svcc *SmsVerifyCodeCreate) sqlSave(ctx context.Context) (*SmsVerifyCode, error) {
+	if err := svcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := svcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, svcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	svcc.mutation.id = &_node.ID
+	svcc.mutation.done = true
+	return _node, nil
+}
+
+func (svcc *SmsVerifyCodeCreate) createSpec() (*SmsVerifyCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SmsVerifyCode{config: svcc.config}
+		_spec = sqlgraph.NewCreateSpec(smsverifycode.Table, sqlgraph.NewFieldSpec(smsverifycode.FieldID, field.TypeUUID))
+	)
+	if id, ok := svcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := svcc.mutation.CreatedAt(); ok {
+		_spec.SetField(smsverifycode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := svcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(smsverifycode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := svcc.mutation.GetType(); ok {
+		_spec.SetField(smsverifycode.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := svcc.mutation.Phone(); ok {
+		_spec.SetField(smsverifycode.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := svcc.mutation.CodeHash(); ok {
+		_spec.SetField(smsverifycode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := svcc.mutation.Attempts(); ok {
+		_spec.SetField(smsverifycode.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := svcc.mutation.ExpiresAt(); ok {
+		_spec.SetField(smsverifycode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// SmsVerifyCodeCreateBulk is the builder for creating many SmsVerifyCode entities in bulk.
+type SmsVerifyCodeCreateBulk struct {
+	config
+	err      error
+	builders []*SmsVerifyCodeCreate
+}
+
+// Save creates the SmsVerifyCode entities in the database.
+func (svccb *SmsVerifyCodeCreateBulk) Save(ctx context.Context) ([]*SmsVerifyCode, error) {
+	if svccb.err != nil {
+		return nil, svccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(svccb.builders))
+	nodes := make([]*SmsVerifyCode, len(svccb.builders))
+	mutators := make([]Mutator, len(svccb.builders))
+	for i := range svccb.builders {
+		func(i int, root context.Context) {
+			builder := svccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SmsVerifyCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, svccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, svccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, svccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (svccb *SmsVerifyCodeCreateBulk) SaveX(ctx context.Context) []*SmsVerifyCode {
+	v, err := svccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (svccb *SmsVerifyCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := svccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svccb *SmsVerifyCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := svccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/smsverifycode"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SmsVerifyCodeDelete is the builder for deleting a SmsVerifyCode entity.
+type SmsVerifyCodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *SmsVerifyCodeMutation
+}
+
+// Where appends a list predicates to the SmsVerifyCodeDelete builder.
+func (svcd *SmsVerifyCodeDelete) Where(ps ...predicate.SmsVerifyCode) *SmsVerifyCodeDelete {
+	svcd.mutation.Where(ps...)
+	return svcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (svcd *SmsVerifyCodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, svcd.sqlExec, svcd.mutation, svcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svcd *SmsVerifyCodeDelete) ExecX(ctx context.Context) int {
+	n, err := svcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (svcd *SmsVerifyCodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(smsverifycode.Table, sqlgraph.NewFieldSpec(smsverifycode.FieldID, field.TypeUUID))
+	if ps := svcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, svcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	svcd.mutation.done = true
+	return affected, err
+}
+
+// SmsVerifyCodeDeleteOne is the builder for deleting a single SmsVerifyCode entity.
+type SmsVerifyCodeDeleteOne struct {
+	svcd *SmsVerifyCodeDelete
+}
+
+// Where appends a list predicates to the SmsVerifyCodeDelete builder.
+func (svcdo *SmsVerifyCodeDeleteOne) Where(ps ...predicate.SmsVerifyCode) *SmsVerifyCodeDeleteOne {
+	svcdo.svcd.mutation.Where(ps...)
+	return svcdo
+}
+
+// Exec executes the deletion query.
+func (svcdo *SmsVerifyCodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := svcdo.svcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{smsverifycode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svcdo *SmsVerifyCodeDeleteOne) ExecX(ctx context.Context) {
+	if err := svcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
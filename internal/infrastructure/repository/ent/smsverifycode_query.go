@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/smsverifycode"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SmsVerifyCodeQuery is the builder for querying SmsVerifyCode entities.
+type SmsVerifyCodeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []smsverifycode.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SmsVerifyCode
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SmsVerifyCodeQuery builder.
+func (svcq *SmsVerifyCodeQuery) Where(ps ...predicate.SmsVerifyCode) *SmsVerifyCodeQuery {
+	svcq.predicates = append(svcq.predicates, ps...)
+	return svcq
+}
+
+// Limit the number of records to be returned by this query.
+func (svcq *SmsVerifyCodeQuery) Limit(limit int) *SmsVerifyCodeQuery {
+	svcq.ctx.Limit = &limit
+	return svcq
+}
+
+// Offset to start from.
+func (svcq *SmsVerifyCodeQuery) Offset(offset int) *SmsVerifyCodeQuery {
+	svcq.ctx.Offset = &offset
+	return svcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (svcq *SmsVerifyCodeQuery) Unique(unique bool) *SmsVerifyCodeQuery {
+	svcq.ctx.Unique = &unique
+	return svcq
+}
+
+// Order specifies how the records should be ordered.
+func (svcq *SmsVerifyCodeQuery) Order(o ...smsverifycode.OrderOption) *SmsVerifyCodeQuery {
+	svcq.order = append(svcq.order, o...)
+	return svcq
+}
+
+// First returns the first SmsVerifyCode entity from the query.
+// Returns a *NotFoundError when no SmsVerifyCode was found.
+func (svcq *SmsVerifyCodeQuery) First(ctx context.Context) (*SmsVerifyCode, error) {
+	nodes, err := svcq.Limit(1).All(setContextOp(ctx, svcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{smsverifycode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (svcq *SmsVerifyCodeQuery) FirstX(ctx context.Context) *SmsVerifyCode {
+	node, err := svcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SmsVerifyCode ID from the query.
+// Returns a *NotFoundError when no SmsVerifyCode ID was found.
+func (svcq *SmsVerifyCodeQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = svcq.Limit(1).IDs(setContextOp(ctx, svcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{smsverifycode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (svcq *SmsVerifyCodeQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := svcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SmsVerifyCode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SmsVerifyCode entity is found.
+// Returns a *NotFoundError when no SmsVerifyCode entities are found.
+func (svcq *SmsVerifyCodeQuery) Only(ctx context.Context) (*SmsVerifyCode, error) {
+	nodes, err := svcq.Limit(2).All(setContextOp(ctx, svcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{smsverifycode.Label}
+	default:
+		return nil, &NotSingularError{smsverifycode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (svcq *SmsVerifyCodeQuery) OnlyX(ctx context.Context) *SmsVerifyCode {
+	node, err := svcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SmsVerifyCode ID in the query.
+// Returns a *NotSingularError when more than one SmsVerifyCode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (svcq *SmsVerifyCodeQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = svcq.Limit(2).IDs(setContextOp(ctx, svcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{smsverifycode.Label}
+	default:
+		err = &NotSingularError{smsverifycode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (svcq *SmsVerifyCodeQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := svcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SmsVerifyCodes.
+func (svcq *SmsVerifyCodeQuery) All(ctx context.Context) ([]*SmsVerifyCode, error) {
+	ctx = setContextOp(ctx, svcq.ctx, ent.OpQueryAll)
+	if err := svcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SmsVerifyCode, *SmsVerifyCodeQuery]()
+	return withInterceptors[[]*SmsVerifyCode](ctx, svcq, qr, svcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (svcq *SmsVerifyCodeQuery) AllX(ctx context.Context) []*SmsVerifyCode {
+	nodes, err := svcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SmsVerifyCode IDs.
+func (svcq *SmsVerifyCodeQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if svcq.ctx.Unique == nil && svcq.path != nil {
+		svcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, svcq.ctx, ent.OpQueryIDs)
+	if err = svcq.Select(smsverifycode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (svcq *SmsVerifyCodeQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := svcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (svcq *SmsVerifyCodeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, svcq.ctx, ent.OpQueryCount)
+	if err := svcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, svcq, querierCount[*SmsVerifyCodeQuery](), svcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (svcq *SmsVerifyCodeQuery) CountX(ctx context.Context) int {
+	count, err := svcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (svcq *SmsVerifyCodeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, svcq.ctx, ent.OpQueryExist)
+	switch _, err := svcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (svcq *SmsVerifyCodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := svcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SmsVerifyCodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (svcq *SmsVerifyCodeQuery) Clone() *SmsVerifyCodeQuery {
+	if svcq == nil {
+		return nil
+	}
+	return &SmsVerifyCodeQuery{
+		config:     svcq.config,
+		ctx:        svcq.ctx.Clone(),
+		order:      append([]smsverifycode.OrderOption{}, svcq.order...),
+		inters:     append([]Interceptor{}, svcq.inters...),
+		predicates: append([]predicate.SmsVerifyCode{}, svcq.predicates...),
+		// clone intermediate query.
+		sql:  svcq.sql.Clone(),
+		path: svcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SmsVerifyCode.Query().
+//		GroupBy(smsverifycode.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (svcq *SmsVerifyCodeQuery) GroupBy(field string, fields ...string) *SmsVerifyCodeGroupBy {
+	svcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SmsVerifyCodeGroupBy{build: svcq}
+	grbuild.flds = &svcq.ctx.Fields
+	grbuild.label = smsverifycode.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SmsVerifyCode.Query().
+//		Select(smsverifycode.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (svcq *SmsVerifyCodeQuery) Select(fields ...string) *SmsVerifyCodeSelect {
+	svcq.ctx.Fields = append(svcq.ctx.Fields, fields...)
+	sbuild := &SmsVerifyCodeSelect{SmsVerifyCodeQuery: svcq}
+	sbuild.label = smsverifycode.Label
+	sbuild.flds, sbuild.scan = &svcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SmsVerifyCodeSelect configured with the given aggregations.
+func (svcq *SmsVerifyCodeQuery) Aggregate(fns ...AggregateFunc) *SmsVerifyCodeSelect {
+	return svcq.Select().Aggregate(fns...)
+}
+
+func (svcq *SmsVerifyCodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range svcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, svcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range svcq.ctx.Fields {
+		if !smsverifycode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if svcq.path != nil {
+		prev, err := svcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		svcq.sql = prev
+	}
+	return nil
+}
+
+func (svcq *SmsVerifyCodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SmsVerifyCode, error) {
+	var (
+		nodes = []*SmsVerifyCode{}
+		_spec = svcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SmsVerifyCode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SmsVerifyCode{config: svcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(svcq.modifiers) > 0 {
+		_spec.Modifiers = svcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, svcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (svcq *SmsVerifyCodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := svcq.querySpec()
+	if len(svcq.modifiers) > 0 {
+		_spec.Modifiers = svcq.modifiers
+	}
+	_spec.Node.Columns = svcq.ctx.Fields
+	if len(svcq.ctx.Fields) > 0 {
+		_spec.Unique = svcq.ctx.Unique != nil && *svcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, svcq.driver, _spec)
+}
+
+func (svcq *SmsVerifyCodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(smsverifycode.Table, smsverifycode.Columns, sqlgraph.NewFieldSpec(smsverifycode.FieldID, field.TypeUUID))
+	_spec.From = svcq.sql
+	if unique := svcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if svcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := svcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, smsverifycode.FieldID)
+		for i := range fields {
+			if fields[i] != smsverifycode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := svcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := svcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := svcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := svcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (svcq *SmsVerifyCodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(svcq.driver.Dialect())
+	t1 := builder.Table(smsverifycode.Table)
+	columns := svcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = smsverifycode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if svcq.sql != nil {
+		selector = svcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if svcq.ctx.Unique != nil && *svcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range svcq.modifiers {
+		m(selector)
+	}
+	for _, p := range svcq.predicates {
+		p(selector)
+	}
+	for _, p := range svcq.order {
+		p(selector)
+	}
+	if offset := svcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := svcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (svcq *SmsVerifyCodeQuery) ForUpdate(opts ...sql.LockOption) *SmsVerifyCodeQuery {
+	if svcq.driver.Dialect() == dialect.Postgres {
+		svcq.Unique(false)
+	}
+	svcq.modifiers = append(svcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return svcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (svcq *SmsVerifyCodeQuery) ForShare(opts ...sql.LockOption) *SmsVerifyCodeQuery {
+	if svcq.driver.Dialect() == dialect.Postgres {
+		svcq.Unique(false)
+	}
+	svcq.modifiers = append(svcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return svcq
+}
+
+// SmsVerifyCodeGroupBy is the group-by builder for SmsVerifyCode entities.
+type SmsVerifyCodeGroupBy struct {
+	selector
+	build *SmsVerifyCodeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (svcgb *SmsVerifyCodeGroupBy) Aggregate(fns ...AggregateFunc) *SmsVerifyCodeGroupBy {
+	svcgb.fns = append(svcgb.fns, fns...)
+	return svcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (svcgb *SmsVerifyCodeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, svcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := svcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SmsVerifyCodeQuery, *SmsVerifyCodeGroupBy](ctx, svcgb.build, svcgb, svcgb.build.inters, v)
+}
+
+func (svcgb *SmsVerifyCodeGroupBy) sqlScan(ctx context.Context, root *SmsVerifyCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(svcgb.fns))
+	for _, fn := range svcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*svcgb.flds)+len(svcgb.fns))
+		for _, f := range *svcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*svcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := svcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SmsVerifyCodeSelect is the builder for selecting fields of SmsVerifyCode entities.
+type SmsVerifyCodeSelect struct {
+	*SmsVerifyCodeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (svcs *SmsVerifyCodeSelect) Aggregate(fns ...AggregateFunc) *SmsVerifyCodeSelect {
+	svcs.fns = append(svcs.fns, fns...)
+	return svcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (svcs *SmsVerifyCodeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, svcs.ctx, ent.OpQuerySelect)
+	if err := svcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SmsVerifyCodeQuery, *SmsVerifyCodeSelect](ctx, svcs.SmsVerifyCodeQuery, svcs, svcs.inters, v)
+}
+
+func (svcs *SmsVerifyCodeSelect) sqlScan(ctx context.Context, root *SmsVerifyCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(svcs.fns))
+	for _, fn := range svcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*svcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := svcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,482 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/smsverifycode"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SmsVerifyCodeUpdate is the builder for updating SmsVerifyCode entities.
+type SmsVerifyCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SmsVerifyCodeMutation
+}
+
+// Where appends a list predicates to the SmsVerifyCodeUpdate builder.
+func (svcu *SmsVerifyCodeUpdate) Where(ps ...predicate.SmsVerifyCode) *SmsVerifyCodeUpdate {
+	svcu.mutation.Where(ps...)
+	return svcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (svcu *SmsVerifyCodeUpdate) SetCreatedAt(t time.Time) *SmsVerifyCodeUpdate {
+	svcu.mutation.SetCreatedAt(t)
+	return svcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (svcu *SmsVerifyCodeUpdate) SetNillableCreatedAt(t *time.Time) *SmsVerifyCodeUpdate {
+	if t != nil {
+		svcu.SetCreatedAt(*t)
+	}
+	return svcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (svcu *SmsVerifyCodeUpdate) SetUpdatedAt(t time.Time) *SmsVerifyCodeUpdate {
+	svcu.mutation.SetUpdatedAt(t)
+	return svcu
+}
+
+// SetType sets the "type" field.
+func (svcu *SmsVerifyCodeUpdate) SetType(s smsverifycode.Type) *SmsVerifyCodeUpdate {
+	svcu.mutation.SetType(s)
+	return svcu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (svcu *SmsVerifyCodeUpdate) SetNillableType(s *smsverifycode.Type) *SmsVerifyCodeUpdate {
+	if s != nil {
+		svcu.SetType(*s)
+	}
+	return svcu
+}
+
+// SetPhone sets the "phone" field.
+func (svcu *SmsVerifyCodeUpdate) SetPhone(s string) *SmsVerifyCodeUpdate {
+	svcu.mutation.SetPhone(s)
+	return svcu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (svcu *SmsVerifyCodeUpdate) SetNillablePhone(s *string) *SmsVerifyCodeUpdate {
+	if s != nil {
+		svcu.SetPhone(*s)
+	}
+	return svcu
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (svcu *SmsVerifyCodeUpdate) SetCodeHash(s string) *SmsVerifyCodeUpdate {
+	svcu.mutation.SetCodeHash(s)
+	return svcu
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (svcu *SmsVerifyCodeUpdate) SetNillableCodeHash(s *string) *SmsVerifyCodeUpdate {
+	if s != nil {
+		svcu.SetCodeHash(*s)
+	}
+	return svcu
+}
+
+// SetAttempts sets the "attempts" field.
+func (svcu *SmsVerifyCodeUpdate) SetAttempts(i int) *SmsVerifyCodeUpdate {
+	svcu.mutation.ResetAttempts()
+	svcu.mutation.SetAttempts(i)
+	return svcu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (svcu *SmsVerifyCodeUpdate) SetNillableAttempts(i *int) *SmsVerifyCodeUpdate {
+	if i != nil {
+		svcu.SetAttempts(*i)
+	}
+	return svcu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (svcu *SmsVerifyCodeUpdate) AddAttempts(i int) *SmsVerifyCodeUpdate {
+	svcu.mutation.AddAttempts(i)
+	return svcu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (svcu *SmsVerifyCodeUpdate) SetExpiresAt(t time.Time) *SmsVerifyCodeUpdate {
+	svcu.mutation.SetExpiresAt(t)
+	return svcu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (svcu *SmsVerifyCodeUpdate) SetNillableExpiresAt(t *time.Time) *SmsVerifyCodeUpdate {
+	if t != nil {
+		svcu.SetExpiresAt(*t)
+	}
+	return svcu
+}
+
+// Mutation returns the SmsVerifyCodeMutation object of the builder.
+func (svcu *SmsVerifyCodeUpdate) Mutation() *SmsVerifyCodeMutation {
+	return svcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (svcu *SmsVerifyCodeUpdate) Save(ctx context.Context) (int, error) {
+	svcu.defaults()
+	return withHooks(ctx, svcu.sqlSave, svcu.mutation, svcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (svcu *SmsVerifyCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := svcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (svcu *SmsVerifyCodeUpdate) Exec(ctx context.Context) error {
+	_, err := svcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svcu *SmsVerifyCodeUpdate) ExecX(ctx context.Context) {
+	if err := svcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (svcu *SmsVerifyCodeUpdate) defaults() {
+	if _, ok := svcu.mutation.UpdatedAt(); !ok {
+		v := smsverifycode.UpdateDefaultUpdatedAt()
+		svcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (svcu *SmsVerifyCodeUpdate) check() error {
+	if v, ok := svcu.mutation.GetType(); ok {
+		if err := smsverifycode.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SmsVerifyCode.type": %w`, err)}
+		}
+	}
+	if v, ok := svcu.mutation.Phone(); ok {
+		if err := smsverifycode.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "SmsVerifyCode.phone": %w`, err)}
+		}
+	}
+	if v, ok := svcu.mutation.CodeHash(); ok {
+		if err := smsverifycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "SmsVerifyCode.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (svcu *SmsVerifyCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := svcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(smsverifycode.Table, smsverifycode.Columns, sqlgraph.NewFieldSpec(smsverifycode.FieldID, field.TypeUUID))
+	if ps := svcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := svcu.mutation.CreatedAt(); ok {
+		_spec.SetField(smsverifycode.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := svcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(smsverifycode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := svcu.mutation.GetType(); ok {
+		_spec.SetField(smsverifycode.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := svcu.mutation.Phone(); ok {
+		_spec.SetField(smsverifycode.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := svcu.mutation.CodeHash(); ok {
+		_spec.SetField(smsverifycode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := svcu.mutation.Attempts(); ok {
+		_spec.SetField(smsverifycode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := svcu.mutation.AddedAttempts(); ok {
+		_spec.AddField(smsverifycode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := svcu.mutation.ExpiresAt(); ok {
+		_spec.SetField(smsverifycode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, svcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{smsverifycode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	svcu.mutation.done = true
+	return n, nil
+}
+
+// SmsVerifyCodeUpdateOne is the builder for updating a single SmsVerifyCode entity.
+type SmsVerifyCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SmsVerifyCodeMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (svcuo *SmsVerifyCodeUpdateOne) SetCreatedAt(t time.Time) *SmsVerifyCodeUpdateOne {
+	svcuo.mutation.SetCreatedAt(t)
+	return svcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (svcuo *SmsVerifyCodeUpdateOne) SetNillableCreatedAt(t *time.Time) *SmsVerifyCodeUpdateOne {
+	if t != nil {
+		svcuo.SetCreatedAt(*t)
+	}
+	return svcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (svcuo *SmsVerifyCodeUpdateOne) SetUpdatedAt(t time.Time) *SmsVerifyCodeUpdateOne {
+	svcuo.mutation.SetUpdatedAt(t)
+	return svcuo
+}
+
+// SetType sets the "type" field.
+func (svcuo *SmsVerifyCodeUpdateOne) SetType(s smsverifycode.Type) *SmsVerifyCodeUpdateOne {
+	svcuo.mutation.SetType(s)
+	return svcuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (svcuo *SmsVerifyCodeUpdateOne) SetNillableType(s *smsverifycode.Type) *SmsVerifyCodeUpdateOne {
+	if s != nil {
+		svcuo.SetType(*s)
+	}
+	return svcuo
+}
+
+// SetPhone sets the "phone" field.
+func (svcuo *SmsVerifyCodeUpdateOne) SetPhone(s string) *SmsVerifyCodeUpdateOne {
+	svcuo.mutation.SetPhone(s)
+	return svcuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (svcuo *SmsVerifyCodeUpdateOne) SetNillablePhone(s *string) *SmsVerifyCodeUpdateOne {
+	if s != nil {
+		svcuo.SetPhone(*s)
+	}
+	return svcuo
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (svcuo *SmsVerifyCodeUpdateOne) SetCodeHash(s string) *SmsVerifyCodeUpdateOne {
+	svcuo.mutation.SetCodeHash(s)
+	return svcuo
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (svcuo *SmsVerifyCodeUpdateOne) SetNillableCodeHash(s *string) *SmsVerifyCodeUpdateOne {
+	if s != nil {
+		svcuo.SetCodeHash(*s)
+	}
+	return svcuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (svcuo *SmsVerifyCodeUpdateOne) SetAttempts(i int) *SmsVerifyCodeUpdateOne {
+	svcuo.mutation.ResetAttempts()
+	svcuo.mutation.SetAttempts(i)
+	return svcuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (svcuo *SmsVerifyCodeUpdateOne) SetNillableAttempts(i *int) *SmsVerifyCodeUpdateOne {
+	if i != nil {
+		svcuo.SetAttempts(*i)
+	}
+	return svcuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (svcuo *SmsVerifyCodeUpdateOne) AddAttempts(i int) *SmsVerifyCodeUpdateOne {
+	svcuo.mutation.AddAttempts(i)
+	return svcuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (svcuo *SmsVerifyCodeUpdateOne) SetExpiresAt(t time.Time) *SmsVerifyCodeUpdateOne {
+	svcuo.mutation.SetExpiresAt(t)
+	return svcuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (svcuo *SmsVerifyCodeUpdateOne) SetNillableExpiresAt(t *time.Time) *SmsVerifyCodeUpdateOne {
+	if t != nil {
+		svcuo.SetExpiresAt(*t)
+	}
+	return svcuo
+}
+
+// Mutation returns the SmsVerifyCodeMutation object of the builder.
+func (svcuo *SmsVerifyCodeUpdateOne) Mutation() *SmsVerifyCodeMutation {
+	return svcuo.mutation
+}
+
+// Where appends a list predicates to the SmsVerifyCodeUpdate builder.
+func (svcuo *SmsVerifyCodeUpdateOne) Where(ps ...predicate.SmsVerifyCode) *SmsVerifyCodeUpdateOne {
+	svcuo.mutation.Where(ps...)
+	return svcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (svcuo *SmsVerifyCodeUpdateOne) Select(field string, fields ...string) *SmsVerifyCodeUpdateOne {
+	svcuo.fields = append([]string{field}, fields...)
+	return svcuo
+}
+
+// Save executes the query and returns the updated SmsVerifyCode entity.
+func (svcuo *SmsVerifyCodeUpdateOne) Save(ctx context.Context) (*SmsVerifyCode, error) {
+	svcuo.defaults()
+	return withHooks(ctx, svcuo.sqlSave, svcuo.mutation, svcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (svcuo *SmsVerifyCodeUpdateOne) SaveX(ctx context.Context) *SmsVerifyCode {
+	node, err := svcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (svcuo *SmsVerifyCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := svcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svcuo *SmsVerifyCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := svcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (svcuo *SmsVerifyCodeUpdateOne) defaults() {
+	if _, ok := svcuo.mutation.UpdatedAt(); !ok {
+		v := smsverifycode.UpdateDefaultUpdatedAt()
+		svcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (svcuo *SmsVerifyCodeUpdateOne) check() error {
+	if v, ok := svcuo.mutation.GetType(); ok {
+		if err := smsverifycode.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "SmsVerifyCode.type": %w`, err)}
+		}
+	}
+	if v, ok := svcuo.mutation.Phone(); ok {
+		if err := smsverifycode.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "SmsVerifyCode.phone": %w`, err)}
+		}
+	}
+	if v, ok := svcuo.mutation.CodeHash(); ok {
+		if err := smsverifycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "SmsVerifyCode.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (svcuo *SmsVerifyCodeUpdateOne) sqlSave(ctx context.Context) (_node *SmsVerifyCode, err error) {
+	if err := svcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(smsverifycode.Table, smsverifycode.Columns, sqlgraph.NewFieldSpec(smsverifycode.FieldID, field.TypeUUID))
+	id, ok := svcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SmsVerifyCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := svcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, smsverifycode.FieldID)
+		for _, f := range fields {
+			if !smsverifycode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != smsverifycode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := svcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := svcuo.mutation.CreatedAt(); ok {
+		_spec.SetField(smsverifycode.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := svcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(smsverifycode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := svcuo.mutation.GetType(); ok {
+		_spec.SetField(smsverifycode.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := svcuo.mutation.Phone(); ok {
+		_spec.SetField(smsverifycode.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := svcuo.mutation.CodeHash(); ok {
+		_spec.SetField(smsverifycode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := svcuo.mutation.Attempts(); ok {
+		_spec.SetField(smsverifycode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := svcuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(smsverifycode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := svcuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(smsverifycode.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &SmsVerifyCode{config: svcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, svcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{smsverifycode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	svcuo.mutation.done = true
+	return _node, nil
+}
@@ -22,8 +22,18 @@ type Tx struct {
 	BindingVerify *BindingVerifyClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// IdentityProvider is the client for interacting with the IdentityProvider builders.
+	IdentityProvider *IdentityProviderClient
+	// LoginLock is the client for interacting with the LoginLock builders.
+	LoginLock *LoginLockClient
+	// MagicLink is the client for interacting with the MagicLink builders.
+	MagicLink *MagicLinkClient
 	// MailVertifyCode is the client for interacting with the MailVertifyCode builders.
 	MailVertifyCode *MailVertifyCodeClient
+	// OAuthAuthorizationCode is the client for interacting with the OAuthAuthorizationCode builders.
+	OAuthAuthorizationCode *OAuthAuthorizationCodeClient
+	// OAuthConsent is the client for interacting with the OAuthConsent builders.
+	OAuthConsent *OAuthConsentClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
 	// OrganizationApplication is the client for interacting with the OrganizationApplication builders.
@@ -32,20 +42,36 @@ type Tx struct {
 	OrganizationRequest *OrganizationRequestClient
 	// OrganizationUser is the client for interacting with the OrganizationUser builders.
 	OrganizationUser *OrganizationUserClient
+	// PasskeyCredential is the client for interacting with the PasskeyCredential builders.
+	PasskeyCredential *PasskeyCredentialClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
+	// QRLogin is the client for interacting with the QRLogin builders.
+	QRLogin *QRLoginClient
+	// QyWechatCorp is the client for interacting with the QyWechatCorp builders.
+	QyWechatCorp *QyWechatCorpClient
 	// QyWechatUserID is the client for interacting with the QyWechatUserID builders.
 	QyWechatUserID *QyWechatUserIDClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// RotatedRefreshToken is the client for interacting with the RotatedRefreshToken builders.
+	RotatedRefreshToken *RotatedRefreshTokenClient
+	// SAMLConnection is the client for interacting with the SAMLConnection builders.
+	SAMLConnection *SAMLConnectionClient
 	// Scope is the client for interacting with the Scope builders.
 	Scope *ScopeClient
+	// SmsVerifyCode is the client for interacting with the SmsVerifyCode builders.
+	SmsVerifyCode *SmsVerifyCodeClient
 	// StripeEvent is the client for interacting with the StripeEvent builders.
 	StripeEvent *StripeEventClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WebAuthnChallenge is the client for interacting with the WebAuthnChallenge builders.
+	WebAuthnChallenge *WebAuthnChallengeClient
 	// WechatOpenID is the client for interacting with the WechatOpenID builders.
 	WechatOpenID *WechatOpenIDClient
+	// WechatScanLogin is the client for interacting with the WechatScanLogin builders.
+	WechatScanLogin *WechatScanLoginClient
 
 	// lazily loaded.
 	client     *Client
@@ -181,18 +207,31 @@ func (tx *Tx) init() {
 	tx.Binding = NewBindingClient(tx.config)
 	tx.BindingVerify = NewBindingVerifyClient(tx.config)
 	tx.Device = NewDeviceClient(tx.config)
+	tx.IdentityProvider = NewIdentityProviderClient(tx.config)
+	tx.LoginLock = NewLoginLockClient(tx.config)
+	tx.MagicLink = NewMagicLinkClient(tx.config)
 	tx.MailVertifyCode = NewMailVertifyCodeClient(tx.config)
+	tx.OAuthAuthorizationCode = NewOAuthAuthorizationCodeClient(tx.config)
+	tx.OAuthConsent = NewOAuthConsentClient(tx.config)
 	tx.Organization = NewOrganizationClient(tx.config)
 	tx.OrganizationApplication = NewOrganizationApplicationClient(tx.config)
 	tx.OrganizationRequest = NewOrganizationRequestClient(tx.config)
 	tx.OrganizationUser = NewOrganizationUserClient(tx.config)
+	tx.PasskeyCredential = NewPasskeyCredentialClient(tx.config)
 	tx.Payment = NewPaymentClient(tx.config)
+	tx.QRLogin = NewQRLoginClient(tx.config)
+	tx.QyWechatCorp = NewQyWechatCorpClient(tx.config)
 	tx.QyWechatUserID = NewQyWechatUserIDClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.RotatedRefreshToken = NewRotatedRefreshTokenClient(tx.config)
+	tx.SAMLConnection = NewSAMLConnectionClient(tx.config)
 	tx.Scope = NewScopeClient(tx.config)
+	tx.SmsVerifyCode = NewSmsVerifyCodeClient(tx.config)
 	tx.StripeEvent = NewStripeEventClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WebAuthnChallenge = NewWebAuthnChallengeClient(tx.config)
 	tx.WechatOpenID = NewWechatOpenIDClient(tx.config)
+	tx.WechatScanLogin = NewWechatScanLoginClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -40,6 +40,8 @@ type User struct {
 	ReferralChannel entity.UserRefferalChannel `json:"referral_channel,omitempty"`
 	// 部门
 	Department string `json:"department,omitempty"`
+	// Banned holds the value of the "banned" field.
+	Banned bool `json:"banned,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges              UserEdges `json:"edges"`
@@ -61,13 +63,15 @@ type UserEdges struct {
 	QyWechatUserIds []*QyWechatUserID `json:"qy_wechat_user_ids,omitempty"`
 	// Payments holds the value of the payments edge.
 	Payments []*Payment `json:"payments,omitempty"`
+	// PasskeyCredentials holds the value of the passkey_credentials edge.
+	PasskeyCredentials []*PasskeyCredential `json:"passkey_credentials,omitempty"`
 	// Application holds the value of the application edge.
 	Application *Application `json:"application,omitempty"`
 	// OrganizationUsers holds the value of the organization_users edge.
 	OrganizationUsers []*OrganizationUser `json:"organization_users,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [9]bool
 }
 
 // BindingsOrErr returns the Bindings value or an error if the edge
@@ -126,12 +130,21 @@ func (e UserEdges) PaymentsOrErr() ([]*Payment, error) {
 	return nil, &NotLoadedError{edge: "payments"}
 }
 
+// PasskeyCredentialsOrErr returns the PasskeyCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasskeyCredentialsOrErr() ([]*PasskeyCredential, error) {
+	if e.loadedTypes[6] {
+		return e.PasskeyCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "passkey_credentials"}
+}
+
 // ApplicationOrErr returns the Application value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e UserEdges) ApplicationOrErr() (*Application, error) {
 	if e.Application != nil {
 		return e.Application, nil
-	} else if e.loadedTypes[6] {
+	} else if e.loadedTypes[7] {
 		return nil, &NotFoundError{label: application.Label}
 	}
 	return nil, &NotLoadedError{edge: "application"}
@@ -140,7 +153,7 @@ func (e UserEdges) ApplicationOrErr() (*Application, error) {
 // OrganizationUsersOrErr returns the OrganizationUsers value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) OrganizationUsersOrErr() ([]*OrganizationUser, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[8] {
 		return e.OrganizationUsers, nil
 	}
 	return nil, &NotLoadedError{edge: "organization_users"}
@@ -153,6 +166,8 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldReferralChannel:
 			values[i] = new([]byte)
+		case user.FieldBanned:
+			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldName, user.FieldDisplayName, user.FieldAvatar, user.FieldDepartment:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
@@ -238,6 +253,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Department = value.String
 			}
+		case user.FieldBanned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field banned", values[i])
+			} else if value.Valid {
+				u.Banned = value.Bool
+			}
 		case user.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_personal_role", values[i])
@@ -288,6 +309,11 @@ func (u *User) QueryPayments() *PaymentQuery {
 	return NewUserClient(u.config).QueryPayments(u)
 }
 
+// QueryPasskeyCredentials queries the "passkey_credentials" edge of the User entity.
+func (u *User) QueryPasskeyCredentials() *PasskeyCredentialQuery {
+	return NewUserClient(u.config).QueryPasskeyCredentials(u)
+}
+
 // QueryApplication queries the "application" edge of the User entity.
 func (u *User) QueryApplication() *ApplicationQuery {
 	return NewUserClient(u.config).QueryApplication(u)
@@ -347,6 +373,9 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("department=")
 	builder.WriteString(u.Department)
+	builder.WriteString(", ")
+	builder.WriteString("banned=")
+	builder.WriteString(fmt.Sprintf("%v", u.Banned))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,6 +32,8 @@ const (
 	FieldReferralChannel = "referral_channel"
 	// FieldDepartment holds the string denoting the department field in the database.
 	FieldDepartment = "department"
+	// FieldBanned holds the string denoting the banned field in the database.
+	FieldBanned = "banned"
 	// EdgeBindings holds the string denoting the bindings edge name in mutations.
 	EdgeBindings = "bindings"
 	// EdgeDevices holds the string denoting the devices edge name in mutations.
@@ -44,6 +46,8 @@ const (
 	EdgeQyWechatUserIds = "qy_wechat_user_ids"
 	// EdgePayments holds the string denoting the payments edge name in mutations.
 	EdgePayments = "payments"
+	// EdgePasskeyCredentials holds the string denoting the passkey_credentials edge name in mutations.
+	EdgePasskeyCredentials = "passkey_credentials"
 	// EdgeApplication holds the string denoting the application edge name in mutations.
 	EdgeApplication = "application"
 	// EdgeOrganizationUsers holds the string denoting the organization_users edge name in mutations.
@@ -92,6 +96,13 @@ const (
 	PaymentsInverseTable = "payments"
 	// PaymentsColumn is the table column denoting the payments relation/edge.
 	PaymentsColumn = "user_id"
+	// PasskeyCredentialsTable is the table that holds the passkey_credentials relation/edge.
+	PasskeyCredentialsTable = "passkey_credentials"
+	// PasskeyCredentialsInverseTable is the table name for the PasskeyCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "passkeycredential" package.
+	PasskeyCredentialsInverseTable = "passkey_credentials"
+	// PasskeyCredentialsColumn is the table column denoting the passkey_credentials relation/edge.
+	PasskeyCredentialsColumn = "user_id"
 	// ApplicationTable is the table that holds the application relation/edge.
 	ApplicationTable = "users"
 	// ApplicationInverseTable is the table name for the Application entity.
@@ -120,6 +131,7 @@ var Columns = []string{
 	FieldAvatar,
 	FieldReferralChannel,
 	FieldDepartment,
+	FieldBanned,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "users"
@@ -154,6 +166,8 @@ var (
 	NameValidator func(string) error
 	// DefaultDepartment holds the default value on creation for the "department" field.
 	DefaultDepartment string
+	// DefaultBanned holds the default value on creation for the "banned" field.
+	DefaultBanned bool
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -204,6 +218,11 @@ func ByDepartment(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDepartment, opts...).ToFunc()
 }
 
+// ByBanned orders the results by the banned field.
+func ByBanned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBanned, opts...).ToFunc()
+}
+
 // ByBindingsCount orders the results by bindings count.
 func ByBindingsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -281,6 +300,20 @@ func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByPasskeyCredentialsCount orders the results by passkey_credentials count.
+func ByPasskeyCredentialsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPasskeyCredentialsStep(), opts...)
+	}
+}
+
+// ByPasskeyCredentials orders the results by passkey_credentials terms.
+func ByPasskeyCredentials(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPasskeyCredentialsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByApplicationField orders the results by application field.
 func ByApplicationField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -343,6 +376,13 @@ func newPaymentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
 	)
 }
+func newPasskeyCredentialsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PasskeyCredentialsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PasskeyCredentialsTable, PasskeyCredentialsColumn),
+	)
+}
 func newApplicationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -106,6 +106,11 @@ func Department(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldDepartment, v))
 }
 
+// Banned applies equality check predicate on the "banned" field. It's identical to BannedEQ.
+func Banned(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBanned, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -556,6 +561,16 @@ func DepartmentContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldDepartment, v))
 }
 
+// BannedEQ applies the EQ predicate on the "banned" field.
+func BannedEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBanned, v))
+}
+
+// BannedNEQ applies the NEQ predicate on the "banned" field.
+func BannedNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldBanned, v))
+}
+
 // HasBindings applies the HasEdge predicate on the "bindings" edge.
 func HasBindings() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -694,6 +709,29 @@ func HasPaymentsWith(preds ...predicate.Payment) predicate.User {
 	})
 }
 
+// HasPasskeyCredentials applies the HasEdge predicate on the "passkey_credentials" edge.
+func HasPasskeyCredentials() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PasskeyCredentialsTable, PasskeyCredentialsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPasskeyCredentialsWith applies the HasEdge predicate on the "passkey_credentials" edge with a given conditions (other predicates).
+func HasPasskeyCredentialsWith(preds ...predicate.PasskeyCredential) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPasskeyCredentialsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasApplication applies the HasEdge predicate on the "application" edge.
 func HasApplication() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
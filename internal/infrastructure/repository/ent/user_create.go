@@ -11,6 +11,7 @@ import (
 	"kiwi-user/internal/infrastructure/repository/ent/binding"
 	"kiwi-user/internal/infrastructure/repository/ent/device"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationuser"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
 	"kiwi-user/internal/infrastructure/repository/ent/payment"
 	"kiwi-user/internal/infrastructure/repository/ent/qywechatuserid"
 	"kiwi-user/internal/infrastructure/repository/ent/role"
@@ -140,6 +141,20 @@ func (uc *UserCreate) SetNillableDepartment(s *string) *UserCreate {
 	return uc
 }
 
+// SetBanned sets the "banned" field.
+func (uc *UserCreate) SetBanned(b bool) *UserCreate {
+	uc.mutation.SetBanned(b)
+	return uc
+}
+
+// SetNillableBanned sets the "banned" field if the given value is not nil.
+func (uc *UserCreate) SetNillableBanned(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetBanned(*b)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(s string) *UserCreate {
 	uc.mutation.SetID(s)
@@ -240,6 +255,21 @@ func (uc *UserCreate) AddPayments(p ...*Payment) *UserCreate {
 	return uc.AddPaymentIDs(ids...)
 }
 
+// AddPasskeyCredentialIDs adds the "passkey_credentials" edge to the PasskeyCredential entity by IDs.
+func (uc *UserCreate) AddPasskeyCredentialIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddPasskeyCredentialIDs(ids...)
+	return uc
+}
+
+// AddPasskeyCredentials adds the "passkey_credentials" edges to the PasskeyCredential entity.
+func (uc *UserCreate) AddPasskeyCredentials(p ...*PasskeyCredential) *UserCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPasskeyCredentialIDs(ids...)
+}
+
 // SetApplication sets the "application" edge to the Application entity.
 func (uc *UserCreate) SetApplication(a *Application) *UserCreate {
 	return uc.SetApplicationID(a.ID)
@@ -307,6 +337,10 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultDepartment
 		uc.mutation.SetDepartment(v)
 	}
+	if _, ok := uc.mutation.Banned(); !ok {
+		v := user.DefaultBanned
+		uc.mutation.SetBanned(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -328,6 +362,9 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.Banned(); !ok {
+		return &ValidationError{Name: "banned", err: errors.New(`ent: missing required field "User.banned"`)}
+	}
 	if len(uc.mutation.ApplicationIDs()) == 0 {
 		return &ValidationError{Name: "application", err: errors.New(`ent: missing required edge "User.application"`)}
 	}
@@ -398,6 +435,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldDepartment, field.TypeString, value)
 		_node.Department = value
 	}
+	if value, ok := uc.mutation.Banned(); ok {
+		_spec.SetField(user.FieldBanned, field.TypeBool, value)
+		_node.Banned = value
+	}
 	if nodes := uc.mutation.BindingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -495,6 +536,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.PasskeyCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyCredentialsTable,
+			Columns: []string{user.PasskeyCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.ApplicationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -10,6 +10,7 @@ import (
 	"kiwi-user/internal/infrastructure/repository/ent/binding"
 	"kiwi-user/internal/infrastructure/repository/ent/device"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationuser"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
 	"kiwi-user/internal/infrastructure/repository/ent/payment"
 	"kiwi-user/internal/infrastructure/repository/ent/predicate"
 	"kiwi-user/internal/infrastructure/repository/ent/qywechatuserid"
@@ -29,20 +30,21 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx                   *QueryContext
-	order                 []user.OrderOption
-	inters                []Interceptor
-	predicates            []predicate.User
-	withBindings          *BindingQuery
-	withDevices           *DeviceQuery
-	withPersonalRole      *RoleQuery
-	withOpenIds           *WechatOpenIDQuery
-	withQyWechatUserIds   *QyWechatUserIDQuery
-	withPayments          *PaymentQuery
-	withApplication       *ApplicationQuery
-	withOrganizationUsers *OrganizationUserQuery
-	withFKs               bool
-	modifiers             []func(*sql.Selector)
+	ctx                    *QueryContext
+	order                  []user.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.User
+	withBindings           *BindingQuery
+	withDevices            *DeviceQuery
+	withPersonalRole       *RoleQuery
+	withOpenIds            *WechatOpenIDQuery
+	withQyWechatUserIds    *QyWechatUserIDQuery
+	withPayments           *PaymentQuery
+	withPasskeyCredentials *PasskeyCredentialQuery
+	withApplication        *ApplicationQuery
+	withOrganizationUsers  *OrganizationUserQuery
+	withFKs                bool
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -211,6 +213,28 @@ func (uq *UserQuery) QueryPayments() *PaymentQuery {
 	return query
 }
 
+// QueryPasskeyCredentials chains the current query on the "passkey_credentials" edge.
+func (uq *UserQuery) QueryPasskeyCredentials() *PasskeyCredentialQuery {
+	query := (&PasskeyCredentialClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(passkeycredential.Table, passkeycredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PasskeyCredentialsTable, user.PasskeyCredentialsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryApplication chains the current query on the "application" edge.
 func (uq *UserQuery) QueryApplication() *ApplicationQuery {
 	query := (&ApplicationClient{config: uq.config}).Query()
@@ -442,19 +466,20 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:                uq.config,
-		ctx:                   uq.ctx.Clone(),
-		order:                 append([]user.OrderOption{}, uq.order...),
-		inters:                append([]Interceptor{}, uq.inters...),
-		predicates:            append([]predicate.User{}, uq.predicates...),
-		withBindings:          uq.withBindings.Clone(),
-		withDevices:           uq.withDevices.Clone(),
-		withPersonalRole:      uq.withPersonalRole.Clone(),
-		withOpenIds:           uq.withOpenIds.Clone(),
-		withQyWechatUserIds:   uq.withQyWechatUserIds.Clone(),
-		withPayments:          uq.withPayments.Clone(),
-		withApplication:       uq.withApplication.Clone(),
-		withOrganizationUsers: uq.withOrganizationUsers.Clone(),
+		config:                 uq.config,
+		ctx:                    uq.ctx.Clone(),
+		order:                  append([]user.OrderOption{}, uq.order...),
+		inters:                 append([]Interceptor{}, uq.inters...),
+		predicates:             append([]predicate.User{}, uq.predicates...),
+		withBindings:           uq.withBindings.Clone(),
+		withDevices:            uq.withDevices.Clone(),
+		withPersonalRole:       uq.withPersonalRole.Clone(),
+		withOpenIds:            uq.withOpenIds.Clone(),
+		withQyWechatUserIds:    uq.withQyWechatUserIds.Clone(),
+		withPayments:           uq.withPayments.Clone(),
+		withPasskeyCredentials: uq.withPasskeyCredentials.Clone(),
+		withApplication:        uq.withApplication.Clone(),
+		withOrganizationUsers:  uq.withOrganizationUsers.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -527,6 +552,17 @@ func (uq *UserQuery) WithPayments(opts ...func(*PaymentQuery)) *UserQuery {
 	return uq
 }
 
+// WithPasskeyCredentials tells the query-builder to eager-load the nodes that are connected to
+// the "passkey_credentials" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithPasskeyCredentials(opts ...func(*PasskeyCredentialQuery)) *UserQuery {
+	query := (&PasskeyCredentialClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withPasskeyCredentials = query
+	return uq
+}
+
 // WithApplication tells the query-builder to eager-load the nodes that are connected to
 // the "application" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithApplication(opts ...func(*ApplicationQuery)) *UserQuery {
@@ -628,13 +664,14 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		nodes       = []*User{}
 		withFKs     = uq.withFKs
 		_spec       = uq.querySpec()
-		loadedTypes = [8]bool{
+		loadedTypes = [9]bool{
 			uq.withBindings != nil,
 			uq.withDevices != nil,
 			uq.withPersonalRole != nil,
 			uq.withOpenIds != nil,
 			uq.withQyWechatUserIds != nil,
 			uq.withPayments != nil,
+			uq.withPasskeyCredentials != nil,
 			uq.withApplication != nil,
 			uq.withOrganizationUsers != nil,
 		}
@@ -707,6 +744,15 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withPasskeyCredentials; query != nil {
+		if err := uq.loadPasskeyCredentials(ctx, query, nodes,
+			func(n *User) { n.Edges.PasskeyCredentials = []*PasskeyCredential{} },
+			func(n *User, e *PasskeyCredential) {
+				n.Edges.PasskeyCredentials = append(n.Edges.PasskeyCredentials, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := uq.withApplication; query != nil {
 		if err := uq.loadApplication(ctx, query, nodes, nil,
 			func(n *User, e *Application) { n.Edges.Application = e }); err != nil {
@@ -905,6 +951,36 @@ func (uq *UserQuery) loadPayments(ctx context.Context, query *PaymentQuery, node
 	}
 	return nil
 }
+func (uq *UserQuery) loadPasskeyCredentials(ctx context.Context, query *PasskeyCredentialQuery, nodes []*User, init func(*User), assign func(*User, *PasskeyCredential)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(passkeycredential.FieldUserID)
+	}
+	query.Where(predicate.PasskeyCredential(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PasskeyCredentialsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (uq *UserQuery) loadApplication(ctx context.Context, query *ApplicationQuery, nodes []*User, init func(*User), assign func(*User, *Application)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*User)
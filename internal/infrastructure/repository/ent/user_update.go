@@ -11,6 +11,7 @@ import (
 	"kiwi-user/internal/infrastructure/repository/ent/binding"
 	"kiwi-user/internal/infrastructure/repository/ent/device"
 	"kiwi-user/internal/infrastructure/repository/ent/organizationuser"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
 	"kiwi-user/internal/infrastructure/repository/ent/payment"
 	"kiwi-user/internal/infrastructure/repository/ent/predicate"
 	"kiwi-user/internal/infrastructure/repository/ent/qywechatuserid"
@@ -172,6 +173,20 @@ func (uu *UserUpdate) ClearDepartment() *UserUpdate {
 	return uu
 }
 
+// SetBanned sets the "banned" field.
+func (uu *UserUpdate) SetBanned(b bool) *UserUpdate {
+	uu.mutation.SetBanned(b)
+	return uu
+}
+
+// SetNillableBanned sets the "banned" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableBanned(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetBanned(*b)
+	}
+	return uu
+}
+
 // AddBindingIDs adds the "bindings" edge to the Binding entity by IDs.
 func (uu *UserUpdate) AddBindingIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddBindingIDs(ids...)
@@ -266,6 +281,21 @@ func (uu *UserUpdate) AddPayments(p ...*Payment) *UserUpdate {
 	return uu.AddPaymentIDs(ids...)
 }
 
+// AddPasskeyCredentialIDs adds the "passkey_credentials" edge to the PasskeyCredential entity by IDs.
+func (uu *UserUpdate) AddPasskeyCredentialIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddPasskeyCredentialIDs(ids...)
+	return uu
+}
+
+// AddPasskeyCredentials adds the "passkey_credentials" edges to the PasskeyCredential entity.
+func (uu *UserUpdate) AddPasskeyCredentials(p ...*PasskeyCredential) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPasskeyCredentialIDs(ids...)
+}
+
 // SetApplication sets the "application" edge to the Application entity.
 func (uu *UserUpdate) SetApplication(a *Application) *UserUpdate {
 	return uu.SetApplicationID(a.ID)
@@ -402,6 +432,27 @@ func (uu *UserUpdate) RemovePayments(p ...*Payment) *UserUpdate {
 	return uu.RemovePaymentIDs(ids...)
 }
 
+// ClearPasskeyCredentials clears all "passkey_credentials" edges to the PasskeyCredential entity.
+func (uu *UserUpdate) ClearPasskeyCredentials() *UserUpdate {
+	uu.mutation.ClearPasskeyCredentials()
+	return uu
+}
+
+// RemovePasskeyCredentialIDs removes the "passkey_credentials" edge to PasskeyCredential entities by IDs.
+func (uu *UserUpdate) RemovePasskeyCredentialIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemovePasskeyCredentialIDs(ids...)
+	return uu
+}
+
+// RemovePasskeyCredentials removes "passkey_credentials" edges to PasskeyCredential entities.
+func (uu *UserUpdate) RemovePasskeyCredentials(p ...*PasskeyCredential) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemovePasskeyCredentialIDs(ids...)
+}
+
 // ClearApplication clears the "application" edge to the Application entity.
 func (uu *UserUpdate) ClearApplication() *UserUpdate {
 	uu.mutation.ClearApplication()
@@ -526,6 +577,9 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.DepartmentCleared() {
 		_spec.ClearField(user.FieldDepartment, field.TypeString)
 	}
+	if value, ok := uu.mutation.Banned(); ok {
+		_spec.SetField(user.FieldBanned, field.TypeBool, value)
+	}
 	if uu.mutation.BindingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -780,6 +834,51 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.PasskeyCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyCredentialsTable,
+			Columns: []string{user.PasskeyCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedPasskeyCredentialsIDs(); len(nodes) > 0 && !uu.mutation.PasskeyCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyCredentialsTable,
+			Columns: []string{user.PasskeyCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.PasskeyCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyCredentialsTable,
+			Columns: []string{user.PasskeyCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uu.mutation.ApplicationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1008,6 +1107,20 @@ func (uuo *UserUpdateOne) ClearDepartment() *UserUpdateOne {
 	return uuo
 }
 
+// SetBanned sets the "banned" field.
+func (uuo *UserUpdateOne) SetBanned(b bool) *UserUpdateOne {
+	uuo.mutation.SetBanned(b)
+	return uuo
+}
+
+// SetNillableBanned sets the "banned" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBanned(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetBanned(*b)
+	}
+	return uuo
+}
+
 // AddBindingIDs adds the "bindings" edge to the Binding entity by IDs.
 func (uuo *UserUpdateOne) AddBindingIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddBindingIDs(ids...)
@@ -1102,6 +1215,21 @@ func (uuo *UserUpdateOne) AddPayments(p ...*Payment) *UserUpdateOne {
 	return uuo.AddPaymentIDs(ids...)
 }
 
+// AddPasskeyCredentialIDs adds the "passkey_credentials" edge to the PasskeyCredential entity by IDs.
+func (uuo *UserUpdateOne) AddPasskeyCredentialIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddPasskeyCredentialIDs(ids...)
+	return uuo
+}
+
+// AddPasskeyCredentials adds the "passkey_credentials" edges to the PasskeyCredential entity.
+func (uuo *UserUpdateOne) AddPasskeyCredentials(p ...*PasskeyCredential) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPasskeyCredentialIDs(ids...)
+}
+
 // SetApplication sets the "application" edge to the Application entity.
 func (uuo *UserUpdateOne) SetApplication(a *Application) *UserUpdateOne {
 	return uuo.SetApplicationID(a.ID)
@@ -1238,6 +1366,27 @@ func (uuo *UserUpdateOne) RemovePayments(p ...*Payment) *UserUpdateOne {
 	return uuo.RemovePaymentIDs(ids...)
 }
 
+// ClearPasskeyCredentials clears all "passkey_credentials" edges to the PasskeyCredential entity.
+func (uuo *UserUpdateOne) ClearPasskeyCredentials() *UserUpdateOne {
+	uuo.mutation.ClearPasskeyCredentials()
+	return uuo
+}
+
+// RemovePasskeyCredentialIDs removes the "passkey_credentials" edge to PasskeyCredential entities by IDs.
+func (uuo *UserUpdateOne) RemovePasskeyCredentialIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemovePasskeyCredentialIDs(ids...)
+	return uuo
+}
+
+// RemovePasskeyCredentials removes "passkey_credentials" edges to PasskeyCredential entities.
+func (uuo *UserUpdateOne) RemovePasskeyCredentials(p ...*PasskeyCredential) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePasskeyCredentialIDs(ids...)
+}
+
 // ClearApplication clears the "application" edge to the Application entity.
 func (uuo *UserUpdateOne) ClearApplication() *UserUpdateOne {
 	uuo.mutation.ClearApplication()
@@ -1392,6 +1541,9 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.DepartmentCleared() {
 		_spec.ClearField(user.FieldDepartment, field.TypeString)
 	}
+	if value, ok := uuo.mutation.Banned(); ok {
+		_spec.SetField(user.FieldBanned, field.TypeBool, value)
+	}
 	if uuo.mutation.BindingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1646,6 +1798,51 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.PasskeyCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyCredentialsTable,
+			Columns: []string{user.PasskeyCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPasskeyCredentialsIDs(); len(nodes) > 0 && !uuo.mutation.PasskeyCredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyCredentialsTable,
+			Columns: []string{user.PasskeyCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PasskeyCredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyCredentialsTable,
+			Columns: []string{user.PasskeyCredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkeycredential.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.ApplicationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
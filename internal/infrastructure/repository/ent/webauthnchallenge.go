@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/webauthnchallenge"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// WebAuthnChallenge is the model entity for the WebAuthnChallenge schema.
+type WebAuthnChallenge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Type holds the value of the "type" field.
+	Type webauthnchallenge.Type `json:"type,omitempty"`
+	// Challenge holds the value of the "challenge" field.
+	Challenge string `json:"challenge,omitempty"`
+	// ApplicationID holds the value of the "application_id" field.
+	ApplicationID uuid.UUID `json:"application_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebAuthnChallenge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webauthnchallenge.FieldType, webauthnchallenge.FieldChallenge, webauthnchallenge.FieldUserID:
+			values[i] = new(sql.NullString)
+		case webauthnchallenge.FieldCreatedAt, webauthnchallenge.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case webauthnchallenge.FieldID, webauthnchallenge.FieldApplicationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebAuthnChallenge fields.
+func (wac *WebAuthnChallenge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webauthnchallenge.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wac.ID = *value
+			}
+		case webauthnchallenge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wac.CreatedAt = value.Time
+			}
+		case webauthnchallenge.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				wac.Type = webauthnchallenge.Type(value.String)
+			}
+		case webauthnchallenge.FieldChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field challenge", values[i])
+			} else if value.Valid {
+				wac.Challenge = value.String
+			}
+		case webauthnchallenge.FieldApplicationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field application_id", values[i])
+			} else if value != nil {
+				wac.ApplicationID = *value
+			}
+		case webauthnchallenge.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				wac.UserID = value.String
+			}
+		case webauthnchallenge.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				wac.ExpiresAt = value.Time
+			}
+		default:
+			wac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebAuthnChallenge.
+// This includes values selected through modifiers, order, etc.
+func (wac *WebAuthnChallenge) Value(name string) (ent.Value, error) {
+	return wac.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebAuthnChallenge.
+// Note that you need to call WebAuthnChallenge.Unwrap() before calling this method if this WebAuthnChallenge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wac *WebAuthnChallenge) Update() *WebAuthnChallengeUpdateOne {
+	return NewWebAuthnChallengeClient(wac.config).UpdateOne(wac)
+}
+
+// Unwrap unwraps the WebAuthnChallenge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wac *WebAuthnChallenge) Unwrap() *WebAuthnChallenge {
+	_tx, ok := wac.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebAuthnChallenge is not a transactional entity")
+	}
+	wac.config.driver = _tx.drv
+	return wac
+}
+
+// String implements the fmt.Stringer.
+func (wac *WebAuthnChallenge) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebAuthnChallenge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wac.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wac.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", wac.Type))
+	builder.WriteString(", ")
+	builder.WriteString("challenge=")
+	builder.WriteString(wac.Challenge)
+	builder.WriteString(", ")
+	builder.WriteString("application_id=")
+	builder.WriteString(fmt.Sprintf("%v", wac.ApplicationID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(wac.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(wac.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebAuthnChallenges is a parsable slice of WebAuthnChallenge.
+type WebAuthnChallenges []*WebAuthnChallenge
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/webauthnchallenge"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebAuthnChallengeCreate is the builder for creating a WebAuthnChallenge entity.
+type WebAuthnChallengeCreate struct {
+	config
+	mutation *WebAuthnChallengeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wacc *WebAuthnChallengeCreate) SetCreatedAt(t time.Time) *WebAuthnChallengeCreate {
+	wacc.mutation.SetCreatedAt(t)
+	return wacc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wacc *WebAuthnChallengeCreate) SetNillableCreatedAt(t *time.Time) *WebAuthnChallengeCreate {
+	if t != nil {
+		wacc.SetCreatedAt(*t)
+	}
+	return wacc
+}
+
+// SetType sets the "type" field.
+func (wacc *WebAuthnChallengeCreate) SetType(w webauthnchallenge.Type) *WebAuthnChallengeCreate {
+	wacc.mutation.SetType(w)
+	return wacc
+}
+
+// SetChallenge sets the "challenge" field.
+func (wacc *WebAuthnChallengeCreate) SetChallenge(s string) *WebAuthnChallengeCreate {
+	wacc.mutation.SetChallenge(s)
+	return wacc
+}
+
+// SetApplicationID sets the "application_id" field.
+func (wacc *WebAuthnChallengeCreate) SetApplicationID(u uuid.UUID) *WebAuthnChallengeCreate {
+	wacc.mutation.SetApplicationID(u)
+	return wacc
+}
+
+// SetUserID sets the "user_id" field.
+func (wacc *WebAuthnChallengeCreate) SetUserID(s string) *WebAuthnChallengeCreate {
+	wacc.mutation.SetUserID(s)
+	return wacc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wacc *WebAuthnChallengeCreate) SetNillableUserID(s *string) *WebAuthnChallengeCreate {
+	if s != nil {
+		wacc.SetUserID(*s)
+	}
+	return wacc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wacc *WebAuthnChallengeCreate) SetExpiresAt(t time.Time) *WebAuthnChallengeCreate {
+	wacc.mutation.SetExpiresAt(t)
+	return wacc
+}
+
+// SetID sets the "id" field.
+func (wacc *WebAuthnChallengeCreate) SetID(u uuid.UUID) *WebAuthnChallengeCreate {
+	wacc.mutation.SetID(u)
+	return wacc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wacc *WebAuthnChallengeCreate) SetNillableID(u *uuid.UUID) *WebAuthnChallengeCreate {
+	if u != nil {
+		wacc.SetID(*u)
+	}
+	return wacc
+}
+
+// Mutation returns the WebAuthnChallengeMutation object of the builder.
+func (wacc *WebAuthnChallengeCreate) Mutation() *WebAuthnChallengeMutation {
+	return wacc.mutation
+}
+
+// Save creates the WebAuthnChallenge in the database.
+func (wacc *WebAuthnChallengeCreate) Save(ctx context.Context) (*WebAuthnChallenge, error) {
+	wacc.defaults()
+	return withHooks(ctx, wacc.sqlSave, wacc.mutation, wacc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wacc *WebAuthnChallengeCreate) SaveX(ctx context.Context) *WebAuthnChallenge {
+	v, err := wacc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wacc *WebAuthnChallengeCreate) Exec(ctx context.Context) error {
+	_, err := wacc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacc *WebAuthnChallengeCreate) ExecX(ctx context.Context) {
+	if err := wacc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wacc *WebAuthnChallengeCreate) defaults() {
+	if _, ok := wacc.mutation.CreatedAt(); !ok {
+		v := webauthnchallenge.DefaultCreatedAt()
+		wacc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wacc.mutation.ID(); !ok {
+		v := webauthnchallenge.DefaultID()
+		wacc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wacc *WebAuthnChallengeCreate) check() error {
+	if _, ok := wacc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebAuthnChallenge.created_at"`)}
+	}
+	if _, ok := wacc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "WebAuthnChallenge.type"`)}
+	}
+	if v, ok := wacc.mutation.GetType(); ok {
+		if err := webauthnchallenge.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.type": %w`, err)}
+		}
+	}
+	if _, ok := wacc.mutation.Challenge(); !ok {
+		return &ValidationError{Name: "challenge", err: errors.New(`ent: missing required field "WebAuthnChallenge.challenge"`)}
+	}
+	if v, ok := wacc.mutation.Challenge(); ok {
+		if err := webauthnchallenge.ChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "challenge", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.challenge": %w`, err)}
+		}
+	}
+	if _, ok := wacc.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application_id", err: errors.New(`ent: missing required field "WebAuthnChallenge.application_id"`)}
+	}
+	if _, ok := wacc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "WebAuthnChallenge.expires_at"`)}
+	}
+	return nil
+}
+
+func (wacc *WebAuthnChallengeCreate) sqlSave(ctx context.Context) (*WebAuthnChallenge, error) {
+	if err := wacc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wacc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wacc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wacc.mutation.id = &_node.ID
+	wacc.mutation.done = true
+	return _node, nil
+}
+
+func (wacc *WebAuthnChallengeCreate) createSpec() (*WebAuthnChallenge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebAuthnChallenge{config: wacc.config}
+		_spec = sqlgraph.NewCreateSpec(webauthnchallenge.Table, sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeUUID))
+	)
+	if id, ok := wacc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wacc.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wacc.mutation.GetType(); ok {
+		_spec.SetField(webauthnchallenge.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := wacc.mutation.Challenge(); ok {
+		_spec.SetField(webauthnchallenge.FieldChallenge, field.TypeString, value)
+		_node.Challenge = value
+	}
+	if value, ok := wacc.mutation.ApplicationID(); ok {
+		_spec.SetField(webauthnchallenge.FieldApplicationID, field.TypeUUID, value)
+		_node.ApplicationID = value
+	}
+	if value, ok := wacc.mutation.UserID(); ok {
+		_spec.SetField(webauthnchallenge.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := wacc.mutation.ExpiresAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// WebAuthnChallengeCreateBulk is the builder for creating many WebAuthnChallenge entities in bulk.
+type WebAuthnChallengeCreateBulk struct {
+	config
+	err      error
+	builders []*WebAuthnChallengeCreate
+}
+
+// Save creates the WebAuthnChallenge entities in the database.
+func (waccb *WebAuthnChallengeCreateBulk) Save(ctx context.Context) ([]*WebAuthnChallenge, error) {
+	if waccb.err != nil {
+		return nil, waccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(waccb.builders))
+	nodes := make([]*WebAuthnChallenge, len(waccb.builders))
+	mutators := make([]Mutator, len(waccb.builders))
+	for i := range waccb.builders {
+		func(i int, root context.Context) {
+			builder := waccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebAuthnChallengeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, waccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, waccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, waccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (waccb *WebAuthnChallengeCreateBulk) SaveX(ctx context.Context) []*WebAuthnChallenge {
+	v, err := waccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (waccb *WebAuthnChallengeCreateBulk) Exec(ctx context.Context) error {
+	_, err := waccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (waccb *WebAuthnChallengeCreateBulk) ExecX(ctx context.Context) {
+	if err := waccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/webauthnchallenge"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebAuthnChallengeUpdate is the builder for updating WebAuthnChallenge entities.
+type WebAuthnChallengeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebAuthnChallengeMutation
+}
+
+// Where appends a list predicates to the WebAuthnChallengeUpdate builder.
+func (wacu *WebAuthnChallengeUpdate) Where(ps ...predicate.WebAuthnChallenge) *WebAuthnChallengeUpdate {
+	wacu.mutation.Where(ps...)
+	return wacu
+}
+
+// SetType sets the "type" field.
+func (wacu *WebAuthnChallengeUpdate) SetType(w webauthnchallenge.Type) *WebAuthnChallengeUpdate {
+	wacu.mutation.SetType(w)
+	return wacu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (wacu *WebAuthnChallengeUpdate) SetNillableType(w *webauthnchallenge.Type) *WebAuthnChallengeUpdate {
+	if w != nil {
+		wacu.SetType(*w)
+	}
+	return wacu
+}
+
+// SetChallenge sets the "challenge" field.
+func (wacu *WebAuthnChallengeUpdate) SetChallenge(s string) *WebAuthnChallengeUpdate {
+	wacu.mutation.SetChallenge(s)
+	return wacu
+}
+
+// SetNillableChallenge sets the "challenge" field if the given value is not nil.
+func (wacu *WebAuthnChallengeUpdate) SetNillableChallenge(s *string) *WebAuthnChallengeUpdate {
+	if s != nil {
+		wacu.SetChallenge(*s)
+	}
+	return wacu
+}
+
+// SetApplicationID sets the "application_id" field.
+func (wacu *WebAuthnChallengeUpdate) SetApplicationID(u uuid.UUID) *WebAuthnChallengeUpdate {
+	wacu.mutation.SetApplicationID(u)
+	return wacu
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (wacu *WebAuthnChallengeUpdate) SetNillableApplicationID(u *uuid.UUID) *WebAuthnChallengeUpdate {
+	if u != nil {
+		wacu.SetApplicationID(*u)
+	}
+	return wacu
+}
+
+// SetUserID sets the "user_id" field.
+func (wacu *WebAuthnChallengeUpdate) SetUserID(s string) *WebAuthnChallengeUpdate {
+	wacu.mutation.SetUserID(s)
+	return wacu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wacu *WebAuthnChallengeUpdate) SetNillableUserID(s *string) *WebAuthnChallengeUpdate {
+	if s != nil {
+		wacu.SetUserID(*s)
+	}
+	return wacu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (wacu *WebAuthnChallengeUpdate) ClearUserID() *WebAuthnChallengeUpdate {
+	wacu.mutation.ClearUserID()
+	return wacu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wacu *WebAuthnChallengeUpdate) SetExpiresAt(t time.Time) *WebAuthnChallengeUpdate {
+	wacu.mutation.SetExpiresAt(t)
+	return wacu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (wacu *WebAuthnChallengeUpdate) SetNillableExpiresAt(t *time.Time) *WebAuthnChallengeUpdate {
+	if t != nil {
+		wacu.SetExpiresAt(*t)
+	}
+	return wacu
+}
+
+// Mutation returns the WebAuthnChallengeMutation object of the builder.
+func (wacu *WebAuthnChallengeUpdate) Mutation() *WebAuthnChallengeMutation {
+	return wacu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wacu *WebAuthnChallengeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wacu.sqlSave, wacu.mutation, wacu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wacu *WebAuthnChallengeUpdate) SaveX(ctx context.Context) int {
+	affected, err := wacu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wacu *WebAuthnChallengeUpdate) Exec(ctx context.Context) error {
+	_, err := wacu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacu *WebAuthnChallengeUpdate) ExecX(ctx context.Context) {
+	if err := wacu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wacu *WebAuthnChallengeUpdate) check() error {
+	if v, ok := wacu.mutation.GetType(); ok {
+		if err := webauthnchallenge.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.type": %w`, err)}
+		}
+	}
+	if v, ok := wacu.mutation.Challenge(); ok {
+		if err := webauthnchallenge.ChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "challenge", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.challenge": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wacu *WebAuthnChallengeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wacu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthnchallenge.Table, webauthnchallenge.Columns, sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeUUID))
+	if ps := wacu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wacu.mutation.GetType(); ok {
+		_spec.SetField(webauthnchallenge.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := wacu.mutation.Challenge(); ok {
+		_spec.SetField(webauthnchallenge.FieldChallenge, field.TypeString, value)
+	}
+	if value, ok := wacu.mutation.ApplicationID(); ok {
+		_spec.SetField(webauthnchallenge.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := wacu.mutation.UserID(); ok {
+		_spec.SetField(webauthnchallenge.FieldUserID, field.TypeString, value)
+	}
+	if wacu.mutation.UserIDCleared() {
+		_spec.ClearField(webauthnchallenge.FieldUserID, field.TypeString)
+	}
+	if value, ok := wacu.mutation.ExpiresAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wacu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthnchallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wacu.mutation.done = true
+	return n, nil
+}
+
+// WebAuthnChallengeUpdateOne is the builder for updating a single WebAuthnChallenge entity.
+type WebAuthnChallengeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebAuthnChallengeMutation
+}
+
+// SetType sets the "type" field.
+func (wacuo *WebAuthnChallengeUpdateOne) SetType(w webauthnchallenge.Type) *WebAuthnChallengeUpdateOne {
+	wacuo.mutation.SetType(w)
+	return wacuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (wacuo *WebAuthnChallengeUpdateOne) SetNillableType(w *webauthnchallenge.Type) *WebAuthnChallengeUpdateOne {
+	if w != nil {
+		wacuo.SetType(*w)
+	}
+	return wacuo
+}
+
+// SetChallenge sets the "challenge" field.
+func (wacuo *WebAuthnChallengeUpdateOne) SetChallenge(s string) *WebAuthnChallengeUpdateOne {
+	wacuo.mutation.SetChallenge(s)
+	return wacuo
+}
+
+// SetNillableChallenge sets the "challenge" field if the given value is not nil.
+func (wacuo *WebAuthnChallengeUpdateOne) SetNillableChallenge(s *string) *WebAuthnChallengeUpdateOne {
+	if s != nil {
+		wacuo.SetChallenge(*s)
+	}
+	return wacuo
+}
+
+// SetApplicationID sets the "application_id" field.
+func (wacuo *WebAuthnChallengeUpdateOne) SetApplicationID(u uuid.UUID) *WebAuthnChallengeUpdateOne {
+	wacuo.mutation.SetApplicationID(u)
+	return wacuo
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (wacuo *WebAuthnChallengeUpdateOne) SetNillableApplicationID(u *uuid.UUID) *WebAuthnChallengeUpdateOne {
+	if u != nil {
+		wacuo.SetApplicationID(*u)
+	}
+	return wacuo
+}
+
+// SetUserID sets the "user_id" field.
+func (wacuo *WebAuthnChallengeUpdateOne) SetUserID(s string) *WebAuthnChallengeUpdateOne {
+	wacuo.mutation.SetUserID(s)
+	return wacuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wacuo *WebAuthnChallengeUpdateOne) SetNillableUserID(s *string) *WebAuthnChallengeUpdateOne {
+	if s != nil {
+		wacuo.SetUserID(*s)
+	}
+	return wacuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (wacuo *WebAuthnChallengeUpdateOne) ClearUserID() *WebAuthnChallengeUpdateOne {
+	wacuo.mutation.ClearUserID()
+	return wacuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wacuo *WebAuthnChallengeUpdateOne) SetExpiresAt(t time.Time) *WebAuthnChallengeUpdateOne {
+	wacuo.mutation.SetExpiresAt(t)
+	return wacuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (wacuo *WebAuthnChallengeUpdateOne) SetNillableExpiresAt(t *time.Time) *WebAuthnChallengeUpdateOne {
+	if t != nil {
+		wacuo.SetExpiresAt(*t)
+	}
+	return wacuo
+}
+
+// Mutation returns the WebAuthnChallengeMutation object of the builder.
+func (wacuo *WebAuthnChallengeUpdateOne) Mutation() *WebAuthnChallengeMutation {
+	return wacuo.mutation
+}
+
+// Where appends a list predicates to the WebAuthnChallengeUpdate builder.
+func (wacuo *WebAuthnChallengeUpdateOne) Where(ps ...predicate.WebAuthnChallenge) *WebAuthnChallengeUpdateOne {
+	wacuo.mutation.Where(ps...)
+	return wacuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wacuo *WebAuthnChallengeUpdateOne) Select(field string, fields ...string) *WebAuthnChallengeUpdateOne {
+	wacuo.fields = append([]string{field}, fields...)
+	return wacuo
+}
+
+// Save executes the query and returns the updated WebAuthnChallenge entity.
+func (wacuo *WebAuthnChallengeUpdateOne) Save(ctx context.Context) (*WebAuthnChallenge, error) {
+	return withHooks(ctx, wacuo.sqlSave, wacuo.mutation, wacuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wacuo *WebAuthnChallengeUpdateOne) SaveX(ctx context.Context) *WebAuthnChallenge {
+	node, err := wacuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wacuo *WebAuthnChallengeUpdateOne) Exec(ctx context.Context) error {
+	_, err := wacuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacuo *WebAuthnChallengeUpdateOne) ExecX(ctx context.Context) {
+	if err := wacuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wacuo *WebAuthnChallengeUpdateOne) check() error {
+	if v, ok := wacuo.mutation.GetType(); ok {
+		if err := webauthnchallenge.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.type": %w`, err)}
+		}
+	}
+	if v, ok := wacuo.mutation.Challenge(); ok {
+		if err := webauthnchallenge.ChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "challenge", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.challenge": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wacuo *WebAuthnChallengeUpdateOne) sqlSave(ctx context.Context) (_node *WebAuthnChallenge, err error) {
+	if err := wacuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthnchallenge.Table, webauthnchallenge.Columns, sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeUUID))
+	id, ok := wacuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebAuthnChallenge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wacuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthnchallenge.FieldID)
+		for _, f := range fields {
+			if !webauthnchallenge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webauthnchallenge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wacuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wacuo.mutation.GetType(); ok {
+		_spec.SetField(webauthnchallenge.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := wacuo.mutation.Challenge(); ok {
+		_spec.SetField(webauthnchallenge.FieldChallenge, field.TypeString, value)
+	}
+	if value, ok := wacuo.mutation.ApplicationID(); ok {
+		_spec.SetField(webauthnchallenge.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := wacuo.mutation.UserID(); ok {
+		_spec.SetField(webauthnchallenge.FieldUserID, field.TypeString, value)
+	}
+	if wacuo.mutation.UserIDCleared() {
+		_spec.ClearField(webauthnchallenge.FieldUserID, field.TypeString)
+	}
+	if value, ok := wacuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &WebAuthnChallenge{config: wacuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wacuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthnchallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wacuo.mutation.done = true
+	return _node, nil
+}
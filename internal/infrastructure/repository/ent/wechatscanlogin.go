@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/wechatscanlogin"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// WechatScanLogin is the model entity for the WechatScanLogin schema.
+type WechatScanLogin struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ApplicationID holds the value of the "application_id" field.
+	ApplicationID uuid.UUID `json:"application_id,omitempty"`
+	// DeviceType holds the value of the "device_type" field.
+	DeviceType string `json:"device_type,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID string `json:"device_id,omitempty"`
+	// PollTokenHash holds the value of the "poll_token_hash" field.
+	PollTokenHash string `json:"poll_token_hash,omitempty"`
+	// OpenID holds the value of the "open_id" field.
+	OpenID string `json:"open_id,omitempty"`
+	// UnionID holds the value of the "union_id" field.
+	UnionID string `json:"union_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WechatScanLogin) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wechatscanlogin.FieldDeviceType, wechatscanlogin.FieldDeviceID, wechatscanlogin.FieldPollTokenHash, wechatscanlogin.FieldOpenID, wechatscanlogin.FieldUnionID:
+			values[i] = new(sql.NullString)
+		case wechatscanlogin.FieldCreatedAt, wechatscanlogin.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case wechatscanlogin.FieldID, wechatscanlogin.FieldApplicationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WechatScanLogin fields.
+func (wsl *WechatScanLogin) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wechatscanlogin.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wsl.ID = *value
+			}
+		case wechatscanlogin.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wsl.CreatedAt = value.Time
+			}
+		case wechatscanlogin.FieldApplicationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field application_id", values[i])
+			} else if value != nil {
+				wsl.ApplicationID = *value
+			}
+		case wechatscanlogin.FieldDeviceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_type", values[i])
+			} else if value.Valid {
+				wsl.DeviceType = value.String
+			}
+		case wechatscanlogin.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				wsl.DeviceID = value.String
+			}
+		case wechatscanlogin.FieldPollTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_token_hash", values[i])
+			} else if value.Valid {
+				wsl.PollTokenHash = value.String
+			}
+		case wechatscanlogin.FieldOpenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field open_id", values[i])
+			} else if value.Valid {
+				wsl.OpenID = value.String
+			}
+		case wechatscanlogin.FieldUnionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field union_id", values[i])
+			} else if value.Valid {
+				wsl.UnionID = value.String
+			}
+		case wechatscanlogin.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				wsl.ExpiresAt = value.Time
+			}
+		default:
+			wsl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WechatScanLogin.
+// This includes values selected through modifiers, order, etc.
+func (wsl *WechatScanLogin) Value(name string) (ent.Value, error) {
+	return wsl.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WechatScanLogin.
+// Note that you need to call WechatScanLogin.Unwrap() before calling this method if this WechatScanLogin
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wsl *WechatScanLogin) Update() *WechatScanLoginUpdateOne {
+	return NewWechatScanLoginClient(wsl.config).UpdateOne(wsl)
+}
+
+// Unwrap unwraps the WechatScanLogin entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wsl *WechatScanLogin) Unwrap() *WechatScanLogin {
+	_tx, ok := wsl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WechatScanLogin is not a transactional entity")
+	}
+	wsl.config.driver = _tx.drv
+	return wsl
+}
+
+// String implements the fmt.Stringer.
+func (wsl *WechatScanLogin) String() string {
+	var builder strings.Builder
+	builder.WriteString("WechatScanLogin(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wsl.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wsl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("application_id=")
+	builder.WriteString(fmt.Sprintf("%v", wsl.ApplicationID))
+	builder.WriteString(", ")
+	builder.WriteString("device_type=")
+	builder.WriteString(wsl.DeviceType)
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(wsl.DeviceID)
+	builder.WriteString(", ")
+	builder.WriteString("poll_token_hash=")
+	builder.WriteString(wsl.PollTokenHash)
+	builder.WriteString(", ")
+	builder.WriteString("open_id=")
+	builder.WriteString(wsl.OpenID)
+	builder.WriteString(", ")
+	builder.WriteString("union_id=")
+	builder.WriteString(wsl.UnionID)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(wsl.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WechatScanLogins is a parsable slice of WechatScanLogin.
+type WechatScanLogins []*WechatScanLogin
@@ -0,0 +1,576 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wechatscanlogin
+
+import (
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ApplicationID applies equality check predicate on the "application_id" field. It's identical to ApplicationIDEQ.
+func ApplicationID(v uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// DeviceType applies equality check predicate on the "device_type" field. It's identical to DeviceTypeEQ.
+func DeviceType(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldDeviceType, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// PollTokenHash applies equality check predicate on the "poll_token_hash" field. It's identical to PollTokenHashEQ.
+func PollTokenHash(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldPollTokenHash, v))
+}
+
+// OpenID applies equality check predicate on the "open_id" field. It's identical to OpenIDEQ.
+func OpenID(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldOpenID, v))
+}
+
+// UnionID applies equality check predicate on the "union_id" field. It's identical to UnionIDEQ.
+func UnionID(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldUnionID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ApplicationIDEQ applies the EQ predicate on the "application_id" field.
+func ApplicationIDEQ(v uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDNEQ applies the NEQ predicate on the "application_id" field.
+func ApplicationIDNEQ(v uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDIn applies the In predicate on the "application_id" field.
+func ApplicationIDIn(vs ...uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDNotIn applies the NotIn predicate on the "application_id" field.
+func ApplicationIDNotIn(vs ...uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDGT applies the GT predicate on the "application_id" field.
+func ApplicationIDGT(v uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGT(FieldApplicationID, v))
+}
+
+// ApplicationIDGTE applies the GTE predicate on the "application_id" field.
+func ApplicationIDGTE(v uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGTE(FieldApplicationID, v))
+}
+
+// ApplicationIDLT applies the LT predicate on the "application_id" field.
+func ApplicationIDLT(v uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLT(FieldApplicationID, v))
+}
+
+// ApplicationIDLTE applies the LTE predicate on the "application_id" field.
+func ApplicationIDLTE(v uuid.UUID) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLTE(FieldApplicationID, v))
+}
+
+// DeviceTypeEQ applies the EQ predicate on the "device_type" field.
+func DeviceTypeEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldDeviceType, v))
+}
+
+// DeviceTypeNEQ applies the NEQ predicate on the "device_type" field.
+func DeviceTypeNEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNEQ(FieldDeviceType, v))
+}
+
+// DeviceTypeIn applies the In predicate on the "device_type" field.
+func DeviceTypeIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIn(FieldDeviceType, vs...))
+}
+
+// DeviceTypeNotIn applies the NotIn predicate on the "device_type" field.
+func DeviceTypeNotIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotIn(FieldDeviceType, vs...))
+}
+
+// DeviceTypeGT applies the GT predicate on the "device_type" field.
+func DeviceTypeGT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGT(FieldDeviceType, v))
+}
+
+// DeviceTypeGTE applies the GTE predicate on the "device_type" field.
+func DeviceTypeGTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGTE(FieldDeviceType, v))
+}
+
+// DeviceTypeLT applies the LT predicate on the "device_type" field.
+func DeviceTypeLT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLT(FieldDeviceType, v))
+}
+
+// DeviceTypeLTE applies the LTE predicate on the "device_type" field.
+func DeviceTypeLTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLTE(FieldDeviceType, v))
+}
+
+// DeviceTypeContains applies the Contains predicate on the "device_type" field.
+func DeviceTypeContains(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContains(FieldDeviceType, v))
+}
+
+// DeviceTypeHasPrefix applies the HasPrefix predicate on the "device_type" field.
+func DeviceTypeHasPrefix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasPrefix(FieldDeviceType, v))
+}
+
+// DeviceTypeHasSuffix applies the HasSuffix predicate on the "device_type" field.
+func DeviceTypeHasSuffix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasSuffix(FieldDeviceType, v))
+}
+
+// DeviceTypeEqualFold applies the EqualFold predicate on the "device_type" field.
+func DeviceTypeEqualFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEqualFold(FieldDeviceType, v))
+}
+
+// DeviceTypeContainsFold applies the ContainsFold predicate on the "device_type" field.
+func DeviceTypeContainsFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContainsFold(FieldDeviceType, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// PollTokenHashEQ applies the EQ predicate on the "poll_token_hash" field.
+func PollTokenHashEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldPollTokenHash, v))
+}
+
+// PollTokenHashNEQ applies the NEQ predicate on the "poll_token_hash" field.
+func PollTokenHashNEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNEQ(FieldPollTokenHash, v))
+}
+
+// PollTokenHashIn applies the In predicate on the "poll_token_hash" field.
+func PollTokenHashIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIn(FieldPollTokenHash, vs...))
+}
+
+// PollTokenHashNotIn applies the NotIn predicate on the "poll_token_hash" field.
+func PollTokenHashNotIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotIn(FieldPollTokenHash, vs...))
+}
+
+// PollTokenHashGT applies the GT predicate on the "poll_token_hash" field.
+func PollTokenHashGT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGT(FieldPollTokenHash, v))
+}
+
+// PollTokenHashGTE applies the GTE predicate on the "poll_token_hash" field.
+func PollTokenHashGTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGTE(FieldPollTokenHash, v))
+}
+
+// PollTokenHashLT applies the LT predicate on the "poll_token_hash" field.
+func PollTokenHashLT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLT(FieldPollTokenHash, v))
+}
+
+// PollTokenHashLTE applies the LTE predicate on the "poll_token_hash" field.
+func PollTokenHashLTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLTE(FieldPollTokenHash, v))
+}
+
+// PollTokenHashContains applies the Contains predicate on the "poll_token_hash" field.
+func PollTokenHashContains(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContains(FieldPollTokenHash, v))
+}
+
+// PollTokenHashHasPrefix applies the HasPrefix predicate on the "poll_token_hash" field.
+func PollTokenHashHasPrefix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasPrefix(FieldPollTokenHash, v))
+}
+
+// PollTokenHashHasSuffix applies the HasSuffix predicate on the "poll_token_hash" field.
+func PollTokenHashHasSuffix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasSuffix(FieldPollTokenHash, v))
+}
+
+// PollTokenHashEqualFold applies the EqualFold predicate on the "poll_token_hash" field.
+func PollTokenHashEqualFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEqualFold(FieldPollTokenHash, v))
+}
+
+// PollTokenHashContainsFold applies the ContainsFold predicate on the "poll_token_hash" field.
+func PollTokenHashContainsFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContainsFold(FieldPollTokenHash, v))
+}
+
+// OpenIDEQ applies the EQ predicate on the "open_id" field.
+func OpenIDEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldOpenID, v))
+}
+
+// OpenIDNEQ applies the NEQ predicate on the "open_id" field.
+func OpenIDNEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNEQ(FieldOpenID, v))
+}
+
+// OpenIDIn applies the In predicate on the "open_id" field.
+func OpenIDIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIn(FieldOpenID, vs...))
+}
+
+// OpenIDNotIn applies the NotIn predicate on the "open_id" field.
+func OpenIDNotIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotIn(FieldOpenID, vs...))
+}
+
+// OpenIDGT applies the GT predicate on the "open_id" field.
+func OpenIDGT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGT(FieldOpenID, v))
+}
+
+// OpenIDGTE applies the GTE predicate on the "open_id" field.
+func OpenIDGTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGTE(FieldOpenID, v))
+}
+
+// OpenIDLT applies the LT predicate on the "open_id" field.
+func OpenIDLT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLT(FieldOpenID, v))
+}
+
+// OpenIDLTE applies the LTE predicate on the "open_id" field.
+func OpenIDLTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLTE(FieldOpenID, v))
+}
+
+// OpenIDContains applies the Contains predicate on the "open_id" field.
+func OpenIDContains(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContains(FieldOpenID, v))
+}
+
+// OpenIDHasPrefix applies the HasPrefix predicate on the "open_id" field.
+func OpenIDHasPrefix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasPrefix(FieldOpenID, v))
+}
+
+// OpenIDHasSuffix applies the HasSuffix predicate on the "open_id" field.
+func OpenIDHasSuffix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasSuffix(FieldOpenID, v))
+}
+
+// OpenIDIsNil applies the IsNil predicate on the "open_id" field.
+func OpenIDIsNil() predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIsNull(FieldOpenID))
+}
+
+// OpenIDNotNil applies the NotNil predicate on the "open_id" field.
+func OpenIDNotNil() predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotNull(FieldOpenID))
+}
+
+// OpenIDEqualFold applies the EqualFold predicate on the "open_id" field.
+func OpenIDEqualFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEqualFold(FieldOpenID, v))
+}
+
+// OpenIDContainsFold applies the ContainsFold predicate on the "open_id" field.
+func OpenIDContainsFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContainsFold(FieldOpenID, v))
+}
+
+// UnionIDEQ applies the EQ predicate on the "union_id" field.
+func UnionIDEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldUnionID, v))
+}
+
+// UnionIDNEQ applies the NEQ predicate on the "union_id" field.
+func UnionIDNEQ(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNEQ(FieldUnionID, v))
+}
+
+// UnionIDIn applies the In predicate on the "union_id" field.
+func UnionIDIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIn(FieldUnionID, vs...))
+}
+
+// UnionIDNotIn applies the NotIn predicate on the "union_id" field.
+func UnionIDNotIn(vs ...string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotIn(FieldUnionID, vs...))
+}
+
+// UnionIDGT applies the GT predicate on the "union_id" field.
+func UnionIDGT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGT(FieldUnionID, v))
+}
+
+// UnionIDGTE applies the GTE predicate on the "union_id" field.
+func UnionIDGTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGTE(FieldUnionID, v))
+}
+
+// UnionIDLT applies the LT predicate on the "union_id" field.
+func UnionIDLT(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLT(FieldUnionID, v))
+}
+
+// UnionIDLTE applies the LTE predicate on the "union_id" field.
+func UnionIDLTE(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLTE(FieldUnionID, v))
+}
+
+// UnionIDContains applies the Contains predicate on the "union_id" field.
+func UnionIDContains(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContains(FieldUnionID, v))
+}
+
+// UnionIDHasPrefix applies the HasPrefix predicate on the "union_id" field.
+func UnionIDHasPrefix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasPrefix(FieldUnionID, v))
+}
+
+// UnionIDHasSuffix applies the HasSuffix predicate on the "union_id" field.
+func UnionIDHasSuffix(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldHasSuffix(FieldUnionID, v))
+}
+
+// UnionIDIsNil applies the IsNil predicate on the "union_id" field.
+func UnionIDIsNil() predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIsNull(FieldUnionID))
+}
+
+// UnionIDNotNil applies the NotNil predicate on the "union_id" field.
+func UnionIDNotNil() predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotNull(FieldUnionID))
+}
+
+// UnionIDEqualFold applies the EqualFold predicate on the "union_id" field.
+func UnionIDEqualFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEqualFold(FieldUnionID, v))
+}
+
+// UnionIDContainsFold applies the ContainsFold predicate on the "union_id" field.
+func UnionIDContainsFold(v string) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldContainsFold(FieldUnionID, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WechatScanLogin) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WechatScanLogin) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WechatScanLogin) predicate.WechatScanLogin {
+	return predicate.WechatScanLogin(sql.NotPredicates(p))
+}
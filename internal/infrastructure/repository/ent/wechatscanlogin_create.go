@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/wechatscanlogin"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WechatScanLoginCreate is the builder for creating a WechatScanLogin entity.
+type WechatScanLoginCreate struct {
+	config
+	mutation *WechatScanLoginMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wslc *WechatScanLoginCreate) SetCreatedAt(t time.Time) *WechatScanLoginCreate {
+	wslc.mutation.SetCreatedAt(t)
+	return wslc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wslc *WechatScanLoginCreate) SetNillableCreatedAt(t *time.Time) *WechatScanLoginCreate {
+	if t != nil {
+		wslc.SetCreatedAt(*t)
+	}
+	return wslc
+}
+
+// SetApplicationID sets the "application_id" field.
+func (wslc *WechatScanLoginCreate) SetApplicationID(u uuid.UUID) *WechatScanLoginCreate {
+	wslc.mutation.SetApplicationID(u)
+	return wslc
+}
+
+// SetDeviceType sets the "device_type" field.
+func (wslc *WechatScanLoginCreate) SetDeviceType(s string) *WechatScanLoginCreate {
+	wslc.mutation.SetDeviceType(s)
+	return wslc
+}
+
+// SetDeviceID sets the "device_id" field.
+func (wslc *WechatScanLoginCreate) SetDeviceID(s string) *WechatScanLoginCreate {
+	wslc.mutation.SetDeviceID(s)
+	return wslc
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (wslc *WechatScanLoginCreate) SetPollTokenHash(s string) *WechatScanLoginCreate {
+	wslc.mutation.SetPollTokenHash(s)
+	return wslc
+}
+
+// SetOpenID sets the "open_id" field.
+func (wslc *WechatScanLoginCreate) SetOpenID(s string) *WechatScanLoginCreate {
+	wslc.mutation.SetOpenID(s)
+	return wslc
+}
+
+// SetNillableOpenID sets the "open_id" field if the given value is not nil.
+func (wslc *WechatScanLoginCreate) SetNillableOpenID(s *string) *WechatScanLoginCreate {
+	if s != nil {
+		wslc.SetOpenID(*s)
+	}
+	return wslc
+}
+
+// SetUnionID sets the "union_id" field.
+func (wslc *WechatScanLoginCreate) SetUnionID(s string) *WechatScanLoginCreate {
+	wslc.mutation.SetUnionID(s)
+	return wslc
+}
+
+// SetNillableUnionID sets the "union_id" field if the given value is not nil.
+func (wslc *WechatScanLoginCreate) SetNillableUnionID(s *string) *WechatScanLoginCreate {
+	if s != nil {
+		wslc.SetUnionID(*s)
+	}
+	return wslc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wslc *WechatScanLoginCreate) SetExpiresAt(t time.Time) *WechatScanLoginCreate {
+	wslc.mutation.SetExpiresAt(t)
+	return wslc
+}
+
+// SetID sets the "id" field.
+func (wslc *WechatScanLoginCreate) SetID(u uuid.UUID) *WechatScanLoginCreate {
+	wslc.mutation.SetID(u)
+	return wslc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wslc *WechatScanLoginCreate) SetNillableID(u *uuid.UUID) *WechatScanLoginCreate {
+	if u != nil {
+		wslc.SetID(*u)
+	}
+	return wslc
+}
+
+// Mutation returns the WechatScanLoginMutation object of the builder.
+func (wslc *WechatScanLoginCreate) Mutation() *WechatScanLoginMutation {
+	return wslc.mutation
+}
+
+// Save creates the WechatScanLogin in the database.
+func (wslc *WechatScanLoginCreate) Save(ctx context.Context) (*WechatScanLogin, error) {
+	wslc.defaults()
+	return withHooks(ctx, wslc.sqlSave, wslc.mutation, wslc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wslc *WechatScanLoginCreate) SaveX(ctx context.Context) *WechatScanLogin {
+	v, err := wslc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wslc *WechatScanLoginCreate) Exec(ctx context.Context) error {
+	_, err := wslc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wslc *WechatScanLoginCreate) ExecX(ctx context.Context) {
+	if err := wslc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wslc *WechatScanLoginCreate) defaults() {
+	if _, ok := wslc.mutation.CreatedAt(); !ok {
+		v := wechatscanlogin.DefaultCreatedAt()
+		wslc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wslc.mutation.ID(); !ok {
+		v := wechatscanlogin.DefaultID()
+		wslc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wslc *WechatScanLoginCreate) check() error {
+	if _, ok := wslc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WechatScanLogin.created_at"`)}
+	}
+	if _, ok := wslc.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application_id", err: errors.New(`ent: missing required field "WechatScanLogin.application_id"`)}
+	}
+	if _, ok := wslc.mutation.DeviceType(); !ok {
+		return &ValidationError{Name: "device_type", err: errors.New(`ent: missing required field "WechatScanLogin.device_type"`)}
+	}
+	if v, ok := wslc.mutation.DeviceType(); ok {
+		if err := wechatscanlogin.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "WechatScanLogin.device_type": %w`, err)}
+		}
+	}
+	if _, ok := wslc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "WechatScanLogin.device_id"`)}
+	}
+	if v, ok := wslc.mutation.DeviceID(); ok {
+		if err := wechatscanlogin.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "WechatScanLogin.device_id": %w`, err)}
+		}
+	}
+	if _, ok := wslc.mutation.PollTokenHash(); !ok {
+		return &ValidationError{Name: "poll_token_hash", err: errors.New(`ent: missing required field "WechatScanLogin.poll_token_hash"`)}
+	}
+	if v, ok := wslc.mutation.PollTokenHash(); ok {
+		if err := wechatscanlogin.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "WechatScanLogin.poll_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := wslc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "WechatScanLogin.expires_at"`)}
+	}
+	return nil
+}
+
+func (wslc *WechatScanLoginCreate) sqlSave(ctx context.Context) (*WechatScanLogin, error) {
+	if err := wslc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wslc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wslc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wslc.mutation.id = &_node.ID
+	wslc.mutation.done = true
+	return _node, nil
+}
+
+func (wslc *WechatScanLoginCreate) createSpec() (*WechatScanLogin, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WechatScanLogin{config: wslc.config}
+		_spec = sqlgraph.NewCreateSpec(wechatscanlogin.Table, sqlgraph.NewFieldSpec(wechatscanlogin.FieldID, field.TypeUUID))
+	)
+	if id, ok := wslc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wslc.mutation.CreatedAt(); ok {
+		_spec.SetField(wechatscanlogin.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wslc.mutation.ApplicationID(); ok {
+		_spec.SetField(wechatscanlogin.FieldApplicationID, field.TypeUUID, value)
+		_node.ApplicationID = value
+	}
+	if value, ok := wslc.mutation.DeviceType(); ok {
+		_spec.SetField(wechatscanlogin.FieldDeviceType, field.TypeString, value)
+		_node.DeviceType = value
+	}
+	if value, ok := wslc.mutation.DeviceID(); ok {
+		_spec.SetField(wechatscanlogin.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := wslc.mutation.PollTokenHash(); ok {
+		_spec.SetField(wechatscanlogin.FieldPollTokenHash, field.TypeString, value)
+		_node.PollTokenHash = value
+	}
+	if value, ok := wslc.mutation.OpenID(); ok {
+		_spec.SetField(wechatscanlogin.FieldOpenID, field.TypeString, value)
+		_node.OpenID = value
+	}
+	if value, ok := wslc.mutation.UnionID(); ok {
+		_spec.SetField(wechatscanlogin.FieldUnionID, field.TypeString, value)
+		_node.UnionID = value
+	}
+	if value, ok := wslc.mutation.ExpiresAt(); ok {
+		_spec.SetField(wechatscanlogin.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// WechatScanLoginCreateBulk is the builder for creating many WechatScanLogin entities in bulk.
+type WechatScanLoginCreateBulk struct {
+	config
+	err      error
+	builders []*WechatScanLoginCreate
+}
+
+// Save creates the WechatScanLogin entities in the database.
+func (wslcb *WechatScanLoginCreateBulk) Save(ctx context.Context) ([]*WechatScanLogin, error) {
+	if wslcb.err != nil {
+		return nil, wslcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wslcb.builders))
+	nodes := make([]*WechatScanLogin, len(wslcb.builders))
+	mutators := make([]Mutator, len(wslcb.builders))
+	for i := range wslcb.builders {
+		func(i int, root context.Context) {
+			builder := wslcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WechatScanLoginMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wslcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wslcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wslcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wslcb *WechatScanLoginCreateBulk) SaveX(ctx context.Context) []*WechatScanLogin {
+	v, err := wslcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wslcb *WechatScanLoginCreateBulk) Exec(ctx context.Context) error {
+	_, err := wslcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wslcb *WechatScanLoginCreateBulk) ExecX(ctx context.Context) {
+	if err := wslcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
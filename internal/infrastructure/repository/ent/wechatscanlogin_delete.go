@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/wechatscanlogin"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WechatScanLoginDelete is the builder for deleting a WechatScanLogin entity.
+type WechatScanLoginDelete struct {
+	config
+	hooks    []Hook
+	mutation *WechatScanLoginMutation
+}
+
+// Where appends a list predicates to the WechatScanLoginDelete builder.
+func (wsld *WechatScanLoginDelete) Where(ps ...predicate.WechatScanLogin) *WechatScanLoginDelete {
+	wsld.mutation.Where(ps...)
+	return wsld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wsld *WechatScanLoginDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wsld.sqlExec, wsld.mutation, wsld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsld *WechatScanLoginDelete) ExecX(ctx context.Context) int {
+	n, err := wsld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wsld *WechatScanLoginDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(wechatscanlogin.Table, sqlgraph.NewFieldSpec(wechatscanlogin.FieldID, field.TypeUUID))
+	if ps := wsld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wsld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wsld.mutation.done = true
+	return affected, err
+}
+
+// WechatScanLoginDeleteOne is the builder for deleting a single WechatScanLogin entity.
+type WechatScanLoginDeleteOne struct {
+	wsld *WechatScanLoginDelete
+}
+
+// Where appends a list predicates to the WechatScanLoginDelete builder.
+func (wsldo *WechatScanLoginDeleteOne) Where(ps ...predicate.WechatScanLogin) *WechatScanLoginDeleteOne {
+	wsldo.wsld.mutation.Where(ps...)
+	return wsldo
+}
+
+// Exec executes the deletion query.
+func (wsldo *WechatScanLoginDeleteOne) Exec(ctx context.Context) error {
+	n, err := wsldo.wsld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{wechatscanlogin.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsldo *WechatScanLoginDeleteOne) ExecX(ctx context.Context) {
+	if err := wsldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
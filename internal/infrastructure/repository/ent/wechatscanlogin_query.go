@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/wechatscanlogin"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WechatScanLoginQuery is the builder for querying WechatScanLogin entities.
+type WechatScanLoginQuery struct {
+	config
+	ctx        *QueryContext
+	order      []wechatscanlogin.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WechatScanLogin
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WechatScanLoginQuery builder.
+func (wslq *WechatScanLoginQuery) Where(ps ...predicate.WechatScanLogin) *WechatScanLoginQuery {
+	wslq.predicates = append(wslq.predicates, ps...)
+	return wslq
+}
+
+// Limit the number of records to be returned by this query.
+func (wslq *WechatScanLoginQuery) Limit(limit int) *WechatScanLoginQuery {
+	wslq.ctx.Limit = &limit
+	return wslq
+}
+
+// Offset to start from.
+func (wslq *WechatScanLoginQuery) Offset(offset int) *WechatScanLoginQuery {
+	wslq.ctx.Offset = &offset
+	return wslq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wslq *WechatScanLoginQuery) Unique(unique bool) *WechatScanLoginQuery {
+	wslq.ctx.Unique = &unique
+	return wslq
+}
+
+// Order specifies how the records should be ordered.
+func (wslq *WechatScanLoginQuery) Order(o ...wechatscanlogin.OrderOption) *WechatScanLoginQuery {
+	wslq.order = append(wslq.order, o...)
+	return wslq
+}
+
+// First returns the first WechatScanLogin entity from the query.
+// Returns a *NotFoundError when no WechatScanLogin was found.
+func (wslq *WechatScanLoginQuery) First(ctx context.Context) (*WechatScanLogin, error) {
+	nodes, err := wslq.Limit(1).All(setContextOp(ctx, wslq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{wechatscanlogin.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wslq *WechatScanLoginQuery) FirstX(ctx context.Context) *WechatScanLogin {
+	node, err := wslq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WechatScanLogin ID from the query.
+// Returns a *NotFoundError when no WechatScanLogin ID was found.
+func (wslq *WechatScanLoginQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wslq.Limit(1).IDs(setContextOp(ctx, wslq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{wechatscanlogin.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wslq *WechatScanLoginQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wslq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WechatScanLogin entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WechatScanLogin entity is found.
+// Returns a *NotFoundError when no WechatScanLogin entities are found.
+func (wslq *WechatScanLoginQuery) Only(ctx context.Context) (*WechatScanLogin, error) {
+	nodes, err := wslq.Limit(2).All(setContextOp(ctx, wslq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{wechatscanlogin.Label}
+	default:
+		return nil, &NotSingularError{wechatscanlogin.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wslq *WechatScanLoginQuery) OnlyX(ctx context.Context) *WechatScanLogin {
+	node, err := wslq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WechatScanLogin ID in the query.
+// Returns a *NotSingularError when more than one WechatScanLogin ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wslq *WechatScanLoginQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wslq.Limit(2).IDs(setContextOp(ctx, wslq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{wechatscanlogin.Label}
+	default:
+		err = &NotSingularError{wechatscanlogin.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wslq *WechatScanLoginQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wslq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WechatScanLogins.
+func (wslq *WechatScanLoginQuery) All(ctx context.Context) ([]*WechatScanLogin, error) {
+	ctx = setContextOp(ctx, wslq.ctx, ent.OpQueryAll)
+	if err := wslq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WechatScanLogin, *WechatScanLoginQuery]()
+	return withInterceptors[[]*WechatScanLogin](ctx, wslq, qr, wslq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wslq *WechatScanLoginQuery) AllX(ctx context.Context) []*WechatScanLogin {
+	nodes, err := wslq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WechatScanLogin IDs.
+func (wslq *WechatScanLoginQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wslq.ctx.Unique == nil && wslq.path != nil {
+		wslq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wslq.ctx, ent.OpQueryIDs)
+	if err = wslq.Select(wechatscanlogin.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wslq *WechatScanLoginQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wslq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wslq *WechatScanLoginQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wslq.ctx, ent.OpQueryCount)
+	if err := wslq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wslq, querierCount[*WechatScanLoginQuery](), wslq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wslq *WechatScanLoginQuery) CountX(ctx context.Context) int {
+	count, err := wslq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wslq *WechatScanLoginQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wslq.ctx, ent.OpQueryExist)
+	switch _, err := wslq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wslq *WechatScanLoginQuery) ExistX(ctx context.Context) bool {
+	exist, err := wslq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WechatScanLoginQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wslq *WechatScanLoginQuery) Clone() *WechatScanLoginQuery {
+	if wslq == nil {
+		return nil
+	}
+	return &WechatScanLoginQuery{
+		config:     wslq.config,
+		ctx:        wslq.ctx.Clone(),
+		order:      append([]wechatscanlogin.OrderOption{}, wslq.order...),
+		inters:     append([]Interceptor{}, wslq.inters...),
+		predicates: append([]predicate.WechatScanLogin{}, wslq.predicates...),
+		// clone intermediate query.
+		sql:  wslq.sql.Clone(),
+		path: wslq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WechatScanLogin.Query().
+//		GroupBy(wechatscanlogin.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wslq *WechatScanLoginQuery) GroupBy(field string, fields ...string) *WechatScanLoginGroupBy {
+	wslq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WechatScanLoginGroupBy{build: wslq}
+	grbuild.flds = &wslq.ctx.Fields
+	grbuild.label = wechatscanlogin.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.WechatScanLogin.Query().
+//		Select(wechatscanlogin.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (wslq *WechatScanLoginQuery) Select(fields ...string) *WechatScanLoginSelect {
+	wslq.ctx.Fields = append(wslq.ctx.Fields, fields...)
+	sbuild := &WechatScanLoginSelect{WechatScanLoginQuery: wslq}
+	sbuild.label = wechatscanlogin.Label
+	sbuild.flds, sbuild.scan = &wslq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WechatScanLoginSelect configured with the given aggregations.
+func (wslq *WechatScanLoginQuery) Aggregate(fns ...AggregateFunc) *WechatScanLoginSelect {
+	return wslq.Select().Aggregate(fns...)
+}
+
+func (wslq *WechatScanLoginQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wslq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wslq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wslq.ctx.Fields {
+		if !wechatscanlogin.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wslq.path != nil {
+		prev, err := wslq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wslq.sql = prev
+	}
+	return nil
+}
+
+func (wslq *WechatScanLoginQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WechatScanLogin, error) {
+	var (
+		nodes = []*WechatScanLogin{}
+		_spec = wslq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WechatScanLogin).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WechatScanLogin{config: wslq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(wslq.modifiers) > 0 {
+		_spec.Modifiers = wslq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wslq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wslq *WechatScanLoginQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wslq.querySpec()
+	if len(wslq.modifiers) > 0 {
+		_spec.Modifiers = wslq.modifiers
+	}
+	_spec.Node.Columns = wslq.ctx.Fields
+	if len(wslq.ctx.Fields) > 0 {
+		_spec.Unique = wslq.ctx.Unique != nil && *wslq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wslq.driver, _spec)
+}
+
+func (wslq *WechatScanLoginQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(wechatscanlogin.Table, wechatscanlogin.Columns, sqlgraph.NewFieldSpec(wechatscanlogin.FieldID, field.TypeUUID))
+	_spec.From = wslq.sql
+	if unique := wslq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wslq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wslq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wechatscanlogin.FieldID)
+		for i := range fields {
+			if fields[i] != wechatscanlogin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wslq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wslq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wslq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wslq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wslq *WechatScanLoginQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wslq.driver.Dialect())
+	t1 := builder.Table(wechatscanlogin.Table)
+	columns := wslq.ctx.Fields
+	if len(columns) == 0 {
+		columns = wechatscanlogin.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wslq.sql != nil {
+		selector = wslq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wslq.ctx.Unique != nil && *wslq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range wslq.modifiers {
+		m(selector)
+	}
+	for _, p := range wslq.predicates {
+		p(selector)
+	}
+	for _, p := range wslq.order {
+		p(selector)
+	}
+	if offset := wslq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wslq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wslq *WechatScanLoginQuery) ForUpdate(opts ...sql.LockOption) *WechatScanLoginQuery {
+	if wslq.driver.Dialect() == dialect.Postgres {
+		wslq.Unique(false)
+	}
+	wslq.modifiers = append(wslq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wslq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wslq *WechatScanLoginQuery) ForShare(opts ...sql.LockOption) *WechatScanLoginQuery {
+	if wslq.driver.Dialect() == dialect.Postgres {
+		wslq.Unique(false)
+	}
+	wslq.modifiers = append(wslq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wslq
+}
+
+// WechatScanLoginGroupBy is the group-by builder for WechatScanLogin entities.
+type WechatScanLoginGroupBy struct {
+	selector
+	build *WechatScanLoginQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wslgb *WechatScanLoginGroupBy) Aggregate(fns ...AggregateFunc) *WechatScanLoginGroupBy {
+	wslgb.fns = append(wslgb.fns, fns...)
+	return wslgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wslgb *WechatScanLoginGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wslgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wslgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WechatScanLoginQuery, *WechatScanLoginGroupBy](ctx, wslgb.build, wslgb, wslgb.build.inters, v)
+}
+
+func (wslgb *WechatScanLoginGroupBy) sqlScan(ctx context.Context, root *WechatScanLoginQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wslgb.fns))
+	for _, fn := range wslgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wslgb.flds)+len(wslgb.fns))
+		for _, f := range *wslgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wslgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wslgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WechatScanLoginSelect is the builder for selecting fields of WechatScanLogin entities.
+type WechatScanLoginSelect struct {
+	*WechatScanLoginQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wsls *WechatScanLoginSelect) Aggregate(fns ...AggregateFunc) *WechatScanLoginSelect {
+	wsls.fns = append(wsls.fns, fns...)
+	return wsls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wsls *WechatScanLoginSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wsls.ctx, ent.OpQuerySelect)
+	if err := wsls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WechatScanLoginQuery, *WechatScanLoginSelect](ctx, wsls.WechatScanLoginQuery, wsls, wsls.inters, v)
+}
+
+func (wsls *WechatScanLoginSelect) sqlScan(ctx context.Context, root *WechatScanLoginQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wsls.fns))
+	for _, fn := range wsls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wsls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wsls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,497 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kiwi-user/internal/infrastructure/repository/ent/predicate"
+	"kiwi-user/internal/infrastructure/repository/ent/wechatscanlogin"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WechatScanLoginUpdate is the builder for updating WechatScanLogin entities.
+type WechatScanLoginUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WechatScanLoginMutation
+}
+
+// Where appends a list predicates to the WechatScanLoginUpdate builder.
+func (wslu *WechatScanLoginUpdate) Where(ps ...predicate.WechatScanLogin) *WechatScanLoginUpdate {
+	wslu.mutation.Where(ps...)
+	return wslu
+}
+
+// SetApplicationID sets the "application_id" field.
+func (wslu *WechatScanLoginUpdate) SetApplicationID(u uuid.UUID) *WechatScanLoginUpdate {
+	wslu.mutation.SetApplicationID(u)
+	return wslu
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (wslu *WechatScanLoginUpdate) SetNillableApplicationID(u *uuid.UUID) *WechatScanLoginUpdate {
+	if u != nil {
+		wslu.SetApplicationID(*u)
+	}
+	return wslu
+}
+
+// SetDeviceType sets the "device_type" field.
+func (wslu *WechatScanLoginUpdate) SetDeviceType(s string) *WechatScanLoginUpdate {
+	wslu.mutation.SetDeviceType(s)
+	return wslu
+}
+
+// SetNillableDeviceType sets the "device_type" field if the given value is not nil.
+func (wslu *WechatScanLoginUpdate) SetNillableDeviceType(s *string) *WechatScanLoginUpdate {
+	if s != nil {
+		wslu.SetDeviceType(*s)
+	}
+	return wslu
+}
+
+// SetDeviceID sets the "device_id" field.
+func (wslu *WechatScanLoginUpdate) SetDeviceID(s string) *WechatScanLoginUpdate {
+	wslu.mutation.SetDeviceID(s)
+	return wslu
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (wslu *WechatScanLoginUpdate) SetNillableDeviceID(s *string) *WechatScanLoginUpdate {
+	if s != nil {
+		wslu.SetDeviceID(*s)
+	}
+	return wslu
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (wslu *WechatScanLoginUpdate) SetPollTokenHash(s string) *WechatScanLoginUpdate {
+	wslu.mutation.SetPollTokenHash(s)
+	return wslu
+}
+
+// SetNillablePollTokenHash sets the "poll_token_hash" field if the given value is not nil.
+func (wslu *WechatScanLoginUpdate) SetNillablePollTokenHash(s *string) *WechatScanLoginUpdate {
+	if s != nil {
+		wslu.SetPollTokenHash(*s)
+	}
+	return wslu
+}
+
+// SetOpenID sets the "open_id" field.
+func (wslu *WechatScanLoginUpdate) SetOpenID(s string) *WechatScanLoginUpdate {
+	wslu.mutation.SetOpenID(s)
+	return wslu
+}
+
+// SetNillableOpenID sets the "open_id" field if the given value is not nil.
+func (wslu *WechatScanLoginUpdate) SetNillableOpenID(s *string) *WechatScanLoginUpdate {
+	if s != nil {
+		wslu.SetOpenID(*s)
+	}
+	return wslu
+}
+
+// ClearOpenID clears the value of the "open_id" field.
+func (wslu *WechatScanLoginUpdate) ClearOpenID() *WechatScanLoginUpdate {
+	wslu.mutation.ClearOpenID()
+	return wslu
+}
+
+// SetUnionID sets the "union_id" field.
+func (wslu *WechatScanLoginUpdate) SetUnionID(s string) *WechatScanLoginUpdate {
+	wslu.mutation.SetUnionID(s)
+	return wslu
+}
+
+// SetNillableUnionID sets the "union_id" field if the given value is not nil.
+func (wslu *WechatScanLoginUpdate) SetNillableUnionID(s *string) *WechatScanLoginUpdate {
+	if s != nil {
+		wslu.SetUnionID(*s)
+	}
+	return wslu
+}
+
+// ClearUnionID clears the value of the "union_id" field.
+func (wslu *WechatScanLoginUpdate) ClearUnionID() *WechatScanLoginUpdate {
+	wslu.mutation.ClearUnionID()
+	return wslu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wslu *WechatScanLoginUpdate) SetExpiresAt(t time.Time) *WechatScanLoginUpdate {
+	wslu.mutation.SetExpiresAt(t)
+	return wslu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (wslu *WechatScanLoginUpdate) SetNillableExpiresAt(t *time.Time) *WechatScanLoginUpdate {
+	if t != nil {
+		wslu.SetExpiresAt(*t)
+	}
+	return wslu
+}
+
+// Mutation returns the WechatScanLoginMutation object of the builder.
+func (wslu *WechatScanLoginUpdate) Mutation() *WechatScanLoginMutation {
+	return wslu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wslu *WechatScanLoginUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wslu.sqlSave, wslu.mutation, wslu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wslu *WechatScanLoginUpdate) SaveX(ctx context.Context) int {
+	affected, err := wslu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wslu *WechatScanLoginUpdate) Exec(ctx context.Context) error {
+	_, err := wslu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wslu *WechatScanLoginUpdate) ExecX(ctx context.Context) {
+	if err := wslu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wslu *WechatScanLoginUpdate) check() error {
+	if v, ok := wslu.mutation.DeviceType(); ok {
+		if err := wechatscanlogin.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "WechatScanLogin.device_type": %w`, err)}
+		}
+	}
+	if v, ok := wslu.mutation.DeviceID(); ok {
+		if err := wechatscanlogin.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "WechatScanLogin.device_id": %w`, err)}
+		}
+	}
+	if v, ok := wslu.mutation.PollTokenHash(); ok {
+		if err := wechatscanlogin.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "WechatScanLogin.poll_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wslu *WechatScanLoginUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wslu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wechatscanlogin.Table, wechatscanlogin.Columns, sqlgraph.NewFieldSpec(wechatscanlogin.FieldID, field.TypeUUID))
+	if ps := wslu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wslu.mutation.ApplicationID(); ok {
+		_spec.SetField(wechatscanlogin.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := wslu.mutation.DeviceType(); ok {
+		_spec.SetField(wechatscanlogin.FieldDeviceType, field.TypeString, value)
+	}
+	if value, ok := wslu.mutation.DeviceID(); ok {
+		_spec.SetField(wechatscanlogin.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := wslu.mutation.PollTokenHash(); ok {
+		_spec.SetField(wechatscanlogin.FieldPollTokenHash, field.TypeString, value)
+	}
+	if value, ok := wslu.mutation.OpenID(); ok {
+		_spec.SetField(wechatscanlogin.FieldOpenID, field.TypeString, value)
+	}
+	if wslu.mutation.OpenIDCleared() {
+		_spec.ClearField(wechatscanlogin.FieldOpenID, field.TypeString)
+	}
+	if value, ok := wslu.mutation.UnionID(); ok {
+		_spec.SetField(wechatscanlogin.FieldUnionID, field.TypeString, value)
+	}
+	if wslu.mutation.UnionIDCleared() {
+		_spec.ClearField(wechatscanlogin.FieldUnionID, field.TypeString)
+	}
+	if value, ok := wslu.mutation.ExpiresAt(); ok {
+		_spec.SetField(wechatscanlogin.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wslu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wechatscanlogin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wslu.mutation.done = true
+	return n, nil
+}
+
+// WechatScanLoginUpdateOne is the builder for updating a single WechatScanLogin entity.
+type WechatScanLoginUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WechatScanLoginMutation
+}
+
+// SetApplicationID sets the "application_id" field.
+func (wsluo *WechatScanLoginUpdateOne) SetApplicationID(u uuid.UUID) *WechatScanLoginUpdateOne {
+	wsluo.mutation.SetApplicationID(u)
+	return wsluo
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (wsluo *WechatScanLoginUpdateOne) SetNillableApplicationID(u *uuid.UUID) *WechatScanLoginUpdateOne {
+	if u != nil {
+		wsluo.SetApplicationID(*u)
+	}
+	return wsluo
+}
+
+// SetDeviceType sets the "device_type" field.
+func (wsluo *WechatScanLoginUpdateOne) SetDeviceType(s string) *WechatScanLoginUpdateOne {
+	wsluo.mutation.SetDeviceType(s)
+	return wsluo
+}
+
+// SetNillableDeviceType sets the "device_type" field if the given value is not nil.
+func (wsluo *WechatScanLoginUpdateOne) SetNillableDeviceType(s *string) *WechatScanLoginUpdateOne {
+	if s != nil {
+		wsluo.SetDeviceType(*s)
+	}
+	return wsluo
+}
+
+// SetDeviceID sets the "device_id" field.
+func (wsluo *WechatScanLoginUpdateOne) SetDeviceID(s string) *WechatScanLoginUpdateOne {
+	wsluo.mutation.SetDeviceID(s)
+	return wsluo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (wsluo *WechatScanLoginUpdateOne) SetNillableDeviceID(s *string) *WechatScanLoginUpdateOne {
+	if s != nil {
+		wsluo.SetDeviceID(*s)
+	}
+	return wsluo
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (wsluo *WechatScanLoginUpdateOne) SetPollTokenHash(s string) *WechatScanLoginUpdateOne {
+	wsluo.mutation.SetPollTokenHash(s)
+	return wsluo
+}
+
+// SetNillablePollTokenHash sets the "poll_token_hash" field if the given value is not nil.
+func (wsluo *WechatScanLoginUpdateOne) SetNillablePollTokenHash(s *string) *WechatScanLoginUpdateOne {
+	if s != nil {
+		wsluo.SetPollTokenHash(*s)
+	}
+	return wsluo
+}
+
+// SetOpenID sets the "open_id" field.
+func (wsluo *WechatScanLoginUpdateOne) SetOpenID(s string) *WechatScanLoginUpdateOne {
+	wsluo.mutation.SetOpenID(s)
+	return wsluo
+}
+
+// SetNillableOpenID sets the "open_id" field if the given value is not nil.
+func (wsluo *WechatScanLoginUpdateOne) SetNillableOpenID(s *string) *WechatScanLoginUpdateOne {
+	if s != nil {
+		wsluo.SetOpenID(*s)
+	}
+	return wsluo
+}
+
+// ClearOpenID clears the value of the "open_id" field.
+func (wsluo *WechatScanLoginUpdateOne) ClearOpenID() *WechatScanLoginUpdateOne {
+	wsluo.mutation.ClearOpenID()
+	return wsluo
+}
+
+// SetUnionID sets the "union_id" field.
+func (wsluo *WechatScanLoginUpdateOne) SetUnionID(s string) *WechatScanLoginUpdateOne {
+	wsluo.mutation.SetUnionID(s)
+	return wsluo
+}
+
+// SetNillableUnionID sets the "union_id" field if the given value is not nil.
+func (wsluo *WechatScanLoginUpdateOne) SetNillableUnionID(s *string) *WechatScanLoginUpdateOne {
+	if s != nil {
+		wsluo.SetUnionID(*s)
+	}
+	return wsluo
+}
+
+// ClearUnionID clears the value of the "union_id" field.
+func (wsluo *WechatScanLoginUpdateOne) ClearUnionID() *WechatScanLoginUpdateOne {
+	wsluo.mutation.ClearUnionID()
+	return wsluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wsluo *WechatScanLoginUpdateOne) SetExpiresAt(t time.Time) *WechatScanLoginUpdateOne {
+	wsluo.mutation.SetExpiresAt(t)
+	return wsluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (wsluo *WechatScanLoginUpdateOne) SetNillableExpiresAt(t *time.Time) *WechatScanLoginUpdateOne {
+	if t != nil {
+		wsluo.SetExpiresAt(*t)
+	}
+	return wsluo
+}
+
+// Mutation returns the WechatScanLoginMutation object of the builder.
+func (wsluo *WechatScanLoginUpdateOne) Mutation() *WechatScanLoginMutation {
+	return wsluo.mutation
+}
+
+// Where appends a list predicates to the WechatScanLoginUpdate builder.
+func (wsluo *WechatScanLoginUpdateOne) Where(ps ...predicate.WechatScanLogin) *WechatScanLoginUpdateOne {
+	wsluo.mutation.Where(ps...)
+	return wsluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsluo *WechatScanLoginUpdateOne) Select(field string, fields ...string) *WechatScanLoginUpdateOne {
+	wsluo.fields = append([]string{field}, fields...)
+	return wsluo
+}
+
+// Save executes the query and returns the updated WechatScanLogin entity.
+func (wsluo *WechatScanLoginUpdateOne) Save(ctx context.Context) (*WechatScanLogin, error) {
+	return withHooks(ctx, wsluo.sqlSave, wsluo.mutation, wsluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsluo *WechatScanLoginUpdateOne) SaveX(ctx context.Context) *WechatScanLogin {
+	node, err := wsluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsluo *WechatScanLoginUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsluo *WechatScanLoginUpdateOne) ExecX(ctx context.Context) {
+	if err := wsluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsluo *WechatScanLoginUpdateOne) check() error {
+	if v, ok := wsluo.mutation.DeviceType(); ok {
+		if err := wechatscanlogin.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "WechatScanLogin.device_type": %w`, err)}
+		}
+	}
+	if v, ok := wsluo.mutation.DeviceID(); ok {
+		if err := wechatscanlogin.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "WechatScanLogin.device_id": %w`, err)}
+		}
+	}
+	if v, ok := wsluo.mutation.PollTokenHash(); ok {
+		if err := wechatscanlogin.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "WechatScanLogin.poll_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wsluo *WechatScanLoginUpdateOne) sqlSave(ctx context.Context) (_node *WechatScanLogin, err error) {
+	if err := wsluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wechatscanlogin.Table, wechatscanlogin.Columns, sqlgraph.NewFieldSpec(wechatscanlogin.FieldID, field.TypeUUID))
+	id, ok := wsluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WechatScanLogin.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wechatscanlogin.FieldID)
+		for _, f := range fields {
+			if !wechatscanlogin.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wechatscanlogin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsluo.mutation.ApplicationID(); ok {
+		_spec.SetField(wechatscanlogin.FieldApplicationID, field.TypeUUID, value)
+	}
+	if value, ok := wsluo.mutation.DeviceType(); ok {
+		_spec.SetField(wechatscanlogin.FieldDeviceType, field.TypeString, value)
+	}
+	if value, ok := wsluo.mutation.DeviceID(); ok {
+		_spec.SetField(wechatscanlogin.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := wsluo.mutation.PollTokenHash(); ok {
+		_spec.SetField(wechatscanlogin.FieldPollTokenHash, field.TypeString, value)
+	}
+	if value, ok := wsluo.mutation.OpenID(); ok {
+		_spec.SetField(wechatscanlogin.FieldOpenID, field.TypeString, value)
+	}
+	if wsluo.mutation.OpenIDCleared() {
+		_spec.ClearField(wechatscanlogin.FieldOpenID, field.TypeString)
+	}
+	if value, ok := wsluo.mutation.UnionID(); ok {
+		_spec.SetField(wechatscanlogin.FieldUnionID, field.TypeString, value)
+	}
+	if wsluo.mutation.UnionIDCleared() {
+		_spec.ClearField(wechatscanlogin.FieldUnionID, field.TypeString)
+	}
+	if value, ok := wsluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(wechatscanlogin.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &WechatScanLogin{config: wsluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wechatscanlogin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wsluo.mutation.done = true
+	return _node, nil
+}
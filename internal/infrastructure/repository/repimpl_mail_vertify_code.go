@@ -14,7 +14,21 @@ type mailVertifyCodeImpl struct {
 }
 
 func (m *mailVertifyCodeImpl) Find(ctx context.Context, email string, codetype enum.VertificationCodeType) (*entity.MailVertifyCodeEntity, error) {
-	mailVertifyCode, err := m.db.MailVertifyCode.Query().Where(mailvertifycode.EmailEQ(email), mailvertifycode.TypeEQ(mailvertifycode.Type(codetype.String()))).Only(ctx)
+	return m.find(ctx, email, codetype, false)
+}
+
+func (m *mailVertifyCodeImpl) FindForUpdate(ctx context.Context, email string, codetype enum.VertificationCodeType) (*entity.MailVertifyCodeEntity, error) {
+	return m.find(ctx, email, codetype, true)
+}
+
+func (m *mailVertifyCodeImpl) find(ctx context.Context, email string, codetype enum.VertificationCodeType, forUpdate bool) (*entity.MailVertifyCodeEntity, error) {
+	query := m.getEntClient(ctx).MailVertifyCode.Query().Where(mailvertifycode.EmailEQ(email), mailvertifycode.TypeEQ(mailvertifycode.Type(codetype.String())))
+
+	if forUpdate {
+		query = query.ForUpdate()
+	}
+
+	mailVertifyCode, err := query.Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, nil
@@ -24,34 +38,37 @@ func (m *mailVertifyCodeImpl) Find(ctx context.Context, email string, codetype e
 	return &entity.MailVertifyCodeEntity{
 		ID:        mailVertifyCode.ID,
 		Email:     mailVertifyCode.Email,
-		Code:      mailVertifyCode.Code,
+		CodeHash:  mailVertifyCode.CodeHash,
 		Type:      enum.ParseVertificationCodeType(mailVertifyCode.Type.String()),
+		Attempts:  mailVertifyCode.Attempts,
 		ExpiresAt: mailVertifyCode.ExpiresAt,
 	}, nil
 }
 
 func (m *mailVertifyCodeImpl) Create(ctx context.Context, mailVertifyCode *entity.MailVertifyCodeEntity) error {
-	_, err := m.db.MailVertifyCode.Create().
+	_, err := m.getEntClient(ctx).MailVertifyCode.Create().
 		SetEmail(mailVertifyCode.Email).
-		SetCode(mailVertifyCode.Code).
+		SetCodeHash(mailVertifyCode.CodeHash).
 		SetType(mailvertifycode.Type(mailVertifyCode.Type.String())).
+		SetAttempts(mailVertifyCode.Attempts).
 		SetExpiresAt(mailVertifyCode.ExpiresAt).
 		Save(ctx)
 	return err
 }
 
 func (m *mailVertifyCodeImpl) Update(ctx context.Context, mailVertifyCode *entity.MailVertifyCodeEntity) error {
-	_, err := m.db.MailVertifyCode.UpdateOneID(mailVertifyCode.ID).
+	_, err := m.getEntClient(ctx).MailVertifyCode.UpdateOneID(mailVertifyCode.ID).
 		SetEmail(mailVertifyCode.Email).
-		SetCode(mailVertifyCode.Code).
+		SetCodeHash(mailVertifyCode.CodeHash).
 		SetType(mailvertifycode.Type(mailVertifyCode.Type.String())).
+		SetAttempts(mailVertifyCode.Attempts).
 		SetExpiresAt(mailVertifyCode.ExpiresAt).
 		Save(ctx)
 	return err
 }
 
 func (m *mailVertifyCodeImpl) Delete(ctx context.Context, mailVertifyCode *entity.MailVertifyCodeEntity) error {
-	return m.db.MailVertifyCode.DeleteOneID(mailVertifyCode.ID).Exec(ctx)
+	return m.getEntClient(ctx).MailVertifyCode.DeleteOneID(mailVertifyCode.ID).Exec(ctx)
 }
 
 func NewMailVertifyCodeImpl(client *Client) contract.IMailVertifyCodeRepository {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"kiwi-user/internal/domain/contract"
 	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
 	"kiwi-user/internal/infrastructure/repository/ent"
 	"kiwi-user/internal/infrastructure/repository/ent/application"
 
@@ -232,6 +233,22 @@ func (a *applicationImpl) Update(ctx context.Context, applicationAggregate *aggr
 	}, nil
 }
 
+// UpdateOAuthClient implements contract.IApplicationRepository.
+func (a *applicationImpl) UpdateOAuthClient(ctx context.Context, applicationEntity *entity.ApplicationEntity) (*entity.ApplicationEntity, error) {
+	db := a.getEntClient(ctx)
+
+	applicationDO, err := db.Application.UpdateOneID(applicationEntity.ID).
+		SetClientSecretHash(applicationEntity.ClientSecretHash).
+		SetRedirectUris(applicationEntity.RedirectURIs).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertApplicationDOToEntity(applicationDO), nil
+}
+
 func NewApplicationImpl(db *Client) contract.IApplicationRepository {
 	return &applicationImpl{
 		baseImpl: baseImpl{
@@ -4,16 +4,59 @@ import (
 	"context"
 	"kiwi-user/internal/domain/contract"
 	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
 	"kiwi-user/internal/infrastructure/repository/ent"
 	"kiwi-user/internal/infrastructure/repository/ent/device"
+	"kiwi-user/internal/infrastructure/repository/ent/rotatedrefreshtoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
 
 	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
 )
 
 type deviceImpl struct {
 	baseImpl
 }
 
+func (d *deviceImpl) Find(ctx context.Context, id int64) (*aggregate.DeviceAggregate, error) {
+	return d.find(ctx, id, false)
+}
+
+func (d *deviceImpl) FindForUpdate(ctx context.Context, id int64) (*aggregate.DeviceAggregate, error) {
+	return d.find(ctx, id, true)
+}
+
+func (d *deviceImpl) find(ctx context.Context, id int64, forUpdate bool) (*aggregate.DeviceAggregate, error) {
+	db := d.getEntClient(ctx)
+
+	query := db.Device.Query().Where(device.ID(id))
+	if forUpdate {
+		query = query.ForUpdate()
+	}
+
+	deviceDO, err := query.Only(ctx)
+
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, xerror.Wrap(err)
+	}
+
+	if deviceDO == nil {
+		return nil, nil
+	}
+
+	userDO, err := deviceDO.QueryUser().Only(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return &aggregate.DeviceAggregate{
+		Device: convertDeviceDOToEntity(deviceDO),
+		User:   convertUserDOToEntity(userDO),
+	}, nil
+}
+
 func (d *deviceImpl) FindByDevice(ctx context.Context, userID string, deviceType, deviceID string) (*aggregate.DeviceAggregate, error) {
 	db := d.getEntClient(ctx)
 
@@ -38,26 +81,82 @@ func (d *deviceImpl) FindByDevice(ctx context.Context, userID string, deviceType
 	}, nil
 }
 
-func (d *deviceImpl) FindByRefreshToken(ctx context.Context, refreshToken string) (*aggregate.DeviceAggregate, error) {
-	panic("not implemented")
+func (d *deviceImpl) FindByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*aggregate.DeviceAggregate, error) {
+	db := d.getEntClient(ctx)
+
+	deviceDO, err := db.Device.Query().Where(device.RefreshTokenHash(refreshTokenHash)).Only(ctx)
+
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, xerror.Wrap(err)
+	}
+
+	if deviceDO == nil {
+		return nil, nil
+	}
+
+	userDO, err := deviceDO.QueryUser().Only(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return &aggregate.DeviceAggregate{
+		Device: convertDeviceDOToEntity(deviceDO),
+		User:   convertUserDOToEntity(userDO),
+	}, nil
+}
+
+// FindActiveByUserID devices of the user with a refresh token not yet expired, latest used first
+func (d *deviceImpl) FindActiveByUserID(ctx context.Context, userID string) ([]*entity.DeviceEntity, error) {
+	db := d.getEntClient(ctx)
+
+	deviceDOs, err := db.Device.Query().
+		Where(device.UserID(userID), device.RefreshTokenExpiresAtGT(time.Now())).
+		Order(
+			device.ByLastRefreshedAt(sql.OrderDesc(), sql.OrderNullsLast()),
+			device.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	devices := make([]*entity.DeviceEntity, 0, len(deviceDOs))
+	for _, deviceDO := range deviceDOs {
+		devices = append(devices, convertDeviceDOToEntity(deviceDO))
+	}
+
+	return devices, nil
 }
 
 func (d *deviceImpl) Create(ctx context.Context, device *aggregate.DeviceAggregate) (*aggregate.DeviceAggregate, error) {
 	db := d.getEntClient(ctx)
 
-	deviceDO, err := db.Device.Create().
+	createQuery := db.Device.Create().
 		SetDeviceType(device.Device.DeviceType).
 		SetDeviceID(device.Device.DeviceID).
-		SetRefreshToken(device.Device.RefreshToken).
+		SetRefreshTokenHash(device.Device.RefreshTokenHash).
 		SetRefreshTokenExpiresAt(device.Device.RefreshTokenExpiresAt).
-		SetUserID(device.User.ID).
-		Save(ctx)
+		SetRefreshTokenFamily(device.Device.RefreshTokenFamily).
+		SetLastIP(device.Device.LastIP).
+		SetUserAgent(device.Device.UserAgent).
+		SetLastRefreshedAt(device.Device.LastRefreshedAt).
+		SetOauthScope(device.Device.OAuthScope).
+		SetUserID(device.User.ID)
+
+	// the first login of a device into an organization keeps it, its tokens are issued for it
+	if device.Device.OrganizationID != uuid.Nil {
+		createQuery = createQuery.SetOrganizationID(device.Device.OrganizationID)
+	}
+
+	deviceDO, err := createQuery.Save(ctx)
 
 	if err != nil {
 		return nil, xerror.Wrap(err)
 	}
 
+	// the issued token is not stored, keep it for the response
+	refreshToken := device.Device.RefreshToken
 	device.Device = convertDeviceDOToEntity(deviceDO)
+	device.Device.RefreshToken = refreshToken
 
 	return device, nil
 }
@@ -66,8 +165,14 @@ func (d *deviceImpl) Update(ctx context.Context, device *aggregate.DeviceAggrega
 	db := d.getEntClient(ctx)
 
 	deviceDO, err := db.Device.UpdateOneID(device.Device.ID).
-		SetRefreshToken(device.Device.RefreshToken).
+		SetRefreshTokenHash(device.Device.RefreshTokenHash).
+		ClearRefreshToken().
 		SetRefreshTokenExpiresAt(device.Device.RefreshTokenExpiresAt).
+		SetRefreshTokenFamily(device.Device.RefreshTokenFamily).
+		SetLastIP(device.Device.LastIP).
+		SetUserAgent(device.Device.UserAgent).
+		SetLastRefreshedAt(device.Device.LastRefreshedAt).
+		SetOauthScope(device.Device.OAuthScope).
 		SetUserID(device.User.ID).
 		SetOrganizationID(device.Device.OrganizationID).
 		Save(ctx)
@@ -76,11 +181,88 @@ func (d *deviceImpl) Update(ctx context.Context, device *aggregate.DeviceAggrega
 		return nil, xerror.Wrap(err)
 	}
 
+	// the issued token is not stored, keep it for the response
+	refreshToken := device.Device.RefreshToken
 	device.Device = convertDeviceDOToEntity(deviceDO)
+	device.Device.RefreshToken = refreshToken
 
 	return device, nil
 }
 
+// RevokeByUserID expires the refresh token of every device of the user
+func (d *deviceImpl) RevokeByUserID(ctx context.Context, userID string) error {
+	db := d.getEntClient(ctx)
+
+	now := time.Now()
+	if _, err := db.Device.Update().
+		Where(device.UserID(userID), device.RefreshTokenExpiresAtGT(now)).
+		SetRefreshTokenExpiresAt(now).
+		Save(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// RevokeOthersByUserID expires the refresh token of every device of the user but one
+func (d *deviceImpl) RevokeOthersByUserID(ctx context.Context, userID string, exceptID int64) error {
+	db := d.getEntClient(ctx)
+
+	now := time.Now()
+	if _, err := db.Device.Update().
+		Where(device.UserID(userID), device.IDNEQ(exceptID), device.RefreshTokenExpiresAtGT(now)).
+		SetRefreshTokenExpiresAt(now).
+		Save(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// HashLegacyRefreshTokens migrate devices by batch, each batch in its own transaction
+func (d *deviceImpl) HashLegacyRefreshTokens(ctx context.Context, hash func(refreshToken string) string) (int, error) {
+	const batchSize = 500
+
+	total := 0
+	for {
+		migrated := 0
+
+		if err := d.WithTransaction(ctx, func(ctx context.Context) error {
+			db := d.getEntClient(ctx)
+
+			deviceDOs, err := db.Device.Query().
+				Where(device.RefreshTokenNotNil()).
+				Limit(batchSize).
+				ForUpdate().
+				All(ctx)
+			if err != nil {
+				return xerror.Wrap(err)
+			}
+
+			for _, deviceDO := range deviceDOs {
+				update := db.Device.UpdateOneID(deviceDO.ID).ClearRefreshToken()
+				if deviceDO.RefreshToken != "" {
+					update.SetRefreshTokenHash(hash(deviceDO.RefreshToken))
+				}
+
+				if err := update.Exec(ctx); err != nil {
+					return xerror.Wrap(err)
+				}
+			}
+
+			migrated = len(deviceDOs)
+			return nil
+		}); err != nil {
+			return total, xerror.Wrap(err)
+		}
+
+		total += migrated
+		if migrated < batchSize {
+			return total, nil
+		}
+	}
+}
+
 func NewDeviceImpl(db *Client) contract.IDeviceRepository {
 	return &deviceImpl{
 		baseImpl{
@@ -88,3 +270,75 @@ func NewDeviceImpl(db *Client) contract.IDeviceRepository {
 		},
 	}
 }
+
+type rotatedRefreshTokenImpl struct {
+	baseImpl
+}
+
+func (r *rotatedRefreshTokenImpl) FindByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*entity.RotatedRefreshTokenEntity, error) {
+	db := r.getEntClient(ctx)
+
+	tokenDO, err := db.RotatedRefreshToken.Query().
+		Where(rotatedrefreshtoken.RefreshTokenHash(refreshTokenHash)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertRotatedRefreshTokenDOToEntity(tokenDO), nil
+}
+
+func (r *rotatedRefreshTokenImpl) Create(ctx context.Context, token *entity.RotatedRefreshTokenEntity) (*entity.RotatedRefreshTokenEntity, error) {
+	db := r.getEntClient(ctx)
+
+	tokenDO, err := db.RotatedRefreshToken.Create().
+		SetRefreshTokenHash(token.RefreshTokenHash).
+		SetFamily(token.Family).
+		SetDeviceID(token.DeviceID).
+		SetRotatedAt(token.RotatedAt).
+		SetSuccessor(token.Successor).
+		SetExpiresAt(token.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertRotatedRefreshTokenDOToEntity(tokenDO), nil
+}
+
+func (r *rotatedRefreshTokenImpl) DeleteByFamily(ctx context.Context, family uuid.UUID) error {
+	db := r.getEntClient(ctx)
+
+	if _, err := db.RotatedRefreshToken.Delete().
+		Where(rotatedrefreshtoken.Family(family)).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *rotatedRefreshTokenImpl) DeleteExpired(ctx context.Context) error {
+	db := r.getEntClient(ctx)
+
+	if _, err := db.RotatedRefreshToken.Delete().
+		Where(rotatedrefreshtoken.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewRotatedRefreshTokenImpl(db *Client) contract.IRotatedRefreshTokenRepository {
+	return &rotatedRefreshTokenImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
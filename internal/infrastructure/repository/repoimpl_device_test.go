@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/model/aggregate"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent/enttest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	return &Client{Client: client}
+}
+
+func TestDeviceCreateOrganization(t *testing.T) {
+	ctx := context.Background()
+	db := newTestClient(t)
+
+	applicationDO, err := db.Application.Create().SetName("application").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userDO, err := db.User.Create().
+		SetID("user-1").
+		SetApplicationID(applicationDO.ID).
+		SetName("user").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := NewDeviceImpl(db)
+	organizationID := uuid.New()
+
+	tests := []struct {
+		name           string
+		deviceID       string
+		organizationID uuid.UUID
+	}{
+		{name: "organization", deviceID: "device-1", organizationID: organizationID},
+		{name: "no organization", deviceID: "device-2", organizationID: uuid.Nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := repository.Create(ctx, &aggregate.DeviceAggregate{
+				Device: &entity.DeviceEntity{
+					DeviceType:            "web",
+					DeviceID:              tt.deviceID,
+					RefreshTokenHash:      "hash-" + tt.deviceID,
+					RefreshTokenExpiresAt: time.Now().Add(time.Hour),
+					LastRefreshedAt:       time.Now(),
+					OrganizationID:        tt.organizationID,
+				},
+				User: &entity.UserEntity{ID: userDO.ID},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			found, err := repository.Find(ctx, created.Device.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if found.Device.OrganizationID != tt.organizationID {
+				t.Fatalf("organization %s, want %s", found.Device.OrganizationID, tt.organizationID)
+			}
+		})
+	}
+}
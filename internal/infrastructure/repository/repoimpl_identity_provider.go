@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/identityprovider"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type identityProviderImpl struct {
+	baseImpl
+}
+
+func (i *identityProviderImpl) Find(ctx context.Context, id uuid.UUID) (*entity.IdentityProviderEntity, error) {
+	db := i.getEntClient(ctx)
+
+	providerDO, err := db.IdentityProvider.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertIdentityProviderDOToEntity(providerDO), nil
+}
+
+func (i *identityProviderImpl) FindByName(ctx context.Context, applicationID uuid.UUID, name string) (*entity.IdentityProviderEntity, error) {
+	db := i.getEntClient(ctx)
+
+	providerDO, err := db.IdentityProvider.Query().
+		Where(
+			identityprovider.ApplicationID(applicationID),
+			identityprovider.Name(name),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertIdentityProviderDOToEntity(providerDO), nil
+}
+
+func (i *identityProviderImpl) FindByApplicationID(ctx context.Context, applicationID uuid.UUID) ([]*entity.IdentityProviderEntity, error) {
+	db := i.getEntClient(ctx)
+
+	providerDOs, err := db.IdentityProvider.Query().
+		Where(identityprovider.ApplicationID(applicationID)).
+		Order(ent.Asc(identityprovider.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	providers := make([]*entity.IdentityProviderEntity, 0, len(providerDOs))
+	for _, providerDO := range providerDOs {
+		providers = append(providers, convertIdentityProviderDOToEntity(providerDO))
+	}
+
+	return providers, nil
+}
+
+func (i *identityProviderImpl) Create(ctx context.Context, provider *entity.IdentityProviderEntity) (*entity.IdentityProviderEntity, error) {
+	db := i.getEntClient(ctx)
+
+	providerDO, err := db.IdentityProvider.Create().
+		SetApplicationID(provider.ApplicationID).
+		SetName(provider.Name).
+		SetDisplayName(provider.DisplayName).
+		SetIssuer(provider.Issuer).
+		SetClientID(provider.ClientID).
+		SetClientSecret(provider.ClientSecret).
+		SetScopes(provider.Scopes).
+		SetAuthorizationEndpoint(provider.AuthorizationEndpoint).
+		SetTokenEndpoint(provider.TokenEndpoint).
+		SetUserinfoEndpoint(provider.UserinfoEndpoint).
+		SetJwksURI(provider.JWKSURI).
+		SetClaimMapping(provider.ClaimMapping).
+		SetEnabled(provider.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertIdentityProviderDOToEntity(providerDO), nil
+}
+
+func (i *identityProviderImpl) Update(ctx context.Context, provider *entity.IdentityProviderEntity) (*entity.IdentityProviderEntity, error) {
+	db := i.getEntClient(ctx)
+
+	providerDO, err := db.IdentityProvider.UpdateOneID(provider.ID).
+		SetDisplayName(provider.DisplayName).
+		SetIssuer(provider.Issuer).
+		SetClientID(provider.ClientID).
+		SetClientSecret(provider.ClientSecret).
+		SetScopes(provider.Scopes).
+		SetAuthorizationEndpoint(provider.AuthorizationEndpoint).
+		SetTokenEndpoint(provider.TokenEndpoint).
+		SetUserinfoEndpoint(provider.UserinfoEndpoint).
+		SetJwksURI(provider.JWKSURI).
+		SetClaimMapping(provider.ClaimMapping).
+		SetEnabled(provider.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertIdentityProviderDOToEntity(providerDO), nil
+}
+
+func (i *identityProviderImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	db := i.getEntClient(ctx)
+
+	if err := db.IdentityProvider.DeleteOneID(id).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewIdentityProviderImpl(db *Client) contract.IIdentityProviderRepository {
+	return &identityProviderImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
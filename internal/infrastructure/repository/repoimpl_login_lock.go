@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/loginlock"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type loginLockImpl struct {
+	baseImpl
+}
+
+func (l *loginLockImpl) Find(ctx context.Context, id uuid.UUID) (*entity.LoginLockEntity, error) {
+	db := l.getEntClient(ctx)
+
+	lockDO, err := db.LoginLock.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertLoginLockDOToEntity(lockDO), nil
+}
+
+func (l *loginLockImpl) FindBySubject(ctx context.Context, applicationName string, scope enum.LoginLockScope, subject string) (*entity.LoginLockEntity, error) {
+	return l.findBySubject(ctx, applicationName, scope, subject, false)
+}
+
+func (l *loginLockImpl) FindBySubjectForUpdate(ctx context.Context, applicationName string, scope enum.LoginLockScope, subject string) (*entity.LoginLockEntity, error) {
+	return l.findBySubject(ctx, applicationName, scope, subject, true)
+}
+
+func (l *loginLockImpl) findBySubject(ctx context.Context, applicationName string, scope enum.LoginLockScope, subject string, forUpdate bool) (*entity.LoginLockEntity, error) {
+	db := l.getEntClient(ctx)
+
+	query := db.LoginLock.Query().
+		Where(
+			loginlock.ApplicationName(applicationName),
+			loginlock.ScopeEQ(loginlock.Scope(scope)),
+			loginlock.Subject(subject),
+		)
+
+	if forUpdate {
+		query = query.ForUpdate()
+	}
+
+	lockDO, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertLoginLockDOToEntity(lockDO), nil
+}
+
+func (l *loginLockImpl) FindLocked(ctx context.Context, applicationName string, at time.Time) ([]*entity.LoginLockEntity, error) {
+	db := l.getEntClient(ctx)
+
+	query := db.LoginLock.Query().
+		Where(loginlock.LockedUntilGT(at))
+
+	if applicationName != "" {
+		query = query.Where(loginlock.ApplicationName(applicationName))
+	}
+
+	lockDOs, err := query.
+		Order(ent.Desc(loginlock.FieldLastFailureAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	locks := make([]*entity.LoginLockEntity, 0, len(lockDOs))
+	for _, lockDO := range lockDOs {
+		locks = append(locks, convertLoginLockDOToEntity(lockDO))
+	}
+
+	return locks, nil
+}
+
+func (l *loginLockImpl) Create(ctx context.Context, lock *entity.LoginLockEntity) (*entity.LoginLockEntity, error) {
+	db := l.getEntClient(ctx)
+
+	create := db.LoginLock.Create().
+		SetApplicationName(lock.ApplicationName).
+		SetScope(loginlock.Scope(lock.Scope)).
+		SetSubject(lock.Subject).
+		SetFailures(lock.Failures).
+		SetLastFailureAt(lock.LastFailureAt)
+
+	if !lock.NextAttemptAt.IsZero() {
+		create.SetNextAttemptAt(lock.NextAttemptAt)
+	}
+
+	if !lock.LockedUntil.IsZero() {
+		create.SetLockedUntil(lock.LockedUntil)
+	}
+
+	lockDO, err := create.Save(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertLoginLockDOToEntity(lockDO), nil
+}
+
+func (l *loginLockImpl) Update(ctx context.Context, lock *entity.LoginLockEntity) (*entity.LoginLockEntity, error) {
+	db := l.getEntClient(ctx)
+
+	update := db.LoginLock.UpdateOneID(lock.ID).
+		SetFailures(lock.Failures).
+		SetLastFailureAt(lock.LastFailureAt)
+
+	if lock.NextAttemptAt.IsZero() {
+		update.ClearNextAttemptAt()
+	} else {
+		update.SetNextAttemptAt(lock.NextAttemptAt)
+	}
+
+	if lock.LockedUntil.IsZero() {
+		update.ClearLockedUntil()
+	} else {
+		update.SetLockedUntil(lock.LockedUntil)
+	}
+
+	lockDO, err := update.Save(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertLoginLockDOToEntity(lockDO), nil
+}
+
+func (l *loginLockImpl) Delete(ctx context.Context, lock *entity.LoginLockEntity) error {
+	db := l.getEntClient(ctx)
+
+	if err := db.LoginLock.DeleteOneID(lock.ID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewLoginLockImpl(db *Client) contract.ILoginLockRepository {
+	return &loginLockImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
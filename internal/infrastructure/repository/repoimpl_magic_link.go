@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/magiclink"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type magicLinkImpl struct {
+	baseImpl
+}
+
+func (m *magicLinkImpl) Find(ctx context.Context, id uuid.UUID) (*entity.MagicLinkEntity, error) {
+	db := m.getEntClient(ctx)
+
+	linkDO, err := db.MagicLink.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertMagicLinkDOToEntity(linkDO), nil
+}
+
+func (m *magicLinkImpl) FindForUpdate(ctx context.Context, id uuid.UUID) (*entity.MagicLinkEntity, error) {
+	db := m.getEntClient(ctx)
+
+	linkDO, err := db.MagicLink.Query().
+		Where(magiclink.ID(id)).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertMagicLinkDOToEntity(linkDO), nil
+}
+
+func (m *magicLinkImpl) Create(ctx context.Context, link *entity.MagicLinkEntity) (*entity.MagicLinkEntity, error) {
+	db := m.getEntClient(ctx)
+
+	linkDO, err := db.MagicLink.Create().
+		SetApplicationID(link.ApplicationID).
+		SetEmail(link.Email).
+		SetDeviceType(link.DeviceType).
+		SetDeviceID(link.DeviceID).
+		SetPollTokenHash(link.PollTokenHash).
+		SetClientIP(link.ClientIP).
+		SetUserAgent(link.UserAgent).
+		SetExpiresAt(link.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertMagicLinkDOToEntity(linkDO), nil
+}
+
+func (m *magicLinkImpl) Confirm(ctx context.Context, link *entity.MagicLinkEntity) error {
+	db := m.getEntClient(ctx)
+
+	if err := db.MagicLink.UpdateOneID(link.ID).
+		SetConfirmed(true).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (m *magicLinkImpl) Delete(ctx context.Context, link *entity.MagicLinkEntity) error {
+	db := m.getEntClient(ctx)
+
+	if err := db.MagicLink.DeleteOneID(link.ID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (m *magicLinkImpl) DeleteExpired(ctx context.Context) error {
+	db := m.getEntClient(ctx)
+
+	if _, err := db.MagicLink.Delete().
+		Where(magiclink.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewMagicLinkImpl(db *Client) contract.IMagicLinkRepository {
+	return &magicLinkImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthauthorizationcode"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+type oauthAuthorizationCodeImpl struct {
+	baseImpl
+}
+
+func (o *oauthAuthorizationCodeImpl) FindByCodeHashForUpdate(ctx context.Context, codeHash string) (*entity.OAuthAuthorizationCodeEntity, error) {
+	db := o.getEntClient(ctx)
+
+	codeDO, err := db.OAuthAuthorizationCode.Query().
+		Where(oauthauthorizationcode.CodeHash(codeHash)).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertOAuthAuthorizationCodeDOToEntity(codeDO), nil
+}
+
+func (o *oauthAuthorizationCodeImpl) Create(ctx context.Context, code *entity.OAuthAuthorizationCodeEntity) (*entity.OAuthAuthorizationCodeEntity, error) {
+	db := o.getEntClient(ctx)
+
+	codeDO, err := db.OAuthAuthorizationCode.Create().
+		SetCodeHash(code.CodeHash).
+		SetApplicationID(code.ApplicationID).
+		SetUserID(code.UserID).
+		SetRedirectURI(code.RedirectURI).
+		SetScope(code.Scope).
+		SetNonce(code.Nonce).
+		SetCodeChallenge(code.CodeChallenge).
+		SetCodeChallengeMethod(code.CodeChallengeMethod).
+		SetExpiresAt(code.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertOAuthAuthorizationCodeDOToEntity(codeDO), nil
+}
+
+func (o *oauthAuthorizationCodeImpl) Delete(ctx context.Context, code *entity.OAuthAuthorizationCodeEntity) error {
+	db := o.getEntClient(ctx)
+
+	if err := db.OAuthAuthorizationCode.DeleteOneID(code.ID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (o *oauthAuthorizationCodeImpl) DeleteExpired(ctx context.Context) error {
+	db := o.getEntClient(ctx)
+
+	if _, err := db.OAuthAuthorizationCode.Delete().
+		Where(oauthauthorizationcode.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewOAuthAuthorizationCodeImpl(db *Client) contract.IOAuthAuthorizationCodeRepository {
+	return &oauthAuthorizationCodeImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/oauthconsent"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type oauthConsentImpl struct {
+	baseImpl
+}
+
+func (o *oauthConsentImpl) Find(ctx context.Context, userID string, applicationID uuid.UUID) (*entity.OAuthConsentEntity, error) {
+	db := o.getEntClient(ctx)
+
+	consentDO, err := db.OAuthConsent.Query().
+		Where(
+			oauthconsent.UserID(userID),
+			oauthconsent.ApplicationID(applicationID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertOAuthConsentDOToEntity(consentDO), nil
+}
+
+func (o *oauthConsentImpl) Create(ctx context.Context, consent *entity.OAuthConsentEntity) (*entity.OAuthConsentEntity, error) {
+	db := o.getEntClient(ctx)
+
+	consentDO, err := db.OAuthConsent.Create().
+		SetApplicationID(consent.ApplicationID).
+		SetUserID(consent.UserID).
+		SetScope(consent.Scope).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertOAuthConsentDOToEntity(consentDO), nil
+}
+
+func (o *oauthConsentImpl) Update(ctx context.Context, consent *entity.OAuthConsentEntity) (*entity.OAuthConsentEntity, error) {
+	db := o.getEntClient(ctx)
+
+	consentDO, err := db.OAuthConsent.UpdateOneID(consent.ID).
+		SetScope(consent.Scope).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertOAuthConsentDOToEntity(consentDO), nil
+}
+
+func NewOAuthConsentImpl(db *Client) contract.IOAuthConsentRepository {
+	return &oauthConsentImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
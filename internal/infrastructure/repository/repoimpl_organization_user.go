@@ -59,6 +59,7 @@ func (u *organizationUserImpl) Find(ctx context.Context, userID string, organiza
 		Application:      convertApplicationDOToEntity(applicationDO),
 		User:             convertUserDOToEntity(userDO),
 		OrganizationRole: convertRoleDOToEntity(roleDO),
+		Department:       organizationUserDO.Department,
 	}
 
 	return organization, nil
@@ -102,6 +103,7 @@ func (u *organizationUserImpl) FindAll(ctx context.Context, userID string) ([]*a
 			Application:      convertApplicationDOToEntity(applicationDO),
 			User:             convertUserDOToEntity(userDO),
 			OrganizationRole: convertRoleDOToEntity(roleDO),
+			Department:       ou.Department,
 		}
 
 		organizations = append(organizations, organization)
@@ -147,6 +149,7 @@ func (u *organizationUserImpl) FindByOrganizationID(ctx context.Context, organiz
 			Application:      convertApplicationDOToEntity(applicationDO),
 			User:             convertUserDOToEntity(userDO),
 			OrganizationRole: convertRoleDOToEntity(roleDO),
+			Department:       ou.Department,
 		})
 	}
 
@@ -163,6 +166,7 @@ func (u *organizationUserImpl) Update(
 			organizationuser.HasOrganizationWith(organization.ID(organizationUserAggregate.Organization.ID)),
 			organizationuser.HasUserWith(user.ID(organizationUserAggregate.User.ID))).
 		SetRoleID(organizationUserAggregate.OrganizationRole.ID).
+		SetDepartment(organizationUserAggregate.Department).
 		Save(ctx)
 
 	if err != nil {
@@ -181,6 +185,7 @@ func (u *organizationUserImpl) Create(
 		SetOrganizationID(organizationUserAggregate.Organization.ID).
 		SetUserID(organizationUserAggregate.User.ID).
 		SetRoleID(organizationUserAggregate.OrganizationRole.ID).
+		SetDepartment(organizationUserAggregate.Department).
 		Save(ctx)
 
 	if err != nil {
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
+	"kiwi-user/internal/infrastructure/repository/ent/webauthnchallenge"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type passkeyCredentialImpl struct {
+	baseImpl
+}
+
+func (p *passkeyCredentialImpl) Find(ctx context.Context, id uuid.UUID) (*entity.PasskeyCredentialEntity, error) {
+	db := p.getEntClient(ctx)
+
+	credentialDO, err := db.PasskeyCredential.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertPasskeyCredentialDOToEntity(credentialDO), nil
+}
+
+func (p *passkeyCredentialImpl) FindByCredentialIDForUpdate(ctx context.Context, credentialID string) (*entity.PasskeyCredentialEntity, error) {
+	db := p.getEntClient(ctx)
+
+	credentialDO, err := db.PasskeyCredential.Query().
+		Where(passkeycredential.CredentialID(credentialID)).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertPasskeyCredentialDOToEntity(credentialDO), nil
+}
+
+func (p *passkeyCredentialImpl) FindByUserID(ctx context.Context, userID string) ([]*entity.PasskeyCredentialEntity, error) {
+	db := p.getEntClient(ctx)
+
+	credentialDOs, err := db.PasskeyCredential.Query().
+		Where(passkeycredential.UserID(userID)).
+		Order(ent.Asc(passkeycredential.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	credentials := make([]*entity.PasskeyCredentialEntity, 0, len(credentialDOs))
+	for _, credentialDO := range credentialDOs {
+		credentials = append(credentials, convertPasskeyCredentialDOToEntity(credentialDO))
+	}
+
+	return credentials, nil
+}
+
+func (p *passkeyCredentialImpl) Create(ctx context.Context, credential *entity.PasskeyCredentialEntity) (*entity.PasskeyCredentialEntity, error) {
+	db := p.getEntClient(ctx)
+
+	credentialDO, err := db.PasskeyCredential.Create().
+		SetUserID(credential.UserID).
+		SetCredentialID(credential.CredentialID).
+		SetPublicKey(credential.PublicKey).
+		SetSignCount(credential.SignCount).
+		SetTransports(credential.Transports).
+		SetAaguid(credential.AAGUID).
+		SetName(credential.Name).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertPasskeyCredentialDOToEntity(credentialDO), nil
+}
+
+func (p *passkeyCredentialImpl) Update(ctx context.Context, credential *entity.PasskeyCredentialEntity) (*entity.PasskeyCredentialEntity, error) {
+	db := p.getEntClient(ctx)
+
+	update := db.PasskeyCredential.UpdateOneID(credential.ID).
+		SetSignCount(credential.SignCount).
+		SetName(credential.Name)
+
+	if !credential.LastUsedAt.IsZero() {
+		update.SetLastUsedAt(credential.LastUsedAt)
+	}
+
+	credentialDO, err := update.Save(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertPasskeyCredentialDOToEntity(credentialDO), nil
+}
+
+func (p *passkeyCredentialImpl) Delete(ctx context.Context, credential *entity.PasskeyCredentialEntity) error {
+	db := p.getEntClient(ctx)
+
+	if err := db.PasskeyCredential.DeleteOneID(credential.ID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewPasskeyCredentialImpl(db *Client) contract.IPasskeyCredentialRepository {
+	return &passkeyCredentialImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
+
+type webAuthnChallengeImpl struct {
+	baseImpl
+}
+
+func (w *webAuthnChallengeImpl) FindByChallengeForUpdate(ctx context.Context, challenge string) (*entity.WebAuthnChallengeEntity, error) {
+	db := w.getEntClient(ctx)
+
+	challengeDO, err := db.WebAuthnChallenge.Query().
+		Where(webauthnchallenge.Challenge(challenge)).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertWebAuthnChallengeDOToEntity(challengeDO), nil
+}
+
+func (w *webAuthnChallengeImpl) Create(ctx context.Context, challenge *entity.WebAuthnChallengeEntity) (*entity.WebAuthnChallengeEntity, error) {
+	db := w.getEntClient(ctx)
+
+	challengeDO, err := db.WebAuthnChallenge.Create().
+		SetType(webauthnchallenge.Type(challenge.Type)).
+		SetChallenge(challenge.Challenge).
+		SetApplicationID(challenge.ApplicationID).
+		SetUserID(challenge.UserID).
+		SetExpiresAt(challenge.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertWebAuthnChallengeDOToEntity(challengeDO), nil
+}
+
+func (w *webAuthnChallengeImpl) Delete(ctx context.Context, challenge *entity.WebAuthnChallengeEntity) error {
+	db := w.getEntClient(ctx)
+
+	if err := db.WebAuthnChallenge.DeleteOneID(challenge.ID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (w *webAuthnChallengeImpl) DeleteExpired(ctx context.Context) error {
+	db := w.getEntClient(ctx)
+
+	if _, err := db.WebAuthnChallenge.Delete().
+		Where(webauthnchallenge.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewWebAuthnChallengeImpl(db *Client) contract.IWebAuthnChallengeRepository {
+	return &webAuthnChallengeImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
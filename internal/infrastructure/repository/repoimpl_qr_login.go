@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/qrlogin"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type qrLoginImpl struct {
+	baseImpl
+}
+
+func (q *qrLoginImpl) FindForUpdate(ctx context.Context, id uuid.UUID) (*entity.QRLoginEntity, error) {
+	db := q.getEntClient(ctx)
+
+	loginDO, err := db.QRLogin.Query().
+		Where(qrlogin.ID(id)).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertQRLoginDOToEntity(loginDO), nil
+}
+
+func (q *qrLoginImpl) Create(ctx context.Context, login *entity.QRLoginEntity) (*entity.QRLoginEntity, error) {
+	db := q.getEntClient(ctx)
+
+	loginDO, err := db.QRLogin.Create().
+		SetApplicationID(login.ApplicationID).
+		SetDeviceType(login.DeviceType).
+		SetDeviceID(login.DeviceID).
+		SetPollTokenHash(login.PollTokenHash).
+		SetClientIP(login.ClientIP).
+		SetUserAgent(login.UserAgent).
+		SetStatus(qrlogin.Status(login.Status)).
+		SetUserID(login.UserID).
+		SetExpiresAt(login.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertQRLoginDOToEntity(loginDO), nil
+}
+
+func (q *qrLoginImpl) Update(ctx context.Context, login *entity.QRLoginEntity) error {
+	db := q.getEntClient(ctx)
+
+	if err := db.QRLogin.UpdateOneID(login.ID).
+		SetStatus(qrlogin.Status(login.Status)).
+		SetUserID(login.UserID).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (q *qrLoginImpl) Delete(ctx context.Context, login *entity.QRLoginEntity) error {
+	db := q.getEntClient(ctx)
+
+	if err := db.QRLogin.DeleteOneID(login.ID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (q *qrLoginImpl) DeleteExpired(ctx context.Context) error {
+	db := q.getEntClient(ctx)
+
+	if _, err := db.QRLogin.Delete().
+		Where(qrlogin.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewQRLoginImpl(db *Client) contract.IQRLoginRepository {
+	return &qrLoginImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
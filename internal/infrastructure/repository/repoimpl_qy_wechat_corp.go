@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatcorp"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type qyWechatCorpImpl struct {
+	baseImpl
+}
+
+func (q *qyWechatCorpImpl) FindByCorpID(ctx context.Context, corpID string) (*entity.QyWechatCorpEntity, error) {
+	db := q.getEntClient(ctx)
+
+	corpDO, err := db.QyWechatCorp.Query().
+		Where(qywechatcorp.CorpID(corpID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertQyWechatCorpDOToEntity(corpDO), nil
+}
+
+func (q *qyWechatCorpImpl) FindByOrganizationID(ctx context.Context, organizationID uuid.UUID) (*entity.QyWechatCorpEntity, error) {
+	db := q.getEntClient(ctx)
+
+	corpDO, err := db.QyWechatCorp.Query().
+		Where(qywechatcorp.OrganizationID(organizationID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertQyWechatCorpDOToEntity(corpDO), nil
+}
+
+func (q *qyWechatCorpImpl) Create(ctx context.Context, corp *entity.QyWechatCorpEntity) (*entity.QyWechatCorpEntity, error) {
+	db := q.getEntClient(ctx)
+
+	corpDO, err := db.QyWechatCorp.Create().
+		SetOrganizationID(corp.OrganizationID).
+		SetCorpID(corp.CorpID).
+		SetCorpSecret(corp.CorpSecret).
+		SetEnabled(corp.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertQyWechatCorpDOToEntity(corpDO), nil
+}
+
+func (q *qyWechatCorpImpl) Update(ctx context.Context, corp *entity.QyWechatCorpEntity) (*entity.QyWechatCorpEntity, error) {
+	db := q.getEntClient(ctx)
+
+	corpDO, err := db.QyWechatCorp.UpdateOneID(corp.ID).
+		SetCorpID(corp.CorpID).
+		SetCorpSecret(corp.CorpSecret).
+		SetEnabled(corp.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertQyWechatCorpDOToEntity(corpDO), nil
+}
+
+func (q *qyWechatCorpImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	db := q.getEntClient(ctx)
+
+	if err := db.QyWechatCorp.DeleteOneID(id).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewQyWechatCorpImpl(db *Client) contract.IQyWechatCorpRepository {
+	return &qyWechatCorpImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/samlconnection"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type samlConnectionImpl struct {
+	baseImpl
+}
+
+func (s *samlConnectionImpl) FindByOrganizationID(ctx context.Context, organizationID uuid.UUID) (*entity.SAMLConnectionEntity, error) {
+	db := s.getEntClient(ctx)
+
+	connectionDO, err := db.SAMLConnection.Query().
+		Where(samlconnection.OrganizationID(organizationID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertSAMLConnectionDOToEntity(connectionDO), nil
+}
+
+func (s *samlConnectionImpl) Create(ctx context.Context, connection *entity.SAMLConnectionEntity) (*entity.SAMLConnectionEntity, error) {
+	db := s.getEntClient(ctx)
+
+	connectionDO, err := db.SAMLConnection.Create().
+		SetOrganizationID(connection.OrganizationID).
+		SetIdpEntityID(connection.IdPEntityID).
+		SetIdpSSOURL(connection.IdPSSOURL).
+		SetIdpCertificates(connection.IdPCertificates).
+		SetIdpMetadata(connection.IdPMetadata).
+		SetAttributeMapping(connection.AttributeMapping).
+		SetRedirectURL(connection.RedirectURL).
+		SetEnabled(connection.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertSAMLConnectionDOToEntity(connectionDO), nil
+}
+
+func (s *samlConnectionImpl) Update(ctx context.Context, connection *entity.SAMLConnectionEntity) (*entity.SAMLConnectionEntity, error) {
+	db := s.getEntClient(ctx)
+
+	connectionDO, err := db.SAMLConnection.UpdateOneID(connection.ID).
+		SetIdpEntityID(connection.IdPEntityID).
+		SetIdpSSOURL(connection.IdPSSOURL).
+		SetIdpCertificates(connection.IdPCertificates).
+		SetIdpMetadata(connection.IdPMetadata).
+		SetAttributeMapping(connection.AttributeMapping).
+		SetRedirectURL(connection.RedirectURL).
+		SetEnabled(connection.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertSAMLConnectionDOToEntity(connectionDO), nil
+}
+
+func (s *samlConnectionImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	db := s.getEntClient(ctx)
+
+	if err := db.SAMLConnection.DeleteOneID(id).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewSAMLConnectionImpl(db *Client) contract.ISAMLConnectionRepository {
+	return &samlConnectionImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
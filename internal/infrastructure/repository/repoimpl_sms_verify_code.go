@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/domain/model/enum"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/smsverifycode"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+type smsVerifyCodeImpl struct {
+	baseImpl
+}
+
+func (s *smsVerifyCodeImpl) Find(ctx context.Context, phone string, codetype enum.VertificationCodeType) (*entity.SmsVerifyCodeEntity, error) {
+	return s.find(ctx, phone, codetype, false)
+}
+
+func (s *smsVerifyCodeImpl) FindForUpdate(ctx context.Context, phone string, codetype enum.VertificationCodeType) (*entity.SmsVerifyCodeEntity, error) {
+	return s.find(ctx, phone, codetype, true)
+}
+
+func (s *smsVerifyCodeImpl) find(ctx context.Context, phone string, codetype enum.VertificationCodeType, forUpdate bool) (*entity.SmsVerifyCodeEntity, error) {
+	query := s.getEntClient(ctx).SmsVerifyCode.Query().
+		Where(
+			smsverifycode.Phone(phone),
+			smsverifycode.TypeEQ(smsverifycode.Type(codetype.String())),
+		)
+
+	if forUpdate {
+		query = query.ForUpdate()
+	}
+
+	codeDO, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertSmsVerifyCodeDOToEntity(codeDO), nil
+}
+
+func (s *smsVerifyCodeImpl) Create(ctx context.Context, smsVerifyCode *entity.SmsVerifyCodeEntity) error {
+	_, err := s.getEntClient(ctx).SmsVerifyCode.Create().
+		SetPhone(smsVerifyCode.Phone).
+		SetCodeHash(smsVerifyCode.CodeHash).
+		SetType(smsverifycode.Type(smsVerifyCode.Type.String())).
+		SetAttempts(smsVerifyCode.Attempts).
+		SetExpiresAt(smsVerifyCode.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (s *smsVerifyCodeImpl) Update(ctx context.Context, smsVerifyCode *entity.SmsVerifyCodeEntity) error {
+	_, err := s.getEntClient(ctx).SmsVerifyCode.UpdateOneID(smsVerifyCode.ID).
+		SetCodeHash(smsVerifyCode.CodeHash).
+		SetAttempts(smsVerifyCode.Attempts).
+		SetExpiresAt(smsVerifyCode.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (s *smsVerifyCodeImpl) Delete(ctx context.Context, smsVerifyCode *entity.SmsVerifyCodeEntity) error {
+	if err := s.getEntClient(ctx).SmsVerifyCode.DeleteOneID(smsVerifyCode.ID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewSmsVerifyCodeImpl(db *Client) contract.ISmsVerifyCodeRepository {
+	return &smsVerifyCodeImpl{
+		baseImpl: baseImpl{db: db},
+	}
+}
@@ -9,8 +9,13 @@ import (
 	"kiwi-user/internal/infrastructure/repository/ent"
 	"kiwi-user/internal/infrastructure/repository/ent/application"
 	"kiwi-user/internal/infrastructure/repository/ent/binding"
+	"kiwi-user/internal/infrastructure/repository/ent/device"
+	"kiwi-user/internal/infrastructure/repository/ent/organizationuser"
+	"kiwi-user/internal/infrastructure/repository/ent/passkeycredential"
+	"kiwi-user/internal/infrastructure/repository/ent/qywechatuserid"
 	"kiwi-user/internal/infrastructure/repository/ent/user"
 	"kiwi-user/internal/infrastructure/repository/ent/wechatopenid"
+	"time"
 
 	"github.com/bwmarrin/snowflake"
 	"github.com/futurxlab/golanggraph/xerror"
@@ -240,12 +245,19 @@ func (u *userImpl) Update(ctx context.Context, user *aggregate.UserAggregate) (*
 	// TODO: handle binding delete
 	for _, bindingEntity := range user.Bindings {
 		if bindingEntity.ID == uuid.Nil {
-			bindingDO, err := db.Binding.Create().
+			createQuery := db.Binding.Create()
+			// logins look bindings up by application
+			if user.Application != nil {
+				createQuery = createQuery.SetApplicationID(user.Application.ID)
+			}
+
+			bindingDO, err := createQuery.
 				SetType(binding.Type(bindingEntity.Type)).
 				SetIdentity(bindingEntity.Identity).
 				SetEmail(bindingEntity.Email).
 				SetVerified(bindingEntity.Verified).
 				SetSalt(bindingEntity.Salt).
+				SetSecret(bindingEntity.Secret).
 				SetUserID(user.User.ID).
 				Save(ctx)
 
@@ -260,6 +272,7 @@ func (u *userImpl) Update(ctx context.Context, user *aggregate.UserAggregate) (*
 				SetEmail(bindingEntity.Email).
 				SetVerified(bindingEntity.Verified).
 				SetSalt(bindingEntity.Salt).
+				SetSecret(bindingEntity.Secret).
 				Save(ctx); err != nil {
 				return nil, xerror.Wrap(err)
 			}
@@ -301,7 +314,8 @@ func (u *userImpl) Update(ctx context.Context, user *aggregate.UserAggregate) (*
 	query := db.User.UpdateOneID(user.User.ID).
 		SetDisplayName(user.User.DisplayName).
 		SetAvatar(user.User.Avatar).
-		SetDepartment(user.User.Department)
+		SetDepartment(user.User.Department).
+		SetBanned(user.User.Banned)
 
 	if user.PersonalRole != nil {
 		query = query.SetPersonalRoleID(user.PersonalRole.ID)
@@ -364,6 +378,7 @@ func (u *userImpl) Create(ctx context.Context, user *aggregate.UserAggregate) (*
 			SetEmail(bindingEntity.Email).
 			SetVerified(bindingEntity.Verified).
 			SetSalt(bindingEntity.Salt).
+			SetSecret(bindingEntity.Secret).
 			SetUser(userDO).
 			Save(ctx)
 
@@ -418,8 +433,71 @@ func (u *userImpl) Create(ctx context.Context, user *aggregate.UserAggregate) (*
 	}, nil
 }
 
+func (u *userImpl) DeleteBinding(ctx context.Context, bindingID uuid.UUID) error {
+	db := u.getEntClient(ctx)
+
+	if err := db.Binding.DeleteOneID(bindingID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+// Delete removes the user with its bindings, sessions and accounts. Payments are kept, a user with
+// payments can not be deleted.
 func (u *userImpl) Delete(ctx context.Context, user *aggregate.UserAggregate) error {
-	panic("not implemented")
+	db := u.getEntClient(ctx)
+
+	userID := user.User.ID
+
+	if _, err := db.Binding.Delete().Where(binding.UserID(userID)).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if _, err := db.Device.Delete().Where(device.UserID(userID)).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if _, err := db.PasskeyCredential.Delete().Where(passkeycredential.UserID(userID)).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if _, err := db.WechatOpenID.Delete().Where(wechatopenid.UserID(userID)).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if _, err := db.QyWechatUserID.Delete().Where(qywechatuserid.UserID(userID)).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if _, err := db.OrganizationUser.Delete().Where(organizationuser.UserID(userID)).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if err := db.User.DeleteOneID(userID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (u *userImpl) FindGuestIDs(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	db := u.getEntClient(ctx)
+
+	ids, err := db.User.Query().
+		Where(
+			user.CreatedAtLT(createdBefore),
+			user.HasBindingsWith(binding.TypeEQ(binding.TypeGuest)),
+			user.Not(user.HasPayments()),
+		).
+		Order(ent.Asc(user.FieldCreatedAt)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return ids, nil
 }
 
 func (u *userImpl) FindWechatOpenIDByUserAndPlatform(ctx context.Context, userID string, platform string) (*entity.WechatOpenIDEntity, error) {
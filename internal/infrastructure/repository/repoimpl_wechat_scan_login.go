@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"kiwi-user/internal/domain/contract"
+	"kiwi-user/internal/domain/model/entity"
+	"kiwi-user/internal/infrastructure/repository/ent"
+	"kiwi-user/internal/infrastructure/repository/ent/wechatscanlogin"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+	"github.com/google/uuid"
+)
+
+type wechatScanLoginImpl struct {
+	baseImpl
+}
+
+func (w *wechatScanLoginImpl) FindForUpdate(ctx context.Context, id uuid.UUID) (*entity.WechatScanLoginEntity, error) {
+	db := w.getEntClient(ctx)
+
+	loginDO, err := db.WechatScanLogin.Query().
+		Where(wechatscanlogin.ID(id)).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertWechatScanLoginDOToEntity(loginDO), nil
+}
+
+func (w *wechatScanLoginImpl) Create(ctx context.Context, login *entity.WechatScanLoginEntity) (*entity.WechatScanLoginEntity, error) {
+	db := w.getEntClient(ctx)
+
+	loginDO, err := db.WechatScanLogin.Create().
+		SetApplicationID(login.ApplicationID).
+		SetDeviceType(login.DeviceType).
+		SetDeviceID(login.DeviceID).
+		SetPollTokenHash(login.PollTokenHash).
+		SetExpiresAt(login.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return convertWechatScanLoginDOToEntity(loginDO), nil
+}
+
+func (w *wechatScanLoginImpl) Update(ctx context.Context, login *entity.WechatScanLoginEntity) error {
+	db := w.getEntClient(ctx)
+
+	if err := db.WechatScanLogin.UpdateOneID(login.ID).
+		SetOpenID(login.OpenID).
+		SetUnionID(login.UnionID).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (w *wechatScanLoginImpl) Delete(ctx context.Context, login *entity.WechatScanLoginEntity) error {
+	db := w.getEntClient(ctx)
+
+	if err := db.WechatScanLogin.DeleteOneID(login.ID).Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func (w *wechatScanLoginImpl) DeleteExpired(ctx context.Context) error {
+	db := w.getEntClient(ctx)
+
+	if _, err := db.WechatScanLogin.Delete().
+		Where(wechatscanlogin.ExpiresAtLT(time.Now())).
+		Exec(ctx); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
+
+func NewWechatScanLoginImpl(db *Client) contract.IWechatScanLoginRepository {
+	return &wechatScanLoginImpl{
+		baseImpl: baseImpl{
+			db: db,
+		},
+	}
+}
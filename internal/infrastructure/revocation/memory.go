@@ -0,0 +1,121 @@
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type deviceKey struct {
+	userID     string
+	deviceType string
+	deviceID   string
+}
+
+// memoryStore fallback without redis, entries are dropped once they no longer revoke anything
+type memoryStore struct {
+	mu                  sync.Mutex
+	tokens              map[string]time.Time
+	users               map[string]time.Time
+	devices             map[deviceKey]time.Time
+	accessTokenLifetime time.Duration
+	lastPurge           time.Time
+}
+
+func newMemoryStore(accessTokenLifetime time.Duration) *memoryStore {
+	return &memoryStore{
+		tokens:              make(map[string]time.Time),
+		users:               make(map[string]time.Time),
+		devices:             make(map[deviceKey]time.Time),
+		accessTokenLifetime: accessTokenLifetime,
+	}
+}
+
+func (s *memoryStore) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if jti == "" || !expiresAt.After(time.Now()) {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purge()
+	s.tokens[jti] = expiresAt
+
+	return nil
+}
+
+func (s *memoryStore) RevokeUser(ctx context.Context, userID string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purge()
+	if at.After(s.users[userID]) {
+		s.users[userID] = at
+	}
+
+	return nil
+}
+
+func (s *memoryStore) RevokeDevice(ctx context.Context, userID string, deviceType string, deviceID string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purge()
+	key := deviceKey{userID: userID, deviceType: deviceType, deviceID: deviceID}
+	if at.After(s.devices[key]) {
+		s.devices[key] = at
+	}
+
+	return nil
+}
+
+func (s *memoryStore) IsRevoked(ctx context.Context, jti string, userID string, deviceType string, deviceID string, issuedAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if jti != "" {
+		if expiresAt, ok := s.tokens[jti]; ok && expiresAt.After(time.Now()) {
+			return true, nil
+		}
+	}
+
+	// a token issued right after the revocation, like the one of the login that follows it, stays valid
+	if revokedAt, ok := s.users[userID]; ok && issuedAt.Before(revokedAt.Truncate(time.Millisecond)) {
+		return true, nil
+	}
+
+	key := deviceKey{userID: userID, deviceType: deviceType, deviceID: deviceID}
+	if revokedAt, ok := s.devices[key]; ok && issuedAt.Before(revokedAt.Truncate(time.Millisecond)) {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// purge runs at most once a minute, callers hold the lock
+func (s *memoryStore) purge() {
+	now := time.Now()
+	if now.Sub(s.lastPurge) < time.Minute {
+		return
+	}
+	s.lastPurge = now
+
+	for jti, expiresAt := range s.tokens {
+		if !expiresAt.After(now) {
+			delete(s.tokens, jti)
+		}
+	}
+
+	for userID, revokedAt := range s.users {
+		if now.Sub(revokedAt) > s.accessTokenLifetime {
+			delete(s.users, userID)
+		}
+	}
+
+	for key, revokedAt := range s.devices {
+		if now.Sub(revokedAt) > s.accessTokenLifetime {
+			delete(s.devices, key)
+		}
+	}
+}
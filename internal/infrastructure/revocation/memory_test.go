@@ -0,0 +1,94 @@
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreRevokeToken(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore(10 * time.Minute)
+	now := time.Now()
+
+	if err := store.RevokeToken(ctx, "jti-1", now.Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	revoked, err := store.IsRevoked(ctx, "jti-1", "user-1", "web", "device-1", now)
+	if err != nil || !revoked {
+		t.Fatalf("expected revoked token, got %v %v", revoked, err)
+	}
+
+	revoked, err = store.IsRevoked(ctx, "jti-2", "user-1", "web", "device-1", now)
+	if err != nil || revoked {
+		t.Fatalf("expected other token to pass, got %v %v", revoked, err)
+	}
+}
+
+func TestMemoryStoreRevokeUser(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore(10 * time.Minute)
+	now := time.Now()
+
+	if err := store.RevokeUser(ctx, "user-1", now); err != nil {
+		t.Fatal(err)
+	}
+
+	revoked, _ := store.IsRevoked(ctx, "", "user-1", "web", "device-1", now.Add(-time.Minute))
+	if !revoked {
+		t.Fatal("expected token issued before the revocation to be revoked")
+	}
+
+	revoked, _ = store.IsRevoked(ctx, "", "user-1", "web", "device-1", now.Add(2*time.Second))
+	if revoked {
+		t.Fatal("expected token issued after the revocation to pass")
+	}
+
+	// the login right after a password change is issued in the same second
+	revoked, _ = store.IsRevoked(ctx, "", "user-1", "web", "device-1", now.Add(time.Millisecond))
+	if revoked {
+		t.Fatal("expected token issued right after the revocation to pass")
+	}
+
+	revoked, _ = store.IsRevoked(ctx, "", "user-1", "web", "device-1", now.Add(-time.Millisecond))
+	if !revoked {
+		t.Fatal("expected token issued right before the revocation to be revoked")
+	}
+
+	revoked, _ = store.IsRevoked(ctx, "", "user-2", "web", "device-1", now.Add(-time.Minute))
+	if revoked {
+		t.Fatal("expected other user to pass")
+	}
+}
+
+func TestMemoryStoreRevokeDevice(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore(10 * time.Minute)
+	now := time.Now()
+
+	if err := store.RevokeDevice(ctx, "user-1", "web", "device-1", now); err != nil {
+		t.Fatal(err)
+	}
+
+	revoked, _ := store.IsRevoked(ctx, "jti-1", "user-1", "web", "device-1", now.Add(-time.Minute))
+	if !revoked {
+		t.Fatal("expected token of the device issued before the revocation to be revoked")
+	}
+
+	// the device logs in again
+	revoked, _ = store.IsRevoked(ctx, "jti-2", "user-1", "web", "device-1", now.Add(time.Millisecond))
+	if revoked {
+		t.Fatal("expected token issued after the revocation to pass")
+	}
+
+	revoked, _ = store.IsRevoked(ctx, "jti-3", "user-1", "web", "device-2", now.Add(-time.Minute))
+	if revoked {
+		t.Fatal("expected other device to pass")
+	}
+
+	revoked, _ = store.IsRevoked(ctx, "jti-4", "user-2", "web", "device-1", now.Add(-time.Minute))
+	if revoked {
+		t.Fatal("expected the same device of another user to pass")
+	}
+}
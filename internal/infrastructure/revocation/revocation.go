@@ -0,0 +1,34 @@
+package revocation
+
+import (
+	"context"
+	"kiwi-user/config"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Store revocation list of access tokens. Access tokens are stateless, the list only has to
+// remember a revocation until the revoked tokens expire by themselves.
+type Store interface {
+	// RevokeToken revoke the access token with the jti until it expires
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+	// RevokeUser revoke every access token of the user issued before at
+	RevokeUser(ctx context.Context, userID string, at time.Time) error
+	// RevokeDevice revoke every access token of one device of the user issued before at
+	RevokeDevice(ctx context.Context, userID string, deviceType string, deviceID string, at time.Time) error
+	// IsRevoked reports whether the access token was revoked by its jti, its user or its device
+	IsRevoked(ctx context.Context, jti string, userID string, deviceType string, deviceID string, issuedAt time.Time) (bool, error)
+}
+
+// NewStore keeps the list in redis when a client is configured, revocations are then shared
+// by all instances. Without redis they only hold in the process that recorded them.
+func NewStore(config *config.Config, client *redis.Client) Store {
+	accessTokenLifetime := time.Duration(config.JWT.AccessTokenExpireSecond) * time.Second
+
+	if client == nil {
+		return newMemoryStore(accessTokenLifetime)
+	}
+
+	return newRedisStore(client, config.Redis.KeyPrefix, accessTokenLifetime)
+}
@@ -0,0 +1,173 @@
+package saml
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+// Verification of enveloped XML signatures (https://www.w3.org/TR/xmldsig-core1/) as SAML uses them.
+// Canonicalization and the signature itself are checked by goxmldsig, checkSignature narrows what it
+// accepts to the way SAML is signed: one reference to the signed element by its ID, the enveloped
+// signature and exclusive canonicalization transforms, RSA with SHA-256 or SHA-512. SHA-1 is refused.
+
+const (
+	dsigNamespace   = "http://www.w3.org/2000/09/xmldsig#"
+	excC14NAlgo     = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	envelopedAlgo   = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	rsaSHA256Algo   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	rsaSHA512Algo   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	sha256DigestAlg = "http://www.w3.org/2001/04/xmlenc#sha256"
+	sha512DigestAlg = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+// verifySignature verifies the signature enveloped in signed with one of the certificates and returns
+// the verified copy of signed, without its signature. document is the root, the ID of signed must be
+// unique in it so the reference can not point elsewhere. Only the returned copy may be read.
+func verifySignature(document *element, signed *etree.Element, certificates []*x509.Certificate, now time.Time) (*etree.Element, error) {
+	// namespaces declared by the ancestors are moved onto the copy, they are part of its canonical form
+	parent, err := etreeutils.NSBuildParentContext(signed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	detached, err := etreeutils.NSDetatch(parent, signed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	e, err := parseTree(detached)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if err := checkSignature(document, e); err != nil {
+		return nil, err
+	}
+
+	// a certificate in the KeyInfo of the signature is only accepted when the metadata has it, without
+	// a KeyInfo goxmldsig wants a single certificate, so every certificate gets its own context
+	for _, certificate := range certificates {
+		validation := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+			Roots: []*x509.Certificate{certificate},
+		})
+		validation.Clock = dsig.NewFakeClockAt(now)
+
+		if verified, err := validation.Validate(detached); err == nil {
+			return verified, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: signature does not verify with the identity provider certificates", ErrInvalidSignature)
+}
+
+// checkSignature refuses the signatures of e that goxmldsig would accept but SAML does not need
+func checkSignature(document *element, e *element) error {
+	signatures := e.children(dsigNamespace, "Signature")
+	if len(signatures) != 1 {
+		return fmt.Errorf("%w: expected one signature", ErrInvalidSignature)
+	}
+	signature := signatures[0]
+
+	id := e.attr("ID")
+	if id == "" {
+		return fmt.Errorf("%w: signed element has no ID", ErrInvalidSignature)
+	}
+
+	occurrences := 0
+	document.walk(func(el *element) {
+		if el.attr("ID") == id {
+			occurrences++
+		}
+	})
+	if occurrences != 1 {
+		return fmt.Errorf("%w: duplicate ID %s", ErrInvalidSignature, id)
+	}
+
+	signedInfo := signature.child(dsigNamespace, "SignedInfo")
+	if signedInfo == nil {
+		return fmt.Errorf("%w: missing SignedInfo", ErrInvalidSignature)
+	}
+
+	canonicalizationMethod := signedInfo.child(dsigNamespace, "CanonicalizationMethod")
+	if canonicalizationMethod == nil || canonicalizationMethod.attr("Algorithm") != excC14NAlgo {
+		return fmt.Errorf("%w: unsupported canonicalization", ErrInvalidSignature)
+	}
+
+	signatureMethod := signedInfo.child(dsigNamespace, "SignatureMethod")
+	if signatureMethod == nil {
+		return fmt.Errorf("%w: missing SignatureMethod", ErrInvalidSignature)
+	}
+
+	switch signatureMethod.attr("Algorithm") {
+	case rsaSHA256Algo, rsaSHA512Algo:
+	default:
+		return fmt.Errorf("%w: unsupported signature method %s", ErrInvalidSignature, signatureMethod.attr("Algorithm"))
+	}
+
+	references := signedInfo.children(dsigNamespace, "Reference")
+	if len(references) != 1 {
+		return fmt.Errorf("%w: expected one reference", ErrInvalidSignature)
+	}
+	reference := references[0]
+
+	if reference.attr("URI") != "#"+id {
+		return fmt.Errorf("%w: reference does not point to the signed element", ErrInvalidSignature)
+	}
+
+	// the enveloped signature transform is required, without it the signature could not cover e
+	enveloped := false
+	if transforms := reference.child(dsigNamespace, "Transforms"); transforms != nil {
+		for _, transform := range transforms.children(dsigNamespace, "Transform") {
+			switch transform.attr("Algorithm") {
+			case envelopedAlgo:
+				enveloped = true
+			case excC14NAlgo:
+			default:
+				return fmt.Errorf("%w: unsupported transform %s", ErrInvalidSignature, transform.attr("Algorithm"))
+			}
+		}
+	}
+	if !enveloped {
+		return fmt.Errorf("%w: missing enveloped signature transform", ErrInvalidSignature)
+	}
+
+	digestMethod := reference.child(dsigNamespace, "DigestMethod")
+	if digestMethod == nil || reference.child(dsigNamespace, "DigestValue") == nil {
+		return fmt.Errorf("%w: missing digest", ErrInvalidSignature)
+	}
+
+	switch digestMethod.attr("Algorithm") {
+	case sha256DigestAlg, sha512DigestAlg:
+	default:
+		return fmt.Errorf("%w: unsupported digest method %s", ErrInvalidSignature, digestMethod.attr("Algorithm"))
+	}
+
+	if signature.child(dsigNamespace, "SignatureValue") == nil {
+		return fmt.Errorf("%w: missing SignatureValue", ErrInvalidSignature)
+	}
+
+	return nil
+}
+
+// parseTree reads an etree element into the tree the rest of the package reads
+func parseTree(e *etree.Element) (*element, error) {
+	document := etree.NewDocument()
+	document.SetRoot(e.Copy())
+
+	b, err := document.WriteToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseXML(b)
+}
+
+// decodeBase64 base64 in XML is often wrapped over several lines
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
@@ -0,0 +1,388 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The fixtures below are laid out the way Entra ID (Azure AD) and Okta post their responses. Their
+// canonical forms are written out by hand from the exclusive canonicalization spec and the
+// signatures are made over those, so the fixtures check goxmldsig instead of agreeing with it.
+
+var fixtureNow = time.Date(2026, 10, 17, 10, 0, 30, 0, time.UTC)
+
+type signatureFixture struct {
+	name string
+	// document with {{DIGEST}}, {{SIGNATURE}} and {{CERTIFICATE}} placeholders
+	document string
+	// canonicalAssertion the assertion without its signature, canonicalized
+	canonicalAssertion string
+	// canonicalSignedInfo with the {{DIGEST}} placeholder, canonicalized
+	canonicalSignedInfo string
+	// prefixList the InclusiveNamespaces of the exclusive canonicalization transform
+	prefixList   string
+	nameID       string
+	email        string
+	sessionIndex string
+}
+
+var entraFixture = signatureFixture{
+	name: "entra id",
+	document: `<samlp:Response ID="_entra-response" Version="2.0" IssueInstant="2026-10-17T10:00:00.123Z" Destination="https://sp.example.com/saml/org-1/acs" InResponseTo="_request-1" xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol"><Issuer xmlns="urn:oasis:names:tc:SAML:2.0:assertion">https://idp.example.com</Issuer><samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success" /></samlp:Status><Assertion ID="_entra-1" IssueInstant="2026-10-17T10:00:00.123Z" Version="2.0" xmlns="urn:oasis:names:tc:SAML:2.0:assertion">
+  <Issuer>https://idp.example.com</Issuer>
+  <Signature xmlns="http://www.w3.org/2000/09/xmldsig#">
+    <SignedInfo>
+      <CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#" />
+      <SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256" />
+      <Reference URI="#_entra-1">
+        <Transforms>
+          <Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature" />
+          <Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#" />
+        </Transforms>
+        <DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256" />
+        <DigestValue>{{DIGEST}}</DigestValue>
+      </Reference>
+    </SignedInfo>
+    <SignatureValue>{{SIGNATURE}}</SignatureValue>
+    <KeyInfo>
+      <X509Data>
+        <X509Certificate>{{CERTIFICATE}}</X509Certificate>
+      </X509Data>
+    </KeyInfo>
+  </Signature>
+  <!-- comments are not signed -->
+  <Subject>
+    <NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">alice@corp.example.com</NameID>
+    <SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">
+      <SubjectConfirmationData InResponseTo="_request-1" NotOnOrAfter="2026-10-17T10:05:00.123Z" Recipient="https://sp.example.com/saml/org-1/acs" />
+    </SubjectConfirmation>
+  </Subject>
+  <Conditions NotBefore="2026-10-17T09:55:00.123Z" NotOnOrAfter="2026-10-17T11:00:00.123Z">
+    <AudienceRestriction>
+      <Audience>https://sp.example.com/saml/org-1/metadata</Audience>
+    </AudienceRestriction>
+  </Conditions>
+  <AttributeStatement>
+    <Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress">
+      <AttributeValue>alice@corp.example.com</AttributeValue>
+    </Attribute>
+  </AttributeStatement>
+  <AuthnStatement AuthnInstant="2026-10-17T09:59:58.000Z" SessionIndex="_entra-session">
+    <AuthnContext>
+      <AuthnContextClassRef>urn:oasis:names:tc:SAML:2.0:ac:classes:Password</AuthnContextClassRef>
+    </AuthnContext>
+  </AuthnStatement>
+</Assertion></samlp:Response>`,
+	// the signature and the comment are gone, the whitespace around them stays
+	canonicalAssertion: `<Assertion xmlns="urn:oasis:names:tc:SAML:2.0:assertion" ID="_entra-1" IssueInstant="2026-10-17T10:00:00.123Z" Version="2.0">
+  <Issuer>https://idp.example.com</Issuer>` + "\n  \n  \n  " + `<Subject>
+    <NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">alice@corp.example.com</NameID>
+    <SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">
+      <SubjectConfirmationData InResponseTo="_request-1" NotOnOrAfter="2026-10-17T10:05:00.123Z" Recipient="https://sp.example.com/saml/org-1/acs"></SubjectConfirmationData>
+    </SubjectConfirmation>
+  </Subject>
+  <Conditions NotBefore="2026-10-17T09:55:00.123Z" NotOnOrAfter="2026-10-17T11:00:00.123Z">
+    <AudienceRestriction>
+      <Audience>https://sp.example.com/saml/org-1/metadata</Audience>
+    </AudienceRestriction>
+  </Conditions>
+  <AttributeStatement>
+    <Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress">
+      <AttributeValue>alice@corp.example.com</AttributeValue>
+    </Attribute>
+  </AttributeStatement>
+  <AuthnStatement AuthnInstant="2026-10-17T09:59:58.000Z" SessionIndex="_entra-session">
+    <AuthnContext>
+      <AuthnContextClassRef>urn:oasis:names:tc:SAML:2.0:ac:classes:Password</AuthnContextClassRef>
+    </AuthnContext>
+  </AuthnStatement>
+</Assertion>`,
+	canonicalSignedInfo: `<SignedInfo xmlns="http://www.w3.org/2000/09/xmldsig#">
+      <CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"></CanonicalizationMethod>
+      <SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"></SignatureMethod>
+      <Reference URI="#_entra-1">
+        <Transforms>
+          <Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"></Transform>
+          <Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"></Transform>
+        </Transforms>
+        <DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"></DigestMethod>
+        <DigestValue>{{DIGEST}}</DigestValue>
+      </Reference>
+    </SignedInfo>`,
+	nameID:       "alice@corp.example.com",
+	email:        "alice@corp.example.com",
+	sessionIndex: "_entra-session",
+}
+
+var oktaFixture = signatureFixture{
+	name: "okta",
+	document: `<?xml version="1.0" encoding="UTF-8"?><saml2p:Response xmlns:saml2p="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:xs="http://www.w3.org/2001/XMLSchema" Destination="https://sp.example.com/saml/org-1/acs" ID="id71820" InResponseTo="_request-1" IssueInstant="2026-10-17T10:00:00.456Z" Version="2.0">` +
+		`<saml2:Issuer xmlns:saml2="urn:oasis:names:tc:SAML:2.0:assertion" Format="urn:oasis:names:tc:SAML:2.0:nameid-format:entity">https://idp.example.com</saml2:Issuer>` +
+		`<saml2p:Status xmlns:saml2p="urn:oasis:names:tc:SAML:2.0:protocol"><saml2p:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></saml2p:Status>` +
+		`<saml2:Assertion xmlns:saml2="urn:oasis:names:tc:SAML:2.0:assertion" xmlns:xs="http://www.w3.org/2001/XMLSchema" ID="id71821" IssueInstant="2026-10-17T10:00:00.456Z" Version="2.0">` +
+		`<saml2:Issuer Format="urn:oasis:names:tc:SAML:2.0:nameid-format:entity">https://idp.example.com</saml2:Issuer>` +
+		`<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:SignedInfo><ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/>` +
+		`<ds:SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"/><ds:Reference URI="#id71821"><ds:Transforms>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"/><ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#">` +
+		`<ec:InclusiveNamespaces xmlns:ec="http://www.w3.org/2001/10/xml-exc-c14n#" PrefixList="xs"/></ds:Transform></ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"/><ds:DigestValue>{{DIGEST}}</ds:DigestValue></ds:Reference></ds:SignedInfo>` +
+		`<ds:SignatureValue>{{SIGNATURE}}</ds:SignatureValue>` +
+		`<ds:KeyInfo><ds:X509Data><ds:X509Certificate>{{CERTIFICATE}}</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature>` +
+		`<saml2:Subject><saml2:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified">alice@corp.example.com</saml2:NameID>` +
+		`<saml2:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer"><saml2:SubjectConfirmationData InResponseTo="_request-1" NotOnOrAfter="2026-10-17T10:05:00.456Z" Recipient="https://sp.example.com/saml/org-1/acs"/></saml2:SubjectConfirmation></saml2:Subject>` +
+		`<saml2:Conditions NotBefore="2026-10-17T09:55:00.456Z" NotOnOrAfter="2026-10-17T10:05:00.456Z" xmlns:saml2="urn:oasis:names:tc:SAML:2.0:assertion">` +
+		`<saml2:AudienceRestriction><saml2:Audience>https://sp.example.com/saml/org-1/metadata</saml2:Audience></saml2:AudienceRestriction></saml2:Conditions>` +
+		`<saml2:AuthnStatement AuthnInstant="2026-10-17T09:59:58.456Z" SessionIndex="id71822" xmlns:saml2="urn:oasis:names:tc:SAML:2.0:assertion">` +
+		`<saml2:AuthnContext><saml2:AuthnContextClassRef>urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport</saml2:AuthnContextClassRef></saml2:AuthnContext></saml2:AuthnStatement>` +
+		`<saml2:AttributeStatement xmlns:saml2="urn:oasis:names:tc:SAML:2.0:assertion"><saml2:Attribute Name="email" NameFormat="urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified">` +
+		`<saml2:AttributeValue xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="xs:string">alice@corp.example.com</saml2:AttributeValue></saml2:Attribute></saml2:AttributeStatement>` +
+		`</saml2:Assertion></saml2p:Response>`,
+	// xs is only used in an attribute value, it is rendered because of the PrefixList. The
+	// repeated saml2 declarations are dropped.
+	canonicalAssertion: `<saml2:Assertion xmlns:saml2="urn:oasis:names:tc:SAML:2.0:assertion" xmlns:xs="http://www.w3.org/2001/XMLSchema" ID="id71821" IssueInstant="2026-10-17T10:00:00.456Z" Version="2.0">` +
+		`<saml2:Issuer Format="urn:oasis:names:tc:SAML:2.0:nameid-format:entity">https://idp.example.com</saml2:Issuer>` +
+		`<saml2:Subject><saml2:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified">alice@corp.example.com</saml2:NameID>` +
+		`<saml2:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer"><saml2:SubjectConfirmationData InResponseTo="_request-1" NotOnOrAfter="2026-10-17T10:05:00.456Z" Recipient="https://sp.example.com/saml/org-1/acs"></saml2:SubjectConfirmationData></saml2:SubjectConfirmation></saml2:Subject>` +
+		`<saml2:Conditions NotBefore="2026-10-17T09:55:00.456Z" NotOnOrAfter="2026-10-17T10:05:00.456Z">` +
+		`<saml2:AudienceRestriction><saml2:Audience>https://sp.example.com/saml/org-1/metadata</saml2:Audience></saml2:AudienceRestriction></saml2:Conditions>` +
+		`<saml2:AuthnStatement AuthnInstant="2026-10-17T09:59:58.456Z" SessionIndex="id71822">` +
+		`<saml2:AuthnContext><saml2:AuthnContextClassRef>urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport</saml2:AuthnContextClassRef></saml2:AuthnContext></saml2:AuthnStatement>` +
+		`<saml2:AttributeStatement><saml2:Attribute Name="email" NameFormat="urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified">` +
+		`<saml2:AttributeValue xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="xs:string">alice@corp.example.com</saml2:AttributeValue></saml2:Attribute></saml2:AttributeStatement>` +
+		`</saml2:Assertion>`,
+	canonicalSignedInfo: `<ds:SignedInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"></ds:CanonicalizationMethod>` +
+		`<ds:SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"></ds:SignatureMethod><ds:Reference URI="#id71821"><ds:Transforms>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"></ds:Transform><ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#">` +
+		`<ec:InclusiveNamespaces xmlns:ec="http://www.w3.org/2001/10/xml-exc-c14n#" PrefixList="xs"></ec:InclusiveNamespaces></ds:Transform></ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"></ds:DigestMethod><ds:DigestValue>{{DIGEST}}</ds:DigestValue></ds:Reference></ds:SignedInfo>`,
+	prefixList:   "xs",
+	nameID:       "alice@corp.example.com",
+	email:        "alice@corp.example.com",
+	sessionIndex: "id71822",
+}
+
+// sign fills the placeholders of the fixture, base64 is wrapped over lines as some IdPs do
+func (f signatureFixture) sign(t *testing.T, idp *IdentityProvider, key *rsa.PrivateKey) string {
+	t.Helper()
+
+	digest := sha256.Sum256([]byte(f.canonicalAssertion))
+	encodedDigest := base64.StdEncoding.EncodeToString(digest[:])
+
+	signedInfo := sha256.Sum256([]byte(strings.Replace(f.canonicalSignedInfo, "{{DIGEST}}", encodedDigest, 1)))
+	value, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, signedInfo[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return strings.NewReplacer(
+		"{{DIGEST}}", encodedDigest,
+		"{{SIGNATURE}}", wrapLines(base64.StdEncoding.EncodeToString(value)),
+		"{{CERTIFICATE}}", wrapLines(idp.Certificates[0]),
+	).Replace(f.document)
+}
+
+func wrapLines(s string) string {
+	var b strings.Builder
+	for len(s) > 76 {
+		b.WriteString(s[:76] + "\n")
+		s = s[76:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+func TestSignatureFixtures(t *testing.T) {
+	idp, key := newTestIdentityProvider(t)
+
+	for _, f := range []signatureFixture{entraFixture, oktaFixture} {
+		t.Run(f.name, func(t *testing.T) {
+			document := f.sign(t, idp, key)
+
+			// compared first so a canonicalization bug shows as a diff, not a bad digest
+			if got := string(canonicalize(t, document, "//Assertion", f.prefixList)); got != f.canonicalAssertion {
+				t.Fatalf("canonical assertion\n got %s\nwant %s", got, f.canonicalAssertion)
+			}
+
+			parsed, err := ParseResponse(encode(document), testSP, idp, testRequestID, fixtureNow)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if parsed.NameID != f.nameID || parsed.SessionIndex != f.sessionIndex || parsed.Attribute("email") != f.email &&
+				parsed.Attribute("http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress") != f.email {
+				t.Fatalf("unexpected assertion %+v", parsed)
+			}
+
+			// any change to the signed content breaks the digest
+			tampered := strings.Replace(document, ">alice@corp.example.com</", ">admin@corp.example.com</", 1)
+			if _, err := ParseResponse(encode(tampered), testSP, idp, testRequestID, fixtureNow); !errors.Is(err, ErrInvalidSignature) {
+				t.Fatalf("tampered fixture: got %v, want %v", err, ErrInvalidSignature)
+			}
+		})
+	}
+}
+
+// A comment or a CDATA section does not change the canonical form, so it can be added to a signed
+// value without breaking the signature. The value must then be read whole, never cut at the comment.
+func TestParseResponseCommentInjection(t *testing.T) {
+	idp, key := newTestIdentityProvider(t)
+
+	o := defaultAssertionOptions()
+	o.nameID = "alice-id.evil.com"
+	o.email = "alice@corp.example.com.evil.com"
+	signed := signedAssertionXML(t, key, "_assertion-1", o)
+
+	tests := []struct {
+		name     string
+		value    string
+		injected string
+		read     func(*Assertion) string
+	}{
+		{name: "comment in NameID", value: o.nameID, injected: "alice-id<!---->.evil.com", read: func(a *Assertion) string { return a.NameID }},
+		{name: "comment in attribute", value: o.email, injected: "alice@corp.example.com<!-- -->.evil.com", read: func(a *Assertion) string { return a.Attribute("email") }},
+		{name: "cdata in attribute", value: o.email, injected: "alice@corp.example.com<![CDATA[.evil.com]]>", read: func(a *Assertion) string { return a.Attribute("email") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := responseXML(strings.Replace(signed, ">"+tt.value+"<", ">"+tt.injected+"<", 1))
+
+			assertion, err := ParseResponse(encode(response), testSP, idp, testRequestID, time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := tt.read(assertion); got != tt.value {
+				t.Fatalf("read %q, want %q", got, tt.value)
+			}
+		})
+	}
+
+	// the entra fixture signs its NameID, a comment in it must not shorten it either
+	document := strings.Replace(entraFixture.sign(t, idp, key), ">alice@corp.example.com</NameID>", ">alice@corp.example.com<!---->.evil.com</NameID>", 1)
+	if _, err := ParseResponse(encode(document), testSP, idp, testRequestID, fixtureNow); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("comment extending the signed NameID: got %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestParseResponseRejectsSignatures(t *testing.T) {
+	idp, key := newTestIdentityProvider(t)
+	otherIdP, otherKey := newTestIdentityProvider(t)
+	o := defaultAssertionOptions()
+
+	replace := func(old string, new string) func(string) string {
+		return func(signature string) string { return strings.Replace(signature, old, new, 1) }
+	}
+
+	signed := signedAssertionXML(t, key, "_assertion-1", o)
+	signature := signed[strings.Index(signed, "<ds:Signature") : strings.Index(signed, "</ds:Signature>")+len("</ds:Signature>")]
+	forged := assertionXML("_assertion-2", "", o)
+
+	tests := []struct {
+		name     string
+		response string
+		reason   string
+	}{
+		{
+			name:     "sha1 signature",
+			response: responseXML(signedAssertionXMLWith(t, key, "_assertion-1", o, replace("xmldsig-more#rsa-sha256", "xmldsig#rsa-sha1"))),
+			reason:   "unsupported signature method",
+		},
+		{
+			name:     "sha1 digest",
+			response: responseXML(signedAssertionXMLWith(t, key, "_assertion-1", o, replace("xmlenc#sha256", "xmldsig#sha1"))),
+			reason:   "unsupported digest method",
+		},
+		{
+			name:     "inclusive canonicalization",
+			response: responseXML(signedAssertionXMLWith(t, key, "_assertion-1", o, replace(`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/>`, `<ds:CanonicalizationMethod Algorithm="http://www.w3.org/TR/2001/REC-xml-c14n-20010315#WithComments"/>`))),
+			reason:   "unsupported canonicalization",
+		},
+		{
+			name:     "no enveloped transform",
+			response: responseXML(signedAssertionXMLWith(t, key, "_assertion-1", o, replace(`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"/>`, ""))),
+			reason:   "missing enveloped signature transform",
+		},
+		{
+			// an XPath transform could select anything of the document
+			name: "xpath transform",
+			response: responseXML(signedAssertionXMLWith(t, key, "_assertion-1", o, replace("</ds:Transforms>",
+				`<ds:Transform Algorithm="http://www.w3.org/TR/1999/REC-xpath-19991116"><ds:XPath>not(ancestor-or-self::saml:NameID)</ds:XPath></ds:Transform></ds:Transforms>`))),
+			reason: "unsupported transform",
+		},
+		{
+			name:     "whole document reference",
+			response: responseXML(signedAssertionXMLWith(t, key, "_assertion-1", o, replace(`URI="#_assertion-1"`, `URI=""`))),
+			reason:   "reference does not point to the signed element",
+		},
+		{
+			name: "second reference",
+			response: responseXML(signedAssertionXMLWith(t, key, "_assertion-1", o, func(signature string) string {
+				reference := signature[strings.Index(signature, "<ds:Reference"):strings.Index(signature, "</ds:SignedInfo>")]
+				return strings.Replace(signature, "</ds:SignedInfo>", strings.Replace(reference, "#_assertion-1", "#_response-1", 1)+"</ds:SignedInfo>", 1)
+			})),
+			reason: "expected one reference",
+		},
+		{
+			// the KeyInfo of the response is not trusted, only the metadata certificates are
+			name: "key of the signature",
+			response: responseXML(signedAssertionXMLWith(t, otherKey, "_assertion-1", o, replace("</ds:Signature>",
+				`<ds:KeyInfo><ds:X509Data><ds:X509Certificate>`+otherIdP.Certificates[0]+`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature>`))),
+			reason: "does not verify",
+		},
+		{
+			// the valid signature moved into a forged assertion, still pointing to the signed one
+			name:     "signature moved to a forged assertion",
+			response: responseXML(assertionXML("_assertion-2", signature, o)),
+			reason:   "reference does not point to the signed element",
+		},
+		{
+			// the signed assertion kept inside the signature of the forged one, both with the same ID
+			name: "signed assertion in a signature object",
+			response: responseXML(assertionXML("_assertion-1", strings.Replace(signature, "</ds:Signature>",
+				"<ds:Object>"+signed+"</ds:Object></ds:Signature>", 1), o)),
+			reason: "duplicate ID",
+		},
+		{
+			// another element of the response claims the ID of the signed assertion
+			name: "duplicate ID in a sibling",
+			response: strings.Replace(responseXML(signed), "<samlp:Status>",
+				`<samlp:Extensions ID="_assertion-1"/><samlp:Status>`, 1),
+			reason: "duplicate ID",
+		},
+		{
+			// the signed assertion hidden in the advice of an unsigned one
+			name: "signed assertion in advice",
+			response: responseXML(strings.Replace(forged, "<saml:Conditions",
+				"<saml:Advice>"+signed+"</saml:Advice><saml:Conditions", 1)),
+			reason: "neither response nor assertion is signed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseResponse(encode(tt.response), testSP, idp, testRequestID, time.Now())
+			if !errors.Is(err, ErrInvalidSignature) || !strings.Contains(err.Error(), tt.reason) {
+				t.Fatalf("ParseResponse() error = %v, want %v: %s", err, ErrInvalidSignature, tt.reason)
+			}
+		})
+	}
+}
+
+func TestParseResponseRefusesEntities(t *testing.T) {
+	idp, _ := newTestIdentityProvider(t)
+
+	response := `<!DOCTYPE r [<!ENTITY a "aaaaaaaaaa"><!ENTITY b "&a;&a;&a;&a;&a;&a;&a;&a;&a;&a;">]>` +
+		responseXML(assertionXML("_assertion-1", "", defaultAssertionOptions()))
+
+	if _, err := ParseResponse(encode(response), testSP, idp, testRequestID, time.Now()); !errors.Is(err, ErrInvalidResponse) {
+		t.Fatalf("ParseResponse() error = %v, want %v", err, ErrInvalidResponse)
+	}
+}
@@ -0,0 +1,419 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+// SAML 2.0 web browser SSO profile, service provider side. Logins are SP initiated: the browser is
+// redirected to the identity provider with an AuthnRequest (HTTP-Redirect binding) and comes back
+// with a signed Response posted to the assertion consumer service (HTTP-POST binding).
+// Encrypted assertions and IdP initiated logins are not supported.
+
+const (
+	protocolNamespace  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	assertionNamespace = "urn:oasis:names:tc:SAML:2.0:assertion"
+	metadataNamespace  = "urn:oasis:names:tc:SAML:2.0:metadata"
+
+	BindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	BindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	NameIDFormatEmail      = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	NameIDFormatPersistent = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+
+	statusSuccess      = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	confirmationBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+
+	// clockSkew tolerated between the identity provider and us
+	clockSkew = 3 * time.Minute
+	// maxResponseSize responses are posted by browsers, anything larger is not a login
+	maxResponseSize = 512 * 1024
+)
+
+var (
+	ErrInvalidMetadata  = errors.New("invalid saml metadata")
+	ErrInvalidResponse  = errors.New("invalid saml response")
+	ErrInvalidSignature = errors.New("invalid saml signature")
+)
+
+// ServiceProvider how the identity provider knows us
+type ServiceProvider struct {
+	EntityID string
+	ACSURL   string
+}
+
+// IdentityProvider what the uploaded identity provider metadata tells about it
+type IdentityProvider struct {
+	EntityID string
+	SSOURL   string
+	// Certificates base64 DER signing certificates, more than one while the IdP rotates its key
+	Certificates []string
+}
+
+// Assertion the verified statements about the user
+type Assertion struct {
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+	// Attributes values by attribute Name, and by FriendlyName when the IdP sets one
+	Attributes map[string][]string
+}
+
+// Attribute the first value of the attribute
+func (a *Assertion) Attribute(name string) string {
+	if values := a.Attributes[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Metadata the service provider metadata to register at the identity provider
+func Metadata(sp ServiceProvider) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	fmt.Fprintf(&buf, `<md:EntityDescriptor xmlns:md="%s" entityID="%s">`, metadataNamespace, escapeAttr(sp.EntityID))
+	fmt.Fprintf(&buf, `<md:SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="true" protocolSupportEnumeration="%s">`, protocolNamespace)
+	fmt.Fprintf(&buf, `<md:NameIDFormat>%s</md:NameIDFormat>`, NameIDFormatPersistent)
+	fmt.Fprintf(&buf, `<md:NameIDFormat>%s</md:NameIDFormat>`, NameIDFormatEmail)
+	fmt.Fprintf(&buf, `<md:AssertionConsumerService Binding="%s" Location="%s" index="0" isDefault="true"/>`, BindingHTTPPost, escapeAttr(sp.ACSURL))
+	buf.WriteString(`</md:SPSSODescriptor></md:EntityDescriptor>`)
+	return buf.Bytes()
+}
+
+// ParseMetadata reads the entity id, the HTTP-Redirect single sign on url and the signing
+// certificates from the metadata of an identity provider
+func ParseMetadata(b []byte) (*IdentityProvider, error) {
+	root, err := parseXML(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+	}
+
+	// an EntitiesDescriptor may wrap the identity provider
+	var entity *element
+	root.walk(func(e *element) {
+		if entity == nil && e.Space == metadataNamespace && e.Local == "EntityDescriptor" &&
+			e.child(metadataNamespace, "IDPSSODescriptor") != nil {
+			entity = e
+		}
+	})
+	if entity == nil {
+		return nil, fmt.Errorf("%w: no identity provider descriptor", ErrInvalidMetadata)
+	}
+
+	idp := &IdentityProvider{EntityID: entity.attr("entityID")}
+	if idp.EntityID == "" {
+		return nil, fmt.Errorf("%w: missing entityID", ErrInvalidMetadata)
+	}
+
+	descriptor := entity.child(metadataNamespace, "IDPSSODescriptor")
+	for _, sso := range descriptor.children(metadataNamespace, "SingleSignOnService") {
+		if sso.attr("Binding") == BindingHTTPRedirect {
+			idp.SSOURL = sso.attr("Location")
+			break
+		}
+	}
+	if idp.SSOURL == "" {
+		return nil, fmt.Errorf("%w: no HTTP-Redirect single sign on service", ErrInvalidMetadata)
+	}
+
+	for _, keyDescriptor := range descriptor.children(metadataNamespace, "KeyDescriptor") {
+		if use := keyDescriptor.attr("use"); use != "" && use != "signing" {
+			continue
+		}
+
+		keyInfo := keyDescriptor.child(dsigNamespace, "KeyInfo")
+		if keyInfo == nil {
+			continue
+		}
+
+		for _, data := range keyInfo.children(dsigNamespace, "X509Data") {
+			for _, certificate := range data.children(dsigNamespace, "X509Certificate") {
+				encoded := strings.Join(strings.Fields(certificate.text()), "")
+				if _, err := parseCertificate(encoded); err != nil {
+					return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+				}
+				if !slices.Contains(idp.Certificates, encoded) {
+					idp.Certificates = append(idp.Certificates, encoded)
+				}
+			}
+		}
+	}
+	if len(idp.Certificates) == 0 {
+		return nil, fmt.Errorf("%w: no signing certificate", ErrInvalidMetadata)
+	}
+
+	return idp, nil
+}
+
+// AuthnRequestURL the single sign on url with a deflated AuthnRequest, HTTP-Redirect binding
+func AuthnRequestURL(sp ServiceProvider, idp *IdentityProvider, requestID string, relayState string, now time.Time) (string, error) {
+	var request bytes.Buffer
+	fmt.Fprintf(&request,
+		`<samlp:AuthnRequest xmlns:samlp="%s" xmlns:saml="%s" ID="%s" Version="2.0" IssueInstant="%s" Destination="%s" AssertionConsumerServiceURL="%s" ProtocolBinding="%s">`,
+		protocolNamespace, assertionNamespace,
+		escapeAttr(requestID), now.UTC().Format(time.RFC3339), escapeAttr(idp.SSOURL), escapeAttr(sp.ACSURL), BindingHTTPPost)
+	fmt.Fprintf(&request, `<saml:Issuer>%s</saml:Issuer>`, escapeText(sp.EntityID))
+	request.WriteString(`<samlp:NameIDPolicy AllowCreate="true"/></samlp:AuthnRequest>`)
+
+	var deflated bytes.Buffer
+	writer, err := flate.NewWriter(&deflated, flate.DefaultCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := writer.Write(request.Bytes()); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(idp.SSOURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("SAMLRequest", base64.StdEncoding.EncodeToString(deflated.Bytes()))
+	if relayState != "" {
+		query.Set("RelayState", relayState)
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// ParseResponse validates the base64 SAMLResponse posted to the assertion consumer service
+// and returns its assertion. requestID is the ID of the AuthnRequest the response answers.
+func ParseResponse(samlResponse string, sp ServiceProvider, idp *IdentityProvider, requestID string, now time.Time) (*Assertion, error) {
+	if len(samlResponse) > maxResponseSize {
+		return nil, fmt.Errorf("%w: response too large", ErrInvalidResponse)
+	}
+
+	b, err := decodeBase64(samlResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	certificates := make([]*x509.Certificate, 0, len(idp.Certificates))
+	for _, encoded := range idp.Certificates {
+		certificate, err := parseCertificate(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	document, err := parseXML(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	tree := etree.NewDocument()
+	if err := tree.ReadFromBytes(b); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	if document.Space != protocolNamespace || document.Local != "Response" {
+		return nil, fmt.Errorf("%w: not a Response", ErrInvalidResponse)
+	}
+
+	// a signed response covers its assertion, otherwise the assertion itself must be signed.
+	// Only the verified copies are read afterwards, wrapped or commented out parts are never looked at.
+	response, responseTree := document, tree.Root()
+	signed := false
+	if response.child(dsigNamespace, "Signature") != nil {
+		if responseTree, err = verifySignature(document, responseTree, certificates, now); err != nil {
+			return nil, err
+		}
+		if response, err = parseTree(responseTree); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+		}
+		signed = true
+	}
+
+	if destination := response.attr("Destination"); destination != "" && destination != sp.ACSURL {
+		return nil, fmt.Errorf("%w: destination %s", ErrInvalidResponse, destination)
+	}
+
+	if requestID == "" || response.attr("InResponseTo") != requestID {
+		return nil, fmt.Errorf("%w: not a response to the request", ErrInvalidResponse)
+	}
+
+	if issuer := response.child(assertionNamespace, "Issuer"); issuer != nil && issuer.text() != idp.EntityID {
+		return nil, fmt.Errorf("%w: issuer %s", ErrInvalidResponse, issuer.text())
+	}
+
+	status := response.child(protocolNamespace, "Status")
+	if status == nil {
+		return nil, fmt.Errorf("%w: missing status", ErrInvalidResponse)
+	}
+	if statusCode := status.child(protocolNamespace, "StatusCode"); statusCode == nil || statusCode.attr("Value") != statusSuccess {
+		return nil, fmt.Errorf("%w: status is not success", ErrInvalidResponse)
+	}
+
+	if len(response.children(assertionNamespace, "EncryptedAssertion")) > 0 {
+		return nil, fmt.Errorf("%w: encrypted assertions are not supported", ErrInvalidResponse)
+	}
+
+	assertions := response.children(assertionNamespace, "Assertion")
+	if len(assertions) != 1 {
+		return nil, fmt.Errorf("%w: expected one assertion", ErrInvalidResponse)
+	}
+	assertion := assertions[0]
+
+	if assertion.child(dsigNamespace, "Signature") != nil {
+		assertionTree, err := etreeutils.NSFindOneChild(responseTree, assertionNamespace, "Assertion")
+		if err != nil || assertionTree == nil {
+			return nil, fmt.Errorf("%w: assertion not found", ErrInvalidResponse)
+		}
+
+		verified, err := verifySignature(document, assertionTree, certificates, now)
+		if err != nil {
+			return nil, err
+		}
+		if assertion, err = parseTree(verified); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+		}
+		signed = true
+	}
+	if !signed {
+		return nil, fmt.Errorf("%w: neither response nor assertion is signed", ErrInvalidSignature)
+	}
+
+	return readAssertion(assertion, sp, idp, requestID, now)
+}
+
+func readAssertion(assertion *element, sp ServiceProvider, idp *IdentityProvider, requestID string, now time.Time) (*Assertion, error) {
+	issuer := assertion.child(assertionNamespace, "Issuer")
+	if issuer == nil || issuer.text() != idp.EntityID {
+		return nil, fmt.Errorf("%w: assertion issuer", ErrInvalidResponse)
+	}
+
+	subject := assertion.child(assertionNamespace, "Subject")
+	if subject == nil {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidResponse)
+	}
+
+	nameID := subject.child(assertionNamespace, "NameID")
+	if nameID == nil || nameID.text() == "" {
+		return nil, fmt.Errorf("%w: missing NameID", ErrInvalidResponse)
+	}
+
+	confirmed := false
+	for _, confirmation := range subject.children(assertionNamespace, "SubjectConfirmation") {
+		if confirmation.attr("Method") != confirmationBearer {
+			continue
+		}
+
+		data := confirmation.child(assertionNamespace, "SubjectConfirmationData")
+		if data == nil || data.attr("Recipient") != sp.ACSURL {
+			continue
+		}
+
+		// required even when the response is signed, an assertion signed alone carries it only here
+		if data.attr("InResponseTo") != requestID {
+			continue
+		}
+
+		notOnOrAfter, err := parseTime(data.attr("NotOnOrAfter"))
+		if err != nil || !now.Before(notOnOrAfter.Add(clockSkew)) {
+			continue
+		}
+
+		confirmed = true
+		break
+	}
+	if !confirmed {
+		return nil, fmt.Errorf("%w: no valid bearer subject confirmation", ErrInvalidResponse)
+	}
+
+	conditions := assertion.child(assertionNamespace, "Conditions")
+	if conditions == nil {
+		return nil, fmt.Errorf("%w: missing conditions", ErrInvalidResponse)
+	}
+
+	if notBefore := conditions.attr("NotBefore"); notBefore != "" {
+		t, err := parseTime(notBefore)
+		if err != nil || now.Add(clockSkew).Before(t) {
+			return nil, fmt.Errorf("%w: assertion not yet valid", ErrInvalidResponse)
+		}
+	}
+
+	if notOnOrAfter := conditions.attr("NotOnOrAfter"); notOnOrAfter != "" {
+		t, err := parseTime(notOnOrAfter)
+		if err != nil || !now.Before(t.Add(clockSkew)) {
+			return nil, fmt.Errorf("%w: assertion expired", ErrInvalidResponse)
+		}
+	}
+
+	// every audience restriction must name us, see section 2.5.1.4 of the core spec
+	restrictions := conditions.children(assertionNamespace, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return nil, fmt.Errorf("%w: missing audience restriction", ErrInvalidResponse)
+	}
+	for _, restriction := range restrictions {
+		found := false
+		for _, audience := range restriction.children(assertionNamespace, "Audience") {
+			if audience.text() == sp.EntityID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: audience", ErrInvalidResponse)
+		}
+	}
+
+	result := &Assertion{
+		NameID:       nameID.text(),
+		NameIDFormat: nameID.attr("Format"),
+		Attributes:   map[string][]string{},
+	}
+
+	if authnStatement := assertion.child(assertionNamespace, "AuthnStatement"); authnStatement != nil {
+		result.SessionIndex = authnStatement.attr("SessionIndex")
+	}
+
+	for _, statement := range assertion.children(assertionNamespace, "AttributeStatement") {
+		for _, attribute := range statement.children(assertionNamespace, "Attribute") {
+			values := make([]string, 0, 1)
+			for _, value := range attribute.children(assertionNamespace, "AttributeValue") {
+				values = append(values, value.text())
+			}
+
+			for _, name := range []string{attribute.attr("Name"), attribute.attr("FriendlyName")} {
+				if name != "" {
+					result.Attributes[name] = append(result.Attributes[name], values...)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func parseCertificate(encoded string) (*x509.Certificate, error) {
+	der, err := decodeBase64(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+// parseTime xs:dateTime as SAML uses it, UTC with optional fractional seconds
+func parseTime(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, value)
+}
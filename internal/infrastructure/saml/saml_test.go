@@ -0,0 +1,376 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+var testSP = ServiceProvider{
+	EntityID: "https://sp.example.com/saml/org-1/metadata",
+	ACSURL:   "https://sp.example.com/saml/org-1/acs",
+}
+
+const testRequestID = "_request-1"
+
+func newTestIdentityProvider(t *testing.T) (*IdentityProvider, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &IdentityProvider{
+		EntityID:     "https://idp.example.com",
+		SSOURL:       "https://idp.example.com/sso?tenant=1",
+		Certificates: []string{base64.StdEncoding.EncodeToString(der)},
+	}, key
+}
+
+type assertionOptions struct {
+	audience     string
+	inResponseTo string
+	notOnOrAfter time.Time
+	nameID       string
+	email        string
+}
+
+func defaultAssertionOptions() assertionOptions {
+	return assertionOptions{
+		audience:     testSP.EntityID,
+		inResponseTo: testRequestID,
+		notOnOrAfter: time.Now().Add(5 * time.Minute),
+		nameID:       "alice-id",
+		email:        "alice@corp.example.com",
+	}
+}
+
+func assertionXML(id string, signature string, o assertionOptions) string {
+	return `<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="` + id + `" Version="2.0" IssueInstant="` + time.Now().UTC().Format(time.RFC3339) + `">` +
+		`<saml:Issuer>https://idp.example.com</saml:Issuer>` + signature +
+		`<saml:Subject><saml:NameID Format="urn:oasis:names:tc:SAML:2.0:nameid-format:persistent">` + o.nameID + `</saml:NameID>` +
+		`<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">` +
+		`<saml:SubjectConfirmationData InResponseTo="` + o.inResponseTo + `" NotOnOrAfter="` + o.notOnOrAfter.UTC().Format(time.RFC3339) + `" Recipient="` + testSP.ACSURL + `"/>` +
+		`</saml:SubjectConfirmation></saml:Subject>` +
+		`<saml:Conditions NotBefore="` + time.Now().Add(-time.Minute).UTC().Format(time.RFC3339) + `" NotOnOrAfter="` + o.notOnOrAfter.UTC().Format(time.RFC3339) + `">` +
+		`<saml:AudienceRestriction><saml:Audience>` + o.audience + `</saml:Audience></saml:AudienceRestriction></saml:Conditions>` +
+		`<saml:AuthnStatement SessionIndex="session-1"/>` +
+		`<saml:AttributeStatement>` +
+		`<saml:Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress" FriendlyName="email"><saml:AttributeValue>` + o.email + `</saml:AttributeValue></saml:Attribute>` +
+		`<saml:Attribute Name="department"><saml:AttributeValue>Engineering</saml:AttributeValue></saml:Attribute>` +
+		`</saml:AttributeStatement></saml:Assertion>`
+}
+
+func responseXML(assertion string) string {
+	return `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_response-1" Version="2.0" InResponseTo="` + testRequestID + `" Destination="` + testSP.ACSURL + `">` +
+		`<saml:Issuer>https://idp.example.com</saml:Issuer>` +
+		`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>` +
+		assertion + `</samlp:Response>`
+}
+
+// signedAssertionXML signs the assertion the way identity providers do
+func signedAssertionXML(t *testing.T, key *rsa.PrivateKey, id string, o assertionOptions) string {
+	t.Helper()
+
+	return signedAssertionXMLWith(t, key, id, o, func(signature string) string { return signature })
+}
+
+// signedAssertionXMLWith signs the assertion after edit changed the signature, the way a
+// forging or misconfigured identity provider would
+func signedAssertionXMLWith(t *testing.T, key *rsa.PrivateKey, id string, o assertionOptions, edit func(signature string) string) string {
+	t.Helper()
+
+	digest := sha256.Sum256(canonicalize(t, responseXML(assertionXML(id, "", o)), "//Assertion", ""))
+
+	signature := `<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:SignedInfo>` +
+		`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/>` +
+		`<ds:SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"/>` +
+		`<ds:Reference URI="#` + id + `"><ds:Transforms>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"/>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/></ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"/>` +
+		`<ds:DigestValue>` + base64.StdEncoding.EncodeToString(digest[:]) + `</ds:DigestValue></ds:Reference></ds:SignedInfo>` +
+		`<ds:SignatureValue>SIGNATURE</ds:SignatureValue></ds:Signature>`
+	signature = edit(signature)
+
+	signedInfoDigest := sha256.Sum256(canonicalize(t, responseXML(assertionXML(id, signature, o)), "//SignedInfo", ""))
+
+	value, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, signedInfoDigest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return assertionXML(id, strings.Replace(signature, "SIGNATURE", base64.StdEncoding.EncodeToString(value), 1), o)
+}
+
+// signedResponseXML signs the whole response instead of its assertion
+func signedResponseXML(t *testing.T, key *rsa.PrivateKey, assertion string) string {
+	t.Helper()
+
+	unsigned := responseXML(assertion)
+	digest := sha256.Sum256(canonicalize(t, unsigned, "/Response", ""))
+
+	signature := `<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:SignedInfo>` +
+		`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/>` +
+		`<ds:SignatureMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"/>` +
+		`<ds:Reference URI="#_response-1"><ds:Transforms>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"/>` +
+		`<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"/></ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"/>` +
+		`<ds:DigestValue>` + base64.StdEncoding.EncodeToString(digest[:]) + `</ds:DigestValue></ds:Reference></ds:SignedInfo>` +
+		`<ds:SignatureValue>SIGNATURE</ds:SignatureValue></ds:Signature>`
+	placeholder := strings.Replace(unsigned, "</saml:Issuer>", "</saml:Issuer>"+signature, 1)
+
+	signedInfoDigest := sha256.Sum256(canonicalize(t, placeholder, "//SignedInfo", ""))
+	value, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, signedInfoDigest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return strings.Replace(placeholder, "SIGNATURE", base64.StdEncoding.EncodeToString(value), 1)
+}
+
+// canonicalize the element of document at path in the exclusive canonical form, without its
+// enveloped signature, the way an identity provider digests and signs it
+func canonicalize(t *testing.T, document string, path string, prefixList string) []byte {
+	t.Helper()
+
+	tree := etree.NewDocument()
+	if err := tree.ReadFromString(document); err != nil {
+		t.Fatal(err)
+	}
+
+	e := tree.FindElement(path)
+	if e == nil {
+		t.Fatalf("no %s in %s", path, document)
+	}
+
+	parent, err := etreeutils.NSBuildParentContext(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	detached, err := etreeutils.NSDetatch(parent, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signature := detached.SelectElement("Signature"); signature != nil {
+		detached.RemoveChild(signature)
+	}
+
+	canonical, err := dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList(prefixList).Canonicalize(detached)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return canonical
+}
+
+func encode(response string) string {
+	return base64.StdEncoding.EncodeToString([]byte(response))
+}
+
+func TestParseResponse(t *testing.T) {
+	idp, key := newTestIdentityProvider(t)
+
+	assertion, err := ParseResponse(encode(responseXML(signedAssertionXML(t, key, "_assertion-1", defaultAssertionOptions()))), testSP, idp, testRequestID, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assertion.NameID != "alice-id" || assertion.SessionIndex != "session-1" ||
+		assertion.Attribute("email") != "alice@corp.example.com" ||
+		assertion.Attribute("http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress") != "alice@corp.example.com" ||
+		assertion.Attribute("department") != "Engineering" {
+		t.Fatalf("unexpected assertion %+v", assertion)
+	}
+}
+
+func TestParseResponseSignedResponse(t *testing.T) {
+	idp, key := newTestIdentityProvider(t)
+
+	response := signedResponseXML(t, key, assertionXML("_assertion-1", "", defaultAssertionOptions()))
+
+	assertion, err := ParseResponse(encode(response), testSP, idp, testRequestID, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assertion.NameID != "alice-id" || assertion.Attribute("email") != "alice@corp.example.com" {
+		t.Fatalf("unexpected assertion %+v", assertion)
+	}
+
+	// an assertion signed on its own inside the signed response is verified as well
+	response = signedResponseXML(t, key, signedAssertionXML(t, key, "_assertion-1", defaultAssertionOptions()))
+	if _, err := ParseResponse(encode(response), testSP, idp, testRequestID, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the signed response wrapped in a forged one, which only adds an unsigned assertion
+	forged := defaultAssertionOptions()
+	forged.nameID = "admin-id"
+	wrapped := strings.Replace(responseXML(assertionXML("_assertion-2", "", forged)), `ID="_response-1"`, `ID="_response-2"`, 1)
+	wrapped = strings.Replace(wrapped, "<samlp:Status>", "<samlp:Extensions>"+response+"</samlp:Extensions><samlp:Status>", 1)
+	if _, err := ParseResponse(encode(wrapped), testSP, idp, testRequestID, time.Now()); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("wrapped response: got %v, want %v", err, ErrInvalidSignature)
+	}
+
+	// an assertion added to the signed response breaks its digest
+	added := strings.Replace(response, "</samlp:Response>", assertionXML("_assertion-2", "", forged)+"</samlp:Response>", 1)
+	if _, err := ParseResponse(encode(added), testSP, idp, testRequestID, time.Now()); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("assertion added to the signed response: got %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestParseResponseRejects(t *testing.T) {
+	idp, key := newTestIdentityProvider(t)
+	otherIdP, _ := newTestIdentityProvider(t)
+
+	wrongAudience := defaultAssertionOptions()
+	wrongAudience.audience = "https://other.example.com"
+
+	wrongRequest := defaultAssertionOptions()
+	wrongRequest.inResponseTo = "_request-2"
+
+	expired := defaultAssertionOptions()
+	expired.notOnOrAfter = time.Now().Add(-10 * time.Minute)
+
+	signed := signedAssertionXML(t, key, "_assertion-1", defaultAssertionOptions())
+
+	tests := []struct {
+		name     string
+		response string
+		idp      *IdentityProvider
+		want     error
+	}{
+		{name: "unsigned", response: responseXML(assertionXML("_assertion-1", "", defaultAssertionOptions())), idp: idp, want: ErrInvalidSignature},
+		{name: "tampered", response: responseXML(strings.Replace(signed, "alice@corp.example.com", "admin@corp.example.com", 1)), idp: idp, want: ErrInvalidSignature},
+		{name: "other identity provider key", response: responseXML(signed), idp: otherIdP, want: ErrInvalidSignature},
+		{name: "wrong audience", response: responseXML(signedAssertionXML(t, key, "_assertion-1", wrongAudience)), idp: idp, want: ErrInvalidResponse},
+		{name: "other request", response: responseXML(signedAssertionXML(t, key, "_assertion-1", wrongRequest)), idp: idp, want: ErrInvalidResponse},
+		{name: "expired", response: responseXML(signedAssertionXML(t, key, "_assertion-1", expired)), idp: idp, want: ErrInvalidResponse},
+		{
+			// a second, forged assertion next to the signed one
+			name:     "wrapped",
+			response: responseXML(signed + assertionXML("_assertion-2", "", defaultAssertionOptions())),
+			idp:      idp,
+			want:     ErrInvalidResponse,
+		},
+		{
+			// the signed assertion hidden in an extension, a forged one with the same ID in its place
+			name: "duplicate id",
+			response: strings.Replace(responseXML(assertionXML("_assertion-1", "", defaultAssertionOptions())),
+				"<samlp:Status>", "<samlp:Extensions>"+signed+"</samlp:Extensions><samlp:Status>", 1),
+			idp:  idp,
+			want: ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseResponse(encode(tt.response), testSP, tt.idp, testRequestID, time.Now())
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("ParseResponse() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMetadata(t *testing.T) {
+	idp, _ := newTestIdentityProvider(t)
+
+	metadata := fmt.Sprintf(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>
+        %s
+      </ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="%s"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, idp.EntityID, idp.Certificates[0], idp.SSOURL)
+
+	parsed, err := ParseMetadata([]byte(metadata))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.EntityID != idp.EntityID || parsed.SSOURL != idp.SSOURL ||
+		len(parsed.Certificates) != 1 || parsed.Certificates[0] != idp.Certificates[0] {
+		t.Fatalf("unexpected identity provider %+v", parsed)
+	}
+
+	if _, err := ParseMetadata([]byte(`<!DOCTYPE x [<!ENTITY e "e">]><x/>`)); !errors.Is(err, ErrInvalidMetadata) {
+		t.Fatalf("expected doctype to be refused, got %v", err)
+	}
+}
+
+func TestAuthnRequestURL(t *testing.T) {
+	idp, _ := newTestIdentityProvider(t)
+
+	location, err := AuthnRequestURL(testSP, idp, testRequestID, "relay", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u.Query().Get("tenant") != "1" || u.Query().Get("RelayState") != "relay" {
+		t.Fatalf("unexpected url %s", location)
+	}
+
+	deflated, err := base64.StdEncoding.DecodeString(u.Query().Get("SAMLRequest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request, err := io.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := parseXML(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if root.Local != "AuthnRequest" || root.attr("ID") != testRequestID ||
+		root.attr("AssertionConsumerServiceURL") != testSP.ACSURL ||
+		root.child(assertionNamespace, "Issuer").text() != testSP.EntityID {
+		t.Fatalf("unexpected request %s", request)
+	}
+}
@@ -0,0 +1,239 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A minimal namespace aware XML tree to read SAML documents. Unlike etree it resolves the
+// prefixes of every element and attribute, and its text joins all character data of an element.
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+type attr struct {
+	Prefix string
+	Local  string
+	Space  string
+	Value  string
+}
+
+type element struct {
+	Prefix string
+	Local  string
+	Space  string
+	Attrs  []attr
+	// Children *element or string
+	Children []any
+
+	// inScope prefix to namespace, "" the default namespace
+	inScope map[string]string
+}
+
+// parseXML parses a document, DTDs are refused
+func parseXML(b []byte) (*element, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(b))
+	decoder.Strict = true
+
+	var root *element
+	stack := make([]*element, 0, 16)
+
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			parentScope := map[string]string{}
+			if len(stack) > 0 {
+				parentScope = stack[len(stack)-1].inScope
+			} else if root != nil {
+				return nil, errors.New("multiple root elements")
+			}
+
+			e, err := newElement(t, parentScope)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, e)
+			} else {
+				root = e
+			}
+			stack = append(stack, e)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, errors.New("unexpected end element")
+			}
+
+			current := stack[len(stack)-1]
+			if current.Prefix != t.Name.Space || current.Local != t.Name.Local {
+				return nil, fmt.Errorf("element %s closed by %s", current.Local, t.Name.Local)
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				current := stack[len(stack)-1]
+				current.Children = append(current.Children, string(t))
+			}
+		case xml.Directive:
+			return nil, errors.New("xml directives are not allowed")
+		}
+	}
+
+	if root == nil || len(stack) > 0 {
+		return nil, errors.New("incomplete xml document")
+	}
+
+	return root, nil
+}
+
+func newElement(t xml.StartElement, parentScope map[string]string) (*element, error) {
+	e := &element{
+		Prefix:  t.Name.Space,
+		Local:   t.Name.Local,
+		inScope: parentScope,
+	}
+
+	declared := false
+	for _, a := range t.Attr {
+		switch {
+		case a.Name.Space == "xmlns":
+			if !declared {
+				e.inScope = copyScope(parentScope)
+				declared = true
+			}
+			e.inScope[a.Name.Local] = a.Value
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			if !declared {
+				e.inScope = copyScope(parentScope)
+				declared = true
+			}
+			e.inScope[""] = a.Value
+		}
+	}
+
+	space, ok := resolvePrefix(e.inScope, e.Prefix)
+	if !ok {
+		return nil, fmt.Errorf("unbound prefix %s", e.Prefix)
+	}
+	e.Space = space
+
+	for _, a := range t.Attr {
+		if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") {
+			continue
+		}
+
+		// unprefixed attributes are in no namespace
+		attrSpace := ""
+		if a.Name.Space != "" {
+			attrSpace, ok = resolvePrefix(e.inScope, a.Name.Space)
+			if !ok {
+				return nil, fmt.Errorf("unbound prefix %s", a.Name.Space)
+			}
+		}
+
+		e.Attrs = append(e.Attrs, attr{
+			Prefix: a.Name.Space,
+			Local:  a.Name.Local,
+			Space:  attrSpace,
+			Value:  a.Value,
+		})
+	}
+
+	return e, nil
+}
+
+func resolvePrefix(scope map[string]string, prefix string) (string, bool) {
+	if prefix == "xml" {
+		return xmlNamespace, true
+	}
+
+	space, ok := scope[prefix]
+	if !ok && prefix == "" {
+		return "", true
+	}
+
+	return space, ok
+}
+
+func copyScope(scope map[string]string) map[string]string {
+	c := make(map[string]string, len(scope)+1)
+	for k, v := range scope {
+		c[k] = v
+	}
+	return c
+}
+
+// attr the value of an attribute without namespace
+func (e *element) attr(local string) string {
+	for _, a := range e.Attrs {
+		if a.Space == "" && a.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (e *element) children(space string, local string) []*element {
+	result := make([]*element, 0, 1)
+	for _, c := range e.Children {
+		if child, ok := c.(*element); ok && child.Space == space && child.Local == local {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+func (e *element) child(space string, local string) *element {
+	for _, c := range e.Children {
+		if child, ok := c.(*element); ok && child.Space == space && child.Local == local {
+			return child
+		}
+	}
+	return nil
+}
+
+// text the character data of the element, trimmed
+func (e *element) text() string {
+	var b strings.Builder
+	for _, c := range e.Children {
+		if s, ok := c.(string); ok {
+			b.WriteString(s)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// walk visits the element and its descendants in document order
+func (e *element) walk(fn func(*element)) {
+	fn(e)
+	for _, c := range e.Children {
+		if child, ok := c.(*element); ok {
+			child.walk(fn)
+		}
+	}
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
@@ -0,0 +1,86 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"kiwi-user/config"
+	"kiwi-user/internal/infrastructure/aliyun"
+	"net/url"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// Short Message Service of Alibaba Cloud (https://help.aliyun.com/document_detail/419273.html)
+
+const (
+	alibabaAPIVersion = "2017-05-25"
+	alibabaAction     = "SendSms"
+)
+
+type alibabaResponse struct {
+	RequestID string `json:"RequestId"`
+	Code      string `json:"Code"`
+	Message   string `json:"Message"`
+	BizID     string `json:"BizId"`
+}
+
+type alibabaSender struct {
+	endpoint        string
+	accessKeyID     string
+	accessKeySecret string
+	signName        string
+	templateCode    string
+	codeParam       string
+	httpClient      *xhttp.Client
+}
+
+func newAlibabaSender(config *config.Config, httpClient *xhttp.Client) *alibabaSender {
+	return &alibabaSender{
+		endpoint:        config.Sms.AlibabaEndpoint,
+		accessKeyID:     config.Sms.AlibabaAccessKeyID,
+		accessKeySecret: config.Sms.AlibabaAccessKeySecret,
+		signName:        config.Sms.AlibabaSignName,
+		templateCode:    config.Sms.AlibabaTemplateCode,
+		codeParam:       config.Sms.CodeParam,
+		httpClient:      httpClient,
+	}
+}
+
+func (a *alibabaSender) Name() string {
+	return ProviderAlibaba
+}
+
+func (a *alibabaSender) SendVerifyCode(ctx context.Context, phone string, code string) error {
+	if a.endpoint == "" || a.accessKeyID == "" || a.accessKeySecret == "" || a.signName == "" || a.templateCode == "" {
+		return xerror.Wrap(ErrNotConfigured)
+	}
+
+	templateParam, err := json.Marshal(map[string]string{a.codeParam: code})
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	params := url.Values{}
+	params.Set("PhoneNumbers", phone)
+	params.Set("SignName", a.signName)
+	params.Set("TemplateCode", a.templateCode)
+	params.Set("TemplateParam", string(templateParam))
+
+	b, status, err := aliyun.CallRPC(ctx, a.httpClient, a.endpoint, a.accessKeyID, a.accessKeySecret, alibabaAction, alibabaAPIVersion, params)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	var response alibabaResponse
+	if err := json.Unmarshal(b, &response); err != nil {
+		return xerror.Wrap(fmt.Errorf("alibaba sms response status %d: %w", status, err))
+	}
+
+	if response.Code != "OK" {
+		return xerror.Wrap(fmt.Errorf("alibaba sms error: %s, %s, request %s", response.Code, response.Message, response.RequestID))
+	}
+
+	return nil
+}
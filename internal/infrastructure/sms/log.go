@@ -0,0 +1,56 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/futurxlab/golanggraph/logger"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// LogSender a development sink, the codes are logged and appended to a file instead of being sent.
+// It must not be in the providers of a production application.
+type LogSender struct {
+	file   string
+	logger logger.ILogger
+	mu     sync.Mutex
+}
+
+func NewLogSender(file string, logger logger.ILogger) *LogSender {
+	return &LogSender{
+		file:   file,
+		logger: logger,
+	}
+}
+
+func (l *LogSender) Name() string {
+	return ProviderLog
+}
+
+func (l *LogSender) SendVerifyCode(ctx context.Context, phone string, code string) error {
+	if l.logger != nil {
+		l.logger.Infof(ctx, "sms verification code of %s: %s", phone, code)
+	}
+
+	if l.file == "" {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.OpenFile(l.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintf(f, "%s %s %s\n", time.Now().Format(time.RFC3339), phone, code); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
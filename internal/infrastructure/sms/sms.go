@@ -0,0 +1,54 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"kiwi-user/config"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/client/volcengine/msgsms"
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/logger"
+)
+
+// The codes are generated and verified by kiwi-user, a provider only delivers the message, so any
+// of them can take over when another one fails.
+
+const (
+	ProviderVolcengine = "volcengine"
+	ProviderAlibaba    = "alibaba"
+	ProviderTwilio     = "twilio"
+	// ProviderLog writes the codes to the log instead of sending them, for local development
+	ProviderLog = "log"
+)
+
+var ErrNotConfigured = errors.New("sms provider is not configured")
+
+// Sender delivers a verification code to a phone number
+type Sender interface {
+	Name() string
+	SendVerifyCode(ctx context.Context, phone string, code string) error
+}
+
+// Senders the senders keyed by provider name
+type Senders map[string]Sender
+
+// NewSenders every provider of the sms config, unconfigured ones fail with ErrNotConfigured
+func NewSenders(config *config.Config, httpClient *xhttp.Client, smsClient msgsms.SmsClient, logger logger.ILogger) Senders {
+	return Senders{
+		ProviderVolcengine: newVolcengineSender(config, smsClient),
+		ProviderAlibaba:    newAlibabaSender(config, httpClient),
+		ProviderTwilio:     newTwilioSender(config, httpClient),
+		ProviderLog:        NewLogSender(config.Sms.LogFile, logger),
+	}
+}
+
+// Ordered the senders of the providers in the given order, unknown names are skipped
+func (s Senders) Ordered(names []string) []Sender {
+	senders := make([]Sender, 0, len(names))
+	for _, name := range names {
+		if sender, ok := s[name]; ok {
+			senders = append(senders, sender)
+		}
+	}
+	return senders
+}
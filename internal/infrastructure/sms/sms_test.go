@@ -0,0 +1,54 @@
+package sms
+
+import (
+	"context"
+	"kiwi-user/config"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+func TestLogSenderAppendsCodes(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "sms.log")
+	sender := NewLogSender(file, nil)
+
+	for _, code := range []string{"123456", "654321"} {
+		if err := sender.SendVerifyCode(context.Background(), "+8613800000000", code); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[1], "+8613800000000 654321") {
+		t.Fatalf("unexpected log file %q", string(b))
+	}
+}
+
+func TestSendersOrdered(t *testing.T) {
+	cfg := &config.Config{Sms: &config.SmsConfig{}}
+	senders := NewSenders(cfg, nil, nil, nil)
+
+	ordered := senders.Ordered([]string{ProviderTwilio, "huawei", ProviderLog})
+	if len(ordered) != 2 || ordered[0].Name() != ProviderTwilio || ordered[1].Name() != ProviderLog {
+		t.Fatalf("unexpected senders %v", ordered)
+	}
+}
+
+func TestSendersNotConfigured(t *testing.T) {
+	cfg := &config.Config{Sms: &config.SmsConfig{}}
+	senders := NewSenders(cfg, nil, nil, nil)
+
+	for _, name := range []string{ProviderVolcengine, ProviderAlibaba, ProviderTwilio} {
+		if err := senders[name].SendVerifyCode(context.Background(), "+8613800000000", "123456"); !xerror.Is(err, ErrNotConfigured) {
+			t.Fatalf("expected %s to be not configured, got %v", name, err)
+		}
+	}
+}
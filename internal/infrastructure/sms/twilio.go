@@ -0,0 +1,96 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"kiwi-user/config"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// Programmable Messaging of Twilio (https://www.twilio.com/docs/messaging/api/message-resource),
+// the phone number has to be in E.164 format
+
+type twilioError struct {
+	Code     int    `json:"code"`
+	Message  string `json:"message"`
+	MoreInfo string `json:"more_info"`
+}
+
+type twilioSender struct {
+	endpoint            string
+	accountSID          string
+	authToken           string
+	from                string
+	messagingServiceSID string
+	message             string
+	httpClient          *xhttp.Client
+}
+
+func newTwilioSender(config *config.Config, httpClient *xhttp.Client) *twilioSender {
+	return &twilioSender{
+		endpoint:            config.Sms.TwilioEndpoint,
+		accountSID:          config.Sms.TwilioAccountSID,
+		authToken:           config.Sms.TwilioAuthToken,
+		from:                config.Sms.TwilioFrom,
+		messagingServiceSID: config.Sms.TwilioMessagingServiceSID,
+		message:             config.Sms.TwilioMessage,
+		httpClient:          httpClient,
+	}
+}
+
+func (t *twilioSender) Name() string {
+	return ProviderTwilio
+}
+
+func (t *twilioSender) SendVerifyCode(ctx context.Context, phone string, code string) error {
+	if t.endpoint == "" || t.accountSID == "" || t.authToken == "" || (t.from == "" && t.messagingServiceSID == "") {
+		return xerror.Wrap(ErrNotConfigured)
+	}
+
+	form := url.Values{}
+	form.Set("To", phone)
+	form.Set("Body", strings.ReplaceAll(t.message, "{code}", code))
+	if t.messagingServiceSID != "" {
+		form.Set("MessagingServiceSid", t.messagingServiceSID)
+	} else {
+		form.Set("From", t.from)
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", strings.TrimRight(t.endpoint, "/"), url.PathEscape(t.accountSID))
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.SetBasicAuth(t.accountSID, t.authToken)
+
+	resp, err := t.httpClient.Do(request)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return xerror.Wrap(fmt.Errorf("twilio response status %d: %w", resp.StatusCode, err))
+	}
+
+	var response twilioError
+	if err := json.Unmarshal(b, &response); err != nil {
+		return xerror.Wrap(fmt.Errorf("twilio response status %d: %w", resp.StatusCode, err))
+	}
+
+	return xerror.Wrap(fmt.Errorf("twilio error: %d, %s, %s", response.Code, response.Message, response.MoreInfo))
+}
@@ -0,0 +1,59 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"kiwi-user/config"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/client/volcengine/msgsms"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// volcengineSender sends the code with the verify template as a plain template message, the
+// verification service of volcengine is not used
+type volcengineSender struct {
+	smsClient  msgsms.SmsClient
+	templateID string
+	codeParam  string
+	configured bool
+}
+
+func newVolcengineSender(config *config.Config, smsClient msgsms.SmsClient) *volcengineSender {
+	return &volcengineSender{
+		smsClient:  smsClient,
+		templateID: config.Sms.VerifyTemplateID,
+		codeParam:  config.Sms.CodeParam,
+		configured: config.Sms.AccessKey != "" && config.Sms.SecretKey != "",
+	}
+}
+
+func (v *volcengineSender) Name() string {
+	return ProviderVolcengine
+}
+
+func (v *volcengineSender) SendVerifyCode(ctx context.Context, phone string, code string) error {
+	if !v.configured || v.templateID == "" || v.smsClient == nil {
+		return xerror.Wrap(ErrNotConfigured)
+	}
+
+	param, err := json.Marshal(map[string]string{v.codeParam: code})
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	result, _, err := v.smsClient.SendSms([]string{phone}, v.templateID, string(param))
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if result == nil {
+		return xerror.Wrap(errors.New("volcengine sms no response"))
+	}
+
+	if result.ResponseMetadata.Error != nil {
+		return xerror.Wrap(errors.New(result.ResponseMetadata.Error.Message))
+	}
+
+	return nil
+}
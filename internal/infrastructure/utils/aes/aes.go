@@ -6,6 +6,7 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"io"
 )
 
@@ -67,3 +68,53 @@ func pkcs7UnPadding(data []byte) []byte {
 	padding := int(data[len(data)-1])
 	return data[:len(data)-padding]
 }
+
+// GCMEncrypt authenticated encryption with AES-GCM, the nonce is prepended to the ciphertext
+func GCMEncrypt(plaintext string, key []byte) (string, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(plaintext), nil)), nil
+}
+
+// GCMDecrypt opens a ciphertext of GCMEncrypt, a tampered one or a wrong key fails
+func GCMDecrypt(ciphertext string, key []byte) (string, error) {
+	ciphertextBytes, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	if len(ciphertextBytes) < gcm.NonceSize() {
+		return "", errors.New("ciphertext too short")
+	}
+
+	nonce := ciphertextBytes[:gcm.NonceSize()]
+	plaintext, err := gcm.Open(nil, nonce, ciphertextBytes[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
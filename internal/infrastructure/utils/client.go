@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"context"
+	"strings"
+)
+
+// ClientContextKey key of the requesting client set by the api server. It is a string so a
+// *gin.Context passed down as context.Context resolves it.
+const ClientContextKey = "kiwi_user_client"
+
+// maxUserAgentLength user agents are free text sent by the client, keep what is worth showing
+const maxUserAgentLength = 512
+
+type Client struct {
+	IP        string
+	UserAgent string
+}
+
+func NewClient(ip string, userAgent string) Client {
+	if len(userAgent) > maxUserAgentLength {
+		// the cut may split a multibyte character
+		userAgent = strings.ToValidUTF8(userAgent[:maxUserAgentLength], "")
+	}
+
+	return Client{
+		IP:        ip,
+		UserAgent: userAgent,
+	}
+}
+
+// ClientFromContext the requesting client, empty outside of a request
+func ClientFromContext(ctx context.Context) Client {
+	if client, ok := ctx.Value(ClientContextKey).(Client); ok {
+		return client
+	}
+
+	return Client{}
+}
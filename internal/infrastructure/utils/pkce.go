@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+)
+
+const (
+	PKCEMethodPlain = "plain"
+	PKCEMethodS256  = "S256"
+)
+
+// VerifyPKCE checks the code_verifier against the code_challenge, see RFC 7636
+func VerifyPKCE(codeVerifier string, codeChallenge string, method string) bool {
+	if codeVerifier == "" || codeChallenge == "" {
+		return false
+	}
+
+	var computed string
+	switch method {
+	case PKCEMethodS256:
+		sum := sha256.Sum256([]byte(codeVerifier))
+		computed = base64.RawURLEncoding.EncodeToString(sum[:])
+	case PKCEMethodPlain:
+		computed = codeVerifier
+	default:
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(codeChallenge)) == 1
+}
+
+// RandomURLSafeToken returns a base64url string of n random bytes from crypto/rand
+func RandomURLSafeToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Sha256 加密
+func Sha256(data string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(data)))
+}
@@ -0,0 +1,25 @@
+package utils
+
+import "testing"
+
+func TestVerifyPKCE(t *testing.T) {
+	// RFC 7636 Appendix B
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	if !VerifyPKCE(verifier, challenge, PKCEMethodS256) {
+		t.Fatal("expected S256 challenge to match")
+	}
+
+	if VerifyPKCE(verifier+"x", challenge, PKCEMethodS256) {
+		t.Fatal("expected wrong verifier to fail")
+	}
+
+	if !VerifyPKCE(verifier, verifier, PKCEMethodPlain) {
+		t.Fatal("expected plain challenge to match")
+	}
+
+	if VerifyPKCE(verifier, challenge, "unknown") {
+		t.Fatal("expected unknown method to fail")
+	}
+}
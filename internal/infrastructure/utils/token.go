@@ -1,8 +1,12 @@
 package utils
 
 import (
+	"crypto/hmac"
+	crand "crypto/rand"
 	"crypto/sha1"
+	"crypto/sha256"
 	"fmt"
+	"math/big"
 	"math/rand"
 	"time"
 )
@@ -15,22 +19,12 @@ func init() {
 	rd = rand.New(rand.NewSource(time.Now().UnixNano()))
 }
 
-// NewSHA1Hash generates a new SHA1 hash based on
-// a random number of characters.
-func GenerateRefreshToken(prefix string, n ...int) string {
-	noRandomCharacters := 32
+// refreshTokenBytes 256 bits of entropy
+const refreshTokenBytes = 32
 
-	if len(n) > 0 {
-		noRandomCharacters = n[0]
-	}
-
-	randString := RandomString(noRandomCharacters)
-
-	hash := sha1.New()
-	hash.Write([]byte(prefix + randString))
-	bs := hash.Sum(nil)
-
-	return fmt.Sprintf("%x", bs)
+// GenerateRefreshToken returns a base64url refresh token from crypto/rand
+func GenerateRefreshToken() (string, error) {
+	return RandomURLSafeToken(refreshTokenBytes)
 }
 
 // RandomString generates a random string of n length
@@ -57,9 +51,29 @@ func GenerateVerificationCode() string {
 	return fmt.Sprintf("%06d", code)
 }
 
+// GenerateNumericCode returns n random digits from crypto/rand, leading zeros included
+func GenerateNumericCode(n int) (string, error) {
+	b := make([]byte, n)
+	for i := range b {
+		digit, err := crand.Int(crand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		b[i] = byte('0' + digit.Int64())
+	}
+	return string(b), nil
+}
+
 // SHA1 加密
 func Sha1(data string) string {
 	h := sha1.New()
 	h.Write([]byte(data))
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
+
+// HmacSha256 keyed hash, hex encoded
+func HmacSha256(key []byte, data string) string {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
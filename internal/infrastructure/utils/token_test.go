@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -12,3 +13,16 @@ func TestTokenGenerate(t *testing.T) {
 		fmt.Println(token)
 	}
 }
+
+func TestGenerateNumericCode(t *testing.T) {
+	for _, n := range []int{4, 6, 8} {
+		code, err := GenerateNumericCode(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(code) != n || strings.Trim(code, "0123456789") != "" {
+			t.Fatalf("expected %d digits, got %q", n, code)
+		}
+	}
+}
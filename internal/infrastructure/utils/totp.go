@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	TOTPPeriod = 30
+	TOTPDigits = 6
+
+	totpSecretBytes = 20
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a random base32 secret, see RFC 6238
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPCode computes the code of the time step containing t
+func TOTPCode(secret string, t time.Time) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+
+	return hotp(key, uint64(t.Unix()/TOTPPeriod)), nil
+}
+
+// VerifyTOTP accepts the code of the current time step and of skew steps around it
+func VerifyTOTP(secret string, code string, t time.Time, skew int) bool {
+	_, ok := MatchTOTP(secret, code, t, skew)
+	return ok
+}
+
+// MatchTOTP like VerifyTOTP, returns the time step the code belongs to
+func MatchTOTP(secret string, code string, t time.Time, skew int) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	counter := t.Unix() / TOTPPeriod
+	for i := -skew; i <= skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(key, uint64(counter+int64(i)))), []byte(code)) == 1 {
+			return counter + int64(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// TOTPProvisioningURI otpauth uri rendered as QR code by authenticator apps
+func TOTPProvisioningURI(issuer string, account string, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", TOTPDigits))
+	query.Set("period", fmt.Sprintf("%d", TOTPPeriod))
+
+	label := url.PathEscape(issuer + ":" + account)
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, query.Encode())
+}
+
+// hotp RFC 4226
+func hotp(key []byte, counter uint64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%mod)
+}
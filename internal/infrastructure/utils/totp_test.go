@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 Appendix B, SHA1 seed, last 6 of the 8 digit codes
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, expected := range cases {
+		code, err := TOTPCode(secret, time.Unix(unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if code != expected {
+			t.Fatalf("time %d: expected %s, got %s", unix, expected, code)
+		}
+	}
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	previous, _ := TOTPCode(secret, now.Add(-TOTPPeriod*time.Second))
+
+	if !VerifyTOTP(secret, previous, now, 1) {
+		t.Fatal("expected previous step to be accepted with skew 1")
+	}
+
+	if VerifyTOTP(secret, previous, now, 0) {
+		t.Fatal("expected previous step to be rejected without skew")
+	}
+
+	if VerifyTOTP(secret, "12345", now, 1) {
+		t.Fatal("expected short code to be rejected")
+	}
+
+	step, ok := MatchTOTP(secret, previous, now, 1)
+	if !ok || step != now.Unix()/TOTPPeriod-1 {
+		t.Fatalf("expected the previous step, got %d %v", step, ok)
+	}
+}
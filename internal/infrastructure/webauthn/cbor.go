@@ -0,0 +1,147 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+var ErrInvalidCBOR = errors.New("invalid cbor")
+
+// maxCBORDepth guards against deeply nested input from untrusted authenticators
+const maxCBORDepth = 16
+
+// decodeCBOR decodes the subset of CBOR (RFC 8949) used by WebAuthn: integers, byte and text strings,
+// arrays, maps, booleans and null. It returns the value and the number of bytes consumed,
+// the credential public key inside authenticator data is followed by more data.
+//
+// unsigned and negative integers decode to int64, maps to map[any]any
+func decodeCBOR(data []byte) (any, int, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (any, int, error) {
+	if depth > maxCBORDepth || len(data) == 0 {
+		return nil, 0, ErrInvalidCBOR
+	}
+
+	major := data[0] >> 5
+	info := data[0] & 0x1f
+
+	if major == 7 {
+		switch info {
+		case 20:
+			return false, 1, nil
+		case 21:
+			return true, 1, nil
+		case 22, 23:
+			return nil, 1, nil
+		default:
+			return nil, 0, ErrInvalidCBOR
+		}
+	}
+
+	arg, n, err := decodeCBORArgument(data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return nil, 0, ErrInvalidCBOR
+		}
+		return int64(arg), n, nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, 0, ErrInvalidCBOR
+		}
+		return -1 - int64(arg), n, nil
+	case 2, 3:
+		if arg > uint64(len(data)-n) {
+			return nil, 0, ErrInvalidCBOR
+		}
+		end := n + int(arg)
+		if major == 2 {
+			b := make([]byte, arg)
+			copy(b, data[n:end])
+			return b, end, nil
+		}
+		return string(data[n:end]), end, nil
+	case 4:
+		if arg > uint64(len(data)) {
+			return nil, 0, ErrInvalidCBOR
+		}
+		items := make([]any, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			item, m, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			items = append(items, item)
+			n += m
+		}
+		return items, n, nil
+	case 5:
+		if arg > uint64(len(data)) {
+			return nil, 0, ErrInvalidCBOR
+		}
+		items := make(map[any]any, arg)
+		for i := uint64(0); i < arg; i++ {
+			key, m, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			n += m
+
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, 0, ErrInvalidCBOR
+			}
+
+			value, m, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			n += m
+
+			items[key] = value
+		}
+		return items, n, nil
+	default:
+		// tags and indefinite lengths are not used by WebAuthn
+		return nil, 0, ErrInvalidCBOR
+	}
+}
+
+func decodeCBORArgument(data []byte) (uint64, int, error) {
+	info := data[0] & 0x1f
+
+	switch {
+	case info < 24:
+		return uint64(info), 1, nil
+	case info == 24:
+		if len(data) < 2 {
+			return 0, 0, ErrInvalidCBOR
+		}
+		return uint64(data[1]), 2, nil
+	case info == 25:
+		if len(data) < 3 {
+			return 0, 0, ErrInvalidCBOR
+		}
+		return uint64(binary.BigEndian.Uint16(data[1:3])), 3, nil
+	case info == 26:
+		if len(data) < 5 {
+			return 0, 0, ErrInvalidCBOR
+		}
+		return uint64(binary.BigEndian.Uint32(data[1:5])), 5, nil
+	case info == 27:
+		if len(data) < 9 {
+			return 0, 0, ErrInvalidCBOR
+		}
+		return binary.BigEndian.Uint64(data[1:9]), 9, nil
+	default:
+		return 0, 0, ErrInvalidCBOR
+	}
+}
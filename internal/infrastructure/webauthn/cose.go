@@ -0,0 +1,120 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+// COSE algorithm identifiers offered in pubKeyCredParams, see https://www.iana.org/assignments/cose
+const (
+	COSEAlgES256 int64 = -7
+	COSEAlgEdDSA int64 = -8
+	COSEAlgRS256 int64 = -257
+
+	coseKtyOKP int64 = 1
+	coseKtyEC2 int64 = 2
+	coseKtyRSA int64 = 3
+
+	coseCrvP256    int64 = 1
+	coseCrvEd25519 int64 = 6
+)
+
+var ErrUnsupportedKey = errors.New("unsupported credential public key")
+
+// SupportedAlgorithms in order of preference
+func SupportedAlgorithms() []int64 {
+	return []int64{COSEAlgES256, COSEAlgEdDSA, COSEAlgRS256}
+}
+
+// parseCOSEKey decodes a COSE encoded public key (RFC 9053) of one of the supported algorithms
+func parseCOSEKey(coseKey []byte) (crypto.PublicKey, error) {
+	decoded, _, err := decodeCBOR(coseKey)
+	if err != nil {
+		return nil, ErrUnsupportedKey
+	}
+
+	key, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, ErrUnsupportedKey
+	}
+
+	kty, _ := key[int64(1)].(int64)
+	alg, _ := key[int64(3)].(int64)
+
+	switch {
+	case kty == coseKtyEC2 && alg == COSEAlgES256:
+		crv, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		y, _ := key[int64(-3)].([]byte)
+		if crv != coseCrvP256 || len(x) != 32 || len(y) != 32 {
+			return nil, ErrUnsupportedKey
+		}
+
+		publicKey := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !publicKey.Curve.IsOnCurve(publicKey.X, publicKey.Y) {
+			return nil, ErrUnsupportedKey
+		}
+
+		return publicKey, nil
+	case kty == coseKtyOKP && alg == COSEAlgEdDSA:
+		crv, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		if crv != coseCrvEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, ErrUnsupportedKey
+		}
+
+		return ed25519.PublicKey(x), nil
+	case kty == coseKtyRSA && alg == COSEAlgRS256:
+		n, _ := key[int64(-1)].([]byte)
+		e, _ := key[int64(-2)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, ErrUnsupportedKey
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+// verifySignature checks signature over data with a COSE encoded public key
+func verifySignature(coseKey []byte, data []byte, signature []byte) error {
+	publicKey, err := parseCOSEKey(coseKey)
+	if err != nil {
+		return err
+	}
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		hashed := sha256.Sum256(data)
+		if !ecdsa.VerifyASN1(key, hashed[:], signature) {
+			return ErrInvalidSignature
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, signature) {
+			return ErrInvalidSignature
+		}
+	case *rsa.PublicKey:
+		hashed := sha256.Sum256(data)
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], signature); err != nil {
+			return ErrInvalidSignature
+		}
+	default:
+		return ErrUnsupportedKey
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+// Ceremony verification for WebAuthn Level 2 (https://www.w3.org/TR/webauthn-2/).
+// Registrations request attestation "none", the attestation statement is therefore not verified:
+// the credential is trusted because the user registering it is already logged in.
+
+const (
+	ClientDataTypeCreate = "webauthn.create"
+	ClientDataTypeGet    = "webauthn.get"
+
+	flagUserPresent            byte = 0x01
+	flagUserVerified           byte = 0x04
+	flagAttestedCredentialData byte = 0x40
+
+	authenticatorDataMinLength = 37
+)
+
+var (
+	ErrInvalidClientData        = errors.New("invalid client data")
+	ErrInvalidAuthenticatorData = errors.New("invalid authenticator data")
+	ErrChallengeMismatch        = errors.New("challenge mismatch")
+	ErrOriginNotAllowed         = errors.New("origin not allowed")
+	ErrRPIDMismatch             = errors.New("rp id hash mismatch")
+	ErrUserNotPresent           = errors.New("user not present")
+	ErrUserNotVerified          = errors.New("user not verified")
+	ErrInvalidSignature         = errors.New("invalid signature")
+	ErrSignCountRollback        = errors.New("sign count did not increase, the authenticator may be cloned")
+)
+
+// RelyingParty identifies this service to authenticators
+type RelyingParty struct {
+	ID      string
+	Name    string
+	Origins []string
+	// RequireUserVerification rejects authenticators that only tested user presence
+	RequireUserVerification bool
+}
+
+type ClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+type AuthenticatorData struct {
+	RPIDHash     []byte
+	Flags        byte
+	SignCount    uint32
+	AAGUID       []byte
+	CredentialID []byte
+	// PublicKey COSE encoded credential public key
+	PublicKey []byte
+}
+
+// Credential a verified registration
+type Credential struct {
+	ID        []byte
+	PublicKey []byte
+	SignCount uint32
+	AAGUID    []byte
+}
+
+// ParseClientData decodes clientDataJSON, the challenge is needed to look up the ceremony state
+func ParseClientData(clientDataJSON []byte) (*ClientData, error) {
+	clientData := &ClientData{}
+	if err := json.Unmarshal(clientDataJSON, clientData); err != nil {
+		return nil, ErrInvalidClientData
+	}
+
+	if clientData.Challenge == "" {
+		return nil, ErrInvalidClientData
+	}
+
+	return clientData, nil
+}
+
+// ParseAuthenticatorData decodes authenticator data, attested credential data is only present on registration
+func ParseAuthenticatorData(data []byte) (*AuthenticatorData, error) {
+	if len(data) < authenticatorDataMinLength {
+		return nil, ErrInvalidAuthenticatorData
+	}
+
+	authData := &AuthenticatorData{
+		RPIDHash:  data[:32],
+		Flags:     data[32],
+		SignCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	if authData.Flags&flagAttestedCredentialData == 0 {
+		return authData, nil
+	}
+
+	rest := data[authenticatorDataMinLength:]
+	if len(rest) < 18 {
+		return nil, ErrInvalidAuthenticatorData
+	}
+
+	authData.AAGUID = rest[:16]
+	idLength := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if idLength == 0 || len(rest) < idLength {
+		return nil, ErrInvalidAuthenticatorData
+	}
+
+	authData.CredentialID = rest[:idLength]
+	rest = rest[idLength:]
+
+	_, n, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, ErrInvalidAuthenticatorData
+	}
+	authData.PublicKey = rest[:n]
+
+	return authData, nil
+}
+
+// VerifyRegistration runs the registration ceremony checks and returns the new credential
+func (rp *RelyingParty) VerifyRegistration(challenge string, clientDataJSON []byte, attestationObject []byte) (*Credential, error) {
+	if err := rp.verifyClientData(clientDataJSON, ClientDataTypeCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	decoded, _, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, ErrInvalidAuthenticatorData
+	}
+
+	attestation, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, ErrInvalidAuthenticatorData
+	}
+
+	rawAuthData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, ErrInvalidAuthenticatorData
+	}
+
+	authData, err := ParseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rp.verifyAuthenticatorData(authData); err != nil {
+		return nil, err
+	}
+
+	if authData.CredentialID == nil {
+		return nil, ErrInvalidAuthenticatorData
+	}
+
+	// reject keys we could not verify assertions with later
+	if _, err := parseCOSEKey(authData.PublicKey); err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:        authData.CredentialID,
+		PublicKey: authData.PublicKey,
+		SignCount: authData.SignCount,
+		AAGUID:    authData.AAGUID,
+	}, nil
+}
+
+// VerifyAssertion runs the authentication ceremony checks against a stored credential and returns the new sign count
+func (rp *RelyingParty) VerifyAssertion(
+	challenge string,
+	clientDataJSON []byte,
+	authenticatorData []byte,
+	signature []byte,
+	publicKey []byte,
+	storedSignCount uint32) (uint32, error) {
+
+	if err := rp.verifyClientData(clientDataJSON, ClientDataTypeGet, challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := ParseAuthenticatorData(authenticatorData)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := rp.verifyAuthenticatorData(authData); err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(slices.Clone(authenticatorData), clientDataHash[:]...)
+	if err := verifySignature(publicKey, signed, signature); err != nil {
+		return 0, err
+	}
+
+	// synced passkeys always report zero
+	if (authData.SignCount != 0 || storedSignCount != 0) && authData.SignCount <= storedSignCount {
+		return 0, ErrSignCountRollback
+	}
+
+	return authData.SignCount, nil
+}
+
+func (rp *RelyingParty) verifyClientData(clientDataJSON []byte, ceremonyType string, challenge string) error {
+	clientData, err := ParseClientData(clientDataJSON)
+	if err != nil {
+		return err
+	}
+
+	if clientData.Type != ceremonyType {
+		return ErrInvalidClientData
+	}
+
+	if subtle.ConstantTimeCompare([]byte(clientData.Challenge), []byte(challenge)) != 1 {
+		return ErrChallengeMismatch
+	}
+
+	if !slices.Contains(rp.Origins, clientData.Origin) {
+		return ErrOriginNotAllowed
+	}
+
+	return nil
+}
+
+func (rp *RelyingParty) verifyAuthenticatorData(authData *AuthenticatorData) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(authData.RPIDHash, rpIDHash[:]) {
+		return ErrRPIDMismatch
+	}
+
+	if authData.Flags&flagUserPresent == 0 {
+		return ErrUserNotPresent
+	}
+
+	if rp.RequireUserVerification && authData.Flags&flagUserVerified == 0 {
+		return ErrUserNotVerified
+	}
+
+	return nil
+}
+
+// EncodeBase64URL the encoding WebAuthn JSON uses for binary values
+func EncodeBase64URL(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeBase64URL accepts padded and unpadded input
+func DecodeBase64URL(s string) ([]byte, error) {
+	if b, err := base64.RawURLEncoding.DecodeString(s); err == nil {
+		return b, nil
+	}
+
+	return base64.URLEncoding.DecodeString(s)
+}
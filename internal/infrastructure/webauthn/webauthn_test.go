@@ -0,0 +1,173 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// minimal cbor encoder for building authenticator responses
+func cborHead(major byte, n int) []byte {
+	switch {
+	case n < 24:
+		return []byte{major<<5 | byte(n)}
+	case n < 256:
+		return []byte{major<<5 | 24, byte(n)}
+	default:
+		return []byte{major<<5 | 25, byte(n >> 8), byte(n)}
+	}
+}
+
+func cborInt(v int) []byte {
+	if v < 0 {
+		return cborHead(1, -1-v)
+	}
+	return cborHead(0, v)
+}
+
+func cborBytes(b []byte) []byte {
+	return append(cborHead(2, len(b)), b...)
+}
+
+func cborText(s string) []byte {
+	return append(cborHead(3, len(s)), s...)
+}
+
+type testAuthenticator struct {
+	privateKey   *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testAuthenticator{
+		privateKey:   privateKey,
+		credentialID: []byte("test-credential-id"),
+	}
+}
+
+func (a *testAuthenticator) coseKey() []byte {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	a.privateKey.X.FillBytes(x)
+	a.privateKey.Y.FillBytes(y)
+
+	key := cborHead(5, 5)
+	key = append(key, cborInt(1)...)
+	key = append(key, cborInt(2)...)
+	key = append(key, cborInt(3)...)
+	key = append(key, cborInt(-7)...)
+	key = append(key, cborInt(-1)...)
+	key = append(key, cborInt(1)...)
+	key = append(key, cborInt(-2)...)
+	key = append(key, cborBytes(x)...)
+	key = append(key, cborInt(-3)...)
+	key = append(key, cborBytes(y)...)
+	return key
+}
+
+func (a *testAuthenticator) authData(rpID string, flags byte, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+		data = append(data, a.credentialID...)
+		data = append(data, a.coseKey()...)
+	}
+
+	return data
+}
+
+func clientDataJSON(t *testing.T, ceremonyType string, challenge string, origin string) []byte {
+	b, err := json.Marshal(ClientData{Type: ceremonyType, Challenge: challenge, Origin: origin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	rp := &RelyingParty{ID: "example.com", Origins: []string{"https://example.com"}, RequireUserVerification: true}
+	authenticator := newTestAuthenticator(t)
+
+	// registration, attestation "none"
+	attestationObject := cborHead(5, 3)
+	attestationObject = append(attestationObject, cborText("fmt")...)
+	attestationObject = append(attestationObject, cborText("none")...)
+	attestationObject = append(attestationObject, cborText("attStmt")...)
+	attestationObject = append(attestationObject, cborHead(5, 0)...)
+	attestationObject = append(attestationObject, cborText("authData")...)
+	attestationObject = append(attestationObject, cborBytes(authenticator.authData(rp.ID, flagUserPresent|flagUserVerified|flagAttestedCredentialData, true))...)
+
+	credential, err := rp.VerifyRegistration(
+		"register-challenge",
+		clientDataJSON(t, ClientDataTypeCreate, "register-challenge", "https://example.com"),
+		attestationObject)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(credential.ID) != string(authenticator.credentialID) {
+		t.Fatalf("unexpected credential id %q", credential.ID)
+	}
+
+	// assertion
+	assert := func(challenge string, origin string, signCount uint32, stored uint32) (uint32, error) {
+		authenticator.signCount = signCount
+		authData := authenticator.authData(rp.ID, flagUserPresent|flagUserVerified, false)
+		clientData := clientDataJSON(t, ClientDataTypeGet, challenge, origin)
+
+		clientDataHash := sha256.Sum256(clientData)
+		digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+		signature, err := ecdsa.SignASN1(rand.Reader, authenticator.privateKey, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return rp.VerifyAssertion("login-challenge", clientData, authData, signature, credential.PublicKey, stored)
+	}
+
+	signCount, err := assert("login-challenge", "https://example.com", 5, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signCount != 5 {
+		t.Fatalf("expected sign count 5, got %d", signCount)
+	}
+
+	if _, err := assert("other-challenge", "https://example.com", 6, 5); !errors.Is(err, ErrChallengeMismatch) {
+		t.Fatalf("expected challenge mismatch, got %v", err)
+	}
+
+	if _, err := assert("login-challenge", "https://evil.com", 6, 5); !errors.Is(err, ErrOriginNotAllowed) {
+		t.Fatalf("expected origin not allowed, got %v", err)
+	}
+
+	if _, err := assert("login-challenge", "https://example.com", 5, 5); !errors.Is(err, ErrSignCountRollback) {
+		t.Fatalf("expected sign count rollback, got %v", err)
+	}
+}
+
+func TestDecodeCBORRejectsTruncatedInput(t *testing.T) {
+	if _, _, err := decodeCBOR([]byte{0x59, 0x01}); err == nil {
+		t.Fatal("expected error for truncated length")
+	}
+
+	if _, _, err := decodeCBOR([]byte{0x42, 0x01}); err == nil {
+		t.Fatal("expected error for truncated byte string")
+	}
+}
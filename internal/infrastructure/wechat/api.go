@@ -0,0 +1,235 @@
+package wechat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"kiwi-user/config"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/Yet-Another-AI-Project/kiwi-lib/tools/xhttp"
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+const (
+	apiBaseURL = "https://api.weixin.qq.com"
+
+	// accessTokenRefreshMargin a cached access token is renewed this long before it expires
+	accessTokenRefreshMargin = 5 * time.Minute
+	// maxTemporaryQRCodeExpire the longest lifetime of a temporary qr code
+	maxTemporaryQRCodeExpire = 30 * 24 * time.Hour
+)
+
+// QRCode a temporary parametric qr code, URL is the content to render, ImageURL the image WeChat renders
+type QRCode struct {
+	Ticket    string
+	URL       string
+	ImageURL  string
+	ExpiresIn int64
+}
+
+// UserInfo a follower of the official account, unionid is only set when the account is bound to an
+// open platform account. WeChat no longer returns nickname and avatar here.
+type UserInfo struct {
+	Subscribe  int    `json:"subscribe"`
+	OpenID     string `json:"openid"`
+	UnionID    string `json:"unionid"`
+	Nickname   string `json:"nickname"`
+	HeadImgURL string `json:"headimgurl"`
+}
+
+// OfficialAccountAPI the http endpoints of the official account, a local fake replaces it in tests
+type OfficialAccountAPI interface {
+	CreateTemporaryQRCode(ctx context.Context, scene string, expire time.Duration) (*QRCode, error)
+	UserInfo(ctx context.Context, openID string) (*UserInfo, error)
+}
+
+type apiError struct {
+	Errcode int    `json:"errcode"`
+	Errmsg  string `json:"errmsg"`
+}
+
+func (e apiError) err() error {
+	if e.Errcode == 0 {
+		return nil
+	}
+	return fmt.Errorf("wechat api error: %d, %s", e.Errcode, e.Errmsg)
+}
+
+type httpOfficialAccountAPI struct {
+	appID      string
+	appSecret  string
+	httpClient *xhttp.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewOfficialAccountAPI the endpoints of the official account of the wechat config
+func NewOfficialAccountAPI(config *config.Config, httpClient *xhttp.Client) OfficialAccountAPI {
+	api := &httpOfficialAccountAPI{
+		httpClient: httpClient,
+	}
+
+	if config.Wechat != nil {
+		api.appID = config.Wechat.OfficalAccountID
+		api.appSecret = config.Wechat.OfficalAccountSecret
+	}
+
+	return api
+}
+
+func (a *httpOfficialAccountAPI) CreateTemporaryQRCode(ctx context.Context, scene string, expire time.Duration) (*QRCode, error) {
+	accessToken, err := a.getAccessToken(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	expire = min(expire, maxTemporaryQRCodeExpire)
+
+	request := map[string]any{
+		"expire_seconds": int64(expire / time.Second),
+		"action_name":    "QR_STR_SCENE",
+		"action_info": map[string]any{
+			"scene": map[string]string{"scene_str": scene},
+		},
+	}
+
+	var response struct {
+		apiError
+		Ticket        string `json:"ticket"`
+		ExpireSeconds int64  `json:"expire_seconds"`
+		URL           string `json:"url"`
+	}
+	if err := a.postJSON(ctx, "/cgi-bin/qrcode/create?access_token="+url.QueryEscape(accessToken), request, &response); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if err := response.err(); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return &QRCode{
+		Ticket:    response.Ticket,
+		URL:       response.URL,
+		ImageURL:  "https://mp.weixin.qq.com/cgi-bin/showqrcode?ticket=" + url.QueryEscape(response.Ticket),
+		ExpiresIn: response.ExpireSeconds,
+	}, nil
+}
+
+func (a *httpOfficialAccountAPI) UserInfo(ctx context.Context, openID string) (*UserInfo, error) {
+	accessToken, err := a.getAccessToken(ctx)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	query := url.Values{}
+	query.Set("access_token", accessToken)
+	query.Set("openid", openID)
+	query.Set("lang", "zh_CN")
+
+	var response struct {
+		apiError
+		UserInfo
+	}
+	if err := a.do(ctx, http.MethodGet, "/cgi-bin/user/info?"+query.Encode(), nil, &response); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	if err := response.err(); err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return &response.UserInfo, nil
+}
+
+// getAccessToken the stable access token, every instance asking for it gets the same one so
+// instances do not invalidate the tokens of each other
+func (a *httpOfficialAccountAPI) getAccessToken(ctx context.Context) (string, error) {
+	if a.appID == "" || a.appSecret == "" {
+		return "", xerror.Wrap(ErrNotConfigured)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.accessToken != "" && time.Now().Before(a.expiresAt) {
+		return a.accessToken, nil
+	}
+
+	request := map[string]string{
+		"grant_type": "client_credential",
+		"appid":      a.appID,
+		"secret":     a.appSecret,
+	}
+
+	var response struct {
+		apiError
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := a.postJSON(ctx, "/cgi-bin/stable_token", request, &response); err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	if err := response.err(); err != nil {
+		return "", xerror.Wrap(err)
+	}
+
+	if response.AccessToken == "" {
+		return "", xerror.Wrap(errors.New("wechat access token is empty"))
+	}
+
+	a.accessToken = response.AccessToken
+	a.expiresAt = time.Now().Add(time.Duration(response.ExpiresIn)*time.Second - accessTokenRefreshMargin)
+
+	return a.accessToken, nil
+}
+
+func (a *httpOfficialAccountAPI) postJSON(ctx context.Context, path string, body any, response any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return a.do(ctx, http.MethodPost, path, bytes.NewReader(b), response)
+}
+
+func (a *httpOfficialAccountAPI) do(ctx context.Context, method string, path string, body io.Reader, response any) error {
+	request, err := http.NewRequestWithContext(ctx, method, apiBaseURL+path, body)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := a.httpClient.Do(request)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return xerror.Wrap(fmt.Errorf("wechat api %s failed: %s", request.URL.Path, resp.Status))
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return xerror.Wrap(err)
+	}
+
+	if err := json.Unmarshal(b, response); err != nil {
+		return xerror.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package wechat
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"kiwi-user/config"
+	"kiwi-user/internal/infrastructure/replay"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+// Scan to follow login of a WeChat official account
+// (https://developers.weixin.qq.com/doc/offiaccount/Account_Management/Generating_a_Parametric_QR_Code.html).
+// A temporary qr code carries the scene of a pending login. Scanning it makes WeChat push a subscribe
+// event to users not following the account yet, a SCAN event to followers. The events are signed with
+// the token of the server config and, in safe mode, encrypted with its EncodingAESKey.
+// The plain signature does not cover the message, only the message signature of the safe mode does,
+// so scans log users in only from encrypted messages.
+
+const (
+	EventSubscribe = "subscribe"
+	EventScan      = "SCAN"
+
+	// subscribeScenePrefix prefixes the scene in the event key of subscribe events
+	subscribeScenePrefix = "qrscene_"
+
+	// callbackTimestampWindow how far the timestamp of a callback may be from now, its nonce is
+	// remembered for as long
+	callbackTimestampWindow = 5 * time.Minute
+)
+
+var (
+	ErrNotConfigured    = errors.New("wechat official account is not configured")
+	ErrInvalidSignature = errors.New("invalid wechat callback signature")
+	ErrInvalidMessage   = errors.New("invalid wechat callback message")
+	ErrStaleMessage     = errors.New("stale or replayed wechat callback message")
+	ErrPlaintextMessage = errors.New("wechat scan events must be encrypted")
+)
+
+// CallbackQuery the query of a callback request
+type CallbackQuery struct {
+	Signature    string
+	Timestamp    string
+	Nonce        string
+	EchoStr      string
+	EncryptType  string
+	MsgSignature string
+}
+
+// Message a message or event pushed to the server, only the fields of events are read
+type Message struct {
+	ToUserName   string `xml:"ToUserName"`
+	FromUserName string `xml:"FromUserName"`
+	CreateTime   int64  `xml:"CreateTime"`
+	MsgType      string `xml:"MsgType"`
+	Event        string `xml:"Event"`
+	EventKey     string `xml:"EventKey"`
+	Ticket       string `xml:"Ticket"`
+
+	// encrypted the message came encrypted and its message signature was verified
+	encrypted bool
+}
+
+// Scene the scene of the scanned parametric qr code, empty for other messages
+func (m *Message) Scene() string {
+	if m.MsgType != "event" {
+		return ""
+	}
+
+	switch m.Event {
+	case EventSubscribe:
+		// following from search or a card has no event key
+		return strings.TrimPrefix(m.EventKey, subscribeScenePrefix)
+	case EventScan:
+		return m.EventKey
+	default:
+		return ""
+	}
+}
+
+// ScanEvent a user scanned the qr code of a scene
+type ScanEvent struct {
+	Scene   string
+	OpenID  string
+	UnionID string
+}
+
+type encryptedMessage struct {
+	ToUserName string `xml:"ToUserName"`
+	Encrypt    string `xml:"Encrypt"`
+}
+
+type OfficialAccount struct {
+	appID  string
+	token  string
+	aesKey []byte
+
+	api         OfficialAccountAPI
+	replayStore replay.Store
+}
+
+func NewOfficialAccount(config *config.Config, api OfficialAccountAPI, replayStore replay.Store) (*OfficialAccount, error) {
+	account := &OfficialAccount{
+		api:         api,
+		replayStore: replayStore,
+	}
+
+	if config.Wechat == nil {
+		return account, nil
+	}
+
+	account.appID = config.Wechat.OfficalAccountID
+	account.token = config.Wechat.OfficalAccountToken
+
+	if config.Wechat.OfficalAccountEncodingAESKey != "" {
+		aesKey, err := base64.StdEncoding.DecodeString(config.Wechat.OfficalAccountEncodingAESKey + "=")
+		if err != nil || len(aesKey) != 32 {
+			return nil, xerror.Wrap(errors.New("invalid wechat official account encoding aes key"))
+		}
+
+		account.aesKey = aesKey
+	}
+
+	return account, nil
+}
+
+// CreateQRCode a temporary qr code of the scene, scans are only accepted in safe mode
+func (o *OfficialAccount) CreateQRCode(ctx context.Context, scene string, expire time.Duration) (*QRCode, error) {
+	if o.aesKey == nil {
+		return nil, xerror.Wrap(ErrNotConfigured)
+	}
+
+	qrCode, err := o.api.CreateTemporaryQRCode(ctx, scene, expire)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return qrCode, nil
+}
+
+// VerifyURL answers the url verification of the server config with the echo string
+func (o *OfficialAccount) VerifyURL(query CallbackQuery) (string, error) {
+	if o.token == "" {
+		return "", xerror.Wrap(ErrNotConfigured)
+	}
+
+	if !o.verifySignature(query.Signature, query.Timestamp, query.Nonce) {
+		return "", xerror.Wrap(ErrInvalidSignature)
+	}
+
+	return query.EchoStr, nil
+}
+
+// ParseMessage verifies and decrypts a pushed message. Messages outside the timestamp window and
+// nonces seen before are refused.
+func (o *OfficialAccount) ParseMessage(ctx context.Context, query CallbackQuery, body []byte) (*Message, error) {
+	if o.token == "" {
+		return nil, xerror.Wrap(ErrNotConfigured)
+	}
+
+	// the plain signature is sent in every mode
+	if !o.verifySignature(query.Signature, query.Timestamp, query.Nonce) {
+		return nil, xerror.Wrap(ErrInvalidSignature)
+	}
+
+	timestamp, err := strconv.ParseInt(query.Timestamp, 10, 64)
+	if err != nil {
+		return nil, xerror.Wrap(ErrInvalidMessage)
+	}
+
+	signedAt := time.Unix(timestamp, 0)
+	if age := time.Since(signedAt); age > callbackTimestampWindow || age < -callbackTimestampWindow {
+		return nil, xerror.Wrap(ErrStaleMessage)
+	}
+
+	encrypted := query.EncryptType == "aes"
+	if encrypted {
+		var encrypted encryptedMessage
+		if err := xml.Unmarshal(body, &encrypted); err != nil {
+			return nil, xerror.Wrap(ErrInvalidMessage)
+		}
+
+		if !o.verifySignature(query.MsgSignature, query.Timestamp, query.Nonce, encrypted.Encrypt) {
+			return nil, xerror.Wrap(ErrInvalidSignature)
+		}
+
+		decrypted, err := o.decrypt(encrypted.Encrypt)
+		if err != nil {
+			return nil, xerror.Wrap(err)
+		}
+
+		body = decrypted
+	}
+
+	// the nonce is consumed once the signatures passed, forged requests cannot burn it
+	ok, err := o.replayStore.Consume(ctx, "wechat-callback:"+query.Nonce, signedAt.Add(callbackTimestampWindow))
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+	if !ok {
+		return nil, xerror.Wrap(ErrStaleMessage)
+	}
+
+	var message Message
+	if err := xml.Unmarshal(body, &message); err != nil {
+		return nil, xerror.Wrap(ErrInvalidMessage)
+	}
+	message.encrypted = encrypted
+
+	return &message, nil
+}
+
+// ScanEvent the scan of a parametric qr code in the message, nil for other messages. The unionid
+// is looked up so logins of the official account match logins of the other wechat apps.
+func (o *OfficialAccount) ScanEvent(ctx context.Context, message *Message) (*ScanEvent, error) {
+	scene := message.Scene()
+	if scene == "" || message.FromUserName == "" {
+		return nil, nil
+	}
+
+	if !message.encrypted {
+		return nil, xerror.Wrap(ErrPlaintextMessage)
+	}
+
+	userInfo, err := o.api.UserInfo(ctx, message.FromUserName)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	return &ScanEvent{
+		Scene:   scene,
+		OpenID:  message.FromUserName,
+		UnionID: userInfo.UnionID,
+	}, nil
+}
+
+// verifySignature the sha1 of the sorted token, timestamp, nonce and, for encrypted messages, the
+// encrypted message
+func (o *OfficialAccount) verifySignature(signature string, values ...string) bool {
+	signed := append([]string{o.token}, values...)
+	sort.Strings(signed)
+
+	digest := sha1.Sum([]byte(strings.Join(signed, "")))
+
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(digest[:])), []byte(signature)) == 1
+}
+
+// decrypt AES-256-CBC with the first 16 bytes of the key as iv. The plaintext is 16 random bytes,
+// the length of the message as 4 bytes big endian, the message and the appid of the account.
+func (o *OfficialAccount) decrypt(encrypted string) ([]byte, error) {
+	if o.aesKey == nil {
+		return nil, xerror.Wrap(ErrNotConfigured)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, xerror.Wrap(ErrInvalidMessage)
+	}
+
+	block, err := aes.NewCipher(o.aesKey)
+	if err != nil {
+		return nil, xerror.Wrap(err)
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, o.aesKey[:aes.BlockSize]).CryptBlocks(plaintext, ciphertext)
+
+	// pkcs7 padded to 32 bytes
+	pad := int(plaintext[len(plaintext)-1])
+	if pad < 1 || pad > 32 || pad > len(plaintext) {
+		return nil, xerror.Wrap(ErrInvalidMessage)
+	}
+	plaintext = plaintext[:len(plaintext)-pad]
+
+	if len(plaintext) < 20 {
+		return nil, xerror.Wrap(ErrInvalidMessage)
+	}
+
+	length := int(binary.BigEndian.Uint32(plaintext[16:20]))
+	if length > len(plaintext)-20 {
+		return nil, xerror.Wrap(ErrInvalidMessage)
+	}
+
+	message := plaintext[20 : 20+length]
+	if string(plaintext[20+length:]) != o.appID {
+		return nil, xerror.Wrap(ErrInvalidMessage)
+	}
+
+	return message, nil
+}
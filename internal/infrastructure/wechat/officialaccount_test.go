@@ -0,0 +1,304 @@
+package wechat
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"kiwi-user/config"
+	"kiwi-user/internal/infrastructure/replay"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/futurxlab/golanggraph/xerror"
+)
+
+const (
+	testAppID = "wx0123456789abcdef"
+	testToken = "callback-token"
+	// 43 characters, base64 of 32 bytes without the padding
+	testEncodingAESKey = "abcdefghijklmnopqrstuvwxyz0123456789ABCDEFG"
+)
+
+type fakeOfficialAccountAPI struct {
+	unionIDs map[string]string
+	scenes   []string
+}
+
+func (f *fakeOfficialAccountAPI) CreateTemporaryQRCode(ctx context.Context, scene string, expire time.Duration) (*QRCode, error) {
+	f.scenes = append(f.scenes, scene)
+	return &QRCode{
+		Ticket:    "ticket-" + scene,
+		URL:       "http://weixin.qq.com/q/" + scene,
+		ExpiresIn: int64(expire / time.Second),
+	}, nil
+}
+
+func (f *fakeOfficialAccountAPI) UserInfo(ctx context.Context, openID string) (*UserInfo, error) {
+	return &UserInfo{Subscribe: 1, OpenID: openID, UnionID: f.unionIDs[openID]}, nil
+}
+
+func newTestOfficialAccount(t *testing.T) (*OfficialAccount, *fakeOfficialAccountAPI) {
+	t.Helper()
+
+	aesKey, err := base64.StdEncoding.DecodeString(testEncodingAESKey + "=")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api := &fakeOfficialAccountAPI{unionIDs: map[string]string{"openid-1": "unionid-1"}}
+
+	return &OfficialAccount{
+		appID:       testAppID,
+		token:       testToken,
+		aesKey:      aesKey,
+		api:         api,
+		replayStore: replay.NewStore(&config.Config{}, nil),
+	}, api
+}
+
+func sign(values ...string) string {
+	sort.Strings(values)
+	digest := sha1.Sum([]byte(strings.Join(values, "")))
+	return hex.EncodeToString(digest[:])
+}
+
+func encrypt(t *testing.T, aesKey []byte, message string) string {
+	t.Helper()
+
+	plaintext := []byte("0123456789abcdef")
+	plaintext = binary.BigEndian.AppendUint32(plaintext, uint32(len(message)))
+	plaintext = append(plaintext, message...)
+	plaintext = append(plaintext, testAppID...)
+
+	pad := 32 - len(plaintext)%32
+	for range pad {
+		plaintext = append(plaintext, byte(pad))
+	}
+
+	block, err := aes.NewCipher(aesKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ciphertext := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, aesKey[:aes.BlockSize]).CryptBlocks(ciphertext, plaintext)
+
+	return base64.StdEncoding.EncodeToString(ciphertext)
+}
+
+// encryptedQuery the query and body of an encrypted message signed now with the nonce
+func encryptedQuery(t *testing.T, aesKey []byte, nonce string, message string) (CallbackQuery, []byte) {
+	t.Helper()
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	encrypted := encrypt(t, aesKey, message)
+
+	return CallbackQuery{
+		Signature:    sign(testToken, timestamp, nonce),
+		Timestamp:    timestamp,
+		Nonce:        nonce,
+		EncryptType:  "aes",
+		MsgSignature: sign(testToken, timestamp, nonce, encrypted),
+	}, []byte("<xml><ToUserName><![CDATA[gh_account]]></ToUserName><Encrypt><![CDATA[" + encrypted + "]]></Encrypt></xml>")
+}
+
+func eventXML(event string, eventKey string) string {
+	return "<xml><ToUserName><![CDATA[gh_account]]></ToUserName><FromUserName><![CDATA[openid-1]]></FromUserName>" +
+		"<CreateTime>1700000000</CreateTime><MsgType><![CDATA[event]]></MsgType><Event><![CDATA[" + event + "]]></Event>" +
+		"<EventKey><![CDATA[" + eventKey + "]]></EventKey><Ticket><![CDATA[ticket]]></Ticket></xml>"
+}
+
+func TestVerifyURL(t *testing.T) {
+	account, _ := newTestOfficialAccount(t)
+
+	echo, err := account.VerifyURL(CallbackQuery{
+		Signature: sign(testToken, "1700000000", "nonce"),
+		Timestamp: "1700000000",
+		Nonce:     "nonce",
+		EchoStr:   "echo",
+	})
+	if err != nil || echo != "echo" {
+		t.Fatalf("verify url: %q, %v", echo, err)
+	}
+
+	if _, err := account.VerifyURL(CallbackQuery{Signature: "bad", Timestamp: "1700000000", Nonce: "nonce"}); !xerror.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected invalid signature, got %v", err)
+	}
+}
+
+func TestScanEvent(t *testing.T) {
+	account, _ := newTestOfficialAccount(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		event    string
+		eventKey string
+		scene    string
+	}{
+		{"subscribe", EventSubscribe, "qrscene_login-1", "login-1"},
+		{"scan", EventScan, "login-1", "login-1"},
+		{"subscribe without qr code", EventSubscribe, "", ""},
+		{"unsubscribe", "unsubscribe", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, body := encryptedQuery(t, account.aesKey, "nonce-"+tt.name, eventXML(tt.event, tt.eventKey))
+
+			message, err := account.ParseMessage(ctx, query, body)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+
+			event, err := account.ScanEvent(ctx, message)
+			if err != nil {
+				t.Fatalf("scan event: %v", err)
+			}
+
+			if tt.scene == "" {
+				if event != nil {
+					t.Fatalf("expected no scan event, got %+v", event)
+				}
+				return
+			}
+
+			if event == nil || event.Scene != tt.scene || event.OpenID != "openid-1" || event.UnionID != "unionid-1" {
+				t.Fatalf("unexpected scan event %+v", event)
+			}
+		})
+	}
+}
+
+func TestParseMessageSignature(t *testing.T) {
+	account, _ := newTestOfficialAccount(t)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	_, err := account.ParseMessage(context.Background(), CallbackQuery{
+		Signature: sign(testToken, timestamp, "other-nonce"),
+		Timestamp: timestamp,
+		Nonce:     "nonce",
+	}, []byte(eventXML(EventScan, "login-1")))
+	if !xerror.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected invalid signature, got %v", err)
+	}
+}
+
+func TestParseMessageTimestamp(t *testing.T) {
+	account, _ := newTestOfficialAccount(t)
+
+	for _, offset := range []time.Duration{-6 * time.Minute, 6 * time.Minute} {
+		timestamp := strconv.FormatInt(time.Now().Add(offset).Unix(), 10)
+
+		_, err := account.ParseMessage(context.Background(), CallbackQuery{
+			Signature: sign(testToken, timestamp, "nonce"),
+			Timestamp: timestamp,
+			Nonce:     "nonce",
+		}, []byte(eventXML(EventScan, "login-1")))
+		if !xerror.Is(err, ErrStaleMessage) {
+			t.Fatalf("timestamp %s from now: expected a stale message, got %v", offset, err)
+		}
+	}
+}
+
+// the plain signature does not cover the body, a captured one must not carry another message
+func TestParseMessageReplay(t *testing.T) {
+	account, _ := newTestOfficialAccount(t)
+	ctx := context.Background()
+
+	query, body := encryptedQuery(t, account.aesKey, "nonce", eventXML(EventScan, "login-1"))
+	if _, err := account.ParseMessage(ctx, query, body); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	// the same signature and nonce again, with the encrypted body of another user
+	_, forged := encryptedQuery(t, account.aesKey, "nonce", strings.Replace(eventXML(EventScan, "login-1"), "openid-1", "openid-2", 1))
+	if _, err := account.ParseMessage(ctx, query, forged); !xerror.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected invalid signature for another body, got %v", err)
+	}
+
+	// the same message again
+	if _, err := account.ParseMessage(ctx, query, body); !xerror.Is(err, ErrStaleMessage) {
+		t.Fatalf("expected a replayed message, got %v", err)
+	}
+
+	// the plain signature of the message with a plaintext body
+	plain := CallbackQuery{Signature: query.Signature, Timestamp: query.Timestamp, Nonce: query.Nonce}
+	if _, err := account.ParseMessage(ctx, plain, []byte(eventXML(EventScan, "login-1"))); !xerror.Is(err, ErrStaleMessage) {
+		t.Fatalf("expected a replayed plain signature, got %v", err)
+	}
+}
+
+// plaintext messages are parsed, their scans do not log anyone in
+func TestPlaintextScanEvent(t *testing.T) {
+	account, _ := newTestOfficialAccount(t)
+	ctx := context.Background()
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	for _, event := range []string{EventScan, EventSubscribe} {
+		nonce := "nonce-" + event
+
+		message, err := account.ParseMessage(ctx, CallbackQuery{
+			Signature: sign(testToken, timestamp, nonce),
+			Timestamp: timestamp,
+			Nonce:     nonce,
+		}, []byte(eventXML(event, "qrscene_login-1")))
+		if err != nil {
+			t.Fatalf("parse: %v", err)
+		}
+
+		if _, err := account.ScanEvent(ctx, message); !xerror.Is(err, ErrPlaintextMessage) {
+			t.Fatalf("%s: expected a plaintext message, got %v", event, err)
+		}
+	}
+}
+
+func TestParseEncryptedMessage(t *testing.T) {
+	account, _ := newTestOfficialAccount(t)
+	ctx := context.Background()
+
+	query, body := encryptedQuery(t, account.aesKey, "nonce-1", eventXML(EventScan, "login-1"))
+
+	message, err := account.ParseMessage(ctx, query, body)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if message.Scene() != "login-1" || message.FromUserName != "openid-1" {
+		t.Fatalf("unexpected message %+v", message)
+	}
+
+	// tampered messages fail the message signature
+	query, body = encryptedQuery(t, account.aesKey, "nonce-2", eventXML(EventScan, "login-1"))
+	query.MsgSignature = sign(testToken, query.Timestamp, query.Nonce, "tampered")
+	if _, err := account.ParseMessage(ctx, query, body); !xerror.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected invalid signature, got %v", err)
+	}
+
+	// messages of another account are refused
+	account.appID = "wx-other"
+	query, body = encryptedQuery(t, account.aesKey, "nonce-3", eventXML(EventScan, "login-1"))
+	if _, err := account.ParseMessage(ctx, query, body); !xerror.Is(err, ErrInvalidMessage) {
+		t.Fatalf("expected invalid message, got %v", err)
+	}
+}
+
+func TestCreateQRCode(t *testing.T) {
+	account, api := newTestOfficialAccount(t)
+
+	qrCode, err := account.CreateQRCode(context.Background(), "login-1", 5*time.Minute)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if qrCode.ExpiresIn != 300 || len(api.scenes) != 1 || api.scenes[0] != "login-1" {
+		t.Fatalf("unexpected qr code %+v, scenes %v", qrCode, api.scenes)
+	}
+}